@@ -5,10 +5,13 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rezkam/mono/internal/application/auth"
 	"github.com/rezkam/mono/internal/config"
+	"github.com/rezkam/mono/internal/domain"
 	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres"
 )
 
@@ -25,11 +28,21 @@ func main() {
 	// Define flags
 	name := flag.String("name", "", "Name/description for the API key (required)")
 	days := flag.Int("days", 0, "Number of days until expiration (0 = never expires)")
+	owner := flag.String("owner", "", "Owner (tenant) ID to issue the key for (empty = create a new tenant)")
+	scopesFlag := flag.String("scopes", domain.ScopePresetReadWrite,
+		"Comma-separated scopes (lists:read, lists:write, items:read, items:write, webhooks:read, webhooks:write, admin) or a preset (read-only, read-write, admin)")
 
 	flag.Parse()
 
 	// Load configuration
-	cfg, err := config.LoadAPIKeyGenConfig(*name, *days)
+	cfg, err := config.LoadAPIKeyGenConfig(*name, *days, *owner, *scopesFlag)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		flag.Usage()
+		log.Fatal(err)
+	}
+
+	scopes, err := domain.ParseScopes(cfg.Scopes)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		flag.Usage()
@@ -76,8 +89,18 @@ func main() {
 		version = defaultVersion
 	}
 
+	// Keys without an explicit owner start a new tenant
+	ownerID := cfg.OwnerID
+	if ownerID == "" {
+		newOwner, err := uuid.NewV7()
+		if err != nil {
+			log.Fatalf("Failed to generate owner ID: %v", err)
+		}
+		ownerID = newOwner.String()
+	}
+
 	// Generate API key with configurable prefix
-	apiKey, err := auth.CreateAPIKey(ctx, store, keyType, serviceName, version, cfg.Name, expiresAt)
+	apiKey, err := auth.CreateAPIKeyForOwner(ctx, store, ownerID, scopes, keyType, serviceName, version, cfg.Name, expiresAt)
 	if err != nil {
 		log.Fatalf("Failed to create API key: %v", err)
 	}
@@ -86,6 +109,8 @@ func main() {
 	fmt.Println("\n API Key created successfully!")
 	fmt.Println("----------------------------------------")
 	fmt.Printf("Name: %s\n", cfg.Name)
+	fmt.Printf("Owner: %s\n", ownerID)
+	fmt.Printf("Scopes: %s\n", formatScopes(scopes))
 	fmt.Printf("Format: %s-%s-%s-{short}-{long}\n", keyType, serviceName, version)
 	if expiresAt != nil {
 		fmt.Printf("Expires: %s (%d days)\n", expiresAt.Format(time.RFC3339), cfg.DaysValid)
@@ -99,3 +124,12 @@ func main() {
 	fmt.Println("Usage example:")
 	fmt.Printf("  curl -H \"Authorization: Bearer %s\" https://monodo.app/api/v1/lists\n", apiKey)
 }
+
+// formatScopes renders scopes as a comma-separated list.
+func formatScopes(scopes []domain.Scope) string {
+	values := make([]string, len(scopes))
+	for i, scope := range scopes {
+		values[i] = string(scope)
+	}
+	return strings.Join(values, ", ")
+}
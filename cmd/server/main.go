@@ -118,6 +118,7 @@ func initializeAPIServer(store *postgres.Store, cfg *config.ServerConfig) (*http
 	todoService := todo.NewService(store, generator, todo.Config{
 		DefaultPageSize: cfg.Todo.DefaultPageSize,
 		MaxPageSize:     cfg.Todo.MaxPageSize,
+		Changes:         postgres.NewChangeListener(store.Pool()),
 	})
 
 	// Initialize coordinator for job management (DLQ operations)
@@ -11,8 +11,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/application/todo"
 	"github.com/rezkam/mono/internal/application/worker"
 	"github.com/rezkam/mono/internal/config"
+	"github.com/rezkam/mono/internal/infrastructure/notify"
 	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres"
 	"github.com/rezkam/mono/internal/recurring"
 )
@@ -76,9 +78,9 @@ func run() error {
 	reconciliationCfg := worker.DefaultReconciliationConfig(workerID)
 	reconciliationWorker := worker.NewReconciliationWorker(coordinator, store, generator, reconciliationCfg)
 
-	// Start both workers concurrently
+	// Start workers concurrently
 	var wg sync.WaitGroup
-	errChan := make(chan error, 2)
+	errChan := make(chan error, 6)
 
 	// Start generation worker pool
 	wg.Add(1)
@@ -101,6 +103,96 @@ func run() error {
 		}
 	}()
 
+	// Start reminder worker pool when a delivery target is configured
+	if cfg.Reminders.WebhookURL != "" {
+		webhookTimeout := cfg.Reminders.WebhookTimeout
+		if webhookTimeout <= 0 {
+			webhookTimeout = 10 * time.Second
+		}
+		notifier := notify.NewWebhookNotifier(cfg.Reminders.WebhookURL, webhookTimeout)
+		reminderCfg := worker.DefaultReminderConfig(workerID)
+		reminderWorker := worker.NewReminderWorker(coordinator, store, notifier, reminderCfg)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runReminderWorkerPool(ctx, reminderWorker, reminderCfg)
+		}()
+	} else {
+		slog.InfoContext(ctx, "Reminder delivery disabled: MONO_REMINDER_WEBHOOK_URL not set")
+	}
+
+	// Start webhook delivery worker pool
+	webhookTimeout := cfg.Webhooks.Timeout
+	if webhookTimeout <= 0 {
+		webhookTimeout = 10 * time.Second
+	}
+	webhookCfg := worker.DefaultWebhookConfig(workerID)
+	webhookWorker := worker.NewWebhookDeliveryWorker(coordinator, store, notify.NewSignedWebhookSender(webhookTimeout), webhookCfg)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		runWebhookWorkerPool(ctx, webhookWorker, webhookCfg)
+	}()
+
+	// Start change log relay (single instance across workers via lease)
+	relay := worker.NewOutboxRelay(coordinator, store, worker.DefaultOutboxRelayConfig(workerID))
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := relay.Run(ctx); err != nil {
+			// Context cancellation is expected during shutdown
+			if ctx.Err() == nil {
+				errChan <- fmt.Errorf("outbox relay error: %w", err)
+			}
+		}
+	}()
+
+	// Start list snapshot worker (single instance across workers via lease)
+	snapshotWorker := worker.NewSnapshotWorker(coordinator, worker.DefaultSnapshotConfig(workerID))
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := snapshotWorker.Run(ctx); err != nil {
+			// Context cancellation is expected during shutdown
+			if ctx.Err() == nil {
+				errChan <- fmt.Errorf("snapshot worker error: %w", err)
+			}
+		}
+	}()
+
+	// Start automation worker for overdue rules (single instance across workers via lease)
+	todoService := todo.NewService(store, generator, todo.Config{})
+	automationWorker := worker.NewAutomationWorker(coordinator, todoService, worker.DefaultAutomationConfig(workerID))
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := automationWorker.Run(ctx); err != nil {
+			// Context cancellation is expected during shutdown
+			if ctx.Err() == nil {
+				errChan <- fmt.Errorf("automation worker error: %w", err)
+			}
+		}
+	}()
+
+	// Start snooze worker to wake items whose snooze passed (single instance across workers via lease)
+	snoozeWorker := worker.NewSnoozeWorker(coordinator, worker.DefaultSnoozeConfig(workerID))
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := snoozeWorker.Run(ctx); err != nil {
+			// Context cancellation is expected during shutdown
+			if ctx.Err() == nil {
+				errChan <- fmt.Errorf("snooze worker error: %w", err)
+			}
+		}
+	}()
+
 	// Wait for shutdown signal or worker errors
 	select {
 	case <-ctx.Done():
@@ -159,3 +251,79 @@ func runGenerationWorker(ctx context.Context, worker *worker.GenerationWorker, c
 		}
 	}
 }
+
+// runReminderWorkerPool runs multiple concurrent reminder delivery workers.
+func runReminderWorkerPool(ctx context.Context, worker *worker.ReminderWorker, cfg worker.ReminderConfig) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < cfg.Concurrency; i++ {
+		wg.Add(1)
+		go func(workerNum int) {
+			defer wg.Done()
+			runReminderWorker(ctx, worker, cfg, workerNum)
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+// runReminderWorker runs a single reminder worker in a polling loop.
+func runReminderWorker(ctx context.Context, worker *worker.ReminderWorker, cfg worker.ReminderConfig, workerNum int) {
+	slog.InfoContext(ctx, "Reminder worker started", "worker_num", workerNum)
+
+	ticker := time.NewTicker(cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "Reminder worker stopping", "worker_num", workerNum)
+			return
+		case <-ticker.C:
+			// Deliver one reminder (or none if nothing is due)
+			if err := worker.RunProcessOnce(ctx); err != nil {
+				slog.ErrorContext(ctx, "Reminder worker error",
+					"worker_num", workerNum,
+					"error", err)
+			}
+		}
+	}
+}
+
+// runWebhookWorkerPool runs multiple concurrent webhook delivery workers.
+func runWebhookWorkerPool(ctx context.Context, worker *worker.WebhookDeliveryWorker, cfg worker.WebhookConfig) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < cfg.Concurrency; i++ {
+		wg.Add(1)
+		go func(workerNum int) {
+			defer wg.Done()
+			runWebhookWorker(ctx, worker, cfg, workerNum)
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+// runWebhookWorker runs a single webhook delivery worker in a polling loop.
+func runWebhookWorker(ctx context.Context, worker *worker.WebhookDeliveryWorker, cfg worker.WebhookConfig, workerNum int) {
+	slog.InfoContext(ctx, "Webhook worker started", "worker_num", workerNum)
+
+	ticker := time.NewTicker(cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "Webhook worker stopping", "worker_num", workerNum)
+			return
+		case <-ticker.C:
+			// Send one delivery (or none if nothing is pending)
+			if err := worker.RunProcessOnce(ctx); err != nil {
+				slog.ErrorContext(ctx, "Webhook worker error",
+					"worker_num", workerNum,
+					"error", err)
+			}
+		}
+	}
+}
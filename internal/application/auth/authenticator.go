@@ -282,8 +282,33 @@ func (a *Authenticator) ValidateAPIKey(ctx context.Context, apiKey string) (*dom
 	return key, nil
 }
 
-// CreateAPIKey creates a new API key and returns the plain key (only shown once).
+// CreateAPIKey creates a new read-write API key for a new tenant and returns the plain key (only shown once).
+// The key gets a fresh owner, so it cannot see data created with any other key.
+// Use CreateAPIKeyForOwner to issue additional keys for an existing tenant or with other scopes.
 func CreateAPIKey(ctx context.Context, repo Repository, keyType, service, version, name string, expiresAt *time.Time) (string, error) {
+	ownerID, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate owner ID: %w", err)
+	}
+
+	return CreateAPIKeyForOwner(ctx, repo, ownerID.String(), domain.ReadWriteScopes(), keyType, service, version, name, expiresAt)
+}
+
+// CreateAPIKeyForOwner creates a new API key for an existing tenant and returns the plain key (only shown once).
+// All keys of the same owner share access to the same lists; scopes limit which operations the key may call.
+func CreateAPIKeyForOwner(ctx context.Context, repo Repository, ownerID string, scopes []domain.Scope, keyType, service, version, name string, expiresAt *time.Time) (string, error) {
+	if _, err := uuid.Parse(ownerID); err != nil {
+		return "", fmt.Errorf("%w: owner %w", domain.ErrInvalidID, err)
+	}
+	if len(scopes) == 0 {
+		return "", fmt.Errorf("%w: at least one scope is required", domain.ErrInvalidScope)
+	}
+	for _, scope := range scopes {
+		if _, err := domain.NewScope(string(scope)); err != nil {
+			return "", err
+		}
+	}
+
 	// Generate API key with short+long pattern
 	keyParts, err := keygen.GenerateAPIKey(keyType, service, version)
 	if err != nil {
@@ -307,6 +332,8 @@ func CreateAPIKey(ctx context.Context, repo Repository, keyType, service, versio
 		ShortToken:     keyParts.ShortToken,
 		LongSecretHash: longSecretHash,
 		Name:           name,
+		OwnerID:        ownerID,
+		Scopes:         scopes,
 		IsActive:       true,
 		CreatedAt:      time.Now().UTC(),
 		ExpiresAt:      expiresAt,
@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"errors"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -959,6 +960,118 @@ func TestAuthenticator_StressTest_RapidFireUpdates(t *testing.T) {
 	t.Logf("Processed %d updates", len(calls))
 }
 
+// =============================================================================
+// API KEY OWNERSHIP TESTS
+// =============================================================================
+
+func TestCreateAPIKey_AssignsNewOwnerPerKey(t *testing.T) {
+	repo := newMockRepository()
+	ctx := context.Background()
+
+	for range 2 {
+		if _, err := CreateAPIKey(ctx, repo, "sk", "mono", "v1", "tenant key", nil); err != nil {
+			t.Fatalf("CreateAPIKey failed: %v", err)
+		}
+	}
+
+	if len(repo.createCalls) != 2 {
+		t.Fatalf("expected 2 created keys, got %d", len(repo.createCalls))
+	}
+	first, second := repo.createCalls[0], repo.createCalls[1]
+	if first.OwnerID == "" || second.OwnerID == "" {
+		t.Fatal("expected keys to have an owner")
+	}
+	if first.OwnerID == second.OwnerID {
+		t.Errorf("expected each key to start a new tenant, both got owner %s", first.OwnerID)
+	}
+}
+
+func TestCreateAPIKeyForOwner_SharesOwner(t *testing.T) {
+	repo := newMockRepository()
+	ownerID := "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a5b"
+
+	if _, err := CreateAPIKeyForOwner(context.Background(), repo, ownerID, domain.ReadWriteScopes(), "sk", "mono", "v1", "second key", nil); err != nil {
+		t.Fatalf("CreateAPIKeyForOwner failed: %v", err)
+	}
+
+	if len(repo.createCalls) != 1 {
+		t.Fatalf("expected 1 created key, got %d", len(repo.createCalls))
+	}
+	if got := repo.createCalls[0].OwnerID; got != ownerID {
+		t.Errorf("expected owner %s, got %s", ownerID, got)
+	}
+}
+
+func TestCreateAPIKeyForOwner_InvalidOwner(t *testing.T) {
+	repo := newMockRepository()
+
+	_, err := CreateAPIKeyForOwner(context.Background(), repo, "not-a-uuid", domain.ReadWriteScopes(), "sk", "mono", "v1", "bad owner", nil)
+	if !errors.Is(err, domain.ErrInvalidID) {
+		t.Fatalf("expected ErrInvalidID, got %v", err)
+	}
+	if len(repo.createCalls) != 0 {
+		t.Errorf("expected no key to be stored, got %d", len(repo.createCalls))
+	}
+}
+
+// =============================================================================
+// API KEY SCOPE TESTS
+// =============================================================================
+
+func TestCreateAPIKey_DefaultsToReadWriteScopes(t *testing.T) {
+	repo := newMockRepository()
+
+	if _, err := CreateAPIKey(context.Background(), repo, "sk", "mono", "v1", "default scopes", nil); err != nil {
+		t.Fatalf("CreateAPIKey failed: %v", err)
+	}
+
+	if len(repo.createCalls) != 1 {
+		t.Fatalf("expected 1 created key, got %d", len(repo.createCalls))
+	}
+	if got := repo.createCalls[0].Scopes; !slices.Equal(got, domain.ReadWriteScopes()) {
+		t.Errorf("expected read-write scopes, got %v", got)
+	}
+}
+
+func TestCreateAPIKeyForOwner_StoresScopes(t *testing.T) {
+	repo := newMockRepository()
+	ownerID := "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a5b"
+
+	if _, err := CreateAPIKeyForOwner(context.Background(), repo, ownerID, domain.ReadOnlyScopes(), "sk", "mono", "v1", "reader", nil); err != nil {
+		t.Fatalf("CreateAPIKeyForOwner failed: %v", err)
+	}
+
+	if got := repo.createCalls[0].Scopes; !slices.Equal(got, domain.ReadOnlyScopes()) {
+		t.Errorf("expected read-only scopes, got %v", got)
+	}
+}
+
+func TestCreateAPIKeyForOwner_InvalidScopes(t *testing.T) {
+	ownerID := "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a5b"
+
+	tests := []struct {
+		name   string
+		scopes []domain.Scope
+	}{
+		{"no scopes", nil},
+		{"unknown scope", []domain.Scope{domain.ScopeListsRead, "lists:delete"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockRepository()
+
+			_, err := CreateAPIKeyForOwner(context.Background(), repo, ownerID, tt.scopes, "sk", "mono", "v1", "bad scopes", nil)
+			if !errors.Is(err, domain.ErrInvalidScope) {
+				t.Fatalf("expected ErrInvalidScope, got %v", err)
+			}
+			if len(repo.createCalls) != 0 {
+				t.Errorf("expected no key to be stored, got %d", len(repo.createCalls))
+			}
+		})
+	}
+}
+
 // =============================================================================
 // BENCHMARKS
 // =============================================================================
@@ -0,0 +1,32 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// GetAgenda returns the open items of a local day across every list the caller
+// can access, grouped into overdue, today and upcoming.
+// date is a YYYY-MM-DD day (empty = today) in the IANA timezone of the viewer
+// (empty = UTC); floating item times are read in that timezone, fixed ones converted.
+func (s *Service) GetAgenda(ctx context.Context, date, timezone string) (*domain.Agenda, error) {
+	day, err := domain.ParseAgendaDay(date, timezone, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.repo.FindAgendaItems(ctx, domain.NewAgendaQuery(day))
+	if err != nil {
+		return nil, err
+	}
+
+	truncated := len(items) > domain.MaxAgendaItems
+	if truncated {
+		items = items[:domain.MaxAgendaItems]
+	}
+	agenda := domain.NewAgenda(day, items)
+	agenda.Truncated = truncated
+	return agenda, nil
+}
@@ -0,0 +1,694 @@
+package todo
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/ptr"
+)
+
+// Automation rule execution log limits.
+const (
+	DefaultRuleRunsLimit = 50
+	MaxRuleRunsLimit     = 200
+)
+
+// ListAutomationRules returns the rules of a list, oldest first.
+// Any principal with access to the list can see its rules.
+func (s *Service) ListAutomationRules(ctx context.Context, listID string) ([]*domain.AutomationRule, error) {
+	if listID == "" {
+		return nil, domain.ErrListNotFound
+	}
+	if err := s.requireListRole(ctx, listID, domain.ListRoleViewer); err != nil {
+		return nil, err
+	}
+	return s.repo.FindAutomationRules(ctx, listID)
+}
+
+// GetAutomationRule retrieves a rule of a list.
+func (s *Service) GetAutomationRule(ctx context.Context, listID, id string) (*domain.AutomationRule, error) {
+	if listID == "" {
+		return nil, domain.ErrListNotFound
+	}
+	if id == "" {
+		return nil, domain.ErrRuleNotFound
+	}
+	if err := s.requireListRole(ctx, listID, domain.ListRoleViewer); err != nil {
+		return nil, err
+	}
+	return s.repo.FindAutomationRuleByID(ctx, listID, id)
+}
+
+// CreateAutomationRule adds a rule to a list. Requires the editor role on the
+// list and on the lists the actions create items in or move items to.
+// The rule runs on behalf of the caller's tenant.
+func (s *Service) CreateAutomationRule(ctx context.Context, rule *domain.AutomationRule) (*domain.AutomationRule, error) {
+	if rule.ListID == "" {
+		return nil, domain.ErrListNotFound
+	}
+	if err := s.requireListRole(ctx, rule.ListID, domain.ListRoleEditor); err != nil {
+		return nil, err
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.checkRuleActions(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountAutomationRules(ctx, rule.ListID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= domain.MaxRulesPerList {
+		return nil, fmt.Errorf("%w: at most %d rules per list", domain.ErrTooManyRules, domain.MaxRulesPerList)
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate id: %w", err)
+	}
+	rule.ID = id.String()
+	rule.OwnerID = ruleOwner(ctx)
+
+	now := time.Now().UTC()
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+
+	return s.repo.CreateAutomationRule(ctx, rule)
+}
+
+// UpdateAutomationRule updates a rule using field mask. The actions are
+// checked against the caller's access, so the rule runs on its behalf from now on.
+func (s *Service) UpdateAutomationRule(ctx context.Context, params domain.UpdateAutomationRuleParams) (*domain.AutomationRule, error) {
+	if params.ListID == "" {
+		return nil, domain.ErrListNotFound
+	}
+	if params.ID == "" {
+		return nil, domain.ErrRuleNotFound
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.requireListRole(ctx, params.ListID, domain.ListRoleEditor); err != nil {
+		return nil, err
+	}
+
+	rule, err := s.repo.FindAutomationRuleByID(ctx, params.ListID, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(params.UpdateMask, "name") {
+		rule.Name = *params.Name
+	}
+	if slices.Contains(params.UpdateMask, "enabled") {
+		rule.Enabled = *params.Enabled
+	}
+	if slices.Contains(params.UpdateMask, "trigger") {
+		rule.Trigger = *params.Trigger
+	}
+	if slices.Contains(params.UpdateMask, "condition") {
+		rule.Condition = *params.Condition
+	}
+	if slices.Contains(params.UpdateMask, "actions") {
+		rule.Actions = params.Actions
+	}
+
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.checkRuleActions(ctx, rule); err != nil {
+		return nil, err
+	}
+	rule.OwnerID = ruleOwner(ctx)
+	rule.UpdatedAt = time.Now().UTC()
+
+	return s.repo.UpdateAutomationRule(ctx, rule)
+}
+
+// DeleteAutomationRule removes a rule and its execution log.
+func (s *Service) DeleteAutomationRule(ctx context.Context, listID, id string) error {
+	if listID == "" {
+		return domain.ErrListNotFound
+	}
+	if id == "" {
+		return domain.ErrRuleNotFound
+	}
+	if err := s.requireListRole(ctx, listID, domain.ListRoleEditor); err != nil {
+		return err
+	}
+	return s.repo.DeleteAutomationRule(ctx, listID, id)
+}
+
+// ListRuleRuns returns the most recent entries of the execution log of a rule, newest first.
+func (s *Service) ListRuleRuns(ctx context.Context, listID, ruleID string, limit int) ([]*domain.RuleRun, error) {
+	if _, err := s.GetAutomationRule(ctx, listID, ruleID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = DefaultRuleRunsLimit
+	}
+	limit = min(limit, MaxRuleRunsLimit)
+
+	return s.repo.FindRuleRuns(ctx, ruleID, limit)
+}
+
+// ruleOwner returns the tenant a rule set up by the principal in ctx runs on
+// behalf of; empty for internal callers.
+func ruleOwner(ctx context.Context) string {
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		return principal.OwnerID
+	}
+	return ""
+}
+
+// ruleContext returns ctx carrying the principal a rule runs on behalf of:
+// its owner, with the scopes checked when the rule was set up.
+// Rules set up by internal callers run with internal access.
+func ruleContext(ctx context.Context, rule *domain.AutomationRule) context.Context {
+	if rule.OwnerID == "" {
+		return domain.WithInternalAccess(ctx)
+	}
+	return domain.WithPrincipal(ctx, domain.Principal{
+		OwnerID: rule.OwnerID,
+		Scopes:  append(domain.ReadWriteScopes(), domain.ScopeWebhooksWrite),
+	})
+}
+
+// checkRuleActions verifies that the caller may set up the actions of a rule:
+// custom field values must fit the schema of the list, items can only be
+// created in or moved to lists the caller can edit, and rules can only call
+// webhooks of the caller's tenant, with the webhooks:write scope.
+func (s *Service) checkRuleActions(ctx context.Context, rule *domain.AutomationRule) error {
+	for _, action := range rule.Actions {
+		switch action.Type {
+		case domain.RuleActionSetField:
+			name, ok := action.CustomField()
+			if !ok || action.Value == nil {
+				continue
+			}
+			if _, err := s.validateCustomFields(ctx, rule.ListID, map[string]any{name: action.Value}); err != nil {
+				return fmt.Errorf("%w: set_field action: %w", domain.ErrInvalidRule, err)
+			}
+		case domain.RuleActionCreateItem, domain.RuleActionMove:
+			target := cmp.Or(action.ListID, rule.ListID)
+			if _, err := s.repo.FindListByID(ctx, target); err != nil {
+				return err
+			}
+			if err := s.requireListRole(ctx, target, domain.ListRoleEditor); err != nil {
+				return err
+			}
+		case domain.RuleActionCallWebhook:
+			if principal, ok := domain.PrincipalFromContext(ctx); ok && !principal.HasScope(domain.ScopeWebhooksWrite) {
+				return fmt.Errorf("%w: call_webhook actions require the %s scope", domain.ErrForbidden, domain.ScopeWebhooksWrite)
+			}
+			if _, err := s.repo.FindWebhookSubscriptionByID(ctx, action.WebhookID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runRules fires the rules of a list for changes to an item made in the
+// transaction of repo, then the rules fired by the changes the rules make.
+// Returns the item as the rules left it.
+//
+// Each rule runs on behalf of its owner, whose access to the lists the rule
+// changes is checked again when it runs. Errors of the database abort the
+// change; actions that cannot run only fail their rule, which is logged.
+func (s *Service) runRules(ctx context.Context, repo Repository, item *domain.TodoItem, events ...domain.RuleEvent) (*domain.TodoItem, error) {
+	if len(events) == 0 {
+		return item, nil
+	}
+
+	ctx = domain.WithInternalAccess(ctx)
+	cascade := &ruleCascade{
+		repo:  repo,
+		now:   time.Now().UTC(),
+		fired: make(map[[2]string]bool),
+		queue: events,
+	}
+	if err := cascade.run(ctx); err != nil {
+		return nil, err
+	}
+	if !cascade.changed {
+		return item, nil
+	}
+	return repo.FindItemByID(ctx, item.ID)
+}
+
+// ruleCascade runs the rules fired by a change, and by the changes their
+// actions make, breadth first within one transaction.
+type ruleCascade struct {
+	repo  Repository
+	now   time.Time
+	fired map[[2]string]bool // Rule and item IDs of the runs so far
+	queue []domain.RuleEvent
+
+	// changed reports that actions changed items.
+	changed bool
+}
+
+func (c *ruleCascade) run(ctx context.Context) error {
+	for len(c.queue) > 0 {
+		event := c.queue[0]
+		c.queue = c.queue[1:]
+
+		rules, err := c.repo.FindEnabledAutomationRules(ctx, event.Item.ListID, event.Trigger)
+		if err != nil {
+			return err
+		}
+		for _, rule := range rules {
+			if err := c.fire(ctx, rule, event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fire runs a rule for an event if it fires for the item as the event saw
+// it, and logs the run. The actions run on the item as earlier rules left it,
+// on behalf of the rule's owner; the rule is skipped if the owner lost access.
+func (c *ruleCascade) fire(ctx context.Context, rule *domain.AutomationRule, event domain.RuleEvent) error {
+	if !rule.Fires(event, c.now) {
+		return nil
+	}
+	item, err := c.repo.FindItemByID(ctx, event.Item.ID)
+	if err != nil {
+		return err
+	}
+	if item.ListID != rule.ListID {
+		return nil // An earlier rule moved the item away
+	}
+
+	runID, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate id: %w", err)
+	}
+	run := &domain.RuleRun{
+		ID:        runID.String(),
+		RuleID:    rule.ID,
+		ListID:    rule.ListID,
+		ItemID:    item.ID,
+		Trigger:   event.Trigger,
+		Depth:     event.Depth,
+		CreatedAt: c.now,
+	}
+
+	key := [2]string{rule.ID, item.ID}
+	switch {
+	case event.Depth >= domain.MaxRuleDepth:
+		run.Status = domain.RuleRunSkipped
+		run.Error = ptr.To(fmt.Sprintf("loop protection: rules fired by %d rules in a row do not run", domain.MaxRuleDepth))
+	case c.fired[key]:
+		run.Status = domain.RuleRunSkipped
+		run.Error = ptr.To("loop protection: the rule already ran for this item")
+	default:
+		c.fired[key] = true
+		ruleCtx := ruleContext(ctx, rule)
+
+		denied, err := c.checkAccess(ruleCtx, rule)
+		if err != nil {
+			return err
+		}
+		if denied != "" {
+			run.Status = domain.RuleRunSkipped
+			run.Error = &denied
+			break
+		}
+
+		failure, err := c.check(ruleCtx, rule, item)
+		if err != nil {
+			return err
+		}
+		if failure != "" {
+			run.Status = domain.RuleRunFailed
+			run.Error = &failure
+			break
+		}
+
+		actions, failure, err := c.tryApply(ruleCtx, rule, item, event.Depth+1)
+		if err != nil {
+			return err
+		}
+		if failure != "" {
+			run.Status = domain.RuleRunFailed
+			run.Error = &failure
+			break
+		}
+		run.Actions = actions
+		run.Status = domain.RuleRunSucceeded
+	}
+
+	return c.repo.CreateRuleRun(ctx, run)
+}
+
+// checkAccess returns why the owner of a rule may no longer run it, if it may
+// not: the owner needs the editor role on the list of the rule and on the
+// lists its actions create items in or move items to, and may have lost it
+// since the rule was set up.
+func (c *ruleCascade) checkAccess(ctx context.Context, rule *domain.AutomationRule) (string, error) {
+	listIDs := []string{rule.ListID}
+	for _, action := range rule.Actions {
+		if action.Type == domain.RuleActionCreateItem || action.Type == domain.RuleActionMove {
+			listIDs = append(listIDs, cmp.Or(action.ListID, rule.ListID))
+		}
+	}
+
+	for _, listID := range listIDs {
+		err := requireListRole(ctx, c.repo, listID, domain.ListRoleEditor)
+		if errors.Is(err, domain.ErrListNotFound) || errors.Is(err, domain.ErrListAccessDenied) {
+			return fmt.Sprintf("rule owner has no access to list %s: %v", listID, err), nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+// check returns why the actions of a rule cannot run for an item, if they
+// cannot, so that none of them runs. Lists and webhooks may have gone since
+// the rule was set up.
+func (c *ruleCascade) check(ctx context.Context, rule *domain.AutomationRule, item *domain.TodoItem) (string, error) {
+	// notFound turns the errors of lookups of things that are gone into failures
+	notFound := func(err error, targets ...error) (string, error) {
+		for _, target := range targets {
+			if errors.Is(err, target) {
+				return err.Error(), nil
+			}
+		}
+		return "", err
+	}
+
+	// The list and custom field values of the item when the action runs
+	listID := item.ListID
+	values := maps.Clone(item.CustomFields)
+	if values == nil {
+		values = make(map[string]any)
+	}
+	for _, action := range rule.Actions {
+		switch action.Type {
+		case domain.RuleActionSetField:
+			name, ok := action.CustomField()
+			if !ok {
+				continue
+			}
+			delete(values, name)
+			if action.Value == nil {
+				continue
+			}
+			list, err := c.repo.FindListByID(ctx, listID)
+			if err != nil {
+				return "", err
+			}
+			if _, err := list.CustomFieldSchema.ValidateValues(map[string]any{name: action.Value}); err != nil {
+				return err.Error(), nil
+			}
+			values[name] = action.Value
+		case domain.RuleActionCreateItem:
+			if _, err := domain.NewTitle(action.ItemTitle(item.Title)); err != nil {
+				return err.Error(), nil
+			}
+			if _, err := c.repo.FindListByID(ctx, cmp.Or(action.ListID, rule.ListID)); err != nil {
+				return notFound(err, domain.ErrListNotFound)
+			}
+		case domain.RuleActionMove:
+			if item.RecurringTemplateID != nil {
+				return "recurring items cannot be moved to another list", nil
+			}
+			list, err := c.repo.FindListByID(ctx, action.ListID)
+			if err != nil {
+				return notFound(err, domain.ErrListNotFound)
+			}
+			// The custom fields of the item move with it
+			if _, err := list.CustomFieldSchema.ValidateValues(values); err != nil {
+				return fmt.Sprintf("item does not fit list %s: %v", list.ID, err), nil
+			}
+			listID = action.ListID
+		case domain.RuleActionCallWebhook:
+			sub, err := c.repo.FindWebhookSubscriptionByID(ctx, action.WebhookID)
+			if err != nil {
+				return notFound(err, domain.ErrWebhookNotFound)
+			}
+			if !sub.IsActive {
+				return fmt.Sprintf("webhook subscription %s is paused", sub.ID), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// ruleActionFailures are the errors with which an action can fail because of
+// the state of the items, lists and webhooks it works on rather than of the
+// storage. They fail the rule instead of the change that fired it.
+var ruleActionFailures = []error{
+	domain.ErrItemNotFound,
+	domain.ErrListNotFound,
+	domain.ErrListAccessDenied,
+	domain.ErrWebhookNotFound,
+	domain.ErrInvalidID,
+	domain.ErrTitleRequired,
+	domain.ErrTitleTooLong,
+	domain.ErrInvalidTaskStatus,
+	domain.ErrInvalidTaskPriority,
+	domain.ErrInvalidCustomFieldValue,
+	domain.ErrUnknownCustomField,
+}
+
+// tryApply runs apply in a savepoint, so that when an action fails for one
+// of ruleActionFailures the actions before it are undone and the failure is
+// returned for the run log. Other errors are returned as errors.
+func (c *ruleCascade) tryApply(ctx context.Context, rule *domain.AutomationRule, item *domain.TodoItem, depth int) ([]string, string, error) {
+	repo, queued, changed := c.repo, len(c.queue), c.changed
+	defer func() { c.repo = repo }()
+
+	var summaries []string
+	err := repo.Atomic(ctx, func(tx Repository) error {
+		c.repo = tx
+		var err error
+		summaries, err = c.apply(ctx, rule, item, depth)
+		return err
+	})
+	if err == nil {
+		return summaries, "", nil
+	}
+
+	c.queue, c.changed = c.queue[:queued], changed
+	for _, target := range ruleActionFailures {
+		if errors.Is(err, target) {
+			return nil, err.Error(), nil
+		}
+	}
+	return nil, "", err
+}
+
+// apply runs the actions of a rule for an item in order, queueing the events
+// of the changes they make at depth, and returns what they did.
+func (c *ruleCascade) apply(ctx context.Context, rule *domain.AutomationRule, item *domain.TodoItem, depth int) ([]string, error) {
+	summaries := make([]string, 0, len(rule.Actions))
+	for _, action := range rule.Actions {
+		params := domain.UpdateItemParams{ItemID: item.ID, ListID: item.ListID}
+		var summary string
+
+		switch action.Type {
+		case domain.RuleActionSetField:
+			switch action.Field {
+			case domain.RuleFieldStatus:
+				status := domain.TaskStatus(action.Value.(string))
+				params.UpdateMask = []string{domain.FieldStatus}
+				params.Status = &status
+				summary = fmt.Sprintf("set status to %s", status)
+			case domain.RuleFieldPriority:
+				params.UpdateMask = []string{domain.FieldItemPriority}
+				summary = "cleared priority"
+				if value, ok := action.Value.(string); ok {
+					priority := domain.TaskPriority(value)
+					params.Priority = &priority
+					summary = fmt.Sprintf("set priority to %s", priority)
+				}
+			default:
+				name, _ := action.CustomField()
+				values := maps.Clone(item.CustomFields)
+				if values == nil {
+					values = make(map[string]any)
+				}
+				delete(values, name)
+				if action.Value != nil {
+					// Checked against the schema by check
+					list, err := c.repo.FindListByID(ctx, item.ListID)
+					if err != nil {
+						return nil, err
+					}
+					normalized, err := list.CustomFieldSchema.ValidateValues(map[string]any{name: action.Value})
+					if err != nil {
+						return nil, err
+					}
+					values[name] = normalized[name]
+				}
+				params.UpdateMask = []string{domain.FieldItemCustomFields}
+				params.CustomFields = values
+				summary = fmt.Sprintf("set %s to %v", action.Field, action.Value)
+				if action.Value == nil {
+					summary = fmt.Sprintf("cleared %s", action.Field)
+				}
+			}
+
+		case domain.RuleActionAddTag:
+			summary = fmt.Sprintf("added tag %s", action.Tag)
+			if slices.Contains(item.Tags, action.Tag) {
+				summaries = append(summaries, summary+" (already present)")
+				continue
+			}
+			tags := append(slices.Clone(item.Tags), action.Tag)
+			params.UpdateMask = []string{domain.FieldItemTags}
+			params.Tags = &tags
+
+		case domain.RuleActionCreateItem:
+			listID := cmp.Or(action.ListID, rule.ListID)
+			newItem := &domain.TodoItem{
+				Title:    action.ItemTitle(item.Title),
+				Tags:     slices.Clone(action.Tags),
+				Priority: action.Priority,
+			}
+			if err := prepareNewItem(ctx, c.repo, listID, newItem); err != nil {
+				return nil, err
+			}
+			created, err := c.repo.CreateItem(ctx, listID, newItem)
+			if err != nil {
+				return nil, err
+			}
+			c.changed = true
+			c.queue = append(c.queue, domain.RuleEvent{Trigger: domain.RuleTriggerItemCreated, Item: created, Depth: depth})
+			summaries = append(summaries, fmt.Sprintf("created item %s in list %s", created.ID, listID))
+			continue
+
+		case domain.RuleActionMove:
+			moved, err := c.repo.MoveItem(ctx, item.ID, item.ListID, action.ListID)
+			if err != nil {
+				return nil, err
+			}
+			c.changed = true
+			item = moved
+			summaries = append(summaries, fmt.Sprintf("moved to list %s", action.ListID))
+			continue
+
+		case domain.RuleActionCallWebhook:
+			eventID, err := uuid.NewV7()
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate id: %w", err)
+			}
+			deliveryID, err := c.repo.EnqueueWebhookEvent(ctx, action.WebhookID, &domain.Event{
+				ID:         eventID.String(),
+				Type:       domain.EventRuleTriggered,
+				OccurredAt: c.now,
+				Data:       ruleTriggeredEventData(rule, item, depth-1),
+			})
+			if err != nil {
+				return nil, err
+			}
+			summaries = append(summaries, fmt.Sprintf("queued webhook delivery %s", deliveryID))
+			continue
+		}
+
+		updated, err := c.update(ctx, item, params, depth)
+		if err != nil {
+			return nil, err
+		}
+		item = updated
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+// update applies params to item and queues the events of the change at depth.
+func (c *ruleCascade) update(ctx context.Context, item *domain.TodoItem, params domain.UpdateItemParams, depth int) (*domain.TodoItem, error) {
+	updated, err := c.repo.UpdateItem(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if item.RecurringTemplateID != nil && item.OccursAt != nil && shouldCreateException(params.UpdateMask) {
+		if err := ensureEditedException(ctx, c.repo, item); err != nil {
+			return nil, err
+		}
+	}
+
+	c.changed = true
+	c.queue = append(c.queue, domain.ItemChangeEvents(item, updated, depth)...)
+	return updated, nil
+}
+
+// ruleTriggeredEventData is the payload of the rule.triggered event a
+// call_webhook action sends.
+func ruleTriggeredEventData(rule *domain.AutomationRule, item *domain.TodoItem, depth int) map[string]any {
+	return map[string]any{
+		"rule_id":   rule.ID,
+		"rule_name": rule.Name,
+		"list_id":   rule.ListID,
+		"trigger":   string(rule.Trigger.Type),
+		"depth":     depth,
+		"item": map[string]any{
+			"id":            item.ID,
+			"list_id":       item.ListID,
+			"title":         item.Title,
+			"status":        string(item.Status),
+			"priority":      item.Priority,
+			"tags":          item.Tags,
+			"due_at":        item.DueAt,
+			"custom_fields": item.CustomFields,
+		},
+	}
+}
+
+// RunOverdueRules fires the overdue rules whose items have been overdue long
+// enough at now, for up to limit items, each in its own transaction together
+// with the rules the changes fire. A rule fires once per item and due date.
+// Returns how many candidates were processed, and the first error.
+func (s *Service) RunOverdueRules(ctx context.Context, now time.Time, limit int) (int, error) {
+	ctx = domain.WithInternalAccess(ctx)
+
+	candidates, err := s.repo.FindOverdueRuleCandidates(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	var firstErr error
+	for _, candidate := range candidates {
+		err := s.repo.Atomic(ctx, func(repo Repository) error {
+			recorded, err := repo.RecordOverdueRuleFire(ctx, candidate.RuleID, candidate.ItemID, candidate.DueAt, now)
+			if err != nil || !recorded {
+				return err
+			}
+
+			rule, err := repo.FindAutomationRuleByID(ctx, candidate.ListID, candidate.RuleID)
+			if err != nil {
+				return err
+			}
+			item, err := repo.FindItemByID(ctx, candidate.ItemID)
+			if err != nil {
+				return err
+			}
+
+			cascade := &ruleCascade{repo: repo, now: now, fired: make(map[[2]string]bool)}
+			if err := cascade.fire(ctx, rule, domain.RuleEvent{Trigger: domain.RuleTriggerOverdue, Item: item}); err != nil {
+				return err
+			}
+			return cascade.run(ctx)
+		})
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("failed to run overdue rule %s for item %s: %w", candidate.RuleID, candidate.ItemID, err)
+		}
+	}
+	return len(candidates), firstErr
+}
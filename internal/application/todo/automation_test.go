@@ -0,0 +1,422 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOtherListID = "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a06"
+
+// mockAutomationRepo stores the items and rules of the shared test list and
+// of a second list the test principals can edit.
+type mockAutomationRepo struct {
+	*mockMembersRepo
+	items      map[string]*domain.TodoItem
+	rules      []*domain.AutomationRule
+	runs       []*domain.RuleRun
+	fires      map[string]bool
+	webhooks   map[string]*domain.WebhookSubscription
+	deliveries []*domain.Event
+	schemas    map[string]domain.CustomFieldSchema // Custom fields of the lists
+	moveErr    error                               // Returned by MoveItem if set
+}
+
+func newMockAutomationRepo() *mockAutomationRepo {
+	return &mockAutomationRepo{
+		mockMembersRepo: newMockMembersRepo(),
+		items:           make(map[string]*domain.TodoItem),
+		fires:           make(map[string]bool),
+		webhooks:        make(map[string]*domain.WebhookSubscription),
+		schemas:         make(map[string]domain.CustomFieldSchema),
+	}
+}
+
+func (m *mockAutomationRepo) Atomic(ctx context.Context, fn func(tx Repository) error) error {
+	return fn(m)
+}
+
+func (m *mockAutomationRepo) FindListRole(ctx context.Context, listID, principalID string) (domain.ListRole, error) {
+	return m.mockMembersRepo.FindListRole(ctx, testListID, principalID)
+}
+
+func (m *mockAutomationRepo) FindListByID(ctx context.Context, id string) (*domain.TodoList, error) {
+	if id != testListID && id != testOtherListID {
+		return nil, domain.ErrListNotFound
+	}
+	return &domain.TodoList{ID: id, CustomFieldSchema: m.schemas[id]}, nil
+}
+
+func (m *mockAutomationRepo) CreateItem(ctx context.Context, listID string, item *domain.TodoItem) (*domain.TodoItem, error) {
+	stored := *item
+	stored.ListID = listID
+	m.items[item.ID] = &stored
+	return m.FindItemByID(ctx, item.ID)
+}
+
+func (m *mockAutomationRepo) FindItemByID(ctx context.Context, id string) (*domain.TodoItem, error) {
+	item, ok := m.items[id]
+	if !ok {
+		return nil, domain.ErrItemNotFound
+	}
+	clone := *item
+	clone.Tags = slices.Clone(item.Tags)
+	return &clone, nil
+}
+
+func (m *mockAutomationRepo) UpdateItem(ctx context.Context, params domain.UpdateItemParams) (*domain.TodoItem, error) {
+	item, ok := m.items[params.ItemID]
+	if !ok || item.ListID != params.ListID {
+		return nil, domain.ErrItemNotFound
+	}
+	for _, field := range params.UpdateMask {
+		switch field {
+		case domain.FieldStatus:
+			item.Status = *params.Status
+		case domain.FieldItemPriority:
+			item.Priority = params.Priority
+		case domain.FieldItemTags:
+			item.Tags = *params.Tags
+		case domain.FieldItemCustomFields:
+			item.CustomFields = params.CustomFields
+		}
+	}
+	return m.FindItemByID(ctx, item.ID)
+}
+
+func (m *mockAutomationRepo) MoveItem(ctx context.Context, itemID, fromListID, toListID string) (*domain.TodoItem, error) {
+	if m.moveErr != nil {
+		return nil, m.moveErr
+	}
+	item, ok := m.items[itemID]
+	if !ok || item.ListID != fromListID {
+		return nil, domain.ErrItemNotFound
+	}
+	item.ListID = toListID
+	return m.FindItemByID(ctx, itemID)
+}
+
+func (m *mockAutomationRepo) CreateAutomationRule(ctx context.Context, rule *domain.AutomationRule) (*domain.AutomationRule, error) {
+	m.rules = append(m.rules, rule)
+	return rule, nil
+}
+
+func (m *mockAutomationRepo) FindAutomationRuleByID(ctx context.Context, listID, id string) (*domain.AutomationRule, error) {
+	for _, rule := range m.rules {
+		if rule.ID == id && rule.ListID == listID {
+			return rule, nil
+		}
+	}
+	return nil, domain.ErrRuleNotFound
+}
+
+func (m *mockAutomationRepo) FindEnabledAutomationRules(ctx context.Context, listID string, trigger domain.RuleTriggerType) ([]*domain.AutomationRule, error) {
+	var rules []*domain.AutomationRule
+	for _, rule := range m.rules {
+		if rule.ListID == listID && rule.Trigger.Type == trigger && rule.Enabled {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+func (m *mockAutomationRepo) CountAutomationRules(ctx context.Context, listID string) (int, error) {
+	return len(m.rules), nil
+}
+
+func (m *mockAutomationRepo) CreateRuleRun(ctx context.Context, run *domain.RuleRun) error {
+	m.runs = append(m.runs, run)
+	return nil
+}
+
+func (m *mockAutomationRepo) FindOverdueRuleCandidates(ctx context.Context, now time.Time, limit int) ([]domain.OverdueRuleCandidate, error) {
+	var candidates []domain.OverdueRuleCandidate
+	for _, rule := range m.rules {
+		for _, item := range m.items {
+			if rule.Trigger.Type == domain.RuleTriggerOverdue && item.ListID == rule.ListID && item.DueAt != nil {
+				candidates = append(candidates, domain.OverdueRuleCandidate{RuleID: rule.ID, ListID: rule.ListID, ItemID: item.ID, DueAt: *item.DueAt})
+			}
+		}
+	}
+	return candidates, nil
+}
+
+func (m *mockAutomationRepo) RecordOverdueRuleFire(ctx context.Context, ruleID, itemID string, dueAt, firedAt time.Time) (bool, error) {
+	key := ruleID + itemID + dueAt.String()
+	if m.fires[key] {
+		return false, nil
+	}
+	m.fires[key] = true
+	return true, nil
+}
+
+func (m *mockAutomationRepo) FindWebhookSubscriptionByID(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	sub, ok := m.webhooks[id]
+	if !ok {
+		return nil, domain.ErrWebhookNotFound
+	}
+	return sub, nil
+}
+
+func (m *mockAutomationRepo) EnqueueWebhookEvent(ctx context.Context, subscriptionID string, event *domain.Event) (string, error) {
+	m.deliveries = append(m.deliveries, event)
+	return "delivery-1", nil
+}
+
+// addRule stores an enabled rule of the test list.
+func (m *mockAutomationRepo) addRule(id string, trigger domain.RuleTrigger, actions ...domain.RuleAction) {
+	m.rules = append(m.rules, &domain.AutomationRule{
+		ID:      id,
+		ListID:  testListID,
+		Name:    id,
+		Enabled: true,
+		Trigger: trigger,
+		Actions: actions,
+	})
+}
+
+func TestCreateItem_RunsRuleCascade(t *testing.T) {
+	repo := newMockAutomationRepo()
+	tag := "bug"
+	repo.addRule("tag-new", domain.RuleTrigger{Type: domain.RuleTriggerItemCreated},
+		domain.RuleAction{Type: domain.RuleActionAddTag, Tag: "bug"})
+	repo.addRule("escalate", domain.RuleTrigger{Type: domain.RuleTriggerTagAdded, Tag: &tag},
+		domain.RuleAction{Type: domain.RuleActionSetField, Field: "priority", Value: "urgent"},
+		domain.RuleAction{Type: domain.RuleActionCreateItem, ListID: testOtherListID, Title: "Verify: {{title}}", Tags: []string{"verify"}})
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	item, err := service.CreateItem(asPrincipal(testEditorID), testListID, &domain.TodoItem{Title: "Crash on save"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"bug"}, item.Tags)
+	require.NotNil(t, item.Priority)
+	assert.Equal(t, domain.TaskPriorityUrgent, *item.Priority)
+
+	var verify *domain.TodoItem
+	for _, other := range repo.items {
+		if other.ListID == testOtherListID {
+			verify = other
+		}
+	}
+	require.NotNil(t, verify)
+	assert.Equal(t, "Verify: Crash on save", verify.Title)
+	assert.Equal(t, []string{"verify"}, verify.Tags)
+
+	require.Len(t, repo.runs, 2)
+	assert.Equal(t, domain.RuleRunSucceeded, repo.runs[0].Status)
+	assert.Equal(t, []string{"added tag bug"}, repo.runs[0].Actions)
+	assert.Equal(t, 0, repo.runs[0].Depth)
+	assert.Equal(t, "escalate", repo.runs[1].RuleID)
+	assert.Equal(t, 1, repo.runs[1].Depth)
+	assert.Equal(t, "set priority to urgent", repo.runs[1].Actions[0])
+}
+
+func TestUpdateItem_LoopProtection(t *testing.T) {
+	repo := newMockAutomationRepo()
+	repo.items["item-1"] = &domain.TodoItem{ID: "item-1", ListID: testListID, Title: "Ping", Status: domain.TaskStatusTodo}
+	done, todo := domain.TaskStatusDone, domain.TaskStatusTodo
+	repo.addRule("reopen", domain.RuleTrigger{Type: domain.RuleTriggerStatusChanged, ToStatus: &done},
+		domain.RuleAction{Type: domain.RuleActionSetField, Field: "status", Value: "todo"})
+	repo.addRule("close", domain.RuleTrigger{Type: domain.RuleTriggerStatusChanged, ToStatus: &todo},
+		domain.RuleAction{Type: domain.RuleActionSetField, Field: "status", Value: "done"})
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	item, err := service.UpdateItem(asPrincipal(testEditorID), domain.UpdateItemParams{
+		ItemID:     "item-1",
+		ListID:     testListID,
+		UpdateMask: []string{"status"},
+		Status:     &done,
+	})
+	require.NoError(t, err)
+
+	// reopen and close each ran once; reopen was then stopped
+	assert.Equal(t, domain.TaskStatusDone, item.Status)
+	require.Len(t, repo.runs, 3)
+	assert.Equal(t, domain.RuleRunSucceeded, repo.runs[0].Status)
+	assert.Equal(t, domain.RuleRunSucceeded, repo.runs[1].Status)
+	assert.Equal(t, "reopen", repo.runs[2].RuleID)
+	assert.Equal(t, domain.RuleRunSkipped, repo.runs[2].Status)
+	require.NotNil(t, repo.runs[2].Error)
+	assert.Contains(t, *repo.runs[2].Error, "loop protection")
+}
+
+func TestUpdateItem_FailedRuleAppliesNoActions(t *testing.T) {
+	repo := newMockAutomationRepo()
+	repo.items["item-1"] = &domain.TodoItem{ID: "item-1", ListID: testListID, Title: "Ship", Status: domain.TaskStatusTodo}
+	repo.addRule("archive", domain.RuleTrigger{Type: domain.RuleTriggerStatusChanged},
+		domain.RuleAction{Type: domain.RuleActionAddTag, Tag: "shipped"},
+		domain.RuleAction{Type: domain.RuleActionMove, ListID: "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4aff"})
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	done := domain.TaskStatusDone
+	item, err := service.UpdateItem(asPrincipal(testEditorID), domain.UpdateItemParams{
+		ItemID:     "item-1",
+		ListID:     testListID,
+		UpdateMask: []string{"status"},
+		Status:     &done,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.TaskStatusDone, item.Status)
+	assert.Empty(t, item.Tags)
+	require.Len(t, repo.runs, 1)
+	assert.Equal(t, domain.RuleRunFailed, repo.runs[0].Status)
+	assert.Empty(t, repo.runs[0].Actions)
+}
+
+func TestUpdateItem_MoveRuleChecksTargetSchema(t *testing.T) {
+	repo := newMockAutomationRepo()
+	repo.schemas[testListID] = domain.CustomFieldSchema{{Name: "points", Type: domain.CustomFieldTypeNumber}}
+	repo.items["item-1"] = &domain.TodoItem{
+		ID: "item-1", ListID: testListID, Title: "Ship", Status: domain.TaskStatusTodo,
+		CustomFields: map[string]any{"points": float64(3)},
+	}
+	repo.addRule("archive", domain.RuleTrigger{Type: domain.RuleTriggerStatusChanged},
+		domain.RuleAction{Type: domain.RuleActionMove, ListID: testOtherListID})
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	done := domain.TaskStatusDone
+	item, err := service.UpdateItem(asPrincipal(testEditorID), domain.UpdateItemParams{
+		ItemID:     "item-1",
+		ListID:     testListID,
+		UpdateMask: []string{"status"},
+		Status:     &done,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, testListID, item.ListID)
+	require.Len(t, repo.runs, 1)
+	assert.Equal(t, domain.RuleRunFailed, repo.runs[0].Status)
+	require.NotNil(t, repo.runs[0].Error)
+	assert.Contains(t, *repo.runs[0].Error, "unknown custom field")
+}
+
+func TestUpdateItem_ActionErrorsFailOnlyTheRule(t *testing.T) {
+	repo := newMockAutomationRepo()
+	repo.moveErr = domain.ErrListNotFound // The list went after the rule was checked
+	repo.items["item-1"] = &domain.TodoItem{ID: "item-1", ListID: testListID, Title: "Ship", Status: domain.TaskStatusTodo}
+	repo.addRule("archive", domain.RuleTrigger{Type: domain.RuleTriggerStatusChanged},
+		domain.RuleAction{Type: domain.RuleActionMove, ListID: testOtherListID})
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	done := domain.TaskStatusDone
+	item, err := service.UpdateItem(asPrincipal(testEditorID), domain.UpdateItemParams{
+		ItemID:     "item-1",
+		ListID:     testListID,
+		UpdateMask: []string{"status"},
+		Status:     &done,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.TaskStatusDone, item.Status)
+	require.Len(t, repo.runs, 1)
+	assert.Equal(t, domain.RuleRunFailed, repo.runs[0].Status)
+	assert.Empty(t, repo.runs[0].Actions)
+
+	repo.moveErr = errors.New("connection reset")
+	repo.items["item-1"].Status = domain.TaskStatusTodo
+	_, err = service.UpdateItem(asPrincipal(testEditorID), domain.UpdateItemParams{
+		ItemID:     "item-1",
+		ListID:     testListID,
+		UpdateMask: []string{"status"},
+		Status:     &done,
+	})
+	assert.ErrorContains(t, err, "connection reset")
+}
+
+func TestUpdateItem_SkipsRulesOfOwnersWithoutAccess(t *testing.T) {
+	repo := newMockAutomationRepo()
+	repo.items["item-1"] = &domain.TodoItem{ID: "item-1", ListID: testListID, Title: "Ship", Status: domain.TaskStatusTodo}
+	owners := map[string]string{
+		"editor":  testEditorID,
+		"demoted": testViewerID,  // now only views the list
+		"removed": testOutsideID, // no longer a member
+	}
+	for _, id := range []string{"editor", "demoted", "removed"} {
+		repo.addRule(id, domain.RuleTrigger{Type: domain.RuleTriggerStatusChanged},
+			domain.RuleAction{Type: domain.RuleActionAddTag, Tag: id})
+		repo.rules[len(repo.rules)-1].OwnerID = owners[id]
+	}
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	done := domain.TaskStatusDone
+	item, err := service.UpdateItem(asPrincipal(testEditorID), domain.UpdateItemParams{
+		ItemID:     "item-1",
+		ListID:     testListID,
+		UpdateMask: []string{"status"},
+		Status:     &done,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"editor"}, item.Tags)
+	require.Len(t, repo.runs, 3)
+	assert.Equal(t, domain.RuleRunSucceeded, repo.runs[0].Status)
+	for _, run := range repo.runs[1:] {
+		assert.Equal(t, domain.RuleRunSkipped, run.Status, run.RuleID)
+		require.NotNil(t, run.Error)
+		assert.Contains(t, *run.Error, "rule owner has no access")
+		assert.Empty(t, run.Actions)
+	}
+}
+
+func TestCreateAutomationRule_ChecksAccess(t *testing.T) {
+	repo := newMockAutomationRepo()
+	repo.webhooks["hook-1"] = &domain.WebhookSubscription{ID: "hook-1", IsActive: true}
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	rule := func(actions ...domain.RuleAction) *domain.AutomationRule {
+		return &domain.AutomationRule{
+			ListID:  testListID,
+			Name:    "Notify",
+			Enabled: true,
+			Trigger: domain.RuleTrigger{Type: domain.RuleTriggerItemCreated},
+			Actions: actions,
+		}
+	}
+	webhook := domain.RuleAction{Type: domain.RuleActionCallWebhook, WebhookID: "hook-1"}
+
+	_, err := service.CreateAutomationRule(asPrincipal(testViewerID), rule(domain.RuleAction{Type: domain.RuleActionAddTag, Tag: "new"}))
+	assert.ErrorIs(t, err, domain.ErrListAccessDenied)
+
+	_, err = service.CreateAutomationRule(asPrincipal(testEditorID), rule(webhook))
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	_, err = service.CreateAutomationRule(asPrincipal(testEditorID), rule(domain.RuleAction{Type: domain.RuleActionMove, ListID: "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4aff"}))
+	assert.ErrorIs(t, err, domain.ErrListNotFound)
+
+	admin := domain.WithPrincipal(context.Background(), domain.Principal{OwnerID: testOwnerID, Scopes: domain.AdminScopes()})
+	created, err := service.CreateAutomationRule(admin, rule(webhook))
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, testOwnerID, created.OwnerID)
+}
+
+func TestRunOverdueRules_FiresOncePerDueDate(t *testing.T) {
+	repo := newMockAutomationRepo()
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	due := now.Add(-72 * time.Hour)
+	repo.items["item-1"] = &domain.TodoItem{ID: "item-1", ListID: testListID, Title: "Renew", Status: domain.TaskStatusTodo, DueAt: &due}
+	repo.webhooks["hook-1"] = &domain.WebhookSubscription{ID: "hook-1", IsActive: true}
+	repo.addRule("bump", domain.RuleTrigger{Type: domain.RuleTriggerOverdue, OverdueBy: 48 * time.Hour},
+		domain.RuleAction{Type: domain.RuleActionSetField, Field: "priority", Value: "urgent"},
+		domain.RuleAction{Type: domain.RuleActionCallWebhook, WebhookID: "hook-1"})
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	processed, err := service.RunOverdueRules(context.Background(), now, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, domain.TaskPriorityUrgent, *repo.items["item-1"].Priority)
+	require.Len(t, repo.deliveries, 1)
+	assert.Equal(t, domain.EventRuleTriggered, repo.deliveries[0].Type)
+	assert.Equal(t, "bump", repo.deliveries[0].Data["rule_id"])
+
+	_, err = service.RunOverdueRules(context.Background(), now, 10)
+	require.NoError(t, err)
+	assert.Len(t, repo.runs, 1)
+}
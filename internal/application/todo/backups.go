@@ -0,0 +1,273 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/ptr"
+)
+
+// BackupWriter receives a list backup part by part, so a large list is written
+// out a page of items at a time instead of being held in memory.
+type BackupWriter interface {
+	// WriteHeader writes the backup without its items. It is called once, first.
+	WriteHeader(backup *domain.ListBackup) error
+
+	// WriteItems writes the next items of the list, oldest first.
+	WriteItems(items []domain.ItemBackup) error
+}
+
+// ExportList writes a backup of a list to w: the list, its recurring templates
+// with their exceptions, and all its items with their status history.
+// Items are read a page at a time; items created during an export may be missing from it.
+func (s *Service) ExportList(ctx context.Context, listID string, w BackupWriter) error {
+	if listID == "" {
+		return domain.ErrListNotFound
+	}
+	if err := s.requireListRole(ctx, listID, domain.ListRoleViewer); err != nil {
+		return err
+	}
+
+	list, err := s.repo.FindListByID(ctx, listID)
+	if err != nil {
+		return err
+	}
+	templates, err := s.repo.FindRecurringTemplates(ctx, listID, false)
+	if err != nil {
+		return fmt.Errorf("failed to find recurring templates: %w", err)
+	}
+
+	backup := &domain.ListBackup{
+		Version:    domain.BackupVersion,
+		ExportedAt: time.Now().UTC(),
+		List:       *list,
+		Templates:  make([]domain.TemplateBackup, 0, len(templates)),
+	}
+	for _, template := range templates {
+		exceptions, err := s.repo.ListAllExceptionsByTemplate(ctx, template.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find exceptions: %w", err)
+		}
+		templateBackup := domain.TemplateBackup{
+			Template:   *template,
+			Exceptions: make([]domain.RecurringTemplateException, 0, len(exceptions)),
+		}
+		for _, exception := range exceptions {
+			templateBackup.Exceptions = append(templateBackup.Exceptions, *exception)
+		}
+		backup.Templates = append(backup.Templates, templateBackup)
+	}
+	if err := w.WriteHeader(backup); err != nil {
+		return err
+	}
+
+	filter, err := domain.NewItemsFilter(domain.ItemsFilterInput{
+		OrderBy:  ptr.To("created_at"),
+		OrderDir: ptr.To("asc"),
+	})
+	if err != nil {
+		return err
+	}
+	params := domain.ListTasksParams{ListID: &listID, Filter: filter, Limit: s.config.MaxPageSize}
+	for {
+		// Archived and cancelled items are part of the backup
+		page, err := s.repo.FindItems(ctx, params, []domain.TaskStatus{})
+		if err != nil {
+			return err
+		}
+		items, err := s.withStatusHistory(ctx, page.Items)
+		if err != nil {
+			return err
+		}
+		if err := w.WriteItems(items); err != nil {
+			return err
+		}
+		if page.NextCursor == nil {
+			return nil
+		}
+		params.After = page.NextCursor
+	}
+}
+
+// withStatusHistory pairs a page of items with their status history.
+func (s *Service) withStatusHistory(ctx context.Context, items []domain.TodoItem) ([]domain.ItemBackup, error) {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	changes, err := s.repo.FindStatusHistory(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	history := make(map[string][]domain.StatusChange, len(items))
+	for _, change := range changes {
+		history[change.ItemID] = append(history[change.ItemID], *change)
+	}
+
+	backups := make([]domain.ItemBackup, 0, len(items))
+	for _, item := range items {
+		backups = append(backups, domain.ItemBackup{Item: item, StatusHistory: history[item.ID]})
+	}
+	return backups, nil
+}
+
+// RestoreList creates a list from a backup in one transaction: the list, its
+// recurring templates and their exceptions, its items and their status history.
+// The restored list belongs to the caller; nobody else has access to it yet.
+//
+// With domain.BackupIDsPreserve, taken IDs fail the restore with
+// domain.ErrBackupConflict. A dry run validates the backup and reports what it
+// would create, and lists taken IDs instead of failing on them.
+func (s *Service) RestoreList(ctx context.Context, backup *domain.ListBackup, idMode domain.BackupIDMode, dryRun bool) (*domain.RestoreReport, error) {
+	if backup == nil {
+		return nil, domain.ErrInvalidRequest
+	}
+	if err := backup.Validate(); err != nil {
+		return nil, err
+	}
+
+	report := &domain.RestoreReport{
+		DryRun:    dryRun,
+		IDMode:    idMode,
+		Templates: len(backup.Templates),
+		Items:     len(backup.Items),
+	}
+	for _, t := range backup.Templates {
+		report.Exceptions += len(t.Exceptions)
+	}
+	for _, i := range backup.Items {
+		report.StatusChanges += len(i.StatusHistory)
+	}
+
+	switch idMode {
+	case domain.BackupIDsPreserve:
+		conflicts, err := s.repo.FindBackupConflicts(ctx, backup)
+		if err != nil {
+			return nil, err
+		}
+		if len(conflicts) > 0 {
+			if !dryRun {
+				return nil, fmt.Errorf("%w: %s (%d taken IDs); restore with new IDs instead",
+					domain.ErrBackupConflict, conflicts[0], len(conflicts))
+			}
+			report.Conflicts = conflicts
+		}
+	case domain.BackupIDsRemap:
+		if err := backup.RemapIDs(newID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown id mode %q", domain.ErrInvalidBackup, idMode)
+	}
+	report.ListID = backup.List.ID
+	if dryRun {
+		return report, nil
+	}
+
+	if err := s.restoreList(ctx, backup); err != nil {
+		slog.ErrorContext(ctx, "failed to restore list",
+			"list_id", backup.List.ID,
+			"id_mode", idMode,
+			"error", err)
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "restored list",
+		"list_id", backup.List.ID,
+		"id_mode", idMode,
+		"templates", report.Templates,
+		"items", report.Items,
+		"status_changes", report.StatusChanges)
+
+	return report, nil
+}
+
+// restoreList writes a validated backup, with the IDs it should get.
+func (s *Service) restoreList(ctx context.Context, backup *domain.ListBackup) error {
+	// Backups written by hand may leave out timestamps
+	now := time.Now().UTC()
+	orNow := func(t time.Time) time.Time {
+		if t.IsZero() {
+			return now
+		}
+		return t
+	}
+
+	list := backup.List
+	list.CreatedAt = orNow(list.CreatedAt)
+	list.OwnerID = ""
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		list.OwnerID = principal.OwnerID
+	}
+
+	// Exceptions and status changes always get new IDs: nothing refers to them
+	var changes []domain.StatusChange
+	for _, itemBackup := range backup.Items {
+		for _, change := range itemBackup.StatusHistory {
+			id, err := newID()
+			if err != nil {
+				return err
+			}
+			change.ID = id
+			change.ItemID = itemBackup.Item.ID
+			changes = append(changes, change)
+		}
+	}
+
+	return s.repo.Atomic(ctx, func(repo Repository) error {
+		if _, err := repo.CreateList(ctx, &list); err != nil {
+			return fmt.Errorf("failed to create list: %w", err)
+		}
+		for _, templateBackup := range backup.Templates {
+			template := templateBackup.Template
+			template.ListID = list.ID
+			template.CreatedAt = orNow(template.CreatedAt)
+			template.UpdatedAt = orNow(template.UpdatedAt)
+			// Generation continues from where it was; the generation worker
+			// catches up on templates whose instances were not generated
+			template.GeneratedThrough = orNow(template.GeneratedThrough)
+			if _, err := repo.CreateRecurringTemplate(ctx, &template); err != nil {
+				return fmt.Errorf("failed to create template: %w", err)
+			}
+		}
+		for _, itemBackup := range backup.Items {
+			item := itemBackup.Item
+			item.ListID = list.ID
+			item.CreatedAt = orNow(item.CreatedAt)
+			item.UpdatedAt = orNow(item.UpdatedAt)
+			if _, err := repo.CreateItem(ctx, list.ID, &item); err != nil {
+				return fmt.Errorf("failed to create item: %w", err)
+			}
+		}
+		// Exceptions may refer to items, so they come after them
+		for _, templateBackup := range backup.Templates {
+			for _, exception := range templateBackup.Exceptions {
+				id, err := newID()
+				if err != nil {
+					return err
+				}
+				exception.ID = id
+				exception.TemplateID = templateBackup.Template.ID
+				exception.CreatedAt = orNow(exception.CreatedAt)
+				if _, err := repo.CreateException(ctx, &exception); err != nil {
+					return fmt.Errorf("failed to create exception: %w", err)
+				}
+			}
+		}
+		// Replaces the history recorded when the items were inserted
+		return repo.RestoreStatusHistory(ctx, changes)
+	})
+}
+
+// newID generates an ID for a new entity.
+func newID() (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate id: %w", err)
+	}
+	return id.String(), nil
+}
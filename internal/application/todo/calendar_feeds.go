@@ -0,0 +1,144 @@
+package todo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// calendarFeedTokenPrefix marks feed tokens, like whsec_ marks webhook secrets.
+const calendarFeedTokenPrefix = "ics_"
+
+// generateCalendarFeedToken creates the secret token of a new feed.
+func generateCalendarFeedToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate calendar feed token: %w", err)
+	}
+	return calendarFeedTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CreateCalendarFeed creates a calendar subscription to the items of a list.
+// Any principal with access to the list can create one; the feed shows what
+// they can see. The returned feed carries the token, which is not stored.
+func (s *Service) CreateCalendarFeed(ctx context.Context, feed *domain.CalendarFeed) (*domain.CalendarFeed, error) {
+	if feed.ListID == "" {
+		return nil, domain.ErrListNotFound
+	}
+	if err := s.requireListRole(ctx, feed.ListID, domain.ListRoleViewer); err != nil {
+		return nil, err
+	}
+	if err := feed.Validate(); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate id: %w", err)
+	}
+	feed.ID = id.String()
+
+	token, err := generateCalendarFeedToken()
+	if err != nil {
+		return nil, err
+	}
+	feed.TokenHash = domain.HashCalendarFeedToken(token)
+
+	// Like saved views, the feed belongs to the tenant of the authenticated caller
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		feed.OwnerID = principal.OwnerID
+	}
+	feed.CreatedAt = time.Now().UTC()
+
+	created, err := s.repo.CreateCalendarFeed(ctx, feed)
+	if err != nil {
+		return nil, err
+	}
+	created.Token = token
+	return created, nil
+}
+
+// ListCalendarFeeds returns the caller's feeds of a list, oldest first.
+func (s *Service) ListCalendarFeeds(ctx context.Context, listID string) ([]*domain.CalendarFeed, error) {
+	if listID == "" {
+		return nil, domain.ErrListNotFound
+	}
+	if err := s.requireListRole(ctx, listID, domain.ListRoleViewer); err != nil {
+		return nil, err
+	}
+	return s.repo.FindCalendarFeeds(ctx, listID)
+}
+
+// DeleteCalendarFeed removes a feed; calendars subscribed to it stop updating.
+func (s *Service) DeleteCalendarFeed(ctx context.Context, listID, id string) error {
+	if listID == "" {
+		return domain.ErrListNotFound
+	}
+	if id == "" {
+		return domain.ErrCalendarFeedNotFound
+	}
+	if err := s.requireListRole(ctx, listID, domain.ListRoleViewer); err != nil {
+		return err
+	}
+	return s.repo.DeleteCalendarFeed(ctx, listID, id)
+}
+
+// GetCalendarFeedContent returns the list and items a feed token shows.
+// The token is the only credential: the request has no principal. Items are
+// read with the access of the feed's tenant, so a feed whose tenant lost access
+// to the list is reported as not found, like an unknown token. Feeds set up by
+// internal callers have no tenant and are read with internal access.
+func (s *Service) GetCalendarFeedContent(ctx context.Context, token string) (*domain.CalendarFeedContent, error) {
+	if token == "" {
+		return nil, domain.ErrCalendarFeedNotFound
+	}
+	feed, err := s.repo.FindCalendarFeedByTokenHash(ctx, domain.HashCalendarFeedToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	if feed.OwnerID != "" {
+		ctx = domain.WithPrincipal(ctx, domain.Principal{OwnerID: feed.OwnerID})
+	} else {
+		ctx = domain.WithInternalAccess(ctx)
+	}
+	list, err := s.repo.FindListByID(ctx, feed.ListID)
+	if err != nil {
+		if errors.Is(err, domain.ErrListNotFound) {
+			return nil, domain.ErrCalendarFeedNotFound
+		}
+		return nil, err
+	}
+
+	filter, err := feed.ItemsFilter()
+	if err != nil {
+		return nil, err
+	}
+	excluded := []domain.TaskStatus{}
+	if !filter.HasStatusFilter() {
+		excluded = domain.DefaultExcludedStatuses()
+	}
+
+	// Read pages until the feed is complete or full
+	content := &domain.CalendarFeedContent{Feed: feed, List: list}
+	params := domain.ListTasksParams{ListID: &feed.ListID, Filter: filter}
+	for len(content.Items) < domain.MaxCalendarFeedItems {
+		params.Limit = min(s.config.MaxPageSize, domain.MaxCalendarFeedItems-len(content.Items))
+		page, err := s.repo.FindItems(ctx, params, excluded)
+		if err != nil {
+			return nil, err
+		}
+		content.Items = append(content.Items, page.Items...)
+		if page.NextCursor == nil {
+			break
+		}
+		params.After = page.NextCursor
+	}
+	return content, nil
+}
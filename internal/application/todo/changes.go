@@ -0,0 +1,182 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// DefaultChangePollInterval is how often event streams look for changes
+// whose notification was missed, or arrived while an older transaction was
+// still running.
+const DefaultChangePollInterval = 5 * time.Second
+
+// changeStreamBatchSize limits changes loaded per catch-up query.
+const changeStreamBatchSize = 100
+
+// streamedEntityTypes are the entities event streams report on.
+var streamedEntityTypes = []domain.ChangeEntityType{domain.ChangeEntityItem, domain.ChangeEntityTemplate}
+
+// ChangeNotifier announces the seq of every new change log row once its
+// transaction commits. Notifications are hints: streams still poll, so a
+// dropped notification only delays a change.
+type ChangeNotifier interface {
+	// SubscribeToChanges returns a channel of change seqs.
+	// The channel is closed when ctx is cancelled.
+	SubscribeToChanges(ctx context.Context) (<-chan int64, error)
+}
+
+// StreamedChange is a change delivered by an event stream.
+// Cursor is the position of the change in the change log; clients send it
+// back as Last-Event-ID. Changes are streamed in cursor order, and like sync
+// only once every transaction older than theirs has finished, so no change
+// can appear behind a cursor already delivered.
+type StreamedChange struct {
+	Change *domain.Change
+	Cursor domain.SyncCursor
+}
+
+// EventID formats a stream cursor as a server-sent event ID, "<txid>-<seq>".
+func EventID(cursor domain.SyncCursor) string {
+	return fmt.Sprintf("%d-%d", cursor.TxID, cursor.Seq)
+}
+
+// ParseLastEventID parses the resume position of an event stream, an ID
+// formatted by EventID. An empty ID means the stream starts with changes made
+// after it connects.
+func ParseLastEventID(lastEventID string) (*domain.SyncCursor, error) {
+	if lastEventID == "" {
+		return nil, nil
+	}
+	txid, seq, ok := strings.Cut(lastEventID, "-")
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidLastEventID, lastEventID)
+	}
+	var cursor domain.SyncCursor
+	var err error
+	if cursor.TxID, err = strconv.ParseUint(txid, 10, 64); err != nil {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidLastEventID, lastEventID)
+	}
+	if cursor.Seq, err = strconv.ParseInt(seq, 10, 64); err != nil || cursor.Seq < 0 {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidLastEventID, lastEventID)
+	}
+	return &cursor, nil
+}
+
+// StreamChanges streams item and template changes until ctx is cancelled.
+// listID restricts the stream to one list; an empty listID streams every list
+// the principal can access. after resumes after a cursor from an earlier stream;
+// nil starts with changes of transactions still running or yet to start.
+//
+// The returned channel is closed when ctx is cancelled, when the principal
+// loses access to the list, or when loading changes fails. Clients reconnect
+// with the last cursor they received.
+func (s *Service) StreamChanges(ctx context.Context, listID string, after *domain.SyncCursor) (<-chan StreamedChange, error) {
+	if listID != "" {
+		if err := s.requireListRole(ctx, listID, domain.ListRoleViewer); err != nil {
+			return nil, err
+		}
+	}
+
+	// Subscribe before reading the cursor so no change falls in between
+	var notifications <-chan int64
+	if s.config.Changes != nil {
+		ch, err := s.config.Changes.SubscribeToChanges(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to subscribe to changes: %w", err)
+		}
+		notifications = ch
+	}
+
+	var cursor domain.SyncCursor
+	if after != nil {
+		cursor = *after
+	} else {
+		watermark, err := s.repo.FindChangeWatermark(ctx)
+		if err != nil {
+			return nil, err
+		}
+		cursor = domain.SyncCursor{TxID: watermark}
+	}
+
+	out := make(chan StreamedChange)
+	go func() {
+		defer close(out)
+		if err := s.runChangeStream(ctx, listID, cursor, notifications, out); err != nil && ctx.Err() == nil {
+			slog.WarnContext(ctx, "change stream closed",
+				"list_id", listID,
+				"error", err)
+		}
+	}()
+	return out, nil
+}
+
+// runChangeStream delivers changes after cursor, then catches up again on
+// every notification and poll. A notification may arrive before the change it
+// announces can be read, while an older transaction is still running; the
+// change is then delivered by a later notification or poll.
+func (s *Service) runChangeStream(ctx context.Context, listID string, cursor domain.SyncCursor, notifications <-chan int64, out chan<- StreamedChange) error {
+	catchUp := func() error {
+		for {
+			changes, err := s.repo.FindChanges(ctx, domain.ChangeFeedQuery{
+				ListID:      listID,
+				EntityTypes: streamedEntityTypes,
+				After:       cursor,
+				Limit:       changeStreamBatchSize,
+			})
+			if err != nil {
+				return err
+			}
+			for _, change := range changes {
+				cursor = change.Position()
+				select {
+				case out <- StreamedChange{Change: change, Cursor: cursor}:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			if len(changes) < changeStreamBatchSize {
+				return nil
+			}
+		}
+	}
+
+	if err := catchUp(); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(s.config.ChangePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case _, ok := <-notifications:
+			if !ok {
+				notifications = nil // Notifier gone - keep polling
+				continue
+			}
+			if err := catchUp(); err != nil {
+				return err
+			}
+
+		case <-ticker.C:
+			// Stop streaming a list the principal can no longer see
+			if listID != "" {
+				if err := s.requireListRole(ctx, listID, domain.ListRoleViewer); err != nil {
+					return err
+				}
+			}
+			if err := catchUp(); err != nil {
+				return err
+			}
+		}
+	}
+}
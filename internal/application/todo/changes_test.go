@@ -0,0 +1,199 @@
+package todo
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockChangesRepo serves an in-memory change log of a single shared list.
+// Like the store, it only reads changes of transactions older than the
+// oldest one still running.
+type mockChangesRepo struct {
+	*mockMembersRepo
+	mu      sync.Mutex
+	changes []*domain.Change
+	running map[uint64]bool // Transactions that have not finished
+}
+
+// newMockChangesRepo returns a log of committed changes, each written by a
+// transaction with the ID of its seq.
+func newMockChangesRepo(seqs ...int64) *mockChangesRepo {
+	repo := &mockChangesRepo{mockMembersRepo: newMockMembersRepo(), running: make(map[uint64]bool)}
+	for _, seq := range seqs {
+		repo.write(uint64(seq), seq)
+		repo.commit(uint64(seq))
+	}
+	return repo
+}
+
+// write records a change of transaction txid, which runs until committed.
+func (m *mockChangesRepo) write(txid uint64, seq int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.running[txid] = true
+	m.changes = append(m.changes, &domain.Change{
+		Seq:        seq,
+		TxID:       txid,
+		EntityType: domain.ChangeEntityItem,
+		ListID:     testListID,
+		Operation:  domain.ChangeUpdated,
+	})
+}
+
+func (m *mockChangesRepo) commit(txid uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.running, txid)
+}
+
+func (m *mockChangesRepo) watermark() uint64 {
+	var oldest, next uint64
+	for _, c := range m.changes {
+		next = max(next, c.TxID+1)
+		if m.running[c.TxID] && (oldest == 0 || c.TxID < oldest) {
+			oldest = c.TxID
+		}
+	}
+	return cmp.Or(oldest, next, 1)
+}
+
+func (m *mockChangesRepo) FindChanges(ctx context.Context, query domain.ChangeFeedQuery) ([]*domain.Change, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	watermark := m.watermark()
+	var found []*domain.Change
+	for _, c := range m.changes {
+		if c.TxID < watermark && c.Position().After(query.After) {
+			found = append(found, c)
+		}
+	}
+	slices.SortFunc(found, func(a, b *domain.Change) int {
+		return cmp.Or(cmp.Compare(a.TxID, b.TxID), cmp.Compare(a.Seq, b.Seq))
+	})
+	return found[:min(len(found), query.Limit)], nil
+}
+
+func (m *mockChangesRepo) FindChangeWatermark(ctx context.Context) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.watermark(), nil
+}
+
+// chanNotifier hands out one notification channel controlled by the test.
+type chanNotifier struct {
+	ch chan int64
+}
+
+func (n *chanNotifier) SubscribeToChanges(ctx context.Context) (<-chan int64, error) {
+	return n.ch, nil
+}
+
+func newChangeStreamService(repo *mockChangesRepo, notifier *chanNotifier) *Service {
+	return NewService(repo, &mockTaskGenerator{}, Config{
+		Changes:            notifier,
+		ChangePollInterval: time.Hour, // Tests drive the stream through notifications
+	})
+}
+
+func receiveChange(t *testing.T, stream <-chan StreamedChange) StreamedChange {
+	t.Helper()
+	select {
+	case streamed, ok := <-stream:
+		require.True(t, ok, "stream closed unexpectedly")
+		return streamed
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for change")
+		return StreamedChange{}
+	}
+}
+
+func assertNoChange(t *testing.T, stream <-chan StreamedChange) {
+	t.Helper()
+	select {
+	case streamed := <-stream:
+		assert.Failf(t, "unexpected change", "seq %d", streamed.Change.Seq)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestStreamChanges_ResumesAfterCursor(t *testing.T) {
+	repo := newMockChangesRepo(1, 2, 3)
+	service := newChangeStreamService(repo, &chanNotifier{ch: make(chan int64)})
+
+	ctx, cancel := context.WithCancel(asPrincipal(testViewerID))
+	defer cancel()
+
+	after := domain.SyncCursor{TxID: 1, Seq: 1}
+	stream, err := service.StreamChanges(ctx, testListID, &after)
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.SyncCursor{TxID: 2, Seq: 2}, receiveChange(t, stream).Cursor)
+	assert.Equal(t, domain.SyncCursor{TxID: 3, Seq: 3}, receiveChange(t, stream).Cursor)
+}
+
+func TestStreamChanges_WaitsForOlderTransactions(t *testing.T) {
+	repo := newMockChangesRepo(1)
+	notifier := &chanNotifier{ch: make(chan int64, 4)}
+	service := newChangeStreamService(repo, notifier)
+
+	ctx, cancel := context.WithCancel(asPrincipal(testOwnerID))
+	defer cancel()
+
+	// Without a cursor the stream starts after the finished transactions
+	stream, err := service.StreamChanges(ctx, testListID, nil)
+	require.NoError(t, err)
+
+	// Transaction 2 wrote seq 2 and is still running when 3 commits seq 3
+	repo.write(2, 2)
+	repo.write(3, 3)
+	repo.commit(3)
+	notifier.ch <- 3
+	assertNoChange(t, stream)
+
+	// Once 2 commits both are streamed in order, so that resuming from the
+	// cursor of seq 3 could not skip seq 2
+	repo.commit(2)
+	notifier.ch <- 2
+	assert.Equal(t, domain.SyncCursor{TxID: 2, Seq: 2}, receiveChange(t, stream).Cursor)
+	assert.Equal(t, domain.SyncCursor{TxID: 3, Seq: 3}, receiveChange(t, stream).Cursor)
+
+	// Repeated notifications do not deliver a change twice
+	notifier.ch <- 3
+	assertNoChange(t, stream)
+	repo.write(4, 4)
+	repo.commit(4)
+	notifier.ch <- 4
+	assert.Equal(t, int64(4), receiveChange(t, stream).Change.Seq)
+}
+
+func TestStreamChanges_RequiresListAccess(t *testing.T) {
+	repo := newMockChangesRepo()
+	service := newChangeStreamService(repo, &chanNotifier{ch: make(chan int64)})
+
+	_, err := service.StreamChanges(asPrincipal(testOutsideID), testListID, nil)
+	assert.ErrorIs(t, err, domain.ErrListNotFound)
+}
+
+func TestParseLastEventID(t *testing.T) {
+	after, err := ParseLastEventID("")
+	require.NoError(t, err)
+	assert.Nil(t, after)
+
+	cursor := domain.SyncCursor{TxID: 812, Seq: 42}
+	after, err = ParseLastEventID(EventID(cursor))
+	require.NoError(t, err)
+	assert.Equal(t, cursor, *after)
+
+	for _, invalid := range []string{"abc", "42", "-1", "1-", "1--1", "1.5-2", "1-2-3"} {
+		_, err := ParseLastEventID(invalid)
+		assert.ErrorIs(t, err, domain.ErrInvalidLastEventID, invalid)
+	}
+}
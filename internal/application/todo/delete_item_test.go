@@ -12,6 +12,8 @@ import (
 )
 
 type mockDeleteItemRepo struct {
+	unimplementedRepository
+
 	findItemFn        func(ctx context.Context, id string) (*domain.TodoItem, error)
 	createExceptionFn func(ctx context.Context, exc *domain.RecurringTemplateException) (*domain.RecurringTemplateException, error)
 	updateItemFn      func(ctx context.Context, params domain.UpdateItemParams) (*domain.TodoItem, error)
@@ -55,36 +57,7 @@ func (m *mockDeleteItemRepo) Atomic(ctx context.Context, fn func(tx Repository)
 }
 
 // AtomicRecurring executes callback without transaction (tests don't need real transactions)
-func (m *mockDeleteItemRepo) AtomicRecurring(ctx context.Context, fn func(ops RecurringOperations) error) error {
-	// Not used in delete item tests
-	panic("AtomicRecurring not used in delete item tests")
-}
-
 // Stub all other methods
-func (m *mockDeleteItemRepo) CreateList(ctx context.Context, list *domain.TodoList) (*domain.TodoList, error) {
-	panic("not used")
-}
-
-func (m *mockDeleteItemRepo) FindListByID(ctx context.Context, id string) (*domain.TodoList, error) {
-	panic("not used")
-}
-
-func (m *mockDeleteItemRepo) FindLists(ctx context.Context, params domain.ListListsParams) (*domain.PagedListResult, error) {
-	panic("not used")
-}
-
-func (m *mockDeleteItemRepo) UpdateList(ctx context.Context, params domain.UpdateListParams) (*domain.TodoList, error) {
-	panic("not used")
-}
-
-func (m *mockDeleteItemRepo) CreateItem(ctx context.Context, listID string, item *domain.TodoItem) (*domain.TodoItem, error) {
-	panic("not used")
-}
-
-func (m *mockDeleteItemRepo) FindItems(ctx context.Context, params domain.ListTasksParams, excludedStatuses []domain.TaskStatus) (*domain.PagedResult, error) {
-	panic("not used")
-}
-
 func (m *mockDeleteItemRepo) DeleteItem(ctx context.Context, id string) error {
 	if m.deleteItemFn != nil {
 		return m.deleteItemFn(ctx, id)
@@ -92,24 +65,8 @@ func (m *mockDeleteItemRepo) DeleteItem(ctx context.Context, id string) error {
 	panic("DeleteItem not implemented")
 }
 
-func (m *mockDeleteItemRepo) CreateRecurringTemplate(ctx context.Context, template *domain.RecurringTemplate) (*domain.RecurringTemplate, error) {
-	panic("not used")
-}
-
-func (m *mockDeleteItemRepo) FindRecurringTemplateByID(ctx context.Context, id string) (*domain.RecurringTemplate, error) {
-	panic("not used")
-}
-
-func (m *mockDeleteItemRepo) UpdateRecurringTemplate(ctx context.Context, params domain.UpdateRecurringTemplateParams) (*domain.RecurringTemplate, error) {
-	panic("not used")
-}
-
-func (m *mockDeleteItemRepo) DeleteRecurringTemplate(ctx context.Context, id string) error {
-	panic("not used")
-}
-
-func (m *mockDeleteItemRepo) FindRecurringTemplates(ctx context.Context, listID string, activeOnly bool) ([]*domain.RecurringTemplate, error) {
-	panic("not used")
+func (m *mockDeleteItemRepo) FindEnabledAutomationRules(ctx context.Context, listID string, trigger domain.RuleTriggerType) ([]*domain.AutomationRule, error) {
+	return nil, nil // No rules
 }
 
 func TestDeleteItem_RecurringItem_CreatesExceptionAndHardDeletes(t *testing.T) {
@@ -146,7 +103,7 @@ func TestDeleteItem_RecurringItem_CreatesExceptionAndHardDeletes(t *testing.T) {
 
 	service := NewService(repo, nil, Config{})
 
-	err := service.DeleteItem(context.Background(), listID, itemID)
+	err := service.DeleteItem(internalContext(), listID, itemID)
 
 	require.NoError(t, err)
 
@@ -204,7 +161,7 @@ func TestUpdateItem_EditRecurringItem_CreatesException(t *testing.T) {
 		Title:      &newTitle,
 	}
 
-	_, err := service.UpdateItem(context.Background(), params)
+	_, err := service.UpdateItem(internalContext(), params)
 
 	require.NoError(t, err)
 
@@ -0,0 +1,231 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/recurring"
+)
+
+// ImportEntries creates the items and recurring templates read from another
+// tool's export in a list, and reports what happened to each entry.
+//
+// A dry run validates and reports without creating anything. Entries whose
+// external ID was imported into the list before are skipped, so importing a
+// file again only adds what is new. Each entry is created in its own
+// transaction together with its import record: if an import stops halfway,
+// running it again picks up where it stopped.
+func (s *Service) ImportEntries(ctx context.Context, listID string, entries []domain.ImportEntry, dryRun bool) (*domain.ImportReport, error) {
+	if listID == "" {
+		return nil, domain.ErrListNotFound
+	}
+	if len(entries) > domain.MaxImportEntries {
+		return nil, fmt.Errorf("%w: more than %d entries", domain.ErrInvalidImport, domain.MaxImportEntries)
+	}
+	if err := s.requireListRole(ctx, listID, domain.ListRoleEditor); err != nil {
+		return nil, err
+	}
+	list, err := s.repo.FindListByID(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	externalIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.ExternalID != "" {
+			externalIDs = append(externalIDs, entry.ExternalID)
+		}
+	}
+	records, err := s.repo.FindImportRecords(ctx, listID, externalIDs)
+	if err != nil {
+		return nil, err
+	}
+	// External ID → ID of the item or template it became ("" if since deleted or in a dry run)
+	imported := make(map[string]string, len(records))
+	for _, record := range records {
+		imported[record.ExternalID] = importedID(record)
+	}
+
+	now := time.Now().UTC()
+	report := &domain.ImportReport{DryRun: dryRun, Results: make([]domain.ImportResult, 0, len(entries))}
+	for _, entry := range entries {
+		result := domain.ImportResult{
+			ExternalID: entry.ExternalID,
+			Kind:       entry.Kind(),
+			Title:      entry.Title(),
+		}
+
+		if id, ok := imported[entry.ExternalID]; ok {
+			result.Action = domain.ImportActionSkip
+			result.ID = id
+			result.Reason = "already imported"
+			report.Results = append(report.Results, result)
+			continue
+		}
+		if err := entry.Validate(); err != nil {
+			result.Action = domain.ImportActionFail
+			result.Reason = err.Error()
+			if entry.Problem != "" {
+				result.Reason = entry.Problem
+			}
+			report.Results = append(report.Results, result)
+			continue
+		}
+
+		entry = withNotes(entry, list.CustomFieldSchema)
+		result.Action = domain.ImportActionCreate
+		if !dryRun {
+			id, err := s.importEntry(ctx, listID, entry, now)
+			switch {
+			case errors.Is(err, domain.ErrAlreadyImported):
+				// Imported concurrently since the records were read
+				result.Action = domain.ImportActionSkip
+				result.Reason = "already imported"
+			case err != nil:
+				return nil, fmt.Errorf("failed to import %q: %w", entry.ExternalID, err)
+			default:
+				result.ID = id
+			}
+		}
+		// A second entry with the same external ID is skipped
+		imported[entry.ExternalID] = result.ID
+		report.Results = append(report.Results, result)
+	}
+
+	slog.InfoContext(ctx, "imported entries",
+		"list_id", listID,
+		"dry_run", dryRun,
+		"created", report.Count(domain.ImportActionCreate),
+		"skipped", report.Count(domain.ImportActionSkip),
+		"failed", report.Count(domain.ImportActionFail))
+
+	return report, nil
+}
+
+// importEntry creates the item or template of an entry together with its import
+// record, and returns its ID.
+func (s *Service) importEntry(ctx context.Context, listID string, entry domain.ImportEntry, now time.Time) (string, error) {
+	record := &domain.ImportRecord{ListID: listID, ExternalID: entry.ExternalID, CreatedAt: now}
+
+	if entry.Template == nil {
+		item := *entry.Item
+		if err := prepareNewItem(ctx, s.repo, listID, &item); err != nil {
+			return "", err
+		}
+		record.ItemID = &item.ID
+		err := s.repo.Atomic(ctx, func(repo Repository) error {
+			if _, err := repo.CreateItem(ctx, listID, &item); err != nil {
+				return fmt.Errorf("failed to create item: %w", err)
+			}
+			return repo.CreateImportRecord(ctx, record)
+		})
+		if err != nil {
+			return "", err
+		}
+		return item.ID, nil
+	}
+
+	template := *entry.Template
+	template.ListID = listID
+	start := firstOccurrence(entry, now)
+
+	// Occurrences deleted before start are never generated anyway
+	var exceptions []*domain.RecurringTemplateException
+	excluded := make(map[time.Time]bool)
+	for _, occursAt := range entry.Exceptions {
+		if occursAt.Before(start) || excluded[occursAt] {
+			continue
+		}
+		excluded[occursAt] = true
+		id, err := uuid.NewV7()
+		if err != nil {
+			return "", fmt.Errorf("failed to generate exception id: %w", err)
+		}
+		exceptions = append(exceptions, &domain.RecurringTemplateException{
+			ID:            id.String(),
+			OccursAt:      occursAt,
+			ExceptionType: domain.ExceptionTypeDeleted,
+			CreatedAt:     now,
+		})
+	}
+
+	created, err := s.createRecurringTemplate(ctx, &template, start, exceptions,
+		func(ops RecurringOperations, created *domain.RecurringTemplate) error {
+			record.TemplateID = &created.ID
+			return ops.CreateImportRecord(ctx, record)
+		})
+	if err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+// withNotes returns the entry with its notes in the domain.ImportNotesField
+// custom field, if the list declares it as a string field.
+func withNotes(entry domain.ImportEntry, schema domain.CustomFieldSchema) domain.ImportEntry {
+	field, ok := schema.Field(domain.ImportNotesField)
+	if entry.Notes == "" || !ok || field.Type != domain.CustomFieldTypeString {
+		return entry
+	}
+
+	notes := entry.Notes
+	if len(notes) > domain.MaxCustomFieldStringLen {
+		notes = strings.ToValidUTF8(notes[:domain.MaxCustomFieldStringLen], "")
+	}
+	withNotes := func(values map[string]any) map[string]any {
+		values = maps.Clone(values)
+		if values == nil {
+			values = make(map[string]any, 1)
+		}
+		values[domain.ImportNotesField] = notes
+		return values
+	}
+	if entry.Template != nil {
+		template := *entry.Template
+		template.CustomFields = withNotes(template.CustomFields)
+		entry.Template = &template
+	} else if entry.Item != nil {
+		item := *entry.Item
+		item.CustomFields = withNotes(item.CustomFields)
+		entry.Item = &item
+	}
+	return entry
+}
+
+// firstOccurrence returns the first occurrence of a recurring entry at or
+// after now, stepping through the pattern from the entry's start.
+func firstOccurrence(entry domain.ImportEntry, now time.Time) time.Time {
+	start := entry.TemplateStart
+	calculator := recurring.GetCalculator(entry.Template.RecurrencePattern)
+	if calculator == nil {
+		return start
+	}
+	for start.Before(now) {
+		next := calculator.NextOccurrence(start, entry.Template.RecurrenceConfig)
+		if next == nil || !next.After(start) {
+			break
+		}
+		start = *next
+	}
+	return start
+}
+
+// importedID returns the ID of the item or template an import record created,
+// or "" if it has since been deleted.
+func importedID(record *domain.ImportRecord) string {
+	switch {
+	case record.ItemID != nil:
+		return *record.ItemID
+	case record.TemplateID != nil:
+		return *record.TemplateID
+	default:
+		return ""
+	}
+}
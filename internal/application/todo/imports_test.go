@@ -0,0 +1,36 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirstOccurrence(t *testing.T) {
+	now := time.Date(2026, 3, 18, 12, 0, 0, 0, time.UTC)
+	monday := time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		pattern domain.RecurrencePattern
+		config  map[string]any
+		start   time.Time
+		want    time.Time
+	}{
+		{"weekly from the past", domain.RecurrenceWeekly, nil, monday, time.Date(2026, 3, 23, 9, 30, 0, 0, time.UTC)},
+		{"every other day", domain.RecurrenceDaily, map[string]any{"interval": float64(2)}, monday, time.Date(2026, 3, 18, 9, 30, 0, 0, time.UTC).AddDate(0, 0, 2)},
+		{"monthly keeps the day", domain.RecurrenceMonthly, nil, monday, time.Date(2026, 4, 2, 9, 30, 0, 0, time.UTC)},
+		{"future start is kept", domain.RecurrenceDaily, nil, monday.AddDate(0, 1, 0), monday.AddDate(0, 1, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := domain.ImportEntry{
+				Template:      &domain.RecurringTemplate{RecurrencePattern: tt.pattern, RecurrenceConfig: tt.config},
+				TemplateStart: tt.start,
+			}
+			assert.Equal(t, tt.want, firstOccurrence(entry, now))
+		})
+	}
+}
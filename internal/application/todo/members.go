@@ -0,0 +1,142 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// requireListRole checks that the authenticated principal has at least the
+// required role on the list.
+//
+// Principals without any access get domain.ErrListNotFound, so the existence of
+// foreign lists is not leaked. Principals that can see the list but lack the role
+// (e.g. a viewer trying to edit) get domain.ErrListAccessDenied.
+// Internal callers marked with domain.WithInternalAccess (workers, tools) are
+// not restricted; callers with neither get domain.ErrUnauthorized.
+func (s *Service) requireListRole(ctx context.Context, listID string, required domain.ListRole) error {
+	return requireListRole(ctx, s.repo, listID, required)
+}
+
+// requireListRole checks the role of the principal with repo, e.g. the
+// repository of a running transaction.
+func requireListRole(ctx context.Context, repo Repository, listID string, required domain.ListRole) error {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		if domain.HasInternalAccess(ctx) {
+			return nil
+		}
+		return fmt.Errorf("%w: no principal or internal access in context", domain.ErrUnauthorized)
+	}
+
+	role, err := repo.FindListRole(ctx, listID, principal.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	if !role.Allows(required) {
+		return fmt.Errorf("%w: %s role required, have %s", domain.ErrListAccessDenied, required, role)
+	}
+	return nil
+}
+
+// ListMembers returns the principals a list is shared with.
+// Any principal with access to the list can see its members.
+func (s *Service) ListMembers(ctx context.Context, listID string) ([]*domain.ListMember, error) {
+	if listID == "" {
+		return nil, domain.ErrListNotFound
+	}
+
+	if err := s.requireListRole(ctx, listID, domain.ListRoleViewer); err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindListMembers(ctx, listID)
+}
+
+// AddMember shares a list with another principal as editor or viewer.
+// Only the list owner can add members.
+func (s *Service) AddMember(ctx context.Context, listID, principalID, roleStr string) (*domain.ListMember, error) {
+	if listID == "" {
+		return nil, domain.ErrListNotFound
+	}
+
+	if _, err := uuid.Parse(principalID); err != nil {
+		return nil, fmt.Errorf("%w: principal %w", domain.ErrInvalidID, err)
+	}
+
+	role, err := domain.NewMemberRole(roleStr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireListRole(ctx, listID, domain.ListRoleOwner); err != nil {
+		return nil, err
+	}
+
+	// The owner already has full access
+	if principal, ok := domain.PrincipalFromContext(ctx); ok && principal.OwnerID == principalID {
+		return nil, domain.ErrMemberAlreadyExists
+	}
+
+	now := time.Now().UTC()
+	return s.repo.CreateListMember(ctx, &domain.ListMember{
+		ListID:      listID,
+		PrincipalID: principalID,
+		Role:        role,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+}
+
+// UpdateMemberRole changes the role of a member.
+// Only the list owner can change roles.
+func (s *Service) UpdateMemberRole(ctx context.Context, listID, principalID, roleStr string) (*domain.ListMember, error) {
+	if listID == "" {
+		return nil, domain.ErrListNotFound
+	}
+	if principalID == "" {
+		return nil, domain.ErrMemberNotFound
+	}
+
+	role, err := domain.NewMemberRole(roleStr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireListRole(ctx, listID, domain.ListRoleOwner); err != nil {
+		return nil, err
+	}
+
+	return s.repo.UpdateListMember(ctx, &domain.ListMember{
+		ListID:      listID,
+		PrincipalID: principalID,
+		Role:        role,
+		UpdatedAt:   time.Now().UTC(),
+	})
+}
+
+// RemoveMember revokes the access of a member.
+// The list owner can remove anyone; members can remove themselves to leave the list.
+func (s *Service) RemoveMember(ctx context.Context, listID, principalID string) error {
+	if listID == "" {
+		return domain.ErrListNotFound
+	}
+	if principalID == "" {
+		return domain.ErrMemberNotFound
+	}
+
+	required := domain.ListRoleOwner
+	if principal, ok := domain.PrincipalFromContext(ctx); ok && principal.OwnerID == principalID {
+		required = domain.ListRoleViewer
+	}
+
+	if err := s.requireListRole(ctx, listID, required); err != nil {
+		return err
+	}
+
+	return s.repo.DeleteListMember(ctx, listID, principalID)
+}
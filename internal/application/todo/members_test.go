@@ -0,0 +1,221 @@
+package todo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testListID    = "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a01"
+	testOwnerID   = "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a02"
+	testEditorID  = "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a03"
+	testViewerID  = "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a04"
+	testOutsideID = "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a05"
+)
+
+// mockMembersRepo holds the roles of a single shared list.
+type mockMembersRepo struct {
+	mockListListsRepo // embed for interface satisfaction
+	roles             map[string]domain.ListRole
+	createdMember     *domain.ListMember
+	updatedMember     *domain.ListMember
+	deletedPrincipal  string
+	updatedList       bool
+	deletedItem       bool
+}
+
+func newMockMembersRepo() *mockMembersRepo {
+	return &mockMembersRepo{
+		roles: map[string]domain.ListRole{
+			testOwnerID:  domain.ListRoleOwner,
+			testEditorID: domain.ListRoleEditor,
+			testViewerID: domain.ListRoleViewer,
+		},
+	}
+}
+
+func (m *mockMembersRepo) FindListRole(ctx context.Context, listID, principalID string) (domain.ListRole, error) {
+	role, ok := m.roles[principalID]
+	if listID != testListID || !ok {
+		return "", domain.ErrListNotFound
+	}
+	return role, nil
+}
+
+func (m *mockMembersRepo) CreateListMember(ctx context.Context, member *domain.ListMember) (*domain.ListMember, error) {
+	m.createdMember = member
+	return member, nil
+}
+
+func (m *mockMembersRepo) UpdateListMember(ctx context.Context, member *domain.ListMember) (*domain.ListMember, error) {
+	m.updatedMember = member
+	return member, nil
+}
+
+func (m *mockMembersRepo) DeleteListMember(ctx context.Context, listID, principalID string) error {
+	m.deletedPrincipal = principalID
+	return nil
+}
+
+func (m *mockMembersRepo) UpdateList(ctx context.Context, params domain.UpdateListParams) (*domain.TodoList, error) {
+	m.updatedList = true
+	return &domain.TodoList{ID: params.ListID}, nil
+}
+
+func (m *mockMembersRepo) FindItemByID(ctx context.Context, id string) (*domain.TodoItem, error) {
+	return &domain.TodoItem{ID: id, ListID: testListID}, nil
+}
+
+func (m *mockMembersRepo) DeleteItem(ctx context.Context, id string) error {
+	m.deletedItem = true
+	return nil
+}
+
+func asPrincipal(ownerID string) context.Context {
+	return domain.WithPrincipal(context.Background(), domain.Principal{
+		KeyID:   "key-" + ownerID,
+		OwnerID: ownerID,
+		Scopes:  domain.ReadWriteScopes(),
+	})
+}
+
+func TestDeleteItem_EnforcesListRole(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal string
+		wantErr   error
+	}{
+		{"owner", testOwnerID, nil},
+		{"editor", testEditorID, nil},
+		{"viewer", testViewerID, domain.ErrListAccessDenied},
+		{"outsider", testOutsideID, domain.ErrListNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockMembersRepo()
+			service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+			err := service.DeleteItem(asPrincipal(tt.principal), testListID, "item-1")
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, repo.deletedItem, "item must not be deleted")
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, repo.deletedItem)
+		})
+	}
+}
+
+func TestUpdateList_ViewerIsDenied(t *testing.T) {
+	repo := newMockMembersRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+	title := "Renamed"
+
+	_, err := service.UpdateList(asPrincipal(testViewerID), domain.UpdateListParams{
+		ListID:     testListID,
+		UpdateMask: []string{domain.FieldTitle},
+		Title:      &title,
+	})
+
+	require.ErrorIs(t, err, domain.ErrListAccessDenied)
+	assert.False(t, repo.updatedList)
+}
+
+func TestAddMember_OnlyOwnerCanShare(t *testing.T) {
+	repo := newMockMembersRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	_, err := service.AddMember(asPrincipal(testEditorID), testListID, testOutsideID, "viewer")
+	require.ErrorIs(t, err, domain.ErrListAccessDenied)
+	assert.Nil(t, repo.createdMember)
+
+	member, err := service.AddMember(asPrincipal(testOwnerID), testListID, testOutsideID, "Editor")
+	require.NoError(t, err)
+	assert.Equal(t, testListID, member.ListID)
+	assert.Equal(t, testOutsideID, member.PrincipalID)
+	assert.Equal(t, domain.ListRoleEditor, member.Role)
+	assert.False(t, member.CreatedAt.IsZero())
+}
+
+func TestAddMember_RejectsInvalidInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal string
+		role      string
+		wantErr   error
+	}{
+		{"owner role is not grantable", testOutsideID, "owner", domain.ErrInvalidListRole},
+		{"unknown role", testOutsideID, "admin", domain.ErrInvalidListRole},
+		{"invalid principal", "not-a-uuid", "viewer", domain.ErrInvalidID},
+		{"owner shares with itself", testOwnerID, "viewer", domain.ErrMemberAlreadyExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockMembersRepo()
+			service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+			_, err := service.AddMember(asPrincipal(testOwnerID), testListID, tt.principal, tt.role)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, repo.createdMember)
+		})
+	}
+}
+
+func TestUpdateMemberRole_OnlyOwnerCanChangeRoles(t *testing.T) {
+	repo := newMockMembersRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	_, err := service.UpdateMemberRole(asPrincipal(testViewerID), testListID, testViewerID, "editor")
+	require.ErrorIs(t, err, domain.ErrListAccessDenied)
+	assert.Nil(t, repo.updatedMember)
+
+	member, err := service.UpdateMemberRole(asPrincipal(testOwnerID), testListID, testViewerID, "editor")
+	require.NoError(t, err)
+	assert.Equal(t, domain.ListRoleEditor, member.Role)
+}
+
+func TestRemoveMember_MembersCanLeave(t *testing.T) {
+	repo := newMockMembersRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	// A viewer cannot remove other members
+	err := service.RemoveMember(asPrincipal(testViewerID), testListID, testEditorID)
+	require.ErrorIs(t, err, domain.ErrListAccessDenied)
+	assert.Empty(t, repo.deletedPrincipal)
+
+	// But can leave the list
+	require.NoError(t, service.RemoveMember(asPrincipal(testViewerID), testListID, testViewerID))
+	assert.Equal(t, testViewerID, repo.deletedPrincipal)
+
+	// The owner can remove anyone
+	require.NoError(t, service.RemoveMember(asPrincipal(testOwnerID), testListID, testEditorID))
+	assert.Equal(t, testEditorID, repo.deletedPrincipal)
+}
+
+func TestListRoles_InternalCallersAreNotRestricted(t *testing.T) {
+	repo := newMockMembersRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	// Internal access: FindListRole is not consulted
+	require.NoError(t, service.DeleteItem(internalContext(), testListID, "item-1"))
+	assert.True(t, repo.deletedItem)
+}
+
+func TestListRoles_CallersWithoutPrincipalAreRefused(t *testing.T) {
+	repo := newMockMembersRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	// Neither a principal nor internal access: fail closed
+	err := service.DeleteItem(context.Background(), testListID, "item-1")
+	assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	assert.False(t, repo.deletedItem)
+}
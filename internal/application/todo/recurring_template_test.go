@@ -26,24 +26,14 @@ func (m *mockTaskGenerator) GenerateTasksForTemplateWithExceptions(ctx context.C
 
 // mockRecurringRepo is a minimal mock for testing validation logic
 type mockRecurringRepo struct {
+	unimplementedRepository
+
 	createTemplateFn func(ctx context.Context, template *domain.RecurringTemplate) (*domain.RecurringTemplate, error)
 	findTemplateFn   func(ctx context.Context, id string) (*domain.RecurringTemplate, error)
 	updateTemplateFn func(ctx context.Context, params domain.UpdateRecurringTemplateParams) (*domain.RecurringTemplate, error)
 	updateListFn     func(ctx context.Context, params domain.UpdateListParams) (*domain.TodoList, error)
 }
 
-func (m *mockRecurringRepo) CreateList(ctx context.Context, list *domain.TodoList) (*domain.TodoList, error) {
-	panic("not used in recurring template tests")
-}
-
-func (m *mockRecurringRepo) FindListByID(ctx context.Context, id string) (*domain.TodoList, error) {
-	panic("not used in recurring template tests")
-}
-
-func (m *mockRecurringRepo) FindLists(ctx context.Context, params domain.ListListsParams) (*domain.PagedListResult, error) {
-	panic("not used in recurring template tests")
-}
-
 func (m *mockRecurringRepo) UpdateList(ctx context.Context, params domain.UpdateListParams) (*domain.TodoList, error) {
 	if m.updateListFn != nil {
 		return m.updateListFn(ctx, params)
@@ -51,26 +41,6 @@ func (m *mockRecurringRepo) UpdateList(ctx context.Context, params domain.Update
 	panic("not used in recurring template tests")
 }
 
-func (m *mockRecurringRepo) CreateItem(ctx context.Context, listID string, item *domain.TodoItem) (*domain.TodoItem, error) {
-	panic("not used in recurring template tests")
-}
-
-func (m *mockRecurringRepo) FindItemByID(ctx context.Context, id string) (*domain.TodoItem, error) {
-	panic("not used in recurring template tests")
-}
-
-func (m *mockRecurringRepo) UpdateItem(ctx context.Context, params domain.UpdateItemParams) (*domain.TodoItem, error) {
-	panic("not used in recurring template tests")
-}
-
-func (m *mockRecurringRepo) FindItems(ctx context.Context, params domain.ListTasksParams, excludedStatuses []domain.TaskStatus) (*domain.PagedResult, error) {
-	panic("not used in recurring template tests")
-}
-
-func (m *mockRecurringRepo) DeleteItem(ctx context.Context, id string) error {
-	panic("not used in recurring template tests")
-}
-
 func (m *mockRecurringRepo) CreateRecurringTemplate(ctx context.Context, template *domain.RecurringTemplate) (*domain.RecurringTemplate, error) {
 	if m.createTemplateFn != nil {
 		return m.createTemplateFn(ctx, template)
@@ -93,22 +63,6 @@ func (m *mockRecurringRepo) UpdateRecurringTemplate(ctx context.Context, params
 	return &domain.RecurringTemplate{ID: params.TemplateID}, nil
 }
 
-func (m *mockRecurringRepo) DeleteRecurringTemplate(ctx context.Context, id string) error {
-	panic("not used in recurring template tests")
-}
-
-func (m *mockRecurringRepo) FindRecurringTemplates(ctx context.Context, listID string, activeOnly bool) ([]*domain.RecurringTemplate, error) {
-	panic("not used in recurring template tests")
-}
-
-func (m *mockRecurringRepo) CreateException(ctx context.Context, exception *domain.RecurringTemplateException) (*domain.RecurringTemplateException, error) {
-	panic("not used in recurring template tests")
-}
-
-func (m *mockRecurringRepo) FindExceptionByOccurrence(ctx context.Context, templateID string, occursAt time.Time) (*domain.RecurringTemplateException, error) {
-	panic("not used in recurring template tests")
-}
-
 // Atomic executes callback without transaction (tests don't need real transactions)
 func (m *mockRecurringRepo) Atomic(ctx context.Context, fn func(tx Repository) error) error {
 	// Execute the function with the same mock (no actual transaction needed for validation tests)
@@ -138,6 +92,10 @@ func (m *mockRecurringRepo) ScheduleGenerationJob(ctx context.Context, templateI
 	return "job-123", nil // Return mock job ID
 }
 
+func (m *mockRecurringRepo) FindEnabledAutomationRules(ctx context.Context, listID string, trigger domain.RuleTriggerType) ([]*domain.AutomationRule, error) {
+	return nil, nil // No rules
+}
+
 // TestCreateRecurringTemplate_RejectsInvalidRecurrencePattern tests that
 // CreateRecurringTemplate validates recurrence_pattern against known values.
 func TestCreateRecurringTemplate_RejectsInvalidRecurrencePattern(t *testing.T) {
@@ -153,7 +111,7 @@ func TestCreateRecurringTemplate_RejectsInvalidRecurrencePattern(t *testing.T) {
 		GenerationHorizonDays: 365,
 	}
 
-	_, err := service.CreateRecurringTemplate(context.Background(), template)
+	_, err := service.CreateRecurringTemplate(internalContext(), template)
 
 	require.Error(t, err)
 	assert.ErrorIs(t, err, domain.ErrInvalidRecurrencePattern)
@@ -198,7 +156,7 @@ func TestCreateRecurringTemplate_AcceptsValidRecurrencePatterns(t *testing.T) {
 				GenerationHorizonDays: 365,
 			}
 
-			_, err := service.CreateRecurringTemplate(context.Background(), template)
+			_, err := service.CreateRecurringTemplate(internalContext(), template)
 
 			require.NoError(t, err, "valid pattern %s should be accepted", pattern)
 		})
@@ -227,7 +185,7 @@ func TestUpdateRecurringTemplate_RejectsInvalidRecurrencePattern(t *testing.T) {
 		RecurrencePattern: &invalidPattern,
 	}
 
-	_, err := service.UpdateRecurringTemplate(context.Background(), params)
+	_, err := service.UpdateRecurringTemplate(internalContext(), params)
 
 	require.Error(t, err)
 	assert.ErrorIs(t, err, domain.ErrInvalidRecurrencePattern)
@@ -259,7 +217,7 @@ func TestUpdateList_RejectsEmptyTitle(t *testing.T) {
 		Title:      ptr.To(""), // Empty string should be rejected
 	}
 
-	_, err := service.UpdateList(context.Background(), params)
+	_, err := service.UpdateList(internalContext(), params)
 
 	require.Error(t, err, "empty title should be rejected")
 	assert.ErrorIs(t, err, domain.ErrTitleRequired)
@@ -283,7 +241,7 @@ func TestUpdateList_RejectsWhitespaceOnlyTitle(t *testing.T) {
 		Title:      ptr.To("   "), // Whitespace only should be rejected
 	}
 
-	_, err := service.UpdateList(context.Background(), params)
+	_, err := service.UpdateList(internalContext(), params)
 
 	require.Error(t, err, "whitespace-only title should be rejected")
 	assert.ErrorIs(t, err, domain.ErrTitleRequired)
@@ -307,7 +265,7 @@ func TestUpdateList_AcceptsValidTitle(t *testing.T) {
 		Title:      ptr.To("Valid Title"),
 	}
 
-	result, err := service.UpdateList(context.Background(), params)
+	result, err := service.UpdateList(internalContext(), params)
 
 	require.NoError(t, err)
 	assert.Equal(t, "Valid Title", capturedTitle)
@@ -332,7 +290,7 @@ func TestUpdateList_RejectsEmptyUpdateMask(t *testing.T) {
 		Title:      nil,
 	}
 
-	_, err := service.UpdateList(context.Background(), params)
+	_, err := service.UpdateList(internalContext(), params)
 
 	require.ErrorIs(t, err, domain.ErrEmptyUpdateMask)
 }
@@ -359,7 +317,7 @@ func TestUpdateRecurringTemplate_RejectsEmptyTitle(t *testing.T) {
 		Title:      ptr.To(""), // Empty string should be rejected
 	}
 
-	_, err := service.UpdateRecurringTemplate(context.Background(), params)
+	_, err := service.UpdateRecurringTemplate(internalContext(), params)
 
 	require.Error(t, err, "empty title should be rejected")
 	assert.ErrorIs(t, err, domain.ErrTitleRequired)
@@ -387,7 +345,7 @@ func TestUpdateRecurringTemplate_RejectsWhitespaceOnlyTitle(t *testing.T) {
 		Title:      ptr.To("   "), // Whitespace only should be rejected
 	}
 
-	_, err := service.UpdateRecurringTemplate(context.Background(), params)
+	_, err := service.UpdateRecurringTemplate(internalContext(), params)
 
 	require.Error(t, err, "whitespace-only title should be rejected")
 	assert.ErrorIs(t, err, domain.ErrTitleRequired)
@@ -418,7 +376,7 @@ func TestUpdateRecurringTemplate_AcceptsValidTitle(t *testing.T) {
 		Title:      ptr.To("Valid Template Title"),
 	}
 
-	result, err := service.UpdateRecurringTemplate(context.Background(), params)
+	result, err := service.UpdateRecurringTemplate(internalContext(), params)
 
 	require.NoError(t, err)
 	assert.Equal(t, "Valid Template Title", capturedTitle)
@@ -440,7 +398,7 @@ func TestCreateRecurringTemplate_RejectsNegativeSyncHorizonDays(t *testing.T) {
 		GenerationHorizonDays: 365,
 	}
 
-	_, err := service.CreateRecurringTemplate(context.Background(), template)
+	_, err := service.CreateRecurringTemplate(internalContext(), template)
 
 	require.Error(t, err)
 	assert.ErrorIs(t, err, domain.ErrSyncHorizonMustBePositive)
@@ -512,7 +470,7 @@ func TestUpdateRecurringTemplate_ValidatesMultipleFields(t *testing.T) {
 			generator := &mockTaskGenerator{}
 			service := NewService(repo, generator, Config{})
 
-			_, err := service.UpdateRecurringTemplate(context.Background(), tt.params)
+			_, err := service.UpdateRecurringTemplate(internalContext(), tt.params)
 
 			require.Error(t, err)
 			assert.ErrorIs(t, err, tt.wantError)
@@ -23,6 +23,8 @@ import (
 // workflowMockRepo is a comprehensive mock that captures all RecurringOperations calls
 // for testing time-dependent workflows.
 type workflowMockRepo struct {
+	unimplementedRepository
+
 	// Captured calls
 	createdTemplate          *domain.RecurringTemplate
 	batchInsertedItems       []*domain.TodoItem
@@ -141,56 +143,8 @@ func (m *workflowMockRepo) AtomicRecurring(ctx context.Context, fn func(ops Recu
 }
 
 // Unused repository methods - panic if called
-func (m *workflowMockRepo) CreateList(ctx context.Context, list *domain.TodoList) (*domain.TodoList, error) {
-	panic("not used in workflow tests")
-}
-
-func (m *workflowMockRepo) FindListByID(ctx context.Context, id string) (*domain.TodoList, error) {
-	panic("not used in workflow tests")
-}
-
-func (m *workflowMockRepo) FindLists(ctx context.Context, params domain.ListListsParams) (*domain.PagedListResult, error) {
-	panic("not used in workflow tests")
-}
-
-func (m *workflowMockRepo) UpdateList(ctx context.Context, params domain.UpdateListParams) (*domain.TodoList, error) {
-	panic("not used in workflow tests")
-}
-
-func (m *workflowMockRepo) CreateItem(ctx context.Context, listID string, item *domain.TodoItem) (*domain.TodoItem, error) {
-	panic("not used in workflow tests")
-}
-
-func (m *workflowMockRepo) FindItemByID(ctx context.Context, id string) (*domain.TodoItem, error) {
-	panic("not used in workflow tests")
-}
-
-func (m *workflowMockRepo) UpdateItem(ctx context.Context, params domain.UpdateItemParams) (*domain.TodoItem, error) {
-	panic("not used in workflow tests")
-}
-
-func (m *workflowMockRepo) FindItems(ctx context.Context, params domain.ListTasksParams, excludedStatuses []domain.TaskStatus) (*domain.PagedResult, error) {
-	panic("not used in workflow tests")
-}
-
-func (m *workflowMockRepo) DeleteItem(ctx context.Context, id string) error {
-	panic("not used in workflow tests")
-}
-
-func (m *workflowMockRepo) DeleteRecurringTemplate(ctx context.Context, id string) error {
-	panic("not used in workflow tests")
-}
-
-func (m *workflowMockRepo) FindRecurringTemplates(ctx context.Context, listID string, activeOnly bool) ([]*domain.RecurringTemplate, error) {
-	panic("not used in workflow tests")
-}
-
-func (m *workflowMockRepo) CreateException(ctx context.Context, exception *domain.RecurringTemplateException) (*domain.RecurringTemplateException, error) {
-	panic("not used in workflow tests")
-}
-
-func (m *workflowMockRepo) FindExceptionByOccurrence(ctx context.Context, templateID string, occursAt time.Time) (*domain.RecurringTemplateException, error) {
-	panic("not used in workflow tests")
+func (m *workflowMockRepo) FindEnabledAutomationRules(ctx context.Context, listID string, trigger domain.RuleTriggerType) ([]*domain.AutomationRule, error) {
+	return nil, nil // No rules
 }
 
 // workflowMockGenerator generates predictable tasks for testing
@@ -270,7 +224,7 @@ func TestCreateRecurringTemplate_SyncGeneration(t *testing.T) {
 		GenerationHorizonDays: 14,
 	}
 
-	ctx := context.Background()
+	ctx := internalContext()
 	now := time.Now().UTC()
 
 	created, err := service.CreateRecurringTemplate(ctx, template)
@@ -323,7 +277,7 @@ func TestCreateRecurringTemplate_ReturnsUpdatedGeneratedThrough(t *testing.T) {
 		GenerationHorizonDays: 14,
 	}
 
-	ctx := context.Background()
+	ctx := internalContext()
 	now := time.Now().UTC()
 
 	created, err := service.CreateRecurringTemplate(ctx, template)
@@ -367,7 +321,7 @@ func TestCreateRecurringTemplate_AsyncJobScheduling(t *testing.T) {
 		GenerationHorizonDays: 365,
 	}
 
-	ctx := context.Background()
+	ctx := internalContext()
 	now := time.Now().UTC()
 
 	created, err := service.CreateRecurringTemplate(ctx, template)
@@ -445,7 +399,7 @@ func TestCreateRecurringTemplate_TransactionRollback(t *testing.T) {
 				GenerationHorizonDays: 365,
 			}
 
-			ctx := context.Background()
+			ctx := internalContext()
 			_, err := service.CreateRecurringTemplate(ctx, template)
 
 			// Should return an error
@@ -500,7 +454,7 @@ func TestUpdateRecurringTemplate_Regeneration(t *testing.T) {
 		SyncHorizonDays:   &newSyncHorizon,
 	}
 
-	ctx := context.Background()
+	ctx := internalContext()
 	updated, err := service.UpdateRecurringTemplate(ctx, params)
 	require.NoError(t, err)
 	require.NotNil(t, updated)
@@ -577,7 +531,7 @@ func TestUpdateRecurringTemplate_ReturnsUpdatedGeneratedThrough(t *testing.T) {
 		SyncHorizonDays:   &newSyncHorizon,
 	}
 
-	ctx := context.Background()
+	ctx := internalContext()
 	updated, err := service.UpdateRecurringTemplate(ctx, params)
 	require.NoError(t, err)
 	require.NotNil(t, updated)
@@ -630,7 +584,7 @@ func TestUpdateRecurringTemplate_NoRegenerationWhenNoRelevantChanges(t *testing.
 		Title:      ptr.To(newTitle),
 	}
 
-	ctx := context.Background()
+	ctx := internalContext()
 	updated, err := service.UpdateRecurringTemplate(ctx, params)
 	require.NoError(t, err)
 	require.NotNil(t, updated)
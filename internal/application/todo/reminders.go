@@ -0,0 +1,130 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// findListItem loads an item and verifies it belongs to the list.
+func (s *Service) findListItem(ctx context.Context, listID, itemID string) (*domain.TodoItem, error) {
+	if listID == "" {
+		return nil, domain.ErrListNotFound
+	}
+	if itemID == "" {
+		return nil, domain.ErrItemNotFound
+	}
+
+	item, err := s.repo.FindItemByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item.ListID != listID {
+		return nil, domain.ErrItemNotFound
+	}
+	return item, nil
+}
+
+// ListReminders returns the reminders of an item, earliest fire time first.
+func (s *Service) ListReminders(ctx context.Context, listID, itemID string) ([]*domain.Reminder, error) {
+	if _, err := s.findListItem(ctx, listID, itemID); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireListRole(ctx, listID, domain.ListRoleViewer); err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindItemReminders(ctx, itemID)
+}
+
+// CreateReminder adds a reminder to an item.
+// Relative reminders on items without the anchor time are stored but only
+// fire once the item gets a due or start time.
+func (s *Service) CreateReminder(ctx context.Context, listID, itemID string, rule domain.ReminderRule) (*domain.Reminder, error) {
+	if _, err := s.findListItem(ctx, listID, itemID); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireListRole(ctx, listID, domain.ListRoleEditor); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.FindItemReminders(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= domain.MaxRemindersPerItem {
+		return nil, fmt.Errorf("%w: at most %d reminders per item", domain.ErrTooManyReminders, domain.MaxRemindersPerItem)
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate id: %w", err)
+	}
+
+	now := time.Now().UTC()
+	return s.repo.CreateItemReminder(ctx, &domain.Reminder{
+		ID:        id.String(),
+		ItemID:    itemID,
+		Rule:      rule,
+		Status:    domain.ReminderStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+}
+
+// DeleteReminder removes a reminder from an item.
+func (s *Service) DeleteReminder(ctx context.Context, listID, itemID, reminderID string) error {
+	if _, err := s.findListItem(ctx, listID, itemID); err != nil {
+		return err
+	}
+
+	if err := s.requireListRole(ctx, listID, domain.ListRoleEditor); err != nil {
+		return err
+	}
+
+	return s.repo.DeleteItemReminder(ctx, itemID, reminderID)
+}
+
+// ListDeadLetterReminders returns unresolved dead letter reminders of the
+// lists the caller can access, newest first.
+func (s *Service) ListDeadLetterReminders(ctx context.Context, limit int) ([]*domain.DeadLetterReminder, error) {
+	return s.repo.FindDeadLetterReminders(ctx, limit)
+}
+
+// RetryDeadLetterReminder resolves a dead letter reminder as retried and
+// re-arms the reminder for immediate delivery with a fresh retry budget.
+func (s *Service) RetryDeadLetterReminder(ctx context.Context, id string) (*domain.DeadLetterReminder, error) {
+	var deadLetter *domain.DeadLetterReminder
+	err := s.repo.Atomic(ctx, func(repo Repository) error {
+		var err error
+		deadLetter, err = repo.ResolveDeadLetterReminder(ctx, id, "retried", nil)
+		if err != nil {
+			return err
+		}
+		if err := s.requireListRole(ctx, deadLetter.ListID, domain.ListRoleEditor); err != nil {
+			return err
+		}
+		return repo.RearmDeadReminder(ctx, deadLetter.ReminderID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deadLetter, nil
+}
+
+// DiscardDeadLetterReminder resolves a dead letter reminder as discarded.
+// The reminder stays dead.
+func (s *Service) DiscardDeadLetterReminder(ctx context.Context, id string, note *string) error {
+	return s.repo.Atomic(ctx, func(repo Repository) error {
+		deadLetter, err := repo.ResolveDeadLetterReminder(ctx, id, "discarded", note)
+		if err != nil {
+			return err
+		}
+		return s.requireListRole(ctx, deadLetter.ListID, domain.ListRoleEditor)
+	})
+}
@@ -0,0 +1,122 @@
+package todo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockRemindersRepo stores the reminders of items in the shared test list.
+type mockRemindersRepo struct {
+	*mockMembersRepo
+	reminders map[string][]*domain.Reminder
+}
+
+func newMockRemindersRepo() *mockRemindersRepo {
+	return &mockRemindersRepo{
+		mockMembersRepo: newMockMembersRepo(),
+		reminders:       make(map[string][]*domain.Reminder),
+	}
+}
+
+func (m *mockRemindersRepo) FindItemReminders(ctx context.Context, itemID string) ([]*domain.Reminder, error) {
+	return m.reminders[itemID], nil
+}
+
+func (m *mockRemindersRepo) CreateItemReminder(ctx context.Context, reminder *domain.Reminder) (*domain.Reminder, error) {
+	m.reminders[reminder.ItemID] = append(m.reminders[reminder.ItemID], reminder)
+	return reminder, nil
+}
+
+func (m *mockRemindersRepo) DeleteItemReminder(ctx context.Context, itemID, reminderID string) error {
+	for i, reminder := range m.reminders[itemID] {
+		if reminder.ID == reminderID {
+			m.reminders[itemID] = append(m.reminders[itemID][:i], m.reminders[itemID][i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrReminderNotFound
+}
+
+func TestCreateReminder_EnforcesListRole(t *testing.T) {
+	rule := domain.NewAbsoluteReminderRule(time.Now().UTC().Add(time.Hour))
+
+	tests := []struct {
+		name      string
+		principal string
+		wantErr   error
+	}{
+		{"owner", testOwnerID, nil},
+		{"editor", testEditorID, nil},
+		{"viewer", testViewerID, domain.ErrListAccessDenied},
+		{"outsider", testOutsideID, domain.ErrListNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockRemindersRepo()
+			service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+			reminder, err := service.CreateReminder(asPrincipal(tt.principal), testListID, "item-1", rule)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, repo.reminders["item-1"])
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "item-1", reminder.ItemID)
+			assert.Equal(t, domain.ReminderStatusPending, reminder.Status)
+			assert.NotEmpty(t, reminder.ID)
+		})
+	}
+}
+
+func TestCreateReminder_RejectsItemOfOtherList(t *testing.T) {
+	repo := newMockRemindersRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	_, err := service.CreateReminder(asPrincipal(testOwnerID), "other-list", "item-1",
+		domain.NewAbsoluteReminderRule(time.Now().UTC()))
+
+	require.ErrorIs(t, err, domain.ErrItemNotFound)
+}
+
+func TestCreateReminder_LimitsRemindersPerItem(t *testing.T) {
+	repo := newMockRemindersRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+	rule, err := domain.NewRelativeReminderRule("due_at", time.Hour)
+	require.NoError(t, err)
+
+	for i := range domain.MaxRemindersPerItem {
+		_, err := service.CreateReminder(asPrincipal(testOwnerID), testListID, "item-1", rule)
+		require.NoError(t, err, "reminder %d", i)
+	}
+
+	_, err = service.CreateReminder(asPrincipal(testOwnerID), testListID, "item-1", rule)
+	require.ErrorIs(t, err, domain.ErrTooManyReminders)
+	assert.Len(t, repo.reminders["item-1"], domain.MaxRemindersPerItem)
+}
+
+func TestDeleteReminder_ViewerIsDenied(t *testing.T) {
+	repo := newMockRemindersRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	reminder, err := service.CreateReminder(asPrincipal(testOwnerID), testListID, "item-1",
+		domain.NewAbsoluteReminderRule(time.Now().UTC()))
+	require.NoError(t, err)
+
+	err = service.DeleteReminder(asPrincipal(testViewerID), testListID, "item-1", reminder.ID)
+	require.ErrorIs(t, err, domain.ErrListAccessDenied)
+
+	reminders, err := service.ListReminders(asPrincipal(testViewerID), testListID, "item-1")
+	require.NoError(t, err)
+	assert.Len(t, reminders, 1)
+
+	require.NoError(t, service.DeleteReminder(asPrincipal(testEditorID), testListID, "item-1", reminder.ID))
+	assert.Empty(t, repo.reminders["item-1"])
+}
@@ -54,6 +54,24 @@ type Repository interface {
 	// excludedStatuses is provided by service layer based on business rules.
 	FindItems(ctx context.Context, params domain.ListTasksParams, excludedStatuses []domain.TaskStatus) (*domain.PagedResult, error)
 
+	// FindAgendaItems returns the candidate items of an agenda across every list
+	// the principal in the context can access: open items due before query.DueBefore,
+	// or occurring or starting in the scheduled window, earliest first, at most query.Limit.
+	// Items snoozed past now are left out.
+	FindAgendaItems(ctx context.Context, query domain.AgendaQuery) ([]domain.TodoItem, error)
+
+	// FindStatsItems returns the items of query.ListID that can count towards stats
+	// of the query range: created before its end, and open or updated since its
+	// start. Oldest first, at most limit.
+	FindStatsItems(ctx context.Context, query domain.StatsQuery, limit int) ([]domain.TodoItem, error)
+
+	// FindListSnapshots returns the recorded snapshots of a list from first through last, oldest first.
+	FindListSnapshots(ctx context.Context, listID string, first, last time.Time) ([]domain.ListSnapshot, error)
+
+	// FindTimesheetPeriods returns the periods of time tracked on items of the
+	// caller's lists that overlap [start, end), by item and start, at most limit.
+	FindTimesheetPeriods(ctx context.Context, start, end time.Time, limit int) ([]domain.TimesheetPeriod, error)
+
 	// DeleteItem deletes a todo item.
 	// Returns domain.ErrItemNotFound if item doesn't exist.
 	DeleteItem(ctx context.Context, id string) error
@@ -93,6 +111,283 @@ type Repository interface {
 	// Returns domain.ErrExceptionNotFound if no exception exists for this occurrence.
 	FindExceptionByOccurrence(ctx context.Context, templateID string, occursAt time.Time) (*domain.RecurringTemplateException, error)
 
+	// ListAllExceptionsByTemplate returns all exceptions of a template, earliest occurrence first.
+	ListAllExceptionsByTemplate(ctx context.Context, templateID string) ([]*domain.RecurringTemplateException, error)
+
+	// === List Membership Operations ===
+
+	// FindListRole returns the role of a principal on a list: owner for the list owner,
+	// the membership role for principals the list is shared with.
+	// Returns domain.ErrListNotFound if list doesn't exist or the principal has no access.
+	FindListRole(ctx context.Context, listID, principalID string) (domain.ListRole, error)
+
+	// FindListMembers lists the principals a list is shared with, oldest first.
+	// The owner is not included.
+	FindListMembers(ctx context.Context, listID string) ([]*domain.ListMember, error)
+
+	// CreateListMember shares a list with a principal.
+	// Returns domain.ErrListNotFound if list doesn't exist.
+	// Returns domain.ErrMemberAlreadyExists if the principal is already a member.
+	CreateListMember(ctx context.Context, member *domain.ListMember) (*domain.ListMember, error)
+
+	// UpdateListMember changes the role of a member.
+	// Returns domain.ErrMemberNotFound if the principal is not a member of the list.
+	UpdateListMember(ctx context.Context, member *domain.ListMember) (*domain.ListMember, error)
+
+	// DeleteListMember revokes the access of a member.
+	// Returns domain.ErrMemberNotFound if the principal is not a member of the list.
+	DeleteListMember(ctx context.Context, listID, principalID string) error
+
+	// === Reminder Operations ===
+
+	// FindItemReminders lists the reminders of an item, earliest fire time first.
+	FindItemReminders(ctx context.Context, itemID string) ([]*domain.Reminder, error)
+
+	// CreateItemReminder adds a reminder to an item.
+	// The fire time is resolved from the item's current times by the persistence layer.
+	// Returns domain.ErrItemNotFound if item doesn't exist.
+	CreateItemReminder(ctx context.Context, reminder *domain.Reminder) (*domain.Reminder, error)
+
+	// DeleteItemReminder removes a reminder from an item.
+	// Returns domain.ErrReminderNotFound if the reminder doesn't belong to the item.
+	DeleteItemReminder(ctx context.Context, itemID, reminderID string) error
+
+	// FindDeadLetterReminders lists unresolved dead letter reminders, newest first.
+	FindDeadLetterReminders(ctx context.Context, limit int) ([]*domain.DeadLetterReminder, error)
+
+	// ResolveDeadLetterReminder marks an unresolved dead letter reminder as
+	// "retried" or "discarded".
+	// Returns domain.ErrDeadLetterReminderNotFound if it doesn't exist or is resolved.
+	ResolveDeadLetterReminder(ctx context.Context, id, resolution string, note *string) (*domain.DeadLetterReminder, error)
+
+	// RearmDeadReminder releases a dead reminder for immediate delivery.
+	// Reminders that are no longer dead (e.g. rescheduled with their item) are left as is.
+	RearmDeadReminder(ctx context.Context, reminderID string) error
+
+	// === Time Entry Operations ===
+
+	// FindTimeEntries lists the time entries of an item, earliest start first.
+	FindTimeEntries(ctx context.Context, itemID string) ([]*domain.TimeEntry, error)
+
+	// FindRunningTimeEntry returns the running timer of a principal.
+	// Returns domain.ErrNoRunningTimer if none is running.
+	FindRunningTimeEntry(ctx context.Context, principalID string) (*domain.TimeEntry, error)
+
+	// CreateTimeEntry adds a time entry to an item; the list is taken from the item.
+	// Returns domain.ErrItemNotFound if item doesn't exist, and
+	// domain.ErrTimerAlreadyRunning for a second running timer of the principal.
+	CreateTimeEntry(ctx context.Context, entry *domain.TimeEntry) (*domain.TimeEntry, error)
+
+	// StopRunningTimeEntry ends the running timer of a principal at endedAt,
+	// appending notes to the entry. An empty itemID stops the timer on any item.
+	// Returns domain.ErrNoRunningTimer if no matching timer is running.
+	StopRunningTimeEntry(ctx context.Context, principalID, itemID string, endedAt time.Time, notes string) (*domain.TimeEntry, error)
+
+	// DeleteTimeEntry removes a time entry from an item. A non-empty principalID
+	// restricts the deletion to entries of that principal.
+	// Returns domain.ErrTimeEntryNotFound if no such entry exists.
+	DeleteTimeEntry(ctx context.Context, itemID, entryID, principalID string) error
+
+	// === Webhook Operations ===
+	// Subscriptions are scoped to the tenant of the principal in the context.
+
+	// CreateWebhookSubscription stores a new webhook subscription.
+	CreateWebhookSubscription(ctx context.Context, sub *domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+
+	// FindWebhookSubscriptionByID retrieves a webhook subscription.
+	// Returns domain.ErrWebhookNotFound if it doesn't exist.
+	FindWebhookSubscriptionByID(ctx context.Context, id string) (*domain.WebhookSubscription, error)
+
+	// FindWebhookSubscriptions lists webhook subscriptions, oldest first.
+	FindWebhookSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error)
+
+	// UpdateWebhookSubscription replaces the URL, secret, events and active flag of a subscription.
+	// Returns domain.ErrWebhookNotFound if it doesn't exist.
+	UpdateWebhookSubscription(ctx context.Context, sub *domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+
+	// DeleteWebhookSubscription removes a subscription together with its deliveries.
+	// Returns domain.ErrWebhookNotFound if it doesn't exist.
+	DeleteWebhookSubscription(ctx context.Context, id string) error
+
+	// FindWebhookDeliveries lists the most recent deliveries of a subscription, newest first.
+	// A nil status returns deliveries in any state.
+	FindWebhookDeliveries(ctx context.Context, subscriptionID string, status *domain.WebhookDeliveryStatus, limit int) ([]*domain.WebhookDelivery, error)
+
+	// RedeliverWebhookDelivery queues a new delivery with the same event payload.
+	// Returns domain.ErrWebhookDeliveryNotFound if the delivery is not part of the subscription.
+	RedeliverWebhookDelivery(ctx context.Context, subscriptionID, deliveryID string) (*domain.WebhookDelivery, error)
+
+	// === Saved View Operations ===
+	// Views are scoped to the tenant of the principal in the context.
+
+	// CreateSavedView stores a new saved view.
+	// Returns domain.ErrListNotFound if the view's list doesn't exist.
+	CreateSavedView(ctx context.Context, view *domain.SavedView) (*domain.SavedView, error)
+
+	// FindSavedViewByID retrieves a saved view.
+	// Returns domain.ErrSavedViewNotFound if it doesn't exist.
+	FindSavedViewByID(ctx context.Context, id string) (*domain.SavedView, error)
+
+	// FindSavedViews lists saved views, oldest first.
+	FindSavedViews(ctx context.Context) ([]*domain.SavedView, error)
+
+	// UpdateSavedView replaces the name, list and definition of a saved view.
+	// Returns domain.ErrSavedViewNotFound if it doesn't exist.
+	UpdateSavedView(ctx context.Context, view *domain.SavedView) (*domain.SavedView, error)
+
+	// DeleteSavedView removes a saved view.
+	// Returns domain.ErrSavedViewNotFound if it doesn't exist.
+	DeleteSavedView(ctx context.Context, id string) error
+
+	// === Automation Rule Operations ===
+	// Rules are not scoped to a tenant; the service checks access to their list.
+
+	// CreateAutomationRule stores a new rule.
+	// Returns domain.ErrListNotFound if the rule's list doesn't exist.
+	CreateAutomationRule(ctx context.Context, rule *domain.AutomationRule) (*domain.AutomationRule, error)
+
+	// FindAutomationRuleByID retrieves a rule of a list.
+	// Returns domain.ErrRuleNotFound if it doesn't exist.
+	FindAutomationRuleByID(ctx context.Context, listID, id string) (*domain.AutomationRule, error)
+
+	// FindAutomationRules lists the rules of a list, oldest first.
+	FindAutomationRules(ctx context.Context, listID string) ([]*domain.AutomationRule, error)
+
+	// FindEnabledAutomationRules lists the enabled rules of a list with a trigger,
+	// in the order they run (oldest first).
+	FindEnabledAutomationRules(ctx context.Context, listID string, trigger domain.RuleTriggerType) ([]*domain.AutomationRule, error)
+
+	// CountAutomationRules returns the number of rules of a list.
+	CountAutomationRules(ctx context.Context, listID string) (int, error)
+
+	// UpdateAutomationRule replaces the name, enabled flag, trigger, condition and actions of a rule.
+	// Returns domain.ErrRuleNotFound if it doesn't exist.
+	UpdateAutomationRule(ctx context.Context, rule *domain.AutomationRule) (*domain.AutomationRule, error)
+
+	// DeleteAutomationRule removes a rule of a list together with its execution log.
+	// Returns domain.ErrRuleNotFound if it doesn't exist.
+	DeleteAutomationRule(ctx context.Context, listID, id string) error
+
+	// CreateRuleRun appends a run to the execution log of a rule.
+	CreateRuleRun(ctx context.Context, run *domain.RuleRun) error
+
+	// FindRuleRuns lists the most recent runs of a rule, newest first.
+	FindRuleRuns(ctx context.Context, ruleID string, limit int) ([]*domain.RuleRun, error)
+
+	// FindOverdueRuleCandidates returns up to limit open items that are overdue
+	// for an enabled overdue rule at now and that the rule has not fired for at
+	// their current due date, earliest due date first.
+	FindOverdueRuleCandidates(ctx context.Context, now time.Time, limit int) ([]domain.OverdueRuleCandidate, error)
+
+	// RecordOverdueRuleFire remembers that an overdue rule fired for an item at
+	// a due date. Returns false if it had already fired.
+	RecordOverdueRuleFire(ctx context.Context, ruleID, itemID string, dueAt, firedAt time.Time) (bool, error)
+
+	// === Tag Operations ===
+	// Registered tags are not scoped to a tenant; the service checks access to their list.
+
+	// FindTagUsage lists the tags registered on a list, by name, with how many
+	// of its items and recurring templates use each.
+	FindTagUsage(ctx context.Context, listID string) ([]*domain.TagUsage, error)
+
+	// FindTag retrieves a registered tag of a list.
+	// Returns domain.ErrTagNotFound if the tag isn't registered.
+	FindTag(ctx context.Context, listID, name string) (*domain.Tag, error)
+
+	// CreateTag registers a tag on a list.
+	// Returns domain.ErrTagAlreadyExists if it is registered already,
+	// and domain.ErrListNotFound if the list doesn't exist.
+	CreateTag(ctx context.Context, tag *domain.Tag) (*domain.Tag, error)
+
+	// UpdateTag replaces the color and description of a registered tag.
+	// Returns domain.ErrTagNotFound if the tag isn't registered.
+	UpdateTag(ctx context.Context, tag *domain.Tag) (*domain.Tag, error)
+
+	// RenameTagRegistration moves the registration of a tag to a new name.
+	// Returns false if the tag isn't registered.
+	RenameTagRegistration(ctx context.Context, listID, name, newName string, updatedAt time.Time) (bool, error)
+
+	// DeleteTags removes the registrations of tags of a list. Items and templates
+	// keep using the tags. Returns the number of registrations removed.
+	DeleteTags(ctx context.Context, listID string, names []string) (int, error)
+
+	// TagInUse reports whether a tag is registered on a list or used by its
+	// items or recurring templates.
+	TagInUse(ctx context.Context, listID, name string) (bool, error)
+
+	// ReplaceTags replaces the source tags of every item and recurring template
+	// of a list with the target tag, dropping the duplicates this creates.
+	ReplaceTags(ctx context.Context, listID string, sources []string, target string) (domain.TagRewrite, error)
+
+	// MoveItem moves an item, with its time entries, from one list to another.
+	// Returns domain.ErrItemNotFound if the item is not in fromListID, and
+	// domain.ErrListNotFound if toListID doesn't exist.
+	MoveItem(ctx context.Context, itemID, fromListID, toListID string) (*domain.TodoItem, error)
+
+	// EnqueueWebhookEvent queues a delivery of an event to a single active
+	// subscription, whatever events it subscribes to, and returns its ID.
+	// Returns domain.ErrWebhookNotFound if no such active subscription exists.
+	EnqueueWebhookEvent(ctx context.Context, subscriptionID string, event *domain.Event) (string, error)
+
+	// === Calendar Feed Operations ===
+	// Feeds are scoped to the tenant of the principal in the context.
+
+	// CreateCalendarFeed stores a new calendar feed.
+	// Returns domain.ErrListNotFound if the feed's list doesn't exist.
+	CreateCalendarFeed(ctx context.Context, feed *domain.CalendarFeed) (*domain.CalendarFeed, error)
+
+	// FindCalendarFeedByTokenHash retrieves the feed a token belongs to, whatever its tenant.
+	// Returns domain.ErrCalendarFeedNotFound if no feed has the token.
+	FindCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*domain.CalendarFeed, error)
+
+	// FindCalendarFeeds lists the feeds of a list, oldest first.
+	FindCalendarFeeds(ctx context.Context, listID string) ([]*domain.CalendarFeed, error)
+
+	// DeleteCalendarFeed removes a feed of a list.
+	// Returns domain.ErrCalendarFeedNotFound if it doesn't exist.
+	DeleteCalendarFeed(ctx context.Context, listID, id string) error
+
+	// === Import Record Operations ===
+
+	// CreateImportRecord remembers that an external ID was imported into a list.
+	// Returns domain.ErrAlreadyImported if the list already has a record for it.
+	CreateImportRecord(ctx context.Context, record *domain.ImportRecord) error
+
+	// FindImportRecords returns the records of the external IDs already imported into a list.
+	FindImportRecords(ctx context.Context, listID string, externalIDs []string) ([]*domain.ImportRecord, error)
+
+	// === Backup Operations ===
+
+	// FindStatusHistory returns the status history of items, oldest change first per item.
+	FindStatusHistory(ctx context.Context, itemIDs []string) ([]*domain.StatusChange, error)
+
+	// RestoreStatusHistory replaces the status history of the items the changes
+	// belong to with the changes, e.g. the history recorded when they were inserted.
+	RestoreStatusHistory(ctx context.Context, changes []domain.StatusChange) error
+
+	// FindBackupConflicts returns the list, template and item IDs of a backup that
+	// are already taken, in any tenant. IDs taken by data the principal in the
+	// context cannot see are reported as domain.BackupConflictUnavailable.
+	FindBackupConflicts(ctx context.Context, backup *domain.ListBackup) ([]domain.BackupConflict, error)
+
+	// === Change Feed Operations ===
+
+	// FindChanges returns change log rows matching the query, in (TxID, Seq) order.
+	// Only changes of transactions that finished before the call are returned,
+	// so no change can later appear before the last one returned.
+	// Only changes of lists the principal in the context can access are returned.
+	FindChanges(ctx context.Context, query domain.ChangeFeedQuery) ([]*domain.Change, error)
+
+	// FindChangeWatermark returns the ID of the oldest running transaction.
+	// Every change of an older transaction has committed or rolled back.
+	FindChangeWatermark(ctx context.Context) (uint64, error)
+
+	// FindSyncPage returns the lists, items and templates changed after the cursor,
+	// in their current state, with tombstones for deleted ones.
+	// At most limit changes are read; the page's cursor continues after them.
+	// Only lists the principal in the context can access are included.
+	FindSyncPage(ctx context.Context, after domain.SyncCursor, limit int) (*domain.SyncPage, error)
+
 	// === Atomic Operations ===
 
 	// Atomic executes a callback function within a database transaction.
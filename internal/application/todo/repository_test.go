@@ -0,0 +1,365 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// unimplementedRepository implements Repository with methods that panic.
+// Test mocks embed it and implement only the methods their tests use, so a
+// test reaching any other method fails loudly.
+type unimplementedRepository struct{}
+
+func (unimplementedRepository) CreateList(ctx context.Context, list *domain.TodoList) (*domain.TodoList, error) {
+	panic("CreateList not implemented")
+}
+
+func (unimplementedRepository) FindListByID(ctx context.Context, id string) (*domain.TodoList, error) {
+	panic("FindListByID not implemented")
+}
+
+func (unimplementedRepository) FindLists(ctx context.Context, params domain.ListListsParams) (*domain.PagedListResult, error) {
+	panic("FindLists not implemented")
+}
+
+func (unimplementedRepository) UpdateList(ctx context.Context, params domain.UpdateListParams) (*domain.TodoList, error) {
+	panic("UpdateList not implemented")
+}
+
+func (unimplementedRepository) CreateItem(ctx context.Context, listID string, item *domain.TodoItem) (*domain.TodoItem, error) {
+	panic("CreateItem not implemented")
+}
+
+func (unimplementedRepository) FindItemByID(ctx context.Context, id string) (*domain.TodoItem, error) {
+	panic("FindItemByID not implemented")
+}
+
+func (unimplementedRepository) UpdateItem(ctx context.Context, params domain.UpdateItemParams) (*domain.TodoItem, error) {
+	panic("UpdateItem not implemented")
+}
+
+func (unimplementedRepository) FindItems(ctx context.Context, params domain.ListTasksParams, excludedStatuses []domain.TaskStatus) (*domain.PagedResult, error) {
+	panic("FindItems not implemented")
+}
+
+func (unimplementedRepository) DeleteItem(ctx context.Context, id string) error {
+	panic("DeleteItem not implemented")
+}
+
+func (unimplementedRepository) CreateRecurringTemplate(ctx context.Context, template *domain.RecurringTemplate) (*domain.RecurringTemplate, error) {
+	panic("CreateRecurringTemplate not implemented")
+}
+
+func (unimplementedRepository) FindRecurringTemplateByID(ctx context.Context, id string) (*domain.RecurringTemplate, error) {
+	panic("FindRecurringTemplateByID not implemented")
+}
+
+func (unimplementedRepository) UpdateRecurringTemplate(ctx context.Context, params domain.UpdateRecurringTemplateParams) (*domain.RecurringTemplate, error) {
+	panic("UpdateRecurringTemplate not implemented")
+}
+
+func (unimplementedRepository) DeleteRecurringTemplate(ctx context.Context, id string) error {
+	panic("DeleteRecurringTemplate not implemented")
+}
+
+func (unimplementedRepository) FindRecurringTemplates(ctx context.Context, listID string, activeOnly bool) ([]*domain.RecurringTemplate, error) {
+	panic("FindRecurringTemplates not implemented")
+}
+
+func (unimplementedRepository) CreateException(ctx context.Context, exception *domain.RecurringTemplateException) (*domain.RecurringTemplateException, error) {
+	panic("CreateException not implemented")
+}
+
+func (unimplementedRepository) FindExceptionByOccurrence(ctx context.Context, templateID string, occursAt time.Time) (*domain.RecurringTemplateException, error) {
+	panic("FindExceptionByOccurrence not implemented")
+}
+
+func (unimplementedRepository) FindListRole(ctx context.Context, listID, principalID string) (domain.ListRole, error) {
+	panic("FindListRole not implemented")
+}
+
+func (unimplementedRepository) FindListMembers(ctx context.Context, listID string) ([]*domain.ListMember, error) {
+	panic("FindListMembers not implemented")
+}
+
+func (unimplementedRepository) CreateListMember(ctx context.Context, member *domain.ListMember) (*domain.ListMember, error) {
+	panic("CreateListMember not implemented")
+}
+
+func (unimplementedRepository) UpdateListMember(ctx context.Context, member *domain.ListMember) (*domain.ListMember, error) {
+	panic("UpdateListMember not implemented")
+}
+
+func (unimplementedRepository) DeleteListMember(ctx context.Context, listID, principalID string) error {
+	panic("DeleteListMember not implemented")
+}
+
+func (unimplementedRepository) FindItemReminders(ctx context.Context, itemID string) ([]*domain.Reminder, error) {
+	panic("FindItemReminders not implemented")
+}
+
+func (unimplementedRepository) CreateItemReminder(ctx context.Context, reminder *domain.Reminder) (*domain.Reminder, error) {
+	panic("CreateItemReminder not implemented")
+}
+
+func (unimplementedRepository) DeleteItemReminder(ctx context.Context, itemID, reminderID string) error {
+	panic("DeleteItemReminder not implemented")
+}
+
+func (unimplementedRepository) CreateWebhookSubscription(ctx context.Context, sub *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	panic("CreateWebhookSubscription not implemented")
+}
+
+func (unimplementedRepository) FindWebhookSubscriptionByID(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	panic("FindWebhookSubscriptionByID not implemented")
+}
+
+func (unimplementedRepository) FindWebhookSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	panic("FindWebhookSubscriptions not implemented")
+}
+
+func (unimplementedRepository) UpdateWebhookSubscription(ctx context.Context, sub *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	panic("UpdateWebhookSubscription not implemented")
+}
+
+func (unimplementedRepository) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	panic("DeleteWebhookSubscription not implemented")
+}
+
+func (unimplementedRepository) FindWebhookDeliveries(ctx context.Context, subscriptionID string, status *domain.WebhookDeliveryStatus, limit int) ([]*domain.WebhookDelivery, error) {
+	panic("FindWebhookDeliveries not implemented")
+}
+
+func (unimplementedRepository) RedeliverWebhookDelivery(ctx context.Context, subscriptionID, deliveryID string) (*domain.WebhookDelivery, error) {
+	panic("RedeliverWebhookDelivery not implemented")
+}
+
+func (unimplementedRepository) Atomic(ctx context.Context, fn func(tx Repository) error) error {
+	panic("Atomic not implemented")
+}
+
+func (unimplementedRepository) AtomicRecurring(ctx context.Context, fn func(ops RecurringOperations) error) error {
+	panic("AtomicRecurring not implemented")
+}
+
+func (unimplementedRepository) BatchInsertItemsIgnoreConflict(ctx context.Context, items []*domain.TodoItem) (int, error) {
+	panic("BatchInsertItemsIgnoreConflict not implemented")
+}
+
+func (unimplementedRepository) DeleteFuturePendingItems(ctx context.Context, templateID string, from time.Time) (int64, error) {
+	panic("DeleteFuturePendingItems not implemented")
+}
+
+func (unimplementedRepository) SetGeneratedThrough(ctx context.Context, templateID string, generatedThrough time.Time) error {
+	panic("SetGeneratedThrough not implemented")
+}
+
+func (unimplementedRepository) ScheduleGenerationJob(ctx context.Context, templateID string, scheduledFor, from, until time.Time) (string, error) {
+	panic("ScheduleGenerationJob not implemented")
+}
+
+func (unimplementedRepository) FindChanges(ctx context.Context, query domain.ChangeFeedQuery) ([]*domain.Change, error) {
+	panic("FindChanges not implemented")
+}
+
+func (unimplementedRepository) FindChangeWatermark(ctx context.Context) (uint64, error) {
+	panic("FindChangeWatermark not implemented")
+}
+
+func (unimplementedRepository) FindSyncPage(ctx context.Context, after domain.SyncCursor, limit int) (*domain.SyncPage, error) {
+	panic("FindSyncPage not implemented")
+}
+
+func (unimplementedRepository) CreateSavedView(ctx context.Context, view *domain.SavedView) (*domain.SavedView, error) {
+	panic("CreateSavedView not implemented")
+}
+
+func (unimplementedRepository) FindSavedViewByID(ctx context.Context, id string) (*domain.SavedView, error) {
+	panic("FindSavedViewByID not implemented")
+}
+
+func (unimplementedRepository) FindSavedViews(ctx context.Context) ([]*domain.SavedView, error) {
+	panic("FindSavedViews not implemented")
+}
+
+func (unimplementedRepository) UpdateSavedView(ctx context.Context, view *domain.SavedView) (*domain.SavedView, error) {
+	panic("UpdateSavedView not implemented")
+}
+
+func (unimplementedRepository) DeleteSavedView(ctx context.Context, id string) error {
+	panic("DeleteSavedView not implemented")
+}
+
+func (unimplementedRepository) FindAgendaItems(ctx context.Context, query domain.AgendaQuery) ([]domain.TodoItem, error) {
+	panic("FindAgendaItems not implemented")
+}
+
+func (unimplementedRepository) CreateCalendarFeed(ctx context.Context, feed *domain.CalendarFeed) (*domain.CalendarFeed, error) {
+	panic("CreateCalendarFeed not implemented")
+}
+
+func (unimplementedRepository) FindCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*domain.CalendarFeed, error) {
+	panic("FindCalendarFeedByTokenHash not implemented")
+}
+
+func (unimplementedRepository) FindCalendarFeeds(ctx context.Context, listID string) ([]*domain.CalendarFeed, error) {
+	panic("FindCalendarFeeds not implemented")
+}
+
+func (unimplementedRepository) DeleteCalendarFeed(ctx context.Context, listID, id string) error {
+	panic("DeleteCalendarFeed not implemented")
+}
+
+func (unimplementedRepository) CreateImportRecord(ctx context.Context, record *domain.ImportRecord) error {
+	panic("CreateImportRecord not implemented")
+}
+
+func (unimplementedRepository) FindImportRecords(ctx context.Context, listID string, externalIDs []string) ([]*domain.ImportRecord, error) {
+	panic("FindImportRecords not implemented")
+}
+
+func (unimplementedRepository) ListAllExceptionsByTemplate(ctx context.Context, templateID string) ([]*domain.RecurringTemplateException, error) {
+	panic("ListAllExceptionsByTemplate not implemented")
+}
+
+func (unimplementedRepository) FindStatusHistory(ctx context.Context, itemIDs []string) ([]*domain.StatusChange, error) {
+	panic("FindStatusHistory not implemented")
+}
+
+func (unimplementedRepository) RestoreStatusHistory(ctx context.Context, changes []domain.StatusChange) error {
+	panic("RestoreStatusHistory not implemented")
+}
+
+func (unimplementedRepository) FindBackupConflicts(ctx context.Context, backup *domain.ListBackup) ([]domain.BackupConflict, error) {
+	panic("FindBackupConflicts not implemented")
+}
+
+func (unimplementedRepository) FindStatsItems(ctx context.Context, query domain.StatsQuery, limit int) ([]domain.TodoItem, error) {
+	panic("FindStatsItems not implemented")
+}
+
+func (unimplementedRepository) FindListSnapshots(ctx context.Context, listID string, first, last time.Time) ([]domain.ListSnapshot, error) {
+	panic("FindListSnapshots not implemented")
+}
+
+func (unimplementedRepository) FindTimeEntries(ctx context.Context, itemID string) ([]*domain.TimeEntry, error) {
+	panic("FindTimeEntries not implemented")
+}
+
+func (unimplementedRepository) FindRunningTimeEntry(ctx context.Context, principalID string) (*domain.TimeEntry, error) {
+	panic("FindRunningTimeEntry not implemented")
+}
+
+func (unimplementedRepository) CreateTimeEntry(ctx context.Context, entry *domain.TimeEntry) (*domain.TimeEntry, error) {
+	panic("CreateTimeEntry not implemented")
+}
+
+func (unimplementedRepository) StopRunningTimeEntry(ctx context.Context, principalID, itemID string, endedAt time.Time, notes string) (*domain.TimeEntry, error) {
+	panic("StopRunningTimeEntry not implemented")
+}
+
+func (unimplementedRepository) DeleteTimeEntry(ctx context.Context, itemID, entryID, principalID string) error {
+	panic("DeleteTimeEntry not implemented")
+}
+
+func (unimplementedRepository) FindTimesheetPeriods(ctx context.Context, start, end time.Time, limit int) ([]domain.TimesheetPeriod, error) {
+	panic("FindTimesheetPeriods not implemented")
+}
+
+func (unimplementedRepository) CreateAutomationRule(ctx context.Context, rule *domain.AutomationRule) (*domain.AutomationRule, error) {
+	panic("CreateAutomationRule not implemented")
+}
+
+func (unimplementedRepository) FindAutomationRuleByID(ctx context.Context, listID, id string) (*domain.AutomationRule, error) {
+	panic("FindAutomationRuleByID not implemented")
+}
+
+func (unimplementedRepository) FindAutomationRules(ctx context.Context, listID string) ([]*domain.AutomationRule, error) {
+	panic("FindAutomationRules not implemented")
+}
+
+func (unimplementedRepository) FindEnabledAutomationRules(ctx context.Context, listID string, trigger domain.RuleTriggerType) ([]*domain.AutomationRule, error) {
+	panic("FindEnabledAutomationRules not implemented")
+}
+
+func (unimplementedRepository) CountAutomationRules(ctx context.Context, listID string) (int, error) {
+	panic("CountAutomationRules not implemented")
+}
+
+func (unimplementedRepository) UpdateAutomationRule(ctx context.Context, rule *domain.AutomationRule) (*domain.AutomationRule, error) {
+	panic("UpdateAutomationRule not implemented")
+}
+
+func (unimplementedRepository) DeleteAutomationRule(ctx context.Context, listID, id string) error {
+	panic("DeleteAutomationRule not implemented")
+}
+
+func (unimplementedRepository) CreateRuleRun(ctx context.Context, run *domain.RuleRun) error {
+	panic("CreateRuleRun not implemented")
+}
+
+func (unimplementedRepository) FindRuleRuns(ctx context.Context, ruleID string, limit int) ([]*domain.RuleRun, error) {
+	panic("FindRuleRuns not implemented")
+}
+
+func (unimplementedRepository) FindOverdueRuleCandidates(ctx context.Context, now time.Time, limit int) ([]domain.OverdueRuleCandidate, error) {
+	panic("FindOverdueRuleCandidates not implemented")
+}
+
+func (unimplementedRepository) RecordOverdueRuleFire(ctx context.Context, ruleID, itemID string, dueAt, firedAt time.Time) (bool, error) {
+	panic("RecordOverdueRuleFire not implemented")
+}
+
+func (unimplementedRepository) MoveItem(ctx context.Context, itemID, fromListID, toListID string) (*domain.TodoItem, error) {
+	panic("MoveItem not implemented")
+}
+
+func (unimplementedRepository) EnqueueWebhookEvent(ctx context.Context, subscriptionID string, event *domain.Event) (string, error) {
+	panic("EnqueueWebhookEvent not implemented")
+}
+
+func (unimplementedRepository) FindTagUsage(ctx context.Context, listID string) ([]*domain.TagUsage, error) {
+	panic("FindTagUsage not implemented")
+}
+
+func (unimplementedRepository) FindTag(ctx context.Context, listID, name string) (*domain.Tag, error) {
+	panic("FindTag not implemented")
+}
+
+func (unimplementedRepository) CreateTag(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
+	panic("CreateTag not implemented")
+}
+
+func (unimplementedRepository) UpdateTag(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
+	panic("UpdateTag not implemented")
+}
+
+func (unimplementedRepository) RenameTagRegistration(ctx context.Context, listID, name, newName string, updatedAt time.Time) (bool, error) {
+	panic("RenameTagRegistration not implemented")
+}
+
+func (unimplementedRepository) DeleteTags(ctx context.Context, listID string, names []string) (int, error) {
+	panic("DeleteTags not implemented")
+}
+
+func (unimplementedRepository) TagInUse(ctx context.Context, listID, name string) (bool, error) {
+	panic("TagInUse not implemented")
+}
+
+func (unimplementedRepository) ReplaceTags(ctx context.Context, listID string, sources []string, target string) (domain.TagRewrite, error) {
+	panic("ReplaceTags not implemented")
+}
+
+func (unimplementedRepository) FindDeadLetterReminders(ctx context.Context, limit int) ([]*domain.DeadLetterReminder, error) {
+	panic("FindDeadLetterReminders not implemented")
+}
+
+func (unimplementedRepository) ResolveDeadLetterReminder(ctx context.Context, id, resolution string, note *string) (*domain.DeadLetterReminder, error) {
+	panic("ResolveDeadLetterReminder not implemented")
+}
+
+func (unimplementedRepository) RearmDeadReminder(ctx context.Context, reminderID string) error {
+	panic("RearmDeadReminder not implemented")
+}
@@ -32,6 +32,7 @@ var (
 		domain.FieldItemTags,
 		domain.FieldItemPriority,
 		domain.FieldItemEstimatedDuration,
+		domain.FieldItemCustomFields,
 		domain.FieldDueAt,
 		domain.FieldStartsAt,
 		domain.FieldOccursAt,
@@ -48,6 +49,13 @@ const (
 type Config struct {
 	DefaultPageSize int
 	MaxPageSize     int
+
+	// Changes announces new change log rows to event streams.
+	// Optional: when nil, streams poll for changes every ChangePollInterval.
+	Changes ChangeNotifier
+
+	// ChangePollInterval is how often event streams check for missed changes (default: 5s)
+	ChangePollInterval time.Duration
 }
 
 // TaskGenerator generates recurring task instances from templates.
@@ -74,6 +82,9 @@ func NewService(repo Repository, generator TaskGenerator, config Config) *Servic
 	if config.MaxPageSize <= 0 {
 		config.MaxPageSize = MaxPageSize
 	}
+	if config.ChangePollInterval <= 0 {
+		config.ChangePollInterval = DefaultChangePollInterval
+	}
 
 	return &Service{
 		repo:      repo,
@@ -82,25 +93,43 @@ func NewService(repo Repository, generator TaskGenerator, config Config) *Servic
 	}
 }
 
-// CreateList creates a new todo list.
+// CreateList creates a new todo list without custom fields.
 func (s *Service) CreateList(ctx context.Context, titleStr string) (*domain.TodoList, error) {
+	return s.CreateListWithCustomFields(ctx, titleStr, nil)
+}
+
+// CreateListWithCustomFields creates a new todo list that declares typed custom fields.
+// Items and recurring templates in the list can only carry values for declared fields.
+func (s *Service) CreateListWithCustomFields(ctx context.Context, titleStr string, customFields []domain.CustomFieldDefinition) (*domain.TodoList, error) {
 	// Validate title using value object
 	title, err := domain.NewTitle(titleStr)
 	if err != nil {
 		return nil, err // Returns domain error (ErrTitleRequired or ErrTitleTooLong)
 	}
 
+	schema, err := domain.NewCustomFieldSchema(customFields)
+	if err != nil {
+		return nil, err
+	}
+
 	idObj, err := uuid.NewV7()
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate id: %w", err)
 	}
 
 	list := &domain.TodoList{
-		ID:          idObj.String(),
-		Title:       title.String(),
-		CreatedAt:   time.Now().UTC(),
-		TotalItems:  0,
-		UndoneItems: 0,
+		ID:                idObj.String(),
+		Title:             title.String(),
+		CreatedAt:         time.Now().UTC(),
+		CustomFieldSchema: schema,
+		TotalItems:        0,
+		UndoneItems:       0,
+	}
+
+	// The list belongs to the tenant of the authenticated caller.
+	// All later access to the list and its contents is scoped to this owner.
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		list.OwnerID = principal.OwnerID
 	}
 
 	// Return the persisted entity from repository (includes version from persistence layer)
@@ -129,11 +158,6 @@ func (s *Service) GetList(ctx context.Context, id string) (*domain.TodoList, err
 // FindLists retrieves todo lists with filtering, sorting, and pagination.
 // Returns summaries with counts only (Items field will be empty).
 func (s *Service) FindLists(ctx context.Context, params domain.ListListsParams) (*domain.PagedListResult, error) {
-	// Reject negative offsets to prevent database errors
-	if params.Offset < 0 {
-		params.Offset = 0
-	}
-
 	// Apply default page size if not specified or invalid
 	if params.Limit <= 0 {
 		params.Limit = s.config.DefaultPageSize
@@ -179,24 +203,84 @@ func (s *Service) UpdateList(ctx context.Context, params domain.UpdateListParams
 		params.Title = ptr.To(title.String())
 	}
 
+	// Validate custom field schema if being updated.
+	// Existing values of removed fields are kept but rejected on the next write.
+	if params.CustomFieldSchema != nil {
+		schema, err := domain.NewCustomFieldSchema(*params.CustomFieldSchema)
+		if err != nil {
+			return nil, err
+		}
+		params.CustomFieldSchema = &schema
+	}
+
+	if err := s.requireListRole(ctx, params.ListID, domain.ListRoleEditor); err != nil {
+		return nil, err
+	}
+
 	return s.repo.UpdateList(ctx, params)
 }
 
+// validateCustomFields checks custom field values against the schema of the list
+// and returns the normalized values. The list is only loaded when values are present.
+func (s *Service) validateCustomFields(ctx context.Context, listID string, values map[string]any) (map[string]any, error) {
+	return validateCustomFields(ctx, s.repo, listID, values)
+}
+
+// validateCustomFields checks custom field values with repo, e.g. the
+// repository of a running transaction.
+func validateCustomFields(ctx context.Context, repo Repository, listID string, values map[string]any) (map[string]any, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	list, err := repo.FindListByID(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	return list.CustomFieldSchema.ValidateValues(values)
+}
+
 // CreateItem creates a new todo item in a list.
 func (s *Service) CreateItem(ctx context.Context, listID string, item *domain.TodoItem) (*domain.TodoItem, error) {
+	if err := prepareNewItem(ctx, s.repo, listID, item); err != nil {
+		return nil, err
+	}
+
+	// Return the persisted entity from repository (includes version from persistence layer),
+	// as the rules of the list left it
+	var createdItem *domain.TodoItem
+	err := s.repo.Atomic(ctx, func(repo Repository) error {
+		item, err := repo.CreateItem(ctx, listID, item)
+		if err != nil {
+			return fmt.Errorf("failed to create item: %w", err)
+		}
+
+		createdItem, err = s.runRules(ctx, repo, item, domain.RuleEvent{Trigger: domain.RuleTriggerItemCreated, Item: item})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return createdItem, nil
+}
+
+// prepareNewItem validates a new item and fills in its ID, timestamps and defaults.
+// Access and custom fields are checked with repo.
+func prepareNewItem(ctx context.Context, repo Repository, listID string, item *domain.TodoItem) error {
 	if listID == "" {
-		return nil, domain.ErrListNotFound
+		return domain.ErrListNotFound
 	}
 
 	// Defensive nil check - handler should never pass nil, but prevent panic
 	if item == nil {
-		return nil, domain.ErrInvalidRequest
+		return domain.ErrInvalidRequest
 	}
 
 	// Validate title using value object
 	title, err := domain.NewTitle(item.Title)
 	if err != nil {
-		return nil, err // Returns domain error (ErrTitleRequired or ErrTitleTooLong)
+		return err // Returns domain error (ErrTitleRequired or ErrTitleTooLong)
 	}
 	item.Title = title.String()
 
@@ -204,7 +288,7 @@ func (s *Service) CreateItem(ctx context.Context, listID string, item *domain.To
 	if item.ID == "" {
 		idObj, err := uuid.NewV7()
 		if err != nil {
-			return nil, fmt.Errorf("failed to generate id: %w", err)
+			return fmt.Errorf("failed to generate id: %w", err)
 		}
 		item.ID = idObj.String()
 	}
@@ -232,23 +316,28 @@ func (s *Service) CreateItem(ctx context.Context, listID string, item *domain.To
 	// Does NOT affect operational times (CreatedAt, UpdatedAt) which are always UTC.
 	if item.Timezone != nil && *item.Timezone != "" {
 		if _, err := time.LoadLocation(*item.Timezone); err != nil {
-			return nil, domain.ErrInvalidTimezone
+			return domain.ErrInvalidTimezone
 		}
 	}
 
 	// Validate recurring instance integrity.
 	// If OccursAt is set, RecurringTemplateID must also be set.
 	if item.OccursAt != nil && item.RecurringTemplateID == nil {
-		return nil, domain.ErrRecurringTaskRequiresTemplate
+		return domain.ErrRecurringTaskRequiresTemplate
 	}
 
-	// Return the persisted entity from repository (includes version from persistence layer)
-	createdItem, err := s.repo.CreateItem(ctx, listID, item)
+	if err := requireListRole(ctx, repo, listID, domain.ListRoleEditor); err != nil {
+		return err
+	}
+
+	// Validate custom field values against the list schema
+	customFields, err := validateCustomFields(ctx, repo, listID, item.CustomFields)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create item: %w", err)
+		return err
 	}
+	item.CustomFields = customFields
 
-	return createdItem, nil
+	return nil
 }
 
 // GetItem retrieves a single todo item by ID.
@@ -334,56 +423,74 @@ func (s *Service) UpdateItem(ctx context.Context, params domain.UpdateItemParams
 		return nil, domain.ErrItemNotFound
 	}
 
-	// Check if this is a recurring item that needs exception handling
-	if existingItem.RecurringTemplateID != nil && existingItem.OccursAt != nil {
-		if shouldCreateException(params.UpdateMask) {
-			// Use atomic operation to update item and create exception together
-			var updatedItem *domain.TodoItem
-			err = s.repo.Atomic(ctx, func(repo Repository) error {
-				item, err := repo.UpdateItem(ctx, params)
-				if err != nil {
-					return err
-				}
-				updatedItem = item
-
-				// Check if exception already exists for this occurrence
-				_, err = repo.FindExceptionByOccurrence(ctx, *existingItem.RecurringTemplateID, *existingItem.OccursAt)
-				if err == nil {
-					// Exception already exists, no need to create another
-					return nil
-				}
-				if !errors.Is(err, domain.ErrExceptionNotFound) {
-					// Unexpected error
-					return err
-				}
-
-				// Exception doesn't exist, create it
-				excID, err := uuid.NewV7()
-				if err != nil {
-					return fmt.Errorf("failed to generate exception id: %w", err)
-				}
-
-				exception := &domain.RecurringTemplateException{
-					ID:            excID.String(),
-					TemplateID:    *existingItem.RecurringTemplateID,
-					OccursAt:      *existingItem.OccursAt,
-					ExceptionType: domain.ExceptionTypeEdited,
-					ItemID:        &existingItem.ID,
-					CreatedAt:     time.Now().UTC(),
-				}
-
-				_, err = repo.CreateException(ctx, exception)
+	if err := s.requireListRole(ctx, params.ListID, domain.ListRoleEditor); err != nil {
+		return nil, err
+	}
+
+	// Validate custom field values against the list schema if being updated
+	if slices.Contains(params.UpdateMask, domain.FieldItemCustomFields) {
+		customFields, err := s.validateCustomFields(ctx, params.ListID, params.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+		params.CustomFields = customFields
+	}
+
+	// Recurring items get an exception with the update, and the rules of the
+	// list run on the change, so everything commits together
+	var updatedItem *domain.TodoItem
+	err = s.repo.Atomic(ctx, func(repo Repository) error {
+		item, err := repo.UpdateItem(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		if existingItem.RecurringTemplateID != nil && existingItem.OccursAt != nil && shouldCreateException(params.UpdateMask) {
+			if err := ensureEditedException(ctx, repo, existingItem); err != nil {
 				return err
-			})
-			if err != nil {
-				return nil, err
 			}
-			return updatedItem, nil
 		}
+
+		updatedItem, err = s.runRules(ctx, repo, item, domain.ItemChangeEvents(existingItem, item, 0)...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updatedItem, nil
+}
+
+// ensureEditedException records that an occurrence of a recurring template was
+// edited, so that the template does not regenerate it.
+func ensureEditedException(ctx context.Context, repo Repository, item *domain.TodoItem) error {
+	// Check if exception already exists for this occurrence
+	_, err := repo.FindExceptionByOccurrence(ctx, *item.RecurringTemplateID, *item.OccursAt)
+	if err == nil {
+		// Exception already exists, no need to create another
+		return nil
+	}
+	if !errors.Is(err, domain.ErrExceptionNotFound) {
+		// Unexpected error
+		return err
 	}
 
-	// No exception needed - standard update
-	return s.repo.UpdateItem(ctx, params)
+	// Exception doesn't exist, create it
+	excID, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate exception id: %w", err)
+	}
+
+	exception := &domain.RecurringTemplateException{
+		ID:            excID.String(),
+		TemplateID:    *item.RecurringTemplateID,
+		OccursAt:      *item.OccursAt,
+		ExceptionType: domain.ExceptionTypeEdited,
+		ItemID:        &item.ID,
+		CreatedAt:     time.Now().UTC(),
+	}
+
+	_, err = repo.CreateException(ctx, exception)
+	return err
 }
 
 // DeleteItem deletes a todo item.
@@ -401,6 +508,10 @@ func (s *Service) DeleteItem(ctx context.Context, listID, itemID string) error {
 		return domain.ErrItemNotFound
 	}
 
+	if err := s.requireListRole(ctx, listID, domain.ListRoleEditor); err != nil {
+		return err
+	}
+
 	// Check if recurring item
 	if item.RecurringTemplateID != nil && item.OccursAt != nil {
 		// Recurring item - create exception + hard delete
@@ -441,11 +552,6 @@ func (s *Service) DeleteItem(ctx context.Context, listID, itemID string) error {
 // Filter is already validated via ItemsFilter value object.
 // Applies business rules (pagination limits, default exclusions) and delegates to repository.
 func (s *Service) ListItems(ctx context.Context, params domain.ListTasksParams) (*domain.PagedResult, error) {
-	// Reject negative offsets to prevent database errors
-	if params.Offset < 0 {
-		params.Offset = 0
-	}
-
 	// Apply default limit if not specified or negative
 	if params.Limit <= 0 {
 		params.Limit = s.config.DefaultPageSize
@@ -454,6 +560,10 @@ func (s *Service) ListItems(ctx context.Context, params domain.ListTasksParams)
 	// Enforce maximum page size
 	params.Limit = min(params.Limit, s.config.MaxPageSize)
 
+	if len(params.ListIDs) > domain.MaxListIDsFilter {
+		return nil, domain.ErrTooManyListIDs
+	}
+
 	// Business rule: when no explicit status filter, exclude archived and cancelled
 	excludedStatuses := []domain.TaskStatus{}
 	if !params.Filter.HasStatusFilter() {
@@ -470,6 +580,19 @@ func (s *Service) ListItems(ctx context.Context, params domain.ListTasksParams)
 
 // CreateRecurringTemplate creates a new recurring task template.
 func (s *Service) CreateRecurringTemplate(ctx context.Context, template *domain.RecurringTemplate) (*domain.RecurringTemplate, error) {
+	return s.createRecurringTemplate(ctx, template, time.Now().UTC(), nil, nil)
+}
+
+// createRecurringTemplate creates a template whose occurrences start at start.
+// Occurrences in exceptions are not generated; the exceptions are stored with
+// the template. inTx, if set, runs in the same transaction once the template exists.
+func (s *Service) createRecurringTemplate(
+	ctx context.Context,
+	template *domain.RecurringTemplate,
+	start time.Time,
+	exceptions []*domain.RecurringTemplateException,
+	inTx func(ops RecurringOperations, created *domain.RecurringTemplate) error,
+) (*domain.RecurringTemplate, error) {
 	if template.ListID == "" {
 		return nil, domain.ErrListNotFound
 	}
@@ -501,7 +624,7 @@ func (s *Service) CreateRecurringTemplate(ctx context.Context, template *domain.
 	now := time.Now().UTC()
 	template.CreatedAt = now
 	template.UpdatedAt = now
-	template.GeneratedThrough = now
+	template.GeneratedThrough = start
 	template.IsActive = true
 
 	// Validate horizon values before applying defaults
@@ -523,10 +646,24 @@ func (s *Service) CreateRecurringTemplate(ctx context.Context, template *domain.
 		return nil, err
 	}
 
+	if err := domain.ValidateDefaultReminders(template.DefaultReminders); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireListRole(ctx, template.ListID, domain.ListRoleEditor); err != nil {
+		return nil, err
+	}
+
+	// Validate custom field values against the list schema (copied into every instance)
+	customFields, err := s.validateCustomFields(ctx, template.ListID, template.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+	template.CustomFields = customFields
+
 	// Prepare SYNC items: generate next N days immediately
-	syncEnd := now.AddDate(0, 0, template.SyncHorizonDays)
-	// No exceptions for newly created template
-	syncItems, err := s.generator.GenerateTasksForTemplateWithExceptions(ctx, template, now, syncEnd, nil)
+	syncEnd := start.AddDate(0, 0, template.SyncHorizonDays)
+	syncItems, err := s.generator.GenerateTasksForTemplateWithExceptions(ctx, template, start, syncEnd, exceptions)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to generate sync items for recurring template",
 			"list_id", template.ListID,
@@ -555,6 +692,12 @@ func (s *Service) CreateRecurringTemplate(ctx context.Context, template *domain.
 		if err != nil {
 			return fmt.Errorf("failed to create template: %w", err)
 		}
+		for _, exception := range exceptions {
+			exception.TemplateID = created.ID
+			if _, err := ops.CreateException(ctx, exception); err != nil {
+				return fmt.Errorf("failed to create exception: %w", err)
+			}
+		}
 
 		// 2. Insert sync horizon items
 		if len(syncItems) > 0 {
@@ -571,7 +714,7 @@ func (s *Service) CreateRecurringTemplate(ctx context.Context, template *domain.
 		created.GeneratedThrough = syncEnd
 
 		// 4. Schedule async generation job if needed
-		asyncEnd := now.AddDate(0, 0, created.GenerationHorizonDays)
+		asyncEnd := start.AddDate(0, 0, created.GenerationHorizonDays)
 		if syncEnd.Before(asyncEnd) {
 			_, err := ops.ScheduleGenerationJob(
 				ctx,
@@ -586,6 +729,9 @@ func (s *Service) CreateRecurringTemplate(ctx context.Context, template *domain.
 			asyncJobScheduled = true
 		}
 
+		if inTx != nil {
+			return inTx(ops, created)
+		}
 		return nil
 	})
 	if err != nil {
@@ -661,6 +807,10 @@ func (s *Service) UpdateRecurringTemplate(ctx context.Context, params domain.Upd
 		return nil, domain.ErrTemplateNotFound
 	}
 
+	if err := s.requireListRole(ctx, params.ListID, domain.ListRoleEditor); err != nil {
+		return nil, err
+	}
+
 	// Validate update mask and required fields
 	if err := params.Validate(); err != nil {
 		slog.WarnContext(ctx, "invalid update parameters for recurring template",
@@ -698,6 +848,20 @@ func (s *Service) UpdateRecurringTemplate(ctx context.Context, params domain.Upd
 		}
 	}
 
+	// Default reminders apply to instances generated after the update
+	if err := domain.ValidateDefaultReminders(params.DefaultReminders); err != nil {
+		return nil, err
+	}
+
+	// Validate custom field values against the list schema if being updated
+	if slices.Contains(params.UpdateMask, domain.FieldCustomFields) {
+		customFields, err := s.validateCustomFields(ctx, params.ListID, params.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+		params.CustomFields = customFields
+	}
+
 	// Check if this is a pattern change (requires regeneration)
 	isPatternChange := s.isPatternChange(params)
 
@@ -881,6 +1045,10 @@ func (s *Service) DeleteRecurringTemplate(ctx context.Context, listID, templateI
 		return domain.ErrTemplateNotFound
 	}
 
+	if err := s.requireListRole(ctx, listID, domain.ListRoleEditor); err != nil {
+		return err
+	}
+
 	slog.InfoContext(ctx, "deleting recurring template",
 		"template_id", templateID,
 		"list_id", listID)
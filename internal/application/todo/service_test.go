@@ -3,27 +3,26 @@ package todo
 import (
 	"context"
 	"testing"
-	"time"
 
 	"github.com/rezkam/mono/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// internalContext returns the context of an internal caller, like a worker,
+// whose access is not scoped to a tenant.
+func internalContext() context.Context {
+	return domain.WithInternalAccess(context.Background())
+}
+
 // mockListListsRepo is a minimal mock for testing ListLists logic
 type mockListListsRepo struct {
+	unimplementedRepository
+
 	capturedParams domain.ListListsParams
 	resultToReturn *domain.PagedListResult
 }
 
-func (m *mockListListsRepo) CreateList(ctx context.Context, list *domain.TodoList) (*domain.TodoList, error) {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) FindListByID(ctx context.Context, id string) (*domain.TodoList, error) {
-	panic("not used in ListLists tests")
-}
-
 func (m *mockListListsRepo) FindLists(ctx context.Context, params domain.ListListsParams) (*domain.PagedListResult, error) {
 	// Capture params for assertion
 	m.capturedParams = params
@@ -31,9 +30,8 @@ func (m *mockListListsRepo) FindLists(ctx context.Context, params domain.ListLis
 		return m.resultToReturn, nil
 	}
 	return &domain.PagedListResult{
-		Lists:      []*domain.TodoList{},
-		TotalCount: 0,
-		HasMore:    false,
+		Lists:   []*domain.TodoList{},
+		HasMore: false,
 	}, nil
 }
 
@@ -42,54 +40,6 @@ func (m *mockListListsRepo) UpdateList(ctx context.Context, params domain.Update
 	return &domain.TodoList{ID: params.ListID}, nil
 }
 
-func (m *mockListListsRepo) CreateItem(ctx context.Context, listID string, item *domain.TodoItem) (*domain.TodoItem, error) {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) FindItemByID(ctx context.Context, id string) (*domain.TodoItem, error) {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) UpdateItem(ctx context.Context, params domain.UpdateItemParams) (*domain.TodoItem, error) {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) FindItems(ctx context.Context, params domain.ListTasksParams, excludedStatuses []domain.TaskStatus) (*domain.PagedResult, error) {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) DeleteItem(ctx context.Context, id string) error {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) CreateRecurringTemplate(ctx context.Context, template *domain.RecurringTemplate) (*domain.RecurringTemplate, error) {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) FindRecurringTemplateByID(ctx context.Context, id string) (*domain.RecurringTemplate, error) {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) UpdateRecurringTemplate(ctx context.Context, params domain.UpdateRecurringTemplateParams) (*domain.RecurringTemplate, error) {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) DeleteRecurringTemplate(ctx context.Context, id string) error {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) FindRecurringTemplates(ctx context.Context, listID string, activeOnly bool) ([]*domain.RecurringTemplate, error) {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) CreateException(ctx context.Context, exception *domain.RecurringTemplateException) (*domain.RecurringTemplateException, error) {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) FindExceptionByOccurrence(ctx context.Context, templateID string, occursAt time.Time) (*domain.RecurringTemplateException, error) {
-	panic("not used in ListLists tests")
-}
-
 // Atomic executes callback without transaction (tests don't need real transactions)
 func (m *mockListListsRepo) Atomic(ctx context.Context, fn func(tx Repository) error) error {
 	// Execute the function with the same mock (no actual transaction needed for validation tests)
@@ -103,20 +53,8 @@ func (m *mockListListsRepo) AtomicRecurring(ctx context.Context, fn func(ops Rec
 }
 
 // RecurringOperations methods (not used in these tests)
-func (m *mockListListsRepo) BatchInsertItemsIgnoreConflict(ctx context.Context, items []*domain.TodoItem) (int, error) {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) DeleteFuturePendingItems(ctx context.Context, templateID string, from time.Time) (int64, error) {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) SetGeneratedThrough(ctx context.Context, templateID string, generatedThrough time.Time) error {
-	panic("not used in ListLists tests")
-}
-
-func (m *mockListListsRepo) ScheduleGenerationJob(ctx context.Context, templateID string, scheduledFor, from, until time.Time) (string, error) {
-	panic("not used in ListLists tests")
+func (m *mockListListsRepo) FindEnabledAutomationRules(ctx context.Context, listID string, trigger domain.RuleTriggerType) ([]*domain.AutomationRule, error) {
+	return nil, nil // No rules
 }
 
 // mockUpdateItemRepo is a minimal mock for testing UpdateItem logic
@@ -167,7 +105,7 @@ func TestUpdateItem_RejectsTrailingNonNumericEtag(t *testing.T) {
 				Etag:   &etag,
 			}
 
-			_, err := service.UpdateItem(context.Background(), params)
+			_, err := service.UpdateItem(internalContext(), params)
 
 			if tc.valid {
 				// Valid etags should not return ErrInvalidEtagFormat
@@ -181,25 +119,6 @@ func TestUpdateItem_RejectsTrailingNonNumericEtag(t *testing.T) {
 	}
 }
 
-// TestListLists_ClampsNegativeOffset tests that FindLists rejects negative
-// offsets by clamping them to 0, preventing PostgreSQL errors.
-func TestListLists_ClampsNegativeOffset(t *testing.T) {
-	repo := &mockListListsRepo{}
-	generator := &mockTaskGenerator{}
-	service := NewService(repo, generator, Config{DefaultPageSize: 25, MaxPageSize: 100})
-
-	params := domain.ListListsParams{
-		Offset: -5, // Negative offset should be clamped to 0
-		Limit:  10,
-	}
-
-	_, err := service.FindLists(context.Background(), params)
-	require.NoError(t, err)
-
-	// Verify that the offset passed to the repository was clamped to 0
-	assert.Equal(t, 0, repo.capturedParams.Offset, "negative offset should be clamped to 0")
-}
-
 // TestListLists_UsesConfiguredDefaultPageSize verifies that when no limit is specified,
 // the service uses the configured DefaultPageSize, not the compile-time constant.
 // This ensures MONO_DEFAULT_PAGE_SIZE env var takes effect.
@@ -215,7 +134,7 @@ func TestListLists_UsesConfiguredDefaultPageSize(t *testing.T) {
 		Limit: 0, // Zero means "use default"
 	}
 
-	_, err := service.FindLists(context.Background(), params)
+	_, err := service.FindLists(internalContext(), params)
 	require.NoError(t, err)
 
 	assert.Equal(t, customDefault, repo.capturedParams.Limit,
@@ -237,7 +156,7 @@ func TestListLists_UsesConfiguredMaxPageSize(t *testing.T) {
 		Limit: 1000, // Exceeds max
 	}
 
-	_, err := service.FindLists(context.Background(), params)
+	_, err := service.FindLists(internalContext(), params)
 	require.NoError(t, err)
 
 	assert.Equal(t, customMax, repo.capturedParams.Limit,
@@ -254,7 +173,7 @@ func TestListLists_RespectsValidLimit(t *testing.T) {
 		Limit: 42, // Within valid range
 	}
 
-	_, err := service.FindLists(context.Background(), params)
+	_, err := service.FindLists(internalContext(), params)
 	require.NoError(t, err)
 
 	assert.Equal(t, 42, repo.capturedParams.Limit, "valid limit should be passed through unchanged")
@@ -275,7 +194,7 @@ func TestUpdateItem_RejectsTitleInMaskWithNilValue(t *testing.T) {
 		Title:      nil,               // but Title is nil - should be rejected
 	}
 
-	_, err := service.UpdateItem(context.Background(), params)
+	_, err := service.UpdateItem(internalContext(), params)
 
 	assert.ErrorIs(t, err, domain.ErrTitleRequired,
 		"should return ErrTitleRequired when title is in update_mask but Title is nil")
@@ -310,7 +229,7 @@ func TestUpdateList_RejectsInvalidEtag(t *testing.T) {
 				Title:      &title,
 			}
 
-			_, err := service.UpdateList(context.Background(), params)
+			_, err := service.UpdateList(internalContext(), params)
 
 			if tc.valid {
 				assert.NotErrorIs(t, err, domain.ErrInvalidEtagFormat)
@@ -354,7 +273,7 @@ func TestUpdateRecurringTemplate_RejectsInvalidEtag(t *testing.T) {
 				RecurrenceConfig:  nil,
 			}
 
-			_, err := service.UpdateRecurringTemplate(context.Background(), params)
+			_, err := service.UpdateRecurringTemplate(internalContext(), params)
 
 			if tc.valid {
 				assert.NotErrorIs(t, err, domain.ErrInvalidEtagFormat)
@@ -365,3 +284,185 @@ func TestUpdateRecurringTemplate_RejectsInvalidEtag(t *testing.T) {
 		})
 	}
 }
+
+// mockCustomFieldsRepo is a minimal mock for testing custom field validation
+type mockCustomFieldsRepo struct {
+	mockListListsRepo // embed for interface satisfaction
+	list              *domain.TodoList
+	findListCalls     int
+	createdList       *domain.TodoList
+	createdItem       *domain.TodoItem
+	updateParams      domain.UpdateItemParams
+}
+
+func (m *mockCustomFieldsRepo) FindListByID(ctx context.Context, id string) (*domain.TodoList, error) {
+	m.findListCalls++
+	return m.list, nil
+}
+
+func (m *mockCustomFieldsRepo) CreateList(ctx context.Context, list *domain.TodoList) (*domain.TodoList, error) {
+	m.createdList = list
+	return list, nil
+}
+
+func (m *mockCustomFieldsRepo) CreateItem(ctx context.Context, listID string, item *domain.TodoItem) (*domain.TodoItem, error) {
+	m.createdItem = item
+	return item, nil
+}
+
+func (m *mockCustomFieldsRepo) FindItemByID(ctx context.Context, id string) (*domain.TodoItem, error) {
+	return &domain.TodoItem{ID: id, ListID: m.list.ID}, nil
+}
+
+func (m *mockCustomFieldsRepo) UpdateItem(ctx context.Context, params domain.UpdateItemParams) (*domain.TodoItem, error) {
+	m.updateParams = params
+	return &domain.TodoItem{ID: params.ItemID, CustomFields: params.CustomFields}, nil
+}
+
+func (m *mockCustomFieldsRepo) Atomic(ctx context.Context, fn func(tx Repository) error) error {
+	return fn(m)
+}
+
+func newMockCustomFieldsRepo(t *testing.T) *mockCustomFieldsRepo {
+	t.Helper()
+	schema, err := domain.NewCustomFieldSchema([]domain.CustomFieldDefinition{
+		{Name: "story_points", Type: domain.CustomFieldTypeNumber},
+		{Name: "stage", Type: domain.CustomFieldTypeEnum, Options: []string{"design", "build"}},
+	})
+	require.NoError(t, err)
+	return &mockCustomFieldsRepo{
+		list: &domain.TodoList{ID: "list-123", Title: "Sprint", CustomFieldSchema: schema},
+	}
+}
+
+func TestCreateItem_ValidatesCustomFieldsAgainstListSchema(t *testing.T) {
+	repo := newMockCustomFieldsRepo(t)
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	created, err := service.CreateItem(internalContext(), "list-123", &domain.TodoItem{
+		Title:        "Implement feature",
+		CustomFields: map[string]any{"story_points": 5, "stage": "build"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"story_points": float64(5), "stage": "build"}, created.CustomFields)
+	assert.Equal(t, 1, repo.findListCalls)
+}
+
+func TestCreateItem_RejectsInvalidCustomFields(t *testing.T) {
+	testCases := []struct {
+		name   string
+		values map[string]any
+		want   error
+	}{
+		{"unknown field", map[string]any{"owner": "alice"}, domain.ErrUnknownCustomField},
+		{"wrong type", map[string]any{"story_points": "five"}, domain.ErrInvalidCustomFieldValue},
+		{"enum option not declared", map[string]any{"stage": "deploy"}, domain.ErrInvalidCustomFieldValue},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := newMockCustomFieldsRepo(t)
+			service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+			_, err := service.CreateItem(internalContext(), "list-123", &domain.TodoItem{
+				Title:        "Implement feature",
+				CustomFields: tc.values,
+			})
+
+			assert.ErrorIs(t, err, tc.want)
+			assert.Nil(t, repo.createdItem, "invalid item must not be persisted")
+		})
+	}
+}
+
+func TestCreateItem_WithoutCustomFieldsSkipsListLookup(t *testing.T) {
+	repo := newMockCustomFieldsRepo(t)
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	_, err := service.CreateItem(internalContext(), "list-123", &domain.TodoItem{Title: "Plain item"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, repo.findListCalls)
+}
+
+func TestUpdateItem_ValidatesCustomFieldsInMask(t *testing.T) {
+	repo := newMockCustomFieldsRepo(t)
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	_, err := service.UpdateItem(internalContext(), domain.UpdateItemParams{
+		ListID:       "list-123",
+		ItemID:       "item-456",
+		UpdateMask:   []string{"custom_fields"},
+		CustomFields: map[string]any{"stage": "deploy"},
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidCustomFieldValue)
+
+	updated, err := service.UpdateItem(internalContext(), domain.UpdateItemParams{
+		ListID:       "list-123",
+		ItemID:       "item-456",
+		UpdateMask:   []string{"custom_fields"},
+		CustomFields: map[string]any{"stage": "design", "story_points": nil},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"stage": "design"}, updated.CustomFields)
+}
+
+func TestCreateListWithCustomFields_ValidatesSchema(t *testing.T) {
+	repo := newMockCustomFieldsRepo(t)
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	_, err := service.CreateListWithCustomFields(internalContext(), "Sprint", []domain.CustomFieldDefinition{
+		{Name: "stage", Type: domain.CustomFieldTypeEnum},
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidCustomFieldSchema)
+	assert.Nil(t, repo.createdList)
+
+	list, err := service.CreateListWithCustomFields(internalContext(), "Sprint", []domain.CustomFieldDefinition{
+		{Name: "story_points", Type: "number"},
+	})
+	require.NoError(t, err)
+	require.Len(t, list.CustomFieldSchema, 1)
+	assert.Equal(t, domain.CustomFieldTypeNumber, list.CustomFieldSchema[0].Type)
+}
+
+func TestUpdateList_ValidatesCustomFieldSchema(t *testing.T) {
+	repo := &mockListListsRepo{}
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	schema := domain.CustomFieldSchema{
+		{Name: "points", Type: domain.CustomFieldTypeNumber},
+		{Name: "points", Type: domain.CustomFieldTypeString},
+	}
+	_, err := service.UpdateList(internalContext(), domain.UpdateListParams{
+		ListID:            "list-123",
+		UpdateMask:        []string{"custom_fields"},
+		CustomFieldSchema: &schema,
+	})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidCustomFieldSchema)
+}
+
+func TestCreateList_AssignsOwnerFromPrincipal(t *testing.T) {
+	repo := newMockCustomFieldsRepo(t)
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	ctx := domain.WithPrincipal(internalContext(), domain.Principal{
+		KeyID:   "key-1",
+		OwnerID: "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a5b",
+	})
+	list, err := service.CreateList(ctx, "Tenant list")
+
+	require.NoError(t, err)
+	assert.Equal(t, "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a5b", list.OwnerID)
+}
+
+func TestCreateList_WithoutPrincipalHasNoOwner(t *testing.T) {
+	repo := newMockCustomFieldsRepo(t)
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	list, err := service.CreateList(internalContext(), "Internal list")
+
+	require.NoError(t, err)
+	assert.Empty(t, list.OwnerID)
+}
@@ -0,0 +1,59 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// GetListSnapshots returns a snapshot of the item counts of a list for every
+// UTC day from first through last (YYYY-MM-DD; empty last = today, empty first
+// = domain.DefaultSnapshotDays days up to last), for burndown and cumulative
+// flow charts. Days without a recorded snapshot, such as days before snapshots
+// were recorded, are reconstructed from status history.
+func (s *Service) GetListSnapshots(ctx context.Context, listID, first, last string) (*domain.SnapshotSeries, error) {
+	if listID == "" {
+		return nil, domain.ErrListNotFound
+	}
+	now := time.Now().UTC()
+	firstDay, lastDay, err := domain.ParseSnapshotRange(first, last, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireListRole(ctx, listID, domain.ListRoleViewer); err != nil {
+		return nil, err
+	}
+
+	recorded, err := s.repo.FindListSnapshots(ctx, listID, firstDay, lastDay)
+	if err != nil {
+		return nil, err
+	}
+	missing := domain.MissingSnapshotDays(firstDay, lastDay, recorded)
+	if len(missing) == 0 {
+		return domain.NewSnapshotSeries(firstDay, lastDay, recorded, nil), nil
+	}
+
+	// Every item created by the end of the last missing day can count
+	query := domain.StatsQuery{ListID: listID, To: missing[len(missing)-1].AddDate(0, 0, 1)}
+	items, err := s.repo.FindStatsItems(ctx, query, domain.MaxStatsItems+1)
+	if err != nil {
+		return nil, err
+	}
+	truncated := len(items) > domain.MaxStatsItems
+	if truncated {
+		items = items[:domain.MaxStatsItems]
+	}
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	history, err := s.repo.FindStatusHistory(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	series := domain.NewSnapshotSeries(firstDay, lastDay, recorded, domain.BackfillSnapshots(listID, missing, items, history, now))
+	series.Truncated = truncated
+	return series, nil
+}
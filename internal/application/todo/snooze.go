@@ -0,0 +1,35 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// SnoozeItem hides an item from default listings until the given time, without
+// changing its schedule. Snoozing an item that is already snoozed replaces the time.
+func (s *Service) SnoozeItem(ctx context.Context, listID, itemID string, until time.Time) (*domain.TodoItem, error) {
+	if !until.After(time.Now().UTC()) {
+		return nil, fmt.Errorf("%w: until must be in the future", domain.ErrInvalidSnooze)
+	}
+
+	until = until.UTC()
+	return s.UpdateItem(ctx, domain.UpdateItemParams{
+		ItemID:       itemID,
+		ListID:       listID,
+		UpdateMask:   []string{domain.FieldSnoozedUntil},
+		SnoozedUntil: &until,
+	})
+}
+
+// WakeItem ends the snooze of an item before its time.
+// The worker wakes items whose snooze has passed.
+func (s *Service) WakeItem(ctx context.Context, listID, itemID string) (*domain.TodoItem, error) {
+	return s.UpdateItem(ctx, domain.UpdateItemParams{
+		ItemID:     itemID,
+		ListID:     listID,
+		UpdateMask: []string{domain.FieldSnoozedUntil},
+	})
+}
@@ -0,0 +1,43 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnoozeItem_SetsSnoozedUntil(t *testing.T) {
+	repo := newMockCustomFieldsRepo(t)
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	until := time.Now().UTC().Add(48 * time.Hour)
+	_, err := service.SnoozeItem(internalContext(), "list-123", "item-456", until)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{domain.FieldSnoozedUntil}, repo.updateParams.UpdateMask)
+	require.NotNil(t, repo.updateParams.SnoozedUntil)
+	assert.True(t, repo.updateParams.SnoozedUntil.Equal(until))
+}
+
+func TestSnoozeItem_RejectsPastTime(t *testing.T) {
+	repo := newMockCustomFieldsRepo(t)
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	_, err := service.SnoozeItem(internalContext(), "list-123", "item-456", time.Now().UTC().Add(-time.Minute))
+	assert.ErrorIs(t, err, domain.ErrInvalidSnooze)
+	assert.Empty(t, repo.updateParams.UpdateMask, "invalid snooze must not be persisted")
+}
+
+func TestWakeItem_ClearsSnoozedUntil(t *testing.T) {
+	repo := newMockCustomFieldsRepo(t)
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	_, err := service.WakeItem(internalContext(), "list-123", "item-456")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{domain.FieldSnoozedUntil}, repo.updateParams.UpdateMask)
+	assert.Nil(t, repo.updateParams.SnoozedUntil)
+}
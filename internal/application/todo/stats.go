@@ -0,0 +1,48 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// GetListStats returns the flow metrics of a list over [from, to): throughput,
+// lead and cycle time, time in status and estimate accuracy, optionally grouped
+// by tag, priority or week. A nil to is now, a nil from domain.DefaultStatsRangeDays
+// before to; weeks start on Monday in the IANA timezone (empty = UTC).
+func (s *Service) GetListStats(ctx context.Context, listID string, from, to *time.Time, groupBy, timezone string) (*domain.ListStats, error) {
+	if listID == "" {
+		return nil, domain.ErrListNotFound
+	}
+	now := time.Now().UTC()
+	query, err := domain.NewStatsQuery(listID, from, to, groupBy, timezone, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireListRole(ctx, listID, domain.ListRoleViewer); err != nil {
+		return nil, err
+	}
+
+	items, err := s.repo.FindStatsItems(ctx, query, domain.MaxStatsItems+1)
+	if err != nil {
+		return nil, err
+	}
+	truncated := len(items) > domain.MaxStatsItems
+	if truncated {
+		items = items[:domain.MaxStatsItems]
+	}
+
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	history, err := s.repo.FindStatusHistory(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := domain.NewListStats(query, items, history, now)
+	stats.Truncated = truncated
+	return stats, nil
+}
@@ -0,0 +1,28 @@
+package todo
+
+import (
+	"context"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// Sync page size limits. A page holds at most this many changes; repeated
+// changes to one entity are reported once, so it can hold fewer entities.
+const (
+	DefaultSyncPageSize = 500
+	MaxSyncPageSize     = 1000
+)
+
+// Sync returns the lists, items and templates changed after the cursor, with
+// tombstones for deleted ones and ones the principal lost access to, and the
+// cursor to sync from next.
+// The zero cursor syncs everything the principal can access.
+// limit is clamped to MaxSyncPageSize; zero or less uses DefaultSyncPageSize.
+func (s *Service) Sync(ctx context.Context, after domain.SyncCursor, limit int) (*domain.SyncPage, error) {
+	if limit <= 0 {
+		limit = DefaultSyncPageSize
+	}
+	limit = min(limit, MaxSyncPageSize)
+
+	return s.repo.FindSyncPage(ctx, after, limit)
+}
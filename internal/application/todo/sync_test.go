@@ -0,0 +1,45 @@
+package todo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSyncRepo records the page size requested from the repository.
+type mockSyncRepo struct {
+	mockListListsRepo // embed for interface satisfaction
+	limit             int
+}
+
+func (m *mockSyncRepo) FindSyncPage(ctx context.Context, after domain.SyncCursor, limit int) (*domain.SyncPage, error) {
+	m.limit = limit
+	return &domain.SyncPage{Cursor: after}, nil
+}
+
+func TestSync_ClampsPageSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		limit    int
+		expected int
+	}{
+		{"zero uses default", 0, DefaultSyncPageSize},
+		{"negative uses default", -1, DefaultSyncPageSize},
+		{"within range passed through", 50, 50},
+		{"above max is clamped", MaxSyncPageSize + 1, MaxSyncPageSize},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &mockSyncRepo{}
+			service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+			_, err := service.Sync(context.Background(), domain.SyncCursor{}, tc.limit)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, repo.limit)
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// ListTags returns the tags registered on a list, by name, with how many of
+// its items and recurring templates use each.
+// Any principal with access to the list can see its tags.
+func (s *Service) ListTags(ctx context.Context, listID string) ([]*domain.TagUsage, error) {
+	if listID == "" {
+		return nil, domain.ErrListNotFound
+	}
+	if err := s.requireListRole(ctx, listID, domain.ListRoleViewer); err != nil {
+		return nil, err
+	}
+	return s.repo.FindTagUsage(ctx, listID)
+}
+
+// CreateTag registers a tag on a list with its color and description.
+// The tag does not have to be in use.
+func (s *Service) CreateTag(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
+	if tag.ListID == "" {
+		return nil, domain.ErrListNotFound
+	}
+	if err := s.requireListRole(ctx, tag.ListID, domain.ListRoleEditor); err != nil {
+		return nil, err
+	}
+	if err := tag.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	tag.CreatedAt = now
+	tag.UpdatedAt = now
+
+	return s.repo.CreateTag(ctx, tag)
+}
+
+// UpdateTag updates a registered tag using field mask.
+func (s *Service) UpdateTag(ctx context.Context, params domain.UpdateTagParams) (*domain.Tag, error) {
+	if params.ListID == "" {
+		return nil, domain.ErrListNotFound
+	}
+	if params.Name == "" {
+		return nil, domain.ErrTagNotFound
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.requireListRole(ctx, params.ListID, domain.ListRoleEditor); err != nil {
+		return nil, err
+	}
+
+	tag, err := s.repo.FindTag(ctx, params.ListID, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(params.UpdateMask, "color") {
+		tag.Color = params.Color
+	}
+	if slices.Contains(params.UpdateMask, "description") {
+		tag.Description = params.Description
+	}
+
+	if err := tag.Validate(); err != nil {
+		return nil, err
+	}
+	tag.UpdatedAt = time.Now().UTC()
+
+	return s.repo.UpdateTag(ctx, tag)
+}
+
+// DeleteTag removes the registration of a tag. Items and recurring templates
+// keep using the tag.
+func (s *Service) DeleteTag(ctx context.Context, listID, name string) error {
+	if listID == "" {
+		return domain.ErrListNotFound
+	}
+	if err := s.requireListRole(ctx, listID, domain.ListRoleEditor); err != nil {
+		return err
+	}
+
+	deleted, err := s.repo.DeleteTags(ctx, listID, []string{name})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrTagNotFound, name)
+	}
+	return nil
+}
+
+// RenameTag renames a tag on every item and recurring template of a list, and
+// its registration, in one transaction. Renaming to a tag that is in use is
+// rejected with domain.ErrTagAlreadyExists; MergeTags combines tags.
+//
+// Rewriting tags is not adding them, so tag_added automation rules do not fire.
+func (s *Service) RenameTag(ctx context.Context, listID, name, newName string) (domain.TagRewrite, error) {
+	if listID == "" {
+		return domain.TagRewrite{}, domain.ErrListNotFound
+	}
+	newName, err := domain.NewTagName(newName)
+	if err != nil {
+		return domain.TagRewrite{}, err
+	}
+	if newName == name {
+		return domain.TagRewrite{}, fmt.Errorf("%w: new name must differ from the current name", domain.ErrInvalidTag)
+	}
+	if err := s.requireListRole(ctx, listID, domain.ListRoleEditor); err != nil {
+		return domain.TagRewrite{}, err
+	}
+
+	var rewrite domain.TagRewrite
+	err = s.repo.Atomic(ctx, func(repo Repository) error {
+		inUse, err := repo.TagInUse(ctx, listID, name)
+		if err != nil {
+			return err
+		}
+		if !inUse {
+			return fmt.Errorf("%w: %s", domain.ErrTagNotFound, name)
+		}
+
+		inUse, err = repo.TagInUse(ctx, listID, newName)
+		if err != nil {
+			return err
+		}
+		if inUse {
+			return fmt.Errorf("%w: %s, merge the tags instead", domain.ErrTagAlreadyExists, newName)
+		}
+
+		if _, err := repo.RenameTagRegistration(ctx, listID, name, newName, time.Now().UTC()); err != nil {
+			return err
+		}
+		rewrite, err = repo.ReplaceTags(ctx, listID, []string{name}, newName)
+		return err
+	})
+	if err != nil {
+		return domain.TagRewrite{}, err
+	}
+	return rewrite, nil
+}
+
+// MergeTags replaces the source tags with the target tag on every item and
+// recurring template of a list, in one transaction. Items that had several of
+// the tags keep the target once. The registrations of the sources are removed;
+// when the target is not registered, it takes over the registration of the
+// first registered source.
+func (s *Service) MergeTags(ctx context.Context, listID, target string, sources []string) (domain.TagRewrite, error) {
+	if listID == "" {
+		return domain.TagRewrite{}, domain.ErrListNotFound
+	}
+	target, err := domain.NewTagName(target)
+	if err != nil {
+		return domain.TagRewrite{}, err
+	}
+	sources, err = domain.NewTagMergeSources(sources, target)
+	if err != nil {
+		return domain.TagRewrite{}, err
+	}
+	if err := s.requireListRole(ctx, listID, domain.ListRoleEditor); err != nil {
+		return domain.TagRewrite{}, err
+	}
+
+	var rewrite domain.TagRewrite
+	err = s.repo.Atomic(ctx, func(repo Repository) error {
+		if err := mergeTagRegistrations(ctx, repo, listID, target, sources); err != nil {
+			return err
+		}
+		rewrite, err = repo.ReplaceTags(ctx, listID, sources, target)
+		return err
+	})
+	if err != nil {
+		return domain.TagRewrite{}, err
+	}
+	return rewrite, nil
+}
+
+// mergeTagRegistrations keeps the registration of target, or moves the first
+// registered source to it, and removes the registrations of the sources.
+func mergeTagRegistrations(ctx context.Context, repo Repository, listID, target string, sources []string) error {
+	_, err := repo.FindTag(ctx, listID, target)
+	if errors.Is(err, domain.ErrTagNotFound) {
+		for _, source := range sources {
+			renamed, err := repo.RenameTagRegistration(ctx, listID, source, target, time.Now().UTC())
+			if err != nil {
+				return err
+			}
+			if renamed {
+				break
+			}
+		}
+	} else if err != nil {
+		return err
+	}
+
+	_, err = repo.DeleteTags(ctx, listID, sources)
+	return err
+}
@@ -0,0 +1,193 @@
+package todo
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTagsRepo keeps the registered tags and the tags of the items of one list in memory.
+type mockTagsRepo struct {
+	mockListListsRepo // embed for interface satisfaction
+	registered        map[string]*domain.Tag
+	itemTags          [][]string
+	replaced          [][]string // sources of each ReplaceTags call
+}
+
+func newMockTagsRepo(itemTags ...[]string) *mockTagsRepo {
+	return &mockTagsRepo{registered: make(map[string]*domain.Tag), itemTags: itemTags}
+}
+
+func (m *mockTagsRepo) Atomic(ctx context.Context, fn func(tx Repository) error) error {
+	return fn(m)
+}
+
+func (m *mockTagsRepo) FindTag(ctx context.Context, listID, name string) (*domain.Tag, error) {
+	tag, ok := m.registered[name]
+	if !ok {
+		return nil, domain.ErrTagNotFound
+	}
+	copied := *tag
+	return &copied, nil
+}
+
+func (m *mockTagsRepo) CreateTag(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
+	if _, ok := m.registered[tag.Name]; ok {
+		return nil, domain.ErrTagAlreadyExists
+	}
+	m.registered[tag.Name] = tag
+	return tag, nil
+}
+
+func (m *mockTagsRepo) UpdateTag(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
+	m.registered[tag.Name] = tag
+	return tag, nil
+}
+
+func (m *mockTagsRepo) RenameTagRegistration(ctx context.Context, listID, name, newName string, updatedAt time.Time) (bool, error) {
+	tag, ok := m.registered[name]
+	if !ok {
+		return false, nil
+	}
+	delete(m.registered, name)
+	tag.Name = newName
+	m.registered[newName] = tag
+	return true, nil
+}
+
+func (m *mockTagsRepo) DeleteTags(ctx context.Context, listID string, names []string) (int, error) {
+	deleted := 0
+	for _, name := range names {
+		if _, ok := m.registered[name]; ok {
+			delete(m.registered, name)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (m *mockTagsRepo) TagInUse(ctx context.Context, listID, name string) (bool, error) {
+	if _, ok := m.registered[name]; ok {
+		return true, nil
+	}
+	return slices.ContainsFunc(m.itemTags, func(tags []string) bool { return slices.Contains(tags, name) }), nil
+}
+
+func (m *mockTagsRepo) ReplaceTags(ctx context.Context, listID string, sources []string, target string) (domain.TagRewrite, error) {
+	m.replaced = append(m.replaced, sources)
+	rewrite := domain.TagRewrite{Tag: target}
+	for i, tags := range m.itemTags {
+		if !slices.ContainsFunc(tags, func(tag string) bool { return slices.Contains(sources, tag) }) {
+			continue
+		}
+		var replaced []string
+		for _, tag := range tags {
+			if slices.Contains(sources, tag) {
+				tag = target
+			}
+			if !slices.Contains(replaced, tag) {
+				replaced = append(replaced, tag)
+			}
+		}
+		m.itemTags[i] = replaced
+		rewrite.Items++
+	}
+	return rewrite, nil
+}
+
+func TestRenameTag_RewritesItemsAndRegistration(t *testing.T) {
+	repo := newMockTagsRepo([]string{"wrok", "home"}, []string{"home"})
+	repo.registered["wrok"] = &domain.Tag{ListID: "list-1", Name: "wrok"}
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	rewrite, err := service.RenameTag(internalContext(), "list-1", "wrok", " work ")
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.TagRewrite{Tag: "work", Items: 1}, rewrite)
+	assert.Equal(t, [][]string{{"work", "home"}, {"home"}}, repo.itemTags)
+	assert.Contains(t, repo.registered, "work")
+	assert.NotContains(t, repo.registered, "wrok")
+}
+
+func TestRenameTag_RejectsTagsInUse(t *testing.T) {
+	repo := newMockTagsRepo([]string{"wrok"}, []string{"work"})
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	_, err := service.RenameTag(internalContext(), "list-1", "wrok", "work")
+	assert.ErrorIs(t, err, domain.ErrTagAlreadyExists)
+
+	_, err = service.RenameTag(internalContext(), "list-1", "missing", "other")
+	assert.ErrorIs(t, err, domain.ErrTagNotFound)
+
+	_, err = service.RenameTag(internalContext(), "list-1", "wrok", "wrok")
+	assert.ErrorIs(t, err, domain.ErrInvalidTag)
+
+	assert.Empty(t, repo.replaced, "rejected renames must not rewrite items")
+}
+
+func TestMergeTags_CombinesTagsAndRegistrations(t *testing.T) {
+	repo := newMockTagsRepo([]string{"wrk", "Work", "home"}, []string{"home"})
+	color := "#ff0000"
+	repo.registered["Work"] = &domain.Tag{ListID: "list-1", Name: "Work", Color: &color}
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	rewrite, err := service.MergeTags(internalContext(), "list-1", "work", []string{"wrk", "Work"})
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.TagRewrite{Tag: "work", Items: 1}, rewrite)
+	assert.Equal(t, [][]string{{"work", "home"}, {"home"}}, repo.itemTags)
+	// The unregistered target takes over the registration of a source
+	require.Contains(t, repo.registered, "work")
+	assert.Equal(t, &color, repo.registered["work"].Color)
+	assert.Len(t, repo.registered, 1)
+}
+
+func TestMergeTags_KeepsTargetRegistration(t *testing.T) {
+	repo := newMockTagsRepo([]string{"wrk"})
+	blue, red := "#0000ff", "#ff0000"
+	repo.registered["work"] = &domain.Tag{ListID: "list-1", Name: "work", Color: &blue}
+	repo.registered["wrk"] = &domain.Tag{ListID: "list-1", Name: "wrk", Color: &red}
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	_, err := service.MergeTags(internalContext(), "list-1", "work", []string{"wrk"})
+	require.NoError(t, err)
+
+	assert.Len(t, repo.registered, 1)
+	assert.Equal(t, &blue, repo.registered["work"].Color)
+}
+
+func TestUpdateTag_ClearsMaskedFields(t *testing.T) {
+	repo := newMockTagsRepo()
+	color, description := "#00ff00", "Paid work"
+	repo.registered["work"] = &domain.Tag{ListID: "list-1", Name: "work", Color: &color, Description: &description}
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	tag, err := service.UpdateTag(internalContext(), domain.UpdateTagParams{
+		ListID:     "list-1",
+		Name:       "work",
+		UpdateMask: []string{"color"},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, tag.Color)
+	assert.Equal(t, &description, tag.Description)
+
+	_, err = service.UpdateTag(internalContext(), domain.UpdateTagParams{
+		ListID:     "list-1",
+		Name:       "missing",
+		UpdateMask: []string{"color"},
+	})
+	assert.ErrorIs(t, err, domain.ErrTagNotFound)
+}
+
+func TestDeleteTag_RequiresRegistration(t *testing.T) {
+	repo := newMockTagsRepo([]string{"work"})
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	err := service.DeleteTag(internalContext(), "list-1", "work")
+	assert.ErrorIs(t, err, domain.ErrTagNotFound)
+}
@@ -0,0 +1,192 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// timerPrincipal returns the principal time is tracked for.
+// Time entries belong to a principal, so internal callers cannot track time.
+func timerPrincipal(ctx context.Context) (string, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("%w: time entries require an authenticated principal", domain.ErrUnauthorized)
+	}
+	return principal.OwnerID, nil
+}
+
+// ListTimeEntries returns the time entries of an item, earliest start first.
+func (s *Service) ListTimeEntries(ctx context.Context, listID, itemID string) ([]*domain.TimeEntry, error) {
+	if _, err := s.findListItem(ctx, listID, itemID); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireListRole(ctx, listID, domain.ListRoleViewer); err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindTimeEntries(ctx, itemID)
+}
+
+// CreateTimeEntry adds a finished time entry of the caller to an item,
+// for time that was not tracked with the timer.
+func (s *Service) CreateTimeEntry(ctx context.Context, listID, itemID string, startedAt, endedAt time.Time, notes string) (*domain.TimeEntry, error) {
+	principalID, err := timerPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if err := domain.ValidateTimeEntryPeriod(startedAt, endedAt, now); err != nil {
+		return nil, err
+	}
+	notes, err = domain.NewTimeEntryNotes(notes)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findListItem(ctx, listID, itemID); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireListRole(ctx, listID, domain.ListRoleEditor); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate id: %w", err)
+	}
+
+	ended := endedAt.UTC()
+	return s.repo.CreateTimeEntry(ctx, &domain.TimeEntry{
+		ID:          id.String(),
+		ItemID:      itemID,
+		ListID:      listID,
+		PrincipalID: principalID,
+		StartedAt:   startedAt.UTC(),
+		EndedAt:     &ended,
+		Notes:       notes,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+}
+
+// DeleteTimeEntry removes a time entry from an item.
+// Editors delete their own entries; the list owner deletes any entry.
+func (s *Service) DeleteTimeEntry(ctx context.Context, listID, itemID, entryID string) error {
+	if _, err := s.findListItem(ctx, listID, itemID); err != nil {
+		return err
+	}
+
+	if err := s.requireListRole(ctx, listID, domain.ListRoleEditor); err != nil {
+		return err
+	}
+
+	principalID := ""
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		if err := s.requireListRole(ctx, listID, domain.ListRoleOwner); err != nil {
+			if !errors.Is(err, domain.ErrListAccessDenied) {
+				return err
+			}
+			principalID = principal.OwnerID
+		}
+	}
+
+	return s.repo.DeleteTimeEntry(ctx, itemID, entryID, principalID)
+}
+
+// StartTimer starts a timer of the caller on an item. A principal has one
+// running timer, so a timer running on any item is stopped first and
+// returned as the second value (nil if none was running).
+func (s *Service) StartTimer(ctx context.Context, listID, itemID, notes string) (*domain.TimeEntry, *domain.TimeEntry, error) {
+	principalID, err := timerPrincipal(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	notes, err = domain.NewTimeEntryNotes(notes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := s.findListItem(ctx, listID, itemID); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.requireListRole(ctx, listID, domain.ListRoleEditor); err != nil {
+		return nil, nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate id: %w", err)
+	}
+
+	var started, stopped *domain.TimeEntry
+	err = s.repo.Atomic(ctx, func(repo Repository) error {
+		now := time.Now().UTC()
+
+		var err error
+		stopped, err = repo.StopRunningTimeEntry(ctx, principalID, "", now, "")
+		if err != nil {
+			if !errors.Is(err, domain.ErrNoRunningTimer) {
+				return err
+			}
+			stopped = nil
+		}
+
+		started, err = repo.CreateTimeEntry(ctx, &domain.TimeEntry{
+			ID:          id.String(),
+			ItemID:      itemID,
+			ListID:      listID,
+			PrincipalID: principalID,
+			StartedAt:   now,
+			Notes:       notes,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return started, stopped, nil
+}
+
+// StopTimer stops the caller's timer on an item, appending notes to the entry.
+// Returns domain.ErrNoRunningTimer if the caller has no timer running on the item.
+func (s *Service) StopTimer(ctx context.Context, listID, itemID, notes string) (*domain.TimeEntry, error) {
+	principalID, err := timerPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	notes, err = domain.NewTimeEntryNotes(notes)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findListItem(ctx, listID, itemID); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireListRole(ctx, listID, domain.ListRoleEditor); err != nil {
+		return nil, err
+	}
+
+	return s.repo.StopRunningTimeEntry(ctx, principalID, itemID, time.Now().UTC(), notes)
+}
+
+// GetRunningTimer returns the caller's running timer.
+// Returns domain.ErrNoRunningTimer if none is running.
+func (s *Service) GetRunningTimer(ctx context.Context) (*domain.TimeEntry, error) {
+	principalID, err := timerPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.FindRunningTimeEntry(ctx, principalID)
+}
@@ -0,0 +1,156 @@
+package todo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTimeEntriesRepo stores time entries of items in the shared test list.
+type mockTimeEntriesRepo struct {
+	*mockMembersRepo
+	entries []*domain.TimeEntry
+}
+
+func newMockTimeEntriesRepo() *mockTimeEntriesRepo {
+	return &mockTimeEntriesRepo{mockMembersRepo: newMockMembersRepo()}
+}
+
+func (m *mockTimeEntriesRepo) Atomic(ctx context.Context, fn func(tx Repository) error) error {
+	return fn(m)
+}
+
+func (m *mockTimeEntriesRepo) FindTimeEntries(ctx context.Context, itemID string) ([]*domain.TimeEntry, error) {
+	var entries []*domain.TimeEntry
+	for _, entry := range m.entries {
+		if entry.ItemID == itemID {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (m *mockTimeEntriesRepo) FindRunningTimeEntry(ctx context.Context, principalID string) (*domain.TimeEntry, error) {
+	for _, entry := range m.entries {
+		if entry.PrincipalID == principalID && entry.Running() {
+			return entry, nil
+		}
+	}
+	return nil, domain.ErrNoRunningTimer
+}
+
+func (m *mockTimeEntriesRepo) CreateTimeEntry(ctx context.Context, entry *domain.TimeEntry) (*domain.TimeEntry, error) {
+	if entry.Running() {
+		if _, err := m.FindRunningTimeEntry(ctx, entry.PrincipalID); err == nil {
+			return nil, domain.ErrTimerAlreadyRunning
+		}
+	}
+	m.entries = append(m.entries, entry)
+	return entry, nil
+}
+
+func (m *mockTimeEntriesRepo) StopRunningTimeEntry(ctx context.Context, principalID, itemID string, endedAt time.Time, notes string) (*domain.TimeEntry, error) {
+	entry, err := m.FindRunningTimeEntry(ctx, principalID)
+	if err != nil || (itemID != "" && entry.ItemID != itemID) {
+		return nil, domain.ErrNoRunningTimer
+	}
+	entry.EndedAt = &endedAt
+	entry.Notes = notes
+	return entry, nil
+}
+
+func (m *mockTimeEntriesRepo) DeleteTimeEntry(ctx context.Context, itemID, entryID, principalID string) error {
+	for i, entry := range m.entries {
+		if entry.ID == entryID && entry.ItemID == itemID && (principalID == "" || entry.PrincipalID == principalID) {
+			m.entries = append(m.entries[:i], m.entries[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrTimeEntryNotFound
+}
+
+func TestStartTimer_StopsRunningTimer(t *testing.T) {
+	repo := newMockTimeEntriesRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+	ctx := asPrincipal(testEditorID)
+
+	first, stopped, err := service.StartTimer(ctx, testListID, "item-1", "drafting")
+	require.NoError(t, err)
+	assert.Nil(t, stopped)
+	assert.True(t, first.Running())
+	assert.Equal(t, testEditorID, first.PrincipalID)
+	assert.Equal(t, "drafting", first.Notes)
+
+	second, stopped, err := service.StartTimer(ctx, testListID, "item-2", "")
+	require.NoError(t, err)
+	require.NotNil(t, stopped)
+	assert.Equal(t, first.ID, stopped.ID)
+	assert.False(t, stopped.Running())
+
+	running, err := service.GetRunningTimer(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, second.ID, running.ID)
+
+	// Only the timer of the item is stopped
+	_, err = service.StopTimer(ctx, testListID, "item-1", "")
+	require.ErrorIs(t, err, domain.ErrNoRunningTimer)
+	_, err = service.StopTimer(ctx, testListID, "item-2", "done")
+	require.NoError(t, err)
+	_, err = service.GetRunningTimer(ctx)
+	require.ErrorIs(t, err, domain.ErrNoRunningTimer)
+}
+
+func TestStartTimer_RequiresPrincipalAndEditorRole(t *testing.T) {
+	repo := newMockTimeEntriesRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	_, _, err := service.StartTimer(context.Background(), testListID, "item-1", "")
+	require.ErrorIs(t, err, domain.ErrUnauthorized)
+
+	_, _, err = service.StartTimer(asPrincipal(testViewerID), testListID, "item-1", "")
+	require.ErrorIs(t, err, domain.ErrListAccessDenied)
+
+	assert.Empty(t, repo.entries)
+}
+
+func TestCreateTimeEntry_ValidatesPeriod(t *testing.T) {
+	repo := newMockTimeEntriesRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+	ctx := asPrincipal(testEditorID)
+	now := time.Now().UTC()
+
+	_, err := service.CreateTimeEntry(ctx, testListID, "item-1", now.Add(-time.Hour), now.Add(-2*time.Hour), "")
+	require.ErrorIs(t, err, domain.ErrInvalidTimeEntry)
+	_, err = service.CreateTimeEntry(ctx, testListID, "item-1", now.Add(-time.Hour), now.Add(time.Hour), "")
+	require.ErrorIs(t, err, domain.ErrInvalidTimeEntry)
+
+	entry, err := service.CreateTimeEntry(ctx, testListID, "item-1", now.Add(-2*time.Hour), now.Add(-time.Hour), " call ")
+	require.NoError(t, err)
+	assert.False(t, entry.Running())
+	assert.Equal(t, time.Hour, entry.Duration(now))
+	assert.Equal(t, "call", entry.Notes)
+}
+
+func TestDeleteTimeEntry_OnlyOwnerDeletesOthersEntries(t *testing.T) {
+	repo := newMockTimeEntriesRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+	now := time.Now().UTC()
+
+	entry, err := service.CreateTimeEntry(asPrincipal(testEditorID), testListID, "item-1", now.Add(-time.Hour), now, "")
+	require.NoError(t, err)
+	ownEntry, err := service.CreateTimeEntry(asPrincipal(testOwnerID), testListID, "item-1", now.Add(-time.Hour), now, "")
+	require.NoError(t, err)
+
+	err = service.DeleteTimeEntry(asPrincipal(testViewerID), testListID, "item-1", entry.ID)
+	require.ErrorIs(t, err, domain.ErrListAccessDenied)
+	err = service.DeleteTimeEntry(asPrincipal(testEditorID), testListID, "item-1", ownEntry.ID)
+	require.ErrorIs(t, err, domain.ErrTimeEntryNotFound)
+
+	require.NoError(t, service.DeleteTimeEntry(asPrincipal(testOwnerID), testListID, "item-1", entry.ID))
+	require.NoError(t, service.DeleteTimeEntry(asPrincipal(testOwnerID), testListID, "item-1", ownEntry.ID))
+	assert.Empty(t, repo.entries)
+}
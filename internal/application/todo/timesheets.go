@@ -0,0 +1,33 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// GetTimesheet returns the time tracked on the items of the caller's lists
+// from the YYYY-MM-DD day from through to, grouped by list, tag or day. Days
+// are in the IANA timezone (empty = UTC); an empty to is today and an empty
+// from domain.DefaultTimesheetDays days up to to.
+func (s *Service) GetTimesheet(ctx context.Context, from, to, groupBy, timezone string) (*domain.Timesheet, error) {
+	now := time.Now().UTC()
+	query, err := domain.NewTimesheetQuery(from, to, groupBy, timezone, now)
+	if err != nil {
+		return nil, err
+	}
+
+	periods, err := s.repo.FindTimesheetPeriods(ctx, query.Start(), query.End(), domain.MaxTimesheetPeriods+1)
+	if err != nil {
+		return nil, err
+	}
+	truncated := len(periods) > domain.MaxTimesheetPeriods
+	if truncated {
+		periods = periods[:domain.MaxTimesheetPeriods]
+	}
+
+	sheet := domain.NewTimesheet(query, periods, now)
+	sheet.Truncated = truncated
+	return sheet, nil
+}
@@ -0,0 +1,130 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// ListSavedViews returns the caller's saved views, oldest first.
+func (s *Service) ListSavedViews(ctx context.Context) ([]*domain.SavedView, error) {
+	return s.repo.FindSavedViews(ctx)
+}
+
+// GetSavedView retrieves a saved view by ID.
+func (s *Service) GetSavedView(ctx context.Context, id string) (*domain.SavedView, error) {
+	if id == "" {
+		return nil, domain.ErrSavedViewNotFound
+	}
+	return s.repo.FindSavedViewByID(ctx, id)
+}
+
+// CreateSavedView saves a named item search for the caller's tenant.
+// A view over one list requires access to that list.
+func (s *Service) CreateSavedView(ctx context.Context, view *domain.SavedView) (*domain.SavedView, error) {
+	if err := view.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.checkSavedViewList(ctx, view.ListID); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate id: %w", err)
+	}
+	view.ID = id.String()
+
+	// Like lists, the view belongs to the tenant of the authenticated caller
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		view.OwnerID = principal.OwnerID
+	}
+
+	now := time.Now().UTC()
+	view.CreatedAt = now
+	view.UpdatedAt = now
+
+	return s.repo.CreateSavedView(ctx, view)
+}
+
+// UpdateSavedView updates a saved view using field mask.
+func (s *Service) UpdateSavedView(ctx context.Context, params domain.UpdateSavedViewParams) (*domain.SavedView, error) {
+	if params.ID == "" {
+		return nil, domain.ErrSavedViewNotFound
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	view, err := s.repo.FindSavedViewByID(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(params.UpdateMask, "name") {
+		view.Name = *params.Name
+	}
+	if slices.Contains(params.UpdateMask, "list_id") {
+		if err := s.checkSavedViewList(ctx, params.ListID); err != nil {
+			return nil, err
+		}
+		view.ListID = params.ListID
+	}
+	if slices.Contains(params.UpdateMask, "filter") {
+		view.Filter = *params.Filter
+	}
+	if slices.Contains(params.UpdateMask, "due_after") {
+		view.DueAfter = params.DueAfter
+	}
+	if slices.Contains(params.UpdateMask, "due_before") {
+		view.DueBefore = params.DueBefore
+	}
+	if slices.Contains(params.UpdateMask, "include_snoozed") {
+		view.IncludeSnoozed = *params.IncludeSnoozed
+	}
+
+	if err := view.Validate(); err != nil {
+		return nil, err
+	}
+	view.UpdatedAt = time.Now().UTC()
+
+	return s.repo.UpdateSavedView(ctx, view)
+}
+
+// DeleteSavedView removes a saved view.
+func (s *Service) DeleteSavedView(ctx context.Context, id string) error {
+	if id == "" {
+		return domain.ErrSavedViewNotFound
+	}
+	return s.repo.DeleteSavedView(ctx, id)
+}
+
+// ListSavedViewItems runs a saved view through ListItems with the given page.
+// Relative due bounds are resolved at the time of the call, so the same view
+// and page size return a different window every day.
+func (s *Service) ListSavedViewItems(ctx context.Context, view *domain.SavedView, limit int, after *domain.PageCursor, includeTotalCount bool) (*domain.PagedResult, error) {
+	params, err := view.ListParams(time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	params.Limit = limit
+	params.After = after
+	params.IncludeTotalCount = includeTotalCount
+
+	return s.ListItems(ctx, params)
+}
+
+// checkSavedViewList verifies that the caller can access the list a view searches.
+// A nil list searches every accessible list and needs no check.
+func (s *Service) checkSavedViewList(ctx context.Context, listID *string) error {
+	if listID == nil {
+		return nil
+	}
+	_, err := s.repo.FindListByID(ctx, *listID)
+	return err
+}
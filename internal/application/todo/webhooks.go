@@ -0,0 +1,176 @@
+package todo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// Webhook delivery listing limits.
+const (
+	DefaultWebhookDeliveriesLimit = 50
+	MaxWebhookDeliveriesLimit     = 200
+)
+
+// generateWebhookSecret creates a random signing secret for subscriptions
+// created without one.
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// ListWebhooks returns the webhook subscriptions of the caller's tenant, oldest first.
+func (s *Service) ListWebhooks(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	return s.repo.FindWebhookSubscriptions(ctx)
+}
+
+// GetWebhook retrieves a webhook subscription by ID.
+func (s *Service) GetWebhook(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	if id == "" {
+		return nil, domain.ErrWebhookNotFound
+	}
+	return s.repo.FindWebhookSubscriptionByID(ctx, id)
+}
+
+// CreateWebhook subscribes a URL to the given event types of the lists the
+// caller's tenant owns or is a member of.
+// A signing secret is generated when none is provided; the returned
+// subscription is the only place the generated secret is exposed.
+func (s *Service) CreateWebhook(ctx context.Context, url, secret string, events []string) (*domain.WebhookSubscription, error) {
+	if err := domain.ValidateWebhookURL(url); err != nil {
+		return nil, err
+	}
+
+	filter, err := domain.NewEventFilter(events)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == "" {
+		secret, err = generateWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+	} else if err := domain.ValidateWebhookSecret(secret); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate id: %w", err)
+	}
+
+	now := time.Now().UTC()
+	sub := &domain.WebhookSubscription{
+		ID:        id.String(),
+		URL:       url,
+		Secret:    secret,
+		Events:    filter,
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	// Like saved views, the subscription belongs to the tenant of the authenticated caller
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		sub.OwnerID = principal.OwnerID
+	}
+
+	return s.repo.CreateWebhookSubscription(ctx, sub)
+}
+
+// UpdateWebhook updates a webhook subscription using field mask.
+// Deactivated subscriptions keep their pending deliveries until reactivated.
+func (s *Service) UpdateWebhook(ctx context.Context, params domain.UpdateWebhookParams) (*domain.WebhookSubscription, error) {
+	if params.ID == "" {
+		return nil, domain.ErrWebhookNotFound
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	sub, err := s.repo.FindWebhookSubscriptionByID(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(params.UpdateMask, "url") {
+		if err := domain.ValidateWebhookURL(*params.URL); err != nil {
+			return nil, err
+		}
+		sub.URL = *params.URL
+	}
+	if slices.Contains(params.UpdateMask, "secret") {
+		if err := domain.ValidateWebhookSecret(*params.Secret); err != nil {
+			return nil, err
+		}
+		sub.Secret = *params.Secret
+	}
+	if slices.Contains(params.UpdateMask, "events") {
+		filter, err := domain.NewEventFilter(params.Events)
+		if err != nil {
+			return nil, err
+		}
+		sub.Events = filter
+	}
+	if slices.Contains(params.UpdateMask, "is_active") {
+		sub.IsActive = *params.IsActive
+	}
+	sub.UpdatedAt = time.Now().UTC()
+
+	return s.repo.UpdateWebhookSubscription(ctx, sub)
+}
+
+// DeleteWebhook removes a webhook subscription and its delivery history.
+func (s *Service) DeleteWebhook(ctx context.Context, id string) error {
+	if id == "" {
+		return domain.ErrWebhookNotFound
+	}
+	return s.repo.DeleteWebhookSubscription(ctx, id)
+}
+
+// ListWebhookDeliveries returns the most recent deliveries of a subscription,
+// optionally filtered by status.
+func (s *Service) ListWebhookDeliveries(ctx context.Context, subscriptionID string, status *string, limit int) ([]*domain.WebhookDelivery, error) {
+	if _, err := s.GetWebhook(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	var filter *domain.WebhookDeliveryStatus
+	if status != nil {
+		st, err := domain.NewWebhookDeliveryStatus(*status)
+		if err != nil {
+			return nil, err
+		}
+		filter = &st
+	}
+
+	if limit <= 0 {
+		limit = DefaultWebhookDeliveriesLimit
+	}
+	limit = min(limit, MaxWebhookDeliveriesLimit)
+
+	return s.repo.FindWebhookDeliveries(ctx, subscriptionID, filter, limit)
+}
+
+// RedeliverWebhookDelivery queues a fresh delivery of the same event,
+// typically after a dead delivery's receiver has been fixed.
+func (s *Service) RedeliverWebhookDelivery(ctx context.Context, subscriptionID, deliveryID string) (*domain.WebhookDelivery, error) {
+	if deliveryID == "" {
+		return nil, domain.ErrWebhookDeliveryNotFound
+	}
+	if _, err := s.GetWebhook(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+	return s.repo.RedeliverWebhookDelivery(ctx, subscriptionID, deliveryID)
+}
@@ -0,0 +1,159 @@
+package todo
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockWebhooksRepo stores webhook subscriptions in memory.
+type mockWebhooksRepo struct {
+	*mockMembersRepo
+	subs          map[string]*domain.WebhookSubscription
+	deliveryLimit int
+}
+
+func newMockWebhooksRepo() *mockWebhooksRepo {
+	return &mockWebhooksRepo{
+		mockMembersRepo: newMockMembersRepo(),
+		subs:            make(map[string]*domain.WebhookSubscription),
+	}
+}
+
+func (m *mockWebhooksRepo) CreateWebhookSubscription(ctx context.Context, sub *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	m.subs[sub.ID] = sub
+	return sub, nil
+}
+
+func (m *mockWebhooksRepo) FindWebhookSubscriptionByID(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	sub, ok := m.subs[id]
+	if !ok {
+		return nil, domain.ErrWebhookNotFound
+	}
+	copied := *sub
+	return &copied, nil
+}
+
+func (m *mockWebhooksRepo) UpdateWebhookSubscription(ctx context.Context, sub *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	m.subs[sub.ID] = sub
+	return sub, nil
+}
+
+func (m *mockWebhooksRepo) FindWebhookDeliveries(ctx context.Context, subscriptionID string, status *domain.WebhookDeliveryStatus, limit int) ([]*domain.WebhookDelivery, error) {
+	m.deliveryLimit = limit
+	return nil, nil
+}
+
+func TestCreateWebhook_GeneratesSecret(t *testing.T) {
+	repo := newMockWebhooksRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	sub, err := service.CreateWebhook(context.Background(), "https://example.com/hooks", "", []string{"item.created", "item.created", "item.deleted"})
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(sub.Secret, "whsec_"))
+	assert.GreaterOrEqual(t, len(sub.Secret), domain.MinWebhookSecretLength)
+	assert.Equal(t, []domain.EventType{domain.EventItemCreated, domain.EventItemDeleted}, sub.Events)
+	assert.True(t, sub.IsActive)
+}
+
+func TestCreateWebhook_OwnedByCallerTenant(t *testing.T) {
+	repo := newMockWebhooksRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	sub, err := service.CreateWebhook(asPrincipal(testEditorID), "https://example.com/hooks", "", []string{"item.created"})
+	require.NoError(t, err)
+	assert.Equal(t, testEditorID, sub.OwnerID)
+
+	internal, err := service.CreateWebhook(context.Background(), "https://example.com/hooks", "", []string{"item.created"})
+	require.NoError(t, err)
+	assert.Empty(t, internal.OwnerID)
+}
+
+func TestCreateWebhook_Validation(t *testing.T) {
+	tests := []struct {
+		name   string
+		url    string
+		secret string
+		events []string
+	}{
+		{"relative url", "/hooks", "", []string{"item.created"}},
+		{"unsupported scheme", "ftp://example.com/hooks", "", []string{"item.created"}},
+		{"short secret", "https://example.com/hooks", "short", []string{"item.created"}},
+		{"no events", "https://example.com/hooks", "", nil},
+		{"unknown event", "https://example.com/hooks", "", []string{"item.archived"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockWebhooksRepo()
+			service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+			_, err := service.CreateWebhook(context.Background(), tt.url, tt.secret, tt.events)
+			require.ErrorIs(t, err, domain.ErrInvalidWebhook)
+			assert.Empty(t, repo.subs)
+		})
+	}
+}
+
+func TestUpdateWebhook_AppliesMaskedFields(t *testing.T) {
+	repo := newMockWebhooksRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	sub, err := service.CreateWebhook(context.Background(), "https://example.com/hooks", "", []string{"item.created"})
+	require.NoError(t, err)
+	originalSecret := sub.Secret
+
+	inactive := false
+	newURL := "https://example.com/other"
+	updated, err := service.UpdateWebhook(context.Background(), domain.UpdateWebhookParams{
+		ID:         sub.ID,
+		UpdateMask: []string{"is_active", "events"},
+		URL:        &newURL, // not in mask
+		Events:     []string{"list.created"},
+		IsActive:   &inactive,
+	})
+	require.NoError(t, err)
+
+	assert.False(t, updated.IsActive)
+	assert.Equal(t, []domain.EventType{domain.EventListCreated}, updated.Events)
+	assert.Equal(t, "https://example.com/hooks", updated.URL)
+	assert.Equal(t, originalSecret, updated.Secret)
+}
+
+func TestUpdateWebhook_RejectsUnknownMaskField(t *testing.T) {
+	service := NewService(newMockWebhooksRepo(), &mockTaskGenerator{}, Config{})
+
+	_, err := service.UpdateWebhook(context.Background(), domain.UpdateWebhookParams{
+		ID:         "sub-1",
+		UpdateMask: []string{"created_at"},
+	})
+	require.ErrorIs(t, err, domain.ErrUnknownField)
+}
+
+func TestListWebhookDeliveries_ValidatesStatusAndLimit(t *testing.T) {
+	repo := newMockWebhooksRepo()
+	service := NewService(repo, &mockTaskGenerator{}, Config{})
+
+	sub, err := service.CreateWebhook(context.Background(), "https://example.com/hooks", "", []string{"item.created"})
+	require.NoError(t, err)
+
+	unknown := "failed"
+	_, err = service.ListWebhookDeliveries(context.Background(), sub.ID, &unknown, 0)
+	require.ErrorIs(t, err, domain.ErrInvalidWebhook)
+
+	_, err = service.ListWebhookDeliveries(context.Background(), sub.ID, nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultWebhookDeliveriesLimit, repo.deliveryLimit)
+
+	_, err = service.ListWebhookDeliveries(context.Background(), sub.ID, nil, 10_000)
+	require.NoError(t, err)
+	assert.Equal(t, MaxWebhookDeliveriesLimit, repo.deliveryLimit)
+
+	_, err = service.ListWebhookDeliveries(context.Background(), "missing", nil, 0)
+	require.ErrorIs(t, err, domain.ErrWebhookNotFound)
+}
@@ -0,0 +1,150 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// AutomationConfig holds configuration for the automation rules worker.
+type AutomationConfig struct {
+	// WorkerID is the unique identifier for this worker instance
+	// Used for lease ownership verification
+	WorkerID string
+
+	// Interval between runs of overdue rules (default: 5m)
+	// Bounds how late an overdue rule fires after its item becomes overdue
+	Interval time.Duration
+
+	// MaxStartupJitter is the maximum random delay before first run (default: 30s)
+	// Prevents thundering herd when multiple workers start simultaneously
+	MaxStartupJitter time.Duration
+
+	// BatchSize is how many overdue rule firings are processed per batch (default: 100)
+	BatchSize int
+
+	// LeaseDuration is how long the exclusive lease is valid (default: 5min)
+	LeaseDuration time.Duration
+}
+
+// DefaultAutomationConfig returns sensible defaults.
+func DefaultAutomationConfig(workerID string) AutomationConfig {
+	return AutomationConfig{
+		WorkerID:         workerID,
+		Interval:         5 * time.Minute,
+		MaxStartupJitter: 30 * time.Second,
+		BatchSize:        100,
+		LeaseDuration:    5 * time.Minute,
+	}
+}
+
+// AutomationRunType is the exclusive run type held while running overdue rules.
+const AutomationRunType = "automation-rules"
+
+// OverdueRuleRunner runs the automation rules triggered by items becoming overdue.
+type OverdueRuleRunner interface {
+	// RunOverdueRules fires up to limit overdue rules that have not fired for
+	// the current due date of their item. Returns the number processed.
+	RunOverdueRules(ctx context.Context, now time.Time, limit int) (int, error)
+}
+
+// AutomationWorker fires the time-based automation rules, which no mutation
+// triggers. Runs as a single instance behind an exclusive lease.
+type AutomationWorker struct {
+	coordinator AutomationCoordinator
+	runner      OverdueRuleRunner
+	cfg         AutomationConfig
+}
+
+// NewAutomationWorker creates an automation worker with the given configuration.
+func NewAutomationWorker(coordinator AutomationCoordinator, runner OverdueRuleRunner, cfg AutomationConfig) *AutomationWorker {
+	return &AutomationWorker{
+		coordinator: coordinator,
+		runner:      runner,
+		cfg:         cfg,
+	}
+}
+
+// Run fires overdue rules after a jittered startup delay, then every Interval,
+// until the context is cancelled.
+func (w *AutomationWorker) Run(ctx context.Context) error {
+	if w.cfg.MaxStartupJitter > 0 {
+		jitter := rand.N(w.cfg.MaxStartupJitter)
+		slog.InfoContext(ctx, "automation worker starting",
+			"startup_jitter", jitter,
+			"interval", w.cfg.Interval)
+
+		timer := time.NewTimer(jitter)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if _, err := w.RunOnce(ctx); err != nil {
+		slog.ErrorContext(ctx, "initial automation run failed", "error", err)
+	}
+
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "automation worker stopping")
+			return ctx.Err()
+		case <-ticker.C:
+			if _, err := w.RunOnce(ctx); err != nil {
+				slog.ErrorContext(ctx, "automation run failed", "error", err)
+			}
+		}
+	}
+}
+
+// RunOnce fires every overdue rule that is due, batch by batch.
+// Returns the number of firings processed.
+func (w *AutomationWorker) RunOnce(ctx context.Context) (int, error) {
+	ctx = domain.WithInternalAccess(ctx)
+
+	release, acquired, err := w.coordinator.TryAcquireExclusiveRun(ctx, AutomationRunType, w.cfg.WorkerID, w.cfg.LeaseDuration)
+	if err != nil {
+		return 0, fmt.Errorf("failed to acquire lease: %w", err)
+	}
+	if !acquired {
+		slog.DebugContext(ctx, "automation run skipped, another instance holds the lease")
+		return 0, nil
+	}
+	defer release()
+
+	startTime := time.Now().UTC()
+
+	processed := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return processed, err
+		}
+		// Fired rules are recorded, so each batch picks up where the last ended
+		n, err := w.runner.RunOverdueRules(ctx, time.Now().UTC(), w.cfg.BatchSize)
+		processed += n
+		if err != nil {
+			return processed, err
+		}
+		if n < w.cfg.BatchSize {
+			break
+		}
+	}
+
+	if processed > 0 {
+		slog.InfoContext(ctx, "overdue rules fired",
+			"processed", processed,
+			"duration", time.Since(startTime))
+	}
+	return processed, nil
+}
@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// mockAutomationCoordinator grants the lease unless it is held elsewhere.
+type mockAutomationCoordinator struct {
+	leaseHeld bool
+}
+
+func (m *mockAutomationCoordinator) TryAcquireExclusiveRun(ctx context.Context, runType string, holderID string, leaseDuration time.Duration) (func(), bool, error) {
+	if m.leaseHeld {
+		return nil, false, nil
+	}
+	return func() {}, true, nil
+}
+
+// mockOverdueRuleRunner has a fixed number of overdue rule firings pending.
+type mockOverdueRuleRunner struct {
+	pending int
+	calls   int
+}
+
+func (m *mockOverdueRuleRunner) RunOverdueRules(ctx context.Context, now time.Time, limit int) (int, error) {
+	m.calls++
+	n := min(m.pending, limit)
+	m.pending -= n
+	return n, nil
+}
+
+func TestAutomationWorker_RunsOverdueRulesInBatches(t *testing.T) {
+	runner := &mockOverdueRuleRunner{pending: 5}
+	cfg := DefaultAutomationConfig("test-worker")
+	cfg.BatchSize = 2
+	w := NewAutomationWorker(&mockAutomationCoordinator{}, runner, cfg)
+
+	processed, err := w.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if processed != 5 {
+		t.Errorf("expected 5 firings processed, got %d", processed)
+	}
+	if runner.calls != 3 {
+		t.Errorf("expected 3 batches, got %d", runner.calls)
+	}
+}
+
+func TestAutomationWorker_SkipsWithoutLease(t *testing.T) {
+	runner := &mockOverdueRuleRunner{pending: 1}
+	w := NewAutomationWorker(&mockAutomationCoordinator{leaseHeld: true}, runner, DefaultAutomationConfig("test-worker"))
+
+	processed, err := w.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if processed != 0 || runner.calls != 0 {
+		t.Errorf("expected no rules run without the lease, got %d", processed)
+	}
+}
@@ -76,6 +76,10 @@ type GenerationCoordinator interface {
 	// errType: "permanent", "exhausted", or "panic"
 	MoveToDeadLetter(ctx context.Context, job *domain.GenerationJob, workerID, errType, errMsg string, stackTrace *string) error
 
+	// Listing, retrying and discarding dead letter jobs is scoped to the tenant of
+	// the principal in the context, through the list of the job's template.
+	// Jobs of other tenants are reported as not found.
+
 	// ListDeadLetterJobs returns unresolved dead letter jobs for manual review.
 	// Results are limited to the specified count.
 	ListDeadLetterJobs(ctx context.Context, limit int) ([]*domain.DeadLetterJob, error)
@@ -98,6 +102,108 @@ type GenerationCoordinator interface {
 	TryAcquireExclusiveRun(ctx context.Context, runType string, holderID string, leaseDuration time.Duration) (release func(), acquired bool, err error)
 }
 
+// ReminderCoordinator manages delivery of due item reminders.
+// Reminders are claimed, heartbeated and retried like generation jobs, but a
+// reminder that keeps failing is parked as dead on its own row instead of
+// moving to the dead letter queue.
+type ReminderCoordinator interface {
+	// ClaimNextReminder atomically claims the next due reminder.
+	// Returns nil if no reminders are due.
+	// The claimed reminder is locked to workerID for availabilityTimeout duration.
+	ClaimNextReminder(ctx context.Context, workerID string, availabilityTimeout time.Duration) (*domain.Reminder, error)
+
+	// ExtendReminderAvailability extends the lock duration for a reminder being delivered.
+	// Returns domain.ErrReminderOwnershipLost if the reminder is no longer claimed by this worker.
+	ExtendReminderAvailability(ctx context.Context, reminderID, workerID string, extension time.Duration) error
+
+	// CompleteReminder marks a reminder as sent or skipped.
+	// Returns domain.ErrReminderOwnershipLost if the reminder is no longer claimed by this worker.
+	CompleteReminder(ctx context.Context, reminderID, workerID string, status domain.ReminderStatus) error
+
+	// FailReminder schedules another delivery attempt with increasing delays.
+	// If max retries exceeded, the reminder is marked dead.
+	// Returns true if the reminder will be retried, false if it was marked dead.
+	FailReminder(ctx context.Context, reminder *domain.Reminder, workerID, errMsg string, cfg RetryConfig) (willRetry bool, err error)
+
+	// MarkReminderDead parks a reminder that failed permanently.
+	// Returns domain.ErrReminderOwnershipLost if the reminder is no longer claimed by this worker.
+	MarkReminderDead(ctx context.Context, reminder *domain.Reminder, workerID, errMsg string) error
+}
+
+// WebhookCoordinator manages delivery of events to webhook subscriptions.
+// Deliveries are claimed, heartbeated and retried like reminders; a delivery
+// that keeps failing is parked as dead and can be redelivered by an admin.
+type WebhookCoordinator interface {
+	// ClaimNextWebhookDelivery atomically claims the next deliverable event.
+	// Returns nil if nothing is waiting. Deliveries of inactive subscriptions are not claimed.
+	// The claimed delivery is locked to workerID for availabilityTimeout duration.
+	ClaimNextWebhookDelivery(ctx context.Context, workerID string, availabilityTimeout time.Duration) (*domain.WebhookDelivery, error)
+
+	// ExtendWebhookDeliveryAvailability extends the lock duration for a delivery in progress.
+	// Returns domain.ErrWebhookDeliveryOwnershipLost if the delivery is no longer claimed by this worker.
+	ExtendWebhookDeliveryAvailability(ctx context.Context, deliveryID, workerID string, extension time.Duration) error
+
+	// CompleteWebhookDelivery marks a delivery as delivered with the receiver's response status.
+	// Returns domain.ErrWebhookDeliveryOwnershipLost if the delivery is no longer claimed by this worker.
+	CompleteWebhookDelivery(ctx context.Context, deliveryID, workerID string, responseStatus int) error
+
+	// FailWebhookDelivery schedules another attempt with increasing delays.
+	// If max retries exceeded, the delivery is marked dead.
+	// responseStatus is nil when the receiver could not be reached.
+	// Returns true if the delivery will be retried, false if it was marked dead.
+	FailWebhookDelivery(ctx context.Context, delivery *domain.WebhookDelivery, workerID, errMsg string, responseStatus *int, cfg RetryConfig) (willRetry bool, err error)
+
+	// MarkWebhookDeliveryDead parks a delivery that failed permanently.
+	// Returns domain.ErrWebhookDeliveryOwnershipLost if the delivery is no longer claimed by this worker.
+	MarkWebhookDeliveryDead(ctx context.Context, delivery *domain.WebhookDelivery, workerID, errMsg string, responseStatus *int) error
+}
+
+// OutboxCoordinator gives the relay access to the transactional change log.
+// Rows are appended in the same transaction as the change they describe.
+type OutboxCoordinator interface {
+	// ListUnpublishedChanges returns the oldest changes not yet published, in seq order.
+	ListUnpublishedChanges(ctx context.Context, limit int) ([]*domain.Change, error)
+
+	// MarkChangesPublished stamps the given changes as published.
+	// Changes that are already published are left untouched.
+	MarkChangesPublished(ctx context.Context, seqs []int64) error
+
+	// TryAcquireExclusiveRun attempts to acquire an exclusive execution lock.
+	// The relay runs as a single instance so changes are published in order.
+	TryAcquireExclusiveRun(ctx context.Context, runType string, holderID string, leaseDuration time.Duration) (release func(), acquired bool, err error)
+}
+
+// SnapshotCoordinator gives the snapshot worker access to the item counts of lists.
+type SnapshotCoordinator interface {
+	// RecordListSnapshots records the current item counts of up to limit lists,
+	// in ID order after afterListID (empty = from the first list), as their
+	// snapshot of day, replacing one recorded earlier that day.
+	// Returns the IDs of the lists recorded.
+	RecordListSnapshots(ctx context.Context, day, recordedAt time.Time, afterListID string, limit int) ([]string, error)
+
+	// TryAcquireExclusiveRun attempts to acquire an exclusive execution lock.
+	// A single instance records snapshots at a time.
+	TryAcquireExclusiveRun(ctx context.Context, runType string, holderID string, leaseDuration time.Duration) (release func(), acquired bool, err error)
+}
+
+// AutomationCoordinator keeps a single instance running time-based automation rules.
+type AutomationCoordinator interface {
+	// TryAcquireExclusiveRun attempts to acquire an exclusive execution lock.
+	// A single instance runs overdue rules at a time.
+	TryAcquireExclusiveRun(ctx context.Context, runType string, holderID string, leaseDuration time.Duration) (release func(), acquired bool, err error)
+}
+
+// SnoozeCoordinator wakes snoozed items once their snooze has passed.
+type SnoozeCoordinator interface {
+	// WakeSnoozedItems clears the snooze of up to limit items snoozed until now
+	// or earlier. Returns the IDs of the items woken.
+	WakeSnoozedItems(ctx context.Context, now time.Time, limit int) ([]string, error)
+
+	// TryAcquireExclusiveRun attempts to acquire an exclusive execution lock.
+	// A single instance wakes items at a time.
+	TryAcquireExclusiveRun(ctx context.Context, runType string, holderID string, leaseDuration time.Duration) (release func(), acquired bool, err error)
+}
+
 // RetryConfig configures retry behavior for failed jobs.
 type RetryConfig struct {
 	MaxRetries int           // Maximum retry attempts (default: 3)
@@ -139,3 +245,57 @@ func DefaultWorkerConfig(workerID string) WorkerConfig {
 		RetryConfig:         DefaultRetryConfig(),
 	}
 }
+
+// ReminderConfig configures reminder delivery workers.
+type ReminderConfig struct {
+	WorkerID            string        // Unique worker identifier
+	Concurrency         int           // Max concurrent deliveries (default: 5, must be > 0)
+	AvailabilityTimeout time.Duration // Reminder reclaim timeout (default: 2min)
+	HeartbeatInterval   time.Duration // Lock extension frequency (default: 30s, should be < AvailabilityTimeout)
+	PollInterval        time.Duration // Due reminder polling frequency (default: 5s)
+	RetryConfig         RetryConfig   // Retry policy for failed deliveries
+}
+
+// DefaultReminderConfig returns default reminder worker configuration.
+func DefaultReminderConfig(workerID string) ReminderConfig {
+	return ReminderConfig{
+		WorkerID:            workerID,
+		Concurrency:         5,
+		AvailabilityTimeout: 2 * time.Minute,
+		HeartbeatInterval:   30 * time.Second,
+		PollInterval:        5 * time.Second,
+		RetryConfig: RetryConfig{
+			MaxRetries: 5,
+			BaseDelay:  30 * time.Second,
+			MaxDelay:   30 * time.Minute,
+		},
+	}
+}
+
+// WebhookConfig configures webhook delivery workers.
+type WebhookConfig struct {
+	WorkerID            string        // Unique worker identifier
+	Concurrency         int           // Max concurrent deliveries (default: 5, must be > 0)
+	AvailabilityTimeout time.Duration // Delivery reclaim timeout (default: 2min)
+	HeartbeatInterval   time.Duration // Lock extension frequency (default: 30s, should be < AvailabilityTimeout)
+	PollInterval        time.Duration // Pending delivery polling frequency (default: 2s)
+	RetryConfig         RetryConfig   // Retry policy for failed deliveries
+}
+
+// DefaultWebhookConfig returns default webhook worker configuration.
+// Receivers get more retries with longer backoff than reminders, since
+// subscribers may be down for maintenance.
+func DefaultWebhookConfig(workerID string) WebhookConfig {
+	return WebhookConfig{
+		WorkerID:            workerID,
+		Concurrency:         5,
+		AvailabilityTimeout: 2 * time.Minute,
+		HeartbeatInterval:   30 * time.Second,
+		PollInterval:        2 * time.Second,
+		RetryConfig: RetryConfig{
+			MaxRetries: 10,
+			BaseDelay:  30 * time.Second,
+			MaxDelay:   6 * time.Hour,
+		},
+	}
+}
@@ -40,6 +40,8 @@ func NewGenerationWorker(coordinator GenerationCoordinator, repo Repository, gen
 // Returns nil if a job was processed (successfully or not), or if no jobs available.
 // Only returns error for infrastructure failures that should stop the worker.
 func (w *GenerationWorker) RunProcessOnce(ctx context.Context) error {
+	ctx = domain.WithInternalAccess(ctx)
+
 	job, err := w.coordinator.ClaimNextJob(ctx, w.cfg.WorkerID, w.cfg.AvailabilityTimeout)
 	if err != nil {
 		return fmt.Errorf("failed to claim job: %w", err)
@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// Notifier delivers due reminders to the outside world (webhook, email, push).
+//
+// Delivery is at-least-once: a reminder may be delivered again if the worker
+// crashes after notifying but before recording the delivery. Implementations
+// should pass the reminder ID along so receivers can deduplicate.
+//
+// Return Transient(err) for failures worth retrying (network errors, 5xx);
+// any other error marks the reminder dead.
+type Notifier interface {
+	Notify(ctx context.Context, notification ReminderNotification) error
+}
+
+// ReminderNotification is the payload handed to a Notifier.
+type ReminderNotification struct {
+	Reminder *domain.Reminder
+	Item     *domain.TodoItem
+	List     *domain.TodoList
+}
+
+// WebhookSender posts a delivery's payload to its subscription URL, signed
+// with the subscription secret.
+//
+// Returns the HTTP status of the response, or 0 if no response was received.
+// Return Transient(err) for failures worth retrying (network errors, 408,
+// 429, 5xx); any other error marks the delivery dead.
+type WebhookSender interface {
+	Send(ctx context.Context, sub *domain.WebhookSubscription, delivery *domain.WebhookDelivery) (statusCode int, err error)
+}
+
+// EventPublisher hands events relayed from the change log to subscribers.
+//
+// The relay publishes a change again if it crashes before marking it
+// published, with the same event ID. Implementations must treat a repeated
+// event ID as already published.
+type EventPublisher interface {
+	PublishEvent(ctx context.Context, event *domain.Event) error
+}
@@ -0,0 +1,186 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// OutboxRelayConfig holds configuration for the change log relay.
+type OutboxRelayConfig struct {
+	// WorkerID is the unique identifier for this worker instance
+	// Used for lease ownership verification
+	WorkerID string
+
+	// PollInterval is how long to wait when the change log is drained (default: 1s)
+	PollInterval time.Duration
+
+	// BatchSize limits changes published per run (default: 100)
+	// A full batch is followed immediately by the next one
+	BatchSize int
+
+	// LeaseDuration is how long the exclusive lease is valid (default: 30s)
+	LeaseDuration time.Duration
+}
+
+// DefaultOutboxRelayConfig returns sensible defaults.
+func DefaultOutboxRelayConfig(workerID string) OutboxRelayConfig {
+	return OutboxRelayConfig{
+		WorkerID:      workerID,
+		PollInterval:  time.Second,
+		BatchSize:     100,
+		LeaseDuration: 30 * time.Second,
+	}
+}
+
+// OutboxRelayRunType is the exclusive run type held while relaying changes.
+const OutboxRelayRunType = "change-log-relay"
+
+// changeEventNamespace derives stable event IDs from change log rows, so a
+// change that is relayed twice produces the same events.
+var changeEventNamespace = uuid.MustParse("0b6f7c2e-5a41-4f0e-9d2a-8c1e3f5b7a90")
+
+// OutboxRelay publishes change log rows as events, in order, and marks them published.
+// Runs as a single instance behind an exclusive lease; delivery is at-least-once.
+type OutboxRelay struct {
+	coordinator OutboxCoordinator
+	publisher   EventPublisher
+	cfg         OutboxRelayConfig
+}
+
+// NewOutboxRelay creates a change log relay with the given configuration.
+func NewOutboxRelay(coordinator OutboxCoordinator, publisher EventPublisher, cfg OutboxRelayConfig) *OutboxRelay {
+	return &OutboxRelay{
+		coordinator: coordinator,
+		publisher:   publisher,
+		cfg:         cfg,
+	}
+}
+
+// Run relays changes until the context is cancelled.
+// Polls every PollInterval once the change log is drained.
+func (r *OutboxRelay) Run(ctx context.Context) error {
+	slog.InfoContext(ctx, "outbox relay starting", "poll_interval", r.cfg.PollInterval)
+
+	for {
+		published, err := r.RunOnce(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "outbox relay failed", "error", err)
+		}
+
+		// A full batch means more changes are probably waiting
+		if err == nil && published == r.cfg.BatchSize {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "outbox relay stopping")
+			return ctx.Err()
+		case <-time.After(r.cfg.PollInterval):
+		}
+	}
+}
+
+// RunOnce publishes one batch of unpublished changes.
+// Publishing stops at the first failure so later changes never overtake
+// earlier ones; the changes published before it are still marked.
+// Returns the number of changes marked published.
+func (r *OutboxRelay) RunOnce(ctx context.Context) (int, error) {
+	ctx = domain.WithInternalAccess(ctx)
+
+	release, acquired, err := r.coordinator.TryAcquireExclusiveRun(ctx, OutboxRelayRunType, r.cfg.WorkerID, r.cfg.LeaseDuration)
+	if err != nil {
+		return 0, fmt.Errorf("failed to acquire lease: %w", err)
+	}
+	if !acquired {
+		slog.DebugContext(ctx, "outbox relay skipped, another instance holds the lease")
+		return 0, nil
+	}
+	defer release()
+
+	changes, err := r.coordinator.ListUnpublishedChanges(ctx, r.cfg.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list unpublished changes: %w", err)
+	}
+	if len(changes) == 0 {
+		return 0, nil
+	}
+
+	published := make([]int64, 0, len(changes))
+	var publishErr error
+	for _, change := range changes {
+		if publishErr = r.publishChange(ctx, change); publishErr != nil {
+			publishErr = fmt.Errorf("failed to publish change %d: %w", change.Seq, publishErr)
+			break
+		}
+		published = append(published, change.Seq)
+	}
+
+	if len(published) > 0 {
+		if err := r.coordinator.MarkChangesPublished(ctx, published); err != nil {
+			return 0, fmt.Errorf("failed to mark changes published: %w", err)
+		}
+		slog.DebugContext(ctx, "outbox relay published changes",
+			"count", len(published),
+			"last_seq", published[len(published)-1])
+	}
+
+	return len(published), publishErr
+}
+
+// publishChange publishes every event the change maps to.
+func (r *OutboxRelay) publishChange(ctx context.Context, change *domain.Change) error {
+	for _, event := range ChangeEvents(change) {
+		if err := r.publisher.PublishEvent(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChangeEvents builds the events subscribers receive for a change.
+// Event data is the entity snapshot; item.status_changed also carries previous_status
+// and item.woke previous_snoozed_until.
+// Event IDs are derived from the change seq and event type.
+func ChangeEvents(change *domain.Change) []*domain.Event {
+	types := change.EventTypes()
+	if len(types) == 0 {
+		return nil
+	}
+
+	events := make([]*domain.Event, 0, len(types))
+	for _, eventType := range types {
+		data := maps.Clone(change.Snapshot)
+		if data == nil {
+			data = make(map[string]any)
+		}
+		if eventType == domain.EventItemStatusChanged {
+			data["previous_status"] = change.Diff["status"].Old
+		}
+		if eventType == domain.EventItemWoke {
+			data["previous_snoozed_until"] = change.Diff["snoozed_until"].Old
+		}
+
+		event := &domain.Event{
+			ID:         uuid.NewSHA1(changeEventNamespace, fmt.Appendf(nil, "%d:%s", change.Seq, eventType)).String(),
+			Type:       eventType,
+			OccurredAt: change.OccurredAt,
+			Data:       data,
+			ListID:     change.ListID,
+		}
+		if change.EntityType == domain.ChangeEntityDeadLetter {
+			event.TemplateID, _ = change.Snapshot["template_id"].(string)
+		}
+		events = append(events, event)
+	}
+	return events
+}
@@ -0,0 +1,186 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// mockOutboxCoordinator serves a fixed change log and records what was marked published.
+type mockOutboxCoordinator struct {
+	changes   []*domain.Change
+	published []int64
+	leaseHeld bool
+}
+
+func (m *mockOutboxCoordinator) ListUnpublishedChanges(ctx context.Context, limit int) ([]*domain.Change, error) {
+	var pending []*domain.Change
+	for _, c := range m.changes {
+		if !slices.Contains(m.published, c.Seq) && len(pending) < limit {
+			pending = append(pending, c)
+		}
+	}
+	return pending, nil
+}
+
+func (m *mockOutboxCoordinator) MarkChangesPublished(ctx context.Context, seqs []int64) error {
+	m.published = append(m.published, seqs...)
+	return nil
+}
+
+func (m *mockOutboxCoordinator) TryAcquireExclusiveRun(ctx context.Context, runType string, holderID string, leaseDuration time.Duration) (func(), bool, error) {
+	if m.leaseHeld {
+		return nil, false, nil
+	}
+	return func() {}, true, nil
+}
+
+// recordingEventPublisher records published events and fails on a chosen event type.
+type recordingEventPublisher struct {
+	events []*domain.Event
+	failOn domain.EventType
+}
+
+func (p *recordingEventPublisher) PublishEvent(ctx context.Context, event *domain.Event) error {
+	if event.Type == p.failOn {
+		return errors.New("database unavailable")
+	}
+	p.events = append(p.events, event)
+	return nil
+}
+
+func testChanges() []*domain.Change {
+	return []*domain.Change{
+		{Seq: 1, EntityType: domain.ChangeEntityList, EntityID: "list-1", Operation: domain.ChangeCreated,
+			Snapshot: map[string]any{"id": "list-1", "title": "Groceries"}},
+		{Seq: 2, EntityType: domain.ChangeEntityItem, EntityID: "item-1", Operation: domain.ChangeUpdated,
+			Snapshot: map[string]any{"id": "item-1", "status": "done"},
+			Diff:     map[string]domain.FieldChange{"status": {Old: "todo", New: "done"}}},
+		{Seq: 3, EntityType: domain.ChangeEntityItem, EntityID: "item-1", Operation: domain.ChangeDeleted,
+			Snapshot: map[string]any{"id": "item-1", "status": "done"}},
+	}
+}
+
+func TestOutboxRelay_PublishesInOrder(t *testing.T) {
+	coordinator := &mockOutboxCoordinator{changes: testChanges()}
+	publisher := &recordingEventPublisher{}
+	relay := NewOutboxRelay(coordinator, publisher, DefaultOutboxRelayConfig("test-worker"))
+
+	published, err := relay.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if published != 3 {
+		t.Errorf("expected 3 changes published, got %d", published)
+	}
+
+	var types []domain.EventType
+	for _, e := range publisher.events {
+		types = append(types, e.Type)
+	}
+	want := []domain.EventType{domain.EventListCreated, domain.EventItemUpdated, domain.EventItemStatusChanged, domain.EventItemDeleted}
+	if !slices.Equal(types, want) {
+		t.Errorf("expected events %v, got %v", want, types)
+	}
+	if got := publisher.events[2].Data["previous_status"]; got != "todo" {
+		t.Errorf("expected previous_status todo, got %v", got)
+	}
+	if !slices.Equal(coordinator.published, []int64{1, 2, 3}) {
+		t.Errorf("expected all changes marked published, got %v", coordinator.published)
+	}
+}
+
+func TestOutboxRelay_StopsAtFirstFailure(t *testing.T) {
+	coordinator := &mockOutboxCoordinator{changes: testChanges()}
+	publisher := &recordingEventPublisher{failOn: domain.EventItemStatusChanged}
+	relay := NewOutboxRelay(coordinator, publisher, DefaultOutboxRelayConfig("test-worker"))
+
+	published, err := relay.RunOnce(context.Background())
+	if err == nil {
+		t.Fatal("expected publish failure to be returned")
+	}
+	if published != 1 {
+		t.Errorf("expected only the change before the failure to be published, got %d", published)
+	}
+	if !slices.Equal(coordinator.published, []int64{1}) {
+		t.Errorf("later changes must not be marked published, got %v", coordinator.published)
+	}
+
+	// The failed change is retried first on the next run
+	publisher.failOn = ""
+	if _, err := relay.RunOnce(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(coordinator.published, []int64{1, 2, 3}) {
+		t.Errorf("expected remaining changes published in order, got %v", coordinator.published)
+	}
+}
+
+func TestOutboxRelay_SkipsWhenLeaseHeld(t *testing.T) {
+	coordinator := &mockOutboxCoordinator{changes: testChanges(), leaseHeld: true}
+	publisher := &recordingEventPublisher{}
+	relay := NewOutboxRelay(coordinator, publisher, DefaultOutboxRelayConfig("test-worker"))
+
+	published, err := relay.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if published != 0 || len(publisher.events) != 0 {
+		t.Errorf("relay must not publish while another instance holds the lease")
+	}
+}
+
+func TestChangeEvents_StableIDs(t *testing.T) {
+	change := testChanges()[1]
+
+	first := ChangeEvents(change)
+	second := ChangeEvents(change)
+	if len(first) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(first))
+	}
+	for i := range first {
+		if first[i].ID != second[i].ID {
+			t.Errorf("event %d: relaying a change twice must produce the same ID", i)
+		}
+	}
+	if first[0].ID == first[1].ID {
+		t.Error("events of one change must have distinct IDs")
+	}
+	if _, ok := change.Snapshot["previous_status"]; ok {
+		t.Error("building events must not modify the change snapshot")
+	}
+}
+
+func TestChangeEvents_WokeCarriesPreviousSnooze(t *testing.T) {
+	change := &domain.Change{Seq: 4, EntityType: domain.ChangeEntityItem, EntityID: "item-1", Operation: domain.ChangeUpdated,
+		Snapshot: map[string]any{"id": "item-1", "snoozed_until": nil},
+		Diff:     map[string]domain.FieldChange{"snoozed_until": {Old: "2026-03-23T08:00:00+00:00", New: nil}}}
+
+	events := ChangeEvents(change)
+	if len(events) != 2 || events[1].Type != domain.EventItemWoke {
+		t.Fatalf("expected item.updated and item.woke, got %v", events)
+	}
+	if got := events[1].Data["previous_snoozed_until"]; got != "2026-03-23T08:00:00+00:00" {
+		t.Errorf("expected previous_snoozed_until, got %v", got)
+	}
+}
+
+func TestChangeEvents_CarryEventScope(t *testing.T) {
+	item := &domain.Change{Seq: 5, EntityType: domain.ChangeEntityItem, EntityID: "item-1", ListID: "list-1",
+		Operation: domain.ChangeCreated, Snapshot: map[string]any{"id": "item-1"}}
+	events := ChangeEvents(item)
+	if len(events) != 1 || events[0].ListID != "list-1" || events[0].TemplateID != "" {
+		t.Fatalf("item events must belong to the item's list, got %+v", events)
+	}
+
+	deadLetter := &domain.Change{Seq: 6, EntityType: domain.ChangeEntityDeadLetter, EntityID: "dlq-1",
+		Operation: domain.ChangeCreated, Snapshot: map[string]any{"id": "dlq-1", "template_id": "template-1"}}
+	events = ChangeEvents(deadLetter)
+	if len(events) != 1 || events[0].ListID != "" || events[0].TemplateID != "template-1" {
+		t.Fatalf("dead letter events must belong to the job's template, got %+v", events)
+	}
+}
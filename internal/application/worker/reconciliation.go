@@ -130,6 +130,8 @@ func (w *ReconciliationWorker) Run(ctx context.Context) error {
 // reconcileOnce runs a single reconciliation cycle.
 // Acquires exclusive lease, finds stale templates, and generates missing items.
 func (w *ReconciliationWorker) reconcileOnce(ctx context.Context) error {
+	ctx = domain.WithInternalAccess(ctx)
+
 	startTime := time.Now().UTC()
 
 	// Acquire exclusive lease for single-instance execution
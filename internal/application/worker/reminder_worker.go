@@ -0,0 +1,197 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// ReminderWorker delivers due reminders with availability timeout and heartbeat.
+// Uses ReminderCoordinator for claiming, ownership verification and stuck reminder recovery.
+type ReminderWorker struct {
+	coordinator ReminderCoordinator
+	repo        ReminderRepository
+	notifier    Notifier
+	cfg         ReminderConfig
+}
+
+// NewReminderWorker creates a reminder worker with the given configuration.
+func NewReminderWorker(coordinator ReminderCoordinator, repo ReminderRepository, notifier Notifier, cfg ReminderConfig) *ReminderWorker {
+	return &ReminderWorker{
+		coordinator: coordinator,
+		repo:        repo,
+		notifier:    notifier,
+		cfg:         cfg,
+	}
+}
+
+// RunProcessOnce claims and delivers a single due reminder.
+// Returns nil if a reminder was processed (successfully or not), or if none are due.
+// Only returns error for infrastructure failures that should stop the worker.
+func (w *ReminderWorker) RunProcessOnce(ctx context.Context) error {
+	ctx = domain.WithInternalAccess(ctx)
+
+	reminder, err := w.coordinator.ClaimNextReminder(ctx, w.cfg.WorkerID, w.cfg.AvailabilityTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to claim reminder: %w", err)
+	}
+	if reminder == nil {
+		return nil // No reminders due
+	}
+
+	slog.InfoContext(ctx, "claimed reminder", "reminder_id", reminder.ID, "worker_id", w.cfg.WorkerID)
+
+	// Start heartbeat goroutine to extend availability
+	heartbeatCtx, cancelHeartbeat := context.WithCancel(ctx)
+	defer cancelHeartbeat()
+	go w.runHeartbeat(heartbeatCtx, reminder.ID)
+
+	status, err := w.deliverWithRecovery(ctx, reminder)
+	cancelHeartbeat() // Stop heartbeat
+
+	if err != nil {
+		return w.handleDeliveryError(ctx, reminder, err)
+	}
+
+	if err := w.coordinator.CompleteReminder(ctx, reminder.ID, w.cfg.WorkerID, status); err != nil {
+		if errors.Is(err, domain.ErrReminderOwnershipLost) {
+			slog.WarnContext(ctx, "reminder ownership lost after delivery - another worker may deliver it again",
+				"reminder_id", reminder.ID)
+			return nil
+		}
+		return fmt.Errorf("failed to complete reminder: %w", err)
+	}
+
+	slog.InfoContext(ctx, "reminder processed", "reminder_id", reminder.ID, "status", status)
+	return nil
+}
+
+// runHeartbeat periodically extends reminder availability to prevent reclamation.
+func (w *ReminderWorker) runHeartbeat(ctx context.Context, reminderID string) {
+	ticker := time.NewTicker(w.cfg.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.coordinator.ExtendReminderAvailability(ctx, reminderID, w.cfg.WorkerID, w.cfg.AvailabilityTimeout); err != nil {
+				slog.WarnContext(ctx, "reminder heartbeat failed", "reminder_id", reminderID, "error", err)
+			}
+		}
+	}
+}
+
+// deliverWithRecovery delivers the reminder, converting panics to PanicError.
+func (w *ReminderWorker) deliverWithRecovery(ctx context.Context, reminder *domain.Reminder) (status domain.ReminderStatus, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = PanicError{Value: r, StackTrace: string(debug.Stack())}
+		}
+	}()
+	return w.deliver(ctx, reminder)
+}
+
+// deliver loads the item and hands the reminder to the notifier.
+// Reminders of closed or deleted items are skipped instead of delivered.
+func (w *ReminderWorker) deliver(ctx context.Context, reminder *domain.Reminder) (domain.ReminderStatus, error) {
+	item, err := w.repo.FindItemByID(ctx, reminder.ItemID)
+	if errors.Is(err, domain.ErrItemNotFound) {
+		return domain.ReminderStatusSkipped, nil
+	}
+	if err != nil {
+		return "", Transient(err) // Database error - retry
+	}
+
+	if isClosedStatus(item.Status) {
+		slog.InfoContext(ctx, "skipping reminder of closed item",
+			"reminder_id", reminder.ID,
+			"item_id", item.ID,
+			"item_status", item.Status)
+		return domain.ReminderStatusSkipped, nil
+	}
+
+	list, err := w.repo.FindListByID(ctx, item.ListID)
+	if errors.Is(err, domain.ErrListNotFound) {
+		return domain.ReminderStatusSkipped, nil
+	}
+	if err != nil {
+		return "", Transient(err) // Database error - retry
+	}
+
+	if err := w.notifier.Notify(ctx, ReminderNotification{
+		Reminder: reminder,
+		Item:     item,
+		List:     list,
+	}); err != nil {
+		return "", err
+	}
+
+	return domain.ReminderStatusSent, nil
+}
+
+// isClosedStatus reports whether reminders for an item in this status are pointless.
+func isClosedStatus(status domain.TaskStatus) bool {
+	switch status {
+	case domain.TaskStatusDone, domain.TaskStatusArchived, domain.TaskStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// handleDeliveryError retries transient failures and marks everything else dead.
+// Returns nil if the error was handled, or error if handling failed.
+func (w *ReminderWorker) handleDeliveryError(ctx context.Context, reminder *domain.Reminder, err error) error {
+	if IsRetryable(err) {
+		willRetry, failErr := w.coordinator.FailReminder(ctx, reminder, w.cfg.WorkerID, err.Error(), w.cfg.RetryConfig)
+		if failErr != nil {
+			if errors.Is(failErr, domain.ErrReminderOwnershipLost) {
+				slog.WarnContext(ctx, "reminder ownership lost during retry scheduling", "reminder_id", reminder.ID)
+				return nil // Another worker is handling it
+			}
+			return fmt.Errorf("failed to schedule reminder retry: %w", failErr)
+		}
+
+		if !willRetry {
+			slog.WarnContext(ctx, "reminder exhausted retries",
+				"reminder_id", reminder.ID,
+				"retry_count", reminder.RetryCount+1,
+				"error", err.Error())
+			return nil
+		}
+
+		slog.InfoContext(ctx, "reminder scheduled for retry",
+			"reminder_id", reminder.ID,
+			"retry_count", reminder.RetryCount+1,
+			"error", err.Error())
+		return nil
+	}
+
+	// Panics and permanent errors are not retried
+	if IsPanic(err) {
+		slog.ErrorContext(ctx, "reminder delivery panicked",
+			"reminder_id", reminder.ID,
+			"panic_value", err.(PanicError).Value,
+			"stack_trace", err.(PanicError).StackTrace)
+	} else {
+		slog.ErrorContext(ctx, "reminder delivery failed with permanent error",
+			"reminder_id", reminder.ID,
+			"error", err.Error())
+	}
+
+	if deadErr := w.coordinator.MarkReminderDead(ctx, reminder, w.cfg.WorkerID, err.Error()); deadErr != nil {
+		if errors.Is(deadErr, domain.ErrReminderOwnershipLost) {
+			slog.WarnContext(ctx, "reminder ownership lost during error handling", "reminder_id", reminder.ID)
+			return nil // Another worker is handling it
+		}
+		return fmt.Errorf("failed to mark reminder dead: %w", deadErr)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// mockReminderCoordinator records how the worker finished a claimed reminder.
+type mockReminderCoordinator struct {
+	reminder *domain.Reminder
+
+	completedStatus domain.ReminderStatus
+	failedMsg       string
+	deadMsg         string
+	completeErr     error
+}
+
+func (m *mockReminderCoordinator) ClaimNextReminder(ctx context.Context, workerID string, availabilityTimeout time.Duration) (*domain.Reminder, error) {
+	reminder := m.reminder
+	m.reminder = nil
+	return reminder, nil
+}
+
+func (m *mockReminderCoordinator) ExtendReminderAvailability(ctx context.Context, reminderID, workerID string, extension time.Duration) error {
+	return nil
+}
+
+func (m *mockReminderCoordinator) CompleteReminder(ctx context.Context, reminderID, workerID string, status domain.ReminderStatus) error {
+	m.completedStatus = status
+	return m.completeErr
+}
+
+func (m *mockReminderCoordinator) FailReminder(ctx context.Context, reminder *domain.Reminder, workerID, errMsg string, cfg RetryConfig) (bool, error) {
+	m.failedMsg = errMsg
+	return true, nil
+}
+
+func (m *mockReminderCoordinator) MarkReminderDead(ctx context.Context, reminder *domain.Reminder, workerID, errMsg string) error {
+	m.deadMsg = errMsg
+	return nil
+}
+
+// mockReminderRepository returns a single item and its list.
+type mockReminderRepository struct {
+	item *domain.TodoItem
+}
+
+func (m *mockReminderRepository) FindItemByID(ctx context.Context, id string) (*domain.TodoItem, error) {
+	if m.item == nil {
+		return nil, domain.ErrItemNotFound
+	}
+	return m.item, nil
+}
+
+func (m *mockReminderRepository) FindListByID(ctx context.Context, id string) (*domain.TodoList, error) {
+	return &domain.TodoList{ID: id, Title: "List"}, nil
+}
+
+// notifierFunc adapts a function to the Notifier interface.
+type notifierFunc func(ctx context.Context, notification ReminderNotification) error
+
+func (f notifierFunc) Notify(ctx context.Context, notification ReminderNotification) error {
+	return f(ctx, notification)
+}
+
+func newTestReminderWorker(coordinator *mockReminderCoordinator, item *domain.TodoItem, notify notifierFunc) *ReminderWorker {
+	cfg := DefaultReminderConfig("test-worker")
+	cfg.HeartbeatInterval = time.Hour
+	return NewReminderWorker(coordinator, &mockReminderRepository{item: item}, notify, cfg)
+}
+
+func TestReminderWorker_DeliversDueReminder(t *testing.T) {
+	coordinator := &mockReminderCoordinator{reminder: &domain.Reminder{ID: "reminder-1", ItemID: "item-1"}}
+	item := &domain.TodoItem{ID: "item-1", ListID: "list-1", Status: domain.TaskStatusTodo}
+
+	var delivered ReminderNotification
+	w := newTestReminderWorker(coordinator, item, func(ctx context.Context, n ReminderNotification) error {
+		delivered = n
+		return nil
+	})
+
+	if err := w.RunProcessOnce(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if delivered.Reminder == nil || delivered.Reminder.ID != "reminder-1" {
+		t.Fatalf("reminder was not delivered: %+v", delivered)
+	}
+	if delivered.List == nil || delivered.List.ID != "list-1" {
+		t.Errorf("expected list-1 in notification, got %+v", delivered.List)
+	}
+	if coordinator.completedStatus != domain.ReminderStatusSent {
+		t.Errorf("expected status sent, got %q", coordinator.completedStatus)
+	}
+}
+
+func TestReminderWorker_SkipsClosedAndDeletedItems(t *testing.T) {
+	tests := []struct {
+		name string
+		item *domain.TodoItem
+	}{
+		{"done item", &domain.TodoItem{ID: "item-1", ListID: "list-1", Status: domain.TaskStatusDone}},
+		{"cancelled item", &domain.TodoItem{ID: "item-1", ListID: "list-1", Status: domain.TaskStatusCancelled}},
+		{"deleted item", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coordinator := &mockReminderCoordinator{reminder: &domain.Reminder{ID: "reminder-1", ItemID: "item-1"}}
+			w := newTestReminderWorker(coordinator, tt.item, func(ctx context.Context, n ReminderNotification) error {
+				t.Error("closed item must not be notified")
+				return nil
+			})
+
+			if err := w.RunProcessOnce(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if coordinator.completedStatus != domain.ReminderStatusSkipped {
+				t.Errorf("expected status skipped, got %q", coordinator.completedStatus)
+			}
+		})
+	}
+}
+
+func TestReminderWorker_RetriesTransientFailures(t *testing.T) {
+	coordinator := &mockReminderCoordinator{reminder: &domain.Reminder{ID: "reminder-1", ItemID: "item-1"}}
+	item := &domain.TodoItem{ID: "item-1", ListID: "list-1", Status: domain.TaskStatusTodo}
+	w := newTestReminderWorker(coordinator, item, func(ctx context.Context, n ReminderNotification) error {
+		return Transient(errors.New("connection refused"))
+	})
+
+	if err := w.RunProcessOnce(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if coordinator.failedMsg != "connection refused" {
+		t.Errorf("expected retry to be scheduled, got failedMsg %q", coordinator.failedMsg)
+	}
+	if coordinator.completedStatus != "" || coordinator.deadMsg != "" {
+		t.Error("transient failure must neither complete nor kill the reminder")
+	}
+}
+
+func TestReminderWorker_PermanentFailureAndPanicMarkDead(t *testing.T) {
+	tests := []struct {
+		name   string
+		notify notifierFunc
+	}{
+		{"permanent error", func(ctx context.Context, n ReminderNotification) error {
+			return errors.New("webhook rejected reminder with 410")
+		}},
+		{"panic", func(ctx context.Context, n ReminderNotification) error {
+			panic("boom")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coordinator := &mockReminderCoordinator{reminder: &domain.Reminder{ID: "reminder-1", ItemID: "item-1"}}
+			item := &domain.TodoItem{ID: "item-1", ListID: "list-1", Status: domain.TaskStatusTodo}
+			w := newTestReminderWorker(coordinator, item, tt.notify)
+
+			if err := w.RunProcessOnce(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if coordinator.deadMsg == "" {
+				t.Error("expected reminder to be marked dead")
+			}
+			if coordinator.failedMsg != "" {
+				t.Error("permanent failures must not be retried")
+			}
+		})
+	}
+}
+
+func TestReminderWorker_OwnershipLostAfterDeliveryIsTolerated(t *testing.T) {
+	coordinator := &mockReminderCoordinator{
+		reminder:    &domain.Reminder{ID: "reminder-1", ItemID: "item-1"},
+		completeErr: domain.ErrReminderOwnershipLost,
+	}
+	item := &domain.TodoItem{ID: "item-1", ListID: "list-1", Status: domain.TaskStatusTodo}
+	w := newTestReminderWorker(coordinator, item, func(ctx context.Context, n ReminderNotification) error {
+		return nil
+	})
+
+	if err := w.RunProcessOnce(context.Background()); err != nil {
+		t.Fatalf("ownership loss should not stop the worker: %v", err)
+	}
+}
@@ -80,3 +80,21 @@ type Repository interface {
 	// Returns count of deleted items.
 	DeleteFuturePendingItems(ctx context.Context, templateID string, after time.Time) (int64, error)
 }
+
+// ReminderRepository defines the storage reads needed to deliver reminders.
+type ReminderRepository interface {
+	// FindItemByID retrieves the item a reminder belongs to.
+	// Returns domain.ErrItemNotFound if the item was deleted.
+	FindItemByID(ctx context.Context, id string) (*domain.TodoItem, error)
+
+	// FindListByID retrieves the list the item belongs to.
+	// Returns domain.ErrListNotFound if the list was deleted.
+	FindListByID(ctx context.Context, id string) (*domain.TodoList, error)
+}
+
+// WebhookRepository defines the storage reads needed to deliver webhooks.
+type WebhookRepository interface {
+	// FindWebhookSubscriptionByID retrieves the subscription a delivery belongs to.
+	// Returns domain.ErrWebhookNotFound if the subscription was deleted.
+	FindWebhookSubscriptionByID(ctx context.Context, id string) (*domain.WebhookSubscription, error)
+}
@@ -0,0 +1,142 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// SnapshotConfig holds configuration for the list snapshot worker.
+type SnapshotConfig struct {
+	// WorkerID is the unique identifier for this worker instance
+	// Used for lease ownership verification
+	WorkerID string
+
+	// Interval between snapshot runs (default: 1h)
+	// Each run replaces the snapshot of the day, so the last run of a day wins
+	Interval time.Duration
+
+	// MaxStartupJitter is the maximum random delay before first run (default: 30s)
+	// Prevents thundering herd when multiple workers start simultaneously
+	MaxStartupJitter time.Duration
+
+	// BatchSize is how many lists are recorded per statement (default: 500)
+	BatchSize int
+
+	// LeaseDuration is how long the exclusive lease is valid (default: 5min)
+	LeaseDuration time.Duration
+}
+
+// DefaultSnapshotConfig returns sensible defaults.
+func DefaultSnapshotConfig(workerID string) SnapshotConfig {
+	return SnapshotConfig{
+		WorkerID:         workerID,
+		Interval:         time.Hour,
+		MaxStartupJitter: 30 * time.Second,
+		BatchSize:        500,
+		LeaseDuration:    5 * time.Minute,
+	}
+}
+
+// SnapshotRunType is the exclusive run type held while recording snapshots.
+const SnapshotRunType = "list-snapshots"
+
+// SnapshotWorker records the daily item counts of every list, for burndown
+// and cumulative flow charts. Runs as a single instance behind an exclusive lease.
+type SnapshotWorker struct {
+	coordinator SnapshotCoordinator
+	cfg         SnapshotConfig
+}
+
+// NewSnapshotWorker creates a snapshot worker with the given configuration.
+func NewSnapshotWorker(coordinator SnapshotCoordinator, cfg SnapshotConfig) *SnapshotWorker {
+	return &SnapshotWorker{
+		coordinator: coordinator,
+		cfg:         cfg,
+	}
+}
+
+// Run records snapshots after a jittered startup delay, then every Interval,
+// until the context is cancelled.
+func (w *SnapshotWorker) Run(ctx context.Context) error {
+	if w.cfg.MaxStartupJitter > 0 {
+		jitter := rand.N(w.cfg.MaxStartupJitter)
+		slog.InfoContext(ctx, "snapshot worker starting",
+			"startup_jitter", jitter,
+			"interval", w.cfg.Interval)
+
+		timer := time.NewTimer(jitter)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if _, err := w.RunOnce(ctx); err != nil {
+		slog.ErrorContext(ctx, "initial snapshot run failed", "error", err)
+	}
+
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "snapshot worker stopping")
+			return ctx.Err()
+		case <-ticker.C:
+			if _, err := w.RunOnce(ctx); err != nil {
+				slog.ErrorContext(ctx, "snapshot run failed", "error", err)
+			}
+		}
+	}
+}
+
+// RunOnce records the current counts of every list as its snapshot of today (UTC),
+// batch by batch. Returns the number of lists recorded.
+func (w *SnapshotWorker) RunOnce(ctx context.Context) (int, error) {
+	ctx = domain.WithInternalAccess(ctx)
+
+	release, acquired, err := w.coordinator.TryAcquireExclusiveRun(ctx, SnapshotRunType, w.cfg.WorkerID, w.cfg.LeaseDuration)
+	if err != nil {
+		return 0, fmt.Errorf("failed to acquire lease: %w", err)
+	}
+	if !acquired {
+		slog.DebugContext(ctx, "snapshot run skipped, another instance holds the lease")
+		return 0, nil
+	}
+	defer release()
+
+	startTime := time.Now().UTC()
+	day := domain.SnapshotDay(startTime)
+
+	recorded := 0
+	after := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			return recorded, err
+		}
+		listIDs, err := w.coordinator.RecordListSnapshots(ctx, day, time.Now().UTC(), after, w.cfg.BatchSize)
+		if err != nil {
+			return recorded, err
+		}
+		recorded += len(listIDs)
+		if len(listIDs) < w.cfg.BatchSize {
+			break
+		}
+		after = listIDs[len(listIDs)-1]
+	}
+
+	slog.InfoContext(ctx, "list snapshots recorded",
+		"day", day.Format(time.DateOnly),
+		"lists", recorded,
+		"duration", time.Since(startTime))
+	return recorded, nil
+}
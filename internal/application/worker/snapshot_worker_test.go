@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+// mockSnapshotCoordinator serves a fixed set of lists and records the snapshot calls.
+type mockSnapshotCoordinator struct {
+	listIDs   []string
+	days      []time.Time
+	recorded  []string
+	leaseHeld bool
+}
+
+func (m *mockSnapshotCoordinator) RecordListSnapshots(ctx context.Context, day, recordedAt time.Time, afterListID string, limit int) ([]string, error) {
+	m.days = append(m.days, day)
+	var batch []string
+	for _, id := range m.listIDs {
+		if id > afterListID && len(batch) < limit {
+			batch = append(batch, id)
+		}
+	}
+	m.recorded = append(m.recorded, batch...)
+	return batch, nil
+}
+
+func (m *mockSnapshotCoordinator) TryAcquireExclusiveRun(ctx context.Context, runType string, holderID string, leaseDuration time.Duration) (func(), bool, error) {
+	if m.leaseHeld {
+		return nil, false, nil
+	}
+	return func() {}, true, nil
+}
+
+func TestSnapshotWorker_RecordsEveryListInBatches(t *testing.T) {
+	coordinator := &mockSnapshotCoordinator{listIDs: []string{"list-1", "list-2", "list-3", "list-4", "list-5"}}
+	cfg := DefaultSnapshotConfig("test-worker")
+	cfg.BatchSize = 2
+	w := NewSnapshotWorker(coordinator, cfg)
+
+	recorded, err := w.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if recorded != 5 {
+		t.Errorf("expected 5 lists recorded, got %d", recorded)
+	}
+	if !slices.Equal(coordinator.recorded, coordinator.listIDs) {
+		t.Errorf("expected lists %v, got %v", coordinator.listIDs, coordinator.recorded)
+	}
+	if len(coordinator.days) != 3 {
+		t.Errorf("expected 3 batches, got %d", len(coordinator.days))
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	for _, day := range coordinator.days {
+		if !day.Equal(today) {
+			t.Errorf("expected snapshots of %s, got %s", today, day)
+		}
+	}
+}
+
+func TestSnapshotWorker_SkipsWithoutLease(t *testing.T) {
+	coordinator := &mockSnapshotCoordinator{listIDs: []string{"list-1"}, leaseHeld: true}
+	w := NewSnapshotWorker(coordinator, DefaultSnapshotConfig("test-worker"))
+
+	recorded, err := w.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if recorded != 0 || len(coordinator.days) != 0 {
+		t.Errorf("expected no snapshots without the lease, got %d", recorded)
+	}
+}
@@ -0,0 +1,142 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// SnoozeConfig holds configuration for the snooze wake worker.
+type SnoozeConfig struct {
+	// WorkerID is the unique identifier for this worker instance
+	// Used for lease ownership verification
+	WorkerID string
+
+	// Interval between wake runs (default: 1m)
+	// Bounds how late the item.woke event follows the end of a snooze
+	Interval time.Duration
+
+	// MaxStartupJitter is the maximum random delay before first run (default: 30s)
+	// Prevents thundering herd when multiple workers start simultaneously
+	MaxStartupJitter time.Duration
+
+	// BatchSize is how many items are woken per statement (default: 500)
+	BatchSize int
+
+	// LeaseDuration is how long the exclusive lease is valid (default: 5min)
+	LeaseDuration time.Duration
+}
+
+// DefaultSnoozeConfig returns sensible defaults.
+func DefaultSnoozeConfig(workerID string) SnoozeConfig {
+	return SnoozeConfig{
+		WorkerID:         workerID,
+		Interval:         time.Minute,
+		MaxStartupJitter: 30 * time.Second,
+		BatchSize:        500,
+		LeaseDuration:    5 * time.Minute,
+	}
+}
+
+// SnoozeRunType is the exclusive run type held while waking snoozed items.
+const SnoozeRunType = "snooze-wake"
+
+// SnoozeWorker clears passed snoozes. Listings hide snoozed items by time alone,
+// so clearing is what records the item.woke change that clients are notified of.
+// Runs as a single instance behind an exclusive lease.
+type SnoozeWorker struct {
+	coordinator SnoozeCoordinator
+	cfg         SnoozeConfig
+}
+
+// NewSnoozeWorker creates a snooze worker with the given configuration.
+func NewSnoozeWorker(coordinator SnoozeCoordinator, cfg SnoozeConfig) *SnoozeWorker {
+	return &SnoozeWorker{
+		coordinator: coordinator,
+		cfg:         cfg,
+	}
+}
+
+// Run wakes items after a jittered startup delay, then every Interval,
+// until the context is cancelled.
+func (w *SnoozeWorker) Run(ctx context.Context) error {
+	if w.cfg.MaxStartupJitter > 0 {
+		jitter := rand.N(w.cfg.MaxStartupJitter)
+		slog.InfoContext(ctx, "snooze worker starting",
+			"startup_jitter", jitter,
+			"interval", w.cfg.Interval)
+
+		timer := time.NewTimer(jitter)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if _, err := w.RunOnce(ctx); err != nil {
+		slog.ErrorContext(ctx, "initial snooze wake run failed", "error", err)
+	}
+
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "snooze worker stopping")
+			return ctx.Err()
+		case <-ticker.C:
+			if _, err := w.RunOnce(ctx); err != nil {
+				slog.ErrorContext(ctx, "snooze wake run failed", "error", err)
+			}
+		}
+	}
+}
+
+// RunOnce wakes every item whose snooze has passed, batch by batch.
+// Returns the number of items woken.
+func (w *SnoozeWorker) RunOnce(ctx context.Context) (int, error) {
+	ctx = domain.WithInternalAccess(ctx)
+
+	release, acquired, err := w.coordinator.TryAcquireExclusiveRun(ctx, SnoozeRunType, w.cfg.WorkerID, w.cfg.LeaseDuration)
+	if err != nil {
+		return 0, fmt.Errorf("failed to acquire lease: %w", err)
+	}
+	if !acquired {
+		slog.DebugContext(ctx, "snooze wake run skipped, another instance holds the lease")
+		return 0, nil
+	}
+	defer release()
+
+	startTime := time.Now().UTC()
+
+	woken := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return woken, err
+		}
+		// Woken items no longer match, so each batch picks up where the last ended
+		itemIDs, err := w.coordinator.WakeSnoozedItems(ctx, time.Now().UTC(), w.cfg.BatchSize)
+		if err != nil {
+			return woken, err
+		}
+		woken += len(itemIDs)
+		if len(itemIDs) < w.cfg.BatchSize {
+			break
+		}
+	}
+
+	if woken > 0 {
+		slog.InfoContext(ctx, "snoozed items woken",
+			"items", woken,
+			"duration", time.Since(startTime))
+	}
+	return woken, nil
+}
@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// mockSnoozeCoordinator holds snoozed items by ID and wakes the passed ones.
+type mockSnoozeCoordinator struct {
+	snoozedUntil map[string]time.Time
+	batches      int
+	leaseHeld    bool
+}
+
+func (m *mockSnoozeCoordinator) WakeSnoozedItems(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	m.batches++
+	var woken []string
+	for id, until := range m.snoozedUntil {
+		if !until.After(now) && len(woken) < limit {
+			woken = append(woken, id)
+			delete(m.snoozedUntil, id)
+		}
+	}
+	return woken, nil
+}
+
+func (m *mockSnoozeCoordinator) TryAcquireExclusiveRun(ctx context.Context, runType string, holderID string, leaseDuration time.Duration) (func(), bool, error) {
+	if m.leaseHeld {
+		return nil, false, nil
+	}
+	return func() {}, true, nil
+}
+
+func TestSnoozeWorker_WakesPassedSnoozesInBatches(t *testing.T) {
+	now := time.Now().UTC()
+	coordinator := &mockSnoozeCoordinator{snoozedUntil: map[string]time.Time{
+		"item-1": now.Add(-time.Hour),
+		"item-2": now.Add(-time.Minute),
+		"item-3": now.Add(-time.Second),
+		"item-4": now.Add(time.Hour),
+	}}
+	cfg := DefaultSnoozeConfig("test-worker")
+	cfg.BatchSize = 2
+	w := NewSnoozeWorker(coordinator, cfg)
+
+	woken, err := w.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if woken != 3 {
+		t.Errorf("expected 3 items woken, got %d", woken)
+	}
+	if coordinator.batches != 2 {
+		t.Errorf("expected 2 batches, got %d", coordinator.batches)
+	}
+	if _, ok := coordinator.snoozedUntil["item-4"]; !ok || len(coordinator.snoozedUntil) != 1 {
+		t.Errorf("expected only item-4 to stay snoozed, got %v", coordinator.snoozedUntil)
+	}
+}
+
+func TestSnoozeWorker_SkipsWithoutLease(t *testing.T) {
+	coordinator := &mockSnoozeCoordinator{
+		snoozedUntil: map[string]time.Time{"item-1": time.Now().UTC().Add(-time.Hour)},
+		leaseHeld:    true,
+	}
+	w := NewSnoozeWorker(coordinator, DefaultSnoozeConfig("test-worker"))
+
+	woken, err := w.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if woken != 0 || coordinator.batches != 0 {
+		t.Errorf("expected no items woken without the lease, got %d", woken)
+	}
+}
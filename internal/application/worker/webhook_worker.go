@@ -0,0 +1,175 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// WebhookDeliveryWorker delivers queued events to webhook subscribers with
+// availability timeout and heartbeat.
+// Uses WebhookCoordinator for claiming, ownership verification and stuck delivery recovery.
+type WebhookDeliveryWorker struct {
+	coordinator WebhookCoordinator
+	repo        WebhookRepository
+	sender      WebhookSender
+	cfg         WebhookConfig
+}
+
+// NewWebhookDeliveryWorker creates a webhook delivery worker with the given configuration.
+func NewWebhookDeliveryWorker(coordinator WebhookCoordinator, repo WebhookRepository, sender WebhookSender, cfg WebhookConfig) *WebhookDeliveryWorker {
+	return &WebhookDeliveryWorker{
+		coordinator: coordinator,
+		repo:        repo,
+		sender:      sender,
+		cfg:         cfg,
+	}
+}
+
+// RunProcessOnce claims and sends a single pending delivery.
+// Returns nil if a delivery was processed (successfully or not), or if none are pending.
+// Only returns error for infrastructure failures that should stop the worker.
+func (w *WebhookDeliveryWorker) RunProcessOnce(ctx context.Context) error {
+	ctx = domain.WithInternalAccess(ctx)
+
+	delivery, err := w.coordinator.ClaimNextWebhookDelivery(ctx, w.cfg.WorkerID, w.cfg.AvailabilityTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to claim webhook delivery: %w", err)
+	}
+	if delivery == nil {
+		return nil // Nothing to deliver
+	}
+
+	slog.InfoContext(ctx, "claimed webhook delivery",
+		"delivery_id", delivery.ID,
+		"event_type", delivery.EventType,
+		"worker_id", w.cfg.WorkerID)
+
+	// Start heartbeat goroutine to extend availability
+	heartbeatCtx, cancelHeartbeat := context.WithCancel(ctx)
+	defer cancelHeartbeat()
+	go w.runHeartbeat(heartbeatCtx, delivery.ID)
+
+	statusCode, err := w.sendWithRecovery(ctx, delivery)
+	cancelHeartbeat() // Stop heartbeat
+
+	if err != nil {
+		return w.handleDeliveryError(ctx, delivery, statusCode, err)
+	}
+
+	if err := w.coordinator.CompleteWebhookDelivery(ctx, delivery.ID, w.cfg.WorkerID, statusCode); err != nil {
+		if errors.Is(err, domain.ErrWebhookDeliveryOwnershipLost) {
+			slog.WarnContext(ctx, "webhook delivery ownership lost after sending - another worker may send it again",
+				"delivery_id", delivery.ID)
+			return nil
+		}
+		return fmt.Errorf("failed to complete webhook delivery: %w", err)
+	}
+
+	slog.InfoContext(ctx, "webhook delivered",
+		"delivery_id", delivery.ID,
+		"subscription_id", delivery.SubscriptionID,
+		"response_status", statusCode)
+	return nil
+}
+
+// runHeartbeat periodically extends delivery availability to prevent reclamation.
+func (w *WebhookDeliveryWorker) runHeartbeat(ctx context.Context, deliveryID string) {
+	ticker := time.NewTicker(w.cfg.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.coordinator.ExtendWebhookDeliveryAvailability(ctx, deliveryID, w.cfg.WorkerID, w.cfg.AvailabilityTimeout); err != nil {
+				slog.WarnContext(ctx, "webhook delivery heartbeat failed", "delivery_id", deliveryID, "error", err)
+			}
+		}
+	}
+}
+
+// sendWithRecovery sends the delivery, converting panics to PanicError.
+func (w *WebhookDeliveryWorker) sendWithRecovery(ctx context.Context, delivery *domain.WebhookDelivery) (statusCode int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = PanicError{Value: r, StackTrace: string(debug.Stack())}
+		}
+	}()
+	return w.send(ctx, delivery)
+}
+
+// send loads the subscription for its URL and secret and hands the delivery to the sender.
+func (w *WebhookDeliveryWorker) send(ctx context.Context, delivery *domain.WebhookDelivery) (int, error) {
+	sub, err := w.repo.FindWebhookSubscriptionByID(ctx, delivery.SubscriptionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrWebhookNotFound) {
+			return 0, err // Subscription deleted - nothing to deliver to
+		}
+		return 0, Transient(err) // Database error - retry
+	}
+
+	return w.sender.Send(ctx, sub, delivery)
+}
+
+// handleDeliveryError retries transient failures and marks everything else dead.
+// Returns nil if the error was handled, or error if handling failed.
+func (w *WebhookDeliveryWorker) handleDeliveryError(ctx context.Context, delivery *domain.WebhookDelivery, statusCode int, err error) error {
+	var responseStatus *int
+	if statusCode > 0 {
+		responseStatus = &statusCode
+	}
+
+	if IsRetryable(err) {
+		willRetry, failErr := w.coordinator.FailWebhookDelivery(ctx, delivery, w.cfg.WorkerID, err.Error(), responseStatus, w.cfg.RetryConfig)
+		if failErr != nil {
+			if errors.Is(failErr, domain.ErrWebhookDeliveryOwnershipLost) {
+				slog.WarnContext(ctx, "webhook delivery ownership lost during retry scheduling", "delivery_id", delivery.ID)
+				return nil // Another worker is handling it
+			}
+			return fmt.Errorf("failed to schedule webhook delivery retry: %w", failErr)
+		}
+
+		if !willRetry {
+			slog.WarnContext(ctx, "webhook delivery exhausted retries",
+				"delivery_id", delivery.ID,
+				"attempts", delivery.Attempts+1,
+				"error", err.Error())
+			return nil
+		}
+
+		slog.InfoContext(ctx, "webhook delivery scheduled for retry",
+			"delivery_id", delivery.ID,
+			"attempts", delivery.Attempts+1,
+			"error", err.Error())
+		return nil
+	}
+
+	// Panics and permanent errors are not retried
+	if IsPanic(err) {
+		slog.ErrorContext(ctx, "webhook delivery panicked",
+			"delivery_id", delivery.ID,
+			"panic_value", err.(PanicError).Value,
+			"stack_trace", err.(PanicError).StackTrace)
+	} else {
+		slog.ErrorContext(ctx, "webhook delivery failed with permanent error",
+			"delivery_id", delivery.ID,
+			"response_status", statusCode,
+			"error", err.Error())
+	}
+
+	if deadErr := w.coordinator.MarkWebhookDeliveryDead(ctx, delivery, w.cfg.WorkerID, err.Error(), responseStatus); deadErr != nil {
+		if errors.Is(deadErr, domain.ErrWebhookDeliveryOwnershipLost) {
+			slog.WarnContext(ctx, "webhook delivery ownership lost during error handling", "delivery_id", delivery.ID)
+			return nil // Another worker is handling it
+		}
+		return fmt.Errorf("failed to mark webhook delivery dead: %w", deadErr)
+	}
+	return nil
+}
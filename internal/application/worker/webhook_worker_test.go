@@ -0,0 +1,185 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// mockWebhookCoordinator records how the worker finished a claimed delivery.
+type mockWebhookCoordinator struct {
+	delivery *domain.WebhookDelivery
+
+	completedStatus int
+	failedMsg       string
+	failedStatus    *int
+	deadMsg         string
+	deadStatus      *int
+}
+
+func (m *mockWebhookCoordinator) ClaimNextWebhookDelivery(ctx context.Context, workerID string, availabilityTimeout time.Duration) (*domain.WebhookDelivery, error) {
+	delivery := m.delivery
+	m.delivery = nil
+	return delivery, nil
+}
+
+func (m *mockWebhookCoordinator) ExtendWebhookDeliveryAvailability(ctx context.Context, deliveryID, workerID string, extension time.Duration) error {
+	return nil
+}
+
+func (m *mockWebhookCoordinator) CompleteWebhookDelivery(ctx context.Context, deliveryID, workerID string, responseStatus int) error {
+	m.completedStatus = responseStatus
+	return nil
+}
+
+func (m *mockWebhookCoordinator) FailWebhookDelivery(ctx context.Context, delivery *domain.WebhookDelivery, workerID, errMsg string, responseStatus *int, cfg RetryConfig) (bool, error) {
+	m.failedMsg = errMsg
+	m.failedStatus = responseStatus
+	return true, nil
+}
+
+func (m *mockWebhookCoordinator) MarkWebhookDeliveryDead(ctx context.Context, delivery *domain.WebhookDelivery, workerID, errMsg string, responseStatus *int) error {
+	m.deadMsg = errMsg
+	m.deadStatus = responseStatus
+	return nil
+}
+
+// mockWebhookRepository returns a single subscription.
+type mockWebhookRepository struct {
+	sub *domain.WebhookSubscription
+}
+
+func (m *mockWebhookRepository) FindWebhookSubscriptionByID(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	if m.sub == nil {
+		return nil, domain.ErrWebhookNotFound
+	}
+	return m.sub, nil
+}
+
+// senderFunc adapts a function to the WebhookSender interface.
+type senderFunc func(ctx context.Context, sub *domain.WebhookSubscription, delivery *domain.WebhookDelivery) (int, error)
+
+func (f senderFunc) Send(ctx context.Context, sub *domain.WebhookSubscription, delivery *domain.WebhookDelivery) (int, error) {
+	return f(ctx, sub, delivery)
+}
+
+func newTestWebhookWorker(coordinator *mockWebhookCoordinator, sub *domain.WebhookSubscription, send senderFunc) *WebhookDeliveryWorker {
+	cfg := DefaultWebhookConfig("test-worker")
+	cfg.HeartbeatInterval = time.Hour
+	return NewWebhookDeliveryWorker(coordinator, &mockWebhookRepository{sub: sub}, send, cfg)
+}
+
+func testDelivery() *domain.WebhookDelivery {
+	return &domain.WebhookDelivery{
+		ID:             "delivery-1",
+		SubscriptionID: "sub-1",
+		EventID:        "event-1",
+		EventType:      domain.EventItemCreated,
+		Payload:        []byte(`{"id":"event-1"}`),
+	}
+}
+
+func TestWebhookDeliveryWorker_DeliversPendingEvent(t *testing.T) {
+	coordinator := &mockWebhookCoordinator{delivery: testDelivery()}
+	sub := &domain.WebhookSubscription{ID: "sub-1", URL: "https://example.com/hook", Secret: "0123456789abcdef"}
+
+	var sentTo *domain.WebhookSubscription
+	w := newTestWebhookWorker(coordinator, sub, func(ctx context.Context, s *domain.WebhookSubscription, d *domain.WebhookDelivery) (int, error) {
+		sentTo = s
+		return 204, nil
+	})
+
+	if err := w.RunProcessOnce(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sentTo == nil || sentTo.Secret != sub.Secret {
+		t.Fatalf("delivery was not sent with the subscription secret: %+v", sentTo)
+	}
+	if coordinator.completedStatus != 204 {
+		t.Errorf("expected completion with status 204, got %d", coordinator.completedStatus)
+	}
+}
+
+func TestWebhookDeliveryWorker_RetriesTransientFailures(t *testing.T) {
+	coordinator := &mockWebhookCoordinator{delivery: testDelivery()}
+	sub := &domain.WebhookSubscription{ID: "sub-1"}
+	w := newTestWebhookWorker(coordinator, sub, func(ctx context.Context, s *domain.WebhookSubscription, d *domain.WebhookDelivery) (int, error) {
+		return 503, Transient(errors.New("webhook returned 503"))
+	})
+
+	if err := w.RunProcessOnce(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if coordinator.failedMsg != "webhook returned 503" {
+		t.Errorf("expected retry to be scheduled, got failedMsg %q", coordinator.failedMsg)
+	}
+	if coordinator.failedStatus == nil || *coordinator.failedStatus != 503 {
+		t.Errorf("expected response status 503 to be recorded, got %v", coordinator.failedStatus)
+	}
+	if coordinator.deadMsg != "" {
+		t.Errorf("transient failure must not mark the delivery dead")
+	}
+}
+
+func TestWebhookDeliveryWorker_NetworkErrorHasNoResponseStatus(t *testing.T) {
+	coordinator := &mockWebhookCoordinator{delivery: testDelivery()}
+	w := newTestWebhookWorker(coordinator, &domain.WebhookSubscription{ID: "sub-1"}, func(ctx context.Context, s *domain.WebhookSubscription, d *domain.WebhookDelivery) (int, error) {
+		return 0, Transient(errors.New("connection refused"))
+	})
+
+	if err := w.RunProcessOnce(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if coordinator.failedStatus != nil {
+		t.Errorf("expected no response status, got %d", *coordinator.failedStatus)
+	}
+}
+
+func TestWebhookDeliveryWorker_MarksPermanentFailuresDead(t *testing.T) {
+	coordinator := &mockWebhookCoordinator{delivery: testDelivery()}
+	w := newTestWebhookWorker(coordinator, &domain.WebhookSubscription{ID: "sub-1"}, func(ctx context.Context, s *domain.WebhookSubscription, d *domain.WebhookDelivery) (int, error) {
+		return 410, errors.New("webhook rejected event with 410")
+	})
+
+	if err := w.RunProcessOnce(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if coordinator.deadMsg != "webhook rejected event with 410" {
+		t.Errorf("expected delivery to be marked dead, got deadMsg %q", coordinator.deadMsg)
+	}
+	if coordinator.deadStatus == nil || *coordinator.deadStatus != 410 {
+		t.Errorf("expected response status 410 to be recorded, got %v", coordinator.deadStatus)
+	}
+}
+
+func TestWebhookDeliveryWorker_DeletedSubscriptionIsDead(t *testing.T) {
+	coordinator := &mockWebhookCoordinator{delivery: testDelivery()}
+	w := newTestWebhookWorker(coordinator, nil, func(ctx context.Context, s *domain.WebhookSubscription, d *domain.WebhookDelivery) (int, error) {
+		t.Error("delivery of a deleted subscription must not be sent")
+		return 0, nil
+	})
+
+	if err := w.RunProcessOnce(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if coordinator.deadMsg == "" {
+		t.Error("expected delivery to be marked dead")
+	}
+}
+
+func TestWebhookDeliveryWorker_RecoversFromPanics(t *testing.T) {
+	coordinator := &mockWebhookCoordinator{delivery: testDelivery()}
+	w := newTestWebhookWorker(coordinator, &domain.WebhookSubscription{ID: "sub-1"}, func(ctx context.Context, s *domain.WebhookSubscription, d *domain.WebhookDelivery) (int, error) {
+		panic("sender bug")
+	})
+
+	if err := w.RunProcessOnce(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if coordinator.deadMsg == "" {
+		t.Error("expected panicking delivery to be marked dead")
+	}
+}
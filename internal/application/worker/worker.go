@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/rezkam/mono/internal/domain"
 	"github.com/rezkam/mono/internal/recurring"
 )
 
@@ -94,6 +95,8 @@ func (w *Worker) Start(ctx context.Context) error {
 // RunScheduleOnce executes a single scheduling cycle.
 // Creates generation jobs for templates that need them.
 func (w *Worker) RunScheduleOnce(ctx context.Context) error {
+	ctx = domain.WithInternalAccess(ctx)
+
 	slog.InfoContext(ctx, "Scheduling generation jobs...")
 
 	templates, err := w.repo.FindActiveTemplatesNeedingGeneration(ctx)
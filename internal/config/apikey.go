@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/rezkam/mono/internal/env"
 )
 
@@ -11,6 +12,7 @@ import (
 var (
 	ErrNameRequired = errors.New("name is required (use -name flag)")
 	ErrInvalidDays  = errors.New("days must be >= 0 (0 = never expires)")
+	ErrInvalidOwner = errors.New("owner must be a UUID (omit to create a new tenant)")
 )
 
 // APIKeyConfig holds API key format configuration.
@@ -26,14 +28,18 @@ type APIKeyGenConfig struct {
 	APIKey    APIKeyConfig
 	Name      string // from command-line flag
 	DaysValid int    // from command-line flag
+	OwnerID   string // from command-line flag (empty = new tenant)
+	Scopes    string // from command-line flag (comma-separated scopes or presets)
 }
 
 // LoadAPIKeyGenConfig loads apikey generation configuration from environment.
-// name and daysValid come from command-line flags.
-func LoadAPIKeyGenConfig(name string, daysValid int) (*APIKeyGenConfig, error) {
+// name, daysValid, ownerID and scopes come from command-line flags.
+func LoadAPIKeyGenConfig(name string, daysValid int, ownerID, scopes string) (*APIKeyGenConfig, error) {
 	cfg := &APIKeyGenConfig{
 		Name:      name,
 		DaysValid: daysValid,
+		OwnerID:   ownerID,
+		Scopes:    scopes,
 	}
 
 	if err := env.Load(cfg); err != nil {
@@ -53,5 +59,11 @@ func (c *APIKeyGenConfig) Validate() error {
 		return ErrInvalidDays
 	}
 
+	if c.OwnerID != "" {
+		if _, err := uuid.Parse(c.OwnerID); err != nil {
+			return ErrInvalidOwner
+		}
+	}
+
 	return nil
 }
@@ -11,6 +11,21 @@ import (
 type WorkerConfig struct {
 	Database         DatabaseConfig
 	OperationTimeout time.Duration `env:"MONO_WORKER_OPERATION_TIMEOUT"`
+	Reminders        RemindersConfig
+	Webhooks         WebhooksConfig
+}
+
+// RemindersConfig holds reminder delivery configuration.
+// Reminder delivery is disabled when WebhookURL is empty.
+type RemindersConfig struct {
+	WebhookURL     string        `env:"MONO_REMINDER_WEBHOOK_URL"`
+	WebhookTimeout time.Duration `env:"MONO_REMINDER_WEBHOOK_TIMEOUT"`
+}
+
+// WebhooksConfig holds event webhook delivery configuration.
+// Subscriptions themselves are managed through the admin API.
+type WebhooksConfig struct {
+	Timeout time.Duration `env:"MONO_WEBHOOK_TIMEOUT"`
 }
 
 // LoadWorkerConfig loads and validates worker configuration from environment.
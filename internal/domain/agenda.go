@@ -0,0 +1,211 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// AgendaGroup is the section of an agenda an item appears in.
+type AgendaGroup string
+
+const (
+	AgendaGroupOverdue  AgendaGroup = "overdue"
+	AgendaGroupToday    AgendaGroup = "today"
+	AgendaGroupUpcoming AgendaGroup = "upcoming"
+)
+
+const (
+	// AgendaUpcomingDays is how many days after the agenda day the upcoming group covers.
+	AgendaUpcomingDays = 7
+
+	// MaxAgendaItems bounds the items an agenda is built from.
+	MaxAgendaItems = 500
+
+	// agendaQuerySlack widens the search window of an agenda on both sides.
+	// Floating times are stored with their wall clock in UTC and fixed start dates
+	// are midnight in the item's timezone, so the stored value of an item on the
+	// day can lie up to two UTC offsets (at most 14h each) outside the day.
+	agendaQuerySlack = 48 * time.Hour
+)
+
+// Agenda is the open work of one local day for a viewer's timezone, across lists.
+type Agenda struct {
+	// Day is midnight of the agenda day in the viewer's timezone.
+	Day time.Time
+
+	// Overdue holds items due before Day; Today items due, occurring or starting
+	// on Day; Upcoming items due, occurring or starting in the AgendaUpcomingDays
+	// days after it. Each group is ordered by time.
+	Overdue  []AgendaEntry
+	Today    []AgendaEntry
+	Upcoming []AgendaEntry
+
+	// Truncated reports that more than MaxAgendaItems items matched, so some are missing.
+	Truncated bool
+}
+
+// AgendaEntry is an item placed on an agenda.
+type AgendaEntry struct {
+	Item  TodoItem
+	Group AgendaGroup
+
+	// At is the due, occurrence or start time that placed the item, in the viewer's timezone.
+	At time.Time
+
+	// AllDay reports that At comes from StartsAt, which is a date without a time of day.
+	AllDay bool
+}
+
+// AgendaQuery selects the candidate items of an agenda: open items due before
+// DueBefore, or occurring or starting between ScheduledFrom and ScheduledBefore.
+// The bounds are wider than the agenda; NewAgenda places the items precisely.
+type AgendaQuery struct {
+	DueBefore       time.Time
+	ScheduledFrom   time.Time
+	ScheduledBefore time.Time
+	Limit           int
+}
+
+// ParseAgendaDay returns midnight of the agenda day in the viewer's timezone.
+// An empty date is today at now; an empty timezone is UTC.
+func ParseAgendaDay(date, timezone string, now time.Time) (time.Time, error) {
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(timezone)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidAgendaTimezone, timezone)
+		}
+	}
+
+	if date == "" {
+		local := now.In(loc)
+		return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc), nil
+	}
+	day, err := time.ParseInLocation(time.DateOnly, date, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %q is not a YYYY-MM-DD date", ErrInvalidAgendaDate, date)
+	}
+	return day, nil
+}
+
+// NewAgendaQuery returns the query for the candidate items of the agenda of day.
+func NewAgendaQuery(day time.Time) AgendaQuery {
+	end := day.AddDate(0, 0, 1+AgendaUpcomingDays)
+	return AgendaQuery{
+		DueBefore:       end.Add(agendaQuerySlack),
+		ScheduledFrom:   day.Add(-agendaQuerySlack),
+		ScheduledBefore: end.Add(agendaQuerySlack),
+		Limit:           MaxAgendaItems + 1, // One beyond the maximum tells the agenda is truncated
+	}
+}
+
+// NewAgenda places items on the agenda of day. Items that are not open, or
+// whose times all fall outside the agenda, are left out.
+func NewAgenda(day time.Time, items []TodoItem) *Agenda {
+	agenda := &Agenda{Day: day}
+	for _, item := range items {
+		entry, ok := newAgendaEntry(item, day)
+		if !ok {
+			continue
+		}
+		switch entry.Group {
+		case AgendaGroupOverdue:
+			agenda.Overdue = append(agenda.Overdue, entry)
+		case AgendaGroupToday:
+			agenda.Today = append(agenda.Today, entry)
+		case AgendaGroupUpcoming:
+			agenda.Upcoming = append(agenda.Upcoming, entry)
+		}
+	}
+
+	for _, group := range [][]AgendaEntry{agenda.Overdue, agenda.Today, agenda.Upcoming} {
+		slices.SortFunc(group, func(a, b AgendaEntry) int {
+			return cmp.Or(a.At.Compare(b.At), cmp.Compare(a.Item.ID, b.Item.ID))
+		})
+	}
+	return agenda
+}
+
+// newAgendaEntry places an item on the agenda of day: overdue when it was due
+// before the day, otherwise in the group of its earliest due, occurrence or
+// start time within the agenda.
+func newAgendaEntry(item TodoItem, day time.Time) (AgendaEntry, bool) {
+	if !slices.Contains(UndoneStatuses(), item.Status) {
+		return AgendaEntry{}, false
+	}
+
+	loc := day.Location()
+	var candidates []AgendaEntry
+	if item.DueAt != nil {
+		due := item.LocalTime(*item.DueAt, loc)
+		if due.Before(day) {
+			return AgendaEntry{Item: item, Group: AgendaGroupOverdue, At: due}, true
+		}
+		candidates = append(candidates, AgendaEntry{At: due})
+	}
+	// A recurring instance's occurrence is more precise than its start date
+	if item.OccursAt != nil {
+		candidates = append(candidates, AgendaEntry{At: item.LocalTime(*item.OccursAt, loc)})
+	} else if item.StartsAt != nil {
+		candidates = append(candidates, AgendaEntry{At: item.localDate(*item.StartsAt, loc), AllDay: true})
+	}
+
+	dayEnd := day.AddDate(0, 0, 1)
+	end := day.AddDate(0, 0, 1+AgendaUpcomingDays)
+	var entry AgendaEntry
+	found := false
+	for _, candidate := range candidates {
+		if candidate.At.Before(day) || !candidate.At.Before(end) {
+			continue
+		}
+		if !found || candidate.At.Before(entry.At) {
+			entry, found = candidate, true
+		}
+	}
+	if !found {
+		return AgendaEntry{}, false
+	}
+
+	entry.Item = item
+	entry.Group = AgendaGroupUpcoming
+	if entry.At.Before(dayEnd) {
+		entry.Group = AgendaGroupToday
+	}
+	return entry, true
+}
+
+// LocalTime returns a task time of the item (DueAt, OccursAt) as seen in loc.
+// A floating time keeps its wall clock, stored as UTC: 9am is 9am in every
+// timezone. A fixed time is an absolute moment and is converted to loc.
+func (item *TodoItem) LocalTime(t time.Time, loc *time.Location) time.Time {
+	if item.isFloating() {
+		utc := t.UTC()
+		return time.Date(utc.Year(), utc.Month(), utc.Day(), utc.Hour(), utc.Minute(), utc.Second(), utc.Nanosecond(), loc)
+	}
+	return t.In(loc)
+}
+
+// localDate returns the start of a date of the item (StartsAt) as seen in loc.
+// A floating date is the same date everywhere; a fixed date starts at midnight
+// in the item's timezone.
+func (item *TodoItem) localDate(date time.Time, loc *time.Location) time.Time {
+	utc := date.UTC()
+	if item.isFloating() {
+		return time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, loc)
+	}
+	itemLoc, err := time.LoadLocation(*item.Timezone)
+	if err != nil {
+		// Timezones are validated on write; read an unknown one as UTC
+		itemLoc = time.UTC
+	}
+	return time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, itemLoc).In(loc)
+}
+
+// isFloating reports whether the item's task times are floating rather than
+// anchored to a timezone.
+func (item *TodoItem) isFloating() bool {
+	return item.Timezone == nil || *item.Timezone == ""
+}
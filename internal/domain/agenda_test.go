@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAgenda_GroupsInViewerTimezone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	day, err := ParseAgendaDay("2026-03-10", "America/New_York", time.Now().UTC())
+	require.NoError(t, err)
+
+	at := func(s string) *time.Time {
+		v, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err)
+		return &v
+	}
+	stockholm := "Europe/Stockholm"
+	templateID := "template-1"
+	items := []TodoItem{
+		// Floating 2am keeps its wall clock: the 11th in New York, not 10pm on the 10th
+		{ID: "floating", Status: TaskStatusTodo, DueAt: at("2026-03-11T02:00:00Z")},
+		// Fixed 4am in Stockholm is 11pm the day before in New York
+		{ID: "fixed", Status: TaskStatusInProgress, DueAt: at("2026-03-11T03:00:00Z"), Timezone: &stockholm},
+		{ID: "overdue", Status: TaskStatusBlocked, DueAt: at("2026-03-09T12:00:00Z")},
+		{ID: "instance", Status: TaskStatusTodo, RecurringTemplateID: &templateID,
+			OccursAt: at("2026-03-10T08:00:00Z"), StartsAt: at("2026-03-10T00:00:00Z")},
+		{ID: "starts", Status: TaskStatusTodo, StartsAt: at("2026-03-12T00:00:00Z")},
+		{ID: "done", Status: TaskStatusDone, DueAt: at("2026-03-10T09:00:00Z")},
+		{ID: "later", Status: TaskStatusTodo, DueAt: at("2026-04-10T09:00:00Z")},
+	}
+
+	agenda := NewAgenda(day, items)
+
+	ids := func(entries []AgendaEntry) []string {
+		var ids []string
+		for _, entry := range entries {
+			ids = append(ids, entry.Item.ID)
+		}
+		return ids
+	}
+	assert.Equal(t, []string{"overdue"}, ids(agenda.Overdue))
+	assert.Equal(t, []string{"instance", "fixed"}, ids(agenda.Today))
+	assert.Equal(t, []string{"floating", "starts"}, ids(agenda.Upcoming))
+
+	assert.Equal(t, time.Date(2026, 3, 10, 8, 0, 0, 0, newYork), agenda.Today[0].At)
+	assert.False(t, agenda.Today[0].AllDay)
+	assert.Equal(t, time.Date(2026, 3, 10, 23, 0, 0, 0, newYork), agenda.Today[1].At)
+	assert.Equal(t, time.Date(2026, 3, 11, 2, 0, 0, 0, newYork), agenda.Upcoming[0].At)
+	assert.Equal(t, time.Date(2026, 3, 12, 0, 0, 0, 0, newYork), agenda.Upcoming[1].At)
+	assert.True(t, agenda.Upcoming[1].AllDay)
+}
+
+func TestNewAgendaQuery_CoversShiftedTimes(t *testing.T) {
+	day, err := ParseAgendaDay("2026-03-10", "Pacific/Kiritimati", time.Now().UTC())
+	require.NoError(t, err)
+
+	query := NewAgendaQuery(day)
+
+	// A floating 11pm on the last upcoming day is stored as 11pm UTC, 14h after
+	// the agenda's end in Kiritimati (UTC+14)
+	lastDay := time.Date(2026, 3, 17, 23, 0, 0, 0, time.UTC)
+	assert.True(t, lastDay.Before(query.ScheduledBefore))
+	assert.True(t, lastDay.Before(query.DueBefore))
+	assert.True(t, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC).After(query.ScheduledFrom))
+	assert.Equal(t, MaxAgendaItems+1, query.Limit)
+}
+
+func TestParseAgendaDay(t *testing.T) {
+	now := time.Date(2026, 3, 10, 23, 30, 0, 0, time.UTC)
+
+	day, err := ParseAgendaDay("", "Asia/Tokyo", now)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03-11", day.Format(time.DateOnly), "today is already the 11th in Tokyo")
+	assert.Equal(t, "Asia/Tokyo", day.Location().String())
+
+	day, err = ParseAgendaDay("", "", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), day)
+
+	_, err = ParseAgendaDay("2026-03-10", "Mars/Olympus_Mons", now)
+	assert.ErrorIs(t, err, ErrInvalidAgendaTimezone)
+
+	_, err = ParseAgendaDay("10/03/2026", "", now)
+	assert.ErrorIs(t, err, ErrInvalidAgendaDate)
+}
@@ -15,6 +15,14 @@ type TodoList struct {
 	Title     string
 	CreatedAt time.Time
 
+	// OwnerID is the tenant that owns the list. Set from the authenticated
+	// principal on creation; empty for lists created by internal callers.
+	OwnerID string
+
+	// CustomFieldSchema declares the typed custom fields that items and
+	// recurring templates in this list may carry.
+	CustomFieldSchema CustomFieldSchema
+
 	// Count fields are always populated from database aggregation.
 	TotalItems  int // Total number of items in the list
 	UndoneItems int // Number of active items (TODO, IN_PROGRESS, BLOCKED)
@@ -54,6 +62,10 @@ type TodoItem struct {
 	// Tags stored as array
 	Tags []string
 
+	// CustomFields holds values for the custom fields declared by the list schema,
+	// keyed by field name. Validated against TodoList.CustomFieldSchema on write.
+	CustomFields map[string]any
+
 	// Recurring task link
 	RecurringTemplateID *string // Optional link to RecurringTemplate
 
@@ -62,6 +74,10 @@ type TodoItem struct {
 	OccursAt  *time.Time     // Exact timestamp for recurring instances (supports intra-day patterns)
 	DueOffset *time.Duration // Duration from StartsAt to calculate DueAt
 
+	// SnoozedUntil hides the item from default listings until then, without
+	// changing its schedule. Cleared by the worker once it has passed.
+	SnoozedUntil *time.Time
+
 	// Timezone controls how task-related times (StartsAt, OccursAt, DueAt) are interpreted.
 	// This field does NOT affect operational times (CreatedAt, UpdatedAt) which are always UTC.
 	//
@@ -90,6 +106,11 @@ func (item *TodoItem) Etag() string {
 	return fmt.Sprintf("%d", item.Version)
 }
 
+// IsSnoozed reports whether the item is hidden from default listings at now.
+func (item *TodoItem) IsSnoozed(now time.Time) bool {
+	return item.SnoozedUntil != nil && item.SnoozedUntil.After(now)
+}
+
 // SetDueFromOffset calculates DueAt from StartsAt + DueOffset if both are set.
 func (item *TodoItem) SetDueFromOffset() {
 	if item.StartsAt != nil && item.DueOffset != nil {
@@ -124,6 +145,8 @@ type UpdateItemParams struct {
 	Timezone          *string
 	EstimatedDuration *time.Duration
 	ActualDuration    *time.Duration
+	CustomFields      map[string]any // Replaces all values when custom_fields is in UpdateMask
+	SnoozedUntil      *time.Time     // nil wakes the item
 
 	// DetachFromTemplate indicates whether to detach this item from its recurring template.
 	// Set by the service layer when content/schedule fields are modified on a recurring item.
@@ -146,7 +169,8 @@ type UpdateListParams struct {
 	UpdateMask []string
 
 	// Field values (only applied if field is in UpdateMask)
-	Title *string
+	Title             *string
+	CustomFieldSchema *CustomFieldSchema
 }
 
 // Field names for RecurringTemplate update masks.
@@ -162,6 +186,8 @@ const (
 	FieldIsActive              = "is_active"
 	FieldSyncHorizonDays       = "sync_horizon_days"
 	FieldGenerationHorizonDays = "generation_horizon_days"
+	FieldCustomFields          = "custom_fields" // Shared by lists (schema), templates and items (values)
+	FieldDefaultReminders      = "default_reminders"
 )
 
 // Field names for TodoItem update masks.
@@ -173,6 +199,7 @@ const (
 	FieldItemTags              = "tags"  // Shares name with template
 	FieldItemPriority          = "priority"
 	FieldItemEstimatedDuration = "estimated_duration"
+	FieldItemCustomFields      = "custom_fields" // Shares name with template
 
 	// Schedule fields - trigger detachment
 	FieldDueAt    = "due_at"
@@ -183,6 +210,7 @@ const (
 	FieldStatus         = "status"
 	FieldActualDuration = "actual_duration"
 	FieldTimezone       = "timezone"
+	FieldSnoozedUntil   = "snoozed_until"
 )
 
 // UpdateRecurringTemplateParams contains parameters for updating a recurring template with field mask support.
@@ -211,6 +239,8 @@ type UpdateRecurringTemplateParams struct {
 	IsActive              *bool
 	SyncHorizonDays       *int
 	GenerationHorizonDays *int
+	CustomFields          map[string]any
+	DefaultReminders      []ReminderRule // Replaces all rules when default_reminders is in UpdateMask
 }
 
 // RecurringTemplate is an aggregate root representing a template for generating recurring task instances.
@@ -233,6 +263,8 @@ type RecurringTemplate struct {
 	Tags              []string
 	Priority          *TaskPriority
 	EstimatedDuration *time.Duration
+	CustomFields      map[string]any // Copied into every generated instance
+	DefaultReminders  []ReminderRule // Relative reminders created for every generated instance
 
 	// Recurrence configuration
 	RecurrencePattern RecurrencePattern
@@ -357,12 +389,14 @@ const (
 //   - No storage of plaintext secrets
 type APIKey struct {
 	ID             string
-	KeyType        string // "sk" = secret key, "pk" = public key
-	Service        string // Service name (e.g., "mono")
-	Version        string // API version (e.g., "v1")
-	ShortToken     string // Indexed portion for fast lookup
-	LongSecretHash string // BLAKE2b-256 hash of long secret
-	Name           string // Human-readable name/description
+	KeyType        string  // "sk" = secret key, "pk" = public key
+	Service        string  // Service name (e.g., "mono")
+	Version        string  // API version (e.g., "v1")
+	ShortToken     string  // Indexed portion for fast lookup
+	LongSecretHash string  // BLAKE2b-256 hash of long secret
+	Name           string  // Human-readable name/description
+	OwnerID        string  // Tenant that owns the key and all data created with it
+	Scopes         []Scope // Permissions checked per API operation
 	IsActive       bool
 	CreatedAt      time.Time
 	LastUsedAt     *time.Time
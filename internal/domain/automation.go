@@ -0,0 +1,348 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Automation rule limits - business rules to prevent abuse.
+const (
+	MaxRulesPerList   = 50
+	MaxRuleActions    = 10
+	MaxRuleNameLength = 255
+
+	// MaxRuleDepth bounds chains of rules triggering rules: changes made by
+	// rules MaxRuleDepth deep fire no further rules.
+	MaxRuleDepth = 5
+)
+
+// RuleTitlePlaceholder in the title of a create_item action stands for the
+// title of the item the rule fired for.
+const RuleTitlePlaceholder = "{{title}}"
+
+// RuleTriggerType is the change to an item that fires an automation rule.
+type RuleTriggerType string
+
+const (
+	RuleTriggerItemCreated   RuleTriggerType = "item.created"
+	RuleTriggerStatusChanged RuleTriggerType = "item.status_changed"
+	RuleTriggerTagAdded      RuleTriggerType = "item.tag_added"
+	RuleTriggerOverdue       RuleTriggerType = "item.overdue" // Fired by the worker, once per due date
+)
+
+// RuleTrigger selects the changes a rule fires on.
+type RuleTrigger struct {
+	Type RuleTriggerType
+
+	// ToStatus restricts item.status_changed to changes to this status (nil = any).
+	ToStatus *TaskStatus
+
+	// Tag restricts item.tag_added to this tag (nil = any).
+	Tag *string
+
+	// OverdueBy is how long after its due date an open item fires item.overdue.
+	OverdueBy time.Duration
+}
+
+// RuleActionType is what an action of a rule does to the item it fired for.
+type RuleActionType string
+
+const (
+	RuleActionSetField    RuleActionType = "set_field"
+	RuleActionAddTag      RuleActionType = "add_tag"
+	RuleActionCreateItem  RuleActionType = "create_item"
+	RuleActionMove        RuleActionType = "move"
+	RuleActionCallWebhook RuleActionType = "call_webhook"
+)
+
+// Fields a set_field action can set, besides custom_fields.<name>.
+const (
+	RuleFieldStatus   = "status"
+	RuleFieldPriority = "priority"
+)
+
+// RuleAction is one step of a rule. Which fields apply depends on Type.
+type RuleAction struct {
+	Type RuleActionType
+
+	// set_field: Field is status, priority or custom_fields.<name>, Value the
+	// new value. A nil Value clears the priority or the custom field.
+	Field string
+	Value any
+
+	// add_tag: the tag to add.
+	Tag string
+
+	// create_item: the list of the new item (empty = the rule's list).
+	// move: the list the item moves to.
+	ListID string
+
+	// create_item: the new item. RuleTitlePlaceholder in Title is replaced
+	// with the title of the item the rule fired for.
+	Title    string
+	Tags     []string
+	Priority *TaskPriority
+
+	// call_webhook: the webhook subscription the rule.triggered event is sent to.
+	WebhookID string
+}
+
+// CustomField returns the custom field a set_field action sets, and false when
+// it sets a built-in field.
+func (a RuleAction) CustomField() (string, bool) {
+	return strings.CutPrefix(a.Field, CustomFieldOrderByPrefix)
+}
+
+// ItemTitle returns the title of the item a create_item action creates for
+// an item titled title.
+func (a RuleAction) ItemTitle(title string) string {
+	return strings.ReplaceAll(a.Title, RuleTitlePlaceholder, title)
+}
+
+// Validate checks the settings of the action.
+func (a RuleAction) Validate() error {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s action: %s", ErrInvalidRule, a.Type, fmt.Sprintf(format, args...))
+	}
+
+	switch a.Type {
+	case RuleActionSetField:
+		switch a.Field {
+		case RuleFieldStatus:
+			s, ok := a.Value.(string)
+			if !ok {
+				return invalid("status must be a string")
+			}
+			if _, err := NewTaskStatus(s); err != nil {
+				return invalid("%v", err)
+			}
+		case RuleFieldPriority:
+			if a.Value == nil {
+				return nil
+			}
+			s, ok := a.Value.(string)
+			if !ok {
+				return invalid("priority must be a string")
+			}
+			if _, err := NewTaskPriority(s); err != nil {
+				return invalid("%v", err)
+			}
+		default:
+			name, ok := a.CustomField()
+			if !ok {
+				return invalid("field must be status, priority or custom_fields.<name>")
+			}
+			if err := ValidateCustomFieldName(name); err != nil {
+				return invalid("%v", err)
+			}
+		}
+	case RuleActionAddTag:
+		if strings.TrimSpace(a.Tag) == "" {
+			return invalid("tag is required")
+		}
+	case RuleActionCreateItem:
+		if _, err := NewTitle(a.Title); err != nil {
+			return invalid("%v", err)
+		}
+		if a.Priority != nil {
+			if _, err := NewTaskPriority(string(*a.Priority)); err != nil {
+				return invalid("%v", err)
+			}
+		}
+	case RuleActionMove:
+		if a.ListID == "" {
+			return invalid("list_id is required")
+		}
+	case RuleActionCallWebhook:
+		if a.WebhookID == "" {
+			return invalid("webhook_id is required")
+		}
+	default:
+		return fmt.Errorf("%w: unknown action %q", ErrInvalidRule, a.Type)
+	}
+	return nil
+}
+
+// AutomationRule changes items of a list when something happens to them:
+// when Trigger fires for an item that matches Condition, the Actions run in
+// order, in the transaction of the change.
+//
+// Changes made by the actions fire rules in turn, up to MaxRuleDepth deep,
+// and a rule runs at most once per item for one change, so rules that undo
+// each other stop.
+type AutomationRule struct {
+	ID        string
+	ListID    string
+	OwnerID   string // Tenant whose access the actions run with; empty for internal callers
+	Name      string
+	Enabled   bool
+	Trigger   RuleTrigger
+	Condition ItemsFilterInput // The filters of item listings; the sort is ignored
+	Actions   []RuleAction
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Validate checks the name, trigger, condition and actions of a rule.
+// The name is trimmed in place.
+func (r *AutomationRule) Validate() error {
+	r.Name = strings.TrimSpace(r.Name)
+	if r.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidRule)
+	}
+	if len(r.Name) > MaxRuleNameLength {
+		return fmt.Errorf("%w: name must be %d characters or less", ErrInvalidRule, MaxRuleNameLength)
+	}
+
+	t := r.Trigger
+	switch t.Type {
+	case RuleTriggerItemCreated, RuleTriggerStatusChanged, RuleTriggerTagAdded, RuleTriggerOverdue:
+	default:
+		return fmt.Errorf("%w: unknown trigger %q", ErrInvalidRule, t.Type)
+	}
+	if t.ToStatus != nil {
+		if t.Type != RuleTriggerStatusChanged {
+			return fmt.Errorf("%w: to_status only applies to %s", ErrInvalidRule, RuleTriggerStatusChanged)
+		}
+		if _, err := NewTaskStatus(string(*t.ToStatus)); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRule, err)
+		}
+	}
+	if t.Tag != nil && t.Type != RuleTriggerTagAdded {
+		return fmt.Errorf("%w: tag only applies to %s", ErrInvalidRule, RuleTriggerTagAdded)
+	}
+	if t.OverdueBy < 0 || (t.OverdueBy > 0 && t.Type != RuleTriggerOverdue) {
+		return fmt.Errorf("%w: overdue_by only applies to %s and must not be negative", ErrInvalidRule, RuleTriggerOverdue)
+	}
+
+	if _, err := NewItemsFilter(r.Condition); err != nil {
+		return fmt.Errorf("%w: condition: %w", ErrInvalidRule, err)
+	}
+
+	if len(r.Actions) == 0 {
+		return fmt.Errorf("%w: at least one action is required", ErrInvalidRule)
+	}
+	if len(r.Actions) > MaxRuleActions {
+		return fmt.Errorf("%w: at most %d actions", ErrInvalidRule, MaxRuleActions)
+	}
+	for _, action := range r.Actions {
+		if err := action.Validate(); err != nil {
+			return err
+		}
+		if action.Type == RuleActionMove && action.ListID == r.ListID {
+			return fmt.Errorf("%w: move action: the item is already in list %s", ErrInvalidRule, r.ListID)
+		}
+	}
+	return nil
+}
+
+// RuleEvent is a change to an item that can fire rules.
+type RuleEvent struct {
+	Trigger   RuleTriggerType
+	Item      *TodoItem // The item after the change
+	AddedTags []string  // item.tag_added: the tags the change added
+
+	// Depth is 0 for changes made by callers and n for changes made by the
+	// actions of rules fired n-1 deep.
+	Depth int
+}
+
+// ItemChangeEvents returns the rule events of an update of before to after:
+// a status change and the tags added.
+func ItemChangeEvents(before, after *TodoItem, depth int) []RuleEvent {
+	var events []RuleEvent
+	if after.Status != before.Status {
+		events = append(events, RuleEvent{Trigger: RuleTriggerStatusChanged, Item: after, Depth: depth})
+	}
+	var added []string
+	for _, tag := range after.Tags {
+		if !slices.Contains(before.Tags, tag) {
+			added = append(added, tag)
+		}
+	}
+	if len(added) > 0 {
+		events = append(events, RuleEvent{Trigger: RuleTriggerTagAdded, Item: after, AddedTags: added, Depth: depth})
+	}
+	return events
+}
+
+// Fires reports whether the rule fires for event at now: the rule is enabled,
+// the trigger and its settings match the change, and the item, as the change
+// left it, is in the rule's list and matches the condition.
+func (r *AutomationRule) Fires(event RuleEvent, now time.Time) bool {
+	item := event.Item
+	if !r.Enabled || event.Trigger != r.Trigger.Type || item.ListID != r.ListID {
+		return false
+	}
+
+	switch r.Trigger.Type {
+	case RuleTriggerStatusChanged:
+		if r.Trigger.ToStatus != nil && item.Status != *r.Trigger.ToStatus {
+			return false
+		}
+	case RuleTriggerTagAdded:
+		if r.Trigger.Tag != nil && !slices.Contains(event.AddedTags, *r.Trigger.Tag) {
+			return false
+		}
+	case RuleTriggerOverdue:
+		if item.DueAt == nil || item.DueAt.Add(r.Trigger.OverdueBy).After(now) ||
+			!slices.Contains(UndoneStatuses(), item.Status) {
+			return false
+		}
+	}
+
+	filter, err := NewItemsFilter(r.Condition)
+	if err != nil {
+		return false
+	}
+	return filter.Matches(item, now)
+}
+
+// UpdateAutomationRuleParams contains parameters for updating a rule.
+// Trigger, Condition and Actions replace the stored values as a whole.
+type UpdateAutomationRuleParams struct {
+	ID         string
+	ListID     string
+	UpdateMask []string
+	Name       *string
+	Enabled    *bool
+	Trigger    *RuleTrigger
+	Condition  *ItemsFilterInput
+	Actions    []RuleAction
+}
+
+// RuleRunStatus is the outcome of a rule that fired.
+type RuleRunStatus string
+
+const (
+	RuleRunSucceeded RuleRunStatus = "succeeded" // Every action ran
+	RuleRunFailed    RuleRunStatus = "failed"    // An action could not run; none of them did
+	RuleRunSkipped   RuleRunStatus = "skipped"   // Stopped by loop protection
+)
+
+// RuleRun is an entry of the execution log of a rule: one firing of the rule
+// for an item.
+type RuleRun struct {
+	ID        string
+	RuleID    string
+	ListID    string
+	ItemID    string
+	Trigger   RuleTriggerType
+	Depth     int
+	Status    RuleRunStatus
+	Actions   []string // What the actions did, e.g. "set priority to urgent"
+	Error     *string  // Why the run failed or was skipped
+	CreatedAt time.Time
+}
+
+// OverdueRuleCandidate is an item that has been overdue for at least the
+// overdue_by of an overdue rule of its list, which has not fired for it at
+// its due date yet.
+type OverdueRuleCandidate struct {
+	RuleID string
+	ListID string
+	ItemID string
+	DueAt  time.Time
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutomationRule_Validate(t *testing.T) {
+	valid := func() AutomationRule {
+		return AutomationRule{
+			ListID:  "list-1",
+			Name:    "  Escalate  ",
+			Enabled: true,
+			Trigger: RuleTrigger{Type: RuleTriggerTagAdded},
+			Actions: []RuleAction{{Type: RuleActionSetField, Field: "priority", Value: "urgent"}},
+		}
+	}
+
+	rule := valid()
+	require.NoError(t, rule.Validate())
+	assert.Equal(t, "Escalate", rule.Name)
+
+	done := TaskStatusDone
+	bogus := TaskStatus("bogus")
+	tag := "urgent"
+	expr := "priority >>"
+	for name, mutate := range map[string]func(r *AutomationRule){
+		"empty name":          func(r *AutomationRule) { r.Name = " " },
+		"long name":           func(r *AutomationRule) { r.Name = strings.Repeat("x", MaxRuleNameLength+1) },
+		"unknown trigger":     func(r *AutomationRule) { r.Trigger.Type = "item.deleted" },
+		"to_status on tag":    func(r *AutomationRule) { r.Trigger.ToStatus = &done },
+		"invalid to_status":   func(r *AutomationRule) { r.Trigger = RuleTrigger{Type: RuleTriggerStatusChanged, ToStatus: &bogus} },
+		"tag on created":      func(r *AutomationRule) { r.Trigger = RuleTrigger{Type: RuleTriggerItemCreated, Tag: &tag} },
+		"overdue_by on tag":   func(r *AutomationRule) { r.Trigger.OverdueBy = time.Hour },
+		"negative overdue_by": func(r *AutomationRule) { r.Trigger = RuleTrigger{Type: RuleTriggerOverdue, OverdueBy: -time.Hour} },
+		"invalid condition":   func(r *AutomationRule) { r.Condition.Expression = &expr },
+		"no actions":          func(r *AutomationRule) { r.Actions = nil },
+		"too many actions": func(r *AutomationRule) {
+			r.Actions = make([]RuleAction, MaxRuleActions+1)
+			for i := range r.Actions {
+				r.Actions[i] = RuleAction{Type: RuleActionAddTag, Tag: "x"}
+			}
+		},
+		"unknown action": func(r *AutomationRule) { r.Actions[0] = RuleAction{Type: "delete"} },
+		"unknown field":  func(r *AutomationRule) { r.Actions[0].Field = "title" },
+		"invalid status": func(r *AutomationRule) {
+			r.Actions[0] = RuleAction{Type: RuleActionSetField, Field: "status", Value: "bogus"}
+		},
+		"cleared status":      func(r *AutomationRule) { r.Actions[0] = RuleAction{Type: RuleActionSetField, Field: "status"} },
+		"numeric priority":    func(r *AutomationRule) { r.Actions[0].Value = 3.0 },
+		"invalid custom name": func(r *AutomationRule) { r.Actions[0].Field = "custom_fields.Bad Name" },
+		"empty tag":           func(r *AutomationRule) { r.Actions[0] = RuleAction{Type: RuleActionAddTag, Tag: " "} },
+		"untitled item":       func(r *AutomationRule) { r.Actions[0] = RuleAction{Type: RuleActionCreateItem} },
+		"move without list":   func(r *AutomationRule) { r.Actions[0] = RuleAction{Type: RuleActionMove} },
+		"move to same list":   func(r *AutomationRule) { r.Actions[0] = RuleAction{Type: RuleActionMove, ListID: "list-1"} },
+		"webhook without id":  func(r *AutomationRule) { r.Actions[0] = RuleAction{Type: RuleActionCallWebhook} },
+	} {
+		rule := valid()
+		mutate(&rule)
+		assert.ErrorIs(t, rule.Validate(), ErrInvalidRule, name)
+	}
+
+	// Clearing the priority or a custom field is allowed
+	rule = valid()
+	rule.Actions = []RuleAction{
+		{Type: RuleActionSetField, Field: "priority"},
+		{Type: RuleActionSetField, Field: "custom_fields.points"},
+	}
+	assert.NoError(t, rule.Validate())
+}
+
+func TestAutomationRule_Fires(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	high := TaskPriorityHigh
+	item := &TodoItem{ListID: "list-1", Status: TaskStatusDone, Priority: &high, Tags: []string{"work"}}
+
+	done := TaskStatusDone
+	blocked := TaskStatusBlocked
+	rule := &AutomationRule{ListID: "list-1", Enabled: true, Trigger: RuleTrigger{Type: RuleTriggerStatusChanged, ToStatus: &done}}
+	event := RuleEvent{Trigger: RuleTriggerStatusChanged, Item: item}
+	assert.True(t, rule.Fires(event, now))
+
+	assert.False(t, rule.Fires(RuleEvent{Trigger: RuleTriggerItemCreated, Item: item}, now))
+	assert.False(t, rule.Fires(RuleEvent{Trigger: RuleTriggerStatusChanged, Item: &TodoItem{ListID: "list-2", Status: TaskStatusDone}}, now))
+
+	rule.Trigger.ToStatus = &blocked
+	assert.False(t, rule.Fires(event, now))
+	rule.Trigger.ToStatus = nil
+	assert.True(t, rule.Fires(event, now))
+
+	rule.Condition = ItemsFilterInput{Priorities: []string{"low"}}
+	assert.False(t, rule.Fires(event, now))
+	rule.Condition = ItemsFilterInput{Tags: []string{"work"}}
+	assert.True(t, rule.Fires(event, now))
+
+	rule.Enabled = false
+	assert.False(t, rule.Fires(event, now))
+
+	// Tag triggers only fire for the tag when it is added
+	tag := "urgent"
+	tagRule := &AutomationRule{ListID: "list-1", Enabled: true, Trigger: RuleTrigger{Type: RuleTriggerTagAdded, Tag: &tag}}
+	assert.True(t, tagRule.Fires(RuleEvent{Trigger: RuleTriggerTagAdded, Item: item, AddedTags: []string{"urgent"}}, now))
+	assert.False(t, tagRule.Fires(RuleEvent{Trigger: RuleTriggerTagAdded, Item: item, AddedTags: []string{"work"}}, now))
+
+	// Overdue triggers check the item is still open and overdue long enough
+	due := now.Add(-2 * time.Hour)
+	open := &TodoItem{ListID: "list-1", Status: TaskStatusTodo, DueAt: &due}
+	overdue := &AutomationRule{ListID: "list-1", Enabled: true, Trigger: RuleTrigger{Type: RuleTriggerOverdue, OverdueBy: time.Hour}}
+	assert.True(t, overdue.Fires(RuleEvent{Trigger: RuleTriggerOverdue, Item: open}, now))
+	overdue.Trigger.OverdueBy = 3 * time.Hour
+	assert.False(t, overdue.Fires(RuleEvent{Trigger: RuleTriggerOverdue, Item: open}, now))
+	overdue.Trigger.OverdueBy = 0
+	open.Status = TaskStatusDone
+	assert.False(t, overdue.Fires(RuleEvent{Trigger: RuleTriggerOverdue, Item: open}, now))
+}
+
+func TestItemChangeEvents(t *testing.T) {
+	before := &TodoItem{Status: TaskStatusTodo, Tags: []string{"a"}}
+
+	events := ItemChangeEvents(before, &TodoItem{Status: TaskStatusDone, Tags: []string{"a", "b", "c"}}, 1)
+	require.Len(t, events, 2)
+	assert.Equal(t, RuleTriggerStatusChanged, events[0].Trigger)
+	assert.Equal(t, RuleTriggerTagAdded, events[1].Trigger)
+	assert.Equal(t, []string{"b", "c"}, events[1].AddedTags)
+	assert.Equal(t, 1, events[1].Depth)
+
+	// Removing tags fires nothing
+	assert.Empty(t, ItemChangeEvents(before, &TodoItem{Status: TaskStatusTodo}, 0))
+}
+
+func TestRuleAction_ItemTitle(t *testing.T) {
+	action := RuleAction{Type: RuleActionCreateItem, Title: "Follow up: {{title}}"}
+	assert.Equal(t, "Follow up: Call Bob", action.ItemTitle("Call Bob"))
+}
@@ -0,0 +1,323 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// BackupVersion is the version of the list backup document. Restore only reads
+// this version: a change that older code could not read needs a new version.
+const BackupVersion = 1
+
+// BackupIDMode decides which IDs the entities of a restored backup get.
+type BackupIDMode string
+
+const (
+	// BackupIDsRemap gives every restored entity a new ID, so a backup can be
+	// restored any number of times, e.g. to copy a list.
+	BackupIDsRemap BackupIDMode = "remap"
+
+	// BackupIDsPreserve keeps the list, template and item IDs of the backup, so
+	// references to them keep working after moving a list to another instance.
+	// Restore fails if any of them is taken.
+	BackupIDsPreserve BackupIDMode = "preserve"
+)
+
+// NewBackupIDMode validates an ID mode; empty means BackupIDsRemap.
+func NewBackupIDMode(s string) (BackupIDMode, error) {
+	switch mode := BackupIDMode(strings.ToLower(s)); mode {
+	case "":
+		return BackupIDsRemap, nil
+	case BackupIDsRemap, BackupIDsPreserve:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("%w: unknown id mode %q", ErrInvalidBackup, s)
+	}
+}
+
+// ListBackup is a snapshot of a list with everything in it: its recurring
+// templates with their exceptions, and its items with their status history.
+// Members, reminders, webhooks and feeds are not part of a backup.
+type ListBackup struct {
+	Version    int
+	ExportedAt time.Time
+	List       TodoList
+	Templates  []TemplateBackup
+	Items      []ItemBackup
+}
+
+// TemplateBackup is a recurring template with its exceptions.
+type TemplateBackup struct {
+	Template   RecurringTemplate
+	Exceptions []RecurringTemplateException
+}
+
+// ItemBackup is an item with its status history, oldest change first.
+type ItemBackup struct {
+	Item          TodoItem
+	StatusHistory []StatusChange
+}
+
+// Validate checks a backup with the constructors that check each entity when it
+// is created through the API, and normalizes the values in place. Errors wrap
+// ErrInvalidBackup and name the entry, e.g. "items[3]: invalid task status".
+func (b *ListBackup) Validate() error {
+	if b.Version != BackupVersion {
+		return fmt.Errorf("%w: unsupported version %d (expected %d)", ErrInvalidBackup, b.Version, BackupVersion)
+	}
+
+	if err := b.validateList(); err != nil {
+		return backupError("list", err)
+	}
+
+	templateIDs := make(map[string]bool, len(b.Templates))
+	for i := range b.Templates {
+		backup := &b.Templates[i]
+		if err := backup.validate(b.List.CustomFieldSchema); err != nil {
+			return backupError(fmt.Sprintf("recurring_templates[%d]", i), err)
+		}
+		if templateIDs[backup.Template.ID] {
+			return backupError(fmt.Sprintf("recurring_templates[%d]", i), fmt.Errorf("duplicate id %s", backup.Template.ID))
+		}
+		templateIDs[backup.Template.ID] = true
+	}
+
+	itemIDs := make(map[string]bool, len(b.Items))
+	for i := range b.Items {
+		backup := &b.Items[i]
+		if err := backup.validate(b.List.CustomFieldSchema, templateIDs); err != nil {
+			return backupError(fmt.Sprintf("items[%d]", i), err)
+		}
+		if itemIDs[backup.Item.ID] {
+			return backupError(fmt.Sprintf("items[%d]", i), fmt.Errorf("duplicate id %s", backup.Item.ID))
+		}
+		itemIDs[backup.Item.ID] = true
+	}
+
+	// Exceptions may point at an item that was deleted since: the link is dropped
+	for i := range b.Templates {
+		for j := range b.Templates[i].Exceptions {
+			exception := &b.Templates[i].Exceptions[j]
+			if exception.ItemID != nil && !itemIDs[*exception.ItemID] {
+				exception.ItemID = nil
+			}
+		}
+	}
+
+	return nil
+}
+
+func (b *ListBackup) validateList() error {
+	if b.List.ID == "" {
+		return fmt.Errorf("missing id")
+	}
+	title, err := NewTitle(b.List.Title)
+	if err != nil {
+		return err
+	}
+	b.List.Title = title.String()
+
+	schema, err := NewCustomFieldSchema(b.List.CustomFieldSchema)
+	if err != nil {
+		return err
+	}
+	b.List.CustomFieldSchema = schema
+	return nil
+}
+
+func (b *TemplateBackup) validate(schema CustomFieldSchema) error {
+	t := &b.Template
+	if t.ID == "" {
+		return fmt.Errorf("missing id")
+	}
+	title, err := NewTitle(t.Title)
+	if err != nil {
+		return err
+	}
+	t.Title = title.String()
+
+	if t.Priority != nil {
+		priority, err := NewTaskPriority(string(*t.Priority))
+		if err != nil {
+			return err
+		}
+		t.Priority = &priority
+	}
+	pattern, err := NewRecurrencePattern(string(t.RecurrencePattern))
+	if err != nil {
+		return err
+	}
+	t.RecurrencePattern = pattern
+
+	if t.SyncHorizonDays < 1 {
+		return ErrSyncHorizonMustBePositive
+	}
+	if err := ValidateGenerationWindowDays(t.GenerationHorizonDays); err != nil {
+		return err
+	}
+	if err := ValidateDefaultReminders(t.DefaultReminders); err != nil {
+		return err
+	}
+	if t.CustomFields, err = schema.ValidateValues(t.CustomFields); err != nil {
+		return err
+	}
+
+	occurrences := make(map[time.Time]bool, len(b.Exceptions))
+	for i := range b.Exceptions {
+		exception := &b.Exceptions[i]
+		if err := exception.ExceptionType.Validate(); err != nil {
+			return fmt.Errorf("exceptions[%d]: %w", i, err)
+		}
+		exception.OccursAt = exception.OccursAt.UTC()
+		if occurrences[exception.OccursAt] {
+			return fmt.Errorf("exceptions[%d]: duplicate occurrence %s", i, exception.OccursAt.Format(time.RFC3339))
+		}
+		occurrences[exception.OccursAt] = true
+	}
+	return nil
+}
+
+func (b *ItemBackup) validate(schema CustomFieldSchema, templateIDs map[string]bool) error {
+	item := &b.Item
+	if item.ID == "" {
+		return fmt.Errorf("missing id")
+	}
+	title, err := NewTitle(item.Title)
+	if err != nil {
+		return err
+	}
+	item.Title = title.String()
+
+	if item.Status, err = NewTaskStatus(string(item.Status)); err != nil {
+		return err
+	}
+	if item.Priority != nil {
+		priority, err := NewTaskPriority(string(*item.Priority))
+		if err != nil {
+			return err
+		}
+		item.Priority = &priority
+	}
+	if item.Timezone != nil && *item.Timezone != "" {
+		if _, err := time.LoadLocation(*item.Timezone); err != nil {
+			return ErrInvalidTimezone
+		}
+	}
+	if item.CustomFields, err = schema.ValidateValues(item.CustomFields); err != nil {
+		return err
+	}
+
+	// An item generated from a template that was deleted since keeps no link,
+	// like items detached when their template is deleted
+	if item.RecurringTemplateID != nil && !templateIDs[*item.RecurringTemplateID] {
+		item.RecurringTemplateID = nil
+		item.OccursAt = nil
+	}
+	if item.OccursAt != nil && item.RecurringTemplateID == nil {
+		return ErrRecurringTaskRequiresTemplate
+	}
+
+	for i := range b.StatusHistory {
+		change := &b.StatusHistory[i]
+		if change.ToStatus, err = NewTaskStatus(string(change.ToStatus)); err != nil {
+			return fmt.Errorf("status_history[%d]: %w", i, err)
+		}
+		if change.FromStatus != nil {
+			from, err := NewTaskStatus(string(*change.FromStatus))
+			if err != nil {
+				return fmt.Errorf("status_history[%d]: %w", i, err)
+			}
+			change.FromStatus = &from
+		}
+	}
+	return nil
+}
+
+// RemapIDs gives the list and its templates and items IDs from newID, and
+// rewrites the references between them. Validate the backup first.
+func (b *ListBackup) RemapIDs(newID func() (string, error)) error {
+	listID, err := newID()
+	if err != nil {
+		return err
+	}
+	b.List.ID = listID
+
+	templateIDs := make(map[string]string, len(b.Templates))
+	for i := range b.Templates {
+		id, err := newID()
+		if err != nil {
+			return err
+		}
+		templateIDs[b.Templates[i].Template.ID] = id
+		b.Templates[i].Template.ID = id
+	}
+
+	itemIDs := make(map[string]string, len(b.Items))
+	for i := range b.Items {
+		item := &b.Items[i].Item
+		id, err := newID()
+		if err != nil {
+			return err
+		}
+		itemIDs[item.ID] = id
+		item.ID = id
+		if item.RecurringTemplateID != nil {
+			templateID := templateIDs[*item.RecurringTemplateID]
+			item.RecurringTemplateID = &templateID
+		}
+	}
+
+	for i := range b.Templates {
+		for j := range b.Templates[i].Exceptions {
+			exception := &b.Templates[i].Exceptions[j]
+			if exception.ItemID != nil {
+				itemID := itemIDs[*exception.ItemID]
+				exception.ItemID = &itemID
+			}
+		}
+	}
+	return nil
+}
+
+// backupError wraps a validation error of one entry of a backup.
+func backupError(path string, err error) error {
+	return fmt.Errorf("%w: %s: %v", ErrInvalidBackup, path, err)
+}
+
+// BackupConflict is an ID in a backup that is already taken, which fails a
+// restore that preserves IDs.
+type BackupConflict struct {
+	Kind string // "list", "recurring_template", "item" or "unavailable"
+	ID   string
+}
+
+// Kinds of entities in a BackupConflict. IDs taken by data of other tenants are
+// unavailable: their kind is not reported, so restores cannot probe other
+// tenants' data.
+const (
+	BackupConflictList        = "list"
+	BackupConflictTemplate    = "recurring_template"
+	BackupConflictItem        = "item"
+	BackupConflictUnavailable = "unavailable"
+)
+
+// String describes the conflict, for errors.
+func (c BackupConflict) String() string {
+	if c.Kind == BackupConflictUnavailable {
+		return "ID " + c.ID + " is unavailable"
+	}
+	return c.Kind + " " + c.ID + " already exists"
+}
+
+// RestoreReport describes what a restore created, or would create in a dry run.
+type RestoreReport struct {
+	DryRun        bool
+	IDMode        BackupIDMode
+	ListID        string // ID of the restored list
+	Templates     int
+	Exceptions    int
+	Items         int
+	StatusChanges int
+	Conflicts     []BackupConflict // Taken IDs; only reported by dry runs that preserve IDs
+}
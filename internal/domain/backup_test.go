@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBackup() *ListBackup {
+	templateID := "template-1"
+	itemID := "item-2"
+	occursAt := time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)
+	todo := TaskStatusTodo
+	return &ListBackup{
+		Version: BackupVersion,
+		List: TodoList{
+			ID:                "list-1",
+			Title:             "  Chores ",
+			CustomFieldSchema: CustomFieldSchema{{Name: "points", Type: CustomFieldTypeNumber}},
+		},
+		Templates: []TemplateBackup{{
+			Template: RecurringTemplate{
+				ID:                    templateID,
+				Title:                 "Vacuum",
+				RecurrencePattern:     "WEEKLY",
+				SyncHorizonDays:       14,
+				GenerationHorizonDays: 365,
+				CustomFields:          map[string]any{"points": 3},
+			},
+			Exceptions: []RecurringTemplateException{
+				{OccursAt: occursAt, ExceptionType: ExceptionTypeEdited, ItemID: &itemID},
+				{OccursAt: occursAt.AddDate(0, 0, 7), ExceptionType: ExceptionTypeDeleted},
+			},
+		}},
+		Items: []ItemBackup{
+			{Item: TodoItem{ID: "item-1", Title: "Buy soap", Status: "DONE"}, StatusHistory: []StatusChange{
+				{ToStatus: "todo"},
+				{FromStatus: &todo, ToStatus: "done"},
+			}},
+			{Item: TodoItem{ID: itemID, Title: "Vacuum", Status: TaskStatusTodo, RecurringTemplateID: &templateID, OccursAt: &occursAt}},
+		},
+	}
+}
+
+func TestListBackup_Validate(t *testing.T) {
+	backup := testBackup()
+	require.NoError(t, backup.Validate())
+
+	// Values are normalized like on create
+	assert.Equal(t, "Chores", backup.List.Title)
+	assert.Equal(t, RecurrenceWeekly, backup.Templates[0].Template.RecurrencePattern)
+	assert.Equal(t, float64(3), backup.Templates[0].Template.CustomFields["points"])
+	assert.Equal(t, TaskStatusDone, backup.Items[0].Item.Status)
+}
+
+func TestListBackup_ValidateDropsDanglingLinks(t *testing.T) {
+	backup := testBackup()
+	deletedTemplate := "template-gone"
+	deletedItem := "item-gone"
+	backup.Items[0].Item.RecurringTemplateID = &deletedTemplate
+	backup.Templates[0].Exceptions[1].ItemID = &deletedItem
+
+	require.NoError(t, backup.Validate())
+	assert.Nil(t, backup.Items[0].Item.RecurringTemplateID)
+	assert.Nil(t, backup.Templates[0].Exceptions[1].ItemID)
+	assert.NotNil(t, backup.Templates[0].Exceptions[0].ItemID)
+}
+
+func TestListBackup_ValidateErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(b *ListBackup)
+		want   string
+	}{
+		{"unknown version", func(b *ListBackup) { b.Version = 2 }, "unsupported version 2"},
+		{"list without title", func(b *ListBackup) { b.List.Title = " " }, "list: title is required"},
+		{"template pattern", func(b *ListBackup) { b.Templates[0].Template.RecurrencePattern = "hourly" }, "recurring_templates[0]: invalid recurrence pattern"},
+		{"exception type", func(b *ListBackup) { b.Templates[0].Exceptions[1].ExceptionType = "moved" }, "recurring_templates[0]: exceptions[1]"},
+		{"duplicate item", func(b *ListBackup) { b.Items[1].Item.ID = "item-1" }, "items[1]: duplicate id item-1"},
+		{"item status", func(b *ListBackup) { b.Items[0].Item.Status = "waiting" }, "items[0]: invalid task status"},
+		{"history status", func(b *ListBackup) { b.Items[0].StatusHistory[1].ToStatus = "gone" }, "items[0]: status_history[1]"},
+		{"undeclared custom field", func(b *ListBackup) { b.Items[0].Item.CustomFields = map[string]any{"color": "red"} }, "items[0]: unknown custom field"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backup := testBackup()
+			tt.modify(backup)
+
+			err := backup.Validate()
+			require.ErrorIs(t, err, ErrInvalidBackup)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestListBackup_RemapIDs(t *testing.T) {
+	backup := testBackup()
+	require.NoError(t, backup.Validate())
+
+	n := 0
+	require.NoError(t, backup.RemapIDs(func() (string, error) {
+		n++
+		return fmt.Sprintf("new-%d", n), nil
+	}))
+
+	assert.Equal(t, "new-1", backup.List.ID)
+	assert.Equal(t, "new-2", backup.Templates[0].Template.ID)
+	assert.Equal(t, "new-3", backup.Items[0].Item.ID)
+	assert.Equal(t, "new-4", backup.Items[1].Item.ID)
+	assert.Equal(t, "new-2", *backup.Items[1].Item.RecurringTemplateID)
+	assert.Equal(t, "new-4", *backup.Templates[0].Exceptions[0].ItemID)
+}
+
+func TestNewBackupIDMode(t *testing.T) {
+	mode, err := NewBackupIDMode("")
+	require.NoError(t, err)
+	assert.Equal(t, BackupIDsRemap, mode)
+
+	mode, err = NewBackupIDMode("PRESERVE")
+	require.NoError(t, err)
+	assert.Equal(t, BackupIDsPreserve, mode)
+
+	_, err = NewBackupIDMode("keep")
+	assert.ErrorIs(t, err, ErrInvalidBackup)
+}
+
+func TestBackupConflict_String(t *testing.T) {
+	assert.Equal(t, "item 42 already exists", BackupConflict{Kind: BackupConflictItem, ID: "42"}.String())
+	assert.Equal(t, "ID 42 is unavailable", BackupConflict{Kind: BackupConflictUnavailable, ID: "42"}.String())
+}
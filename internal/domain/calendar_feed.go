@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxCalendarFeedItems bounds the items a calendar feed returns, soonest due first.
+const MaxCalendarFeedItems = 1000
+
+// CalendarFeed is a read-only iCalendar subscription to the items of a list,
+// optionally narrowed to some statuses and tags.
+//
+// Calendar apps cannot send an Authorization header, so a feed is fetched with
+// a secret token in its URL. Only a hash of the token is stored; the token
+// itself is returned once, when the feed is created. A feed reads items with
+// the access of the tenant that created it, so it never shows more than they can see.
+type CalendarFeed struct {
+	ID        string
+	OwnerID   string // Tenant of the principal that created the feed; empty for internal callers
+	ListID    string
+	Name      string
+	Statuses  []string // Empty = the statuses an item listing shows by default
+	Tags      []string // Items must carry all tags
+	TokenHash string
+	Token     string // Only set on the feed returned by creation
+	CreatedAt time.Time
+}
+
+// CalendarFeedContent is what a calendar feed shows: its list and the matching items.
+type CalendarFeedContent struct {
+	Feed  *CalendarFeed
+	List  *TodoList
+	Items []TodoItem
+}
+
+// Validate checks the name and the filter of a feed.
+// The name is trimmed in place.
+func (f *CalendarFeed) Validate() error {
+	f.Name = strings.TrimSpace(f.Name)
+	if len(f.Name) > 255 {
+		return fmt.Errorf("%w: name must be 255 characters or less", ErrInvalidCalendarFeed)
+	}
+	if _, err := f.ItemsFilter(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidCalendarFeed, err)
+	}
+	return nil
+}
+
+// ItemsFilter returns the item search of the feed, soonest due first.
+func (f *CalendarFeed) ItemsFilter() (ItemsFilter, error) {
+	orderBy, orderDir := "due_at", "asc"
+	return NewItemsFilter(ItemsFilterInput{
+		Statuses: f.Statuses,
+		Tags:     f.Tags,
+		OrderBy:  &orderBy,
+		OrderDir: &orderDir,
+	})
+}
+
+// HashCalendarFeedToken returns the stored form of a feed token.
+// Tokens carry 256 bits of randomness, so a plain SHA-256 is enough to keep
+// them unrecoverable from the database.
+func HashCalendarFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
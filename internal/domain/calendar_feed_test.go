@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarFeed_Validate(t *testing.T) {
+	feed := &CalendarFeed{Name: "  Work calls  ", Statuses: []string{"todo"}, Tags: []string{"calls"}}
+	require.NoError(t, feed.Validate())
+	assert.Equal(t, "Work calls", feed.Name)
+
+	feed = &CalendarFeed{Name: strings.Repeat("a", 256)}
+	assert.ErrorIs(t, feed.Validate(), ErrInvalidCalendarFeed)
+
+	feed = &CalendarFeed{Statuses: []string{"sleeping"}}
+	assert.ErrorIs(t, feed.Validate(), ErrInvalidCalendarFeed)
+}
+
+func TestHashCalendarFeedToken(t *testing.T) {
+	hash := HashCalendarFeedToken("ics_token")
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, HashCalendarFeedToken("ics_token"))
+	assert.NotEqual(t, hash, HashCalendarFeedToken("ics_other"))
+}
@@ -0,0 +1,124 @@
+package domain
+
+import "time"
+
+// ChangeEntityType identifies the kind of entity a change log row describes.
+type ChangeEntityType string
+
+const (
+	ChangeEntityList       ChangeEntityType = "list"
+	ChangeEntityItem       ChangeEntityType = "item"
+	ChangeEntityTemplate   ChangeEntityType = "template"
+	ChangeEntityDeadLetter ChangeEntityType = "dead_letter"
+)
+
+// ChangeOperation is the kind of write recorded in the change log.
+type ChangeOperation string
+
+const (
+	ChangeCreated ChangeOperation = "created"
+	ChangeUpdated ChangeOperation = "updated"
+	ChangeDeleted ChangeOperation = "deleted"
+	ChangeRevoked ChangeOperation = "revoked" // A list membership was removed; only read by sync
+)
+
+// FieldChange holds the previous and new value of a changed field.
+type FieldChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// Change is one row of the transactional change log.
+// It is written in the same transaction as the create, update or delete it describes.
+// Snapshot is the entity after the change (before it, for deletes), keyed by
+// snake_case column names. Diff is only set for updates.
+type Change struct {
+	Seq         int64
+	TxID        uint64 // Transaction that wrote the change
+	EntityType  ChangeEntityType
+	EntityID    string
+	ListID      string // Empty for entities outside a list (dead letter jobs)
+	Operation   ChangeOperation
+	Version     int
+	Snapshot    map[string]any
+	Diff        map[string]FieldChange
+	OccurredAt  time.Time
+	PublishedAt *time.Time
+}
+
+// templateBookkeepingFields are template columns maintained by the generator.
+// Updates touching nothing else are not user-visible changes.
+var templateBookkeepingFields = map[string]bool{
+	"generated_through": true,
+}
+
+// EventTypes returns the events subscribers should receive for the change, in order.
+// Changes without a subscribable event (e.g. resolving a dead letter job) return nil.
+func (c *Change) EventTypes() []EventType {
+	switch c.EntityType {
+	case ChangeEntityList:
+		switch c.Operation {
+		case ChangeCreated:
+			return []EventType{EventListCreated}
+		case ChangeUpdated:
+			return []EventType{EventListUpdated}
+		}
+	case ChangeEntityItem:
+		switch c.Operation {
+		case ChangeCreated:
+			return []EventType{EventItemCreated}
+		case ChangeUpdated:
+			types := []EventType{EventItemUpdated}
+			if _, ok := c.Diff["status"]; ok {
+				types = append(types, EventItemStatusChanged)
+			}
+			if snooze, ok := c.Diff["snoozed_until"]; ok && snooze.New == nil {
+				types = append(types, EventItemWoke)
+			}
+			return types
+		case ChangeDeleted:
+			return []EventType{EventItemDeleted}
+		}
+	case ChangeEntityTemplate:
+		switch c.Operation {
+		case ChangeCreated:
+			return []EventType{EventTemplateCreated}
+		case ChangeUpdated:
+			if c.onlyChanges(templateBookkeepingFields) {
+				return nil
+			}
+			return []EventType{EventTemplateUpdated}
+		case ChangeDeleted:
+			return []EventType{EventTemplateDeleted}
+		}
+	case ChangeEntityDeadLetter:
+		if c.Operation == ChangeCreated {
+			return []EventType{EventDeadLetterCreated}
+		}
+	}
+	return nil
+}
+
+// onlyChanges reports whether every changed field is in fields.
+func (c *Change) onlyChanges(fields map[string]bool) bool {
+	for field := range c.Diff {
+		if !fields[field] {
+			return false
+		}
+	}
+	return true
+}
+
+// ChangeFeedQuery selects the changes an event stream delivers.
+// Results are restricted to lists the principal in the context can access.
+type ChangeFeedQuery struct {
+	ListID      string             // One list; empty for every accessible list
+	EntityTypes []ChangeEntityType // Entity types to include
+	After       SyncCursor         // Only changes after this position
+	Limit       int
+}
+
+// Position returns the position of the change in the change log.
+func (c *Change) Position() SyncCursor {
+	return SyncCursor{TxID: c.TxID, Seq: c.Seq}
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChange_EventTypes(t *testing.T) {
+	tests := []struct {
+		name   string
+		change Change
+		want   []EventType
+	}{
+		{
+			name:   "list created",
+			change: Change{EntityType: ChangeEntityList, Operation: ChangeCreated},
+			want:   []EventType{EventListCreated},
+		},
+		{
+			name: "item title changed",
+			change: Change{EntityType: ChangeEntityItem, Operation: ChangeUpdated, Diff: map[string]FieldChange{
+				"title": {Old: "a", New: "b"},
+			}},
+			want: []EventType{EventItemUpdated},
+		},
+		{
+			name: "item status changed",
+			change: Change{EntityType: ChangeEntityItem, Operation: ChangeUpdated, Diff: map[string]FieldChange{
+				"status": {Old: "todo", New: "done"},
+			}},
+			want: []EventType{EventItemUpdated, EventItemStatusChanged},
+		},
+		{
+			name: "item snoozed",
+			change: Change{EntityType: ChangeEntityItem, Operation: ChangeUpdated, Diff: map[string]FieldChange{
+				"snoozed_until": {Old: nil, New: "2026-03-23T09:00:00+00:00"},
+			}},
+			want: []EventType{EventItemUpdated},
+		},
+		{
+			name: "item woke",
+			change: Change{EntityType: ChangeEntityItem, Operation: ChangeUpdated, Diff: map[string]FieldChange{
+				"snoozed_until": {Old: "2026-03-23T09:00:00+00:00", New: nil},
+			}},
+			want: []EventType{EventItemUpdated, EventItemWoke},
+		},
+		{
+			name:   "item deleted",
+			change: Change{EntityType: ChangeEntityItem, Operation: ChangeDeleted},
+			want:   []EventType{EventItemDeleted},
+		},
+		{
+			name: "template generation marker only",
+			change: Change{EntityType: ChangeEntityTemplate, Operation: ChangeUpdated, Diff: map[string]FieldChange{
+				"generated_through": {Old: "2026-01-01", New: "2026-02-01"},
+			}},
+			want: nil,
+		},
+		{
+			name: "template edited",
+			change: Change{EntityType: ChangeEntityTemplate, Operation: ChangeUpdated, Diff: map[string]FieldChange{
+				"generated_through": {Old: "2026-01-01", New: "2026-02-01"},
+				"title":             {Old: "a", New: "b"},
+			}},
+			want: []EventType{EventTemplateUpdated},
+		},
+		{
+			name:   "dead letter created",
+			change: Change{EntityType: ChangeEntityDeadLetter, Operation: ChangeCreated},
+			want:   []EventType{EventDeadLetterCreated},
+		},
+		{
+			name:   "dead letter resolved",
+			change: Change{EntityType: ChangeEntityDeadLetter, Operation: ChangeUpdated},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.change.EventTypes())
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CustomFieldType represents the value type of a custom field declared on a list.
+// Value object - immutable string enum.
+type CustomFieldType string
+
+const (
+	CustomFieldTypeString  CustomFieldType = "string"
+	CustomFieldTypeNumber  CustomFieldType = "number"
+	CustomFieldTypeDate    CustomFieldType = "date"
+	CustomFieldTypeEnum    CustomFieldType = "enum"
+	CustomFieldTypeBoolean CustomFieldType = "boolean"
+	CustomFieldTypeURL     CustomFieldType = "url"
+)
+
+// Custom field limits - business rules to prevent abuse.
+const (
+	MaxCustomFieldsPerList    = 50
+	MaxCustomFieldEnumOptions = 100
+	MaxCustomFieldStringLen   = 1024
+	MaxCustomFieldFilter      = 5
+
+	// CustomFieldDateLayout is the format of date custom field values (calendar date, no time).
+	CustomFieldDateLayout = "2006-01-02"
+
+	// CustomFieldOrderByPrefix selects a custom field as sort key, e.g. "custom_fields.story_points".
+	CustomFieldOrderByPrefix = "custom_fields."
+)
+
+// customFieldNamePattern restricts names to lowercase identifiers so they are safe
+// to use as JSON keys, query parameter names, and order_by suffixes.
+var customFieldNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+
+// NewCustomFieldType validates and creates a CustomFieldType.
+func NewCustomFieldType(s string) (CustomFieldType, error) {
+	fieldType := CustomFieldType(strings.ToLower(s))
+
+	switch fieldType {
+	case CustomFieldTypeString, CustomFieldTypeNumber, CustomFieldTypeDate,
+		CustomFieldTypeEnum, CustomFieldTypeBoolean, CustomFieldTypeURL:
+		return fieldType, nil
+	default:
+		return "", fmt.Errorf("%w: unknown type %q", ErrInvalidCustomFieldSchema, s)
+	}
+}
+
+// ValidateCustomFieldName checks that a custom field name is a lowercase identifier.
+func ValidateCustomFieldName(name string) error {
+	if !customFieldNamePattern.MatchString(name) {
+		return fmt.Errorf("%w: invalid field name %q (expected lowercase letters, digits and underscores)", ErrInvalidCustomFieldSchema, name)
+	}
+	return nil
+}
+
+// CustomFieldDefinition declares a single typed custom field on a list.
+type CustomFieldDefinition struct {
+	Name    string
+	Type    CustomFieldType
+	Options []string // Allowed values, only for CustomFieldTypeEnum
+}
+
+// CustomFieldSchema is the ordered set of custom field definitions declared by a list.
+// Items and recurring templates in the list may only carry values for declared fields.
+type CustomFieldSchema []CustomFieldDefinition
+
+// NewCustomFieldSchema validates field definitions and returns a schema.
+// Names must be unique identifiers, types must be known, and enum fields must
+// declare at least one distinct option. Options are rejected for non-enum types.
+func NewCustomFieldSchema(definitions []CustomFieldDefinition) (CustomFieldSchema, error) {
+	if len(definitions) > MaxCustomFieldsPerList {
+		return nil, fmt.Errorf("%w: at most %d fields allowed", ErrInvalidCustomFieldSchema, MaxCustomFieldsPerList)
+	}
+
+	schema := make(CustomFieldSchema, 0, len(definitions))
+	seen := make(map[string]bool, len(definitions))
+
+	for _, def := range definitions {
+		if err := ValidateCustomFieldName(def.Name); err != nil {
+			return nil, err
+		}
+		if seen[def.Name] {
+			return nil, fmt.Errorf("%w: duplicate field name %q", ErrInvalidCustomFieldSchema, def.Name)
+		}
+		seen[def.Name] = true
+
+		fieldType, err := NewCustomFieldType(string(def.Type))
+		if err != nil {
+			return nil, err
+		}
+
+		var options []string
+		if fieldType == CustomFieldTypeEnum {
+			if len(def.Options) == 0 {
+				return nil, fmt.Errorf("%w: enum field %q requires options", ErrInvalidCustomFieldSchema, def.Name)
+			}
+			if len(def.Options) > MaxCustomFieldEnumOptions {
+				return nil, fmt.Errorf("%w: enum field %q allows at most %d options", ErrInvalidCustomFieldSchema, def.Name, MaxCustomFieldEnumOptions)
+			}
+			options = make([]string, 0, len(def.Options))
+			for _, opt := range def.Options {
+				if opt == "" || slices.Contains(options, opt) {
+					return nil, fmt.Errorf("%w: enum field %q has empty or duplicate option %q", ErrInvalidCustomFieldSchema, def.Name, opt)
+				}
+				options = append(options, opt)
+			}
+		} else if len(def.Options) > 0 {
+			return nil, fmt.Errorf("%w: options are only allowed for enum fields (%q)", ErrInvalidCustomFieldSchema, def.Name)
+		}
+
+		schema = append(schema, CustomFieldDefinition{
+			Name:    def.Name,
+			Type:    fieldType,
+			Options: options,
+		})
+	}
+
+	return schema, nil
+}
+
+// Field returns the definition for the named field.
+func (s CustomFieldSchema) Field(name string) (CustomFieldDefinition, bool) {
+	for _, def := range s {
+		if def.Name == name {
+			return def, true
+		}
+	}
+	return CustomFieldDefinition{}, false
+}
+
+// ValidateValues checks custom field values against the schema and returns a
+// normalized copy. Values are expected in their JSON-decoded form:
+//
+//	string, enum, url → string
+//	number            → float64 (or any Go integer/float type)
+//	date              → string in YYYY-MM-DD format
+//	boolean           → bool
+//
+// Nil values are dropped (the field is unset). Unknown field names are rejected.
+func (s CustomFieldSchema) ValidateValues(values map[string]any) (map[string]any, error) {
+	normalized := make(map[string]any, len(values))
+
+	for name, raw := range values {
+		def, ok := s.Field(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCustomField, name)
+		}
+		if raw == nil {
+			continue
+		}
+		value, err := def.normalizeValue(raw)
+		if err != nil {
+			return nil, err
+		}
+		normalized[name] = value
+	}
+
+	return normalized, nil
+}
+
+// normalizeValue validates a single value against the field type.
+func (d CustomFieldDefinition) normalizeValue(raw any) (any, error) {
+	invalid := func(reason string) error {
+		return fmt.Errorf("%w: %s %s", ErrInvalidCustomFieldValue, d.Name, reason)
+	}
+
+	switch d.Type {
+	case CustomFieldTypeString:
+		s, ok := raw.(string)
+		if !ok {
+			return nil, invalid("must be a string")
+		}
+		if len(s) > MaxCustomFieldStringLen {
+			return nil, invalid(fmt.Sprintf("must be %d characters or less", MaxCustomFieldStringLen))
+		}
+		return s, nil
+
+	case CustomFieldTypeNumber:
+		n, ok := toFloat64(raw)
+		if !ok || math.IsNaN(n) || math.IsInf(n, 0) {
+			return nil, invalid("must be a finite number")
+		}
+		return n, nil
+
+	case CustomFieldTypeDate:
+		s, ok := raw.(string)
+		if !ok {
+			return nil, invalid("must be a date string (YYYY-MM-DD)")
+		}
+		if _, err := time.Parse(CustomFieldDateLayout, s); err != nil {
+			return nil, invalid("must be a date string (YYYY-MM-DD)")
+		}
+		return s, nil
+
+	case CustomFieldTypeEnum:
+		s, ok := raw.(string)
+		if !ok || !slices.Contains(d.Options, s) {
+			return nil, invalid(fmt.Sprintf("must be one of %v", d.Options))
+		}
+		return s, nil
+
+	case CustomFieldTypeBoolean:
+		b, ok := raw.(bool)
+		if !ok {
+			return nil, invalid("must be a boolean")
+		}
+		return b, nil
+
+	case CustomFieldTypeURL:
+		s, ok := raw.(string)
+		if !ok || len(s) > MaxCustomFieldStringLen {
+			return nil, invalid("must be an absolute http(s) URL")
+		}
+		u, err := url.ParseRequestURI(s)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, invalid("must be an absolute http(s) URL")
+		}
+		return s, nil
+
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFieldType, d.Type)
+	}
+}
+
+// toFloat64 converts JSON-decoded or Go numeric values to float64.
+func toFloat64(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCustomFieldSchema_Valid(t *testing.T) {
+	schema, err := NewCustomFieldSchema([]CustomFieldDefinition{
+		{Name: "story_points", Type: "number"},
+		{Name: "stage", Type: "ENUM", Options: []string{"design", "build"}},
+		{Name: "review_on", Type: CustomFieldTypeDate},
+		{Name: "billable", Type: CustomFieldTypeBoolean},
+		{Name: "spec", Type: CustomFieldTypeURL},
+		{Name: "notes", Type: CustomFieldTypeString},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, schema, 6)
+	assert.Equal(t, CustomFieldTypeEnum, schema[1].Type, "type should be normalized to lowercase")
+
+	def, ok := schema.Field("stage")
+	require.True(t, ok)
+	assert.Equal(t, []string{"design", "build"}, def.Options)
+
+	_, ok = schema.Field("missing")
+	assert.False(t, ok)
+}
+
+func TestNewCustomFieldSchema_Empty(t *testing.T) {
+	schema, err := NewCustomFieldSchema(nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, schema)
+}
+
+func TestNewCustomFieldSchema_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		defs []CustomFieldDefinition
+	}{
+		{"empty name", []CustomFieldDefinition{{Name: "", Type: CustomFieldTypeString}}},
+		{"uppercase name", []CustomFieldDefinition{{Name: "Points", Type: CustomFieldTypeNumber}}},
+		{"name with dot", []CustomFieldDefinition{{Name: "a.b", Type: CustomFieldTypeNumber}}},
+		{"name too long", []CustomFieldDefinition{{Name: "a" + strings.Repeat("b", 63), Type: CustomFieldTypeNumber}}},
+		{"duplicate name", []CustomFieldDefinition{
+			{Name: "points", Type: CustomFieldTypeNumber},
+			{Name: "points", Type: CustomFieldTypeString},
+		}},
+		{"unknown type", []CustomFieldDefinition{{Name: "points", Type: "integer"}}},
+		{"enum without options", []CustomFieldDefinition{{Name: "stage", Type: CustomFieldTypeEnum}}},
+		{"enum with duplicate option", []CustomFieldDefinition{{Name: "stage", Type: CustomFieldTypeEnum, Options: []string{"a", "a"}}}},
+		{"enum with empty option", []CustomFieldDefinition{{Name: "stage", Type: CustomFieldTypeEnum, Options: []string{""}}}},
+		{"options on non-enum", []CustomFieldDefinition{{Name: "notes", Type: CustomFieldTypeString, Options: []string{"a"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCustomFieldSchema(tt.defs)
+			assert.ErrorIs(t, err, ErrInvalidCustomFieldSchema)
+		})
+	}
+}
+
+func TestNewCustomFieldSchema_TooManyFields(t *testing.T) {
+	defs := make([]CustomFieldDefinition, MaxCustomFieldsPerList+1)
+	for i := range defs {
+		defs[i] = CustomFieldDefinition{Name: "f" + strings.Repeat("x", i), Type: CustomFieldTypeString}
+	}
+
+	_, err := NewCustomFieldSchema(defs)
+	assert.ErrorIs(t, err, ErrInvalidCustomFieldSchema)
+}
+
+func testCustomFieldSchema(t *testing.T) CustomFieldSchema {
+	t.Helper()
+	schema, err := NewCustomFieldSchema([]CustomFieldDefinition{
+		{Name: "story_points", Type: CustomFieldTypeNumber},
+		{Name: "stage", Type: CustomFieldTypeEnum, Options: []string{"design", "build"}},
+		{Name: "review_on", Type: CustomFieldTypeDate},
+		{Name: "billable", Type: CustomFieldTypeBoolean},
+		{Name: "spec", Type: CustomFieldTypeURL},
+		{Name: "notes", Type: CustomFieldTypeString},
+	})
+	require.NoError(t, err)
+	return schema
+}
+
+func TestCustomFieldSchema_ValidateValues_Valid(t *testing.T) {
+	schema := testCustomFieldSchema(t)
+
+	values, err := schema.ValidateValues(map[string]any{
+		"story_points": 3, // Go int is normalized to float64
+		"stage":        "build",
+		"review_on":    "2026-01-15",
+		"billable":     true,
+		"spec":         "https://example.com/spec",
+		"notes":        "hello",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"story_points": float64(3),
+		"stage":        "build",
+		"review_on":    "2026-01-15",
+		"billable":     true,
+		"spec":         "https://example.com/spec",
+		"notes":        "hello",
+	}, values)
+}
+
+func TestCustomFieldSchema_ValidateValues_NilUnsetsField(t *testing.T) {
+	schema := testCustomFieldSchema(t)
+
+	values, err := schema.ValidateValues(map[string]any{
+		"story_points": nil,
+		"stage":        "design",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"stage": "design"}, values)
+}
+
+func TestCustomFieldSchema_ValidateValues_UnknownField(t *testing.T) {
+	schema := testCustomFieldSchema(t)
+
+	_, err := schema.ValidateValues(map[string]any{"unknown": "x"})
+	assert.ErrorIs(t, err, ErrUnknownCustomField)
+
+	// A list without a schema rejects all values
+	_, err = CustomFieldSchema(nil).ValidateValues(map[string]any{"stage": "build"})
+	assert.ErrorIs(t, err, ErrUnknownCustomField)
+}
+
+func TestCustomFieldSchema_ValidateValues_InvalidValues(t *testing.T) {
+	schema := testCustomFieldSchema(t)
+
+	tests := []struct {
+		name  string
+		field string
+		value any
+	}{
+		{"number as string", "story_points", "3"},
+		{"number as bool", "story_points", true},
+		{"enum not in options", "stage", "deploy"},
+		{"enum as number", "stage", 1.0},
+		{"date with time", "review_on", "2026-01-15T10:00:00Z"},
+		{"date invalid day", "review_on", "2026-02-30"},
+		{"boolean as string", "billable", "true"},
+		{"url relative", "spec", "/spec"},
+		{"url wrong scheme", "spec", "ftp://example.com/spec"},
+		{"url without host", "spec", "https://"},
+		{"string as number", "notes", 1.0},
+		{"string too long", "notes", strings.Repeat("x", MaxCustomFieldStringLen+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := schema.ValidateValues(map[string]any{tt.field: tt.value})
+			assert.ErrorIs(t, err, ErrInvalidCustomFieldValue)
+			assert.Contains(t, err.Error(), tt.field)
+		})
+	}
+}
@@ -45,12 +45,91 @@ var (
 	ErrTooManyStatuses               = errors.New("too many statuses in filter")
 	ErrTooManyPriorities             = errors.New("too many priorities in filter")
 	ErrTooManyTags                   = errors.New("too many tags in filter")
-	ErrFilterParsingNotImplemented   = errors.New("filter parsing not yet implemented")
+	ErrTooManyListIDs                = errors.New("too many lists in filter")
+	ErrInvalidFilterExpression       = errors.New("invalid filter expression")
+	ErrTooManyCustomFieldFilters     = errors.New("too many custom field filters")
+	ErrInvalidCustomFieldFilter      = errors.New("invalid custom field filter")
+	ErrInvalidAgendaDate             = errors.New("invalid agenda date")
+	ErrInvalidAgendaTimezone         = errors.New("invalid agenda timezone")
+	ErrInvalidStatsRange             = errors.New("invalid stats range")
+	ErrInvalidStatsGroupBy           = errors.New("invalid stats group_by")
+	ErrInvalidStatsTimezone          = errors.New("invalid stats timezone")
+	ErrInvalidSnapshotRange          = errors.New("invalid snapshot range")
+	ErrInvalidTimesheetRange         = errors.New("invalid timesheet range")
+	ErrInvalidTimesheetGroupBy       = errors.New("invalid timesheet group_by")
+	ErrInvalidTimesheetTimezone      = errors.New("invalid timesheet timezone")
+
+	// Custom field errors
+	ErrInvalidCustomFieldSchema = errors.New("invalid custom field schema")
+	ErrUnknownCustomField       = errors.New("unknown custom field")
+	ErrInvalidCustomFieldValue  = errors.New("invalid custom field value")
 
 	// Business logic errors
 	ErrItemNotFound     = errors.New("item not found")
 	ErrTemplateNotFound = errors.New("recurring template not found")
 	ErrUnauthorized     = errors.New("unauthorized")
+	ErrForbidden        = errors.New("insufficient scope")
+	ErrInvalidScope     = errors.New("invalid scope")
+
+	// List sharing errors
+	ErrInvalidListRole     = errors.New("invalid list role")
+	ErrListAccessDenied    = errors.New("insufficient role on list")
+	ErrMemberNotFound      = errors.New("list member not found")
+	ErrMemberAlreadyExists = errors.New("principal already has access to this list")
+
+	// Reminder errors
+	ErrInvalidReminder            = errors.New("invalid reminder")
+	ErrReminderNotFound           = errors.New("reminder not found")
+	ErrTooManyReminders           = errors.New("too many reminders")
+	ErrReminderOwnershipLost      = errors.New("reminder ownership lost to another worker")
+	ErrDeadLetterReminderNotFound = errors.New("dead letter reminder not found")
+
+	// Snooze errors
+	ErrInvalidSnooze = errors.New("invalid snooze")
+
+	// Time entry errors
+	ErrInvalidTimeEntry    = errors.New("invalid time entry")
+	ErrTimeEntryNotFound   = errors.New("time entry not found")
+	ErrNoRunningTimer      = errors.New("no running timer")
+	ErrTimerAlreadyRunning = errors.New("timer already running")
+
+	// Webhook errors
+	ErrInvalidWebhook               = errors.New("invalid webhook subscription")
+	ErrWebhookNotFound              = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound      = errors.New("webhook delivery not found")
+	ErrWebhookDeliveryOwnershipLost = errors.New("webhook delivery ownership lost to another worker")
+
+	// Saved view errors
+	ErrInvalidSavedView  = errors.New("invalid saved view")
+	ErrSavedViewNotFound = errors.New("saved view not found")
+
+	// Automation rule errors
+	ErrInvalidRule  = errors.New("invalid automation rule")
+	ErrRuleNotFound = errors.New("automation rule not found")
+	ErrTooManyRules = errors.New("too many automation rules")
+
+	// Tag errors
+	ErrInvalidTag       = errors.New("invalid tag")
+	ErrTagNotFound      = errors.New("tag not found")
+	ErrTagAlreadyExists = errors.New("tag already exists")
+
+	// Calendar feed errors
+	ErrInvalidCalendarFeed  = errors.New("invalid calendar feed")
+	ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+	// Import errors
+	ErrInvalidImport   = errors.New("invalid import")
+	ErrAlreadyImported = errors.New("already imported into this list")
+
+	// Backup errors
+	ErrInvalidBackup  = errors.New("invalid backup")
+	ErrBackupConflict = errors.New("backup conflicts with existing data")
+
+	// Change stream errors
+	ErrInvalidLastEventID = errors.New("invalid Last-Event-ID")
+
+	// Sync errors
+	ErrInvalidSyncCursor = errors.New("invalid sync cursor")
 
 	// Exception errors
 	ErrInvalidExceptionType   = errors.New("invalid exception type")
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// EventType identifies a change that integrations can subscribe to.
+type EventType string
+
+const (
+	EventListCreated       EventType = "list.created"
+	EventListUpdated       EventType = "list.updated"
+	EventItemCreated       EventType = "item.created"
+	EventItemUpdated       EventType = "item.updated"
+	EventItemStatusChanged EventType = "item.status_changed"
+	EventItemDeleted       EventType = "item.deleted"
+	EventItemWoke          EventType = "item.woke"
+	EventTemplateCreated   EventType = "template.created"
+	EventTemplateUpdated   EventType = "template.updated"
+	EventTemplateDeleted   EventType = "template.deleted"
+	EventDeadLetterCreated EventType = "dead_letter.created"
+)
+
+// EventRuleTriggered is sent by the call_webhook action of an automation rule
+// to the subscription the action names. Subscriptions cannot select it: it is
+// not one of EventTypes.
+const EventRuleTriggered EventType = "rule.triggered"
+
+// EventTypes returns all event types in a stable order.
+func EventTypes() []EventType {
+	return []EventType{
+		EventListCreated,
+		EventListUpdated,
+		EventItemCreated,
+		EventItemUpdated,
+		EventItemStatusChanged,
+		EventItemDeleted,
+		EventItemWoke,
+		EventTemplateCreated,
+		EventTemplateUpdated,
+		EventTemplateDeleted,
+		EventDeadLetterCreated,
+	}
+}
+
+// NewEventType validates and creates an EventType.
+func NewEventType(s string) (EventType, error) {
+	for _, t := range EventTypes() {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("%w: unknown event %q", ErrInvalidWebhook, s)
+}
+
+// Event records a change to a list, item, template or the job queue.
+// Data is a JSON-friendly snapshot of the changed entity keyed by snake_case field names.
+// Events are only delivered to subscriptions whose tenant can access the list
+// the event belongs to.
+type Event struct {
+	ID         string
+	Type       EventType
+	OccurredAt time.Time
+	Data       map[string]any
+	ListID     string // Empty for dead letter jobs
+	TemplateID string // Template of a dead letter job; its list owns the event
+}
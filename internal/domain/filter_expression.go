@@ -0,0 +1,680 @@
+package domain
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Filter expression limits - business rules to prevent abuse.
+const (
+	MaxFilterExpressionLength      = 1024
+	MaxFilterExpressionComparisons = 32
+	MaxFilterExpressionDepth       = 8
+)
+
+// FilterField is an item field that a filter expression can test.
+type FilterField string
+
+const (
+	FilterFieldStatus      FilterField = "status"
+	FilterFieldPriority    FilterField = "priority"
+	FilterFieldTags        FilterField = "tags"
+	FilterFieldTitle       FilterField = "title"
+	FilterFieldDueAt       FilterField = "due_at"
+	FilterFieldCreatedAt   FilterField = "created_at"
+	FilterFieldUpdatedAt   FilterField = "updated_at"
+	FilterFieldCustomField FilterField = "custom_fields" // written as custom_fields.<name>
+)
+
+// FilterOperator is the comparison of a filter expression term.
+type FilterOperator string
+
+const (
+	FilterOpEqual          FilterOperator = "="
+	FilterOpNotEqual       FilterOperator = "!="
+	FilterOpLess           FilterOperator = "<"
+	FilterOpLessOrEqual    FilterOperator = "<="
+	FilterOpGreater        FilterOperator = ">"
+	FilterOpGreaterOrEqual FilterOperator = ">="
+	FilterOpHas            FilterOperator = ":"  // tags: has the tag; title: contains, ignoring case; otherwise equal
+	FilterOpPresent        FilterOperator = ":*" // the field has a value
+)
+
+// filterValueKind is the type a field's values are checked against.
+type filterValueKind int
+
+const (
+	filterValueStatus filterValueKind = iota
+	filterValuePriority
+	filterValueText
+	filterValueTime
+)
+
+// filterFieldSpec describes which operators a field supports and how its values are checked.
+type filterFieldSpec struct {
+	field     FilterField
+	kind      filterValueKind
+	operators []FilterOperator
+}
+
+var (
+	filterOrderedOps = []FilterOperator{FilterOpEqual, FilterOpNotEqual, FilterOpLess, FilterOpLessOrEqual, FilterOpGreater, FilterOpGreaterOrEqual}
+
+	filterFieldSpecs = map[string]filterFieldSpec{
+		"status":     {FilterFieldStatus, filterValueStatus, []FilterOperator{FilterOpEqual, FilterOpNotEqual, FilterOpHas}},
+		"priority":   {FilterFieldPriority, filterValuePriority, append(slices.Clone(filterOrderedOps), FilterOpHas, FilterOpPresent)},
+		"tags":       {FilterFieldTags, filterValueText, []FilterOperator{FilterOpHas, FilterOpPresent}},
+		"tag":        {FilterFieldTags, filterValueText, []FilterOperator{FilterOpHas, FilterOpPresent}},
+		"title":      {FilterFieldTitle, filterValueText, []FilterOperator{FilterOpEqual, FilterOpNotEqual, FilterOpHas}},
+		"due_at":     {FilterFieldDueAt, filterValueTime, append(slices.Clone(filterOrderedOps), FilterOpPresent)},
+		"created_at": {FilterFieldCreatedAt, filterValueTime, filterOrderedOps},
+		"updated_at": {FilterFieldUpdatedAt, filterValueTime, filterOrderedOps},
+	}
+
+	filterCustomFieldSpec = filterFieldSpec{FilterFieldCustomField, filterValueText, []FilterOperator{FilterOpEqual, FilterOpNotEqual, FilterOpHas, FilterOpPresent}}
+)
+
+const filterSupportedFields = "status, priority, tags, title, due_at, created_at, updated_at, custom_fields.<name>"
+
+// FilterNode is a node of a type-checked filter expression:
+// FilterAnd, FilterOr, FilterNot or FilterComparison.
+type FilterNode interface {
+	filterNode()
+}
+
+// FilterAnd matches items that match every operand.
+type FilterAnd struct {
+	Operands []FilterNode
+}
+
+// FilterOr matches items that match any operand.
+type FilterOr struct {
+	Operands []FilterNode
+}
+
+// FilterNot matches items that do not match its operand.
+type FilterNot struct {
+	Operand FilterNode
+}
+
+// FilterComparison tests one field of an item.
+// Value is a TaskStatus for status, a TaskPriority for priority, a FilterTime
+// for timestamps and a string otherwise; it is nil for FilterOpPresent.
+type FilterComparison struct {
+	Field FilterField
+	Name  string // custom field name when Field is FilterFieldCustomField
+	Op    FilterOperator
+	Value any
+}
+
+func (FilterAnd) filterNode()        {}
+func (FilterOr) filterNode()         {}
+func (FilterNot) filterNode()        {}
+func (FilterComparison) filterNode() {}
+
+// FilterTime is a timestamp value of a filter expression: a fixed time, or an
+// offset from the moment the filter runs ("now", "now+2d", "now-3h").
+type FilterTime struct {
+	At       time.Time
+	Relative bool
+	Offset   time.Duration
+}
+
+// Resolve returns the time the value stands for in a search run at now.
+func (t FilterTime) Resolve(now time.Time) time.Time {
+	if t.Relative {
+		return now.Add(t.Offset)
+	}
+	return t.At
+}
+
+// FilterExpression is a parsed and type-checked AIP-160 style filter, e.g.
+//
+//	priority >= high AND (tag:work OR tag:oncall) AND due_at < now+2d AND NOT status:blocked
+//
+// As in AIP-160, OR binds tighter than AND: "a AND b OR c" means "a AND (b OR c)".
+// A comparison on an item without a value for the field is false, and its NOT is true.
+// The zero value matches every item.
+type FilterExpression struct {
+	source string
+	root   FilterNode
+}
+
+// ParseFilterExpression parses and type-checks a filter expression.
+// Errors wrap ErrInvalidFilterExpression and name the position of the problem.
+func ParseFilterExpression(source string) (FilterExpression, error) {
+	source = strings.TrimSpace(source)
+	if source == "" {
+		return FilterExpression{}, nil
+	}
+	if len(source) > MaxFilterExpressionLength {
+		return FilterExpression{}, fmt.Errorf("%w: must be %d characters or less", ErrInvalidFilterExpression, MaxFilterExpressionLength)
+	}
+
+	tokens, err := lexFilter(source)
+	if err != nil {
+		return FilterExpression{}, err
+	}
+	p := &filterParser{tokens: tokens}
+	root, err := p.parseAnd(0)
+	if err != nil {
+		return FilterExpression{}, err
+	}
+	if tok := p.peek(); tok.kind != filterTokenEOF {
+		return FilterExpression{}, filterErrorf(tok.pos, "expected AND, OR or end of filter, got %s", tok)
+	}
+	return FilterExpression{source: source, root: root}, nil
+}
+
+// IsZero reports whether the expression is empty and matches every item.
+func (e FilterExpression) IsZero() bool {
+	return e.root == nil
+}
+
+// Root returns the top node of the expression, or nil if it is empty.
+func (e FilterExpression) Root() FilterNode {
+	return e.root
+}
+
+// String returns the expression as it was written, trimmed.
+func (e FilterExpression) String() string {
+	return e.source
+}
+
+// References reports whether any comparison of the expression tests field.
+func (e FilterExpression) References(field FilterField) bool {
+	var walk func(node FilterNode) bool
+	walk = func(node FilterNode) bool {
+		switch n := node.(type) {
+		case FilterAnd:
+			return slices.ContainsFunc(n.Operands, walk)
+		case FilterOr:
+			return slices.ContainsFunc(n.Operands, walk)
+		case FilterNot:
+			return walk(n.Operand)
+		case FilterComparison:
+			return n.Field == field
+		}
+		return false
+	}
+	return e.root != nil && walk(e.root)
+}
+
+// Matches reports whether item matches the expression in a search run at now.
+// Comparisons have the meaning they have in the item search: on a field the
+// item has no value for they are false, and title:x ignores case.
+func (e FilterExpression) Matches(item *TodoItem, now time.Time) bool {
+	return e.root == nil || matchFilterNode(e.root, item, now)
+}
+
+func matchFilterNode(node FilterNode, item *TodoItem, now time.Time) bool {
+	switch n := node.(type) {
+	case FilterAnd:
+		for _, operand := range n.Operands {
+			if !matchFilterNode(operand, item, now) {
+				return false
+			}
+		}
+		return true
+	case FilterOr:
+		return slices.ContainsFunc(n.Operands, func(operand FilterNode) bool {
+			return matchFilterNode(operand, item, now)
+		})
+	case FilterNot:
+		return !matchFilterNode(n.Operand, item, now)
+	case FilterComparison:
+		return matchFilterComparison(n, item, now)
+	}
+	return false
+}
+
+// filterPriorityWeights order priorities semantically for comparisons.
+var filterPriorityWeights = map[TaskPriority]int{
+	TaskPriorityLow:    1,
+	TaskPriorityMedium: 2,
+	TaskPriorityHigh:   3,
+	TaskPriorityUrgent: 4,
+}
+
+func matchFilterComparison(c FilterComparison, item *TodoItem, now time.Time) bool {
+	switch c.Field {
+	case FilterFieldStatus:
+		status, _ := c.Value.(TaskStatus)
+		return filterCompare(c.Op, cmp.Compare(item.Status, status))
+
+	case FilterFieldPriority:
+		if item.Priority == nil {
+			return false
+		}
+		if c.Op == FilterOpPresent {
+			return true
+		}
+		priority, _ := c.Value.(TaskPriority)
+		return filterCompare(c.Op, cmp.Compare(filterPriorityWeights[*item.Priority], filterPriorityWeights[priority]))
+
+	case FilterFieldTags:
+		if c.Op == FilterOpPresent {
+			return len(item.Tags) > 0
+		}
+		tag, _ := c.Value.(string)
+		return slices.Contains(item.Tags, tag)
+
+	case FilterFieldTitle:
+		title, _ := c.Value.(string)
+		if c.Op == FilterOpHas {
+			return strings.Contains(strings.ToLower(item.Title), strings.ToLower(title))
+		}
+		return filterCompare(c.Op, cmp.Compare(item.Title, title))
+
+	case FilterFieldDueAt, FilterFieldCreatedAt, FilterFieldUpdatedAt:
+		var at *time.Time
+		switch c.Field {
+		case FilterFieldDueAt:
+			at = item.DueAt
+		case FilterFieldCreatedAt:
+			at = &item.CreatedAt
+		case FilterFieldUpdatedAt:
+			at = &item.UpdatedAt
+		}
+		if at == nil {
+			return false
+		}
+		if c.Op == FilterOpPresent {
+			return true
+		}
+		value, _ := c.Value.(FilterTime)
+		return filterCompare(c.Op, at.Compare(value.Resolve(now)))
+
+	case FilterFieldCustomField:
+		stored, ok := item.CustomFields[c.Name]
+		if c.Op == FilterOpPresent {
+			return ok
+		}
+		text, ok := customFieldText(stored)
+		if !ok {
+			return false
+		}
+		value, _ := c.Value.(string)
+		return filterCompare(c.Op, cmp.Compare(text, value))
+	}
+	return false
+}
+
+// filterCompare reports whether the result of comparing a field to a value
+// satisfies op. The has operator is equality for fields it does not search in.
+func filterCompare(op FilterOperator, result int) bool {
+	switch op {
+	case FilterOpEqual, FilterOpHas:
+		return result == 0
+	case FilterOpNotEqual:
+		return result != 0
+	case FilterOpLess:
+		return result < 0
+	case FilterOpLessOrEqual:
+		return result <= 0
+	case FilterOpGreater:
+		return result > 0
+	case FilterOpGreaterOrEqual:
+		return result >= 0
+	}
+	return false
+}
+
+// customFieldText returns the text form of a custom field value, as custom
+// field filters compare it: strings as they are, other values as JSON.
+// Returns false for null.
+func customFieldText(value any) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(data), true
+	}
+}
+
+// filterErrorf reports a problem at a 1-based character position of the filter.
+func filterErrorf(pos int, format string, args ...any) error {
+	return fmt.Errorf("%w: position %d: %s", ErrInvalidFilterExpression, pos, fmt.Sprintf(format, args...))
+}
+
+// === Lexer ===
+
+type filterTokenKind int
+
+const (
+	filterTokenEOF filterTokenKind = iota
+	filterTokenWord
+	filterTokenString
+	filterTokenOperator
+	filterTokenLParen
+	filterTokenRParen
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+	pos  int
+}
+
+func (t filterToken) String() string {
+	switch t.kind {
+	case filterTokenEOF:
+		return "end of filter"
+	case filterTokenString:
+		return strconv.Quote(t.text)
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// isFilterWordRune reports whether r can be part of a bare word:
+// a field, a keyword or an unquoted value such as high, 2025-01-31 or now+2d.
+func isFilterWordRune(r rune) bool {
+	return !unicode.IsSpace(r) && !strings.ContainsRune(`()<>=!:"`, r)
+}
+
+func lexFilter(source string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		pos := i + 1
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{filterTokenLParen, "(", pos})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{filterTokenRParen, ")", pos})
+			i++
+		case r == '"':
+			var text strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				text.WriteRune(runes[i])
+			}
+			if i == len(runes) {
+				return nil, filterErrorf(pos, "unterminated string")
+			}
+			tokens = append(tokens, filterToken{filterTokenString, text.String(), pos})
+			i++
+		case strings.ContainsRune("<>=!:", r):
+			op := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' && r != '=' && r != ':' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, filterErrorf(pos, "unexpected \"!\" (use != or NOT)")
+			}
+			tokens = append(tokens, filterToken{filterTokenOperator, op, pos})
+			i += len(op)
+		default:
+			start := i
+			for i < len(runes) && isFilterWordRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, filterToken{filterTokenWord, string(runes[start:i]), pos})
+		}
+	}
+	return append(tokens, filterToken{kind: filterTokenEOF, pos: len(runes) + 1}), nil
+}
+
+// === Parser ===
+
+// filterParser is a recursive descent parser over the grammar
+//
+//	and        = or { "AND" or }
+//	or         = factor { "OR" factor }
+//	factor     = [ "NOT" | "-" ] simple
+//	simple     = "(" and ")" | comparison
+//	comparison = field operator value
+type filterParser struct {
+	tokens      []filterToken
+	next        int
+	comparisons int
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.next]
+}
+
+func (p *filterParser) take() filterToken {
+	tok := p.tokens[p.next]
+	if tok.kind != filterTokenEOF {
+		p.next++
+	}
+	return tok
+}
+
+func (p *filterParser) keyword(word string) bool {
+	tok := p.peek()
+	if tok.kind == filterTokenWord && tok.text == word {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) parseAnd(depth int) (FilterNode, error) {
+	if depth > MaxFilterExpressionDepth {
+		return nil, filterErrorf(p.peek().pos, "nested deeper than %d levels", MaxFilterExpressionDepth)
+	}
+	var operands []FilterNode
+	for {
+		node, err := p.parseOr(depth)
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, node)
+		if !p.keyword("AND") {
+			break
+		}
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return FilterAnd{Operands: operands}, nil
+}
+
+func (p *filterParser) parseOr(depth int) (FilterNode, error) {
+	var operands []FilterNode
+	for {
+		node, err := p.parseFactor(depth)
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, node)
+		if !p.keyword("OR") {
+			break
+		}
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return FilterOr{Operands: operands}, nil
+}
+
+func (p *filterParser) parseFactor(depth int) (FilterNode, error) {
+	tok := p.peek()
+	negate := p.keyword("NOT")
+	// "-field:value" negates like NOT; a lone "-" negates the next group
+	if !negate && tok.kind == filterTokenWord && strings.HasPrefix(tok.text, "-") {
+		negate = true
+		if tok.text == "-" {
+			p.next++
+		} else {
+			p.tokens[p.next] = filterToken{filterTokenWord, tok.text[1:], tok.pos + 1}
+		}
+	}
+
+	node, err := p.parseSimple(depth)
+	if err != nil {
+		return nil, err
+	}
+	if negate {
+		return FilterNot{Operand: node}, nil
+	}
+	return node, nil
+}
+
+func (p *filterParser) parseSimple(depth int) (FilterNode, error) {
+	tok := p.take()
+	switch tok.kind {
+	case filterTokenLParen:
+		node, err := p.parseAnd(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.take(); closing.kind != filterTokenRParen {
+			return nil, filterErrorf(closing.pos, "expected \")\" to close \"(\" at position %d, got %s", tok.pos, closing)
+		}
+		return node, nil
+	case filterTokenWord:
+		if tok.text == "AND" || tok.text == "OR" || tok.text == "NOT" {
+			return nil, filterErrorf(tok.pos, "expected a comparison, got keyword %s", tok.text)
+		}
+		return p.parseComparison(tok)
+	default:
+		return nil, filterErrorf(tok.pos, "expected a comparison or \"(\", got %s", tok)
+	}
+}
+
+// parseComparison parses and type-checks the operator and value following a field name.
+func (p *filterParser) parseComparison(fieldTok filterToken) (FilterNode, error) {
+	p.comparisons++
+	if p.comparisons > MaxFilterExpressionComparisons {
+		return nil, filterErrorf(fieldTok.pos, "at most %d comparisons allowed", MaxFilterExpressionComparisons)
+	}
+
+	spec, name, err := lookupFilterField(fieldTok)
+	if err != nil {
+		return nil, err
+	}
+
+	opTok := p.take()
+	if opTok.kind != filterTokenOperator {
+		return nil, filterErrorf(opTok.pos, "expected an operator after %q, got %s", fieldTok.text, opTok)
+	}
+	op := FilterOperator(opTok.text)
+
+	valueTok := p.take()
+	if valueTok.kind != filterTokenWord && valueTok.kind != filterTokenString {
+		return nil, filterErrorf(valueTok.pos, "expected a value after %q, got %s", fieldTok.text+opTok.text, valueTok)
+	}
+	if op == FilterOpHas && valueTok.kind == filterTokenWord && valueTok.text == "*" {
+		op = FilterOpPresent
+	}
+
+	if !slices.Contains(spec.operators, op) {
+		supported := make([]string, len(spec.operators))
+		for i, o := range spec.operators {
+			supported[i] = string(o)
+		}
+		return nil, filterErrorf(opTok.pos, "operator %s is not supported for %s (supported: %s)", op, fieldTok.text, strings.Join(supported, " "))
+	}
+
+	comparison := FilterComparison{Field: spec.field, Name: name, Op: op}
+	if op == FilterOpPresent {
+		return comparison, nil
+	}
+	comparison.Value, err = checkFilterValue(spec, fieldTok.text, valueTok)
+	if err != nil {
+		return nil, err
+	}
+	return comparison, nil
+}
+
+// lookupFilterField resolves a field name, returning the custom field name for custom_fields.<name>.
+func lookupFilterField(tok filterToken) (filterFieldSpec, string, error) {
+	if name, ok := strings.CutPrefix(tok.text, CustomFieldOrderByPrefix); ok {
+		if !customFieldNamePattern.MatchString(name) {
+			return filterFieldSpec{}, "", filterErrorf(tok.pos, "invalid custom field name %q", name)
+		}
+		return filterCustomFieldSpec, name, nil
+	}
+	spec, ok := filterFieldSpecs[tok.text]
+	if !ok {
+		return filterFieldSpec{}, "", filterErrorf(tok.pos, "unknown field %q (supported: %s)", tok.text, filterSupportedFields)
+	}
+	return spec, "", nil
+}
+
+// checkFilterValue converts a value to the type of its field.
+func checkFilterValue(spec filterFieldSpec, field string, tok filterToken) (any, error) {
+	switch spec.kind {
+	case filterValueStatus:
+		status, err := NewTaskStatus(tok.text)
+		if err != nil {
+			return nil, filterErrorf(tok.pos, "invalid %s %s (supported: todo, in_progress, blocked, done, archived, cancelled)", field, tok)
+		}
+		return status, nil
+	case filterValuePriority:
+		priority, err := NewTaskPriority(tok.text)
+		if err != nil {
+			return nil, filterErrorf(tok.pos, "invalid %s %s (supported: low, medium, high, urgent)", field, tok)
+		}
+		return priority, nil
+	case filterValueTime:
+		value, ok := parseFilterTime(tok.text)
+		if !ok {
+			return nil, filterErrorf(tok.pos, "invalid time %s for %s (use RFC 3339 in quotes, YYYY-MM-DD, now or now±N with unit m, h, d or w)", tok, field)
+		}
+		return value, nil
+	default:
+		return tok.text, nil
+	}
+}
+
+// parseFilterTime parses an RFC 3339 timestamp, a date (midnight UTC) or a time relative to now.
+func parseFilterTime(text string) (FilterTime, bool) {
+	if rest, ok := strings.CutPrefix(text, "now"); ok {
+		if rest == "" {
+			return FilterTime{Relative: true}, true
+		}
+		if len(rest) < 3 || (rest[0] != '+' && rest[0] != '-') {
+			return FilterTime{}, false
+		}
+		// Bounded like due bounds of saved views; checking the amount first avoids overflow
+		amount, err := strconv.Atoi(rest[1 : len(rest)-1])
+		if err != nil || amount < 0 || amount > MaxDueBoundOffsetDays*24*60 {
+			return FilterTime{}, false
+		}
+		units := map[byte]time.Duration{'m': time.Minute, 'h': time.Hour, 'd': 24 * time.Hour, 'w': 7 * 24 * time.Hour}
+		unit, ok := units[rest[len(rest)-1]]
+		if !ok {
+			return FilterTime{}, false
+		}
+		offset := time.Duration(amount) * unit
+		if offset > MaxDueBoundOffsetDays*24*time.Hour {
+			return FilterTime{}, false
+		}
+		if rest[0] == '-' {
+			offset = -offset
+		}
+		return FilterTime{Relative: true, Offset: offset}, true
+	}
+	if at, err := time.Parse(time.RFC3339Nano, text); err == nil {
+		return FilterTime{At: at.UTC()}, true
+	}
+	if at, err := time.Parse(time.DateOnly, text); err == nil {
+		return FilterTime{At: at}, true
+	}
+	return FilterTime{}, false
+}
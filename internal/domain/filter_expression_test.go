@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilterExpression(t *testing.T) {
+	expr, err := ParseFilterExpression("priority >= high AND (tag:work OR tag:oncall) AND due_at < now+2d AND NOT status:blocked")
+	require.NoError(t, err)
+
+	assert.Equal(t, FilterAnd{Operands: []FilterNode{
+		FilterComparison{Field: FilterFieldPriority, Op: FilterOpGreaterOrEqual, Value: TaskPriorityHigh},
+		FilterOr{Operands: []FilterNode{
+			FilterComparison{Field: FilterFieldTags, Op: FilterOpHas, Value: "work"},
+			FilterComparison{Field: FilterFieldTags, Op: FilterOpHas, Value: "oncall"},
+		}},
+		FilterComparison{Field: FilterFieldDueAt, Op: FilterOpLess, Value: FilterTime{Relative: true, Offset: 48 * time.Hour}},
+		FilterNot{Operand: FilterComparison{Field: FilterFieldStatus, Op: FilterOpHas, Value: TaskStatusBlocked}},
+	}}, expr.Root())
+	assert.True(t, expr.References(FilterFieldStatus))
+	assert.False(t, expr.References(FilterFieldTitle))
+}
+
+func TestParseFilterExpression_Precedence(t *testing.T) {
+	// As in AIP-160, OR binds tighter than AND
+	expr, err := ParseFilterExpression("status=todo AND priority=low OR priority=high")
+	require.NoError(t, err)
+
+	assert.Equal(t, FilterAnd{Operands: []FilterNode{
+		FilterComparison{Field: FilterFieldStatus, Op: FilterOpEqual, Value: TaskStatusTodo},
+		FilterOr{Operands: []FilterNode{
+			FilterComparison{Field: FilterFieldPriority, Op: FilterOpEqual, Value: TaskPriorityLow},
+			FilterComparison{Field: FilterFieldPriority, Op: FilterOpEqual, Value: TaskPriorityHigh},
+		}},
+	}}, expr.Root())
+}
+
+func TestParseFilterExpression_Values(t *testing.T) {
+	tests := []struct {
+		source string
+		want   FilterNode
+	}{
+		{`title:"weekly report"`, FilterComparison{Field: FilterFieldTitle, Op: FilterOpHas, Value: "weekly report"}},
+		{`title = "say \"hi\""`, FilterComparison{Field: FilterFieldTitle, Op: FilterOpEqual, Value: `say "hi"`}},
+		{`due_at:*`, FilterComparison{Field: FilterFieldDueAt, Op: FilterOpPresent}},
+		{`-tags:*`, FilterNot{Operand: FilterComparison{Field: FilterFieldTags, Op: FilterOpPresent}}},
+		{`custom_fields.points != -5`, FilterComparison{Field: FilterFieldCustomField, Name: "points", Op: FilterOpNotEqual, Value: "-5"}},
+		{`created_at >= 2026-01-31`, FilterComparison{Field: FilterFieldCreatedAt, Op: FilterOpGreaterOrEqual, Value: FilterTime{At: time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)}}},
+		{`updated_at < "2026-01-31T10:00:00+02:00"`, FilterComparison{Field: FilterFieldUpdatedAt, Op: FilterOpLess, Value: FilterTime{At: time.Date(2026, 1, 31, 8, 0, 0, 0, time.UTC)}}},
+		{`due_at > now-3h`, FilterComparison{Field: FilterFieldDueAt, Op: FilterOpGreater, Value: FilterTime{Relative: true, Offset: -3 * time.Hour}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			expr, err := ParseFilterExpression(tt.source)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, expr.Root())
+		})
+	}
+}
+
+func TestParseFilterExpression_Errors(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{`prio = high`, `position 1: unknown field "prio"`},
+		{`status = done AND colour = red`, `position 19: unknown field "colour"`},
+		{`status < done`, `position 8: operator < is not supported for status`},
+		{`priority = critical`, `position 12: invalid priority "critical"`},
+		{`due_at < tomorrow`, `position 10: invalid time "tomorrow" for due_at`},
+		{`created_at:*`, `position 11: operator :* is not supported for created_at`},
+		{`custom_fields.Bad = 1`, `position 1: invalid custom field name "Bad"`},
+		{`(status = done`, `position 15: expected ")" to close "(" at position 1, got end of filter`},
+		{`status = done priority = low`, `position 15: expected AND, OR or end of filter`},
+		{`status`, `position 7: expected an operator after "status"`},
+		{`title = "open`, `position 9: unterminated string`},
+		{`status ! done`, `position 8: unexpected "!"`},
+		{`AND status = done`, `position 1: expected a comparison, got keyword AND`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			_, err := ParseFilterExpression(tt.source)
+			require.ErrorIs(t, err, ErrInvalidFilterExpression)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestParseFilterExpression_Limits(t *testing.T) {
+	expr, err := ParseFilterExpression("   ")
+	require.NoError(t, err)
+	assert.True(t, expr.IsZero())
+
+	var deep string
+	for range MaxFilterExpressionDepth + 1 {
+		deep += "("
+	}
+	_, err = ParseFilterExpression(deep + "status=done")
+	assert.ErrorIs(t, err, ErrInvalidFilterExpression)
+
+	many := "tag:a"
+	for range MaxFilterExpressionComparisons {
+		many += " OR tag:a"
+	}
+	_, err = ParseFilterExpression(many)
+	assert.ErrorIs(t, err, ErrInvalidFilterExpression)
+}
+
+func TestItemsFilter_ExpressionCountsAsStatusFilter(t *testing.T) {
+	expression := "NOT status:archived"
+	filter, err := NewItemsFilter(ItemsFilterInput{Expression: &expression})
+	require.NoError(t, err)
+	assert.True(t, filter.HasStatusFilter())
+	assert.Equal(t, expression, filter.Expression().String())
+
+	expression = "priority:high"
+	filter, err = NewItemsFilter(ItemsFilterInput{Expression: &expression})
+	require.NoError(t, err)
+	assert.False(t, filter.HasStatusFilter())
+}
+
+func TestFilterExpression_Matches(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	high := TaskPriorityHigh
+	due := now.Add(24 * time.Hour)
+	item := &TodoItem{
+		Title:        "Renew Passport",
+		Status:       TaskStatusTodo,
+		Priority:     &high,
+		Tags:         []string{"admin", "travel"},
+		DueAt:        &due,
+		CustomFields: map[string]any{"points": 3.0, "team": "ops"},
+	}
+
+	for source, want := range map[string]bool{
+		"priority >= medium":          true,
+		"priority > high":             false,
+		"tag:travel AND NOT tag:work": true,
+		"title:passport":              true,
+		"due_at < now+2d":             true,
+		"due_at < now":                false,
+		"status:done OR status:todo":  true,
+		"custom_fields.points = 3":    true,
+		"custom_fields.team = dev":    false,
+		"custom_fields.missing = x":   false,
+		"updated_at > now":            false, // Zero time
+	} {
+		expr, err := ParseFilterExpression(source)
+		require.NoError(t, err, source)
+		assert.Equal(t, want, expr.Matches(item, now), source)
+	}
+}
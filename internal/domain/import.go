@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxImportEntries bounds the items and recurring templates read from one import file.
+const MaxImportEntries = 1000
+
+// ImportNotesField is the custom field that keeps the notes of imported entries,
+// in lists that declare it as a string field.
+const ImportNotesField = "notes"
+
+// ImportKind is what an import entry becomes.
+type ImportKind string
+
+const (
+	ImportKindItem              ImportKind = "item"
+	ImportKindRecurringTemplate ImportKind = "recurring_template"
+)
+
+// ImportAction is what an import did, or would do in a dry run, with an entry.
+type ImportAction string
+
+const (
+	ImportActionCreate ImportAction = "create"
+	ImportActionSkip   ImportAction = "skip" // Already imported into the list
+	ImportActionFail   ImportAction = "fail" // Cannot be imported; see the reason
+)
+
+// ImportEntry is an item or a recurring template read from a file exported by
+// another tool. ExternalID is the ID the source tool gave it (an iCalendar UID);
+// importing the same file twice skips the entries imported the first time.
+type ImportEntry struct {
+	ExternalID string
+
+	// Exactly one of Item and Template is set, unless Problem is.
+	Item     *TodoItem
+	Template *RecurringTemplate
+
+	// TemplateStart is the first occurrence of a recurring entry, which may lie
+	// in the past. Exceptions are occurrences the source tool deleted.
+	TemplateStart time.Time
+	Exceptions    []time.Time
+
+	// Notes is free text the source tool keeps with the entry, like Taskwarrior
+	// annotations. Items have no notes of their own: they are kept in the
+	// ImportNotesField custom field if the list declares it, and left out otherwise.
+	Notes string
+
+	// Problem explains why the entry cannot be imported, e.g. an unsupported
+	// recurrence rule. Empty if it can.
+	Problem string
+}
+
+// Kind returns what the entry becomes when imported.
+func (e ImportEntry) Kind() ImportKind {
+	if e.Template != nil {
+		return ImportKindRecurringTemplate
+	}
+	return ImportKindItem
+}
+
+// Title returns the title of the item or template, or "" if there is none.
+func (e ImportEntry) Title() string {
+	switch {
+	case e.Template != nil:
+		return e.Template.Title
+	case e.Item != nil:
+		return e.Item.Title
+	default:
+		return ""
+	}
+}
+
+// Validate checks what can be checked without storage: a dry run reports
+// entries that fail here, an import leaves them out.
+func (e ImportEntry) Validate() error {
+	if e.Problem != "" {
+		return fmt.Errorf("%w: %s", ErrInvalidImport, e.Problem)
+	}
+	if e.ExternalID == "" {
+		return fmt.Errorf("%w: missing external ID", ErrInvalidImport)
+	}
+	if _, err := NewTitle(e.Title()); err != nil {
+		return err
+	}
+	switch {
+	case e.Template != nil:
+		if _, err := NewRecurrencePattern(string(e.Template.RecurrencePattern)); err != nil {
+			return err
+		}
+		if e.TemplateStart.IsZero() {
+			return fmt.Errorf("%w: recurring entry without a start", ErrInvalidImport)
+		}
+	case e.Item != nil:
+		if e.Item.Timezone != nil {
+			if _, err := time.LoadLocation(*e.Item.Timezone); err != nil {
+				return ErrInvalidTimezone
+			}
+		}
+	default:
+		return fmt.Errorf("%w: entry is neither an item nor a recurring template", ErrInvalidImport)
+	}
+	return nil
+}
+
+// ImportResult reports what happened to one import entry.
+type ImportResult struct {
+	ExternalID string
+	Kind       ImportKind
+	Title      string
+	Action     ImportAction
+	ID         string // ID of the created or previously imported item or template, if any
+	Reason     string // Why the entry was skipped or failed
+}
+
+// ImportReport lists what an import did, or would do in a dry run, entry by entry in file order.
+type ImportReport struct {
+	DryRun  bool
+	Results []ImportResult
+}
+
+// Count returns the number of entries an action was taken for.
+func (r *ImportReport) Count(action ImportAction) int {
+	n := 0
+	for _, result := range r.Results {
+		if result.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// ImportRecord remembers that an external ID was imported into a list, so a
+// second import of the same file skips it. The record outlives the item or
+// template it created: deleting an imported item does not bring it back on re-import.
+type ImportRecord struct {
+	ListID     string
+	ExternalID string
+	ItemID     *string
+	TemplateID *string
+	CreatedAt  time.Time
+}
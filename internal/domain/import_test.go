@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportEntry_Validate(t *testing.T) {
+	stockholm := "Europe/Stockholm"
+	mars := "Mars/Olympus"
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		entry ImportEntry
+		err   error
+	}{
+		{"item", ImportEntry{ExternalID: "a", Item: &TodoItem{Title: "Call", Timezone: &stockholm}}, nil},
+		{"template", ImportEntry{ExternalID: "a", Template: &RecurringTemplate{Title: "Standup", RecurrencePattern: RecurrenceDaily}, TemplateStart: start}, nil},
+		{"problem", ImportEntry{ExternalID: "a", Item: &TodoItem{Title: "Call"}, Problem: "unsupported"}, ErrInvalidImport},
+		{"no external ID", ImportEntry{Item: &TodoItem{Title: "Call"}}, ErrInvalidImport},
+		{"no title", ImportEntry{ExternalID: "a", Item: &TodoItem{}}, ErrTitleRequired},
+		{"unknown timezone", ImportEntry{ExternalID: "a", Item: &TodoItem{Title: "Call", Timezone: &mars}}, ErrInvalidTimezone},
+		{"template without start", ImportEntry{ExternalID: "a", Template: &RecurringTemplate{Title: "Standup", RecurrencePattern: RecurrenceDaily}}, ErrInvalidImport},
+		{"empty", ImportEntry{ExternalID: "a"}, ErrTitleRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.entry.Validate()
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestImportReport_Count(t *testing.T) {
+	report := ImportReport{Results: []ImportResult{
+		{Action: ImportActionCreate}, {Action: ImportActionSkip}, {Action: ImportActionCreate}, {Action: ImportActionFail},
+	}}
+
+	assert.Equal(t, 2, report.Count(ImportActionCreate))
+	assert.Equal(t, 1, report.Count(ImportActionSkip))
+	assert.Equal(t, 1, report.Count(ImportActionFail))
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ListRole is the role of a principal on a list.
+//
+// Roles are ordered: owner > editor > viewer. A role allows everything
+// the roles below it allow.
+type ListRole string
+
+const (
+	ListRoleOwner  ListRole = "owner"  // Owns the list and manages its members
+	ListRoleEditor ListRole = "editor" // Modifies the list, its items and recurring templates
+	ListRoleViewer ListRole = "viewer" // Reads the list, its items and recurring templates
+)
+
+// NewMemberRole validates and creates a role that can be granted to a list member.
+// The owner role is not grantable; it belongs to the principal that created the list.
+func NewMemberRole(s string) (ListRole, error) {
+	role := ListRole(strings.ToLower(s))
+
+	switch role {
+	case ListRoleEditor, ListRoleViewer:
+		return role, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidListRole, s)
+	}
+}
+
+// rank orders roles from least to most privileged. Unknown roles rank lowest.
+func (r ListRole) rank() int {
+	switch r {
+	case ListRoleViewer:
+		return 1
+	case ListRoleEditor:
+		return 2
+	case ListRoleOwner:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Allows reports whether the role grants at least the required role.
+func (r ListRole) Allows(required ListRole) bool {
+	return r.rank() > 0 && r.rank() >= required.rank()
+}
+
+// ListMember grants a principal (tenant) access to a list it does not own.
+type ListMember struct {
+	ListID      string
+	PrincipalID string // Owner ID of the tenant the list is shared with
+	Role        ListRole
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"slices"
+)
+
+// Principal identifies the authenticated caller of a request.
+//
+// The principal is attached to the request context by the authentication
+// middleware and carried through the application layer into the repository,
+// where it scopes every query to the caller's tenant.
+type Principal struct {
+	KeyID   string  // ID of the API key used to authenticate
+	OwnerID string  // Tenant the API key belongs to
+	Scopes  []Scope // Permissions granted to the API key
+}
+
+// HasScope reports whether the principal was granted the scope.
+func (p Principal) HasScope(scope Scope) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+// principalKey is the context key for the authenticated Principal.
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the authenticated principal.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the authenticated principal stored in ctx.
+// Returns false for internal callers (background workers, tools) that run
+// without an authenticated principal; see HasInternalAccess.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// internalAccessKey is the context key marking internal callers.
+type internalAccessKey struct{}
+
+// WithInternalAccess returns a copy of ctx that runs as an internal caller, not
+// scoped to a tenant, dropping the principal in ctx if there is one. Background
+// workers and tools set it explicitly, as does the service where it acts on its
+// own authority, like automation rules acting on the lists they were allowed to
+// reach when they were saved. Callers with neither a principal nor this marker
+// are refused.
+func WithInternalAccess(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, principalKey{}, nil)
+	return context.WithValue(ctx, internalAccessKey{}, true)
+}
+
+// HasInternalAccess reports whether ctx runs as an internal caller without a
+// principal, set up with WithInternalAccess.
+func HasInternalAccess(ctx context.Context) bool {
+	if _, ok := PrincipalFromContext(ctx); ok {
+		return false
+	}
+	internal, _ := ctx.Value(internalAccessKey{}).(bool)
+	return internal
+}
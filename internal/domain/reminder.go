@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxRemindersPerItem limits the reminders of a single item and the default
+// reminders of a recurring template.
+const MaxRemindersPerItem = 10
+
+// ReminderAnchor is the item time a relative reminder is computed from.
+type ReminderAnchor string
+
+const (
+	ReminderAnchorDueAt    ReminderAnchor = "due_at"
+	ReminderAnchorStartsAt ReminderAnchor = "starts_at"
+)
+
+// NewReminderAnchor validates and creates a ReminderAnchor.
+func NewReminderAnchor(s string) (ReminderAnchor, error) {
+	anchor := ReminderAnchor(s)
+	switch anchor {
+	case ReminderAnchorDueAt, ReminderAnchorStartsAt:
+		return anchor, nil
+	default:
+		return "", fmt.Errorf("%w: relative_to must be due_at or starts_at, got %q", ErrInvalidReminder, s)
+	}
+}
+
+// ReminderRule describes when a reminder fires.
+//
+// A rule is either absolute (RemindAt is set) or relative to one of the item's
+// times (Anchor is set, e.g. "1h before due_at"). Relative reminders follow the
+// item: when its due or start time changes the reminder is rescheduled.
+type ReminderRule struct {
+	RemindAt *time.Time     // Absolute fire time (UTC)
+	Anchor   ReminderAnchor // Item time for relative reminders
+	Before   time.Duration  // How long before the anchor a relative reminder fires
+}
+
+// NewAbsoluteReminderRule creates a rule that fires at a fixed time.
+func NewAbsoluteReminderRule(at time.Time) ReminderRule {
+	utc := at.UTC()
+	return ReminderRule{RemindAt: &utc}
+}
+
+// NewRelativeReminderRule creates a rule that fires the given duration before the anchor.
+func NewRelativeReminderRule(anchor string, before time.Duration) (ReminderRule, error) {
+	a, err := NewReminderAnchor(anchor)
+	if err != nil {
+		return ReminderRule{}, err
+	}
+	if before < 0 {
+		return ReminderRule{}, fmt.Errorf("%w: before must not be negative", ErrInvalidReminder)
+	}
+	return ReminderRule{Anchor: a, Before: before}, nil
+}
+
+// IsRelative reports whether the rule is computed from an item time.
+func (r ReminderRule) IsRelative() bool {
+	return r.RemindAt == nil
+}
+
+// FireAt returns when the rule fires for the given item.
+// Returns nil for relative rules whose anchor is not set on the item.
+//
+// StartsAt is a date: starts_at reminders are computed from the start of that
+// day in the item's timezone, or in UTC for floating items.
+func (r ReminderRule) FireAt(item *TodoItem) *time.Time {
+	if !r.IsRelative() {
+		return r.RemindAt
+	}
+
+	var anchor *time.Time
+	switch r.Anchor {
+	case ReminderAnchorDueAt:
+		anchor = item.DueAt
+	case ReminderAnchorStartsAt:
+		anchor = startOfDay(item.StartsAt, item.Timezone)
+	}
+	if anchor == nil {
+		return nil
+	}
+
+	fireAt := anchor.Add(-r.Before).UTC()
+	return &fireAt
+}
+
+// startOfDay returns midnight of the date in the given IANA timezone (UTC if nil).
+func startOfDay(date *time.Time, timezone *string) *time.Time {
+	if date == nil {
+		return nil
+	}
+	loc := time.UTC
+	if timezone != nil {
+		if l, err := time.LoadLocation(*timezone); err == nil {
+			loc = l
+		}
+	}
+	y, m, d := date.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	return &start
+}
+
+// ValidateDefaultReminders checks the default reminders of a recurring template.
+// Only relative rules are allowed: an absolute time cannot apply to every occurrence.
+func ValidateDefaultReminders(rules []ReminderRule) error {
+	if len(rules) > MaxRemindersPerItem {
+		return fmt.Errorf("%w: at most %d default reminders", ErrTooManyReminders, MaxRemindersPerItem)
+	}
+	for _, rule := range rules {
+		if !rule.IsRelative() {
+			return fmt.Errorf("%w: default reminders must be relative to due_at or starts_at", ErrInvalidReminder)
+		}
+	}
+	return nil
+}
+
+// ReminderStatus is the delivery state of a reminder.
+type ReminderStatus string
+
+const (
+	ReminderStatusPending ReminderStatus = "pending" // Waiting for its fire time
+	ReminderStatusRunning ReminderStatus = "running" // Claimed by a worker
+	ReminderStatusSent    ReminderStatus = "sent"    // Delivered to the notifier
+	ReminderStatusSkipped ReminderStatus = "skipped" // Not delivered because the item was closed
+	ReminderStatusDead    ReminderStatus = "dead"    // Delivery failed permanently or exhausted retries
+)
+
+// Reminder is an entity within the TodoItem that notifies someone about the item.
+//
+// Reminders are delivered by the worker: due reminders are claimed with an
+// availability timeout (extended by heartbeats while delivering), retried with
+// backoff on transient failures and parked as dead when delivery keeps failing.
+type Reminder struct {
+	ID     string
+	ItemID string
+	Rule   ReminderRule
+
+	// FireAt is when the reminder is due. Nil for relative reminders whose
+	// anchor is not set on the item yet; they fire once the item gets one.
+	FireAt *time.Time
+
+	// Delivery state
+	Status      ReminderStatus
+	RetryCount  int
+	LastError   *string
+	SentAt      *time.Time
+	ClaimedBy   *string    // Worker that claimed the reminder (nil if unclaimed)
+	AvailableAt *time.Time // When a claimed reminder becomes re-claimable, or when a retry is due
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Dead letter error types of reminders.
+const (
+	ReminderErrorPermanent = "permanent" // Delivery failed with a non-retryable error
+	ReminderErrorExhausted = "exhausted" // Delivery kept failing until the retries ran out
+)
+
+// DeadLetterReminder records a reminder that was parked as dead, for review.
+// Retrying it re-arms the reminder for immediate delivery; discarding it
+// leaves the reminder dead.
+type DeadLetterReminder struct {
+	ID         string
+	ReminderID string
+	ItemID     string
+	ListID     string
+
+	// Failure information
+	ErrorType    string // ReminderErrorPermanent or ReminderErrorExhausted
+	ErrorMessage string
+	RetryCount   int
+	LastWorkerID string
+	FailedAt     time.Time
+}
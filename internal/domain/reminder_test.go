@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRelativeReminderRule(t *testing.T) {
+	rule, err := NewRelativeReminderRule("due_at", time.Hour)
+	require.NoError(t, err)
+	assert.True(t, rule.IsRelative())
+	assert.Equal(t, ReminderAnchorDueAt, rule.Anchor)
+
+	_, err = NewRelativeReminderRule("completed_at", time.Hour)
+	assert.ErrorIs(t, err, ErrInvalidReminder)
+
+	_, err = NewRelativeReminderRule("due_at", -time.Minute)
+	assert.ErrorIs(t, err, ErrInvalidReminder)
+}
+
+func TestReminderRule_FireAt(t *testing.T) {
+	due := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	item := &TodoItem{DueAt: &due}
+
+	relative, err := NewRelativeReminderRule("due_at", 30*time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, relative.FireAt(item))
+	assert.Equal(t, due.Add(-30*time.Minute), *relative.FireAt(item))
+
+	// Anchor not set on the item: the reminder waits
+	startsRule, err := NewRelativeReminderRule("starts_at", 0)
+	require.NoError(t, err)
+	assert.Nil(t, startsRule.FireAt(item))
+
+	// starts_at is a date: the reminder is computed from its midnight in the
+	// item's timezone, or in UTC for floating items
+	startsAt := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	item.StartsAt = &startsAt
+	beforeStart, err := NewRelativeReminderRule("starts_at", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 9, 23, 0, 0, 0, time.UTC), *beforeStart.FireAt(item))
+
+	tz := "Europe/Stockholm"
+	item.Timezone = &tz
+	assert.Equal(t, time.Date(2026, 3, 9, 22, 0, 0, 0, time.UTC), beforeStart.FireAt(item).UTC())
+
+	at := time.Date(2026, 3, 9, 18, 0, 0, 0, time.FixedZone("CET", 3600))
+	absolute := NewAbsoluteReminderRule(at)
+	assert.False(t, absolute.IsRelative())
+	assert.Equal(t, at.UTC(), *absolute.FireAt(item))
+}
+
+func TestValidateDefaultReminders(t *testing.T) {
+	relative, err := NewRelativeReminderRule("due_at", time.Hour)
+	require.NoError(t, err)
+
+	assert.NoError(t, ValidateDefaultReminders([]ReminderRule{relative}))
+
+	absolute := NewAbsoluteReminderRule(time.Now().UTC())
+	assert.ErrorIs(t, ValidateDefaultReminders([]ReminderRule{absolute}), ErrInvalidReminder)
+
+	tooMany := make([]ReminderRule, MaxRemindersPerItem+1)
+	for i := range tooMany {
+		tooMany[i] = relative
+	}
+	assert.ErrorIs(t, ValidateDefaultReminders(tooMany), ErrTooManyReminders)
+}
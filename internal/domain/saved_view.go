@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxDueBoundOffsetDays limits how far a relative due bound reaches from now.
+const MaxDueBoundOffsetDays = 3650
+
+// SavedView is a named item search that a principal runs again and again:
+// the filters and sort of ItemsFilterInput plus a due window, over one list or
+// over every list the principal can access. Like item listings, views leave
+// out snoozed items unless IncludeSnoozed is set.
+type SavedView struct {
+	ID             string
+	OwnerID        string // Tenant of the principal that saved the view; empty for internal callers
+	Name           string
+	ListID         *string // nil searches every accessible list
+	Filter         ItemsFilterInput
+	DueAfter       *DueBound
+	DueBefore      *DueBound
+	IncludeSnoozed bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// DueBound is one end of a saved view's due window: either a fixed time, or a
+// number of days from the moment the view is run. "Due within 7 days" is a
+// DueBefore of 7 days; "overdue" is a DueBefore of 0 days.
+type DueBound struct {
+	At         *time.Time
+	OffsetDays *int
+}
+
+// Resolve returns the bound's time for a view run at now.
+func (b DueBound) Resolve(now time.Time) time.Time {
+	if b.At != nil {
+		return *b.At
+	}
+	return now.AddDate(0, 0, *b.OffsetDays)
+}
+
+// Validate checks that exactly one of At and OffsetDays is set.
+func (b DueBound) Validate() error {
+	switch {
+	case (b.At == nil) == (b.OffsetDays == nil):
+		return fmt.Errorf("%w: a due bound needs either at or offset_days", ErrInvalidSavedView)
+	case b.OffsetDays != nil && (*b.OffsetDays > MaxDueBoundOffsetDays || *b.OffsetDays < -MaxDueBoundOffsetDays):
+		return fmt.Errorf("%w: offset_days must be between -%d and %d", ErrInvalidSavedView, MaxDueBoundOffsetDays, MaxDueBoundOffsetDays)
+	}
+	return nil
+}
+
+// Validate checks the name, the filter and the due window of a view.
+// The name is trimmed in place.
+func (v *SavedView) Validate() error {
+	v.Name = strings.TrimSpace(v.Name)
+	if v.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidSavedView)
+	}
+	if len(v.Name) > 255 {
+		return fmt.Errorf("%w: name must be 255 characters or less", ErrInvalidSavedView)
+	}
+
+	if _, err := NewItemsFilter(v.Filter); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSavedView, err)
+	}
+
+	for _, bound := range []*DueBound{v.DueAfter, v.DueBefore} {
+		if bound == nil {
+			continue
+		}
+		if err := bound.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListParams builds the item search of a view run at now: relative due bounds
+// are resolved against now, so the same view returns different items over time.
+func (v *SavedView) ListParams(now time.Time) (ListTasksParams, error) {
+	filter, err := NewItemsFilter(v.Filter)
+	if err != nil {
+		return ListTasksParams{}, fmt.Errorf("%w: %w", ErrInvalidSavedView, err)
+	}
+
+	params := ListTasksParams{
+		ListID:         v.ListID,
+		Filter:         filter,
+		ExcludeSnoozed: !v.IncludeSnoozed,
+	}
+	if v.DueAfter != nil {
+		after := v.DueAfter.Resolve(now)
+		params.DueAfter = &after
+	}
+	if v.DueBefore != nil {
+		before := v.DueBefore.Resolve(now)
+		params.DueBefore = &before
+	}
+	return params, nil
+}
+
+// UpdateSavedViewParams contains parameters for updating a saved view.
+// Filter, DueAfter and DueBefore replace the stored values as a whole;
+// a nil due bound in the mask clears it.
+type UpdateSavedViewParams struct {
+	ID             string
+	UpdateMask     []string
+	Name           *string
+	ListID         *string
+	Filter         *ItemsFilterInput
+	DueAfter       *DueBound
+	DueBefore      *DueBound
+	IncludeSnoozed *bool
+}
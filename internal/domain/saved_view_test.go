@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDueBound_Resolve(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	fixed := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	days := 7
+
+	assert.Equal(t, fixed, DueBound{At: &fixed}.Resolve(now))
+	assert.Equal(t, now.AddDate(0, 0, 7), DueBound{OffsetDays: &days}.Resolve(now))
+}
+
+func TestDueBound_Validate(t *testing.T) {
+	fixed := time.Now().UTC()
+	days := 7
+	tooFar := MaxDueBoundOffsetDays + 1
+
+	assert.NoError(t, DueBound{At: &fixed}.Validate())
+	assert.NoError(t, DueBound{OffsetDays: &days}.Validate())
+	assert.ErrorIs(t, DueBound{}.Validate(), ErrInvalidSavedView)
+	assert.ErrorIs(t, DueBound{At: &fixed, OffsetDays: &days}.Validate(), ErrInvalidSavedView)
+	assert.ErrorIs(t, DueBound{OffsetDays: &tooFar}.Validate(), ErrInvalidSavedView)
+}
+
+func TestSavedView_ListParams(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	days := 0
+	view := &SavedView{
+		Name:      "Overdue",
+		Filter:    ItemsFilterInput{Statuses: []string{"todo"}},
+		DueBefore: &DueBound{OffsetDays: &days},
+	}
+	require.NoError(t, view.Validate())
+
+	params, err := view.ListParams(now)
+	require.NoError(t, err)
+	assert.Nil(t, params.ListID)
+	assert.Nil(t, params.DueAfter)
+	require.NotNil(t, params.DueBefore)
+	assert.Equal(t, now, *params.DueBefore)
+	assert.True(t, params.Filter.HasStatusFilter())
+	assert.True(t, params.ExcludeSnoozed)
+
+	view.IncludeSnoozed = true
+	params, err = view.ListParams(now)
+	require.NoError(t, err)
+	assert.False(t, params.ExcludeSnoozed)
+
+	view.Filter = ItemsFilterInput{Statuses: []string{"someday"}}
+	assert.ErrorIs(t, view.Validate(), ErrInvalidSavedView)
+}
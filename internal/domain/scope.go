@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Scope is a permission granted to an API key.
+//
+// Scopes are checked per API operation. The required scopes of every operation
+// are declared as security requirements in the OpenAPI spec.
+type Scope string
+
+const (
+	ScopeListsRead     Scope = "lists:read"
+	ScopeListsWrite    Scope = "lists:write"
+	ScopeItemsRead     Scope = "items:read"     // Items and recurring templates
+	ScopeItemsWrite    Scope = "items:write"    // Items and recurring templates
+	ScopeWebhooksRead  Scope = "webhooks:read"  // Webhook subscriptions of the key's tenant
+	ScopeWebhooksWrite Scope = "webhooks:write" // Webhook subscriptions of the key's tenant
+	ScopeAdmin         Scope = "admin"          // Operational endpoints (dead letter jobs)
+)
+
+// Scope presets accepted wherever a list of scopes is parsed.
+// The "admin" preset grants every scope, not only ScopeAdmin.
+const (
+	ScopePresetReadOnly  = "read-only"
+	ScopePresetReadWrite = "read-write"
+	ScopePresetAdmin     = "admin"
+)
+
+// ReadOnlyScopes returns the scopes of a key that can only read data.
+func ReadOnlyScopes() []Scope {
+	return []Scope{ScopeListsRead, ScopeItemsRead}
+}
+
+// ReadWriteScopes returns the scopes of a key that can read and modify data.
+// This is the default for newly created keys.
+func ReadWriteScopes() []Scope {
+	return []Scope{ScopeListsRead, ScopeListsWrite, ScopeItemsRead, ScopeItemsWrite}
+}
+
+// AdminScopes returns all scopes, including access to operational endpoints.
+func AdminScopes() []Scope {
+	return append(ReadWriteScopes(), ScopeWebhooksRead, ScopeWebhooksWrite, ScopeAdmin)
+}
+
+// NewScope validates and creates a Scope.
+func NewScope(s string) (Scope, error) {
+	scope := Scope(strings.TrimSpace(s))
+	switch scope {
+	case ScopeListsRead, ScopeListsWrite, ScopeItemsRead, ScopeItemsWrite, ScopeWebhooksRead, ScopeWebhooksWrite, ScopeAdmin:
+		return scope, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidScope, s)
+	}
+}
+
+// ParseScopes parses a comma-separated list of scopes and presets
+// (e.g. "read-only", "lists:read,items:write").
+// Duplicates are removed; the result keeps the order of first appearance.
+func ParseScopes(s string) ([]Scope, error) {
+	var scopes []Scope
+	for part := range strings.SplitSeq(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		var expanded []Scope
+		switch part {
+		case ScopePresetReadOnly:
+			expanded = ReadOnlyScopes()
+		case ScopePresetReadWrite:
+			expanded = ReadWriteScopes()
+		case ScopePresetAdmin:
+			expanded = AdminScopes()
+		default:
+			scope, err := NewScope(part)
+			if err != nil {
+				return nil, err
+			}
+			expanded = []Scope{scope}
+		}
+
+		for _, scope := range expanded {
+			if !slices.Contains(scopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("%w: at least one scope is required", ErrInvalidScope)
+	}
+	return scopes, nil
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewScope(t *testing.T) {
+	scope, err := NewScope(" items:write ")
+	require.NoError(t, err)
+	assert.Equal(t, ScopeItemsWrite, scope)
+
+	_, err = NewScope("items:delete")
+	assert.ErrorIs(t, err, ErrInvalidScope)
+}
+
+func TestParseScopes(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Scope
+	}{
+		{"read-only preset", "read-only", ReadOnlyScopes()},
+		{"read-write preset", "read-write", ReadWriteScopes()},
+		{"admin preset grants everything", "admin", AdminScopes()},
+		{"explicit scopes", "lists:read, items:write", []Scope{ScopeListsRead, ScopeItemsWrite}},
+		{"webhook scopes", "read-only,webhooks:read", []Scope{ScopeListsRead, ScopeItemsRead, ScopeWebhooksRead}},
+		{"preset and scope deduplicated", "read-only,lists:read,items:write", []Scope{ScopeListsRead, ScopeItemsRead, ScopeItemsWrite}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseScopes(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseScopes_Invalid(t *testing.T) {
+	for _, input := range []string{"", " , ", "lists:read,owner"} {
+		_, err := ParseScopes(input)
+		assert.ErrorIs(t, err, ErrInvalidScope, "input %q", input)
+	}
+}
+
+func TestPrincipal_HasScope(t *testing.T) {
+	p := Principal{Scopes: ReadOnlyScopes()}
+
+	assert.True(t, p.HasScope(ScopeListsRead))
+	assert.False(t, p.HasScope(ScopeListsWrite))
+	assert.False(t, Principal{}.HasScope(ScopeListsRead))
+}
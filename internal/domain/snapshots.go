@@ -0,0 +1,191 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	// DefaultSnapshotDays is how many days a snapshot series covers when first is not given.
+	DefaultSnapshotDays = 30
+
+	// MaxSnapshotDays bounds the days of a snapshot series.
+	MaxSnapshotDays = 366
+)
+
+// ListSnapshot are the item counts of a list on a UTC day.
+type ListSnapshot struct {
+	ListID string
+	Day    time.Time // Midnight UTC
+
+	StatusCounts map[TaskStatus]int
+
+	// PriorityCounts counts open items by priority; items without priority are counted under "".
+	PriorityCounts map[TaskPriority]int
+
+	// Overdue counts open items due before RecordedAt.
+	Overdue int
+
+	// RecordedAt is when the counts were taken: the last snapshot run of the day,
+	// or the end of the day (at most now) for backfilled snapshots.
+	RecordedAt time.Time
+
+	// Backfilled reports that no snapshot was recorded that day and the counts
+	// were reconstructed from status history. Deleted items are missing from
+	// them, and priorities and due dates are the current ones.
+	Backfilled bool
+}
+
+// Open returns the number of open (todo, in progress or blocked) items.
+func (s ListSnapshot) Open() int {
+	open := 0
+	for _, status := range UndoneStatuses() {
+		open += s.StatusCounts[status]
+	}
+	return open
+}
+
+// SnapshotSeries is a snapshot of a list for every day from First through Last.
+type SnapshotSeries struct {
+	First     time.Time
+	Last      time.Time
+	Snapshots []ListSnapshot // Oldest first
+
+	// Truncated reports that more than MaxStatsItems items had to be backfilled,
+	// so the counts of backfilled days miss some.
+	Truncated bool
+}
+
+// ParseSnapshotRange returns the first and last UTC day of a snapshot series
+// from YYYY-MM-DD dates. An empty last is today at now, an empty first
+// DefaultSnapshotDays days up to last. Days after today are cut off.
+func ParseSnapshotRange(first, last string, now time.Time) (time.Time, time.Time, error) {
+	today := SnapshotDay(now)
+	lastDay := today
+	if last != "" {
+		var err error
+		if lastDay, err = time.Parse(time.DateOnly, last); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: invalid to date %q", ErrInvalidSnapshotRange, last)
+		}
+		if lastDay.After(today) {
+			lastDay = today
+		}
+	}
+	firstDay := lastDay.AddDate(0, 0, 1-DefaultSnapshotDays)
+	if first != "" {
+		var err error
+		if firstDay, err = time.Parse(time.DateOnly, first); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: invalid from date %q", ErrInvalidSnapshotRange, first)
+		}
+	}
+
+	if firstDay.After(lastDay) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: from must not be after to or today", ErrInvalidSnapshotRange)
+	}
+	if firstDay.AddDate(0, 0, MaxSnapshotDays).Before(lastDay.AddDate(0, 0, 1)) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: at most %d days", ErrInvalidSnapshotRange, MaxSnapshotDays)
+	}
+	return firstDay, lastDay, nil
+}
+
+// SnapshotDay returns midnight UTC of the day of t.
+func SnapshotDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// MissingSnapshotDays returns the days from first through last without a snapshot.
+func MissingSnapshotDays(first, last time.Time, snapshots []ListSnapshot) []time.Time {
+	recorded := make(map[time.Time]bool, len(snapshots))
+	for _, snapshot := range snapshots {
+		recorded[snapshot.Day] = true
+	}
+	var missing []time.Time
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if !recorded[day] {
+			missing = append(missing, day)
+		}
+	}
+	return missing
+}
+
+// BackfillSnapshots reconstructs the snapshots of days from the items of a
+// list and their status history, as of the end of each day or now, whichever
+// is earlier. An item counts from its creation in the status of its last
+// change by then; items without history count in their current status.
+func BackfillSnapshots(listID string, days []time.Time, items []TodoItem, history []*StatusChange, now time.Time) []ListSnapshot {
+	changes := make(map[string][]*StatusChange, len(items))
+	for _, change := range history {
+		changes[change.ItemID] = append(changes[change.ItemID], change)
+	}
+	for _, itemChanges := range changes {
+		slices.SortStableFunc(itemChanges, func(a, b *StatusChange) int {
+			return a.ChangedAt.Compare(b.ChangedAt)
+		})
+	}
+
+	snapshots := make([]ListSnapshot, 0, len(days))
+	for _, day := range days {
+		end := day.AddDate(0, 0, 1)
+		if now.Before(end) {
+			end = now
+		}
+		snapshot := ListSnapshot{
+			ListID:         listID,
+			Day:            day,
+			StatusCounts:   make(map[TaskStatus]int),
+			PriorityCounts: make(map[TaskPriority]int),
+			RecordedAt:     end,
+			Backfilled:     true,
+		}
+		for _, item := range items {
+			if !item.CreatedAt.Before(end) {
+				continue
+			}
+			status := statusAt(item, changes[item.ID], end)
+			snapshot.StatusCounts[status]++
+			if !slices.Contains(UndoneStatuses(), status) {
+				continue
+			}
+			var priority TaskPriority
+			if item.Priority != nil {
+				priority = *item.Priority
+			}
+			snapshot.PriorityCounts[priority]++
+			if item.DueAt != nil && item.DueAt.Before(end) {
+				snapshot.Overdue++
+			}
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// statusAt returns the status of item just before t from its sorted changes.
+func statusAt(item TodoItem, changes []*StatusChange, t time.Time) TaskStatus {
+	if len(changes) == 0 {
+		return item.Status
+	}
+	status := changes[0].ToStatus
+	if changes[0].FromStatus != nil && !changes[0].ChangedAt.Before(t) {
+		status = *changes[0].FromStatus
+	}
+	for _, change := range changes {
+		if !change.ChangedAt.Before(t) {
+			break
+		}
+		status = change.ToStatus
+	}
+	return status
+}
+
+// NewSnapshotSeries returns the series of recorded and backfilled snapshots
+// from first through last, oldest first.
+func NewSnapshotSeries(first, last time.Time, recorded, backfilled []ListSnapshot) *SnapshotSeries {
+	snapshots := slices.Concat(recorded, backfilled)
+	slices.SortFunc(snapshots, func(a, b ListSnapshot) int {
+		return a.Day.Compare(b.Day)
+	})
+	return &SnapshotSeries{First: first, Last: last, Snapshots: snapshots}
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSnapshotRange(t *testing.T) {
+	now := time.Date(2026, 3, 20, 15, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	first, last, err := ParseSnapshotRange("", "", now)
+	require.NoError(t, err)
+	assert.Equal(t, day(20), last)
+	assert.Equal(t, time.Date(2026, 2, 19, 0, 0, 0, 0, time.UTC), first)
+
+	// Days after today are cut off
+	first, last, err = ParseSnapshotRange("2026-03-10", "2026-04-01", now)
+	require.NoError(t, err)
+	assert.Equal(t, day(10), first)
+	assert.Equal(t, day(20), last)
+
+	_, _, err = ParseSnapshotRange("2025-03-20", "2026-03-20", now)
+	require.NoError(t, err)
+
+	for _, tt := range [][2]string{
+		{"2025-03-19", "2026-03-20"}, // 367 days
+		{"2026-03-21", ""},
+		{"2026-03-12", "2026-03-11"},
+		{"03/10/2026", ""},
+		{"", "tomorrow"},
+	} {
+		_, _, err := ParseSnapshotRange(tt[0], tt[1], now)
+		assert.ErrorIs(t, err, ErrInvalidSnapshotRange, tt)
+	}
+}
+
+func TestMissingSnapshotDays(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	missing := MissingSnapshotDays(day(1), day(5), []ListSnapshot{{Day: day(2)}, {Day: day(4)}})
+	assert.Equal(t, []time.Time{day(1), day(3), day(5)}, missing)
+}
+
+func TestBackfillSnapshots(t *testing.T) {
+	at := func(s string) time.Time {
+		v, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err)
+		return v
+	}
+	ptrTime := func(s string) *time.Time {
+		v := at(s)
+		return &v
+	}
+	high := TaskPriorityHigh
+	todo := TaskStatusTodo
+
+	items := []TodoItem{
+		{ID: "a", Status: TaskStatusDone, CreatedAt: at("2026-03-01T09:00:00Z"), Priority: &high, DueAt: ptrTime("2026-03-02T12:00:00Z")},
+		{ID: "b", Status: TaskStatusInProgress, CreatedAt: at("2026-03-02T09:00:00Z")},
+		// No history: counts in its current status
+		{ID: "c", Status: TaskStatusBlocked, CreatedAt: at("2026-03-01T10:00:00Z")},
+	}
+	history := []*StatusChange{
+		{ItemID: "a", ToStatus: TaskStatusTodo, ChangedAt: at("2026-03-01T09:00:00Z")},
+		{ItemID: "a", FromStatus: &todo, ToStatus: TaskStatusDone, ChangedAt: at("2026-03-03T09:00:00Z")},
+		{ItemID: "b", FromStatus: &todo, ToStatus: TaskStatusInProgress, ChangedAt: at("2026-03-03T08:00:00Z")},
+	}
+	days := []time.Time{at("2026-03-01T00:00:00Z"), at("2026-03-02T00:00:00Z"), at("2026-03-03T00:00:00Z")}
+	now := at("2026-03-03T12:00:00Z")
+
+	snapshots := BackfillSnapshots("list-1", days, items, history, now)
+	require.Len(t, snapshots, 3)
+
+	first := snapshots[0]
+	assert.True(t, first.Backfilled)
+	assert.Equal(t, "list-1", first.ListID)
+	assert.Equal(t, map[TaskStatus]int{TaskStatusTodo: 1, TaskStatusBlocked: 1}, first.StatusCounts)
+	assert.Equal(t, map[TaskPriority]int{TaskPriorityHigh: 1, "": 1}, first.PriorityCounts)
+	assert.Equal(t, 0, first.Overdue)
+	assert.Equal(t, at("2026-03-02T00:00:00Z"), first.RecordedAt)
+
+	// b counts in the status it had before its first recorded change
+	second := snapshots[1]
+	assert.Equal(t, map[TaskStatus]int{TaskStatusTodo: 2, TaskStatusBlocked: 1}, second.StatusCounts)
+	assert.Equal(t, 3, second.Open())
+	assert.Equal(t, 1, second.Overdue)
+
+	// Today is counted up to now
+	third := snapshots[2]
+	assert.Equal(t, map[TaskStatus]int{TaskStatusDone: 1, TaskStatusInProgress: 1, TaskStatusBlocked: 1}, third.StatusCounts)
+	assert.Equal(t, 0, third.Overdue)
+	assert.Equal(t, now, third.RecordedAt)
+}
@@ -0,0 +1,399 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// StatsGroupBy selects how list stats are broken down besides the totals.
+type StatsGroupBy string
+
+const (
+	StatsGroupByNone     StatsGroupBy = ""
+	StatsGroupByTag      StatsGroupBy = "tag"
+	StatsGroupByPriority StatsGroupBy = "priority"
+	StatsGroupByWeek     StatsGroupBy = "week"
+)
+
+const (
+	// DefaultStatsRangeDays is the range stats cover when from is not given.
+	DefaultStatsRangeDays = 30
+
+	// MaxStatsRangeDays bounds the range of a stats query.
+	MaxStatsRangeDays = 366
+
+	// MaxStatsItems bounds the items stats are computed from.
+	MaxStatsItems = 10000
+
+	// EstimateTolerance is how far the actual duration of an item may be from
+	// its estimate for the estimate to count as accurate.
+	EstimateTolerance = 0.25
+)
+
+// StatsQuery selects the items of list stats: the range is [From, To).
+type StatsQuery struct {
+	ListID  string
+	From    time.Time
+	To      time.Time
+	GroupBy StatsGroupBy
+
+	// Location is where weeks start, on Monday at midnight.
+	Location *time.Location
+}
+
+// NewStatsQuery validates the parameters of list stats. A nil to is now, a nil
+// from DefaultStatsRangeDays before to; an empty timezone is UTC.
+func NewStatsQuery(listID string, from, to *time.Time, groupBy, timezone string, now time.Time) (StatsQuery, error) {
+	query := StatsQuery{ListID: listID, To: now.UTC(), GroupBy: StatsGroupBy(groupBy), Location: time.UTC}
+
+	switch query.GroupBy {
+	case StatsGroupByNone, StatsGroupByTag, StatsGroupByPriority, StatsGroupByWeek:
+	default:
+		return StatsQuery{}, fmt.Errorf("%w: %q", ErrInvalidStatsGroupBy, groupBy)
+	}
+	if timezone != "" {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return StatsQuery{}, fmt.Errorf("%w: %q", ErrInvalidStatsTimezone, timezone)
+		}
+		query.Location = loc
+	}
+
+	if to != nil {
+		query.To = to.UTC()
+	}
+	query.From = query.To.AddDate(0, 0, -DefaultStatsRangeDays)
+	if from != nil {
+		query.From = from.UTC()
+	}
+	if !query.From.Before(query.To) {
+		return StatsQuery{}, fmt.Errorf("%w: from must be before to", ErrInvalidStatsRange)
+	}
+	if query.To.Sub(query.From) > MaxStatsRangeDays*24*time.Hour {
+		return StatsQuery{}, fmt.Errorf("%w: at most %d days", ErrInvalidStatsRange, MaxStatsRangeDays)
+	}
+	return query, nil
+}
+
+// ListStats are the flow metrics of a list over a range.
+type ListStats struct {
+	From    time.Time
+	To      time.Time
+	GroupBy StatsGroupBy
+
+	Total  FlowStats
+	Groups []FlowStats // Ordered by key; empty without GroupBy
+
+	// Truncated reports that more than MaxStatsItems items matched, so some are missing.
+	Truncated bool
+}
+
+// FlowStats are the flow metrics of a set of items over the range of the stats.
+type FlowStats struct {
+	// Key is the tag, priority or week (its Monday as YYYY-MM-DD) of a group.
+	// Items without tags or priority are grouped under the empty key, as are the totals.
+	Key string
+
+	Created   int // Items created in the range
+	Completed int // Items completed in the range
+
+	// LeadTime is from creation to completion, CycleTime from the first start
+	// (in_progress) to completion, of the items completed in the range.
+	LeadTime  DurationStats
+	CycleTime DurationStats
+
+	// TimeInStatus is the time items spent in each open status within the range.
+	TimeInStatus map[TaskStatus]time.Duration
+
+	Estimates EstimateAccuracy
+}
+
+// DurationStats are nearest-rank percentiles of durations.
+type DurationStats struct {
+	Count int
+	P50   time.Duration
+	P85   time.Duration
+	P95   time.Duration
+}
+
+// EstimateAccuracy compares estimated and actual durations of the items
+// completed in the range that have both.
+type EstimateAccuracy struct {
+	Items     int
+	Estimated time.Duration
+	Actual    time.Duration
+
+	// Ratio is the total actual duration over the total estimate, MedianRatio
+	// the median of the per item ratios; above 1 means work was underestimated.
+	// Both are zero without items.
+	Ratio       float64
+	MedianRatio float64
+
+	// Accurate counts the items whose actual duration was within
+	// EstimateTolerance of their estimate.
+	Accurate int
+}
+
+// NewListStats computes the stats of items over the range of query, from their
+// status history. now bounds the time spent in the current status.
+//
+// An item is completed at its last change to done, unless it was reopened or
+// cancelled after it. Tags and priority are the current ones; week groups
+// count events in the week they happened and split time in status at week
+// boundaries.
+func NewListStats(query StatsQuery, items []TodoItem, history []*StatusChange, now time.Time) *ListStats {
+	changes := make(map[string][]*StatusChange, len(items))
+	for _, change := range history {
+		changes[change.ItemID] = append(changes[change.ItemID], change)
+	}
+	for _, itemChanges := range changes {
+		slices.SortStableFunc(itemChanges, func(a, b *StatusChange) int {
+			return a.ChangedAt.Compare(b.ChangedAt)
+		})
+	}
+
+	end := query.To
+	if now.Before(end) {
+		end = now
+	}
+
+	b := statsBuilder{query: query, total: newFlowCounter(""), groups: make(map[string]*flowCounter)}
+	if query.GroupBy == StatsGroupByWeek {
+		// Every week of the range is a group, including quiet ones
+		for week := weekStart(query.From, query.Location); week.Before(query.To); week = week.AddDate(0, 0, 7) {
+			b.group(weekKey(week))
+		}
+	}
+
+	for _, item := range items {
+		itemChanges := changes[item.ID]
+
+		if query.contains(item.CreatedAt) {
+			b.add(item, item.CreatedAt, func(c *flowCounter) { c.created++ })
+		}
+
+		started, completed := completion(itemChanges)
+		if completed != nil && query.contains(*completed) {
+			b.add(item, *completed, func(c *flowCounter) { c.complete(item, started, *completed) })
+		}
+
+		for i, change := range itemChanges {
+			if !slices.Contains(UndoneStatuses(), change.ToStatus) {
+				continue
+			}
+			from, to := change.ChangedAt, end
+			if i+1 < len(itemChanges) && itemChanges[i+1].ChangedAt.Before(to) {
+				to = itemChanges[i+1].ChangedAt
+			}
+			if from.Before(query.From) {
+				from = query.From
+			}
+			b.addInterval(item, change.ToStatus, from, to)
+		}
+	}
+	return b.stats()
+}
+
+// contains reports whether t is in [From, To).
+func (q StatsQuery) contains(t time.Time) bool {
+	return !t.Before(q.From) && t.Before(q.To)
+}
+
+// completion returns when an item first started and when it was completed, if it is.
+func completion(changes []*StatusChange) (started, completed *time.Time) {
+	for _, change := range changes {
+		switch change.ToStatus {
+		case TaskStatusDone:
+			completed = &change.ChangedAt
+		case TaskStatusArchived:
+			// Archiving keeps the completion of done items
+		default:
+			completed = nil
+		}
+		if change.ToStatus == TaskStatusInProgress && started == nil {
+			started = &change.ChangedAt
+		}
+	}
+	return started, completed
+}
+
+// statsBuilder accumulates the flow of items into the totals and groups.
+type statsBuilder struct {
+	query  StatsQuery
+	total  *flowCounter
+	groups map[string]*flowCounter
+}
+
+// add counts an event of item that happened at at.
+func (b *statsBuilder) add(item TodoItem, at time.Time, count func(c *flowCounter)) {
+	count(b.total)
+	for _, key := range b.keys(item, at) {
+		count(b.group(key))
+	}
+}
+
+// addInterval adds the time item spent in status from from to to.
+func (b *statsBuilder) addInterval(item TodoItem, status TaskStatus, from, to time.Time) {
+	if !from.Before(to) {
+		return
+	}
+	b.total.timeInStatus[status] += to.Sub(from)
+	if b.query.GroupBy != StatsGroupByWeek {
+		for _, key := range b.keys(item, from) {
+			b.group(key).timeInStatus[status] += to.Sub(from)
+		}
+		return
+	}
+	for from.Before(to) {
+		week := weekStart(from, b.query.Location)
+		next := week.AddDate(0, 0, 7)
+		if to.Before(next) {
+			next = to
+		}
+		b.group(weekKey(week)).timeInStatus[status] += next.Sub(from)
+		from = next
+	}
+}
+
+// keys returns the groups an event of item that happened at at belongs to.
+func (b *statsBuilder) keys(item TodoItem, at time.Time) []string {
+	switch b.query.GroupBy {
+	case StatsGroupByTag:
+		if len(item.Tags) == 0 {
+			return []string{""}
+		}
+		return item.Tags
+	case StatsGroupByPriority:
+		if item.Priority == nil {
+			return []string{""}
+		}
+		return []string{string(*item.Priority)}
+	case StatsGroupByWeek:
+		return []string{weekKey(weekStart(at, b.query.Location))}
+	default:
+		return nil
+	}
+}
+
+func (b *statsBuilder) group(key string) *flowCounter {
+	c, ok := b.groups[key]
+	if !ok {
+		c = newFlowCounter(key)
+		b.groups[key] = c
+	}
+	return c
+}
+
+func (b *statsBuilder) stats() *ListStats {
+	stats := &ListStats{
+		From:    b.query.From,
+		To:      b.query.To,
+		GroupBy: b.query.GroupBy,
+		Total:   b.total.stats(),
+		Groups:  make([]FlowStats, 0, len(b.groups)),
+	}
+	for _, c := range b.groups {
+		stats.Groups = append(stats.Groups, c.stats())
+	}
+	slices.SortFunc(stats.Groups, func(a, b FlowStats) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+	return stats
+}
+
+// flowCounter accumulates the flow metrics of a group.
+type flowCounter struct {
+	key          string
+	created      int
+	completed    int
+	leadTimes    []time.Duration
+	cycleTimes   []time.Duration
+	timeInStatus map[TaskStatus]time.Duration
+	estimated    time.Duration
+	actual       time.Duration
+	ratios       []float64
+	accurate     int
+}
+
+func newFlowCounter(key string) *flowCounter {
+	return &flowCounter{key: key, timeInStatus: make(map[TaskStatus]time.Duration)}
+}
+
+// complete counts the completion of item.
+func (c *flowCounter) complete(item TodoItem, started *time.Time, completed time.Time) {
+	c.completed++
+	c.leadTimes = append(c.leadTimes, completed.Sub(item.CreatedAt))
+	if started != nil && !started.After(completed) {
+		c.cycleTimes = append(c.cycleTimes, completed.Sub(*started))
+	}
+
+	if item.EstimatedDuration == nil || item.ActualDuration == nil || *item.EstimatedDuration <= 0 || *item.ActualDuration <= 0 {
+		return
+	}
+	ratio := float64(*item.ActualDuration) / float64(*item.EstimatedDuration)
+	c.estimated += *item.EstimatedDuration
+	c.actual += *item.ActualDuration
+	c.ratios = append(c.ratios, ratio)
+	if math.Abs(ratio-1) <= EstimateTolerance {
+		c.accurate++
+	}
+}
+
+func (c *flowCounter) stats() FlowStats {
+	stats := FlowStats{
+		Key:          c.key,
+		Created:      c.created,
+		Completed:    c.completed,
+		LeadTime:     newDurationStats(c.leadTimes),
+		CycleTime:    newDurationStats(c.cycleTimes),
+		TimeInStatus: make(map[TaskStatus]time.Duration, len(UndoneStatuses())),
+		Estimates: EstimateAccuracy{
+			Items:     len(c.ratios),
+			Estimated: c.estimated,
+			Actual:    c.actual,
+			Accurate:  c.accurate,
+		},
+	}
+	for _, status := range UndoneStatuses() {
+		stats.TimeInStatus[status] = c.timeInStatus[status]
+	}
+	if len(c.ratios) > 0 {
+		stats.Estimates.Ratio = float64(c.actual) / float64(c.estimated)
+		slices.Sort(c.ratios)
+		stats.Estimates.MedianRatio = percentile(c.ratios, 50)
+	}
+	return stats
+}
+
+func newDurationStats(durations []time.Duration) DurationStats {
+	if len(durations) == 0 {
+		return DurationStats{}
+	}
+	slices.Sort(durations)
+	return DurationStats{
+		Count: len(durations),
+		P50:   percentile(durations, 50),
+		P85:   percentile(durations, 85),
+		P95:   percentile(durations, 95),
+	}
+}
+
+// percentile returns the nearest-rank percentile p of sorted, non-empty values.
+func percentile[T time.Duration | float64](sorted []T, p float64) T {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+// weekStart returns Monday midnight of the week of t in loc.
+func weekStart(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	daysSinceMonday := (int(local.Weekday()) + 6) % 7
+	return time.Date(local.Year(), local.Month(), local.Day()-daysSinceMonday, 0, 0, 0, 0, loc)
+}
+
+func weekKey(week time.Time) string {
+	return week.Format(time.DateOnly)
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStatsQuery(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	query, err := NewStatsQuery("list-1", nil, nil, "", "", now)
+	require.NoError(t, err)
+	assert.Equal(t, now, query.To)
+	assert.Equal(t, now.AddDate(0, 0, -DefaultStatsRangeDays), query.From)
+	assert.Equal(t, time.UTC, query.Location)
+
+	from := now.AddDate(0, 0, -MaxStatsRangeDays)
+	query, err = NewStatsQuery("list-1", &from, nil, "week", "Europe/Stockholm", now)
+	require.NoError(t, err)
+	assert.Equal(t, from, query.From)
+	assert.Equal(t, StatsGroupByWeek, query.GroupBy)
+	assert.Equal(t, "Europe/Stockholm", query.Location.String())
+
+	tooEarly := from.Add(-time.Second)
+	tests := []struct {
+		name    string
+		from    *time.Time
+		groupBy string
+		tz      string
+		want    error
+	}{
+		{"from after to", &now, "", "", ErrInvalidStatsRange},
+		{"range too long", &tooEarly, "", "", ErrInvalidStatsRange},
+		{"unknown group", nil, "status", "", ErrInvalidStatsGroupBy},
+		{"unknown timezone", nil, "", "Mars/Olympus", ErrInvalidStatsTimezone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewStatsQuery("list-1", tt.from, &now, tt.groupBy, tt.tz, now)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestNewListStats(t *testing.T) {
+	at := func(s string) time.Time {
+		v, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err)
+		return v
+	}
+	duration := func(d time.Duration) *time.Duration { return &d }
+	high := TaskPriorityHigh
+
+	items := []TodoItem{
+		{ID: "a", CreatedAt: at("2026-03-02T09:00:00Z"), Tags: []string{"x"}, Priority: &high,
+			EstimatedDuration: duration(time.Hour), ActualDuration: duration(70 * time.Minute)},
+		// Created before the range
+		{ID: "b", CreatedAt: at("2026-02-20T09:00:00Z"),
+			EstimatedDuration: duration(time.Hour), ActualDuration: duration(2 * time.Hour)},
+		{ID: "c", CreatedAt: at("2026-03-10T09:00:00Z"), Tags: []string{"x", "y"}},
+		// Reopened after it was done
+		{ID: "d", CreatedAt: at("2026-03-03T09:00:00Z")},
+	}
+	var history []*StatusChange
+	change := func(itemID string, to TaskStatus, changedAt string) {
+		history = append(history, &StatusChange{ItemID: itemID, ToStatus: to, ChangedAt: at(changedAt)})
+	}
+	change("a", TaskStatusTodo, "2026-03-02T09:00:00Z")
+	change("a", TaskStatusDone, "2026-03-05T09:00:00Z")
+	change("a", TaskStatusInProgress, "2026-03-03T09:00:00Z") // Out of order on purpose
+	change("b", TaskStatusTodo, "2026-02-20T09:00:00Z")
+	change("b", TaskStatusInProgress, "2026-03-09T09:00:00Z")
+	change("b", TaskStatusBlocked, "2026-03-10T09:00:00Z")
+	change("b", TaskStatusInProgress, "2026-03-11T09:00:00Z")
+	change("b", TaskStatusDone, "2026-03-12T09:00:00Z")
+	change("c", TaskStatusTodo, "2026-03-10T09:00:00Z")
+	change("d", TaskStatusTodo, "2026-03-03T09:00:00Z")
+	change("d", TaskStatusDone, "2026-03-04T09:00:00Z")
+	change("d", TaskStatusTodo, "2026-03-06T09:00:00Z")
+
+	from, to := at("2026-03-02T00:00:00Z"), at("2026-03-16T00:00:00Z")
+	now := at("2026-03-20T00:00:00Z")
+	newStats := func(groupBy StatsGroupBy) *ListStats {
+		return NewListStats(StatsQuery{ListID: "list-1", From: from, To: to, GroupBy: groupBy, Location: time.UTC}, items, history, now)
+	}
+
+	stats := newStats(StatsGroupByNone)
+	assert.Empty(t, stats.Groups)
+	total := stats.Total
+	assert.Equal(t, 3, total.Created)
+	assert.Equal(t, 2, total.Completed)
+	day := 24 * time.Hour
+	assert.Equal(t, DurationStats{Count: 2, P50: 3 * day, P85: 20 * day, P95: 20 * day}, total.LeadTime)
+	assert.Equal(t, DurationStats{Count: 2, P50: 2 * day, P85: 3 * day, P95: 3 * day}, total.CycleTime)
+	// Time before the range and after its end is left out
+	assert.Equal(t, map[TaskStatus]time.Duration{
+		TaskStatusTodo:       591 * time.Hour,
+		TaskStatusInProgress: 96 * time.Hour,
+		TaskStatusBlocked:    24 * time.Hour,
+	}, total.TimeInStatus)
+
+	estimates := total.Estimates
+	assert.Equal(t, 2, estimates.Items)
+	assert.Equal(t, 2*time.Hour, estimates.Estimated)
+	assert.Equal(t, 190*time.Minute, estimates.Actual)
+	assert.InDelta(t, 190.0/120, estimates.Ratio, 1e-9)
+	assert.InDelta(t, 70.0/60, estimates.MedianRatio, 1e-9)
+	assert.Equal(t, 1, estimates.Accurate)
+
+	t.Run("by week", func(t *testing.T) {
+		stats := newStats(StatsGroupByWeek)
+		require.Len(t, stats.Groups, 2)
+		first, second := stats.Groups[0], stats.Groups[1]
+		assert.Equal(t, "2026-03-02", first.Key)
+		assert.Equal(t, 2, first.Created)
+		assert.Equal(t, 1, first.Completed)
+		assert.Equal(t, "2026-03-09", second.Key)
+		assert.Equal(t, 1, second.Created)
+		assert.Equal(t, 1, second.Completed)
+		assert.Equal(t, 24*time.Hour, second.TimeInStatus[TaskStatusBlocked])
+		// Time in status is split at the week boundary
+		assert.Equal(t, total.TimeInStatus[TaskStatusTodo], first.TimeInStatus[TaskStatusTodo]+second.TimeInStatus[TaskStatusTodo])
+		assert.Equal(t, 312*time.Hour, second.TimeInStatus[TaskStatusTodo])
+	})
+
+	t.Run("by tag", func(t *testing.T) {
+		stats := newStats(StatsGroupByTag)
+		keys := make([]string, len(stats.Groups))
+		for i, group := range stats.Groups {
+			keys[i] = group.Key
+		}
+		assert.Equal(t, []string{"", "x", "y"}, keys)
+		assert.Equal(t, 1, stats.Groups[0].Created)
+		assert.Equal(t, 1, stats.Groups[0].Completed)
+		assert.Equal(t, 2, stats.Groups[1].Created)
+		assert.Equal(t, 1, stats.Groups[1].Completed)
+		assert.Equal(t, 1, stats.Groups[2].Created)
+	})
+
+	t.Run("by priority", func(t *testing.T) {
+		stats := newStats(StatsGroupByPriority)
+		require.Len(t, stats.Groups, 2)
+		assert.Equal(t, "", stats.Groups[0].Key)
+		assert.Equal(t, "high", stats.Groups[1].Key)
+		assert.Equal(t, 1, stats.Groups[1].Estimates.Accurate)
+	})
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// StatusChange is one transition in the status history of an item. The
+// database records a change on every status update; the first change of an
+// item has no FromStatus.
+type StatusChange struct {
+	ID         string
+	ItemID     string
+	FromStatus *TaskStatus
+	ToStatus   TaskStatus
+	ChangedAt  time.Time
+	Notes      *string
+}
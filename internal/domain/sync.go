@@ -0,0 +1,38 @@
+package domain
+
+// SyncCursor is a position in the change log for delta sync.
+// Changes are synced in (TxID, Seq) order: by the transaction that wrote them,
+// then by seq. The zero cursor syncs everything.
+type SyncCursor struct {
+	TxID uint64
+	Seq  int64
+}
+
+// After reports whether c is a later position than other.
+func (c SyncCursor) After(other SyncCursor) bool {
+	if c.TxID != other.TxID {
+		return c.TxID > other.TxID
+	}
+	return c.Seq > other.Seq
+}
+
+// SyncTombstone records an entity that was hard-deleted since the cursor, or
+// that the caller can no longer access. A list tombstone also removes the
+// list's items and templates.
+type SyncTombstone struct {
+	EntityType ChangeEntityType
+	EntityID   string
+	ListID     string
+}
+
+// SyncPage holds the entities changed since a cursor, in their current state.
+// An entity appears at most once per page: in Lists, Items or Templates if it
+// still exists and is accessible, in Deleted otherwise.
+type SyncPage struct {
+	Lists     []*TodoList
+	Items     []*TodoItem
+	Templates []*RecurringTemplate
+	Deleted   []SyncTombstone
+	Cursor    SyncCursor // Position to sync from next
+	HasMore   bool       // More changes are waiting after Cursor
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Tag registry limits.
+const (
+	MaxTagNameLength        = 64  // Characters of a registered or renamed tag
+	MaxTagDescriptionLength = 500 // Characters of a tag description
+	MaxTagMergeSources      = 20  // Tags merged into another at once
+)
+
+// tagColorPattern matches a #rrggbb hex color.
+var tagColorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+// Tag is a tag registered on a list, with how it is displayed.
+//
+// Items and recurring templates store tags as free-form text: registering a
+// tag does not restrict the tags they use, and tags can be used unregistered.
+type Tag struct {
+	ListID      string
+	Name        string
+	Color       *string // #rrggbb
+	Description *string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TagUsage is a tag registered on a list with how many of its items and
+// recurring templates use it.
+type TagUsage struct {
+	Tag
+	ItemCount     int
+	TemplateCount int
+}
+
+// TagRewrite is the outcome of renaming or merging tags: the tag the others
+// became and how many items and recurring templates were rewritten.
+type TagRewrite struct {
+	Tag       string
+	Items     int
+	Templates int
+}
+
+// UpdateTagParams contains parameters for updating a registered tag.
+// A nil color or description in the mask clears it.
+type UpdateTagParams struct {
+	ListID      string
+	Name        string
+	UpdateMask  []string
+	Color       *string
+	Description *string
+}
+
+// NewTagName trims and validates a tag name for the registry or as the new
+// name of a rename or merge.
+func NewTagName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("%w: name is required", ErrInvalidTag)
+	}
+	if utf8.RuneCountInString(name) > MaxTagNameLength {
+		return "", fmt.Errorf("%w: name must be at most %d characters", ErrInvalidTag, MaxTagNameLength)
+	}
+	if strings.ContainsFunc(name, unicode.IsControl) {
+		return "", fmt.Errorf("%w: name must not contain control characters", ErrInvalidTag)
+	}
+	return name, nil
+}
+
+// NewTagColor validates a #rrggbb hex color. Hex digits are lowercased.
+func NewTagColor(color string) (string, error) {
+	color = strings.ToLower(strings.TrimSpace(color))
+	if !tagColorPattern.MatchString(color) {
+		return "", fmt.Errorf("%w: color must be a #rrggbb hex color", ErrInvalidTag)
+	}
+	return color, nil
+}
+
+// Validate checks the name, color and description of a tag.
+// They are normalized in place; an empty description is cleared.
+func (t *Tag) Validate() error {
+	name, err := NewTagName(t.Name)
+	if err != nil {
+		return err
+	}
+	t.Name = name
+
+	if t.Color != nil {
+		color, err := NewTagColor(*t.Color)
+		if err != nil {
+			return err
+		}
+		t.Color = &color
+	}
+
+	if t.Description != nil {
+		description := strings.TrimSpace(*t.Description)
+		if utf8.RuneCountInString(description) > MaxTagDescriptionLength {
+			return fmt.Errorf("%w: description must be at most %d characters", ErrInvalidTag, MaxTagDescriptionLength)
+		}
+		t.Description = &description
+		if description == "" {
+			t.Description = nil
+		}
+	}
+	return nil
+}
+
+// NewTagMergeSources validates the tags merged into target: at least one, at
+// most MaxTagMergeSources, none of them target. Duplicates are dropped.
+// Sources are matched exactly, so they are not trimmed.
+func NewTagMergeSources(sources []string, target string) ([]string, error) {
+	var unique []string
+	for _, source := range sources {
+		if source == "" {
+			return nil, fmt.Errorf("%w: source tags must not be empty", ErrInvalidTag)
+		}
+		if source == target {
+			return nil, fmt.Errorf("%w: cannot merge %q into itself", ErrInvalidTag, source)
+		}
+		if !slices.Contains(unique, source) {
+			unique = append(unique, source)
+		}
+	}
+	if len(unique) == 0 {
+		return nil, fmt.Errorf("%w: at least one source tag is required", ErrInvalidTag)
+	}
+	if len(unique) > MaxTagMergeSources {
+		return nil, fmt.Errorf("%w: at most %d source tags", ErrInvalidTag, MaxTagMergeSources)
+	}
+	return unique, nil
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTagName(t *testing.T) {
+	name, err := NewTagName("  work  ")
+	require.NoError(t, err)
+	assert.Equal(t, "work", name)
+
+	for _, invalid := range []string{"", "   ", strings.Repeat("x", MaxTagNameLength+1), "a\nb"} {
+		_, err := NewTagName(invalid)
+		assert.ErrorIs(t, err, ErrInvalidTag, invalid)
+	}
+}
+
+func TestNewTagColor(t *testing.T) {
+	color, err := NewTagColor("#FF8800")
+	require.NoError(t, err)
+	assert.Equal(t, "#ff8800", color)
+
+	for _, invalid := range []string{"", "red", "#fff", "ff8800", "#gg8800"} {
+		_, err := NewTagColor(invalid)
+		assert.ErrorIs(t, err, ErrInvalidTag, invalid)
+	}
+}
+
+func TestTag_Validate(t *testing.T) {
+	color := "#00AA00"
+	description := "  "
+	tag := &Tag{Name: " urgent ", Color: &color, Description: &description}
+	require.NoError(t, tag.Validate())
+	assert.Equal(t, "urgent", tag.Name)
+	assert.Equal(t, "#00aa00", *tag.Color)
+	assert.Nil(t, tag.Description, "blank descriptions are cleared")
+
+	long := strings.Repeat("x", MaxTagDescriptionLength+1)
+	assert.ErrorIs(t, (&Tag{Name: "urgent", Description: &long}).Validate(), ErrInvalidTag)
+}
+
+func TestNewTagMergeSources(t *testing.T) {
+	sources, err := NewTagMergeSources([]string{"wrk", "Work", "wrk"}, "work")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"wrk", "Work"}, sources)
+
+	tooMany := make([]string, MaxTagMergeSources+1)
+	for i := range tooMany {
+		tooMany[i] = strings.Repeat("x", i+1)
+	}
+	for name, invalid := range map[string][]string{
+		"none":     nil,
+		"empty":    {""},
+		"target":   {"wrk", "work"},
+		"too many": tooMany,
+	} {
+		_, err := NewTagMergeSources(invalid, "work")
+		assert.ErrorIs(t, err, ErrInvalidTag, name)
+	}
+}
+
+func TestUpdateTagParams_Validate(t *testing.T) {
+	assert.NoError(t, UpdateTagParams{UpdateMask: []string{"color", "description"}}.Validate())
+	assert.ErrorIs(t, UpdateTagParams{}.Validate(), ErrEmptyUpdateMask)
+	assert.ErrorIs(t, UpdateTagParams{UpdateMask: []string{"name"}}.Validate(), ErrUnknownField)
+}
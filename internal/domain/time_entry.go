@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxTimeEntryNotesLength limits the notes of a time entry, in characters.
+const MaxTimeEntryNotesLength = 1000
+
+// TimeEntry is time a principal tracked on an item. An entry without EndedAt
+// is a running timer; a principal has at most one.
+//
+// Finished entries make up the item's actual duration. Items without entries
+// keep the duration derived from the time spent in progress.
+type TimeEntry struct {
+	ID          string
+	ItemID      string
+	ListID      string
+	PrincipalID string
+	StartedAt   time.Time
+	EndedAt     *time.Time // nil while the timer runs
+	Notes       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Running reports whether the entry is a running timer.
+func (e *TimeEntry) Running() bool {
+	return e.EndedAt == nil
+}
+
+// Duration returns the tracked time, up to now for a running timer.
+func (e *TimeEntry) Duration(now time.Time) time.Duration {
+	end := now
+	if e.EndedAt != nil {
+		end = *e.EndedAt
+	}
+	if end.Before(e.StartedAt) {
+		return 0
+	}
+	return end.Sub(e.StartedAt)
+}
+
+// NewTimeEntryNotes trims and validates the notes of a time entry.
+func NewTimeEntryNotes(notes string) (string, error) {
+	notes = strings.TrimSpace(notes)
+	if utf8.RuneCountInString(notes) > MaxTimeEntryNotesLength {
+		return "", fmt.Errorf("%w: notes must be at most %d characters", ErrInvalidTimeEntry, MaxTimeEntryNotesLength)
+	}
+	return notes, nil
+}
+
+// ValidateTimeEntryPeriod validates the period of a manual time entry:
+// it must end after it starts and must not end after now.
+func ValidateTimeEntryPeriod(startedAt, endedAt, now time.Time) error {
+	if !endedAt.After(startedAt) {
+		return fmt.Errorf("%w: ended_at must be after started_at", ErrInvalidTimeEntry)
+	}
+	if endedAt.After(now) {
+		return fmt.Errorf("%w: ended_at must not be in the future", ErrInvalidTimeEntry)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeEntry_Duration(t *testing.T) {
+	start := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	now := start.Add(2 * time.Hour)
+
+	running := &TimeEntry{StartedAt: start}
+	assert.True(t, running.Running())
+	assert.Equal(t, 2*time.Hour, running.Duration(now))
+
+	end := start.Add(45 * time.Minute)
+	finished := &TimeEntry{StartedAt: start, EndedAt: &end}
+	assert.False(t, finished.Running())
+	assert.Equal(t, 45*time.Minute, finished.Duration(now))
+}
+
+func TestNewTimeEntryNotes(t *testing.T) {
+	notes, err := NewTimeEntryNotes("  reviewed the draft \n")
+	require.NoError(t, err)
+	assert.Equal(t, "reviewed the draft", notes)
+
+	_, err = NewTimeEntryNotes(strings.Repeat("é", MaxTimeEntryNotesLength))
+	require.NoError(t, err)
+
+	_, err = NewTimeEntryNotes(strings.Repeat("é", MaxTimeEntryNotesLength+1))
+	assert.ErrorIs(t, err, ErrInvalidTimeEntry)
+}
+
+func TestValidateTimeEntryPeriod(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, ValidateTimeEntryPeriod(now.Add(-time.Hour), now, now))
+	assert.ErrorIs(t, ValidateTimeEntryPeriod(now.Add(-time.Hour), now.Add(-time.Hour), now), ErrInvalidTimeEntry)
+	assert.ErrorIs(t, ValidateTimeEntryPeriod(now.Add(-time.Hour), now.Add(-2*time.Hour), now), ErrInvalidTimeEntry)
+	assert.ErrorIs(t, ValidateTimeEntryPeriod(now, now.Add(time.Minute), now), ErrInvalidTimeEntry)
+}
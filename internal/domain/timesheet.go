@@ -0,0 +1,313 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// TimesheetGroupBy selects how a timesheet is broken down.
+type TimesheetGroupBy string
+
+const (
+	TimesheetGroupByList TimesheetGroupBy = "list"
+	TimesheetGroupByTag  TimesheetGroupBy = "tag"
+	TimesheetGroupByDay  TimesheetGroupBy = "day"
+)
+
+// TimesheetSource is where the time of an item in a timesheet comes from.
+type TimesheetSource string
+
+const (
+	// TimesheetSourceEntries is time tracked in time entries.
+	TimesheetSourceEntries TimesheetSource = "entries"
+
+	// TimesheetSourceStatus is time the item spent in progress.
+	TimesheetSourceStatus TimesheetSource = "status"
+
+	// TimesheetSourceManual is an actual duration set by hand.
+	TimesheetSourceManual TimesheetSource = "manual"
+)
+
+const (
+	// DefaultTimesheetDays is how many days a timesheet covers when from is not given.
+	DefaultTimesheetDays = 30
+
+	// MaxTimesheetDays bounds the days of a timesheet.
+	MaxTimesheetDays = 366
+
+	// MaxTimesheetPeriods bounds the periods of tracked time a timesheet is computed from.
+	MaxTimesheetPeriods = 50000
+)
+
+// TimesheetQuery selects the days of a timesheet, FirstDay through LastDay
+// in Location.
+type TimesheetQuery struct {
+	FirstDay time.Time // Midnight in Location
+	LastDay  time.Time // Midnight in Location
+	GroupBy  TimesheetGroupBy
+	Location *time.Location
+}
+
+// NewTimesheetQuery validates the parameters of a timesheet from YYYY-MM-DD
+// dates. An empty to is today at now, an empty from DefaultTimesheetDays days
+// up to to; an empty groupBy is list and an empty timezone UTC.
+func NewTimesheetQuery(from, to, groupBy, timezone string, now time.Time) (TimesheetQuery, error) {
+	query := TimesheetQuery{GroupBy: TimesheetGroupBy(groupBy), Location: time.UTC}
+
+	switch query.GroupBy {
+	case "":
+		query.GroupBy = TimesheetGroupByList
+	case TimesheetGroupByList, TimesheetGroupByTag, TimesheetGroupByDay:
+	default:
+		return TimesheetQuery{}, fmt.Errorf("%w: %q", ErrInvalidTimesheetGroupBy, groupBy)
+	}
+	if timezone != "" {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return TimesheetQuery{}, fmt.Errorf("%w: %q", ErrInvalidTimesheetTimezone, timezone)
+		}
+		query.Location = loc
+	}
+
+	local := now.In(query.Location)
+	query.LastDay = time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, query.Location)
+	if to != "" {
+		day, err := time.ParseInLocation(time.DateOnly, to, query.Location)
+		if err != nil {
+			return TimesheetQuery{}, fmt.Errorf("%w: invalid to date %q", ErrInvalidTimesheetRange, to)
+		}
+		query.LastDay = day
+	}
+	query.FirstDay = query.LastDay.AddDate(0, 0, 1-DefaultTimesheetDays)
+	if from != "" {
+		day, err := time.ParseInLocation(time.DateOnly, from, query.Location)
+		if err != nil {
+			return TimesheetQuery{}, fmt.Errorf("%w: invalid from date %q", ErrInvalidTimesheetRange, from)
+		}
+		query.FirstDay = day
+	}
+
+	if query.FirstDay.After(query.LastDay) {
+		return TimesheetQuery{}, fmt.Errorf("%w: from must not be after to", ErrInvalidTimesheetRange)
+	}
+	if query.FirstDay.AddDate(0, 0, MaxTimesheetDays).Before(query.LastDay.AddDate(0, 0, 1)) {
+		return TimesheetQuery{}, fmt.Errorf("%w: at most %d days", ErrInvalidTimesheetRange, MaxTimesheetDays)
+	}
+	return query, nil
+}
+
+// Start returns the start of the first day.
+func (q TimesheetQuery) Start() time.Time {
+	return q.FirstDay
+}
+
+// End returns the end of the last day, exclusive.
+func (q TimesheetQuery) End() time.Time {
+	return q.LastDay.AddDate(0, 0, 1)
+}
+
+// TimesheetPeriod is a period of time tracked on an item.
+type TimesheetPeriod struct {
+	ItemID    string
+	ListID    string
+	ListTitle string
+	Title     string
+	Tags      []string
+
+	Source TimesheetSource
+	Start  time.Time
+	End    *time.Time // nil while the timer runs or the item is in progress
+
+	// ActualDuration and TrackedDuration (the total of the finished in_progress
+	// periods of the item) detect an actual duration set by hand on status periods.
+	// For manual periods ActualDuration is the time reported at Start.
+	ActualDuration  *time.Duration
+	TrackedDuration time.Duration
+}
+
+// Timesheet is the time tracked on items over days, grouped by list, tag or day.
+type Timesheet struct {
+	FirstDay time.Time
+	LastDay  time.Time
+	GroupBy  TimesheetGroupBy
+	Location *time.Location
+
+	Total  time.Duration
+	Groups []TimesheetGroup
+
+	// Truncated reports that more than MaxTimesheetPeriods periods matched, so some are missing.
+	Truncated bool
+}
+
+// TimesheetGroup is the time tracked in a list, on a tag or on a day.
+type TimesheetGroup struct {
+	// Key is the list ID, the tag or the day (YYYY-MM-DD). Items without
+	// tags are grouped under the empty key.
+	Key string
+
+	// Name is the title of a list group, and the key otherwise.
+	Name string
+
+	Duration time.Duration
+	Items    []TimesheetItem // Most time first
+}
+
+// TimesheetItem is the time tracked on an item within a group.
+type TimesheetItem struct {
+	ItemID   string
+	ListID   string
+	Title    string
+	Source   TimesheetSource
+	Duration time.Duration
+}
+
+// NewTimesheet computes a timesheet from periods of tracked time. now ends
+// the periods still running.
+//
+// Items with time entries are reported by their entries, other items by the
+// time they spent in progress. An actual duration set by hand replaces the
+// time in progress, spread over the in_progress periods in proportion. Time
+// is clipped to the days of the query; day groups split it at midnight in
+// the query location. Items count in each of their current tags.
+func NewTimesheet(query TimesheetQuery, periods []TimesheetPeriod, now time.Time) *Timesheet {
+	start, end := query.Start(), query.End()
+	if now.Before(end) {
+		end = now
+	}
+
+	b := timesheetBuilder{query: query, groups: make(map[string]*timesheetGroupCounter), totals: make(map[string]time.Duration)}
+	if query.GroupBy == TimesheetGroupByDay {
+		// Every day is a group, including days without tracked time
+		for day := query.FirstDay; !day.After(query.LastDay); day = day.AddDate(0, 0, 1) {
+			b.group(day.Format(time.DateOnly), "")
+		}
+	}
+
+	for _, period := range periods {
+		source, scale := period.Source, 1.0
+		if period.Source == TimesheetSourceStatus && period.ActualDuration != nil &&
+			period.TrackedDuration > 0 && *period.ActualDuration != period.TrackedDuration {
+			source = TimesheetSourceManual
+			scale = float64(*period.ActualDuration) / float64(period.TrackedDuration)
+		}
+
+		if period.Source == TimesheetSourceManual {
+			if period.ActualDuration != nil && !period.Start.Before(start) && period.Start.Before(query.End()) {
+				b.add(period, source, period.Start, *period.ActualDuration)
+			}
+			continue
+		}
+
+		from, to := period.Start, end
+		if period.End != nil && period.End.Before(to) {
+			to = *period.End
+		}
+		if from.Before(start) {
+			from = start
+		}
+		for from.Before(to) {
+			next := to
+			if query.GroupBy == TimesheetGroupByDay {
+				local := from.In(query.Location)
+				midnight := time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, query.Location)
+				if midnight.Before(next) {
+					next = midnight
+				}
+			}
+			b.add(period, source, from, time.Duration(float64(next.Sub(from))*scale))
+			from = next
+		}
+	}
+	return b.timesheet()
+}
+
+// timesheetBuilder accumulates tracked time into the groups.
+type timesheetBuilder struct {
+	query  TimesheetQuery
+	groups map[string]*timesheetGroupCounter
+	totals map[string]time.Duration // Tracked time per item, for the total
+}
+
+type timesheetGroupCounter struct {
+	name  string
+	items map[string]*TimesheetItem
+}
+
+func (b *timesheetBuilder) group(key, name string) *timesheetGroupCounter {
+	g, ok := b.groups[key]
+	if !ok {
+		if name == "" {
+			name = key
+		}
+		g = &timesheetGroupCounter{name: name, items: make(map[string]*TimesheetItem)}
+		b.groups[key] = g
+	}
+	return g
+}
+
+// add records d tracked on the item of period at at.
+func (b *timesheetBuilder) add(period TimesheetPeriod, source TimesheetSource, at time.Time, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	b.totals[period.ItemID] += d
+
+	var keys []string
+	name := ""
+	switch b.query.GroupBy {
+	case TimesheetGroupByList:
+		keys, name = []string{period.ListID}, period.ListTitle
+	case TimesheetGroupByTag:
+		keys = period.Tags
+		if len(keys) == 0 {
+			keys = []string{""}
+		}
+	case TimesheetGroupByDay:
+		keys = []string{at.In(b.query.Location).Format(time.DateOnly)}
+	}
+
+	for _, key := range keys {
+		g := b.group(key, name)
+		item, ok := g.items[period.ItemID]
+		if !ok {
+			item = &TimesheetItem{ItemID: period.ItemID, ListID: period.ListID, Title: period.Title, Source: source}
+			g.items[period.ItemID] = item
+		}
+		item.Duration += d
+	}
+}
+
+func (b *timesheetBuilder) timesheet() *Timesheet {
+	sheet := &Timesheet{
+		FirstDay: b.query.FirstDay,
+		LastDay:  b.query.LastDay,
+		GroupBy:  b.query.GroupBy,
+		Location: b.query.Location,
+		Groups:   make([]TimesheetGroup, 0, len(b.groups)),
+	}
+	for _, d := range b.totals {
+		sheet.Total += d.Round(time.Second)
+	}
+
+	for key, g := range b.groups {
+		group := TimesheetGroup{Key: key, Name: g.name, Items: make([]TimesheetItem, 0, len(g.items))}
+		for _, item := range g.items {
+			item.Duration = item.Duration.Round(time.Second)
+			group.Duration += item.Duration
+			group.Items = append(group.Items, *item)
+		}
+		slices.SortFunc(group.Items, func(a, b TimesheetItem) int {
+			return cmp.Or(cmp.Compare(b.Duration, a.Duration), cmp.Compare(a.Title, b.Title), cmp.Compare(a.ItemID, b.ItemID))
+		})
+		sheet.Groups = append(sheet.Groups, group)
+	}
+	slices.SortFunc(sheet.Groups, func(a, b TimesheetGroup) int {
+		if sheet.GroupBy == TimesheetGroupByList {
+			return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Key, b.Key))
+		}
+		return cmp.Compare(a.Key, b.Key)
+	})
+	return sheet
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTimesheetQuery(t *testing.T) {
+	// Late evening in UTC is already the next day in Stockholm
+	now := time.Date(2026, 3, 20, 23, 30, 0, 0, time.UTC)
+
+	query, err := NewTimesheetQuery("", "", "", "", now)
+	require.NoError(t, err)
+	assert.Equal(t, TimesheetGroupByList, query.GroupBy)
+	assert.Equal(t, time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC), query.LastDay)
+	assert.Equal(t, time.Date(2026, 2, 19, 0, 0, 0, 0, time.UTC), query.FirstDay)
+
+	query, err = NewTimesheetQuery("2026-03-01", "", "day", "Europe/Stockholm", now)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03-21", query.LastDay.Format(time.DateOnly))
+	assert.Equal(t, time.Date(2026, 2, 28, 23, 0, 0, 0, time.UTC), query.Start().UTC())
+	assert.Equal(t, time.Date(2026, 3, 21, 23, 0, 0, 0, time.UTC), query.End().UTC())
+
+	tests := []struct {
+		name          string
+		from, to, tz  string
+		groupBy, want string
+		err           error
+	}{
+		{name: "from after to", from: "2026-03-10", to: "2026-03-09", err: ErrInvalidTimesheetRange},
+		{name: "too many days", from: "2025-03-19", to: "2026-03-20", err: ErrInvalidTimesheetRange},
+		{name: "not a date", from: "03/10/2026", err: ErrInvalidTimesheetRange},
+		{name: "unknown group", groupBy: "week", err: ErrInvalidTimesheetGroupBy},
+		{name: "unknown timezone", tz: "Mars/Olympus", err: ErrInvalidTimesheetTimezone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTimesheetQuery(tt.from, tt.to, tt.groupBy, tt.tz, now)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestNewTimesheet(t *testing.T) {
+	at := func(s string) time.Time {
+		v, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err)
+		return v
+	}
+	ptrTime := func(s string) *time.Time {
+		v := at(s)
+		return &v
+	}
+	duration := func(d time.Duration) *time.Duration { return &d }
+
+	periods := []TimesheetPeriod{
+		// Tracked in entries; the first one started before the range
+		{ItemID: "a", ListID: "l1", ListTitle: "Work", Title: "Spec", Tags: []string{"x"},
+			Source: TimesheetSourceEntries, Start: at("2026-03-01T22:00:00Z"), End: ptrTime("2026-03-02T01:00:00Z")},
+		{ItemID: "a", ListID: "l1", ListTitle: "Work", Title: "Spec", Tags: []string{"x"},
+			Source: TimesheetSourceEntries, Start: at("2026-03-03T09:00:00Z"), End: ptrTime("2026-03-03T10:00:00Z")},
+		// In progress across midnight, actual duration derived from status
+		{ItemID: "b", ListID: "l2", ListTitle: "Home", Title: "Taxes", Tags: []string{"x", "y"},
+			Source: TimesheetSourceStatus, Start: at("2026-03-02T23:00:00Z"), End: ptrTime("2026-03-03T01:00:00Z"),
+			ActualDuration: duration(2 * time.Hour), TrackedDuration: 2 * time.Hour},
+		// Actual duration overridden: 2h of in progress time count as 1h
+		{ItemID: "c", ListID: "l1", ListTitle: "Work", Title: "Review",
+			Source: TimesheetSourceStatus, Start: at("2026-03-04T10:00:00Z"), End: ptrTime("2026-03-04T12:00:00Z"),
+			ActualDuration: duration(time.Hour), TrackedDuration: 2 * time.Hour},
+		// Never in progress, actual duration set by hand
+		{ItemID: "d", ListID: "l2", ListTitle: "Home", Title: "Groceries",
+			Source: TimesheetSourceManual, Start: at("2026-03-05T08:00:00Z"), ActualDuration: duration(30 * time.Minute)},
+		// Still in progress, counted up to now
+		{ItemID: "e", ListID: "l2", ListTitle: "Home", Title: "Garden",
+			Source: TimesheetSourceStatus, Start: at("2026-03-05T10:00:00Z")},
+	}
+	now := at("2026-03-05T10:15:00Z")
+	newTimesheet := func(groupBy TimesheetGroupBy) *Timesheet {
+		query := TimesheetQuery{
+			FirstDay: at("2026-03-02T00:00:00Z"),
+			LastDay:  at("2026-03-05T00:00:00Z"),
+			GroupBy:  groupBy,
+			Location: time.UTC,
+		}
+		return NewTimesheet(query, periods, now)
+	}
+
+	t.Run("by list", func(t *testing.T) {
+		sheet := newTimesheet(TimesheetGroupByList)
+		assert.Equal(t, 5*time.Hour+45*time.Minute, sheet.Total)
+		require.Len(t, sheet.Groups, 2)
+
+		home, work := sheet.Groups[0], sheet.Groups[1]
+		assert.Equal(t, "Home", home.Name)
+		assert.Equal(t, "l2", home.Key)
+		assert.Equal(t, 2*time.Hour+45*time.Minute, home.Duration)
+		assert.Equal(t, "Work", work.Name)
+		assert.Equal(t, 3*time.Hour, work.Duration)
+		assert.Equal(t, []TimesheetItem{
+			{ItemID: "a", ListID: "l1", Title: "Spec", Source: TimesheetSourceEntries, Duration: 2 * time.Hour},
+			{ItemID: "c", ListID: "l1", Title: "Review", Source: TimesheetSourceManual, Duration: time.Hour},
+		}, work.Items)
+	})
+
+	t.Run("by tag", func(t *testing.T) {
+		sheet := newTimesheet(TimesheetGroupByTag)
+		require.Len(t, sheet.Groups, 3)
+		assert.Equal(t, "", sheet.Groups[0].Key)
+		assert.Equal(t, time.Hour+45*time.Minute, sheet.Groups[0].Duration)
+		assert.Equal(t, "x", sheet.Groups[1].Key)
+		assert.Equal(t, 4*time.Hour, sheet.Groups[1].Duration)
+		assert.Equal(t, "y", sheet.Groups[2].Key)
+		// Items in several tags count once in the total
+		assert.Equal(t, 5*time.Hour+45*time.Minute, sheet.Total)
+	})
+
+	t.Run("by day", func(t *testing.T) {
+		sheet := newTimesheet(TimesheetGroupByDay)
+		require.Len(t, sheet.Groups, 4)
+		days := map[string]time.Duration{}
+		for _, group := range sheet.Groups {
+			days[group.Key] = group.Duration
+		}
+		assert.Equal(t, map[string]time.Duration{
+			"2026-03-02": 2 * time.Hour,
+			"2026-03-03": 2 * time.Hour,
+			"2026-03-04": time.Hour,
+			"2026-03-05": 45 * time.Minute,
+		}, days)
+	})
+}
@@ -8,32 +8,52 @@ import "time"
 // Common use cases:
 //   - "My overdue tasks": DueBefore=now(), Filter with OrderBy="due_at"
 //   - "Tasks in list X": ListID=X, default ordering
+//   - "Tasks in lists X or Y": ListIDs=[X, Y]
 //   - "High priority TODO items": Filter with Priorities=[high], Statuses=[todo]
 //   - "Active work": Filter with Statuses=[todo, in_progress]
-//   - Paginated search: Limit=50, Offset=100 for page 3
+//   - Paginated search: Limit=50, After=NextCursor of the previous page
 type ListTasksParams struct {
 	// Filter by specific list (nil = search all lists)
 	ListID *string
 
+	// Filter by any of these lists (empty = no filter), at most MaxListIDsFilter
+	ListIDs []string
+
 	// Validated filter (statuses, priorities, tags, orderBy, orderDir)
 	// Created via NewItemsFilter which validates all fields at construction.
 	Filter ItemsFilter
 
 	// Date filters (nil = no filter applied)
-	DueBefore *time.Time // Filter tasks due before this time
-	DueAfter  *time.Time // Filter tasks due after this time
+	DueBefore    *time.Time // Filter tasks due before this time
+	DueAfter     *time.Time // Filter tasks due after this time
+	UpdatedAfter *time.Time // Filter tasks last updated after this time
+	CreatedAfter *time.Time // Filter tasks created after this time
+
+	// ExcludeSnoozed leaves out items snoozed past the time of the search
+	ExcludeSnoozed bool
 
-	// Pagination (both required for correct pagination)
-	Limit  int // Maximum number of items to return (page size)
-	Offset int // Number of items to skip (for page N: offset = (N-1) * limit)
+	// Pagination
+	Limit             int         // Maximum number of items to return (page size)
+	After             *PageCursor // Position to continue after (nil = first page)
+	IncludeTotalCount bool        // Count all matching items; costs a scan of every match
+}
+
+// PageCursor is a keyset pagination position: the sort key and ID of the last
+// row of a page. The next page starts strictly after that row in the sort order,
+// so rows inserted or deleted between pages never shift it.
+// A cursor is only meaningful for the sort and filters of the query that returned it.
+type PageCursor struct {
+	SortKey *string // Encoded sort key of the row, set by the repository; nil when the row has none
+	ID      string
 }
 
 // PagedResult contains items matching the query parameters.
 // Result of applying ListTasksParams (filtering, sorting, and pagination).
 type PagedResult struct {
-	Items      []TodoItem // Items matching the ListTasksParams criteria
-	TotalCount int        // Total matching items across all pages
-	HasMore    bool       // Whether there are more pages
+	Items      []TodoItem  // Items matching the ListTasksParams criteria
+	TotalCount *int        // Total matching items across all pages; nil unless requested
+	HasMore    bool        // Whether there are more pages
+	NextCursor *PageCursor // Position after the last item; nil when there are no more pages
 }
 
 // ListListsParams contains parameters for listing todo lists with filtering, sorting, and pagination.
@@ -51,14 +71,16 @@ type ListListsParams struct {
 	// Validated sorting configuration (created via NewListsSorting)
 	Sorting ListsSorting
 
-	// Pagination (both required for correct pagination)
-	Limit  int // Maximum number of items to return (page size)
-	Offset int // Number of items to skip (for page N: offset = (N-1) * limit)
+	// Pagination
+	Limit             int         // Maximum number of lists to return (page size)
+	After             *PageCursor // Position to continue after (nil = first page)
+	IncludeTotalCount bool        // Count all matching lists; costs a scan of every match
 }
 
 // PagedListResult contains lists matching the query parameters.
 type PagedListResult struct {
 	Lists      []*TodoList // Lists matching the ListListsParams criteria
-	TotalCount int         // Total matching lists across all pages
+	TotalCount *int        // Total matching lists across all pages; nil unless requested
 	HasMore    bool        // Whether there are more pages
+	NextCursor *PageCursor // Position after the last list; nil when there are no more pages
 }
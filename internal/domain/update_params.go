@@ -14,6 +14,8 @@ var updateItemValidFields = map[string]struct{}{
 	"timezone":           {},
 	"estimated_duration": {},
 	"actual_duration":    {},
+	"custom_fields":      {},
+	"snoozed_until":      {},
 }
 
 // Validate checks that UpdateMask contains only known fields and that
@@ -46,7 +48,8 @@ func (p UpdateItemParams) Validate() error {
 
 // Valid fields for UpdateListParams.
 var updateListValidFields = map[string]struct{}{
-	"title": {},
+	"title":         {},
+	"custom_fields": {},
 }
 
 // Validate checks that UpdateMask contains only known fields and that
@@ -86,6 +89,8 @@ var updateRecurringTemplateValidFields = map[string]struct{}{
 	"is_active":               {},
 	"sync_horizon_days":       {},
 	"generation_horizon_days": {},
+	"custom_fields":           {},
+	"default_reminders":       {},
 }
 
 // Validate checks that UpdateMask contains only known fields and that
@@ -118,3 +123,142 @@ func (p UpdateRecurringTemplateParams) Validate() error {
 
 	return nil
 }
+
+// Valid fields for UpdateWebhookParams.
+var updateWebhookValidFields = map[string]struct{}{
+	"url":       {},
+	"secret":    {},
+	"events":    {},
+	"is_active": {},
+}
+
+// Validate checks that UpdateMask contains only known fields and that
+// required fields have non-nil values when included in the mask.
+func (p UpdateWebhookParams) Validate() error {
+	if len(p.UpdateMask) == 0 {
+		return ErrEmptyUpdateMask
+	}
+
+	maskSet := make(map[string]bool, len(p.UpdateMask))
+	for _, field := range p.UpdateMask {
+		if _, ok := updateWebhookValidFields[field]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownField, field)
+		}
+		maskSet[field] = true
+	}
+
+	if maskSet["url"] && p.URL == nil {
+		return fmt.Errorf("%w: url is required", ErrInvalidWebhook)
+	}
+	if maskSet["secret"] && p.Secret == nil {
+		return fmt.Errorf("%w: secret is required", ErrInvalidWebhook)
+	}
+	if maskSet["is_active"] && p.IsActive == nil {
+		return fmt.Errorf("%w: is_active is required", ErrInvalidWebhook)
+	}
+
+	return nil
+}
+
+// Valid fields for UpdateSavedViewParams.
+var updateSavedViewValidFields = map[string]struct{}{
+	"name":            {},
+	"list_id":         {},
+	"filter":          {},
+	"due_after":       {},
+	"due_before":      {},
+	"include_snoozed": {},
+}
+
+// Validate checks that UpdateMask contains only known fields and that
+// required fields have non-nil values when included in the mask.
+// list_id, due_after and due_before may be nil to clear them.
+func (p UpdateSavedViewParams) Validate() error {
+	if len(p.UpdateMask) == 0 {
+		return ErrEmptyUpdateMask
+	}
+
+	maskSet := make(map[string]bool, len(p.UpdateMask))
+	for _, field := range p.UpdateMask {
+		if _, ok := updateSavedViewValidFields[field]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownField, field)
+		}
+		maskSet[field] = true
+	}
+
+	if maskSet["name"] && p.Name == nil {
+		return fmt.Errorf("%w: name is required", ErrInvalidSavedView)
+	}
+	if maskSet["filter"] && p.Filter == nil {
+		return fmt.Errorf("%w: filter is required", ErrInvalidSavedView)
+	}
+	if maskSet["include_snoozed"] && p.IncludeSnoozed == nil {
+		return fmt.Errorf("%w: include_snoozed is required", ErrInvalidSavedView)
+	}
+
+	return nil
+}
+
+// Valid fields for UpdateAutomationRuleParams.
+var updateAutomationRuleValidFields = map[string]struct{}{
+	"name":      {},
+	"enabled":   {},
+	"trigger":   {},
+	"condition": {},
+	"actions":   {},
+}
+
+// Validate checks that UpdateMask contains only known fields and that
+// required fields have non-nil values when included in the mask.
+func (p UpdateAutomationRuleParams) Validate() error {
+	if len(p.UpdateMask) == 0 {
+		return ErrEmptyUpdateMask
+	}
+
+	maskSet := make(map[string]bool, len(p.UpdateMask))
+	for _, field := range p.UpdateMask {
+		if _, ok := updateAutomationRuleValidFields[field]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownField, field)
+		}
+		maskSet[field] = true
+	}
+
+	if maskSet["name"] && p.Name == nil {
+		return fmt.Errorf("%w: name is required", ErrInvalidRule)
+	}
+	if maskSet["enabled"] && p.Enabled == nil {
+		return fmt.Errorf("%w: enabled is required", ErrInvalidRule)
+	}
+	if maskSet["trigger"] && p.Trigger == nil {
+		return fmt.Errorf("%w: trigger is required", ErrInvalidRule)
+	}
+	if maskSet["condition"] && p.Condition == nil {
+		return fmt.Errorf("%w: condition is required", ErrInvalidRule)
+	}
+	if maskSet["actions"] && p.Actions == nil {
+		return fmt.Errorf("%w: actions are required", ErrInvalidRule)
+	}
+
+	return nil
+}
+
+// Valid fields for UpdateTagParams.
+var updateTagValidFields = map[string]struct{}{
+	"color":       {},
+	"description": {},
+}
+
+// Validate checks that UpdateMask contains only known fields.
+// Both fields can be cleared, so none is required.
+func (p UpdateTagParams) Validate() error {
+	if len(p.UpdateMask) == 0 {
+		return ErrEmptyUpdateMask
+	}
+
+	for _, field := range p.UpdateMask {
+		if _, ok := updateTagValidFields[field]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownField, field)
+		}
+	}
+	return nil
+}
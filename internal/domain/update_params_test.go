@@ -73,6 +73,11 @@ func TestUpdateItemParams_Validate_UnknownField(t *testing.T) {
 			mask:    []string{"title", "status", "priority", "due_at", "tags", "timezone", "estimated_duration", "actual_duration"},
 			wantErr: false,
 		},
+		{
+			name:    "valid field custom_fields",
+			mask:    []string{"custom_fields"},
+			wantErr: false,
+		},
 		{
 			name:    "unknown field typo",
 			mask:    []string{"titl"},
@@ -211,6 +216,11 @@ func TestUpdateListParams_Validate_UnknownField(t *testing.T) {
 			mask:    []string{"title"},
 			wantErr: false,
 		},
+		{
+			name:    "valid field custom_fields",
+			mask:    []string{"custom_fields"},
+			wantErr: false,
+		},
 		{
 			name:    "unknown field",
 			mask:    []string{"description"},
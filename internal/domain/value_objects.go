@@ -2,7 +2,10 @@ package domain
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
+	"time"
 )
 
 // Title is a validated title value object (1-255 characters).
@@ -88,6 +91,7 @@ const (
 	MaxStatusFilter   = 6 // All possible statuses
 	MaxPriorityFilter = 4 // All possible priorities
 	MaxTagsFilter     = 5
+	MaxListIDsFilter  = 50
 )
 
 // Default sorting values - business rules for item listing.
@@ -109,11 +113,13 @@ var validOrderByFields = map[string]bool{
 // All filter fields are slices (OR logic within field, AND logic across fields).
 // Defaults are applied for orderBy/orderDir if not provided.
 type ItemsFilter struct {
-	statuses   []TaskStatus
-	priorities []TaskPriority
-	tags       []string
-	orderBy    string
-	orderDir   string
+	statuses     []TaskStatus
+	priorities   []TaskPriority
+	tags         []string
+	customFields map[string]string
+	expression   FilterExpression
+	orderBy      string
+	orderDir     string
 }
 
 // ItemsFilterInput holds raw input for creating an ItemsFilter.
@@ -122,8 +128,16 @@ type ItemsFilterInput struct {
 	Statuses   []string
 	Priorities []string
 	Tags       []string
-	OrderBy    *string
-	OrderDir   *string
+	// CustomFields filters by exact custom field value (field name → value text).
+	// Values are compared against the text form of the stored JSON value,
+	// e.g. "3" for a number, "true" for a boolean, "2025-01-31" for a date.
+	CustomFields map[string]string
+	// Expression is an AIP-160 style filter, ANDed with the other filters.
+	// See FilterExpression for the syntax.
+	Expression *string
+	// OrderBy accepts a built-in field or "custom_fields.<name>".
+	OrderBy  *string
+	OrderDir *string
 }
 
 // NewItemsFilter creates a validated filter for listing items.
@@ -169,10 +183,37 @@ func NewItemsFilter(input ItemsFilterInput) (ItemsFilter, error) {
 		copy(filter.tags, input.Tags)
 	}
 
+	// Validate custom field filters - names must be identifiers, copy to ensure immutability
+	if len(input.CustomFields) > MaxCustomFieldFilter {
+		return ItemsFilter{}, ErrTooManyCustomFieldFilters
+	}
+	if len(input.CustomFields) > 0 {
+		filter.customFields = make(map[string]string, len(input.CustomFields))
+		for name, value := range input.CustomFields {
+			if !customFieldNamePattern.MatchString(name) {
+				return ItemsFilter{}, fmt.Errorf("%w: invalid field name %q", ErrInvalidCustomFieldFilter, name)
+			}
+			filter.customFields[name] = value
+		}
+	}
+
+	// Parse and type-check the filter expression
+	if input.Expression != nil {
+		expression, err := ParseFilterExpression(*input.Expression)
+		if err != nil {
+			return ItemsFilter{}, err
+		}
+		filter.expression = expression
+	}
+
 	// Validate and set orderBy if provided, otherwise keep default
 	if input.OrderBy != nil && *input.OrderBy != "" {
-		if !validOrderByFields[*input.OrderBy] {
-			return ItemsFilter{}, fmt.Errorf("%w: %s (supported: due_at, priority, created_at, updated_at)", ErrInvalidOrderByField, *input.OrderBy)
+		if name, ok := strings.CutPrefix(*input.OrderBy, CustomFieldOrderByPrefix); ok {
+			if !customFieldNamePattern.MatchString(name) {
+				return ItemsFilter{}, fmt.Errorf("%w: %s (invalid custom field name)", ErrInvalidOrderByField, *input.OrderBy)
+			}
+		} else if !validOrderByFields[*input.OrderBy] {
+			return ItemsFilter{}, fmt.Errorf("%w: %s (supported: due_at, priority, created_at, updated_at, custom_fields.<name>)", ErrInvalidOrderByField, *input.OrderBy)
 		}
 		filter.orderBy = *input.OrderBy
 	}
@@ -222,19 +263,65 @@ func (f ItemsFilter) Tags() []string {
 	return result
 }
 
+// CustomFields returns the custom field filters keyed by field name (nil if not filtering).
+// Returns a defensive copy to prevent external mutation.
+func (f ItemsFilter) CustomFields() map[string]string {
+	if len(f.customFields) == 0 {
+		return nil
+	}
+	return maps.Clone(f.customFields)
+}
+
+// Expression returns the filter expression (zero value if not filtering).
+func (f ItemsFilter) Expression() FilterExpression {
+	return f.expression
+}
+
 // OrderBy returns the order by field (defaults to "created_at").
+// Custom field sort keys are returned as "custom_fields.<name>".
 func (f ItemsFilter) OrderBy() string {
 	return f.orderBy
 }
 
+// CustomFieldOrderBy returns the custom field name used as sort key,
+// and false when sorting by a built-in field.
+func (f ItemsFilter) CustomFieldOrderBy() (string, bool) {
+	return strings.CutPrefix(f.orderBy, CustomFieldOrderByPrefix)
+}
+
 // OrderDir returns the sort direction (defaults to "desc").
 func (f ItemsFilter) OrderDir() string {
 	return f.orderDir
 }
 
-// HasStatusFilter returns true if a status filter is applied.
+// HasStatusFilter returns true if a status filter is applied,
+// either as a status list or as a status comparison of the expression.
 func (f ItemsFilter) HasStatusFilter() bool {
-	return len(f.statuses) > 0
+	return len(f.statuses) > 0 || f.expression.References(FilterFieldStatus)
+}
+
+// Matches reports whether item passes the filter in a search run at now:
+// any of the statuses and priorities, all of the tags and custom field values,
+// and the expression. The sort is ignored, and so are the statuses an item
+// listing hides by default.
+func (f ItemsFilter) Matches(item *TodoItem, now time.Time) bool {
+	if len(f.statuses) > 0 && !slices.Contains(f.statuses, item.Status) {
+		return false
+	}
+	if len(f.priorities) > 0 && (item.Priority == nil || !slices.Contains(f.priorities, *item.Priority)) {
+		return false
+	}
+	for _, tag := range f.tags {
+		if !slices.Contains(item.Tags, tag) {
+			return false
+		}
+	}
+	for name, value := range f.customFields {
+		if text, ok := customFieldText(item.CustomFields[name]); !ok || text != value {
+			return false
+		}
+	}
+	return f.expression.Matches(item, now)
 }
 
 // Lists sorting defaults and valid fields.
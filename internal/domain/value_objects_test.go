@@ -242,6 +242,74 @@ func TestNewItemsFilter_InvalidOrderBy(t *testing.T) {
 	assert.Contains(t, err.Error(), "supported:")
 }
 
+func TestNewItemsFilter_CustomFieldOrderBy(t *testing.T) {
+	orderBy := "custom_fields.story_points"
+	filter, err := NewItemsFilter(ItemsFilterInput{
+		OrderBy: &orderBy,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, orderBy, filter.OrderBy())
+	name, ok := filter.CustomFieldOrderBy()
+	assert.True(t, ok)
+	assert.Equal(t, "story_points", name)
+}
+
+func TestNewItemsFilter_BuiltInOrderByIsNotCustomField(t *testing.T) {
+	orderBy := "due_at"
+	filter, err := NewItemsFilter(ItemsFilterInput{
+		OrderBy: &orderBy,
+	})
+
+	require.NoError(t, err)
+	_, ok := filter.CustomFieldOrderBy()
+	assert.False(t, ok)
+}
+
+func TestNewItemsFilter_InvalidCustomFieldOrderBy(t *testing.T) {
+	for _, orderBy := range []string{"custom_fields.", "custom_fields.Story", "custom_fields.a;DROP TABLE"} {
+		t.Run(orderBy, func(t *testing.T) {
+			ob := orderBy
+			_, err := NewItemsFilter(ItemsFilterInput{
+				OrderBy: &ob,
+			})
+
+			assert.ErrorIs(t, err, ErrInvalidOrderByField)
+		})
+	}
+}
+
+func TestNewItemsFilter_CustomFieldFilters(t *testing.T) {
+	input := map[string]string{"stage": "build", "story_points": "3"}
+	filter, err := NewItemsFilter(ItemsFilterInput{
+		CustomFields: input,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, input, filter.CustomFields())
+
+	// Mutating the input or the returned map must not affect the filter
+	input["stage"] = "design"
+	filter.CustomFields()["story_points"] = "5"
+	assert.Equal(t, map[string]string{"stage": "build", "story_points": "3"}, filter.CustomFields())
+}
+
+func TestNewItemsFilter_TooManyCustomFieldFilters(t *testing.T) {
+	_, err := NewItemsFilter(ItemsFilterInput{
+		CustomFields: map[string]string{"a": "1", "b": "2", "c": "3", "d": "4", "e": "5", "f": "6"},
+	})
+
+	assert.ErrorIs(t, err, ErrTooManyCustomFieldFilters)
+}
+
+func TestNewItemsFilter_InvalidCustomFieldFilterName(t *testing.T) {
+	_, err := NewItemsFilter(ItemsFilterInput{
+		CustomFields: map[string]string{"Stage": "build"},
+	})
+
+	assert.ErrorIs(t, err, ErrInvalidCustomFieldFilter)
+}
+
 func TestNewItemsFilter_EmptyOrderByUsesDefault(t *testing.T) {
 	empty := ""
 	filter, err := NewItemsFilter(ItemsFilterInput{
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MinWebhookSecretLength is the minimum length of a caller-provided signing secret.
+const MinWebhookSecretLength = 16
+
+// WebhookSubscription delivers events of the selected types to a URL.
+// Payloads are signed with HMAC-SHA256 using Secret so receivers can verify them.
+// Subscriptions belong to a tenant and only receive events of lists the tenant
+// owns or is a member of.
+type WebhookSubscription struct {
+	ID        string
+	OwnerID   string // Tenant of the principal that created the subscription; empty for internal callers
+	URL       string
+	Secret    string
+	Events    []EventType
+	IsActive  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Subscribes reports whether the subscription receives events of the given type.
+func (s *WebhookSubscription) Subscribes(eventType EventType) bool {
+	return slices.Contains(s.Events, eventType)
+}
+
+// ValidateWebhookURL checks that a subscription URL is an absolute http(s) URL
+// that does not point at the network of the service. Host names can resolve
+// to anything, so senders check the addresses they connect to as well.
+func ValidateWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidWebhook)
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: url must not point at a local address", ErrInvalidWebhook)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !IsPublicAddr(addr) {
+		return fmt.Errorf("%w: url must not point at a loopback, link-local or private address", ErrInvalidWebhook)
+	}
+	return nil
+}
+
+// IsPublicAddr reports whether webhook deliveries may connect to addr.
+// Loopback, link-local, private (RFC 1918 and RFC 4193), unspecified and
+// multicast addresses are reserved for the network of the service.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsPrivate() &&
+		!addr.IsUnspecified() &&
+		!addr.IsMulticast()
+}
+
+// ValidateWebhookSecret checks a caller-provided signing secret.
+func ValidateWebhookSecret(secret string) error {
+	if len(secret) < MinWebhookSecretLength {
+		return fmt.Errorf("%w: secret must be at least %d characters", ErrInvalidWebhook, MinWebhookSecretLength)
+	}
+	return nil
+}
+
+// NewEventFilter validates the event types of a subscription.
+// Duplicates are removed; at least one event type is required.
+func NewEventFilter(events []string) ([]EventType, error) {
+	if len(events) == 0 {
+		return nil, fmt.Errorf("%w: at least one event is required", ErrInvalidWebhook)
+	}
+
+	filter := make([]EventType, 0, len(events))
+	for _, e := range events {
+		t, err := NewEventType(e)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(filter, t) {
+			filter = append(filter, t)
+		}
+	}
+	return filter, nil
+}
+
+// UpdateWebhookParams contains parameters for updating a webhook subscription.
+type UpdateWebhookParams struct {
+	ID         string
+	UpdateMask []string
+	URL        *string
+	Secret     *string
+	Events     []string
+	IsActive   *bool
+}
+
+// WebhookDeliveryStatus is the state of a single delivery attempt chain.
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"   // Waiting for a worker or a retry
+	WebhookDeliveryRunning   WebhookDeliveryStatus = "running"   // Claimed by a worker
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered" // Receiver answered 2xx
+	WebhookDeliveryDead      WebhookDeliveryStatus = "dead"      // Failed permanently or exhausted retries
+)
+
+// NewWebhookDeliveryStatus validates and creates a WebhookDeliveryStatus.
+func NewWebhookDeliveryStatus(s string) (WebhookDeliveryStatus, error) {
+	status := WebhookDeliveryStatus(s)
+	switch status {
+	case WebhookDeliveryPending, WebhookDeliveryRunning, WebhookDeliveryDelivered, WebhookDeliveryDead:
+		return status, nil
+	default:
+		return "", fmt.Errorf("%w: unknown delivery status %q", ErrInvalidWebhook, s)
+	}
+}
+
+// WebhookDelivery is one event queued for one subscription.
+//
+// Deliveries are claimed by the worker like generation jobs: retried with
+// exponential backoff on transient failures and parked as dead when the
+// receiver keeps failing. Dead deliveries can be redelivered, which queues a
+// new delivery with the same event payload.
+type WebhookDelivery struct {
+	ID             string
+	SubscriptionID string
+	EventID        string
+	EventType      EventType
+	Payload        []byte // Signed JSON body
+
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	LastError      *string
+	ResponseStatus *int
+	ClaimedBy      *string
+	AvailableAt    *time.Time
+	DeliveredAt    *time.Time
+	RedeliveryOf   *string // Delivery this one was redelivered from
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateWebhookURL(t *testing.T) {
+	assert.NoError(t, ValidateWebhookURL("https://example.com/hooks"))
+	assert.NoError(t, ValidateWebhookURL("http://203.0.113.7:8080/hooks"))
+
+	for _, raw := range []string{
+		"", "/hooks", "example.com/hooks", "ftp://example.com", "https://",
+		"http://localhost:8080/hooks", "http://api.localhost/hooks",
+		"http://127.0.0.1/hooks", "http://[::1]/hooks", "http://169.254.169.254/latest",
+		"http://10.0.0.5/hooks", "http://192.168.1.1/hooks", "http://[::ffff:172.16.0.1]/hooks",
+		"http://0.0.0.0/hooks",
+	} {
+		assert.ErrorIs(t, ValidateWebhookURL(raw), ErrInvalidWebhook, raw)
+	}
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	assert.True(t, IsPublicAddr(netip.MustParseAddr("93.184.216.34")))
+	assert.True(t, IsPublicAddr(netip.MustParseAddr("2606:2800:220:1::1")))
+
+	for _, raw := range []string{"127.0.0.1", "::1", "169.254.169.254", "fe80::1", "10.1.2.3", "172.31.0.1", "192.168.0.1", "fd00::1", "0.0.0.0", "224.0.0.1", "::ffff:127.0.0.1"} {
+		assert.False(t, IsPublicAddr(netip.MustParseAddr(raw)), raw)
+	}
+}
+
+func TestNewEventFilter(t *testing.T) {
+	filter, err := NewEventFilter([]string{"item.updated", "list.created", "item.updated"})
+	require.NoError(t, err)
+	assert.Equal(t, []EventType{EventItemUpdated, EventListCreated}, filter)
+
+	_, err = NewEventFilter(nil)
+	assert.ErrorIs(t, err, ErrInvalidWebhook)
+
+	_, err = NewEventFilter([]string{"item.created", "item.exploded"})
+	assert.ErrorIs(t, err, ErrInvalidWebhook)
+}
+
+func TestWebhookSubscription_Subscribes(t *testing.T) {
+	sub := &WebhookSubscription{Events: []EventType{EventItemCreated, EventDeadLetterCreated}}
+	assert.True(t, sub.Subscribes(EventDeadLetterCreated))
+	assert.False(t, sub.Subscribes(EventItemDeleted))
+}
+
+func TestUpdateWebhookParams_Validate(t *testing.T) {
+	url := "https://example.com/hooks"
+
+	assert.ErrorIs(t, UpdateWebhookParams{}.Validate(), ErrEmptyUpdateMask)
+	assert.ErrorIs(t, UpdateWebhookParams{UpdateMask: []string{"id"}}.Validate(), ErrUnknownField)
+	assert.ErrorIs(t, UpdateWebhookParams{UpdateMask: []string{"url"}}.Validate(), ErrInvalidWebhook)
+	assert.ErrorIs(t, UpdateWebhookParams{UpdateMask: []string{"is_active"}}.Validate(), ErrInvalidWebhook)
+	assert.NoError(t, UpdateWebhookParams{UpdateMask: []string{"url"}, URL: &url}.Validate())
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+	"github.com/rezkam/mono/internal/ptr"
+)
+
+// GetAgenda implements ServerInterface.GetAgenda.
+// GET /v1/agenda
+func (h *TodoHandler) GetAgenda(w http.ResponseWriter, r *http.Request, params openapi.GetAgendaParams) {
+	agenda, err := h.todoService.GetAgenda(r.Context(), ptr.Deref(params.Date, ""), ptr.Deref(params.Tz, ""))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to get agenda via HTTP",
+			"date", ptr.Deref(params.Date, ""),
+			"tz", ptr.Deref(params.Tz, ""),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	response.OK(w, MapAgendaToDTO(agenda))
+}
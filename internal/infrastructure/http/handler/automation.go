@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+	"github.com/rezkam/mono/internal/ptr"
+)
+
+// ListAutomationRules implements ServerInterface.ListAutomationRules.
+// GET /v1/lists/{list_id}/rules
+func (h *TodoHandler) ListAutomationRules(w http.ResponseWriter, r *http.Request, listID types.UUID) {
+	rules, err := h.todoService.ListAutomationRules(r.Context(), listID.String())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list automation rules via HTTP",
+			"list_id", listID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dtos := make([]openapi.AutomationRule, len(rules))
+	for i, rule := range rules {
+		dtos[i] = MapAutomationRuleToDTO(rule)
+	}
+
+	response.OK(w, openapi.ListAutomationRulesResponse{
+		Rules: &dtos,
+	})
+}
+
+// CreateAutomationRule implements ServerInterface.CreateAutomationRule.
+// POST /v1/lists/{list_id}/rules
+func (h *TodoHandler) CreateAutomationRule(w http.ResponseWriter, r *http.Request, listID types.UUID) {
+	var req openapi.CreateAutomationRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	trigger, err := MapRuleTriggerFromDTO(req.Trigger)
+	if err != nil {
+		response.FromDomainFieldError(w, r, err, "overdue_by")
+		return
+	}
+
+	rule := &domain.AutomationRule{
+		ListID:    listID.String(),
+		Name:      req.Name,
+		Enabled:   ptr.Deref(req.Enabled, true),
+		Trigger:   trigger,
+		Condition: MapRuleConditionFromDTO(req.Condition),
+		Actions:   MapRuleActionsFromDTO(req.Actions),
+	}
+
+	created, err := h.todoService.CreateAutomationRule(r.Context(), rule)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to create automation rule via HTTP",
+			"list_id", listID.String(),
+			"name", req.Name,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapAutomationRuleToDTO(created)
+	response.Created(w, openapi.AutomationRuleResponse{
+		Rule: &dto,
+	})
+}
+
+// GetAutomationRule implements ServerInterface.GetAutomationRule.
+// GET /v1/lists/{list_id}/rules/{rule_id}
+func (h *TodoHandler) GetAutomationRule(w http.ResponseWriter, r *http.Request, listID types.UUID, ruleID types.UUID) {
+	rule, err := h.todoService.GetAutomationRule(r.Context(), listID.String(), ruleID.String())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to get automation rule via HTTP",
+			"list_id", listID.String(),
+			"rule_id", ruleID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapAutomationRuleToDTO(rule)
+	response.OK(w, openapi.AutomationRuleResponse{
+		Rule: &dto,
+	})
+}
+
+// UpdateAutomationRule implements ServerInterface.UpdateAutomationRule.
+// PATCH /v1/lists/{list_id}/rules/{rule_id}
+func (h *TodoHandler) UpdateAutomationRule(w http.ResponseWriter, r *http.Request, listID types.UUID, ruleID types.UUID) {
+	var req openapi.UpdateAutomationRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	params := domain.UpdateAutomationRuleParams{
+		ID:         ruleID.String(),
+		ListID:     listID.String(),
+		UpdateMask: make([]string, len(req.UpdateMask)),
+		Name:       req.Name,
+		Enabled:    req.Enabled,
+	}
+	for i, field := range req.UpdateMask {
+		params.UpdateMask[i] = string(field)
+	}
+	if req.Trigger != nil {
+		trigger, err := MapRuleTriggerFromDTO(*req.Trigger)
+		if err != nil {
+			response.FromDomainFieldError(w, r, err, "overdue_by")
+			return
+		}
+		params.Trigger = &trigger
+	}
+	if req.Condition != nil {
+		condition := MapRuleConditionFromDTO(req.Condition)
+		params.Condition = &condition
+	}
+	if req.Actions != nil {
+		params.Actions = MapRuleActionsFromDTO(*req.Actions)
+	}
+
+	rule, err := h.todoService.UpdateAutomationRule(r.Context(), params)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to update automation rule via HTTP",
+			"list_id", listID.String(),
+			"rule_id", ruleID.String(),
+			"update_mask", params.UpdateMask,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapAutomationRuleToDTO(rule)
+	response.OK(w, openapi.AutomationRuleResponse{
+		Rule: &dto,
+	})
+}
+
+// DeleteAutomationRule implements ServerInterface.DeleteAutomationRule.
+// DELETE /v1/lists/{list_id}/rules/{rule_id}
+func (h *TodoHandler) DeleteAutomationRule(w http.ResponseWriter, r *http.Request, listID types.UUID, ruleID types.UUID) {
+	if err := h.todoService.DeleteAutomationRule(r.Context(), listID.String(), ruleID.String()); err != nil {
+		slog.ErrorContext(r.Context(), "failed to delete automation rule via HTTP",
+			"list_id", listID.String(),
+			"rule_id", ruleID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// ListAutomationRuleRuns implements ServerInterface.ListAutomationRuleRuns.
+// GET /v1/lists/{list_id}/rules/{rule_id}/runs
+func (h *TodoHandler) ListAutomationRuleRuns(w http.ResponseWriter, r *http.Request, listID types.UUID, ruleID types.UUID, params openapi.ListAutomationRuleRunsParams) {
+	runs, err := h.todoService.ListRuleRuns(r.Context(), listID.String(), ruleID.String(), ptr.Deref(params.Limit, 0))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list automation rule runs via HTTP",
+			"list_id", listID.String(),
+			"rule_id", ruleID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dtos := make([]openapi.AutomationRuleRun, len(runs))
+	for i, run := range runs {
+		dtos[i] = MapRuleRunToDTO(run)
+	}
+
+	response.OK(w, openapi.ListAutomationRuleRunsResponse{
+		Runs: &dtos,
+	})
+}
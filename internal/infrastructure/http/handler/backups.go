@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/rezkam/mono/internal/application/todo"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+	"github.com/rezkam/mono/internal/ptr"
+)
+
+// backupStream writes a backup as an openapi.ListBackup document while it is
+// read: the list and templates first, then the items page by page.
+type backupStream struct {
+	w       http.ResponseWriter
+	rc      *http.ResponseController
+	started bool // The status and the start of the document were sent
+	items   int
+}
+
+// WriteHeader implements todo.BackupWriter.
+func (s *backupStream) WriteHeader(backup *domain.ListBackup) error {
+	templates := make([]openapi.BackupRecurringTemplate, len(backup.Templates))
+	for i := range backup.Templates {
+		templates[i] = MapTemplateBackupToDTO(&backup.Templates[i])
+	}
+	header, err := json.Marshal(struct {
+		Version            int                               `json:"version"`
+		ExportedAt         time.Time                         `json:"exported_at"`
+		List               openapi.TodoList                  `json:"list"`
+		RecurringTemplates []openapi.BackupRecurringTemplate `json:"recurring_templates"`
+	}{
+		Version:            backup.Version,
+		ExportedAt:         backup.ExportedAt,
+		List:               MapListToDTO(&backup.List),
+		RecurringTemplates: templates,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode backup: %w", err)
+	}
+
+	// Leave the object open for the items
+	header = append(header[:len(header)-1], `,"items":[`...)
+
+	s.w.Header().Set("Content-Type", "application/json")
+	s.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="list-%s.json"`, backup.List.ID))
+	s.w.WriteHeader(http.StatusOK)
+	s.started = true
+	_, err = s.w.Write(header)
+	return err
+}
+
+// WriteItems implements todo.BackupWriter.
+func (s *backupStream) WriteItems(items []domain.ItemBackup) error {
+	for i := range items {
+		data, err := json.Marshal(MapItemBackupToDTO(&items[i]))
+		if err != nil {
+			return fmt.Errorf("failed to encode backup item: %w", err)
+		}
+		if s.items > 0 {
+			data = append([]byte{','}, data...)
+		}
+		if _, err := s.w.Write(data); err != nil {
+			return err
+		}
+		s.items++
+	}
+	// Send each page as it is written; not every writer can flush
+	_ = s.rc.Flush()
+	return nil
+}
+
+// sent implements listExport.
+func (s *backupStream) sent() bool {
+	return s.started
+}
+
+// close implements listExport.
+func (s *backupStream) close() error {
+	_, err := s.w.Write([]byte("]}"))
+	return err
+}
+
+// ExportListBackup implements ServerInterface.ExportListBackup.
+// GET /v1/lists/{list_id}/backup
+func (h *TodoHandler) ExportListBackup(w http.ResponseWriter, r *http.Request, listID types.UUID) {
+	h.exportList(w, r, listID, &backupStream{w: w, rc: http.NewResponseController(w)})
+}
+
+// listExport writes a list export as response while it is read.
+type listExport interface {
+	todo.BackupWriter
+
+	// sent reports whether the response status was sent.
+	sent() bool
+
+	// close ends the document once everything is written.
+	close() error
+}
+
+// exportList streams a list export.
+func (h *TodoHandler) exportList(w http.ResponseWriter, r *http.Request, listID types.UUID, export listExport) {
+	if err := h.todoService.ExportList(r.Context(), listID.String(), export); err != nil {
+		slog.ErrorContext(r.Context(), "failed to export list via HTTP",
+			"list_id", listID.String(),
+			"error", err)
+		// Once the status is sent the document is left incomplete: it does not
+		// parse, so a truncated export cannot be imported by mistake
+		if !export.sent() {
+			response.FromDomainError(w, r, err)
+		}
+		return
+	}
+	if err := export.close(); err != nil {
+		slog.ErrorContext(r.Context(), "failed to write list export", "list_id", listID.String(), "error", err)
+	}
+}
+
+// RestoreListBackup implements ServerInterface.RestoreListBackup.
+// POST /v1/backups/restore
+func (h *TodoHandler) RestoreListBackup(w http.ResponseWriter, r *http.Request, params openapi.RestoreListBackupParams) {
+	var req openapi.ListBackup
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	idMode, err := domain.NewBackupIDMode(string(ptr.Deref(params.IdMode, "")))
+	if err != nil {
+		response.FromDomainError(w, r, err)
+		return
+	}
+	backup, err := MapListBackupFromDTO(req)
+	if err != nil {
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dryRun := ptr.Deref(params.DryRun, false)
+	report, err := h.todoService.RestoreList(r.Context(), backup, idMode, dryRun)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to restore list via HTTP",
+			"id_mode", idMode,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	if dryRun {
+		response.OK(w, MapRestoreReportToDTO(report))
+		return
+	}
+	response.Created(w, MapRestoreReportToDTO(report))
+}
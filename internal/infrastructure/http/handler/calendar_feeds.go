@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+	"github.com/rezkam/mono/internal/infrastructure/ical"
+	"github.com/rezkam/mono/internal/ptr"
+)
+
+// ListCalendarFeeds implements ServerInterface.ListCalendarFeeds.
+// GET /v1/lists/{list_id}/feeds
+func (h *TodoHandler) ListCalendarFeeds(w http.ResponseWriter, r *http.Request, listID types.UUID) {
+	feeds, err := h.todoService.ListCalendarFeeds(r.Context(), listID.String())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list calendar feeds via HTTP",
+			"list_id", listID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dtos := make([]openapi.CalendarFeed, len(feeds))
+	for i, feed := range feeds {
+		dtos[i] = MapCalendarFeedToDTO(feed)
+	}
+
+	response.OK(w, openapi.ListCalendarFeedsResponse{
+		Feeds: &dtos,
+	})
+}
+
+// CreateCalendarFeed implements ServerInterface.CreateCalendarFeed.
+// POST /v1/lists/{list_id}/feeds
+func (h *TodoHandler) CreateCalendarFeed(w http.ResponseWriter, r *http.Request, listID types.UUID) {
+	var req openapi.CreateCalendarFeedRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	feed := &domain.CalendarFeed{
+		ListID:   listID.String(),
+		Name:     ptr.Deref(req.Name, ""),
+		Statuses: mapStatusesToStrings(req.Statuses),
+		Tags:     derefStringSlice(req.Tags),
+	}
+
+	created, err := h.todoService.CreateCalendarFeed(r.Context(), feed)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to create calendar feed via HTTP",
+			"list_id", listID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapCalendarFeedToDTO(created)
+	response.Created(w, openapi.CalendarFeedResponse{
+		Feed: &dto,
+	})
+}
+
+// DeleteCalendarFeed implements ServerInterface.DeleteCalendarFeed.
+// DELETE /v1/lists/{list_id}/feeds/{feed_id}
+func (h *TodoHandler) DeleteCalendarFeed(w http.ResponseWriter, r *http.Request, listID types.UUID, feedID types.UUID) {
+	if err := h.todoService.DeleteCalendarFeed(r.Context(), listID.String(), feedID.String()); err != nil {
+		slog.ErrorContext(r.Context(), "failed to delete calendar feed via HTTP",
+			"list_id", listID.String(),
+			"feed_id", feedID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// GetCalendarFeed implements ServerInterface.GetCalendarFeed.
+// GET /v1/feeds/{token}
+//
+// The route is exempt from API key authentication: the token in the path is
+// the credential. Unknown tokens are not logged as errors, since calendar apps
+// keep polling feeds long after they were deleted.
+func (h *TodoHandler) GetCalendarFeed(w http.ResponseWriter, r *http.Request, token string) {
+	content, err := h.todoService.GetCalendarFeedContent(r.Context(), token)
+	if err != nil {
+		if !errors.Is(err, domain.ErrCalendarFeedNotFound) {
+			slog.ErrorContext(r.Context(), "failed to get calendar feed via HTTP", "error", err)
+		}
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	name := content.List.Title
+	if content.Feed.Name != "" {
+		name = content.Feed.Name
+	}
+
+	w.Header().Set("Content-Type", ical.ContentType)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(ical.Encode(name, content.Items)); err != nil {
+		slog.ErrorContext(r.Context(), "failed to write calendar feed", "feed_id", content.Feed.ID, "error", err)
+	}
+}
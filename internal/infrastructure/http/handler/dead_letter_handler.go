@@ -129,3 +129,95 @@ func toOpenAPIDeadLetterJobs(jobs []*domain.DeadLetterJob) *[]openapi.DeadLetter
 	}
 	return &result
 }
+
+// ListDeadLetterReminders lists pending dead letter reminders.
+func (h *TodoHandler) ListDeadLetterReminders(w http.ResponseWriter, r *http.Request, params openapi.ListDeadLetterRemindersParams) {
+	// Default limit to 50
+	limit := 50
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	reminders, err := h.todoService.ListDeadLetterReminders(r.Context(), limit)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list dead letter reminders via HTTP",
+			"limit", limit,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	response.OK(w, openapi.ListDeadLetterRemindersResponse{
+		Reminders: toOpenAPIDeadLetterReminders(reminders),
+	})
+}
+
+// RetryDeadLetterReminder re-arms a dead letter reminder for delivery.
+func (h *TodoHandler) RetryDeadLetterReminder(w http.ResponseWriter, r *http.Request, id types.UUID) {
+	deadLetter, err := h.todoService.RetryDeadLetterReminder(r.Context(), id.String())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to retry dead letter reminder via HTTP",
+			"dead_letter_id", id.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	slog.InfoContext(r.Context(), "dead letter reminder retried via HTTP",
+		"dead_letter_id", id.String(),
+		"reminder_id", deadLetter.ReminderID)
+
+	reminderID, _ := uuid.Parse(deadLetter.ReminderID)
+	response.OK(w, openapi.RetryDeadLetterReminderResponse{
+		ReminderId: reminderID,
+	})
+}
+
+// DiscardDeadLetterReminder discards a dead letter reminder.
+func (h *TodoHandler) DiscardDeadLetterReminder(w http.ResponseWriter, r *http.Request, id types.UUID) {
+	var req openapi.DiscardDeadLetterReminderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.WarnContext(r.Context(), "failed to parse discard dead letter reminder request",
+			"dead_letter_id", id.String(),
+			"error", err)
+		response.FromDomainError(w, r, domain.ErrInvalidRequest)
+		return
+	}
+
+	if err := h.todoService.DiscardDeadLetterReminder(r.Context(), id.String(), req.Note); err != nil {
+		slog.ErrorContext(r.Context(), "failed to discard dead letter reminder via HTTP",
+			"dead_letter_id", id.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	slog.InfoContext(r.Context(), "dead letter reminder discarded via HTTP",
+		"dead_letter_id", id.String(),
+		"has_note", req.Note != nil)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toOpenAPIDeadLetterReminders(reminders []*domain.DeadLetterReminder) []openapi.DeadLetterReminder {
+	result := make([]openapi.DeadLetterReminder, len(reminders))
+	for i, reminder := range reminders {
+		id, _ := uuid.Parse(reminder.ID)
+		reminderID, _ := uuid.Parse(reminder.ReminderID)
+		itemID, _ := uuid.Parse(reminder.ItemID)
+		listID, _ := uuid.Parse(reminder.ListID)
+
+		result[i] = openapi.DeadLetterReminder{
+			Id:           id,
+			ReminderId:   reminderID,
+			ItemId:       itemID,
+			ListId:       listID,
+			ErrorType:    openapi.DeadLetterReminderErrorType(reminder.ErrorType),
+			ErrorMessage: ptrString(reminder.ErrorMessage),
+			RetryCount:   reminder.RetryCount,
+			LastWorkerId: ptrString(reminder.LastWorkerID),
+			FailedAt:     reminder.FailedAt,
+		}
+	}
+	return result
+}
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/rezkam/mono/internal/application/todo"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+)
+
+const (
+	// eventStreamKeepAlive is how often an idle stream sends a comment so
+	// proxies do not close the connection.
+	eventStreamKeepAlive = 15 * time.Second
+
+	// eventStreamRetry is the reconnect delay suggested to clients, in milliseconds.
+	eventStreamRetry = 3000
+)
+
+// StreamListEvents implements ServerInterface.StreamListEvents.
+// GET /v1/lists/{list_id}/events
+func (h *TodoHandler) StreamListEvents(w http.ResponseWriter, r *http.Request, listID types.UUID, params openapi.StreamListEventsParams) {
+	h.streamEvents(w, r, listID.String(), params.LastEventID)
+}
+
+// StreamEvents implements ServerInterface.StreamEvents.
+// GET /v1/events
+func (h *TodoHandler) StreamEvents(w http.ResponseWriter, r *http.Request, params openapi.StreamEventsParams) {
+	h.streamEvents(w, r, "", params.LastEventID)
+}
+
+// streamEvents writes changes as server-sent events until the client disconnects
+// or the stream ends. listID is empty to stream every accessible list.
+func (h *TodoHandler) streamEvents(w http.ResponseWriter, r *http.Request, listID string, lastEventID *string) {
+	var lastID string
+	if lastEventID != nil {
+		lastID = *lastEventID
+	}
+	after, err := todo.ParseLastEventID(lastID)
+	if err != nil {
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	changes, err := h.todoService.StreamChanges(r.Context(), listID, after)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to open event stream via HTTP",
+			"list_id", listID,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	// The stream outlives the server write timeout
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		slog.WarnContext(r.Context(), "failed to clear write deadline for event stream", "error", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", eventStreamRetry)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	keepAlive := time.NewTicker(eventStreamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+
+		case streamed, ok := <-changes:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(MapChangeToDTO(streamed.Change))
+			if err != nil {
+				slog.ErrorContext(r.Context(), "failed to encode change event",
+					"seq", streamed.Change.Seq,
+					"error", err)
+				return
+			}
+			fmt.Fprintf(w, "id: %s\nevent: %s.%s\ndata: %s\n\n",
+				todo.EventID(streamed.Cursor), streamed.Change.EntityType, streamed.Change.Operation, data)
+
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keepalive\n\n")
+		}
+
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/taskwarrior"
+	"github.com/rezkam/mono/internal/infrastructure/todotxt"
+)
+
+// ExportTodoTxt implements ServerInterface.ExportTodoTxt.
+// GET /v1/lists/{list_id}/export/todotxt
+func (h *TodoHandler) ExportTodoTxt(w http.ResponseWriter, r *http.Request, listID types.UUID) {
+	h.exportList(w, r, listID, &todoTxtStream{w: w, rc: http.NewResponseController(w)})
+}
+
+// ExportTaskwarrior implements ServerInterface.ExportTaskwarrior.
+// GET /v1/lists/{list_id}/export/taskwarrior
+func (h *TodoHandler) ExportTaskwarrior(w http.ResponseWriter, r *http.Request, listID types.UUID) {
+	h.exportList(w, r, listID, &taskwarriorStream{w: w, rc: http.NewResponseController(w)})
+}
+
+// todoTxtStream writes the items of a list as todo.txt lines, page by page.
+type todoTxtStream struct {
+	w       http.ResponseWriter
+	rc      *http.ResponseController
+	started bool
+}
+
+// WriteHeader implements todo.BackupWriter.
+func (s *todoTxtStream) WriteHeader(backup *domain.ListBackup) error {
+	s.w.Header().Set("Content-Type", todotxt.ContentType)
+	s.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="list-%s.txt"`, backup.List.ID))
+	s.w.WriteHeader(http.StatusOK)
+	s.started = true
+	return nil
+}
+
+// WriteItems implements todo.BackupWriter.
+func (s *todoTxtStream) WriteItems(items []domain.ItemBackup) error {
+	page := make([]domain.TodoItem, len(items))
+	for i := range items {
+		page[i] = items[i].Item
+	}
+	if _, err := s.w.Write(todotxt.Encode(page)); err != nil {
+		return err
+	}
+	_ = s.rc.Flush()
+	return nil
+}
+
+// sent implements listExport.
+func (s *todoTxtStream) sent() bool {
+	return s.started
+}
+
+// close implements listExport.
+func (s *todoTxtStream) close() error {
+	return nil
+}
+
+// taskwarriorStream writes the items of a list as a JSON array of Taskwarrior
+// tasks, page by page, and its recurring templates last: each continues after
+// the last of its occurrences in the export.
+type taskwarriorStream struct {
+	w         http.ResponseWriter
+	rc        *http.ResponseController
+	started   bool
+	tasks     int
+	templates []domain.RecurringTemplate
+	latest    map[string]time.Time // Template ID → last exported occurrence
+}
+
+// WriteHeader implements todo.BackupWriter.
+func (s *taskwarriorStream) WriteHeader(backup *domain.ListBackup) error {
+	s.latest = make(map[string]time.Time, len(backup.Templates))
+	for _, t := range backup.Templates {
+		s.templates = append(s.templates, t.Template)
+	}
+
+	s.w.Header().Set("Content-Type", taskwarrior.ContentType)
+	s.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="list-%s.json"`, backup.List.ID))
+	s.w.WriteHeader(http.StatusOK)
+	s.started = true
+	_, err := s.w.Write([]byte("["))
+	return err
+}
+
+// WriteItems implements todo.BackupWriter.
+func (s *taskwarriorStream) WriteItems(items []domain.ItemBackup) error {
+	for _, itemBackup := range items {
+		item := itemBackup.Item
+		if item.RecurringTemplateID != nil && item.OccursAt != nil {
+			if item.OccursAt.After(s.latest[*item.RecurringTemplateID]) {
+				s.latest[*item.RecurringTemplateID] = *item.OccursAt
+			}
+		}
+		if err := s.write(taskwarrior.EncodeItem(item)); err != nil {
+			return err
+		}
+	}
+	_ = s.rc.Flush()
+	return nil
+}
+
+// write appends a task to the array.
+func (s *taskwarriorStream) write(task taskwarrior.Task) error {
+	data, err := json.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("failed to encode task: %w", err)
+	}
+	if s.tasks > 0 {
+		data = append([]byte{','}, data...)
+	}
+	if _, err := s.w.Write(data); err != nil {
+		return err
+	}
+	s.tasks++
+	return nil
+}
+
+// sent implements listExport.
+func (s *taskwarriorStream) sent() bool {
+	return s.started
+}
+
+// close implements listExport.
+func (s *taskwarriorStream) close() error {
+	for _, template := range s.templates {
+		// Without exported occurrences, continue after what was generated
+		after, ok := s.latest[template.ID]
+		if !ok {
+			after = template.GeneratedThrough
+		}
+		task, ok := taskwarrior.EncodeTemplate(template, after)
+		if !ok {
+			continue
+		}
+		if err := s.write(task); err != nil {
+			return err
+		}
+	}
+	_, err := s.w.Write([]byte("]"))
+	return err
+}
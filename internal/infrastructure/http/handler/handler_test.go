@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rezkam/mono/internal/application/todo"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newScopedTestRouter mounts the OpenAPI router under /api the same way the server does.
+// Repository calls panic, so only requests rejected before reaching the service may use it.
+func newScopedTestRouter(t *testing.T) http.Handler {
+	t.Helper()
+
+	service := todo.NewService(&stubRepository{}, &stubGenerator{}, todo.Config{})
+	apiRouter, err := NewOpenAPIRouter(service, &stubCoordinator{})
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Mount("/api", apiRouter)
+	return r
+}
+
+func doScopedRequest(t *testing.T, router http.Handler, scopes []domain.Scope, method, path string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&buf).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buf)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	ctx := domain.WithPrincipal(context.Background(), domain.Principal{
+		KeyID:   "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a50",
+		OwnerID: "018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a5b",
+		Scopes:  scopes,
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req.WithContext(ctx))
+	return w
+}
+
+func TestOpenAPIRouter_InsufficientScopeReturnsForbidden(t *testing.T) {
+	router := newScopedTestRouter(t)
+
+	tests := []struct {
+		name         string
+		scopes       []domain.Scope
+		method       string
+		path         string
+		body         any
+		missingScope string
+	}{
+		{
+			name:         "read-only key creates list",
+			scopes:       domain.ReadOnlyScopes(),
+			method:       http.MethodPost,
+			path:         "/api/v1/lists",
+			body:         map[string]any{"title": "groceries"},
+			missingScope: "lists:write",
+		},
+		{
+			name:         "read-only key deletes item",
+			scopes:       domain.ReadOnlyScopes(),
+			method:       http.MethodDelete,
+			path:         "/api/v1/lists/018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a51/items/018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a52",
+			missingScope: "items:write",
+		},
+		{
+			name:         "lists-only key reads items",
+			scopes:       []domain.Scope{domain.ScopeListsRead},
+			method:       http.MethodGet,
+			path:         "/api/v1/lists/018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a51/items",
+			missingScope: "items:read",
+		},
+		{
+			name:         "read-write key lists dead letter jobs",
+			scopes:       domain.ReadWriteScopes(),
+			method:       http.MethodGet,
+			path:         "/api/v1/admin/dead-letter-jobs",
+			missingScope: "admin",
+		},
+		{
+			name:         "read-write key lists webhooks",
+			scopes:       domain.ReadWriteScopes(),
+			method:       http.MethodGet,
+			path:         "/api/v1/webhooks",
+			missingScope: "webhooks:read",
+		},
+		{
+			name:         "items-only key renames tag",
+			scopes:       []domain.Scope{domain.ScopeItemsRead, domain.ScopeItemsWrite},
+			method:       http.MethodPost,
+			path:         "/api/v1/lists/018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a51/tags/wip/rename",
+			body:         map[string]any{"name": "doing"},
+			missingScope: "lists:write",
+		},
+		{
+			name:         "items-only key merges tags",
+			scopes:       []domain.Scope{domain.ScopeItemsRead, domain.ScopeItemsWrite},
+			method:       http.MethodPost,
+			path:         "/api/v1/lists/018f1a2b-3c4d-7e5f-8a9b-0c1d2e3f4a51/tags/chore/merge",
+			body:         map[string]any{"sources": []string{"errand"}},
+			missingScope: "lists:write",
+		},
+		{
+			name:         "invalid body does not hide missing scope",
+			scopes:       domain.ReadOnlyScopes(),
+			method:       http.MethodPost,
+			path:         "/api/v1/lists",
+			body:         map[string]any{"title": ""},
+			missingScope: "lists:write",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doScopedRequest(t, router, tt.scopes, tt.method, tt.path, tt.body)
+
+			require.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+
+			var resp struct {
+				Error struct {
+					Code    string `json:"code"`
+					Message string `json:"message"`
+					Details []struct {
+						Field string `json:"field"`
+						Issue string `json:"issue"`
+					} `json:"details"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, "FORBIDDEN", resp.Error.Code)
+			require.Len(t, resp.Error.Details, 1)
+			assert.Equal(t, "scope", resp.Error.Details[0].Field)
+			assert.Equal(t, "missing required scope: "+tt.missingScope, resp.Error.Details[0].Issue)
+		})
+	}
+}
+
+func TestOpenAPIRouter_AdminScopeAllowsDeadLetterJobs(t *testing.T) {
+	router := newScopedTestRouter(t)
+
+	w := doScopedRequest(t, router, domain.AdminScopes(), http.MethodGet, "/api/v1/admin/dead-letter-jobs", nil)
+
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+}
+
+func TestOpenAPIRouter_MissingPrincipalIsForbidden(t *testing.T) {
+	router := newScopedTestRouter(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/dead-letter-jobs", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+}
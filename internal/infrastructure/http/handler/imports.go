@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+	"github.com/rezkam/mono/internal/infrastructure/ical"
+	"github.com/rezkam/mono/internal/infrastructure/taskwarrior"
+	"github.com/rezkam/mono/internal/infrastructure/todotxt"
+	"github.com/rezkam/mono/internal/ptr"
+)
+
+// ImportCalendar implements ServerInterface.ImportCalendar.
+// POST /v1/lists/{list_id}/import/ical
+func (h *TodoHandler) ImportCalendar(w http.ResponseWriter, r *http.Request, listID types.UUID, params openapi.ImportCalendarParams) {
+	h.importFile(w, r, listID, ptr.Deref(params.DryRun, false), "ical", ical.Decode)
+}
+
+// ImportTodoTxt implements ServerInterface.ImportTodoTxt.
+// POST /v1/lists/{list_id}/import/todotxt
+func (h *TodoHandler) ImportTodoTxt(w http.ResponseWriter, r *http.Request, listID types.UUID, params openapi.ImportTodoTxtParams) {
+	h.importFile(w, r, listID, ptr.Deref(params.DryRun, false), "todotxt", todotxt.Decode)
+}
+
+// ImportTaskwarrior implements ServerInterface.ImportTaskwarrior.
+// POST /v1/lists/{list_id}/import/taskwarrior
+func (h *TodoHandler) ImportTaskwarrior(w http.ResponseWriter, r *http.Request, listID types.UUID, params openapi.ImportTaskwarriorParams) {
+	h.importFile(w, r, listID, ptr.Deref(params.DryRun, false), "taskwarrior", taskwarrior.Decode)
+}
+
+// importFile imports the entries decode reads from the request body into a list.
+func (h *TodoHandler) importFile(
+	w http.ResponseWriter,
+	r *http.Request,
+	listID types.UUID,
+	dryRun bool,
+	format string,
+	decode func(data []byte) ([]domain.ImportEntry, error),
+) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.BadRequest(w, "failed to read request body")
+		return
+	}
+
+	entries, err := decode(data)
+	if err != nil {
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	report, err := h.todoService.ImportEntries(r.Context(), listID.String(), entries, dryRun)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to import file via HTTP",
+			"list_id", listID.String(),
+			"format", format,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	response.OK(w, MapImportReportToDTO(report))
+}
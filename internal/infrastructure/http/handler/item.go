@@ -2,14 +2,17 @@ package handler
 
 import (
 	"encoding/json"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 
 	"github.com/oapi-codegen/runtime/types"
 
 	"github.com/rezkam/mono/internal/domain"
 	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
 	"github.com/rezkam/mono/internal/infrastructure/http/response"
+	"github.com/rezkam/mono/internal/ptr"
 )
 
 // CreateItem implements ServerInterface.CreateItem.
@@ -24,11 +27,12 @@ func (h *TodoHandler) CreateItem(w http.ResponseWriter, r *http.Request, listID
 
 	// Build domain item from request
 	item := &domain.TodoItem{
-		Title:    req.Title,
-		ListID:   listID.String(),
-		Tags:     derefStringSlice(req.Tags),
-		Timezone: normalizeTimezone(req.Timezone),
-		DueAt:    req.DueAt,
+		Title:        req.Title,
+		ListID:       listID.String(),
+		Tags:         derefStringSlice(req.Tags),
+		Timezone:     normalizeTimezone(req.Timezone),
+		DueAt:        req.DueAt,
+		CustomFields: MapCustomFieldValuesFromDTO(req.CustomFields),
 	}
 
 	// Parse estimated_duration if provided
@@ -200,6 +204,8 @@ func (h *TodoHandler) UpdateItem(w http.ResponseWriter, r *http.Request, listID
 				duration := d.Value()
 				params.DueOffset = &duration
 			}
+		case "custom_fields":
+			params.CustomFields = MapCustomFieldValuesFromDTO(req.Item.CustomFields)
 		}
 	}
 
@@ -248,23 +254,110 @@ func (h *TodoHandler) DeleteItem(w http.ResponseWriter, r *http.Request, listID
 	response.NoContent(w)
 }
 
+// SnoozeItem implements ServerInterface.SnoozeItem.
+// POST /v1/lists/{list_id}/items/{item_id}/snooze
+func (h *TodoHandler) SnoozeItem(w http.ResponseWriter, r *http.Request, listID types.UUID, itemID types.UUID) {
+	var req openapi.SnoozeItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	snoozed, err := h.todoService.SnoozeItem(r.Context(), listID.String(), itemID.String(), req.Until)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to snooze item via HTTP",
+			"list_id", listID.String(),
+			"item_id", itemID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	slog.InfoContext(r.Context(), "item snoozed via HTTP",
+		"item_id", itemID.String(),
+		"list_id", listID.String(),
+		"until", req.Until)
+
+	itemDTO := MapItemToDTO(snoozed)
+	response.OK(w, openapi.UpdateItemResponse{
+		Item: &itemDTO,
+	})
+}
+
+// WakeItem implements ServerInterface.WakeItem.
+// DELETE /v1/lists/{list_id}/items/{item_id}/snooze
+func (h *TodoHandler) WakeItem(w http.ResponseWriter, r *http.Request, listID types.UUID, itemID types.UUID) {
+	woken, err := h.todoService.WakeItem(r.Context(), listID.String(), itemID.String())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to wake item via HTTP",
+			"list_id", listID.String(),
+			"item_id", itemID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	itemDTO := MapItemToDTO(woken)
+	response.OK(w, openapi.UpdateItemResponse{
+		Item: &itemDTO,
+	})
+}
+
 // ListItems implements ServerInterface.ListItems.
 // GET /v1/lists/{list_id}/items
 func (h *TodoHandler) ListItems(w http.ResponseWriter, r *http.Request, listID types.UUID, params openapi.ListItemsParams) {
-	offset, err := parsePageToken(params.PageToken)
+	listIDStr := listID.String()
+	h.findItems(w, r, domain.ListTasksParams{ListID: &listIDStr}, params)
+}
+
+// SearchItems implements ServerInterface.SearchItems.
+// GET /v1/items
+func (h *TodoHandler) SearchItems(w http.ResponseWriter, r *http.Request, params openapi.SearchItemsParams) {
+	// Without list_id, the search covers every list the caller can access
+	var scope domain.ListTasksParams
+	if params.ListId != nil {
+		for _, id := range *params.ListId {
+			scope.ListIDs = append(scope.ListIDs, id.String())
+		}
+	}
+
+	h.findItems(w, r, scope, openapi.ListItemsParams{
+		Status:            params.Status,
+		Priority:          params.Priority,
+		Tags:              params.Tags,
+		CustomField:       params.CustomField,
+		Filter:            params.Filter,
+		DueBefore:         params.DueBefore,
+		DueAfter:          params.DueAfter,
+		UpdatedAfter:      params.UpdatedAfter,
+		CreatedAfter:      params.CreatedAfter,
+		IncludeSnoozed:    params.IncludeSnoozed,
+		SortBy:            params.SortBy,
+		SortDir:           (*openapi.ListItemsParamsSortDir)(params.SortDir),
+		PageSize:          params.PageSize,
+		PageToken:         params.PageToken,
+		IncludeTotalCount: params.IncludeTotalCount,
+	})
+}
+
+// findItems runs an item search over the lists of scope with the filters,
+// sort and page of params. listItems and searchItems share the same parameters.
+func (h *TodoHandler) findItems(w http.ResponseWriter, r *http.Request, scope domain.ListTasksParams, params openapi.ListItemsParams) {
+	customFields, err := parseCustomFieldFilters(params.CustomField)
 	if err != nil {
 		response.FromDomainError(w, r, err)
 		return
 	}
-	listIDStr := listID.String()
 
 	// Map OpenAPI params to domain filter input - just pass through values
 	filterInput := domain.ItemsFilterInput{
-		Statuses:   mapStatusesToStrings(params.Status),
-		Priorities: mapPrioritiesToStrings(params.Priority),
-		Tags:       derefStringSlice(params.Tags),
-		OrderBy:    mapSortByToString(params.SortBy),
-		OrderDir:   mapSortDirToString(params.SortDir),
+		Statuses:     mapStatusesToStrings(params.Status),
+		Priorities:   mapPrioritiesToStrings(params.Priority),
+		Tags:         derefStringSlice(params.Tags),
+		CustomFields: customFields,
+		Expression:   params.Filter,
+		OrderBy:      params.SortBy,
+		OrderDir:     mapSortDirToString(params.SortDir),
 	}
 
 	// Create validated filter - domain layer validates all fields
@@ -276,18 +369,32 @@ func (h *TodoHandler) ListItems(w http.ResponseWriter, r *http.Request, listID t
 
 	// Build domain params with validated filter
 	domainParams := domain.ListTasksParams{
-		Limit:  getPageSize(params.PageSize),
-		Offset: offset,
-		ListID: &listIDStr,
-		Filter: filter,
+		ListID:            scope.ListID,
+		ListIDs:           scope.ListIDs,
+		Filter:            filter,
+		DueBefore:         params.DueBefore,
+		DueAfter:          params.DueAfter,
+		UpdatedAfter:      params.UpdatedAfter,
+		CreatedAfter:      params.CreatedAfter,
+		ExcludeSnoozed:    !ptr.Deref(params.IncludeSnoozed, false),
+		Limit:             getPageSize(params.PageSize),
+		IncludeTotalCount: ptr.Deref(params.IncludeTotalCount, false),
+	}
+
+	// The page token must belong to these lists, sort and filters
+	query := itemsQueryFingerprint(domainParams)
+	domainParams.After, err = parsePageToken(params.PageToken, query)
+	if err != nil {
+		response.FromDomainError(w, r, err)
+		return
 	}
 
 	// Call service layer
 	result, err := h.todoService.ListItems(r.Context(), domainParams)
 	if err != nil {
 		slog.ErrorContext(r.Context(), "failed to list items via HTTP",
-			"list_id", listID.String(),
-			"offset", offset,
+			"list_id", ptr.Deref(scope.ListID, ""),
+			"list_ids", scope.ListIDs,
 			"limit", domainParams.Limit,
 			"error", err)
 		response.FromDomainError(w, r, err)
@@ -301,17 +408,18 @@ func (h *TodoHandler) ListItems(w http.ResponseWriter, r *http.Request, listID t
 	}
 
 	// Generate next page token based on repository result
-	nextToken := generatePageToken(offset+len(result.Items), result.HasMore)
+	nextToken := generatePageToken(query, result.NextCursor)
 
 	// Return success response
 	response.OK(w, openapi.ListItemsResponse{
 		Items:         &itemDTOs,
 		NextPageToken: nextToken,
+		TotalCount:    result.TotalCount,
 	})
 }
 
 // mapStatusesToStrings converts OpenAPI status slice to string slice
-func mapStatusesToStrings(statuses *[]openapi.ListItemsParamsStatus) []string {
+func mapStatusesToStrings(statuses *[]openapi.ItemStatus) []string {
 	if statuses == nil {
 		return nil
 	}
@@ -323,7 +431,7 @@ func mapStatusesToStrings(statuses *[]openapi.ListItemsParamsStatus) []string {
 }
 
 // mapPrioritiesToStrings converts OpenAPI priority slice to string slice
-func mapPrioritiesToStrings(priorities *[]openapi.ListItemsParamsPriority) []string {
+func mapPrioritiesToStrings(priorities *[]openapi.ItemPriority) []string {
 	if priorities == nil {
 		return nil
 	}
@@ -334,13 +442,24 @@ func mapPrioritiesToStrings(priorities *[]openapi.ListItemsParamsPriority) []str
 	return result
 }
 
-// mapSortByToString converts OpenAPI sort_by to string pointer
-func mapSortByToString(sortBy *openapi.ListItemsParamsSortBy) *string {
-	if sortBy == nil {
-		return nil
+// parseCustomFieldFilters converts "name:value" query values to a name → value map.
+// A field may only be filtered once.
+func parseCustomFieldFilters(filters *[]string) (map[string]string, error) {
+	if filters == nil {
+		return nil, nil
 	}
-	s := string(*sortBy)
-	return &s
+	result := make(map[string]string, len(*filters))
+	for _, f := range *filters {
+		name, value, ok := strings.Cut(f, ":")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("%w: expected name:value, got %q", domain.ErrInvalidCustomFieldFilter, f)
+		}
+		if _, exists := result[name]; exists {
+			return nil, fmt.Errorf("%w: duplicate filter for %q", domain.ErrInvalidCustomFieldFilter, name)
+		}
+		result[name] = value
+	}
+	return result, nil
 }
 
 // mapSortDirToString converts OpenAPI sort_dir to string pointer
@@ -10,6 +10,7 @@ import (
 	"github.com/rezkam/mono/internal/domain"
 	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
 	"github.com/rezkam/mono/internal/infrastructure/http/response"
+	"github.com/rezkam/mono/internal/ptr"
 )
 
 // CreateList implements ServerInterface.CreateList.
@@ -22,8 +23,8 @@ func (h *TodoHandler) CreateList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Call service layer (validation happens here via value objects in future)
-	list, err := h.todoService.CreateList(r.Context(), req.Title)
+	// Call service layer (title and custom field schema are validated there)
+	list, err := h.todoService.CreateListWithCustomFields(r.Context(), req.Title, MapCustomFieldDefinitionsFromDTO(req.CustomFields))
 	if err != nil {
 		slog.ErrorContext(r.Context(), "failed to create list via HTTP",
 			"title", req.Title,
@@ -66,16 +67,68 @@ func (h *TodoHandler) GetList(w http.ResponseWriter, r *http.Request, id types.U
 	})
 }
 
-// ListLists implements ServerInterface.ListLists.
-// GET /v1/lists
-func (h *TodoHandler) ListLists(w http.ResponseWriter, r *http.Request, params openapi.ListListsParams) {
-	// Build domain params from query params
-	offset, err := parsePageToken(params.PageToken)
+// UpdateList implements ServerInterface.UpdateList.
+// PATCH /v1/lists/{id}
+func (h *TodoHandler) UpdateList(w http.ResponseWriter, r *http.Request, id types.UUID) {
+	// Parse request body
+	var req openapi.UpdateListRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	// Build UpdateListParams from request
+	// Note: list and update_mask are required by OpenAPI spec
+	params := domain.UpdateListParams{
+		ListID: id.String(),
+		Etag:   req.List.Etag,
+	}
+
+	// Convert update_mask enum values to strings
+	// OpenAPI validates that only allowed field names are present
+	params.UpdateMask = make([]string, len(req.UpdateMask))
+	for i, m := range req.UpdateMask {
+		params.UpdateMask[i] = string(m)
+	}
+
+	// Map field values from request to params based on update_mask
+	for _, field := range params.UpdateMask {
+		switch field {
+		case "title":
+			params.Title = req.List.Title
+		case "custom_fields":
+			schema := domain.CustomFieldSchema(MapCustomFieldDefinitionsFromDTO(req.List.CustomFields))
+			params.CustomFieldSchema = &schema
+		}
+	}
+
+	// Call service layer - returns updated list
+	updated, err := h.todoService.UpdateList(r.Context(), params)
 	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to update list via HTTP",
+			"list_id", id.String(),
+			"update_mask", params.UpdateMask,
+			"error", err)
 		response.FromDomainError(w, r, err)
 		return
 	}
 
+	slog.InfoContext(r.Context(), "list updated via HTTP",
+		"list_id", id.String(),
+		"update_mask", params.UpdateMask)
+
+	// Map domain model to DTO
+	listDTO := MapListToDTO(updated)
+
+	// Return success response
+	response.OK(w, openapi.UpdateListResponse{
+		List: &listDTO,
+	})
+}
+
+// ListLists implements ServerInterface.ListLists.
+// GET /v1/lists
+func (h *TodoHandler) ListLists(w http.ResponseWriter, r *http.Request, params openapi.ListListsParams) {
 	// Validate sorting parameters
 	var sortBy, sortDir *string
 	if params.SortBy != nil {
@@ -100,9 +153,9 @@ func (h *TodoHandler) ListLists(w http.ResponseWriter, r *http.Request, params o
 	}
 
 	filterParams := domain.ListListsParams{
-		Limit:   getPageSize(params.PageSize),
-		Offset:  offset,
-		Sorting: sorting,
+		Limit:             getPageSize(params.PageSize),
+		IncludeTotalCount: ptr.Deref(params.IncludeTotalCount, false),
+		Sorting:           sorting,
 	}
 
 	// Set filter parameters if specified
@@ -116,11 +169,18 @@ func (h *TodoHandler) ListLists(w http.ResponseWriter, r *http.Request, params o
 		filterParams.CreatedAtBefore = params.CreatedBefore
 	}
 
+	// The page token must belong to this sort and these filters
+	query := listsQueryFingerprint(filterParams)
+	filterParams.After, err = parsePageToken(params.PageToken, query)
+	if err != nil {
+		response.FromDomainError(w, r, err)
+		return
+	}
+
 	// Call service layer with filters and sorting
 	result, err := h.todoService.FindLists(r.Context(), filterParams)
 	if err != nil {
 		slog.ErrorContext(r.Context(), "failed to list lists via HTTP",
-			"offset", offset,
 			"limit", filterParams.Limit,
 			"error", err)
 		response.FromDomainError(w, r, err)
@@ -134,11 +194,12 @@ func (h *TodoHandler) ListLists(w http.ResponseWriter, r *http.Request, params o
 	}
 
 	// Generate next page token based on repository result
-	nextToken := generatePageToken(offset+len(result.Lists), result.HasMore)
+	nextToken := generatePageToken(query, result.NextCursor)
 
 	// Return success response
 	response.OK(w, openapi.ListListsResponse{
 		Lists:         &listDTOs,
 		NextPageToken: nextToken,
+		TotalCount:    result.TotalCount,
 	})
 }
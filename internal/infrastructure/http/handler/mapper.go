@@ -2,6 +2,7 @@ package handler
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/rezkam/mono/internal/domain"
 	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/ptr"
 )
 
 // Helper functions for pointer conversion
@@ -59,18 +61,78 @@ func ptrDate(t *time.Time) *types.Date {
 	return &date
 }
 
+// ptrCustomFieldValues always returns a non-nil object so clients see {} when no values are set.
+func ptrCustomFieldValues(values map[string]any) *openapi.CustomFieldValues {
+	dto := openapi.CustomFieldValues{}
+	for name, value := range values {
+		dto[name] = value
+	}
+	return &dto
+}
+
 // Domain → DTO mappers
 
 // MapListToDTO converts domain.TodoList to openapi.TodoList.
 // Note: Items are fetched separately via GET /v1/lists/{list_id}/items
 func MapListToDTO(list *domain.TodoList) openapi.TodoList {
+	etag := list.Etag()
+	customFields := MapCustomFieldSchemaToDTO(list.CustomFieldSchema)
 	return openapi.TodoList{
-		Id:          ptrUUID(list.ID),
-		Title:       ptrString(list.Title),
-		CreatedAt:   ptrTime(list.CreatedAt),
-		TotalItems:  ptrInt(list.TotalItems),
-		UndoneItems: ptrInt(list.UndoneItems),
+		Id:           ptrUUID(list.ID),
+		Title:        ptrString(list.Title),
+		CreatedAt:    ptrTime(list.CreatedAt),
+		TotalItems:   ptrInt(list.TotalItems),
+		UndoneItems:  ptrInt(list.UndoneItems),
+		CustomFields: &customFields,
+		Etag:         &etag,
+	}
+}
+
+// MapCustomFieldSchemaToDTO converts a domain custom field schema to openapi definitions.
+func MapCustomFieldSchemaToDTO(schema domain.CustomFieldSchema) []openapi.CustomFieldDefinition {
+	dtos := make([]openapi.CustomFieldDefinition, len(schema))
+	for i, def := range schema {
+		dtos[i] = openapi.CustomFieldDefinition{
+			Name: def.Name,
+			Type: openapi.CustomFieldType(def.Type),
+		}
+		if len(def.Options) > 0 {
+			options := def.Options
+			dtos[i].Options = &options
+		}
+	}
+	return dtos
+}
+
+// MapCustomFieldDefinitionsFromDTO converts openapi definitions to unvalidated domain definitions.
+// Validation happens in the service layer via domain.NewCustomFieldSchema.
+func MapCustomFieldDefinitionsFromDTO(dtos *[]openapi.CustomFieldDefinition) []domain.CustomFieldDefinition {
+	if dtos == nil {
+		return nil
+	}
+	defs := make([]domain.CustomFieldDefinition, len(*dtos))
+	for i, dto := range *dtos {
+		defs[i] = domain.CustomFieldDefinition{
+			Name: dto.Name,
+			Type: domain.CustomFieldType(dto.Type),
+		}
+		if dto.Options != nil {
+			defs[i].Options = *dto.Options
+		}
+	}
+	return defs
+}
+
+// MapCustomFieldValuesFromDTO converts openapi custom field values to a domain map.
+func MapCustomFieldValuesFromDTO(dto *openapi.CustomFieldValues) map[string]any {
+	if dto == nil {
+		return nil
 	}
+	values := make(map[string]any, len(*dto))
+	for name, value := range *dto {
+		values[name] = value
+	}
+	return values
 }
 
 // MapItemToDTO converts domain.TodoItem to openapi.TodoItem.
@@ -95,6 +157,8 @@ func MapItemToDTO(item *domain.TodoItem) openapi.TodoItem {
 		}(),
 		InstanceDate: item.OccursAt,
 		Timezone:     item.Timezone,
+		SnoozedUntil: item.SnoozedUntil,
+		CustomFields: ptrCustomFieldValues(item.CustomFields),
 		Etag:         &etag,
 	}
 
@@ -113,6 +177,156 @@ func MapItemToDTO(item *domain.TodoItem) openapi.TodoItem {
 	return dto
 }
 
+// MapAgendaToDTO converts domain.Agenda to openapi.AgendaResponse.
+// Empty groups are returned as [] rather than null.
+func MapAgendaToDTO(agenda *domain.Agenda) openapi.AgendaResponse {
+	return openapi.AgendaResponse{
+		Date:      types.Date{Time: agenda.Day},
+		Timezone:  agenda.Day.Location().String(),
+		Overdue:   mapAgendaEntriesToDTO(agenda.Overdue),
+		Today:     mapAgendaEntriesToDTO(agenda.Today),
+		Upcoming:  mapAgendaEntriesToDTO(agenda.Upcoming),
+		Truncated: agenda.Truncated,
+	}
+}
+
+func mapAgendaEntriesToDTO(entries []domain.AgendaEntry) []openapi.AgendaEntry {
+	dtos := make([]openapi.AgendaEntry, len(entries))
+	for i, entry := range entries {
+		dtos[i] = openapi.AgendaEntry{
+			Item:   MapItemToDTO(&entry.Item),
+			At:     entry.At,
+			AllDay: entry.AllDay,
+		}
+	}
+	return dtos
+}
+
+// MapListStatsToDTO converts domain.ListStats to openapi.ListStats.
+// Durations are ISO 8601; percentiles and ratios without samples are omitted.
+func MapListStatsToDTO(stats *domain.ListStats) openapi.ListStats {
+	dto := openapi.ListStats{
+		From:      stats.From,
+		To:        stats.To,
+		Total:     mapFlowStatsToDTO(stats.Total),
+		Groups:    make([]openapi.FlowStats, len(stats.Groups)),
+		Truncated: stats.Truncated,
+	}
+	if stats.GroupBy != domain.StatsGroupByNone {
+		groupBy := openapi.StatsGroupBy(stats.GroupBy)
+		dto.GroupBy = &groupBy
+	}
+	for i, group := range stats.Groups {
+		dto.Groups[i] = mapFlowStatsToDTO(group)
+	}
+	return dto
+}
+
+func mapFlowStatsToDTO(stats domain.FlowStats) openapi.FlowStats {
+	dto := openapi.FlowStats{
+		Key:       stats.Key,
+		Created:   stats.Created,
+		Completed: stats.Completed,
+		LeadTime:  mapDurationStatsToDTO(stats.LeadTime),
+		CycleTime: mapDurationStatsToDTO(stats.CycleTime),
+		TimeInStatus: openapi.TimeInStatus{
+			Todo:       domain.FormatDurationISO8601(stats.TimeInStatus[domain.TaskStatusTodo]),
+			InProgress: domain.FormatDurationISO8601(stats.TimeInStatus[domain.TaskStatusInProgress]),
+			Blocked:    domain.FormatDurationISO8601(stats.TimeInStatus[domain.TaskStatusBlocked]),
+		},
+		Estimates: openapi.EstimateAccuracy{
+			Items:          stats.Estimates.Items,
+			EstimatedTotal: domain.FormatDurationISO8601(stats.Estimates.Estimated),
+			ActualTotal:    domain.FormatDurationISO8601(stats.Estimates.Actual),
+			Accurate:       stats.Estimates.Accurate,
+		},
+	}
+	if stats.Estimates.Items > 0 {
+		dto.Estimates.Ratio = &stats.Estimates.Ratio
+		dto.Estimates.MedianRatio = &stats.Estimates.MedianRatio
+	}
+	return dto
+}
+
+func mapDurationStatsToDTO(stats domain.DurationStats) openapi.DurationStats {
+	dto := openapi.DurationStats{Count: stats.Count}
+	if stats.Count > 0 {
+		dto.P50 = ptrDuration(&stats.P50)
+		dto.P85 = ptrDuration(&stats.P85)
+		dto.P95 = ptrDuration(&stats.P95)
+	}
+	return dto
+}
+
+// MapSnapshotSeriesToDTO converts domain.SnapshotSeries to openapi.ListSnapshots.
+func MapSnapshotSeriesToDTO(series *domain.SnapshotSeries) openapi.ListSnapshots {
+	dto := openapi.ListSnapshots{
+		From:      types.Date{Time: series.First},
+		To:        types.Date{Time: series.Last},
+		Snapshots: make([]openapi.ListSnapshot, len(series.Snapshots)),
+		Truncated: series.Truncated,
+	}
+	for i, snapshot := range series.Snapshots {
+		dto.Snapshots[i] = openapi.ListSnapshot{
+			Date: types.Date{Time: snapshot.Day},
+			StatusCounts: openapi.StatusCounts{
+				Todo:       snapshot.StatusCounts[domain.TaskStatusTodo],
+				InProgress: snapshot.StatusCounts[domain.TaskStatusInProgress],
+				Blocked:    snapshot.StatusCounts[domain.TaskStatusBlocked],
+				Done:       snapshot.StatusCounts[domain.TaskStatusDone],
+				Archived:   snapshot.StatusCounts[domain.TaskStatusArchived],
+				Cancelled:  snapshot.StatusCounts[domain.TaskStatusCancelled],
+			},
+			PriorityCounts: openapi.PriorityCounts{
+				Urgent: snapshot.PriorityCounts[domain.TaskPriorityUrgent],
+				High:   snapshot.PriorityCounts[domain.TaskPriorityHigh],
+				Medium: snapshot.PriorityCounts[domain.TaskPriorityMedium],
+				Low:    snapshot.PriorityCounts[domain.TaskPriorityLow],
+				None:   snapshot.PriorityCounts[""],
+			},
+			Open:       snapshot.Open(),
+			Overdue:    snapshot.Overdue,
+			RecordedAt: snapshot.RecordedAt,
+			Backfilled: snapshot.Backfilled,
+		}
+	}
+	return dto
+}
+
+// MapTimesheetToDTO converts domain.Timesheet to openapi.Timesheet.
+func MapTimesheetToDTO(sheet *domain.Timesheet) openapi.Timesheet {
+	dto := openapi.Timesheet{
+		From:      types.Date{Time: sheet.FirstDay},
+		To:        types.Date{Time: sheet.LastDay},
+		GroupBy:   openapi.TimesheetGroupBy(sheet.GroupBy),
+		Tz:        sheet.Location.String(),
+		Total:     domain.FormatDurationISO8601(sheet.Total),
+		Groups:    make([]openapi.TimesheetGroup, len(sheet.Groups)),
+		Truncated: sheet.Truncated,
+	}
+	for i, group := range sheet.Groups {
+		items := make([]openapi.TimesheetItem, len(group.Items))
+		for j, item := range group.Items {
+			itemID, _ := uuid.Parse(item.ItemID)
+			listID, _ := uuid.Parse(item.ListID)
+			items[j] = openapi.TimesheetItem{
+				ItemId:   itemID,
+				ListId:   listID,
+				Title:    item.Title,
+				Source:   openapi.TimesheetSource(item.Source),
+				Duration: domain.FormatDurationISO8601(item.Duration),
+			}
+		}
+		dto.Groups[i] = openapi.TimesheetGroup{
+			Key:      group.Key,
+			Name:     group.Name,
+			Duration: domain.FormatDurationISO8601(group.Duration),
+			Items:    items,
+		}
+	}
+	return dto
+}
+
 // MapTemplateToDTO converts domain.RecurringTemplate to openapi.RecurringItemTemplate.
 func MapTemplateToDTO(template *domain.RecurringTemplate) openapi.RecurringItemTemplate {
 	dto := openapi.RecurringItemTemplate{
@@ -128,6 +342,8 @@ func MapTemplateToDTO(template *domain.RecurringTemplate) openapi.RecurringItemT
 		LastGeneratedUntil:    ptrTime(template.GeneratedThrough),
 		SyncHorizonDays:       &template.SyncHorizonDays,
 		GenerationHorizonDays: &template.GenerationHorizonDays,
+		CustomFields:          ptrCustomFieldValues(template.CustomFields),
+		DefaultReminders:      MapDefaultRemindersToDTO(template.DefaultReminders),
 	}
 
 	// Map priority
@@ -151,3 +367,733 @@ func MapTemplateToDTO(template *domain.RecurringTemplate) openapi.RecurringItemT
 
 	return dto
 }
+
+// MapListMemberToDTO converts domain.ListMember to openapi.ListMember.
+func MapListMemberToDTO(member *domain.ListMember) openapi.ListMember {
+	principalID, _ := uuid.Parse(member.PrincipalID)
+
+	return openapi.ListMember{
+		PrincipalId: principalID,
+		Role:        openapi.ListRole(member.Role),
+		CreatedAt:   ptrTime(member.CreatedAt),
+		UpdatedAt:   ptrTime(member.UpdatedAt),
+	}
+}
+
+// MapReminderToDTO converts domain.Reminder to openapi.Reminder.
+func MapReminderToDTO(reminder *domain.Reminder) openapi.Reminder {
+	id, _ := uuid.Parse(reminder.ID)
+
+	dto := openapi.Reminder{
+		Id:        id,
+		RemindAt:  reminder.Rule.RemindAt,
+		FireAt:    reminder.FireAt,
+		Status:    openapi.ReminderStatus(reminder.Status),
+		SentAt:    reminder.SentAt,
+		CreatedAt: ptrTime(reminder.CreatedAt),
+	}
+
+	if reminder.Rule.IsRelative() {
+		anchor := openapi.ReminderAnchor(reminder.Rule.Anchor)
+		dto.RelativeTo = &anchor
+		dto.Before = ptrDuration(&reminder.Rule.Before)
+	}
+
+	return dto
+}
+
+// MapReminderRuleFromDTO converts the fields of a reminder request to a domain.ReminderRule.
+// Exactly one of remind_at or relative_to with before must be set.
+func MapReminderRuleFromDTO(req openapi.CreateReminderRequest) (domain.ReminderRule, error) {
+	if req.RemindAt != nil {
+		if req.RelativeTo != nil || req.Before != nil {
+			return domain.ReminderRule{}, fmt.Errorf("%w: set either remind_at or relative_to with before", domain.ErrInvalidReminder)
+		}
+		return domain.NewAbsoluteReminderRule(*req.RemindAt), nil
+	}
+
+	if req.RelativeTo == nil || req.Before == nil {
+		return domain.ReminderRule{}, fmt.Errorf("%w: remind_at or relative_to with before is required", domain.ErrInvalidReminder)
+	}
+	return mapRelativeReminderRule(string(*req.RelativeTo), *req.Before)
+}
+
+// MapTimeEntryToDTO converts domain.TimeEntry to openapi.TimeEntry.
+// The duration of a running timer is counted up to now.
+func MapTimeEntryToDTO(entry *domain.TimeEntry, now time.Time) openapi.TimeEntry {
+	id, _ := uuid.Parse(entry.ID)
+	itemID, _ := uuid.Parse(entry.ItemID)
+	listID, _ := uuid.Parse(entry.ListID)
+	principalID, _ := uuid.Parse(entry.PrincipalID)
+
+	return openapi.TimeEntry{
+		Id:          id,
+		ItemId:      itemID,
+		ListId:      listID,
+		PrincipalId: principalID,
+		StartedAt:   entry.StartedAt,
+		EndedAt:     entry.EndedAt,
+		Duration:    domain.FormatDurationISO8601(entry.Duration(now)),
+		Running:     entry.Running(),
+		Notes:       entry.Notes,
+		CreatedAt:   ptrTime(entry.CreatedAt),
+		UpdatedAt:   ptrTime(entry.UpdatedAt),
+	}
+}
+
+// MapDefaultRemindersFromDTO converts template default reminders to domain rules.
+func MapDefaultRemindersFromDTO(dtos *[]openapi.DefaultReminder) ([]domain.ReminderRule, error) {
+	if dtos == nil {
+		return nil, nil
+	}
+
+	rules := make([]domain.ReminderRule, 0, len(*dtos))
+	for _, dto := range *dtos {
+		rule, err := mapRelativeReminderRule(string(dto.RelativeTo), dto.Before)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// MapDefaultRemindersToDTO converts template default reminders to DTOs.
+func MapDefaultRemindersToDTO(rules []domain.ReminderRule) *[]openapi.DefaultReminder {
+	dtos := make([]openapi.DefaultReminder, 0, len(rules))
+	for _, rule := range rules {
+		dtos = append(dtos, openapi.DefaultReminder{
+			RelativeTo: openapi.ReminderAnchor(rule.Anchor),
+			Before:     domain.FormatDurationISO8601(rule.Before),
+		})
+	}
+	return &dtos
+}
+
+func mapRelativeReminderRule(anchor, before string) (domain.ReminderRule, error) {
+	d, err := domain.NewDuration(before)
+	if err != nil {
+		return domain.ReminderRule{}, fmt.Errorf("%w: before must be an ISO 8601 duration", domain.ErrInvalidReminder)
+	}
+	return domain.NewRelativeReminderRule(anchor, d.Value())
+}
+
+// MapWebhookToDTO converts domain.WebhookSubscription to openapi.Webhook.
+// The signing secret is never included.
+func MapWebhookToDTO(sub *domain.WebhookSubscription) openapi.Webhook {
+	id, _ := uuid.Parse(sub.ID)
+
+	events := make([]openapi.WebhookEventType, len(sub.Events))
+	for i, e := range sub.Events {
+		events[i] = openapi.WebhookEventType(e)
+	}
+
+	return openapi.Webhook{
+		Id:        id,
+		Url:       sub.URL,
+		Events:    events,
+		IsActive:  sub.IsActive,
+		CreatedAt: ptrTime(sub.CreatedAt),
+		UpdatedAt: ptrTime(sub.UpdatedAt),
+	}
+}
+
+// MapWebhookDeliveryToDTO converts domain.WebhookDelivery to openapi.WebhookDelivery.
+func MapWebhookDeliveryToDTO(delivery *domain.WebhookDelivery) openapi.WebhookDelivery {
+	id, _ := uuid.Parse(delivery.ID)
+	eventID, _ := uuid.Parse(delivery.EventID)
+
+	dto := openapi.WebhookDelivery{
+		Id:             id,
+		EventId:        eventID,
+		EventType:      openapi.WebhookEventType(delivery.EventType),
+		Status:         openapi.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		LastError:      delivery.LastError,
+		ResponseStatus: delivery.ResponseStatus,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      ptrTime(delivery.CreatedAt),
+	}
+
+	if delivery.Status == domain.WebhookDeliveryPending {
+		dto.NextAttemptAt = delivery.AvailableAt
+	}
+	if delivery.RedeliveryOf != nil {
+		dto.RedeliveryOf = ptrUUID(*delivery.RedeliveryOf)
+	}
+
+	return dto
+}
+
+// MapChangeToDTO converts domain.Change to openapi.ChangeEvent.
+func MapChangeToDTO(change *domain.Change) openapi.ChangeEvent {
+	entityID, _ := uuid.Parse(change.EntityID)
+	listID, _ := uuid.Parse(change.ListID)
+
+	dto := openapi.ChangeEvent{
+		Seq:        change.Seq,
+		EntityType: openapi.ChangeEventEntityType(change.EntityType),
+		EntityId:   entityID,
+		ListId:     listID,
+		Operation:  openapi.ChangeEventOperation(change.Operation),
+		Version:    change.Version,
+		OccurredAt: change.OccurredAt,
+		Data:       change.Snapshot,
+	}
+
+	if len(change.Diff) > 0 {
+		diff := make(map[string]openapi.FieldChange, len(change.Diff))
+		for field, fc := range change.Diff {
+			diff[field] = openapi.FieldChange{Old: fc.Old, New: fc.New}
+		}
+		dto.Diff = &diff
+	}
+
+	return dto
+}
+
+// MapSyncTombstoneToDTO converts domain.SyncTombstone to openapi.SyncTombstone.
+func MapSyncTombstoneToDTO(tombstone domain.SyncTombstone) openapi.SyncTombstone {
+	id, _ := uuid.Parse(tombstone.EntityID)
+	listID, _ := uuid.Parse(tombstone.ListID)
+
+	return openapi.SyncTombstone{
+		EntityType: openapi.SyncTombstoneEntityType(tombstone.EntityType),
+		Id:         id,
+		ListId:     listID,
+	}
+}
+
+// MapSavedViewToDTO converts domain.SavedView to openapi.SavedView.
+func MapSavedViewToDTO(view *domain.SavedView) openapi.SavedView {
+	id, _ := uuid.Parse(view.ID)
+
+	dto := openapi.SavedView{
+		Id:             id,
+		Name:           view.Name,
+		Filter:         MapViewFilterToDTO(view.Filter),
+		DueAfter:       mapDueBoundToDTO(view.DueAfter),
+		DueBefore:      mapDueBoundToDTO(view.DueBefore),
+		IncludeSnoozed: view.IncludeSnoozed,
+		CreatedAt:      ptrTime(view.CreatedAt),
+		UpdatedAt:      ptrTime(view.UpdatedAt),
+	}
+	if view.ListID != nil {
+		dto.ListId = ptrUUID(*view.ListID)
+	}
+	return dto
+}
+
+// MapCalendarFeedToDTO converts domain.CalendarFeed to openapi.CalendarFeed.
+// The token and the feed path are only set when the feed carries its token,
+// right after creation.
+func MapCalendarFeedToDTO(feed *domain.CalendarFeed) openapi.CalendarFeed {
+	id, _ := uuid.Parse(feed.ID)
+	listID, _ := uuid.Parse(feed.ListID)
+
+	dto := openapi.CalendarFeed{
+		Id:        id,
+		ListId:    listID,
+		Name:      feed.Name,
+		Statuses:  make([]openapi.ItemStatus, len(feed.Statuses)),
+		Tags:      append([]string{}, feed.Tags...),
+		CreatedAt: feed.CreatedAt,
+	}
+	for i, status := range feed.Statuses {
+		dto.Statuses[i] = openapi.ItemStatus(status)
+	}
+	if feed.Token != "" {
+		dto.Token = ptrString(feed.Token)
+		dto.Path = ptrString("/api/v1/feeds/" + feed.Token)
+	}
+	return dto
+}
+
+// MapImportReportToDTO converts domain.ImportReport to openapi.ImportReport.
+func MapImportReportToDTO(report *domain.ImportReport) openapi.ImportReport {
+	results := make([]openapi.ImportResult, len(report.Results))
+	for i, result := range report.Results {
+		results[i] = openapi.ImportResult{
+			ExternalId: result.ExternalID,
+			Kind:       openapi.ImportResultKind(result.Kind),
+			Title:      result.Title,
+			Action:     openapi.ImportResultAction(result.Action),
+			Id:         ptrUUID(result.ID),
+			Reason:     ptrString(result.Reason),
+		}
+	}
+
+	return openapi.ImportReport{
+		DryRun:  report.DryRun,
+		Created: report.Count(domain.ImportActionCreate),
+		Skipped: report.Count(domain.ImportActionSkip),
+		Failed:  report.Count(domain.ImportActionFail),
+		Results: results,
+	}
+}
+
+// MapViewFilterToDTO converts a saved view's filter input to openapi.ViewFilter.
+func MapViewFilterToDTO(filter domain.ItemsFilterInput) openapi.ViewFilter {
+	var dto openapi.ViewFilter
+	if len(filter.Statuses) > 0 {
+		statuses := make([]openapi.ItemStatus, len(filter.Statuses))
+		for i, s := range filter.Statuses {
+			statuses[i] = openapi.ItemStatus(s)
+		}
+		dto.Status = &statuses
+	}
+	if len(filter.Priorities) > 0 {
+		priorities := make([]openapi.ItemPriority, len(filter.Priorities))
+		for i, p := range filter.Priorities {
+			priorities[i] = openapi.ItemPriority(p)
+		}
+		dto.Priority = &priorities
+	}
+	if len(filter.Tags) > 0 {
+		dto.Tags = &filter.Tags
+	}
+	if len(filter.CustomFields) > 0 {
+		dto.CustomFields = &filter.CustomFields
+	}
+	dto.Expression = filter.Expression
+	dto.SortBy = filter.OrderBy
+	if filter.OrderDir != nil {
+		dir := openapi.ViewFilterSortDir(*filter.OrderDir)
+		dto.SortDir = &dir
+	}
+	return dto
+}
+
+// MapViewFilterFromDTO converts openapi.ViewFilter to the raw filter input of a saved view.
+// Values are validated by the domain when the view is saved.
+func MapViewFilterFromDTO(dto *openapi.ViewFilter) domain.ItemsFilterInput {
+	var filter domain.ItemsFilterInput
+	if dto == nil {
+		return filter
+	}
+	if dto.Status != nil {
+		for _, s := range *dto.Status {
+			filter.Statuses = append(filter.Statuses, string(s))
+		}
+	}
+	if dto.Priority != nil {
+		for _, p := range *dto.Priority {
+			filter.Priorities = append(filter.Priorities, string(p))
+		}
+	}
+	if dto.Tags != nil {
+		filter.Tags = *dto.Tags
+	}
+	if dto.CustomFields != nil {
+		filter.CustomFields = *dto.CustomFields
+	}
+	filter.Expression = dto.Expression
+	filter.OrderBy = dto.SortBy
+	if dto.SortDir != nil {
+		dir := string(*dto.SortDir)
+		filter.OrderDir = &dir
+	}
+	return filter
+}
+
+// mapDueBoundToDTO converts a saved view due bound to openapi.DueBound.
+func mapDueBoundToDTO(bound *domain.DueBound) *openapi.DueBound {
+	if bound == nil {
+		return nil
+	}
+	return &openapi.DueBound{At: bound.At, OffsetDays: bound.OffsetDays}
+}
+
+// MapDueBoundFromDTO converts openapi.DueBound to a saved view due bound.
+func MapDueBoundFromDTO(dto *openapi.DueBound) *domain.DueBound {
+	if dto == nil {
+		return nil
+	}
+	return &domain.DueBound{At: dto.At, OffsetDays: dto.OffsetDays}
+}
+
+// MapTemplateBackupToDTO converts a template with its exceptions to openapi.BackupRecurringTemplate.
+func MapTemplateBackupToDTO(backup *domain.TemplateBackup) openapi.BackupRecurringTemplate {
+	exceptions := make([]openapi.RecurringTemplateException, len(backup.Exceptions))
+	for i, exception := range backup.Exceptions {
+		exceptions[i] = openapi.RecurringTemplateException{
+			Id:        ptrUUID(exception.ID),
+			OccursAt:  exception.OccursAt,
+			Type:      openapi.RecurringTemplateExceptionType(exception.ExceptionType),
+			CreatedAt: ptrTime(exception.CreatedAt),
+		}
+		if exception.ItemID != nil {
+			exceptions[i].ItemId = ptrUUID(*exception.ItemID)
+		}
+	}
+	return openapi.BackupRecurringTemplate{
+		Template:   MapTemplateToDTO(&backup.Template),
+		Exceptions: exceptions,
+	}
+}
+
+// MapItemBackupToDTO converts an item with its status history to openapi.BackupItem.
+func MapItemBackupToDTO(backup *domain.ItemBackup) openapi.BackupItem {
+	history := make([]openapi.StatusChange, len(backup.StatusHistory))
+	for i, change := range backup.StatusHistory {
+		history[i] = openapi.StatusChange{
+			ToStatus:  openapi.ItemStatus(change.ToStatus),
+			ChangedAt: change.ChangedAt,
+			Notes:     change.Notes,
+		}
+		if change.FromStatus != nil {
+			from := openapi.ItemStatus(*change.FromStatus)
+			history[i].FromStatus = &from
+		}
+	}
+	return openapi.BackupItem{
+		Item:          MapItemToDTO(&backup.Item),
+		StatusHistory: history,
+	}
+}
+
+// MapListBackupFromDTO converts a backup document to an unvalidated domain backup.
+// Validation happens in the service layer via domain.ListBackup.Validate; only
+// values that cannot be represented in the domain, like malformed durations, fail here.
+func MapListBackupFromDTO(dto openapi.ListBackup) (*domain.ListBackup, error) {
+	backup := &domain.ListBackup{
+		Version:    dto.Version,
+		ExportedAt: dto.ExportedAt,
+		List: domain.TodoList{
+			Title:             ptr.Deref(dto.List.Title, ""),
+			CreatedAt:         ptr.Deref(dto.List.CreatedAt, time.Time{}),
+			CustomFieldSchema: MapCustomFieldDefinitionsFromDTO(dto.List.CustomFields),
+		},
+		Templates: make([]domain.TemplateBackup, 0, len(dto.RecurringTemplates)),
+		Items:     make([]domain.ItemBackup, 0, len(dto.Items)),
+	}
+	if dto.List.Id != nil {
+		backup.List.ID = dto.List.Id.String()
+	}
+
+	for i, templateDTO := range dto.RecurringTemplates {
+		templateBackup, err := mapTemplateBackupFromDTO(templateDTO)
+		if err != nil {
+			return nil, fmt.Errorf("%w: recurring_templates[%d]: %v", domain.ErrInvalidBackup, i, err)
+		}
+		backup.Templates = append(backup.Templates, templateBackup)
+	}
+	for i, itemDTO := range dto.Items {
+		itemBackup, err := mapItemBackupFromDTO(itemDTO)
+		if err != nil {
+			return nil, fmt.Errorf("%w: items[%d]: %v", domain.ErrInvalidBackup, i, err)
+		}
+		backup.Items = append(backup.Items, itemBackup)
+	}
+	return backup, nil
+}
+
+func mapTemplateBackupFromDTO(dto openapi.BackupRecurringTemplate) (domain.TemplateBackup, error) {
+	t := dto.Template
+	template := domain.RecurringTemplate{
+		Title:                 ptr.Deref(t.Title, ""),
+		Tags:                  derefStringSlice(t.Tags),
+		IsActive:              ptr.Deref(t.IsActive, true),
+		CreatedAt:             ptr.Deref(t.CreatedAt, time.Time{}),
+		UpdatedAt:             ptr.Deref(t.UpdatedAt, time.Time{}),
+		GeneratedThrough:      ptr.Deref(t.LastGeneratedUntil, time.Time{}),
+		SyncHorizonDays:       ptr.Deref(t.SyncHorizonDays, domain.DefaultSyncHorizonDays),
+		GenerationHorizonDays: ptr.Deref(t.GenerationHorizonDays, domain.DefaultGenerationHorizonDays),
+		CustomFields:          MapCustomFieldValuesFromDTO(t.CustomFields),
+	}
+	if t.Id != nil {
+		template.ID = t.Id.String()
+	}
+	if t.Priority != nil {
+		priority := domain.TaskPriority(*t.Priority)
+		template.Priority = &priority
+	}
+	if t.RecurrencePattern != nil {
+		template.RecurrencePattern = domain.RecurrencePattern(*t.RecurrencePattern)
+	}
+	if t.RecurrenceConfig != nil {
+		if err := json.Unmarshal([]byte(*t.RecurrenceConfig), &template.RecurrenceConfig); err != nil {
+			return domain.TemplateBackup{}, fmt.Errorf("recurrence_config must be a JSON object")
+		}
+	}
+
+	var err error
+	if template.EstimatedDuration, err = parseBackupDuration(t.EstimatedDuration, "estimated_duration"); err != nil {
+		return domain.TemplateBackup{}, err
+	}
+	if template.DueOffset, err = parseBackupDuration(t.DueOffset, "due_offset"); err != nil {
+		return domain.TemplateBackup{}, err
+	}
+	if template.DefaultReminders, err = MapDefaultRemindersFromDTO(t.DefaultReminders); err != nil {
+		return domain.TemplateBackup{}, err
+	}
+
+	exceptions := make([]domain.RecurringTemplateException, 0, len(dto.Exceptions))
+	for _, e := range dto.Exceptions {
+		exception := domain.RecurringTemplateException{
+			OccursAt:      e.OccursAt,
+			ExceptionType: domain.ExceptionType(e.Type),
+			CreatedAt:     ptr.Deref(e.CreatedAt, time.Time{}),
+		}
+		if e.ItemId != nil {
+			exception.ItemID = ptr.To(e.ItemId.String())
+		}
+		exceptions = append(exceptions, exception)
+	}
+	return domain.TemplateBackup{Template: template, Exceptions: exceptions}, nil
+}
+
+func mapItemBackupFromDTO(dto openapi.BackupItem) (domain.ItemBackup, error) {
+	i := dto.Item
+	item := domain.TodoItem{
+		Title:        ptr.Deref(i.Title, ""),
+		CreatedAt:    ptr.Deref(i.CreatedAt, time.Time{}),
+		UpdatedAt:    ptr.Deref(i.UpdatedAt, time.Time{}),
+		DueAt:        i.DueAt,
+		Tags:         derefStringSlice(i.Tags),
+		CustomFields: MapCustomFieldValuesFromDTO(i.CustomFields),
+		OccursAt:     i.InstanceDate,
+		Timezone:     normalizeTimezone(i.Timezone),
+	}
+	if i.Id != nil {
+		item.ID = i.Id.String()
+	}
+	if i.Status != nil {
+		item.Status = domain.TaskStatus(*i.Status)
+	}
+	if i.Priority != nil {
+		priority := domain.TaskPriority(*i.Priority)
+		item.Priority = &priority
+	}
+	if i.RecurringTemplateId != nil {
+		item.RecurringTemplateID = ptr.To(i.RecurringTemplateId.String())
+	}
+	if i.StartsAt != nil {
+		startsAt := i.StartsAt.Time
+		item.StartsAt = &startsAt
+	}
+
+	var err error
+	if item.EstimatedDuration, err = parseBackupDuration(i.EstimatedDuration, "estimated_duration"); err != nil {
+		return domain.ItemBackup{}, err
+	}
+	if item.ActualDuration, err = parseBackupDuration(i.ActualDuration, "actual_duration"); err != nil {
+		return domain.ItemBackup{}, err
+	}
+	if item.DueOffset, err = parseBackupDuration(i.DueOffset, "due_offset"); err != nil {
+		return domain.ItemBackup{}, err
+	}
+
+	history := make([]domain.StatusChange, 0, len(dto.StatusHistory))
+	for _, h := range dto.StatusHistory {
+		change := domain.StatusChange{
+			ToStatus:  domain.TaskStatus(h.ToStatus),
+			ChangedAt: h.ChangedAt,
+			Notes:     h.Notes,
+		}
+		if h.FromStatus != nil {
+			from := domain.TaskStatus(*h.FromStatus)
+			change.FromStatus = &from
+		}
+		history = append(history, change)
+	}
+	return domain.ItemBackup{Item: item, StatusHistory: history}, nil
+}
+
+// parseBackupDuration parses an optional ISO 8601 duration of a backup entry.
+func parseBackupDuration(s *string, field string) (*time.Duration, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d, err := domain.NewDuration(*s)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", field, err)
+	}
+	duration := d.Value()
+	return &duration, nil
+}
+
+// MapRestoreReportToDTO converts domain.RestoreReport to openapi.RestoreReport.
+func MapRestoreReportToDTO(report *domain.RestoreReport) openapi.RestoreReport {
+	conflicts := make([]openapi.BackupConflict, len(report.Conflicts))
+	for i, conflict := range report.Conflicts {
+		id, _ := uuid.Parse(conflict.ID)
+		conflicts[i] = openapi.BackupConflict{
+			Kind: openapi.BackupConflictKind(conflict.Kind),
+			Id:   id,
+		}
+	}
+
+	listID, _ := uuid.Parse(report.ListID)
+	return openapi.RestoreReport{
+		DryRun:             report.DryRun,
+		IdMode:             openapi.RestoreReportIdMode(report.IDMode),
+		ListId:             listID,
+		RecurringTemplates: report.Templates,
+		Exceptions:         report.Exceptions,
+		Items:              report.Items,
+		StatusChanges:      report.StatusChanges,
+		Conflicts:          conflicts,
+	}
+}
+
+// MapTagToDTO converts domain.Tag to openapi.Tag.
+func MapTagToDTO(tag *domain.Tag) openapi.Tag {
+	return openapi.Tag{
+		Name:        tag.Name,
+		Color:       tag.Color,
+		Description: tag.Description,
+		CreatedAt:   ptrTime(tag.CreatedAt),
+		UpdatedAt:   ptrTime(tag.UpdatedAt),
+	}
+}
+
+// MapTagUsageToDTO converts domain.TagUsage to openapi.ListTag.
+func MapTagUsageToDTO(usage *domain.TagUsage) openapi.ListTag {
+	return openapi.ListTag{
+		Name:          usage.Name,
+		Color:         usage.Color,
+		Description:   usage.Description,
+		ItemCount:     usage.ItemCount,
+		TemplateCount: usage.TemplateCount,
+	}
+}
+
+// MapAutomationRuleToDTO converts domain.AutomationRule to openapi.AutomationRule.
+func MapAutomationRuleToDTO(rule *domain.AutomationRule) openapi.AutomationRule {
+	id, _ := uuid.Parse(rule.ID)
+	listID, _ := uuid.Parse(rule.ListID)
+
+	trigger := openapi.RuleTrigger{
+		Type: openapi.RuleTriggerType(rule.Trigger.Type),
+		Tag:  rule.Trigger.Tag,
+	}
+	if rule.Trigger.ToStatus != nil {
+		status := openapi.ItemStatus(*rule.Trigger.ToStatus)
+		trigger.ToStatus = &status
+	}
+	if rule.Trigger.Type == domain.RuleTriggerOverdue {
+		trigger.OverdueBy = ptrDuration(&rule.Trigger.OverdueBy)
+	}
+
+	actions := make([]openapi.RuleAction, len(rule.Actions))
+	for i, action := range rule.Actions {
+		actions[i] = openapi.RuleAction{
+			Type:      openapi.RuleActionType(action.Type),
+			Field:     ptrString(action.Field),
+			Value:     action.Value,
+			Tag:       ptrString(action.Tag),
+			ListId:    ptrUUID(action.ListID),
+			Title:     ptrString(action.Title),
+			WebhookId: ptrUUID(action.WebhookID),
+		}
+		if len(action.Tags) > 0 {
+			actions[i].Tags = &action.Tags
+		}
+		if action.Priority != nil {
+			priority := openapi.ItemPriority(*action.Priority)
+			actions[i].Priority = &priority
+		}
+	}
+
+	filter := MapViewFilterToDTO(rule.Condition)
+	return openapi.AutomationRule{
+		Id:      id,
+		ListId:  listID,
+		Name:    rule.Name,
+		Enabled: rule.Enabled,
+		Trigger: trigger,
+		Condition: openapi.RuleCondition{
+			Status:       filter.Status,
+			Priority:     filter.Priority,
+			Tags:         filter.Tags,
+			CustomFields: filter.CustomFields,
+			Expression:   filter.Expression,
+		},
+		Actions:   actions,
+		CreatedAt: ptrTime(rule.CreatedAt),
+		UpdatedAt: ptrTime(rule.UpdatedAt),
+	}
+}
+
+// MapRuleTriggerFromDTO converts openapi.RuleTrigger to a domain rule trigger.
+// Returns the error of an invalid overdue_by duration.
+func MapRuleTriggerFromDTO(dto openapi.RuleTrigger) (domain.RuleTrigger, error) {
+	trigger := domain.RuleTrigger{
+		Type: domain.RuleTriggerType(dto.Type),
+		Tag:  dto.Tag,
+	}
+	if dto.ToStatus != nil {
+		status := domain.TaskStatus(*dto.ToStatus)
+		trigger.ToStatus = &status
+	}
+	if dto.OverdueBy != nil {
+		d, err := domain.NewDuration(*dto.OverdueBy)
+		if err != nil {
+			return domain.RuleTrigger{}, err
+		}
+		trigger.OverdueBy = d.Value()
+	}
+	return trigger, nil
+}
+
+// MapRuleConditionFromDTO converts openapi.RuleCondition to the raw filter input of a rule.
+// Values are validated by the domain when the rule is saved.
+func MapRuleConditionFromDTO(dto *openapi.RuleCondition) domain.ItemsFilterInput {
+	if dto == nil {
+		return domain.ItemsFilterInput{}
+	}
+	return MapViewFilterFromDTO(&openapi.ViewFilter{
+		Status:       dto.Status,
+		Priority:     dto.Priority,
+		Tags:         dto.Tags,
+		CustomFields: dto.CustomFields,
+		Expression:   dto.Expression,
+	})
+}
+
+// MapRuleActionsFromDTO converts openapi.RuleAction values to domain rule actions.
+// Values are validated by the domain when the rule is saved.
+func MapRuleActionsFromDTO(dtos []openapi.RuleAction) []domain.RuleAction {
+	actions := make([]domain.RuleAction, len(dtos))
+	for i, dto := range dtos {
+		actions[i] = domain.RuleAction{
+			Type:  domain.RuleActionType(dto.Type),
+			Field: ptr.Deref(dto.Field, ""),
+			Value: dto.Value,
+			Tag:   ptr.Deref(dto.Tag, ""),
+			Title: ptr.Deref(dto.Title, ""),
+			Tags:  ptr.Deref(dto.Tags, nil),
+		}
+		if dto.ListId != nil {
+			actions[i].ListID = dto.ListId.String()
+		}
+		if dto.WebhookId != nil {
+			actions[i].WebhookID = dto.WebhookId.String()
+		}
+		if dto.Priority != nil {
+			priority := domain.TaskPriority(*dto.Priority)
+			actions[i].Priority = &priority
+		}
+	}
+	return actions
+}
+
+// MapRuleRunToDTO converts domain.RuleRun to openapi.AutomationRuleRun.
+func MapRuleRunToDTO(run *domain.RuleRun) openapi.AutomationRuleRun {
+	id, _ := uuid.Parse(run.ID)
+	ruleID, _ := uuid.Parse(run.RuleID)
+	itemID, _ := uuid.Parse(run.ItemID)
+
+	return openapi.AutomationRuleRun{
+		Id:        id,
+		RuleId:    ruleID,
+		ItemId:    itemID,
+		Trigger:   openapi.RuleTriggerType(run.Trigger),
+		Depth:     run.Depth,
+		Status:    openapi.AutomationRuleRunStatus(run.Status),
+		Actions:   append([]string{}, run.Actions...),
+		Error:     run.Error,
+		CreatedAt: run.CreatedAt,
+	}
+}
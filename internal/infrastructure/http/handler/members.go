@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+)
+
+// ListListMembers implements ServerInterface.ListListMembers.
+// GET /v1/lists/{list_id}/members
+func (h *TodoHandler) ListListMembers(w http.ResponseWriter, r *http.Request, listID types.UUID) {
+	members, err := h.todoService.ListMembers(r.Context(), listID.String())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list members via HTTP",
+			"list_id", listID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dtos := make([]openapi.ListMember, len(members))
+	for i, member := range members {
+		dtos[i] = MapListMemberToDTO(member)
+	}
+
+	response.OK(w, openapi.ListListMembersResponse{
+		Members: &dtos,
+	})
+}
+
+// AddListMember implements ServerInterface.AddListMember.
+// POST /v1/lists/{list_id}/members
+func (h *TodoHandler) AddListMember(w http.ResponseWriter, r *http.Request, listID types.UUID) {
+	var req openapi.AddListMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	member, err := h.todoService.AddMember(r.Context(), listID.String(), req.PrincipalId.String(), string(req.Role))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to add list member via HTTP",
+			"list_id", listID.String(),
+			"principal_id", req.PrincipalId.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	slog.InfoContext(r.Context(), "list shared via HTTP",
+		"list_id", listID.String(),
+		"principal_id", member.PrincipalID,
+		"role", member.Role)
+
+	dto := MapListMemberToDTO(member)
+	response.Created(w, openapi.ListMemberResponse{
+		Member: &dto,
+	})
+}
+
+// UpdateListMember implements ServerInterface.UpdateListMember.
+// PATCH /v1/lists/{list_id}/members/{principal_id}
+func (h *TodoHandler) UpdateListMember(w http.ResponseWriter, r *http.Request, listID types.UUID, principalID types.UUID) {
+	var req openapi.UpdateListMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	member, err := h.todoService.UpdateMemberRole(r.Context(), listID.String(), principalID.String(), string(req.Role))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to update list member via HTTP",
+			"list_id", listID.String(),
+			"principal_id", principalID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	slog.InfoContext(r.Context(), "list member role updated via HTTP",
+		"list_id", listID.String(),
+		"principal_id", member.PrincipalID,
+		"role", member.Role)
+
+	dto := MapListMemberToDTO(member)
+	response.OK(w, openapi.ListMemberResponse{
+		Member: &dto,
+	})
+}
+
+// RemoveListMember implements ServerInterface.RemoveListMember.
+// DELETE /v1/lists/{list_id}/members/{principal_id}
+func (h *TodoHandler) RemoveListMember(w http.ResponseWriter, r *http.Request, listID types.UUID, principalID types.UUID) {
+	if err := h.todoService.RemoveMember(r.Context(), listID.String(), principalID.String()); err != nil {
+		slog.ErrorContext(r.Context(), "failed to remove list member via HTTP",
+			"list_id", listID.String(),
+			"principal_id", principalID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	slog.InfoContext(r.Context(), "list member removed via HTTP",
+		"list_id", listID.String(),
+		"principal_id", principalID.String())
+
+	response.NoContent(w)
+}
@@ -1,52 +1,129 @@
 package handler
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/rezkam/mono/internal/domain"
 	"github.com/rezkam/mono/internal/ptr"
 )
 
-// generatePageToken creates a pagination token from an offset value.
-// Returns nil if there are no more pages.
-func generatePageToken(offset int, hasMore bool) *string {
-	if !hasMore {
+// pageToken is the decoded form of a page token: where the previous page ended,
+// and a fingerprint of the sort and filters of the query it belongs to.
+type pageToken struct {
+	Query   string  `json:"q"`
+	SortKey *string `json:"k,omitempty"`
+	ID      string  `json:"id"`
+}
+
+// queryFingerprint identifies a query's sort and filters, so a page token is
+// only accepted by the query that issued it. A cursor applied to a different
+// sort or filter would silently skip or repeat rows.
+func queryFingerprint(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:16])
+}
+
+// itemsQueryFingerprint identifies the lists, sort and filters of an item search.
+// Filter values are sorted, since their order does not change the results.
+func itemsQueryFingerprint(params domain.ListTasksParams) string {
+	filter := params.Filter
+	var statuses, priorities []string
+	for _, status := range filter.Statuses() {
+		statuses = append(statuses, string(status))
+	}
+	for _, priority := range filter.Priorities() {
+		priorities = append(priorities, string(priority))
+	}
+	var customFields []string
+	for _, name := range slices.Sorted(maps.Keys(filter.CustomFields())) {
+		customFields = append(customFields, name+":"+filter.CustomFields()[name])
+	}
+	return queryFingerprint(
+		"items",
+		ptr.Deref(params.ListID, ""),
+		strings.Join(slices.Sorted(slices.Values(params.ListIDs)), ","),
+		filter.OrderBy(),
+		filter.OrderDir(),
+		strings.Join(slices.Sorted(slices.Values(statuses)), ","),
+		strings.Join(slices.Sorted(slices.Values(priorities)), ","),
+		strings.Join(slices.Sorted(slices.Values(filter.Tags())), ","),
+		strings.Join(customFields, "\x00"),
+		filter.Expression().String(),
+		formatFingerprintTime(params.DueBefore),
+		formatFingerprintTime(params.DueAfter),
+		formatFingerprintTime(params.UpdatedAfter),
+		formatFingerprintTime(params.CreatedAfter),
+		strconv.FormatBool(params.ExcludeSnoozed),
+	)
+}
+
+// formatFingerprintTime formats an optional time filter for a query fingerprint.
+func formatFingerprintTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// listsQueryFingerprint identifies the sort and filters of a list search.
+func listsQueryFingerprint(params domain.ListListsParams) string {
+	return queryFingerprint(
+		"lists",
+		params.Sorting.OrderBy(),
+		params.Sorting.OrderDir(),
+		ptr.Deref(params.TitleContains, ""),
+		formatFingerprintTime(params.CreatedAtAfter),
+		formatFingerprintTime(params.CreatedAtBefore),
+	)
+}
+
+// generatePageToken creates an opaque pagination token from the cursor after
+// the last row of a page. Returns nil if there are no more pages.
+func generatePageToken(query string, next *domain.PageCursor) *string {
+	if next == nil {
 		return nil
 	}
 
-	// Encode the next offset as a base64 string
-	token := base64.URLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+	data, err := json.Marshal(pageToken{Query: query, SortKey: next.SortKey, ID: next.ID})
+	if err != nil {
+		return nil
+	}
+	token := base64.RawURLEncoding.EncodeToString(data)
 	return &token
 }
 
-// parsePageToken decodes a pagination token to get the offset.
-// Returns (0, nil) if token is nil or empty (first page).
-// Returns (0, ErrInvalidPageToken) if token is malformed or invalid.
-func parsePageToken(token *string) (int, error) {
+// parsePageToken decodes a pagination token to get the cursor to continue after.
+// Returns (nil, nil) if token is nil or empty (first page).
+// Returns (nil, ErrInvalidPageToken) if token is malformed, or was issued for a
+// query with a different sort or filters.
+func parsePageToken(token *string, query string) (*domain.PageCursor, error) {
 	// nil or empty token is valid - means first page
 	if token == nil || *token == "" {
-		return 0, nil
+		return nil, nil
 	}
 
-	// Decode base64
-	decoded, err := base64.URLEncoding.DecodeString(*token)
+	decoded, err := base64.RawURLEncoding.DecodeString(*token)
 	if err != nil {
-		return 0, domain.ErrInvalidPageToken
+		return nil, domain.ErrInvalidPageToken
 	}
 
-	// Parse integer
-	offset, err := strconv.Atoi(string(decoded))
-	if err != nil {
-		return 0, domain.ErrInvalidPageToken
+	var parsed pageToken
+	if err := json.Unmarshal(decoded, &parsed); err != nil {
+		return nil, domain.ErrInvalidPageToken
 	}
-
-	// Reject negative offsets
-	if offset < 0 {
-		return 0, domain.ErrInvalidPageToken
+	if parsed.ID == "" || parsed.Query != query {
+		return nil, domain.ErrInvalidPageToken
 	}
 
-	return offset, nil
+	return &domain.PageCursor{SortKey: parsed.SortKey, ID: parsed.ID}, nil
 }
 
 // getPageSize returns the requested page size, or 0 if not specified.
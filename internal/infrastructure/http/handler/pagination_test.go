@@ -2,8 +2,12 @@ package handler
 
 import (
 	"testing"
+	"time"
 
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/ptr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestGetPageSize_PassesRawValue verifies that getPageSize returns the raw value
@@ -31,6 +35,66 @@ func TestGetPageSize_PassesRawValue(t *testing.T) {
 	}
 }
 
+// TestPageToken_RoundTrip verifies that a token decodes to the cursor it was
+// generated from, including a cursor whose row had no sort key.
+func TestPageToken_RoundTrip(t *testing.T) {
+	query := queryFingerprint("items", "list-1")
+	for _, cursor := range []*domain.PageCursor{
+		{SortKey: ptr.To("1736942400.123456"), ID: "0190d3c8-6b4a-7000-8000-000000000001"},
+		{SortKey: nil, ID: "0190d3c8-6b4a-7000-8000-000000000002"},
+	} {
+		token := generatePageToken(query, cursor)
+		require.NotNil(t, token)
+
+		parsed, err := parsePageToken(token, query)
+		require.NoError(t, err)
+		assert.Equal(t, cursor, parsed)
+	}
+
+	assert.Nil(t, generatePageToken(query, nil), "no token without a next page")
+
+	parsed, err := parsePageToken(nil, query)
+	require.NoError(t, err)
+	assert.Nil(t, parsed, "no token starts at the first page")
+}
+
+// TestPageToken_RejectsOtherQuery verifies that a token only continues the
+// query it was issued for: a different sort or filter must not reuse it.
+func TestPageToken_RejectsOtherQuery(t *testing.T) {
+	byDue, err := domain.NewItemsFilter(domain.ItemsFilterInput{OrderBy: ptr.To("due_at")})
+	require.NoError(t, err)
+	byPriority, err := domain.NewItemsFilter(domain.ItemsFilterInput{OrderBy: ptr.To("priority")})
+	require.NoError(t, err)
+	done, err := domain.NewItemsFilter(domain.ItemsFilterInput{OrderBy: ptr.To("due_at"), Statuses: []string{"done"}})
+	require.NoError(t, err)
+
+	list1, list2 := "list-1", "list-2"
+	query := func(listID *string, filter domain.ItemsFilter) string {
+		return itemsQueryFingerprint(domain.ListTasksParams{ListID: listID, Filter: filter})
+	}
+	dueBefore := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	token := generatePageToken(query(&list1, byDue), &domain.PageCursor{ID: "0190d3c8-6b4a-7000-8000-000000000001"})
+	require.NotNil(t, token)
+
+	for name, other := range map[string]string{
+		"other sort":   query(&list1, byPriority),
+		"other filter": query(&list1, done),
+		"other list":   query(&list2, byDue),
+		"all lists":    query(nil, byDue),
+		"other lists":  itemsQueryFingerprint(domain.ListTasksParams{ListIDs: []string{list1, list2}, Filter: byDue}),
+		"due window":   itemsQueryFingerprint(domain.ListTasksParams{ListID: &list1, Filter: byDue, DueBefore: &dueBefore}),
+	} {
+		_, err := parsePageToken(token, other)
+		assert.ErrorIs(t, err, domain.ErrInvalidPageToken, name)
+	}
+
+	for _, malformed := range []string{"not-base64!", "MTA", "e30"} { // "10" and "{}"
+		_, err := parsePageToken(&malformed, query(&list1, byDue))
+		assert.ErrorIs(t, err, domain.ErrInvalidPageToken, malformed)
+	}
+}
+
 func intPtr(i int) *int {
 	return &i
 }
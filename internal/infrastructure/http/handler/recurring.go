@@ -96,6 +96,16 @@ func (h *TodoHandler) CreateRecurringTemplate(w http.ResponseWriter, r *http.Req
 		template.RecurrenceConfig = make(map[string]any)
 	}
 
+	// Custom field values are validated against the list schema by the service layer
+	template.CustomFields = MapCustomFieldValuesFromDTO(req.CustomFields)
+
+	defaultReminders, err := MapDefaultRemindersFromDTO(req.DefaultReminders)
+	if err != nil {
+		response.FromDomainError(w, r, err)
+		return
+	}
+	template.DefaultReminders = defaultReminders
+
 	// Call service layer (validation happens here)
 	created, err := h.todoService.CreateRecurringTemplate(r.Context(), template)
 	if err != nil {
@@ -227,6 +237,15 @@ func (h *TodoHandler) UpdateRecurringTemplate(w http.ResponseWriter, r *http.Req
 			params.SyncHorizonDays = req.Template.SyncHorizonDays
 		case "generation_horizon_days":
 			params.GenerationHorizonDays = req.Template.GenerationHorizonDays
+		case "custom_fields":
+			params.CustomFields = MapCustomFieldValuesFromDTO(req.Template.CustomFields)
+		case "default_reminders":
+			defaultReminders, err := MapDefaultRemindersFromDTO(req.Template.DefaultReminders)
+			if err != nil {
+				response.FromDomainError(w, r, err)
+				return
+			}
+			params.DefaultReminders = defaultReminders
 		}
 	}
 
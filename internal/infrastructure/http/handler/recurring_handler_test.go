@@ -153,6 +153,51 @@ func (s *stubRepository) FindExceptions(ctx context.Context, templateID string,
 func (s *stubRepository) FindExceptionByOccurrence(ctx context.Context, templateID string, occursAt time.Time) (*domain.RecurringTemplateException, error) {
 	panic("not implemented")
 }
+func (s *stubRepository) FindListRole(ctx context.Context, listID, principalID string) (domain.ListRole, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindListMembers(ctx context.Context, listID string) ([]*domain.ListMember, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) CreateListMember(ctx context.Context, member *domain.ListMember) (*domain.ListMember, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) UpdateListMember(ctx context.Context, member *domain.ListMember) (*domain.ListMember, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) DeleteListMember(ctx context.Context, listID, principalID string) error {
+	panic("not implemented")
+}
+func (s *stubRepository) FindItemReminders(ctx context.Context, itemID string) ([]*domain.Reminder, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) CreateItemReminder(ctx context.Context, reminder *domain.Reminder) (*domain.Reminder, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) DeleteItemReminder(ctx context.Context, itemID, reminderID string) error {
+	panic("not implemented")
+}
+func (s *stubRepository) CreateWebhookSubscription(ctx context.Context, sub *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindWebhookSubscriptionByID(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindWebhookSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) UpdateWebhookSubscription(ctx context.Context, sub *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	panic("not implemented")
+}
+func (s *stubRepository) FindWebhookDeliveries(ctx context.Context, subscriptionID string, status *domain.WebhookDeliveryStatus, limit int) ([]*domain.WebhookDelivery, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) RedeliverWebhookDelivery(ctx context.Context, subscriptionID, deliveryID string) (*domain.WebhookDelivery, error) {
+	panic("not implemented")
+}
 func (s *stubRepository) DeleteException(ctx context.Context, templateID string, occursAt time.Time) error {
 	panic("not implemented")
 }
@@ -173,6 +218,156 @@ func (s *stubRepository) AtomicRecurring(ctx context.Context, fn func(todo.Recur
 func (s *stubRepository) ScheduleGenerationJob(ctx context.Context, templateID string, scheduledFor, from, until time.Time) (string, error) {
 	return "job-123", nil // Return mock job ID
 }
+func (s *stubRepository) FindChanges(ctx context.Context, query domain.ChangeFeedQuery) ([]*domain.Change, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindChangeWatermark(ctx context.Context) (uint64, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) CreateSavedView(ctx context.Context, view *domain.SavedView) (*domain.SavedView, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindSavedViewByID(ctx context.Context, id string) (*domain.SavedView, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindSavedViews(ctx context.Context) ([]*domain.SavedView, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) UpdateSavedView(ctx context.Context, view *domain.SavedView) (*domain.SavedView, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) DeleteSavedView(ctx context.Context, id string) error {
+	panic("not implemented")
+}
+func (s *stubRepository) FindSyncPage(ctx context.Context, after domain.SyncCursor, limit int) (*domain.SyncPage, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) CreateCalendarFeed(ctx context.Context, feed *domain.CalendarFeed) (*domain.CalendarFeed, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*domain.CalendarFeed, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindCalendarFeeds(ctx context.Context, listID string) ([]*domain.CalendarFeed, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) DeleteCalendarFeed(ctx context.Context, listID, id string) error {
+	panic("not implemented")
+}
+func (s *stubRepository) CreateImportRecord(ctx context.Context, record *domain.ImportRecord) error {
+	panic("not implemented")
+}
+func (s *stubRepository) FindImportRecords(ctx context.Context, listID string, externalIDs []string) ([]*domain.ImportRecord, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindStatusHistory(ctx context.Context, itemIDs []string) ([]*domain.StatusChange, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) RestoreStatusHistory(ctx context.Context, changes []domain.StatusChange) error {
+	panic("not implemented")
+}
+func (s *stubRepository) FindBackupConflicts(ctx context.Context, backup *domain.ListBackup) ([]domain.BackupConflict, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindAgendaItems(ctx context.Context, query domain.AgendaQuery) ([]domain.TodoItem, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindListSnapshots(ctx context.Context, listID string, first, last time.Time) ([]domain.ListSnapshot, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindStatsItems(ctx context.Context, query domain.StatsQuery, limit int) ([]domain.TodoItem, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindTimeEntries(ctx context.Context, itemID string) ([]*domain.TimeEntry, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindRunningTimeEntry(ctx context.Context, principalID string) (*domain.TimeEntry, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) CreateTimeEntry(ctx context.Context, entry *domain.TimeEntry) (*domain.TimeEntry, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) StopRunningTimeEntry(ctx context.Context, principalID, itemID string, endedAt time.Time, notes string) (*domain.TimeEntry, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindTimesheetPeriods(ctx context.Context, start, end time.Time, limit int) ([]domain.TimesheetPeriod, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) DeleteTimeEntry(ctx context.Context, itemID, entryID, principalID string) error {
+	panic("not implemented")
+}
+func (s *stubRepository) CreateAutomationRule(ctx context.Context, rule *domain.AutomationRule) (*domain.AutomationRule, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindAutomationRuleByID(ctx context.Context, listID, id string) (*domain.AutomationRule, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindAutomationRules(ctx context.Context, listID string) ([]*domain.AutomationRule, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindEnabledAutomationRules(ctx context.Context, listID string, trigger domain.RuleTriggerType) ([]*domain.AutomationRule, error) {
+	return nil, nil // No rules
+}
+func (s *stubRepository) CountAutomationRules(ctx context.Context, listID string) (int, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) UpdateAutomationRule(ctx context.Context, rule *domain.AutomationRule) (*domain.AutomationRule, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) DeleteAutomationRule(ctx context.Context, listID, id string) error {
+	panic("not implemented")
+}
+func (s *stubRepository) CreateRuleRun(ctx context.Context, run *domain.RuleRun) error {
+	panic("not implemented")
+}
+func (s *stubRepository) FindRuleRuns(ctx context.Context, ruleID string, limit int) ([]*domain.RuleRun, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindOverdueRuleCandidates(ctx context.Context, now time.Time, limit int) ([]domain.OverdueRuleCandidate, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) RecordOverdueRuleFire(ctx context.Context, ruleID, itemID string, dueAt, firedAt time.Time) (bool, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) MoveItem(ctx context.Context, itemID, fromListID, toListID string) (*domain.TodoItem, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindTagUsage(ctx context.Context, listID string) ([]*domain.TagUsage, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindTag(ctx context.Context, listID, name string) (*domain.Tag, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) CreateTag(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) UpdateTag(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) RenameTagRegistration(ctx context.Context, listID, name, newName string, updatedAt time.Time) (bool, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) DeleteTags(ctx context.Context, listID string, names []string) (int, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) TagInUse(ctx context.Context, listID, name string) (bool, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) ReplaceTags(ctx context.Context, listID string, sources []string, target string) (domain.TagRewrite, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) FindDeadLetterReminders(ctx context.Context, limit int) ([]*domain.DeadLetterReminder, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) ResolveDeadLetterReminder(ctx context.Context, id, resolution string, note *string) (*domain.DeadLetterReminder, error) {
+	panic("not implemented")
+}
+func (s *stubRepository) RearmDeadReminder(ctx context.Context, reminderID string) error {
+	panic("not implemented")
+}
+func (s *stubRepository) EnqueueWebhookEvent(ctx context.Context, subscriptionID string, event *domain.Event) (string, error) {
+	panic("not implemented")
+}
 
 // spyRepository captures what was passed to UpdateRecurringTemplate
 type spyRepository struct {
@@ -278,6 +473,8 @@ func TestUpdateRecurringTemplate_UpdatesGenerationWindowDays(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodPatch, "/v1/lists/"+listUUID.String()+"/recurring-templates/"+templateUUID.String(), bytes.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
+	// The handler is called directly, without the auth middleware
+	req = req.WithContext(domain.WithInternalAccess(req.Context()))
 
 	w := httptest.NewRecorder()
 	srv.UpdateRecurringTemplate(w, req, listUUID, templateUUID)
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+)
+
+// ListItemReminders implements ServerInterface.ListItemReminders.
+// GET /v1/lists/{list_id}/items/{item_id}/reminders
+func (h *TodoHandler) ListItemReminders(w http.ResponseWriter, r *http.Request, listID types.UUID, itemID types.UUID) {
+	reminders, err := h.todoService.ListReminders(r.Context(), listID.String(), itemID.String())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list reminders via HTTP",
+			"list_id", listID.String(),
+			"item_id", itemID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dtos := make([]openapi.Reminder, len(reminders))
+	for i, reminder := range reminders {
+		dtos[i] = MapReminderToDTO(reminder)
+	}
+
+	response.OK(w, openapi.ListRemindersResponse{
+		Reminders: &dtos,
+	})
+}
+
+// CreateItemReminder implements ServerInterface.CreateItemReminder.
+// POST /v1/lists/{list_id}/items/{item_id}/reminders
+func (h *TodoHandler) CreateItemReminder(w http.ResponseWriter, r *http.Request, listID types.UUID, itemID types.UUID) {
+	var req openapi.CreateReminderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	rule, err := MapReminderRuleFromDTO(req)
+	if err != nil {
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	reminder, err := h.todoService.CreateReminder(r.Context(), listID.String(), itemID.String(), rule)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to create reminder via HTTP",
+			"list_id", listID.String(),
+			"item_id", itemID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapReminderToDTO(reminder)
+	response.Created(w, openapi.ReminderResponse{
+		Reminder: &dto,
+	})
+}
+
+// DeleteItemReminder implements ServerInterface.DeleteItemReminder.
+// DELETE /v1/lists/{list_id}/items/{item_id}/reminders/{reminder_id}
+func (h *TodoHandler) DeleteItemReminder(w http.ResponseWriter, r *http.Request, listID types.UUID, itemID types.UUID, reminderID types.UUID) {
+	if err := h.todoService.DeleteReminder(r.Context(), listID.String(), itemID.String(), reminderID.String()); err != nil {
+		slog.ErrorContext(r.Context(), "failed to delete reminder via HTTP",
+			"list_id", listID.String(),
+			"item_id", itemID.String(),
+			"reminder_id", reminderID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	response.NoContent(w)
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+	"github.com/rezkam/mono/internal/ptr"
+)
+
+// GetListSnapshots implements ServerInterface.GetListSnapshots.
+// GET /v1/lists/{list_id}/snapshots
+func (h *TodoHandler) GetListSnapshots(w http.ResponseWriter, r *http.Request, listID types.UUID, params openapi.GetListSnapshotsParams) {
+	series, err := h.todoService.GetListSnapshots(r.Context(), listID.String(), ptr.Deref(params.From, ""), ptr.Deref(params.To, ""))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to get list snapshots via HTTP",
+			"list_id", listID.String(),
+			"from", ptr.Deref(params.From, ""),
+			"to", ptr.Deref(params.To, ""),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	response.OK(w, MapSnapshotSeriesToDTO(series))
+}
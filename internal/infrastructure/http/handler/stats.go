@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+	"github.com/rezkam/mono/internal/ptr"
+)
+
+// GetListStats implements ServerInterface.GetListStats.
+// GET /v1/lists/{list_id}/stats
+func (h *TodoHandler) GetListStats(w http.ResponseWriter, r *http.Request, listID types.UUID, params openapi.GetListStatsParams) {
+	groupBy := string(ptr.Deref(params.GroupBy, ""))
+	stats, err := h.todoService.GetListStats(r.Context(), listID.String(), params.From, params.To, groupBy, ptr.Deref(params.Tz, ""))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to get list stats via HTTP",
+			"list_id", listID.String(),
+			"group_by", groupBy,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	response.OK(w, MapListStatsToDTO(stats))
+}
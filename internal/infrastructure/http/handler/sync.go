@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+	"github.com/rezkam/mono/internal/ptr"
+)
+
+// Sync implements ServerInterface.Sync.
+// GET /v1/sync
+func (h *TodoHandler) Sync(w http.ResponseWriter, r *http.Request, params openapi.SyncParams) {
+	after, err := parseSyncCursor(params.Cursor)
+	if err != nil {
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	page, err := h.todoService.Sync(r.Context(), after, ptr.Deref(params.Limit, 0))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to sync via HTTP", "error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	resp := openapi.SyncResponse{
+		Lists:     make([]openapi.TodoList, len(page.Lists)),
+		Items:     make([]openapi.TodoItem, len(page.Items)),
+		Templates: make([]openapi.RecurringItemTemplate, len(page.Templates)),
+		Deleted:   make([]openapi.SyncTombstone, len(page.Deleted)),
+		Cursor:    generateSyncCursor(page.Cursor),
+		HasMore:   page.HasMore,
+	}
+	for i, list := range page.Lists {
+		resp.Lists[i] = MapListToDTO(list)
+	}
+	for i, item := range page.Items {
+		resp.Items[i] = MapItemToDTO(item)
+	}
+	for i, template := range page.Templates {
+		resp.Templates[i] = MapTemplateToDTO(template)
+	}
+	for i, tombstone := range page.Deleted {
+		resp.Deleted[i] = MapSyncTombstoneToDTO(tombstone)
+	}
+
+	response.OK(w, resp)
+}
+
+// generateSyncCursor encodes a sync cursor as an opaque string.
+func generateSyncCursor(cursor domain.SyncCursor) string {
+	return base64.URLEncoding.EncodeToString(fmt.Appendf(nil, "%d:%d", cursor.TxID, cursor.Seq))
+}
+
+// parseSyncCursor decodes a sync cursor.
+// Returns the zero cursor (sync everything) if the cursor is nil or empty.
+func parseSyncCursor(cursor *string) (domain.SyncCursor, error) {
+	if cursor == nil || *cursor == "" {
+		return domain.SyncCursor{}, nil
+	}
+
+	decoded, err := base64.URLEncoding.DecodeString(*cursor)
+	if err != nil {
+		return domain.SyncCursor{}, domain.ErrInvalidSyncCursor
+	}
+
+	var parsed domain.SyncCursor
+	var rest string
+	if n, _ := fmt.Sscanf(string(decoded), "%d:%d%s", &parsed.TxID, &parsed.Seq, &rest); n != 2 || parsed.Seq < 0 {
+		return domain.SyncCursor{}, domain.ErrInvalidSyncCursor
+	}
+	return parsed, nil
+}
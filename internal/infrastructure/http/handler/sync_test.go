@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCursor_RoundTrip(t *testing.T) {
+	cursor := domain.SyncCursor{TxID: 1<<40 + 7, Seq: 12345}
+
+	encoded := generateSyncCursor(cursor)
+	parsed, err := parseSyncCursor(&encoded)
+	require.NoError(t, err)
+	assert.Equal(t, cursor, parsed)
+}
+
+func TestParseSyncCursor_EmptyMeansEverything(t *testing.T) {
+	empty := ""
+	for _, cursor := range []*string{nil, &empty} {
+		parsed, err := parseSyncCursor(cursor)
+		require.NoError(t, err)
+		assert.Equal(t, domain.SyncCursor{}, parsed)
+	}
+}
+
+func TestParseSyncCursor_RejectsMalformed(t *testing.T) {
+	encode := func(s string) string { return base64.URLEncoding.EncodeToString([]byte(s)) }
+
+	for _, cursor := range []string{"not base64!", encode("12"), encode("a:b"), encode("1:-2"), encode("-1:2"), encode("1:2:3")} {
+		_, err := parseSyncCursor(&cursor)
+		assert.ErrorIs(t, err, domain.ErrInvalidSyncCursor, cursor)
+	}
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+)
+
+// ListTags implements ServerInterface.ListTags.
+// GET /v1/lists/{list_id}/tags
+func (h *TodoHandler) ListTags(w http.ResponseWriter, r *http.Request, listID types.UUID) {
+	tags, err := h.todoService.ListTags(r.Context(), listID.String())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list tags via HTTP",
+			"list_id", listID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dtos := make([]openapi.ListTag, len(tags))
+	for i, tag := range tags {
+		dtos[i] = MapTagUsageToDTO(tag)
+	}
+
+	response.OK(w, openapi.ListTagsResponse{
+		Tags: &dtos,
+	})
+}
+
+// CreateTag implements ServerInterface.CreateTag.
+// POST /v1/lists/{list_id}/tags
+func (h *TodoHandler) CreateTag(w http.ResponseWriter, r *http.Request, listID types.UUID) {
+	var req openapi.CreateTagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	tag, err := h.todoService.CreateTag(r.Context(), &domain.Tag{
+		ListID:      listID.String(),
+		Name:        req.Name,
+		Color:       req.Color,
+		Description: req.Description,
+	})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to create tag via HTTP",
+			"list_id", listID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapTagToDTO(tag)
+	response.Created(w, openapi.TagResponse{
+		Tag: &dto,
+	})
+}
+
+// UpdateTag implements ServerInterface.UpdateTag.
+// PATCH /v1/lists/{list_id}/tags/{tag_name}
+func (h *TodoHandler) UpdateTag(w http.ResponseWriter, r *http.Request, listID types.UUID, tagName string) {
+	var req openapi.UpdateTagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	params := domain.UpdateTagParams{
+		ListID:      listID.String(),
+		Name:        tagName,
+		UpdateMask:  make([]string, len(req.UpdateMask)),
+		Color:       req.Color,
+		Description: req.Description,
+	}
+	for i, field := range req.UpdateMask {
+		params.UpdateMask[i] = string(field)
+	}
+
+	tag, err := h.todoService.UpdateTag(r.Context(), params)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to update tag via HTTP",
+			"list_id", listID.String(),
+			"tag", tagName,
+			"update_mask", params.UpdateMask,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapTagToDTO(tag)
+	response.OK(w, openapi.TagResponse{
+		Tag: &dto,
+	})
+}
+
+// DeleteTag implements ServerInterface.DeleteTag.
+// DELETE /v1/lists/{list_id}/tags/{tag_name}
+func (h *TodoHandler) DeleteTag(w http.ResponseWriter, r *http.Request, listID types.UUID, tagName string) {
+	if err := h.todoService.DeleteTag(r.Context(), listID.String(), tagName); err != nil {
+		slog.ErrorContext(r.Context(), "failed to delete tag via HTTP",
+			"list_id", listID.String(),
+			"tag", tagName,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// RenameTag implements ServerInterface.RenameTag.
+// POST /v1/lists/{list_id}/tags/{tag_name}/rename
+func (h *TodoHandler) RenameTag(w http.ResponseWriter, r *http.Request, listID types.UUID, tagName string) {
+	var req openapi.RenameTagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	rewrite, err := h.todoService.RenameTag(r.Context(), listID.String(), tagName, req.Name)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to rename tag via HTTP",
+			"list_id", listID.String(),
+			"tag", tagName,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	slog.InfoContext(r.Context(), "tag renamed via HTTP",
+		"list_id", listID.String(),
+		"tag", tagName,
+		"new_name", rewrite.Tag,
+		"items", rewrite.Items,
+		"templates", rewrite.Templates)
+
+	response.OK(w, openapi.TagRewriteResponse{
+		Tag:              rewrite.Tag,
+		ItemsUpdated:     rewrite.Items,
+		TemplatesUpdated: rewrite.Templates,
+	})
+}
+
+// MergeTags implements ServerInterface.MergeTags.
+// POST /v1/lists/{list_id}/tags/{tag_name}/merge
+func (h *TodoHandler) MergeTags(w http.ResponseWriter, r *http.Request, listID types.UUID, tagName string) {
+	var req openapi.MergeTagsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	rewrite, err := h.todoService.MergeTags(r.Context(), listID.String(), tagName, req.Sources)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to merge tags via HTTP",
+			"list_id", listID.String(),
+			"tag", tagName,
+			"sources", req.Sources,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	slog.InfoContext(r.Context(), "tags merged via HTTP",
+		"list_id", listID.String(),
+		"tag", rewrite.Tag,
+		"sources", req.Sources,
+		"items", rewrite.Items,
+		"templates", rewrite.Templates)
+
+	response.OK(w, openapi.TagRewriteResponse{
+		Tag:              rewrite.Tag,
+		ItemsUpdated:     rewrite.Items,
+		TemplatesUpdated: rewrite.Templates,
+	})
+}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+)
+
+// ListTimeEntries implements ServerInterface.ListTimeEntries.
+// GET /v1/lists/{list_id}/items/{item_id}/time-entries
+func (h *TodoHandler) ListTimeEntries(w http.ResponseWriter, r *http.Request, listID types.UUID, itemID types.UUID) {
+	entries, err := h.todoService.ListTimeEntries(r.Context(), listID.String(), itemID.String())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list time entries via HTTP",
+			"list_id", listID.String(),
+			"item_id", itemID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	now := time.Now().UTC()
+	dtos := make([]openapi.TimeEntry, len(entries))
+	for i, entry := range entries {
+		dtos[i] = MapTimeEntryToDTO(entry, now)
+	}
+
+	response.OK(w, openapi.ListTimeEntriesResponse{
+		TimeEntries: &dtos,
+	})
+}
+
+// CreateTimeEntry implements ServerInterface.CreateTimeEntry.
+// POST /v1/lists/{list_id}/items/{item_id}/time-entries
+func (h *TodoHandler) CreateTimeEntry(w http.ResponseWriter, r *http.Request, listID types.UUID, itemID types.UUID) {
+	var req openapi.CreateTimeEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	var notes string
+	if req.Notes != nil {
+		notes = *req.Notes
+	}
+
+	entry, err := h.todoService.CreateTimeEntry(r.Context(), listID.String(), itemID.String(), req.StartedAt, req.EndedAt, notes)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to create time entry via HTTP",
+			"list_id", listID.String(),
+			"item_id", itemID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapTimeEntryToDTO(entry, time.Now().UTC())
+	response.Created(w, openapi.TimeEntryResponse{
+		TimeEntry: &dto,
+	})
+}
+
+// DeleteTimeEntry implements ServerInterface.DeleteTimeEntry.
+// DELETE /v1/lists/{list_id}/items/{item_id}/time-entries/{entry_id}
+func (h *TodoHandler) DeleteTimeEntry(w http.ResponseWriter, r *http.Request, listID types.UUID, itemID types.UUID, entryID types.UUID) {
+	if err := h.todoService.DeleteTimeEntry(r.Context(), listID.String(), itemID.String(), entryID.String()); err != nil {
+		slog.ErrorContext(r.Context(), "failed to delete time entry via HTTP",
+			"list_id", listID.String(),
+			"item_id", itemID.String(),
+			"entry_id", entryID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// StartTimer implements ServerInterface.StartTimer.
+// POST /v1/lists/{list_id}/items/{item_id}/timer/start
+func (h *TodoHandler) StartTimer(w http.ResponseWriter, r *http.Request, listID types.UUID, itemID types.UUID) {
+	notes, ok := decodeTimerNotes(w, r)
+	if !ok {
+		return
+	}
+
+	started, stopped, err := h.todoService.StartTimer(r.Context(), listID.String(), itemID.String(), notes)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to start timer via HTTP",
+			"list_id", listID.String(),
+			"item_id", itemID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	now := time.Now().UTC()
+	resp := openapi.StartTimerResponse{
+		TimeEntry: MapTimeEntryToDTO(started, now),
+	}
+	if stopped != nil {
+		dto := MapTimeEntryToDTO(stopped, now)
+		resp.StoppedTimeEntry = &dto
+	}
+	response.Created(w, resp)
+}
+
+// StopTimer implements ServerInterface.StopTimer.
+// POST /v1/lists/{list_id}/items/{item_id}/timer/stop
+func (h *TodoHandler) StopTimer(w http.ResponseWriter, r *http.Request, listID types.UUID, itemID types.UUID) {
+	notes, ok := decodeTimerNotes(w, r)
+	if !ok {
+		return
+	}
+
+	entry, err := h.todoService.StopTimer(r.Context(), listID.String(), itemID.String(), notes)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to stop timer via HTTP",
+			"list_id", listID.String(),
+			"item_id", itemID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapTimeEntryToDTO(entry, time.Now().UTC())
+	response.OK(w, openapi.TimeEntryResponse{
+		TimeEntry: &dto,
+	})
+}
+
+// GetRunningTimer implements ServerInterface.GetRunningTimer.
+// GET /v1/timer
+func (h *TodoHandler) GetRunningTimer(w http.ResponseWriter, r *http.Request) {
+	entry, err := h.todoService.GetRunningTimer(r.Context())
+	if errors.Is(err, domain.ErrNoRunningTimer) {
+		response.OK(w, openapi.TimeEntryResponse{})
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to get running timer via HTTP", "error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapTimeEntryToDTO(entry, time.Now().UTC())
+	response.OK(w, openapi.TimeEntryResponse{
+		TimeEntry: &dto,
+	})
+}
+
+// decodeTimerNotes reads the optional body of the timer endpoints.
+// An empty body means no notes.
+func decodeTimerNotes(w http.ResponseWriter, r *http.Request) (string, bool) {
+	var req openapi.TimerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.BadRequest(w, "invalid JSON")
+		return "", false
+	}
+	if req.Notes == nil {
+		return "", true
+	}
+	return *req.Notes, true
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"encoding/csv"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+	"github.com/rezkam/mono/internal/ptr"
+)
+
+// GetTimesheet implements ServerInterface.GetTimesheet.
+// GET /v1/reports/timesheet
+func (h *TodoHandler) GetTimesheet(w http.ResponseWriter, r *http.Request, params openapi.GetTimesheetParams) {
+	format := ptr.Deref(params.Format, openapi.Json)
+	if format != openapi.Json && format != openapi.Csv {
+		response.ValidationError(w, "format", "must be json or csv")
+		return
+	}
+
+	groupBy := string(ptr.Deref(params.GroupBy, ""))
+	sheet, err := h.todoService.GetTimesheet(r.Context(), ptr.Deref(params.From, ""), ptr.Deref(params.To, ""), groupBy, ptr.Deref(params.Tz, ""))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to get timesheet via HTTP",
+			"from", ptr.Deref(params.From, ""),
+			"to", ptr.Deref(params.To, ""),
+			"group_by", groupBy,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	if format == openapi.Csv {
+		writeTimesheetCSV(w, r, sheet)
+		return
+	}
+	response.OK(w, MapTimesheetToDTO(sheet))
+}
+
+// writeTimesheetCSV writes a timesheet as one row per group and item.
+func writeTimesheetCSV(w http.ResponseWriter, r *http.Request, sheet *domain.Timesheet) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="timesheet-%s-%s.csv"`,
+		sheet.FirstDay.Format("20060102"), sheet.LastDay.Format("20060102")))
+	w.WriteHeader(http.StatusOK)
+
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"group", "group_name", "item_id", "list_id", "title", "source", "duration", "hours"})
+	for _, group := range sheet.Groups {
+		for _, item := range group.Items {
+			_ = cw.Write([]string{
+				group.Key,
+				group.Name,
+				item.ItemID,
+				item.ListID,
+				item.Title,
+				string(item.Source),
+				domain.FormatDurationISO8601(item.Duration),
+				strconv.FormatFloat(item.Duration.Hours(), 'f', 2, 64),
+			})
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		slog.ErrorContext(r.Context(), "failed to write timesheet CSV", "error", err)
+	}
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+	"github.com/rezkam/mono/internal/ptr"
+)
+
+// ListViews implements ServerInterface.ListViews.
+// GET /v1/views
+func (h *TodoHandler) ListViews(w http.ResponseWriter, r *http.Request) {
+	views, err := h.todoService.ListSavedViews(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list saved views via HTTP", "error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dtos := make([]openapi.SavedView, len(views))
+	for i, view := range views {
+		dtos[i] = MapSavedViewToDTO(view)
+	}
+
+	response.OK(w, openapi.ListViewsResponse{
+		Views: &dtos,
+	})
+}
+
+// CreateView implements ServerInterface.CreateView.
+// POST /v1/views
+func (h *TodoHandler) CreateView(w http.ResponseWriter, r *http.Request) {
+	var req openapi.CreateViewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	view := &domain.SavedView{
+		Name:           req.Name,
+		Filter:         MapViewFilterFromDTO(req.Filter),
+		DueAfter:       MapDueBoundFromDTO(req.DueAfter),
+		DueBefore:      MapDueBoundFromDTO(req.DueBefore),
+		IncludeSnoozed: ptr.Deref(req.IncludeSnoozed, false),
+	}
+	if req.ListId != nil {
+		listID := req.ListId.String()
+		view.ListID = &listID
+	}
+
+	created, err := h.todoService.CreateSavedView(r.Context(), view)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to create saved view via HTTP",
+			"name", req.Name,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapSavedViewToDTO(created)
+	response.Created(w, openapi.ViewResponse{
+		View: &dto,
+	})
+}
+
+// GetView implements ServerInterface.GetView.
+// GET /v1/views/{id}
+func (h *TodoHandler) GetView(w http.ResponseWriter, r *http.Request, id types.UUID) {
+	view, err := h.todoService.GetSavedView(r.Context(), id.String())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to get saved view via HTTP",
+			"view_id", id.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapSavedViewToDTO(view)
+	response.OK(w, openapi.ViewResponse{
+		View: &dto,
+	})
+}
+
+// UpdateView implements ServerInterface.UpdateView.
+// PATCH /v1/views/{id}
+func (h *TodoHandler) UpdateView(w http.ResponseWriter, r *http.Request, id types.UUID) {
+	var req openapi.UpdateViewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	params := domain.UpdateSavedViewParams{
+		ID:             id.String(),
+		UpdateMask:     make([]string, len(req.UpdateMask)),
+		Name:           req.Name,
+		DueAfter:       MapDueBoundFromDTO(req.DueAfter),
+		DueBefore:      MapDueBoundFromDTO(req.DueBefore),
+		IncludeSnoozed: req.IncludeSnoozed,
+	}
+	for i, field := range req.UpdateMask {
+		params.UpdateMask[i] = string(field)
+	}
+	if req.ListId != nil {
+		listID := req.ListId.String()
+		params.ListID = &listID
+	}
+	if req.Filter != nil {
+		filter := MapViewFilterFromDTO(req.Filter)
+		params.Filter = &filter
+	}
+
+	view, err := h.todoService.UpdateSavedView(r.Context(), params)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to update saved view via HTTP",
+			"view_id", id.String(),
+			"update_mask", params.UpdateMask,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapSavedViewToDTO(view)
+	response.OK(w, openapi.ViewResponse{
+		View: &dto,
+	})
+}
+
+// DeleteView implements ServerInterface.DeleteView.
+// DELETE /v1/views/{id}
+func (h *TodoHandler) DeleteView(w http.ResponseWriter, r *http.Request, id types.UUID) {
+	if err := h.todoService.DeleteSavedView(r.Context(), id.String()); err != nil {
+		slog.ErrorContext(r.Context(), "failed to delete saved view via HTTP",
+			"view_id", id.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// ListViewItems implements ServerInterface.ListViewItems.
+// GET /v1/views/{id}/items
+func (h *TodoHandler) ListViewItems(w http.ResponseWriter, r *http.Request, id types.UUID, params openapi.ListViewItemsParams) {
+	view, err := h.todoService.GetSavedView(r.Context(), id.String())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to get saved view via HTTP",
+			"view_id", id.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	// Page tokens belong to one revision of the view: editing it changes the
+	// search they would continue. Relative due bounds are left out, since they
+	// resolve to a new time on every request.
+	query := queryFingerprint("view", view.ID, view.UpdatedAt.UTC().Format(time.RFC3339Nano))
+	after, err := parsePageToken(params.PageToken, query)
+	if err != nil {
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	result, err := h.todoService.ListSavedViewItems(r.Context(), view, getPageSize(params.PageSize), after, ptr.Deref(params.IncludeTotalCount, false))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list saved view items via HTTP",
+			"view_id", id.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	itemDTOs := make([]openapi.TodoItem, len(result.Items))
+	for i, item := range result.Items {
+		itemDTOs[i] = MapItemToDTO(&item)
+	}
+
+	response.OK(w, openapi.ListItemsResponse{
+		Items:         &itemDTOs,
+		NextPageToken: generatePageToken(query, result.NextCursor),
+		TotalCount:    result.TotalCount,
+	})
+}
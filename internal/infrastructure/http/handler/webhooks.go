@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/http/response"
+)
+
+// ListWebhooks implements ServerInterface.ListWebhooks.
+// GET /v1/webhooks
+func (h *TodoHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	subs, err := h.todoService.ListWebhooks(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list webhooks via HTTP", "error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dtos := make([]openapi.Webhook, len(subs))
+	for i, sub := range subs {
+		dtos[i] = MapWebhookToDTO(sub)
+	}
+
+	response.OK(w, openapi.ListWebhooksResponse{
+		Webhooks: &dtos,
+	})
+}
+
+// CreateWebhook implements ServerInterface.CreateWebhook.
+// POST /v1/webhooks
+func (h *TodoHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var req openapi.CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	secret := ""
+	if req.Secret != nil {
+		secret = *req.Secret
+	}
+
+	sub, err := h.todoService.CreateWebhook(r.Context(), req.Url, secret, mapWebhookEventsFromDTO(req.Events))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to create webhook via HTTP",
+			"url", req.Url,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	slog.InfoContext(r.Context(), "webhook created via HTTP",
+		"webhook_id", sub.ID,
+		"events", sub.Events)
+
+	dto := MapWebhookToDTO(sub)
+	response.Created(w, openapi.CreateWebhookResponse{
+		Webhook: &dto,
+		Secret:  &sub.Secret,
+	})
+}
+
+// GetWebhook implements ServerInterface.GetWebhook.
+// GET /v1/webhooks/{id}
+func (h *TodoHandler) GetWebhook(w http.ResponseWriter, r *http.Request, id types.UUID) {
+	sub, err := h.todoService.GetWebhook(r.Context(), id.String())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to get webhook via HTTP",
+			"webhook_id", id.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapWebhookToDTO(sub)
+	response.OK(w, openapi.WebhookResponse{
+		Webhook: &dto,
+	})
+}
+
+// UpdateWebhook implements ServerInterface.UpdateWebhook.
+// PATCH /v1/webhooks/{id}
+func (h *TodoHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request, id types.UUID) {
+	var req openapi.UpdateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid JSON")
+		return
+	}
+
+	params := domain.UpdateWebhookParams{
+		ID:         id.String(),
+		UpdateMask: make([]string, len(req.UpdateMask)),
+		URL:        req.Url,
+		Secret:     req.Secret,
+		IsActive:   req.IsActive,
+	}
+	for i, field := range req.UpdateMask {
+		params.UpdateMask[i] = string(field)
+	}
+	if req.Events != nil {
+		params.Events = mapWebhookEventsFromDTO(*req.Events)
+	}
+
+	sub, err := h.todoService.UpdateWebhook(r.Context(), params)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to update webhook via HTTP",
+			"webhook_id", id.String(),
+			"update_mask", params.UpdateMask,
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dto := MapWebhookToDTO(sub)
+	response.OK(w, openapi.WebhookResponse{
+		Webhook: &dto,
+	})
+}
+
+// DeleteWebhook implements ServerInterface.DeleteWebhook.
+// DELETE /v1/webhooks/{id}
+func (h *TodoHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request, id types.UUID) {
+	if err := h.todoService.DeleteWebhook(r.Context(), id.String()); err != nil {
+		slog.ErrorContext(r.Context(), "failed to delete webhook via HTTP",
+			"webhook_id", id.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	response.NoContent(w)
+}
+
+// ListWebhookDeliveries implements ServerInterface.ListWebhookDeliveries.
+// GET /v1/webhooks/{id}/deliveries
+func (h *TodoHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id types.UUID, params openapi.ListWebhookDeliveriesParams) {
+	var status *string
+	if params.Status != nil {
+		s := string(*params.Status)
+		status = &s
+	}
+
+	limit := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	deliveries, err := h.todoService.ListWebhookDeliveries(r.Context(), id.String(), status, limit)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list webhook deliveries via HTTP",
+			"webhook_id", id.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	dtos := make([]openapi.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		dtos[i] = MapWebhookDeliveryToDTO(delivery)
+	}
+
+	response.OK(w, openapi.ListWebhookDeliveriesResponse{
+		Deliveries: &dtos,
+	})
+}
+
+// RedeliverWebhookDelivery implements ServerInterface.RedeliverWebhookDelivery.
+// POST /v1/webhooks/{id}/deliveries/{delivery_id}/redeliver
+func (h *TodoHandler) RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request, id types.UUID, deliveryID types.UUID) {
+	delivery, err := h.todoService.RedeliverWebhookDelivery(r.Context(), id.String(), deliveryID.String())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to redeliver webhook delivery via HTTP",
+			"webhook_id", id.String(),
+			"delivery_id", deliveryID.String(),
+			"error", err)
+		response.FromDomainError(w, r, err)
+		return
+	}
+
+	slog.InfoContext(r.Context(), "webhook delivery queued for redelivery via HTTP",
+		"webhook_id", id.String(),
+		"delivery_id", deliveryID.String(),
+		"new_delivery_id", delivery.ID)
+
+	dto := MapWebhookDeliveryToDTO(delivery)
+	response.Created(w, openapi.WebhookDeliveryResponse{
+		Delivery: &dto,
+	})
+}
+
+func mapWebhookEventsFromDTO(events []openapi.WebhookEventType) []string {
+	out := make([]string, len(events))
+	for i, e := range events {
+		out[i] = string(e)
+	}
+	return out
+}
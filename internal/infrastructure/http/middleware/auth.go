@@ -13,20 +13,34 @@ import (
 
 // Auth is HTTP middleware for API key authentication.
 type Auth struct {
-	authenticator *auth.Authenticator
+	authenticator  *auth.Authenticator
+	publicPrefixes []string
 }
 
 // NewAuth creates a new auth middleware.
-func NewAuth(authenticator *auth.Authenticator) *Auth {
+// GET requests whose path starts with one of publicPrefixes pass through
+// without a principal; their handlers authenticate them some other way, as
+// data access without a principal is refused.
+func NewAuth(authenticator *auth.Authenticator, publicPrefixes ...string) *Auth {
 	return &Auth{
-		authenticator: authenticator,
+		authenticator:  authenticator,
+		publicPrefixes: publicPrefixes,
 	}
 }
 
 // Validate is a Chi middleware that validates API keys from Authorization header.
 // Expects format: "Authorization: Bearer <api-key>"
+// On success, the authenticated principal (key, owner and scopes) is attached to the
+// request context so downstream layers can scope data access to the tenant.
 func (a *Auth) Validate(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, prefix := range a.publicPrefixes {
+			if r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, prefix) {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+
 		// Extract API key from Authorization header
 		authHeader := r.Header.Get("Authorization")
 		if authHeader == "" {
@@ -70,9 +84,17 @@ func (a *Auth) Validate(next http.Handler) http.Handler {
 			"path", r.URL.Path,
 			"method", r.Method,
 			"key_id", validatedKey.ID,
-			"key_name", validatedKey.Name)
+			"key_name", validatedKey.Name,
+			"owner_id", validatedKey.OwnerID)
+
+		// Propagate principal to handlers, services and repositories
+		ctx := domain.WithPrincipal(r.Context(), domain.Principal{
+			KeyID:   validatedKey.ID,
+			OwnerID: validatedKey.OwnerID,
+			Scopes:  validatedKey.Scopes,
+		})
 
 		// Call next handler
-		next.ServeHTTP(w, r)
+		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
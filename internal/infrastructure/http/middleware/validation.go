@@ -3,15 +3,24 @@ package middleware
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3filter"
 	nethttpmiddleware "github.com/oapi-codegen/nethttp-middleware"
+	"github.com/rezkam/mono/internal/domain"
 )
 
+// iCalendar request bodies (calendar imports) are validated as plain text.
+func init() {
+	openapi3filter.RegisterBodyDecoder("text/calendar", openapi3filter.PlainBodyDecoder)
+}
+
 // ValidationConfig holds configuration for the OpenAPI validation middleware.
 type ValidationConfig struct {
 	// MultiError when true collects all validation errors instead of stopping at first.
@@ -22,8 +31,10 @@ type ValidationConfig struct {
 // The middleware validates incoming requests against the OpenAPI spec,
 // returning 400 Bad Request for invalid requests.
 //
-// Note: Authentication is handled separately by Auth middleware,
-// so we skip OpenAPI security validation here.
+// Note: Authentication is handled separately by Auth middleware, which runs
+// first and attaches the principal to the request context. The OpenAPI security
+// requirements are used for authorization only: every scope an operation declares
+// must be granted to the principal, otherwise the request fails with 403 Forbidden.
 func NewValidator(spec *openapi3.T, config ValidationConfig) func(http.Handler) http.Handler {
 	// Set base path to /api without host validation
 	// This matches our router mounting at /api
@@ -34,10 +45,8 @@ func NewValidator(spec *openapi3.T, config ValidationConfig) func(http.Handler)
 	opts := &nethttpmiddleware.Options{
 		Options: openapi3filter.Options{
 			MultiError: config.MultiError,
-			// Skip authentication validation - handled by Auth middleware
-			AuthenticationFunc: func(_ context.Context, _ *openapi3filter.AuthenticationInput) error {
-				return nil
-			},
+			// Authentication is handled by Auth middleware, only scopes are checked here
+			AuthenticationFunc: authorizeScopes,
 		},
 		ErrorHandlerWithOpts:  validationErrorHandler,
 		SilenceServersWarning: true, // We use relative path /api, not full host
@@ -46,6 +55,50 @@ func NewValidator(spec *openapi3.T, config ValidationConfig) func(http.Handler)
 	return nethttpmiddleware.OapiRequestValidatorWithOptions(spec, opts)
 }
 
+// scopeError reports a scope that the principal is missing.
+type scopeError struct {
+	scope string
+}
+
+func (e *scopeError) Error() string {
+	return fmt.Sprintf("%s: %s", domain.ErrForbidden, e.scope)
+}
+
+func (e *scopeError) Unwrap() error {
+	return domain.ErrForbidden
+}
+
+// authorizeScopes checks that the authenticated principal was granted every scope
+// the operation declares in its security requirement.
+// Requests without a principal only pass operations that require no scopes.
+func authorizeScopes(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+	principal, _ := domain.PrincipalFromContext(ctx)
+	for _, scope := range input.Scopes {
+		if !principal.HasScope(domain.Scope(scope)) {
+			return &scopeError{scope: scope}
+		}
+	}
+	return nil
+}
+
+// missingScopes returns the scopes reported by authorizeScopes for a failed request.
+// Returns false if the validation error is not an authorization failure.
+func missingScopes(err error) ([]string, bool) {
+	var secErr *openapi3filter.SecurityRequirementsError
+	if !errors.As(err, &secErr) {
+		return nil, false
+	}
+
+	var scopes []string
+	for _, e := range secErr.Errors {
+		var scopeErr *scopeError
+		if errors.As(e, &scopeErr) && !slices.Contains(scopes, scopeErr.scope) {
+			scopes = append(scopes, scopeErr.scope)
+		}
+	}
+	return scopes, len(scopes) > 0
+}
+
 // ErrorResponse matches the OpenAPI ErrorResponse schema.
 // All error responses must use this standard structure.
 type ErrorResponse struct {
@@ -66,6 +119,12 @@ type ErrorField struct {
 // validationErrorHandler formats validation errors as JSON responses using the standard ErrorResponse format.
 // Parses OpenAPI validation errors to extract field-specific details.
 func validationErrorHandler(ctx context.Context, err error, w http.ResponseWriter, r *http.Request, opts nethttpmiddleware.ErrorHandlerOpts) {
+	// Insufficient scope takes precedence over request validation errors
+	if scopes, ok := missingScopes(err); ok {
+		forbiddenErrorHandler(ctx, scopes, w, r)
+		return
+	}
+
 	// Parse validation error to extract field details
 	details := parseValidationError(err)
 
@@ -103,6 +162,43 @@ func validationErrorHandler(ctx context.Context, err error, w http.ResponseWrite
 	_, _ = w.Write(jsonBytes)
 }
 
+// forbiddenErrorHandler responds with 403 Forbidden listing the scopes the API key is missing.
+func forbiddenErrorHandler(ctx context.Context, scopes []string, w http.ResponseWriter, r *http.Request) {
+	slog.WarnContext(ctx, "authorization failed: insufficient scope",
+		"path", r.URL.Path,
+		"method", r.Method,
+		"missing_scopes", scopes)
+
+	details := make([]ErrorField, len(scopes))
+	for i, scope := range scopes {
+		details[i] = ErrorField{Field: "scope", Issue: "missing required scope: " + scope}
+	}
+
+	resp := ErrorResponse{
+		Error: ErrorDetail{
+			Code:    "FORBIDDEN",
+			Message: "API key does not have the required scope",
+			Details: details,
+		},
+	}
+
+	jsonBytes, encErr := json.Marshal(resp)
+	if encErr != nil {
+		slog.ErrorContext(ctx, "failed to marshal forbidden error response",
+			"path", r.URL.Path,
+			"method", r.Method,
+			"error", encErr)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"error":{"code":"INTERNAL_ERROR","message":"failed to encode response","details":[]}}`))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	_, _ = w.Write(jsonBytes)
+}
+
 // parseValidationError extracts field-specific validation details from OpenAPI errors.
 // Returns an empty array if no specific fields can be extracted.
 func parseValidationError(err error) []ErrorField {
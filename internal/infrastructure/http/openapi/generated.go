@@ -25,22 +25,85 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AutomationRuleRunStatus.
+const (
+	AutomationRuleRunStatusFailed    AutomationRuleRunStatus = "failed"
+	AutomationRuleRunStatusSkipped   AutomationRuleRunStatus = "skipped"
+	AutomationRuleRunStatusSucceeded AutomationRuleRunStatus = "succeeded"
+)
+
+// Defines values for BackupConflictKind.
+const (
+	BackupConflictKindItem              BackupConflictKind = "item"
+	BackupConflictKindList              BackupConflictKind = "list"
+	BackupConflictKindRecurringTemplate BackupConflictKind = "recurring_template"
+	BackupConflictKindUnavailable       BackupConflictKind = "unavailable"
+)
+
+// Defines values for ChangeEventEntityType.
+const (
+	ChangeEventEntityTypeItem     ChangeEventEntityType = "item"
+	ChangeEventEntityTypeTemplate ChangeEventEntityType = "template"
+)
+
+// Defines values for ChangeEventOperation.
+const (
+	ChangeEventOperationCreated ChangeEventOperation = "created"
+	ChangeEventOperationDeleted ChangeEventOperation = "deleted"
+	ChangeEventOperationUpdated ChangeEventOperation = "updated"
+)
+
+// Defines values for CustomFieldType.
+const (
+	CustomFieldTypeBoolean CustomFieldType = "boolean"
+	CustomFieldTypeDate    CustomFieldType = "date"
+	CustomFieldTypeEnum    CustomFieldType = "enum"
+	CustomFieldTypeNumber  CustomFieldType = "number"
+	CustomFieldTypeString  CustomFieldType = "string"
+	CustomFieldTypeUrl     CustomFieldType = "url"
+)
+
+// Defines values for DeadLetterReminderErrorType.
+const (
+	Exhausted DeadLetterReminderErrorType = "exhausted"
+	Permanent DeadLetterReminderErrorType = "permanent"
+)
+
+// Defines values for ImportResultAction.
+const (
+	Create ImportResultAction = "create"
+	Fail   ImportResultAction = "fail"
+	Skip   ImportResultAction = "skip"
+)
+
+// Defines values for ImportResultKind.
+const (
+	ImportResultKindItem              ImportResultKind = "item"
+	ImportResultKindRecurringTemplate ImportResultKind = "recurring_template"
+)
+
 // Defines values for ItemPriority.
 const (
-	ItemPriorityHigh   ItemPriority = "high"
-	ItemPriorityLow    ItemPriority = "low"
-	ItemPriorityMedium ItemPriority = "medium"
-	ItemPriorityUrgent ItemPriority = "urgent"
+	High   ItemPriority = "high"
+	Low    ItemPriority = "low"
+	Medium ItemPriority = "medium"
+	Urgent ItemPriority = "urgent"
 )
 
 // Defines values for ItemStatus.
 const (
-	ItemStatusArchived   ItemStatus = "archived"
-	ItemStatusBlocked    ItemStatus = "blocked"
-	ItemStatusCancelled  ItemStatus = "cancelled"
-	ItemStatusDone       ItemStatus = "done"
-	ItemStatusInProgress ItemStatus = "in_progress"
-	ItemStatusTodo       ItemStatus = "todo"
+	Archived   ItemStatus = "archived"
+	Blocked    ItemStatus = "blocked"
+	Cancelled  ItemStatus = "cancelled"
+	Done       ItemStatus = "done"
+	InProgress ItemStatus = "in_progress"
+	Todo       ItemStatus = "todo"
+)
+
+// Defines values for ListRole.
+const (
+	Editor ListRole = "editor"
+	Viewer ListRole = "viewer"
 )
 
 // Defines values for RecurrencePattern.
@@ -54,9 +117,98 @@ const (
 	Yearly    RecurrencePattern = "yearly"
 )
 
+// Defines values for RecurringTemplateExceptionType.
+const (
+	RecurringTemplateExceptionTypeDeleted     RecurringTemplateExceptionType = "deleted"
+	RecurringTemplateExceptionTypeEdited      RecurringTemplateExceptionType = "edited"
+	RecurringTemplateExceptionTypeRescheduled RecurringTemplateExceptionType = "rescheduled"
+)
+
+// Defines values for ReminderAnchor.
+const (
+	ReminderAnchorDueAt    ReminderAnchor = "due_at"
+	ReminderAnchorStartsAt ReminderAnchor = "starts_at"
+)
+
+// Defines values for ReminderStatus.
+const (
+	ReminderStatusDead    ReminderStatus = "dead"
+	ReminderStatusPending ReminderStatus = "pending"
+	ReminderStatusRunning ReminderStatus = "running"
+	ReminderStatusSent    ReminderStatus = "sent"
+	ReminderStatusSkipped ReminderStatus = "skipped"
+)
+
+// Defines values for RestoreReportIdMode.
+const (
+	RestoreReportIdModePreserve RestoreReportIdMode = "preserve"
+	RestoreReportIdModeRemap    RestoreReportIdMode = "remap"
+)
+
+// Defines values for RuleActionType.
+const (
+	AddTag      RuleActionType = "add_tag"
+	CallWebhook RuleActionType = "call_webhook"
+	CreateItem  RuleActionType = "create_item"
+	Move        RuleActionType = "move"
+	SetField    RuleActionType = "set_field"
+)
+
+// Defines values for RuleTriggerType.
+const (
+	RuleTriggerTypeItemCreated       RuleTriggerType = "item.created"
+	RuleTriggerTypeItemOverdue       RuleTriggerType = "item.overdue"
+	RuleTriggerTypeItemStatusChanged RuleTriggerType = "item.status_changed"
+	RuleTriggerTypeItemTagAdded      RuleTriggerType = "item.tag_added"
+)
+
+// Defines values for StatsGroupBy.
+const (
+	StatsGroupByPriority StatsGroupBy = "priority"
+	StatsGroupByTag      StatsGroupBy = "tag"
+	StatsGroupByWeek     StatsGroupBy = "week"
+)
+
+// Defines values for SyncTombstoneEntityType.
+const (
+	SyncTombstoneEntityTypeItem     SyncTombstoneEntityType = "item"
+	SyncTombstoneEntityTypeList     SyncTombstoneEntityType = "list"
+	SyncTombstoneEntityTypeTemplate SyncTombstoneEntityType = "template"
+)
+
+// Defines values for TimesheetFormat.
+const (
+	Csv  TimesheetFormat = "csv"
+	Json TimesheetFormat = "json"
+)
+
+// Defines values for TimesheetGroupBy.
+const (
+	TimesheetGroupByDay  TimesheetGroupBy = "day"
+	TimesheetGroupByList TimesheetGroupBy = "list"
+	TimesheetGroupByTag  TimesheetGroupBy = "tag"
+)
+
+// Defines values for TimesheetSource.
+const (
+	Entries TimesheetSource = "entries"
+	Manual  TimesheetSource = "manual"
+	Status  TimesheetSource = "status"
+)
+
+// Defines values for UpdateAutomationRuleRequestUpdateMask.
+const (
+	UpdateAutomationRuleRequestUpdateMaskActions   UpdateAutomationRuleRequestUpdateMask = "actions"
+	UpdateAutomationRuleRequestUpdateMaskCondition UpdateAutomationRuleRequestUpdateMask = "condition"
+	UpdateAutomationRuleRequestUpdateMaskEnabled   UpdateAutomationRuleRequestUpdateMask = "enabled"
+	UpdateAutomationRuleRequestUpdateMaskName      UpdateAutomationRuleRequestUpdateMask = "name"
+	UpdateAutomationRuleRequestUpdateMaskTrigger   UpdateAutomationRuleRequestUpdateMask = "trigger"
+)
+
 // Defines values for UpdateItemRequestUpdateMask.
 const (
 	UpdateItemRequestUpdateMaskActualDuration    UpdateItemRequestUpdateMask = "actual_duration"
+	UpdateItemRequestUpdateMaskCustomFields      UpdateItemRequestUpdateMask = "custom_fields"
 	UpdateItemRequestUpdateMaskDueAt             UpdateItemRequestUpdateMask = "due_at"
 	UpdateItemRequestUpdateMaskDueOffset         UpdateItemRequestUpdateMask = "due_offset"
 	UpdateItemRequestUpdateMaskEstimatedDuration UpdateItemRequestUpdateMask = "estimated_duration"
@@ -68,8 +220,16 @@ const (
 	UpdateItemRequestUpdateMaskTitle             UpdateItemRequestUpdateMask = "title"
 )
 
+// Defines values for UpdateListRequestUpdateMask.
+const (
+	UpdateListRequestUpdateMaskCustomFields UpdateListRequestUpdateMask = "custom_fields"
+	UpdateListRequestUpdateMaskTitle        UpdateListRequestUpdateMask = "title"
+)
+
 // Defines values for UpdateRecurringTemplateRequestUpdateMask.
 const (
+	UpdateRecurringTemplateRequestUpdateMaskCustomFields          UpdateRecurringTemplateRequestUpdateMask = "custom_fields"
+	UpdateRecurringTemplateRequestUpdateMaskDefaultReminders      UpdateRecurringTemplateRequestUpdateMask = "default_reminders"
 	UpdateRecurringTemplateRequestUpdateMaskDueOffset             UpdateRecurringTemplateRequestUpdateMask = "due_offset"
 	UpdateRecurringTemplateRequestUpdateMaskEstimatedDuration     UpdateRecurringTemplateRequestUpdateMask = "estimated_duration"
 	UpdateRecurringTemplateRequestUpdateMaskGenerationHorizonDays UpdateRecurringTemplateRequestUpdateMask = "generation_horizon_days"
@@ -82,53 +242,268 @@ const (
 	UpdateRecurringTemplateRequestUpdateMaskTitle                 UpdateRecurringTemplateRequestUpdateMask = "title"
 )
 
-// Defines values for ListListsParamsSortBy.
+// Defines values for UpdateTagRequestUpdateMask.
 const (
-	ListListsParamsSortByCreatedAt ListListsParamsSortBy = "created_at"
-	ListListsParamsSortByTitle     ListListsParamsSortBy = "title"
+	Color       UpdateTagRequestUpdateMask = "color"
+	Description UpdateTagRequestUpdateMask = "description"
 )
 
-// Defines values for ListListsParamsSortDir.
+// Defines values for UpdateViewRequestUpdateMask.
 const (
-	ListListsParamsSortDirAsc  ListListsParamsSortDir = "asc"
-	ListListsParamsSortDirDesc ListListsParamsSortDir = "desc"
+	UpdateViewRequestUpdateMaskDueAfter       UpdateViewRequestUpdateMask = "due_after"
+	UpdateViewRequestUpdateMaskDueBefore      UpdateViewRequestUpdateMask = "due_before"
+	UpdateViewRequestUpdateMaskFilter         UpdateViewRequestUpdateMask = "filter"
+	UpdateViewRequestUpdateMaskIncludeSnoozed UpdateViewRequestUpdateMask = "include_snoozed"
+	UpdateViewRequestUpdateMaskListId         UpdateViewRequestUpdateMask = "list_id"
+	UpdateViewRequestUpdateMaskName           UpdateViewRequestUpdateMask = "name"
+)
+
+// Defines values for UpdateWebhookRequestUpdateMask.
+const (
+	UpdateWebhookRequestUpdateMaskEvents   UpdateWebhookRequestUpdateMask = "events"
+	UpdateWebhookRequestUpdateMaskIsActive UpdateWebhookRequestUpdateMask = "is_active"
+	UpdateWebhookRequestUpdateMaskSecret   UpdateWebhookRequestUpdateMask = "secret"
+	UpdateWebhookRequestUpdateMaskUrl      UpdateWebhookRequestUpdateMask = "url"
+)
+
+// Defines values for ViewFilterSortDir.
+const (
+	ViewFilterSortDirAsc  ViewFilterSortDir = "asc"
+	ViewFilterSortDirDesc ViewFilterSortDir = "desc"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Dead      WebhookDeliveryStatus = "dead"
+	Delivered WebhookDeliveryStatus = "delivered"
+	Pending   WebhookDeliveryStatus = "pending"
+	Running   WebhookDeliveryStatus = "running"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeDeadLetterCreated WebhookEventType = "dead_letter.created"
+	WebhookEventTypeItemCreated       WebhookEventType = "item.created"
+	WebhookEventTypeItemDeleted       WebhookEventType = "item.deleted"
+	WebhookEventTypeItemStatusChanged WebhookEventType = "item.status_changed"
+	WebhookEventTypeItemUpdated       WebhookEventType = "item.updated"
+	WebhookEventTypeItemWoke          WebhookEventType = "item.woke"
+	WebhookEventTypeListCreated       WebhookEventType = "list.created"
+	WebhookEventTypeListUpdated       WebhookEventType = "list.updated"
+	WebhookEventTypeRuleTriggered     WebhookEventType = "rule.triggered"
+	WebhookEventTypeTemplateCreated   WebhookEventType = "template.created"
+	WebhookEventTypeTemplateDeleted   WebhookEventType = "template.deleted"
+	WebhookEventTypeTemplateUpdated   WebhookEventType = "template.updated"
+)
+
+// Defines values for ItemSortDir.
+const (
+	ItemSortDirAsc  ItemSortDir = "asc"
+	ItemSortDirDesc ItemSortDir = "desc"
+)
+
+// Defines values for RestoreListBackupParamsIdMode.
+const (
+	RestoreListBackupParamsIdModePreserve RestoreListBackupParamsIdMode = "preserve"
+	RestoreListBackupParamsIdModeRemap    RestoreListBackupParamsIdMode = "remap"
 )
 
-// Defines values for ListItemsParamsStatus.
+// Defines values for SearchItemsParamsSortDir.
 const (
-	ListItemsParamsStatusArchived   ListItemsParamsStatus = "archived"
-	ListItemsParamsStatusBlocked    ListItemsParamsStatus = "blocked"
-	ListItemsParamsStatusCancelled  ListItemsParamsStatus = "cancelled"
-	ListItemsParamsStatusDone       ListItemsParamsStatus = "done"
-	ListItemsParamsStatusInProgress ListItemsParamsStatus = "in_progress"
-	ListItemsParamsStatusTodo       ListItemsParamsStatus = "todo"
+	SearchItemsParamsSortDirAsc  SearchItemsParamsSortDir = "asc"
+	SearchItemsParamsSortDirDesc SearchItemsParamsSortDir = "desc"
 )
 
-// Defines values for ListItemsParamsPriority.
+// Defines values for ListListsParamsSortBy.
 const (
-	ListItemsParamsPriorityHigh   ListItemsParamsPriority = "high"
-	ListItemsParamsPriorityLow    ListItemsParamsPriority = "low"
-	ListItemsParamsPriorityMedium ListItemsParamsPriority = "medium"
-	ListItemsParamsPriorityUrgent ListItemsParamsPriority = "urgent"
+	CreatedAt ListListsParamsSortBy = "created_at"
+	Title     ListListsParamsSortBy = "title"
 )
 
-// Defines values for ListItemsParamsSortBy.
+// Defines values for ListListsParamsSortDir.
 const (
-	ListItemsParamsSortByCreatedAt ListItemsParamsSortBy = "created_at"
-	ListItemsParamsSortByDueAt     ListItemsParamsSortBy = "due_at"
-	ListItemsParamsSortByPriority  ListItemsParamsSortBy = "priority"
-	ListItemsParamsSortByUpdatedAt ListItemsParamsSortBy = "updated_at"
+	ListListsParamsSortDirAsc  ListListsParamsSortDir = "asc"
+	ListListsParamsSortDirDesc ListListsParamsSortDir = "desc"
 )
 
 // Defines values for ListItemsParamsSortDir.
 const (
-	ListItemsParamsSortDirAsc  ListItemsParamsSortDir = "asc"
-	ListItemsParamsSortDirDesc ListItemsParamsSortDir = "desc"
+	Asc  ListItemsParamsSortDir = "asc"
+	Desc ListItemsParamsSortDir = "desc"
 )
 
+// AddListMemberRequest defines model for AddListMemberRequest.
+type AddListMemberRequest struct {
+	PrincipalId openapi_types.UUID `json:"principal_id"`
+
+	// Role Role of a list member. The owner role belongs to the creator of the list and cannot be granted.
+	Role ListRole `json:"role"`
+}
+
+// AgendaEntry defines model for AgendaEntry.
+type AgendaEntry struct {
+	// AllDay True when at is a start date without a time of day
+	AllDay bool `json:"all_day"`
+
+	// At Due, occurrence or start time that placed the item, in the viewer's timezone
+	At   time.Time `json:"at"`
+	Item TodoItem  `json:"item"`
+}
+
+// AgendaResponse defines model for AgendaResponse.
+type AgendaResponse struct {
+	Date     openapi_types.Date `json:"date"`
+	Overdue  []AgendaEntry      `json:"overdue"`
+	Timezone string             `json:"timezone"`
+	Today    []AgendaEntry      `json:"today"`
+
+	// Truncated True when more than 500 items matched and some are missing
+	Truncated bool          `json:"truncated"`
+	Upcoming  []AgendaEntry `json:"upcoming"`
+}
+
+// AutomationRule defines model for AutomationRule.
+type AutomationRule struct {
+	Actions []RuleAction `json:"actions"`
+
+	// Condition Item filters with the same meaning as the listItems query parameters.
+	// The rule only runs for items that match all of them.
+	Condition RuleCondition      `json:"condition"`
+	CreatedAt *time.Time         `json:"created_at,omitempty"`
+	Enabled   bool               `json:"enabled"`
+	Id        openapi_types.UUID `json:"id"`
+	ListId    openapi_types.UUID `json:"list_id"`
+	Name      string             `json:"name"`
+	Trigger   RuleTrigger        `json:"trigger"`
+	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+}
+
+// AutomationRuleResponse defines model for AutomationRuleResponse.
+type AutomationRuleResponse struct {
+	Rule *AutomationRule `json:"rule,omitempty"`
+}
+
+// AutomationRuleRun defines model for AutomationRuleRun.
+type AutomationRuleRun struct {
+	// Actions What each action did, in order
+	Actions   []string  `json:"actions"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Depth Number of rules in the chain that fired this run; 0 for changes made through the API or by the worker.
+	Depth  int                `json:"depth"`
+	Error  *string            `json:"error,omitempty"`
+	Id     openapi_types.UUID `json:"id"`
+	ItemId openapi_types.UUID `json:"item_id"`
+	RuleId openapi_types.UUID `json:"rule_id"`
+
+	// Status Failed runs applied none of their actions; skipped runs were stopped by loop protection or because the rule's owner lost access.
+	Status  AutomationRuleRunStatus `json:"status"`
+	Trigger RuleTriggerType         `json:"trigger"`
+}
+
+// AutomationRuleRunStatus Failed runs applied none of their actions; skipped runs were stopped by loop protection or because the rule's owner lost access.
+type AutomationRuleRunStatus string
+
+// BackupConflict defines model for BackupConflict.
+type BackupConflict struct {
+	Id openapi_types.UUID `json:"id"`
+
+	// Kind Kind of the data holding the ID; unavailable for IDs taken by data you cannot access
+	Kind BackupConflictKind `json:"kind"`
+}
+
+// BackupConflictKind Kind of the data holding the ID; unavailable for IDs taken by data you cannot access
+type BackupConflictKind string
+
+// BackupItem defines model for BackupItem.
+type BackupItem struct {
+	Item TodoItem `json:"item"`
+
+	// StatusHistory Status changes, oldest first
+	StatusHistory []StatusChange `json:"status_history"`
+}
+
+// BackupRecurringTemplate defines model for BackupRecurringTemplate.
+type BackupRecurringTemplate struct {
+	Exceptions []RecurringTemplateException `json:"exceptions"`
+	Template   RecurringItemTemplate        `json:"template"`
+}
+
+// CalendarFeed defines model for CalendarFeed.
+type CalendarFeed struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	ListId    openapi_types.UUID `json:"list_id"`
+	Name      string             `json:"name"`
+
+	// Path Path of the feed, relative to the server. Only returned on creation.
+	Path *string `json:"path,omitempty"`
+
+	// Statuses Only items in these statuses. Empty shows the statuses an item listing shows by default.
+	Statuses []ItemStatus `json:"statuses"`
+
+	// Tags Only items with all of these tags
+	Tags []string `json:"tags"`
+
+	// Token Secret token of the feed. Only returned on creation.
+	Token *string `json:"token,omitempty"`
+}
+
+// CalendarFeedResponse defines model for CalendarFeedResponse.
+type CalendarFeedResponse struct {
+	Feed *CalendarFeed `json:"feed,omitempty"`
+}
+
+// ChangeEvent defines model for ChangeEvent.
+type ChangeEvent struct {
+	// Data The entity after the change (before it, for deletes)
+	Data map[string]interface{} `json:"data"`
+
+	// Diff Changed fields with their old and new values (updates only)
+	Diff       *map[string]FieldChange `json:"diff,omitempty"`
+	EntityId   openapi_types.UUID      `json:"entity_id"`
+	EntityType ChangeEventEntityType   `json:"entity_type"`
+	ListId     openapi_types.UUID      `json:"list_id"`
+	OccurredAt time.Time               `json:"occurred_at"`
+	Operation  ChangeEventOperation    `json:"operation"`
+
+	// Seq Position of the change in the change log
+	Seq     int64 `json:"seq"`
+	Version int   `json:"version"`
+}
+
+// ChangeEventEntityType defines model for ChangeEvent.EntityType.
+type ChangeEventEntityType string
+
+// ChangeEventOperation defines model for ChangeEvent.Operation.
+type ChangeEventOperation string
+
+// CreateAutomationRuleRequest defines model for CreateAutomationRuleRequest.
+type CreateAutomationRuleRequest struct {
+	Actions []RuleAction `json:"actions"`
+
+	// Condition Item filters with the same meaning as the listItems query parameters.
+	// The rule only runs for items that match all of them.
+	Condition *RuleCondition `json:"condition,omitempty"`
+	Enabled   *bool          `json:"enabled,omitempty"`
+	Name      string         `json:"name"`
+	Trigger   RuleTrigger    `json:"trigger"`
+}
+
+// CreateCalendarFeedRequest defines model for CreateCalendarFeedRequest.
+type CreateCalendarFeedRequest struct {
+	Name     *string       `json:"name,omitempty"`
+	Statuses *[]ItemStatus `json:"statuses,omitempty"`
+	Tags     *[]string     `json:"tags,omitempty"`
+}
+
 // CreateItemRequest defines model for CreateItemRequest.
 type CreateItemRequest struct {
-	DueAt *time.Time `json:"due_at,omitempty"`
+	// CustomFields Custom field values keyed by field name. Fields must be declared by the list.
+	// string, enum and url values are strings, number values are numbers,
+	// date values are YYYY-MM-DD strings, and boolean values are booleans.
+	CustomFields *CustomFieldValues `json:"custom_fields,omitempty"`
+	DueAt        *time.Time         `json:"due_at,omitempty"`
 
 	// DueOffset Duration from starts_at to due_at (ISO 8601 duration).
 	// If set with starts_at, due_at = starts_at + due_offset.
@@ -159,7 +534,9 @@ type CreateItemResponse struct {
 
 // CreateListRequest defines model for CreateListRequest.
 type CreateListRequest struct {
-	Title string `json:"title"`
+	// CustomFields Typed custom fields that items and recurring templates in this list can carry
+	CustomFields *[]CustomFieldDefinition `json:"custom_fields,omitempty"`
+	Title        string                   `json:"title"`
 }
 
 // CreateListResponse defines model for CreateListResponse.
@@ -169,6 +546,14 @@ type CreateListResponse struct {
 
 // CreateRecurringTemplateRequest defines model for CreateRecurringTemplateRequest.
 type CreateRecurringTemplateRequest struct {
+	// CustomFields Custom field values keyed by field name. Fields must be declared by the list.
+	// string, enum and url values are strings, number values are numbers,
+	// date values are YYYY-MM-DD strings, and boolean values are booleans.
+	CustomFields *CustomFieldValues `json:"custom_fields,omitempty"`
+
+	// DefaultReminders Relative reminders added to every generated item
+	DefaultReminders *[]DefaultReminder `json:"default_reminders,omitempty"`
+
 	// DueOffset ISO 8601 duration offset from instance date
 	DueOffset *string `json:"due_offset,omitempty"`
 
@@ -194,6 +579,83 @@ type CreateRecurringTemplateResponse struct {
 	Template *RecurringItemTemplate `json:"template,omitempty"`
 }
 
+// CreateReminderRequest Set either remind_at, or relative_to together with before.
+type CreateReminderRequest struct {
+	// Before ISO 8601 duration
+	Before     *string         `json:"before,omitempty"`
+	RelativeTo *ReminderAnchor `json:"relative_to,omitempty"`
+	RemindAt   *time.Time      `json:"remind_at,omitempty"`
+}
+
+// CreateTagRequest defines model for CreateTagRequest.
+type CreateTagRequest struct {
+	// Color #rrggbb hex color
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+}
+
+// CreateTimeEntryRequest defines model for CreateTimeEntryRequest.
+type CreateTimeEntryRequest struct {
+	EndedAt   time.Time `json:"ended_at"`
+	Notes     *string   `json:"notes,omitempty"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// CreateViewRequest defines model for CreateViewRequest.
+type CreateViewRequest struct {
+	// DueAfter One end of a due window. Set either at (a fixed time) or offset_days
+	// (days from the time the view is run, negative for the past).
+	DueAfter *DueBound `json:"due_after,omitempty"`
+
+	// DueBefore One end of a due window. Set either at (a fixed time) or offset_days
+	// (days from the time the view is run, negative for the past).
+	DueBefore *DueBound `json:"due_before,omitempty"`
+
+	// Filter Item filters and sort, with the same meaning as the listItems query parameters.
+	Filter *ViewFilter `json:"filter,omitempty"`
+
+	// IncludeSnoozed Also return items that are snoozed past the time the view is run.
+	IncludeSnoozed *bool               `json:"include_snoozed,omitempty"`
+	ListId         *openapi_types.UUID `json:"list_id,omitempty"`
+	Name           string              `json:"name"`
+}
+
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	Events []WebhookEventType `json:"events"`
+
+	// Secret Signing secret. Generated when omitted.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Absolute http or https URL that receives deliveries. Loopback, link-local and private addresses are rejected.
+	Url string `json:"url"`
+}
+
+// CreateWebhookResponse defines model for CreateWebhookResponse.
+type CreateWebhookResponse struct {
+	// Secret Signing secret. Not returned by any other operation.
+	Secret  *string  `json:"secret,omitempty"`
+	Webhook *Webhook `json:"webhook,omitempty"`
+}
+
+// CustomFieldDefinition defines model for CustomFieldDefinition.
+type CustomFieldDefinition struct {
+	Name string `json:"name"`
+
+	// Options Allowed values (enum fields only)
+	Options *[]string       `json:"options,omitempty"`
+	Type    CustomFieldType `json:"type"`
+}
+
+// CustomFieldType defines model for CustomFieldType.
+type CustomFieldType string
+
+// CustomFieldValues Custom field values keyed by field name. Fields must be declared by the list.
+// string, enum and url values are strings, number values are numbers,
+// date values are YYYY-MM-DD strings, and boolean values are booleans.
+type CustomFieldValues map[string]interface{}
+
 // DeadLetterJob defines model for DeadLetterJob.
 type DeadLetterJob struct {
 	ErrorMessage  *string             `json:"error_message,omitempty"`
@@ -208,6 +670,44 @@ type DeadLetterJob struct {
 	TemplateId    *openapi_types.UUID `json:"template_id,omitempty"`
 }
 
+// DeadLetterReminder defines model for DeadLetterReminder.
+type DeadLetterReminder struct {
+	ErrorMessage *string                     `json:"error_message,omitempty"`
+	ErrorType    DeadLetterReminderErrorType `json:"error_type"`
+	FailedAt     time.Time                   `json:"failed_at"`
+	Id           openapi_types.UUID          `json:"id"`
+	ItemId       openapi_types.UUID          `json:"item_id"`
+	LastWorkerId *string                     `json:"last_worker_id,omitempty"`
+	ListId       openapi_types.UUID          `json:"list_id"`
+	ReminderId   openapi_types.UUID          `json:"reminder_id"`
+	RetryCount   int                         `json:"retry_count"`
+}
+
+// DeadLetterReminderErrorType defines model for DeadLetterReminder.ErrorType.
+type DeadLetterReminderErrorType string
+
+// DefaultReminder defines model for DefaultReminder.
+type DefaultReminder struct {
+	// Before ISO 8601 duration
+	Before     string         `json:"before"`
+	RelativeTo ReminderAnchor `json:"relative_to"`
+}
+
+// DueBound One end of a due window. Set either at (a fixed time) or offset_days
+// (days from the time the view is run, negative for the past).
+type DueBound struct {
+	At         *time.Time `json:"at,omitempty"`
+	OffsetDays *int       `json:"offset_days,omitempty"`
+}
+
+// DurationStats Nearest-rank percentiles as ISO 8601 durations, omitted without samples
+type DurationStats struct {
+	Count int     `json:"count"`
+	P50   *string `json:"p50,omitempty"`
+	P85   *string `json:"p85,omitempty"`
+	P95   *string `json:"p95,omitempty"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error *struct {
@@ -220,6 +720,56 @@ type ErrorResponse struct {
 	} `json:"error,omitempty"`
 }
 
+// EstimateAccuracy Estimated against actual duration of the completed items that have both
+type EstimateAccuracy struct {
+	// Accurate Items whose actual duration was within 25% of the estimate
+	Accurate int `json:"accurate"`
+
+	// ActualTotal ISO 8601 duration
+	ActualTotal string `json:"actual_total"`
+
+	// EstimatedTotal ISO 8601 duration
+	EstimatedTotal string `json:"estimated_total"`
+	Items          int    `json:"items"`
+
+	// MedianRatio Median of the per item actual over estimated ratios
+	MedianRatio *float64 `json:"median_ratio,omitempty"`
+
+	// Ratio Total actual over total estimated duration; above 1 means work was underestimated
+	Ratio *float64 `json:"ratio,omitempty"`
+}
+
+// FieldChange defines model for FieldChange.
+type FieldChange struct {
+	New interface{} `json:"new"`
+	Old interface{} `json:"old"`
+}
+
+// FlowStats defines model for FlowStats.
+type FlowStats struct {
+	// Completed Items completed in the range
+	Completed int `json:"completed"`
+
+	// Created Items created in the range
+	Created int `json:"created"`
+
+	// CycleTime Nearest-rank percentiles as ISO 8601 durations, omitted without samples
+	CycleTime DurationStats `json:"cycle_time"`
+
+	// Estimates Estimated against actual duration of the completed items that have both
+	Estimates EstimateAccuracy `json:"estimates"`
+
+	// Key Tag, priority or week (its Monday as YYYY-MM-DD) of the group. Items without
+	// tags or priority are grouped under the empty key; empty for the totals.
+	Key string `json:"key"`
+
+	// LeadTime Nearest-rank percentiles as ISO 8601 durations, omitted without samples
+	LeadTime DurationStats `json:"lead_time"`
+
+	// TimeInStatus Time spent in each open status within the range, as ISO 8601 durations
+	TimeInStatus TimeInStatus `json:"time_in_status"`
+}
+
 // GetListResponse defines model for GetListResponse.
 type GetListResponse struct {
 	List *TodoList `json:"list,omitempty"`
@@ -230,27 +780,125 @@ type GetRecurringTemplateResponse struct {
 	Template *RecurringItemTemplate `json:"template,omitempty"`
 }
 
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	Created int  `json:"created"`
+	DryRun  bool `json:"dry_run"`
+	Failed  int  `json:"failed"`
+
+	// Results One result per entry, in file order
+	Results []ImportResult `json:"results"`
+	Skipped int            `json:"skipped"`
+}
+
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	// Action create: created, or would be in a dry run.
+	// skip: imported into the list before.
+	// fail: cannot be imported, see reason.
+	Action ImportResultAction `json:"action"`
+
+	// ExternalId ID of the entry in the source file, e.g. an iCalendar UID
+	ExternalId string `json:"external_id"`
+
+	// Id ID of the created item or template; of the earlier one when skipped, unless it was deleted
+	Id   *openapi_types.UUID `json:"id,omitempty"`
+	Kind ImportResultKind    `json:"kind"`
+
+	// Reason Why the entry was skipped or failed
+	Reason *string `json:"reason,omitempty"`
+	Title  string  `json:"title"`
+}
+
+// ImportResultAction create: created, or would be in a dry run.
+// skip: imported into the list before.
+// fail: cannot be imported, see reason.
+type ImportResultAction string
+
+// ImportResultKind defines model for ImportResult.Kind.
+type ImportResultKind string
+
 // ItemPriority defines model for ItemPriority.
 type ItemPriority string
 
 // ItemStatus defines model for ItemStatus.
 type ItemStatus string
 
+// ListAutomationRuleRunsResponse defines model for ListAutomationRuleRunsResponse.
+type ListAutomationRuleRunsResponse struct {
+	Runs *[]AutomationRuleRun `json:"runs,omitempty"`
+}
+
+// ListAutomationRulesResponse defines model for ListAutomationRulesResponse.
+type ListAutomationRulesResponse struct {
+	Rules *[]AutomationRule `json:"rules,omitempty"`
+}
+
+// ListBackup A list with everything in it. Version 1 is the only version; later
+// versions will change it in ways older servers cannot restore.
+type ListBackup struct {
+	ExportedAt time.Time `json:"exported_at"`
+
+	// Items All items, including archived and cancelled ones, oldest first
+	Items              []BackupItem              `json:"items"`
+	List               TodoList                  `json:"list"`
+	RecurringTemplates []BackupRecurringTemplate `json:"recurring_templates"`
+	Version            int                       `json:"version"`
+}
+
+// ListCalendarFeedsResponse defines model for ListCalendarFeedsResponse.
+type ListCalendarFeedsResponse struct {
+	Feeds *[]CalendarFeed `json:"feeds,omitempty"`
+}
+
 // ListDeadLetterJobsResponse defines model for ListDeadLetterJobsResponse.
 type ListDeadLetterJobsResponse struct {
 	Jobs *[]DeadLetterJob `json:"jobs,omitempty"`
 }
 
+// ListDeadLetterRemindersResponse defines model for ListDeadLetterRemindersResponse.
+type ListDeadLetterRemindersResponse struct {
+	Reminders []DeadLetterReminder `json:"reminders"`
+}
+
 // ListItemsResponse defines model for ListItemsResponse.
 type ListItemsResponse struct {
 	Items         *[]TodoItem `json:"items,omitempty"`
 	NextPageToken *string     `json:"next_page_token,omitempty"`
+
+	// TotalCount Total number of matching items across all pages; only returned when include_total_count is set
+	TotalCount *int `json:"total_count,omitempty"`
+}
+
+// ListListMembersResponse defines model for ListListMembersResponse.
+type ListListMembersResponse struct {
+	Members *[]ListMember `json:"members,omitempty"`
 }
 
 // ListListsResponse defines model for ListListsResponse.
 type ListListsResponse struct {
 	Lists         *[]TodoList `json:"lists,omitempty"`
 	NextPageToken *string     `json:"next_page_token,omitempty"`
+
+	// TotalCount Total number of matching lists across all pages; only returned when include_total_count is set
+	TotalCount *int `json:"total_count,omitempty"`
+}
+
+// ListMember defines model for ListMember.
+type ListMember struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// PrincipalId Owner ID of the tenant the list is shared with
+	PrincipalId openapi_types.UUID `json:"principal_id"`
+
+	// Role Role of a list member. The owner role belongs to the creator of the list and cannot be granted.
+	Role      ListRole   `json:"role"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// ListMemberResponse defines model for ListMemberResponse.
+type ListMemberResponse struct {
+	Member *ListMember `json:"member,omitempty"`
 }
 
 // ListRecurringTemplatesResponse defines model for ListRecurringTemplatesResponse.
@@ -258,6 +906,118 @@ type ListRecurringTemplatesResponse struct {
 	Templates *[]RecurringItemTemplate `json:"templates,omitempty"`
 }
 
+// ListRemindersResponse defines model for ListRemindersResponse.
+type ListRemindersResponse struct {
+	Reminders *[]Reminder `json:"reminders,omitempty"`
+}
+
+// ListRole Role of a list member. The owner role belongs to the creator of the list and cannot be granted.
+type ListRole string
+
+// ListSnapshot defines model for ListSnapshot.
+type ListSnapshot struct {
+	// Backfilled True when no snapshot was recorded that day and the counts were reconstructed from status history
+	Backfilled bool               `json:"backfilled"`
+	Date       openapi_types.Date `json:"date"`
+
+	// Open Items that are todo, in progress or blocked
+	Open int `json:"open"`
+
+	// Overdue Open items due before recorded_at
+	Overdue int `json:"overdue"`
+
+	// PriorityCounts Open items per priority
+	PriorityCounts PriorityCounts `json:"priority_counts"`
+
+	// RecordedAt When the counts were taken; the end of the day (at most now) for backfilled days
+	RecordedAt time.Time `json:"recorded_at"`
+
+	// StatusCounts Items per status
+	StatusCounts StatusCounts `json:"status_counts"`
+}
+
+// ListSnapshots defines model for ListSnapshots.
+type ListSnapshots struct {
+	From openapi_types.Date `json:"from"`
+
+	// Snapshots One snapshot per day, oldest first
+	Snapshots []ListSnapshot     `json:"snapshots"`
+	To        openapi_types.Date `json:"to"`
+
+	// Truncated True when more than 10000 items had to be backfilled and backfilled days miss some
+	Truncated bool `json:"truncated"`
+}
+
+// ListStats defines model for ListStats.
+type ListStats struct {
+	From    time.Time     `json:"from"`
+	GroupBy *StatsGroupBy `json:"group_by,omitempty"`
+
+	// Groups Groups ordered by key; empty without group_by
+	Groups []FlowStats `json:"groups"`
+	To     time.Time   `json:"to"`
+	Total  FlowStats   `json:"total"`
+
+	// Truncated True when more than 10000 items matched and some are missing
+	Truncated bool `json:"truncated"`
+}
+
+// ListTag defines model for ListTag.
+type ListTag struct {
+	// Color #rrggbb hex color
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// ItemCount Items of the list with the tag
+	ItemCount int    `json:"item_count"`
+	Name      string `json:"name"`
+
+	// TemplateCount Recurring templates of the list with the tag
+	TemplateCount int `json:"template_count"`
+}
+
+// ListTagsResponse defines model for ListTagsResponse.
+type ListTagsResponse struct {
+	Tags *[]ListTag `json:"tags,omitempty"`
+}
+
+// ListTimeEntriesResponse defines model for ListTimeEntriesResponse.
+type ListTimeEntriesResponse struct {
+	TimeEntries *[]TimeEntry `json:"time_entries,omitempty"`
+}
+
+// ListViewsResponse defines model for ListViewsResponse.
+type ListViewsResponse struct {
+	Views *[]SavedView `json:"views,omitempty"`
+}
+
+// ListWebhookDeliveriesResponse defines model for ListWebhookDeliveriesResponse.
+type ListWebhookDeliveriesResponse struct {
+	Deliveries *[]WebhookDelivery `json:"deliveries,omitempty"`
+}
+
+// ListWebhooksResponse defines model for ListWebhooksResponse.
+type ListWebhooksResponse struct {
+	Webhooks *[]Webhook `json:"webhooks,omitempty"`
+}
+
+// MergeTagsRequest defines model for MergeTagsRequest.
+type MergeTagsRequest struct {
+	// Sources Tags replaced by the tag merged into
+	Sources []string `json:"sources"`
+}
+
+// PriorityCounts Open items per priority
+type PriorityCounts struct {
+	High   int `json:"high"`
+	Low    int `json:"low"`
+	Medium int `json:"medium"`
+
+	// None Open items without priority
+	None   int `json:"none"`
+	Urgent int `json:"urgent"`
+}
+
 // RecurrencePattern defines model for RecurrencePattern.
 type RecurrencePattern string
 
@@ -265,6 +1025,14 @@ type RecurrencePattern string
 type RecurringItemTemplate struct {
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 
+	// CustomFields Custom field values keyed by field name. Fields must be declared by the list.
+	// string, enum and url values are strings, number values are numbers,
+	// date values are YYYY-MM-DD strings, and boolean values are booleans.
+	CustomFields *CustomFieldValues `json:"custom_fields,omitempty"`
+
+	// DefaultReminders Relative reminders added to every generated item
+	DefaultReminders *[]DefaultReminder `json:"default_reminders,omitempty"`
+
 	// DueOffset ISO 8601 duration
 	DueOffset *string `json:"due_offset,omitempty"`
 
@@ -290,17 +1058,378 @@ type RecurringItemTemplate struct {
 	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
 }
 
+// RecurringTemplateException An occurrence of a template that was deleted, rescheduled or edited
+type RecurringTemplateException struct {
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+
+	// ItemId The rescheduled or edited item
+	ItemId   *openapi_types.UUID            `json:"item_id,omitempty"`
+	OccursAt time.Time                      `json:"occurs_at"`
+	Type     RecurringTemplateExceptionType `json:"type"`
+}
+
+// RecurringTemplateExceptionType defines model for RecurringTemplateException.Type.
+type RecurringTemplateExceptionType string
+
+// Reminder defines model for Reminder.
+type Reminder struct {
+	// Before ISO 8601 duration before relative_to (relative reminders only)
+	Before    *string    `json:"before,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// FireAt Resolved fire time. Absent while the item has no relative_to time.
+	FireAt     *time.Time         `json:"fire_at,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+	RelativeTo *ReminderAnchor    `json:"relative_to,omitempty"`
+
+	// RemindAt Absolute fire time (absolute reminders only)
+	RemindAt *time.Time     `json:"remind_at,omitempty"`
+	SentAt   *time.Time     `json:"sent_at,omitempty"`
+	Status   ReminderStatus `json:"status"`
+}
+
+// ReminderAnchor defines model for ReminderAnchor.
+type ReminderAnchor string
+
+// ReminderResponse defines model for ReminderResponse.
+type ReminderResponse struct {
+	Reminder *Reminder `json:"reminder,omitempty"`
+}
+
+// ReminderStatus defines model for ReminderStatus.
+type ReminderStatus string
+
+// RenameTagRequest defines model for RenameTagRequest.
+type RenameTagRequest struct {
+	// Name New name of the tag
+	Name string `json:"name"`
+}
+
+// RestoreReport defines model for RestoreReport.
+type RestoreReport struct {
+	// Conflicts IDs of the backup that are taken; only reported by dry runs with id_mode=preserve
+	Conflicts  []BackupConflict    `json:"conflicts"`
+	DryRun     bool                `json:"dry_run"`
+	Exceptions int                 `json:"exceptions"`
+	IdMode     RestoreReportIdMode `json:"id_mode"`
+	Items      int                 `json:"items"`
+
+	// ListId ID of the restored list
+	ListId             openapi_types.UUID `json:"list_id"`
+	RecurringTemplates int                `json:"recurring_templates"`
+	StatusChanges      int                `json:"status_changes"`
+}
+
+// RestoreReportIdMode defines model for RestoreReport.IdMode.
+type RestoreReportIdMode string
+
 // RetryDeadLetterJobResponse defines model for RetryDeadLetterJobResponse.
 type RetryDeadLetterJobResponse struct {
 	NewJobId *openapi_types.UUID `json:"new_job_id,omitempty"`
 }
 
+// RetryDeadLetterReminderResponse defines model for RetryDeadLetterReminderResponse.
+type RetryDeadLetterReminderResponse struct {
+	// ReminderId Reminder re-armed for delivery
+	ReminderId openapi_types.UUID `json:"reminder_id"`
+}
+
+// RuleAction set_field sets field (status, priority or custom_fields.<name>) to value; a null
+// value clears the priority or the custom field. add_tag adds tag. create_item
+// creates an item with title, tags and priority in list_id (the rule's list when
+// omitted); {{title}} in the title stands for the title of the item the rule fired
+// for. move moves the item and its time entries to list_id; recurring items
+// cannot move. call_webhook queues a rule.triggered delivery to webhook_id.
+type RuleAction struct {
+	Field    *string             `json:"field,omitempty"`
+	ListId   *openapi_types.UUID `json:"list_id,omitempty"`
+	Priority *ItemPriority       `json:"priority,omitempty"`
+	Tag      *string             `json:"tag,omitempty"`
+	Tags     *[]string           `json:"tags,omitempty"`
+	Title    *string             `json:"title,omitempty"`
+	Type     RuleActionType      `json:"type"`
+
+	// Value New value of field, typed as the field.
+	Value     interface{}         `json:"value"`
+	WebhookId *openapi_types.UUID `json:"webhook_id,omitempty"`
+}
+
+// RuleActionType defines model for RuleActionType.
+type RuleActionType string
+
+// RuleCondition Item filters with the same meaning as the listItems query parameters.
+// The rule only runs for items that match all of them.
+type RuleCondition struct {
+	// CustomFields Custom field name to value text; items must match all.
+	CustomFields *map[string]string `json:"custom_fields,omitempty"`
+
+	// Expression Filter expression, as the listItems filter parameter. Relative times resolve when the rule fires.
+	Expression *string         `json:"expression,omitempty"`
+	Priority   *[]ItemPriority `json:"priority,omitempty"`
+	Status     *[]ItemStatus   `json:"status,omitempty"`
+	Tags       *[]string       `json:"tags,omitempty"`
+}
+
+// RuleTrigger defines model for RuleTrigger.
+type RuleTrigger struct {
+	// OverdueBy item.overdue only. ISO 8601 duration an open item must be past its due date.
+	OverdueBy *string `json:"overdue_by,omitempty"`
+
+	// Tag item.tag_added only. Fires only when this tag is added; any tag when omitted.
+	Tag      *string         `json:"tag,omitempty"`
+	ToStatus *ItemStatus     `json:"to_status,omitempty"`
+	Type     RuleTriggerType `json:"type"`
+}
+
+// RuleTriggerType defines model for RuleTriggerType.
+type RuleTriggerType string
+
+// SavedView defines model for SavedView.
+type SavedView struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// DueAfter One end of a due window. Set either at (a fixed time) or offset_days
+	// (days from the time the view is run, negative for the past).
+	DueAfter *DueBound `json:"due_after,omitempty"`
+
+	// DueBefore One end of a due window. Set either at (a fixed time) or offset_days
+	// (days from the time the view is run, negative for the past).
+	DueBefore *DueBound `json:"due_before,omitempty"`
+
+	// Filter Item filters and sort, with the same meaning as the listItems query parameters.
+	Filter ViewFilter         `json:"filter"`
+	Id     openapi_types.UUID `json:"id"`
+
+	// IncludeSnoozed Whether the view also returns items that are snoozed past the time it is run.
+	IncludeSnoozed bool `json:"include_snoozed"`
+
+	// ListId List the view searches. Absent when it searches every accessible list.
+	ListId    *openapi_types.UUID `json:"list_id,omitempty"`
+	Name      string              `json:"name"`
+	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+}
+
+// SnoozeItemRequest defines model for SnoozeItemRequest.
+type SnoozeItemRequest struct {
+	// Until When the item wakes. Must be in the future.
+	Until time.Time `json:"until"`
+}
+
+// StartTimerResponse defines model for StartTimerResponse.
+type StartTimerResponse struct {
+	StoppedTimeEntry *TimeEntry `json:"stopped_time_entry,omitempty"`
+	TimeEntry        TimeEntry  `json:"time_entry"`
+}
+
+// StatsGroupBy defines model for StatsGroupBy.
+type StatsGroupBy string
+
+// StatusChange defines model for StatusChange.
+type StatusChange struct {
+	ChangedAt  time.Time   `json:"changed_at"`
+	FromStatus *ItemStatus `json:"from_status,omitempty"`
+	Notes      *string     `json:"notes,omitempty"`
+	ToStatus   ItemStatus  `json:"to_status"`
+}
+
+// StatusCounts Items per status
+type StatusCounts struct {
+	Archived   int `json:"archived"`
+	Blocked    int `json:"blocked"`
+	Cancelled  int `json:"cancelled"`
+	Done       int `json:"done"`
+	InProgress int `json:"in_progress"`
+	Todo       int `json:"todo"`
+}
+
+// SyncResponse defines model for SyncResponse.
+type SyncResponse struct {
+	// Cursor Opaque cursor to send on the next sync
+	Cursor string `json:"cursor"`
+
+	// Deleted Entities deleted or no longer accessible since the cursor
+	Deleted []SyncTombstone `json:"deleted"`
+
+	// HasMore More changes are waiting; sync again with the new cursor
+	HasMore   bool                    `json:"has_more"`
+	Items     []TodoItem              `json:"items"`
+	Lists     []TodoList              `json:"lists"`
+	Templates []RecurringItemTemplate `json:"templates"`
+}
+
+// SyncTombstone defines model for SyncTombstone.
+type SyncTombstone struct {
+	EntityType SyncTombstoneEntityType `json:"entity_type"`
+	Id         openapi_types.UUID      `json:"id"`
+	ListId     openapi_types.UUID      `json:"list_id"`
+}
+
+// SyncTombstoneEntityType defines model for SyncTombstone.EntityType.
+type SyncTombstoneEntityType string
+
+// Tag defines model for Tag.
+type Tag struct {
+	// Color #rrggbb hex color
+	Color       *string    `json:"color,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Name        string     `json:"name"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// TagResponse defines model for TagResponse.
+type TagResponse struct {
+	Tag *Tag `json:"tag,omitempty"`
+}
+
+// TagRewriteResponse defines model for TagRewriteResponse.
+type TagRewriteResponse struct {
+	ItemsUpdated int `json:"items_updated"`
+
+	// Tag The tag the renamed or merged tags became
+	Tag              string `json:"tag"`
+	TemplatesUpdated int    `json:"templates_updated"`
+}
+
+// TaskwarriorAnnotation defines model for TaskwarriorAnnotation.
+type TaskwarriorAnnotation struct {
+	Description *string `json:"description,omitempty"`
+	Entry       *string `json:"entry,omitempty"`
+}
+
+// TaskwarriorTask A task as `task export` writes it. Dates are UTC in ISO 8601 basic
+// format, e.g. 20260311T100000Z. Other attributes are ignored.
+type TaskwarriorTask struct {
+	Annotations *[]TaskwarriorAnnotation `json:"annotations,omitempty"`
+	Description *string                  `json:"description,omitempty"`
+	Due         *string                  `json:"due,omitempty"`
+	End         *string                  `json:"end,omitempty"`
+	Entry       *string                  `json:"entry,omitempty"`
+	Modified    *string                  `json:"modified,omitempty"`
+	Parent      *string                  `json:"parent,omitempty"`
+
+	// Priority H, M or L
+	Priority *string `json:"priority,omitempty"`
+	Project  *string `json:"project,omitempty"`
+	Recur    *string `json:"recur,omitempty"`
+	Start    *string `json:"start,omitempty"`
+
+	// Status pending, waiting, completed, deleted or recurring
+	Status *string   `json:"status,omitempty"`
+	Tags   *[]string `json:"tags,omitempty"`
+	Until  *string   `json:"until,omitempty"`
+	Uuid   *string   `json:"uuid,omitempty"`
+	Wait   *string   `json:"wait,omitempty"`
+}
+
+// TimeEntry defines model for TimeEntry.
+type TimeEntry struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Duration ISO 8601 duration, up to now for a running timer
+	Duration string `json:"duration"`
+
+	// EndedAt Absent while the timer runs
+	EndedAt *time.Time         `json:"ended_at,omitempty"`
+	Id      openapi_types.UUID `json:"id"`
+	ItemId  openapi_types.UUID `json:"item_id"`
+	ListId  openapi_types.UUID `json:"list_id"`
+	Notes   string             `json:"notes"`
+
+	// PrincipalId Principal who tracked the time
+	PrincipalId openapi_types.UUID `json:"principal_id"`
+	Running     bool               `json:"running"`
+	StartedAt   time.Time          `json:"started_at"`
+	UpdatedAt   *time.Time         `json:"updated_at,omitempty"`
+}
+
+// TimeEntryResponse defines model for TimeEntryResponse.
+type TimeEntryResponse struct {
+	TimeEntry *TimeEntry `json:"time_entry,omitempty"`
+}
+
+// TimeInStatus Time spent in each open status within the range, as ISO 8601 durations
+type TimeInStatus struct {
+	Blocked    string `json:"blocked"`
+	InProgress string `json:"in_progress"`
+	Todo       string `json:"todo"`
+}
+
+// TimerRequest defines model for TimerRequest.
+type TimerRequest struct {
+	Notes *string `json:"notes,omitempty"`
+}
+
+// Timesheet defines model for Timesheet.
+type Timesheet struct {
+	From    openapi_types.Date `json:"from"`
+	GroupBy TimesheetGroupBy   `json:"group_by"`
+
+	// Groups List groups by name, tag and day groups by key; every day of the range for day groups
+	Groups []TimesheetGroup   `json:"groups"`
+	To     openapi_types.Date `json:"to"`
+
+	// Total ISO 8601 duration; items in several tags count once
+	Total string `json:"total"`
+
+	// Truncated True when more than 50000 periods of tracked time matched and some are missing
+	Truncated bool `json:"truncated"`
+
+	// Tz IANA timezone of the days
+	Tz string `json:"tz"`
+}
+
+// TimesheetFormat defines model for TimesheetFormat.
+type TimesheetFormat string
+
+// TimesheetGroup defines model for TimesheetGroup.
+type TimesheetGroup struct {
+	// Duration ISO 8601 duration
+	Duration string `json:"duration"`
+
+	// Items Most time first
+	Items []TimesheetItem `json:"items"`
+
+	// Key List ID, tag (empty for items without tags) or day (YYYY-MM-DD)
+	Key string `json:"key"`
+
+	// Name List title for list groups, the key otherwise
+	Name string `json:"name"`
+}
+
+// TimesheetGroupBy defines model for TimesheetGroupBy.
+type TimesheetGroupBy string
+
+// TimesheetItem defines model for TimesheetItem.
+type TimesheetItem struct {
+	// Duration ISO 8601 duration
+	Duration string             `json:"duration"`
+	ItemId   openapi_types.UUID `json:"item_id"`
+	ListId   openapi_types.UUID `json:"list_id"`
+
+	// Source Where the time of an item comes from: its time entries, its time in
+	// progress, or an actual_duration set by hand.
+	Source TimesheetSource `json:"source"`
+	Title  string          `json:"title"`
+}
+
+// TimesheetSource Where the time of an item comes from: its time entries, its time in
+// progress, or an actual_duration set by hand.
+type TimesheetSource string
+
 // TodoItem defines model for TodoItem.
 type TodoItem struct {
-	// ActualDuration ISO 8601 duration
+	// ActualDuration ISO 8601 duration. The sum of the item's finished time entries; items
+	// without time entries fall back to the time spent in progress.
 	ActualDuration *string    `json:"actual_duration,omitempty"`
 	CreatedAt      *time.Time `json:"created_at,omitempty"`
-	DueAt          *time.Time `json:"due_at,omitempty"`
+
+	// CustomFields Custom field values keyed by field name. Fields must be declared by the list.
+	// string, enum and url values are strings, number values are numbers,
+	// date values are YYYY-MM-DD strings, and boolean values are booleans.
+	CustomFields *CustomFieldValues `json:"custom_fields,omitempty"`
+	DueAt        *time.Time         `json:"due_at,omitempty"`
 
 	// DueOffset Duration from starts_at to due_at (ISO 8601 duration).
 	// If set with starts_at, due_at = starts_at + due_offset.
@@ -316,6 +1445,10 @@ type TodoItem struct {
 	Priority            *ItemPriority       `json:"priority,omitempty"`
 	RecurringTemplateId *openapi_types.UUID `json:"recurring_template_id,omitempty"`
 
+	// SnoozedUntil Set while the item is snoozed. Snoozed items are left out of listings
+	// until this time unless include_snoozed is set. Cleared when the item wakes.
+	SnoozedUntil *time.Time `json:"snoozed_until,omitempty"`
+
 	// StartsAt When the task becomes active/visible.
 	// For recurring items: set from the occurrence pattern.
 	// For direct items: explicitly set to schedule future tasks.
@@ -331,9 +1464,13 @@ type TodoItem struct {
 
 // TodoList defines model for TodoList.
 type TodoList struct {
-	CreatedAt *time.Time          `json:"created_at,omitempty"`
-	Id        *openapi_types.UUID `json:"id,omitempty"`
-	Title     *string             `json:"title,omitempty"`
+	CreatedAt    *time.Time               `json:"created_at,omitempty"`
+	CustomFields *[]CustomFieldDefinition `json:"custom_fields,omitempty"`
+
+	// Etag Entity tag for optimistic concurrency control (RFC 7232). Quoted string format like "1", "2", etc.
+	Etag  *string             `json:"etag,omitempty"`
+	Id    *openapi_types.UUID `json:"id,omitempty"`
+	Title *string             `json:"title,omitempty"`
 
 	// TotalItems Total items in this list
 	TotalItems *int `json:"total_items,omitempty"`
@@ -342,6 +1479,24 @@ type TodoList struct {
 	UndoneItems *int `json:"undone_items,omitempty"`
 }
 
+// UpdateAutomationRuleRequest defines model for UpdateAutomationRuleRequest.
+type UpdateAutomationRuleRequest struct {
+	Actions *[]RuleAction `json:"actions,omitempty"`
+
+	// Condition Item filters with the same meaning as the listItems query parameters.
+	// The rule only runs for items that match all of them.
+	Condition *RuleCondition `json:"condition,omitempty"`
+	Enabled   *bool          `json:"enabled,omitempty"`
+	Name      *string        `json:"name,omitempty"`
+	Trigger   *RuleTrigger   `json:"trigger,omitempty"`
+
+	// UpdateMask Fields to update. Unknown fields are rejected with 400.
+	UpdateMask []UpdateAutomationRuleRequestUpdateMask `json:"update_mask"`
+}
+
+// UpdateAutomationRuleRequestUpdateMask defines model for UpdateAutomationRuleRequest.UpdateMask.
+type UpdateAutomationRuleRequestUpdateMask string
+
 // UpdateItemRequest defines model for UpdateItemRequest.
 type UpdateItemRequest struct {
 	Item TodoItem `json:"item"`
@@ -358,6 +1513,28 @@ type UpdateItemResponse struct {
 	Item *TodoItem `json:"item,omitempty"`
 }
 
+// UpdateListMemberRequest defines model for UpdateListMemberRequest.
+type UpdateListMemberRequest struct {
+	// Role Role of a list member. The owner role belongs to the creator of the list and cannot be granted.
+	Role ListRole `json:"role"`
+}
+
+// UpdateListRequest defines model for UpdateListRequest.
+type UpdateListRequest struct {
+	List TodoList `json:"list"`
+
+	// UpdateMask Fields to update. Unknown fields are rejected with 400.
+	UpdateMask []UpdateListRequestUpdateMask `json:"update_mask"`
+}
+
+// UpdateListRequestUpdateMask defines model for UpdateListRequest.UpdateMask.
+type UpdateListRequestUpdateMask string
+
+// UpdateListResponse defines model for UpdateListResponse.
+type UpdateListResponse struct {
+	List *TodoList `json:"list,omitempty"`
+}
+
 // UpdateRecurringTemplateRequest defines model for UpdateRecurringTemplateRequest.
 type UpdateRecurringTemplateRequest struct {
 	Template RecurringItemTemplate `json:"template"`
@@ -374,9 +1551,187 @@ type UpdateRecurringTemplateResponse struct {
 	Template *RecurringItemTemplate `json:"template,omitempty"`
 }
 
+// UpdateTagRequest defines model for UpdateTagRequest.
+type UpdateTagRequest struct {
+	// Color #rrggbb hex color
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// UpdateMask Fields to update. Masked fields that are omitted are cleared.
+	UpdateMask []UpdateTagRequestUpdateMask `json:"update_mask"`
+}
+
+// UpdateTagRequestUpdateMask defines model for UpdateTagRequest.UpdateMask.
+type UpdateTagRequestUpdateMask string
+
+// UpdateViewRequest defines model for UpdateViewRequest.
+type UpdateViewRequest struct {
+	// DueAfter One end of a due window. Set either at (a fixed time) or offset_days
+	// (days from the time the view is run, negative for the past).
+	DueAfter *DueBound `json:"due_after,omitempty"`
+
+	// DueBefore One end of a due window. Set either at (a fixed time) or offset_days
+	// (days from the time the view is run, negative for the past).
+	DueBefore *DueBound `json:"due_before,omitempty"`
+
+	// Filter Item filters and sort, with the same meaning as the listItems query parameters.
+	Filter         *ViewFilter `json:"filter,omitempty"`
+	IncludeSnoozed *bool       `json:"include_snoozed,omitempty"`
+
+	// ListId Omit with list_id in update_mask to search every accessible list.
+	ListId *openapi_types.UUID `json:"list_id,omitempty"`
+	Name   *string             `json:"name,omitempty"`
+
+	// UpdateMask Fields to update. Masked due bounds that are omitted are cleared.
+	UpdateMask []UpdateViewRequestUpdateMask `json:"update_mask"`
+}
+
+// UpdateViewRequestUpdateMask defines model for UpdateViewRequest.UpdateMask.
+type UpdateViewRequestUpdateMask string
+
+// UpdateWebhookRequest defines model for UpdateWebhookRequest.
+type UpdateWebhookRequest struct {
+	Events   *[]WebhookEventType `json:"events,omitempty"`
+	IsActive *bool               `json:"is_active,omitempty"`
+	Secret   *string             `json:"secret,omitempty"`
+
+	// UpdateMask Fields to update. Unknown fields are rejected with 400.
+	UpdateMask []UpdateWebhookRequestUpdateMask `json:"update_mask"`
+	Url        *string                          `json:"url,omitempty"`
+}
+
+// UpdateWebhookRequestUpdateMask defines model for UpdateWebhookRequest.UpdateMask.
+type UpdateWebhookRequestUpdateMask string
+
+// ViewFilter Item filters and sort, with the same meaning as the listItems query parameters.
+type ViewFilter struct {
+	// CustomFields Custom field name to value text; items must match all.
+	CustomFields *map[string]string `json:"custom_fields,omitempty"`
+
+	// Expression Filter expression, as the listItems filter parameter. Relative times resolve when the view is run.
+	Expression *string            `json:"expression,omitempty"`
+	Priority   *[]ItemPriority    `json:"priority,omitempty"`
+	SortBy     *string            `json:"sort_by,omitempty"`
+	SortDir    *ViewFilterSortDir `json:"sort_dir,omitempty"`
+	Status     *[]ItemStatus      `json:"status,omitempty"`
+	Tags       *[]string          `json:"tags,omitempty"`
+}
+
+// ViewFilterSortDir defines model for ViewFilter.SortDir.
+type ViewFilterSortDir string
+
+// ViewResponse defines model for ViewResponse.
+type ViewResponse struct {
+	View *SavedView `json:"view,omitempty"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt *time.Time         `json:"created_at,omitempty"`
+	Events    []WebhookEventType `json:"events"`
+	Id        openapi_types.UUID `json:"id"`
+	IsActive  bool               `json:"is_active"`
+	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+	Url       string             `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts    int                `json:"attempts"`
+	CreatedAt   *time.Time         `json:"created_at,omitempty"`
+	DeliveredAt *time.Time         `json:"delivered_at,omitempty"`
+	EventId     openapi_types.UUID `json:"event_id"`
+
+	// EventType rule.triggered deliveries are queued by the call_webhook action of an
+	// automation rule, to the subscription it names; subscriptions cannot select it.
+	// item.woke is published when the snooze of an item ends, and carries
+	// previous_snoozed_until.
+	EventType WebhookEventType   `json:"event_type"`
+	Id        openapi_types.UUID `json:"id"`
+	LastError *string            `json:"last_error,omitempty"`
+
+	// NextAttemptAt When a pending delivery is next attempted
+	NextAttemptAt *time.Time          `json:"next_attempt_at,omitempty"`
+	RedeliveryOf  *openapi_types.UUID `json:"redelivery_of,omitempty"`
+
+	// ResponseStatus HTTP status of the last response from the receiver
+	ResponseStatus *int                  `json:"response_status,omitempty"`
+	Status         WebhookDeliveryStatus `json:"status"`
+}
+
+// WebhookDeliveryResponse defines model for WebhookDeliveryResponse.
+type WebhookDeliveryResponse struct {
+	Delivery *WebhookDelivery `json:"delivery,omitempty"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
+// WebhookEventType rule.triggered deliveries are queued by the call_webhook action of an
+// automation rule, to the subscription it names; subscriptions cannot select it.
+// item.woke is published when the snooze of an item ends, and carries
+// previous_snoozed_until.
+type WebhookEventType string
+
+// WebhookResponse defines model for WebhookResponse.
+type WebhookResponse struct {
+	Webhook *Webhook `json:"webhook,omitempty"`
+}
+
+// IncludeSnoozed defines model for IncludeSnoozed.
+type IncludeSnoozed = bool
+
+// IncludeTotalCount defines model for IncludeTotalCount.
+type IncludeTotalCount = bool
+
+// ItemCreatedAfter defines model for ItemCreatedAfter.
+type ItemCreatedAfter = time.Time
+
+// ItemCustomFieldFilter defines model for ItemCustomFieldFilter.
+type ItemCustomFieldFilter = []string
+
+// ItemDueAfter defines model for ItemDueAfter.
+type ItemDueAfter = time.Time
+
+// ItemDueBefore defines model for ItemDueBefore.
+type ItemDueBefore = time.Time
+
+// ItemFilterExpression defines model for ItemFilterExpression.
+type ItemFilterExpression = string
+
+// ItemPageToken defines model for ItemPageToken.
+type ItemPageToken = string
+
+// ItemPriorityFilter defines model for ItemPriorityFilter.
+type ItemPriorityFilter = []ItemPriority
+
+// ItemSortBy defines model for ItemSortBy.
+type ItemSortBy = string
+
+// ItemSortDir defines model for ItemSortDir.
+type ItemSortDir string
+
+// ItemStatusFilter defines model for ItemStatusFilter.
+type ItemStatusFilter = []ItemStatus
+
+// ItemTagsFilter defines model for ItemTagsFilter.
+type ItemTagsFilter = []string
+
+// ItemUpdatedAfter defines model for ItemUpdatedAfter.
+type ItemUpdatedAfter = time.Time
+
+// PageSize defines model for PageSize.
+type PageSize = int
+
 // BadRequest defines model for BadRequest.
 type BadRequest = ErrorResponse
 
+// Conflict defines model for Conflict.
+type Conflict = ErrorResponse
+
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
+
 // InternalError defines model for InternalError.
 type InternalError = ErrorResponse
 
@@ -398,14 +1753,134 @@ type DiscardDeadLetterJobJSONBody struct {
 	Note *string `json:"note,omitempty"`
 }
 
+// ListDeadLetterRemindersParams defines parameters for ListDeadLetterReminders.
+type ListDeadLetterRemindersParams struct {
+	// Limit Maximum number of reminders to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DiscardDeadLetterReminderJSONBody defines parameters for DiscardDeadLetterReminder.
+type DiscardDeadLetterReminderJSONBody struct {
+	// Note Reason for discarding
+	Note *string `json:"note,omitempty"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// Status Only return deliveries in this status
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Maximum number of deliveries to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetAgendaParams defines parameters for GetAgenda.
+type GetAgendaParams struct {
+	// Date Local day as YYYY-MM-DD (defaults to today in tz)
+	Date *string `form:"date,omitempty" json:"date,omitempty"`
+
+	// Tz IANA timezone of the viewer (defaults to UTC)
+	Tz *string `form:"tz,omitempty" json:"tz,omitempty"`
+}
+
+// RestoreListBackupParams defines parameters for RestoreListBackup.
+type RestoreListBackupParams struct {
+	// IdMode Give everything new IDs (remap) or keep the IDs of the backup (preserve)
+	IdMode *RestoreListBackupParamsIdMode `form:"id_mode,omitempty" json:"id_mode,omitempty"`
+
+	// DryRun Validate and report without creating anything (default false)
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// RestoreListBackupParamsIdMode defines parameters for RestoreListBackup.
+type RestoreListBackupParamsIdMode string
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	// LastEventID Cursor of the last event received. The stream resumes after it,
+	// so no change is missed across reconnects. Without it the stream
+	// starts with changes made after connecting.
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// SearchItemsParams defines parameters for SearchItems.
+type SearchItemsParams struct {
+	// ListId Only items of these lists (can specify multiple for OR logic)
+	ListId *[]openapi_types.UUID `form:"list_id,omitempty" json:"list_id,omitempty"`
+
+	// Status Filter by item status (can specify multiple).
+	// If not specified, archived and cancelled items are excluded by default.
+	// Use status=archived to explicitly include archived items.
+	Status *ItemStatusFilter `form:"status,omitempty" json:"status,omitempty"`
+
+	// Priority Filter by item priority (can specify multiple for OR logic)
+	Priority *ItemPriorityFilter `form:"priority,omitempty" json:"priority,omitempty"`
+
+	// Tags Filter by tags (items must have all specified tags)
+	Tags *ItemTagsFilter `form:"tags,omitempty" json:"tags,omitempty"`
+
+	// CustomField Filter by custom field value as name:value (can specify multiple, items must match all).
+	// The value is compared to the text form of the stored value,
+	// e.g. stage:build, story_points:3, billable:true, review_on:2026-01-15.
+	CustomField *ItemCustomFieldFilter `form:"custom_field,omitempty" json:"custom_field,omitempty"`
+
+	// Filter AIP-160 style filter expression, combined with the other filters using AND.
+	// For example: priority >= high AND (tag:work OR tag:oncall) AND due_at < now+2d AND NOT status:blocked
+	//
+	// Fields: status, priority, tags (or tag), title, due_at, created_at, updated_at, custom_fields.<name>.
+	// Operators: = != < <= > >= and : (has a tag, title contains ignoring case, otherwise equals).
+	// field:* matches items with a value for priority, tags, due_at or a custom field.
+	// Terms combine with AND, OR, NOT (or a leading -) and parentheses; OR binds tighter than AND.
+	// Times are RFC 3339 in quotes, YYYY-MM-DD, now, or now+N / now-N with unit m, h, d or w.
+	// A status comparison replaces the default exclusion of archived and cancelled items.
+	Filter *ItemFilterExpression `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// DueBefore Only items due at or before this time
+	DueBefore *ItemDueBefore `form:"due_before,omitempty" json:"due_before,omitempty"`
+
+	// DueAfter Only items due at or after this time
+	DueAfter *ItemDueAfter `form:"due_after,omitempty" json:"due_after,omitempty"`
+
+	// UpdatedAfter Only items last updated at or after this time
+	UpdatedAfter *ItemUpdatedAfter `form:"updated_after,omitempty" json:"updated_after,omitempty"`
+
+	// CreatedAfter Only items created at or after this time
+	CreatedAfter *ItemCreatedAfter `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// IncludeSnoozed Also return items that are snoozed past now.
+	IncludeSnoozed *IncludeSnoozed `form:"include_snoozed,omitempty" json:"include_snoozed,omitempty"`
+
+	// SortBy Field to sort by: due_at, priority, created_at, updated_at,
+	// or custom_fields.<name> to sort by a custom field value (items without a value sort last).
+	SortBy *ItemSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+
+	// SortDir Sort direction
+	SortDir  *SearchItemsParamsSortDir `form:"sort_dir,omitempty" json:"sort_dir,omitempty"`
+	PageSize *PageSize                 `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Page token from previous response. A token only continues the query that
+	// returned it: changing the sort or filters between pages is rejected.
+	PageToken *ItemPageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// IncludeTotalCount Also return the total number of matching items. Counting reads every match, so only ask when needed.
+	IncludeTotalCount *IncludeTotalCount `form:"include_total_count,omitempty" json:"include_total_count,omitempty"`
+}
+
+// SearchItemsParamsSortDir defines parameters for SearchItems.
+type SearchItemsParamsSortDir string
+
 // ListListsParams defines parameters for ListLists.
 type ListListsParams struct {
 	// PageSize Number of lists per page
 	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
 
-	// PageToken Page token from previous response
+	// PageToken Page token from previous response. A token only continues the query that
+	// returned it: changing the sort or filters between pages is rejected.
 	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
 
+	// IncludeTotalCount Also return the total number of matching lists. Counting reads every match, so only ask when needed.
+	IncludeTotalCount *bool `form:"include_total_count,omitempty" json:"include_total_count,omitempty"`
+
 	// TitleContains Filter by title substring (case-insensitive)
 	TitleContains *string `form:"title_contains,omitempty" json:"title_contains,omitempty"`
 
@@ -428,36 +1903,97 @@ type ListListsParamsSortBy string
 // ListListsParamsSortDir defines parameters for ListLists.
 type ListListsParamsSortDir string
 
+// StreamListEventsParams defines parameters for StreamListEvents.
+type StreamListEventsParams struct {
+	// LastEventID Cursor of the last event received. The stream resumes after it,
+	// so no change is missed across reconnects. Without it the stream
+	// starts with changes made after connecting.
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// ImportCalendarParams defines parameters for ImportCalendar.
+type ImportCalendarParams struct {
+	// DryRun Validate and report without creating anything (default false)
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ImportTaskwarriorJSONBody defines parameters for ImportTaskwarrior.
+type ImportTaskwarriorJSONBody = []TaskwarriorTask
+
+// ImportTaskwarriorParams defines parameters for ImportTaskwarrior.
+type ImportTaskwarriorParams struct {
+	// DryRun Validate and report without creating anything (default false)
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ImportTodoTxtTextBody defines parameters for ImportTodoTxt.
+type ImportTodoTxtTextBody = string
+
+// ImportTodoTxtParams defines parameters for ImportTodoTxt.
+type ImportTodoTxtParams struct {
+	// DryRun Validate and report without creating anything (default false)
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
 // ListItemsParams defines parameters for ListItems.
 type ListItemsParams struct {
 	// Status Filter by item status (can specify multiple).
 	// If not specified, archived and cancelled items are excluded by default.
 	// Use status=archived to explicitly include archived items.
-	Status *[]ListItemsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Status *ItemStatusFilter `form:"status,omitempty" json:"status,omitempty"`
 
 	// Priority Filter by item priority (can specify multiple for OR logic)
-	Priority *[]ListItemsParamsPriority `form:"priority,omitempty" json:"priority,omitempty"`
+	Priority *ItemPriorityFilter `form:"priority,omitempty" json:"priority,omitempty"`
 
 	// Tags Filter by tags (items must have all specified tags)
-	Tags *[]string `form:"tags,omitempty" json:"tags,omitempty"`
+	Tags *ItemTagsFilter `form:"tags,omitempty" json:"tags,omitempty"`
 
-	// SortBy Field to sort by
-	SortBy *ListItemsParamsSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+	// CustomField Filter by custom field value as name:value (can specify multiple, items must match all).
+	// The value is compared to the text form of the stored value,
+	// e.g. stage:build, story_points:3, billable:true, review_on:2026-01-15.
+	CustomField *ItemCustomFieldFilter `form:"custom_field,omitempty" json:"custom_field,omitempty"`
 
-	// SortDir Sort direction
-	SortDir   *ListItemsParamsSortDir `form:"sort_dir,omitempty" json:"sort_dir,omitempty"`
-	PageSize  *int                    `form:"page_size,omitempty" json:"page_size,omitempty"`
-	PageToken *string                 `form:"page_token,omitempty" json:"page_token,omitempty"`
-}
+	// Filter AIP-160 style filter expression, combined with the other filters using AND.
+	// For example: priority >= high AND (tag:work OR tag:oncall) AND due_at < now+2d AND NOT status:blocked
+	//
+	// Fields: status, priority, tags (or tag), title, due_at, created_at, updated_at, custom_fields.<name>.
+	// Operators: = != < <= > >= and : (has a tag, title contains ignoring case, otherwise equals).
+	// field:* matches items with a value for priority, tags, due_at or a custom field.
+	// Terms combine with AND, OR, NOT (or a leading -) and parentheses; OR binds tighter than AND.
+	// Times are RFC 3339 in quotes, YYYY-MM-DD, now, or now+N / now-N with unit m, h, d or w.
+	// A status comparison replaces the default exclusion of archived and cancelled items.
+	Filter *ItemFilterExpression `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// DueBefore Only items due at or before this time
+	DueBefore *ItemDueBefore `form:"due_before,omitempty" json:"due_before,omitempty"`
+
+	// DueAfter Only items due at or after this time
+	DueAfter *ItemDueAfter `form:"due_after,omitempty" json:"due_after,omitempty"`
+
+	// UpdatedAfter Only items last updated at or after this time
+	UpdatedAfter *ItemUpdatedAfter `form:"updated_after,omitempty" json:"updated_after,omitempty"`
 
-// ListItemsParamsStatus defines parameters for ListItems.
-type ListItemsParamsStatus string
+	// CreatedAfter Only items created at or after this time
+	CreatedAfter *ItemCreatedAfter `form:"created_after,omitempty" json:"created_after,omitempty"`
 
-// ListItemsParamsPriority defines parameters for ListItems.
-type ListItemsParamsPriority string
+	// IncludeSnoozed Also return items that are snoozed past now.
+	IncludeSnoozed *IncludeSnoozed `form:"include_snoozed,omitempty" json:"include_snoozed,omitempty"`
 
-// ListItemsParamsSortBy defines parameters for ListItems.
-type ListItemsParamsSortBy string
+	// SortBy Field to sort by: due_at, priority, created_at, updated_at,
+	// or custom_fields.<name> to sort by a custom field value (items without a value sort last).
+	SortBy *ItemSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+
+	// SortDir Sort direction
+	SortDir  *ListItemsParamsSortDir `form:"sort_dir,omitempty" json:"sort_dir,omitempty"`
+	PageSize *PageSize               `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Page token from previous response. A token only continues the query that
+	// returned it: changing the sort or filters between pages is rejected.
+	PageToken *ItemPageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// IncludeTotalCount Also return the total number of matching items. Counting reads every match, so only ask when needed.
+	IncludeTotalCount *IncludeTotalCount `form:"include_total_count,omitempty" json:"include_total_count,omitempty"`
+}
 
 // ListItemsParamsSortDir defines parameters for ListItems.
 type ListItemsParamsSortDir string
@@ -470,36 +2006,197 @@ type ListRecurringTemplatesParams struct {
 	ActiveOnly *bool `form:"active_only,omitempty" json:"active_only,omitempty"`
 }
 
-// DiscardDeadLetterJobJSONRequestBody defines body for DiscardDeadLetterJob for application/json ContentType.
-type DiscardDeadLetterJobJSONRequestBody DiscardDeadLetterJobJSONBody
+// ListAutomationRuleRunsParams defines parameters for ListAutomationRuleRuns.
+type ListAutomationRuleRunsParams struct {
+	// Limit Maximum number of runs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
 
-// CreateListJSONRequestBody defines body for CreateList for application/json ContentType.
-type CreateListJSONRequestBody = CreateListRequest
+// GetListSnapshotsParams defines parameters for GetListSnapshots.
+type GetListSnapshotsParams struct {
+	// From First day as YYYY-MM-DD (defaults to 30 days up to to)
+	From *string `form:"from,omitempty" json:"from,omitempty"`
 
-// CreateItemJSONRequestBody defines body for CreateItem for application/json ContentType.
-type CreateItemJSONRequestBody = CreateItemRequest
+	// To Last day as YYYY-MM-DD (defaults to today, UTC); at most 366 days after from
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+}
 
-// UpdateItemJSONRequestBody defines body for UpdateItem for application/json ContentType.
-type UpdateItemJSONRequestBody = UpdateItemRequest
+// GetListStatsParams defines parameters for GetListStats.
+type GetListStatsParams struct {
+	// From Start of the range (defaults to 30 days before to)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
 
-// CreateRecurringTemplateJSONRequestBody defines body for CreateRecurringTemplate for application/json ContentType.
-type CreateRecurringTemplateJSONRequestBody = CreateRecurringTemplateRequest
+	// To End of the range, exclusive (defaults to now); at most 366 days after from
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
 
-// UpdateRecurringTemplateJSONRequestBody defines body for UpdateRecurringTemplate for application/json ContentType.
-type UpdateRecurringTemplateJSONRequestBody = UpdateRecurringTemplateRequest
+	// GroupBy Break the stats down by tag, priority or week (starting Monday)
+	GroupBy *StatsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// List pending dead letter jobs
-	// (GET /v1/admin/dead-letter-jobs)
-	ListDeadLetterJobs(w http.ResponseWriter, r *http.Request, params ListDeadLetterJobsParams)
-	// Discard a dead letter job
-	// (POST /v1/admin/dead-letter-jobs/{id}/discard)
-	DiscardDeadLetterJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
-	// Retry a dead letter job
-	// (POST /v1/admin/dead-letter-jobs/{id}/retry)
-	RetryDeadLetterJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
-	// List all todo lists with pagination
+	// Tz IANA timezone weeks start in (defaults to UTC)
+	Tz *string `form:"tz,omitempty" json:"tz,omitempty"`
+}
+
+// GetTimesheetParams defines parameters for GetTimesheet.
+type GetTimesheetParams struct {
+	// From First day as YYYY-MM-DD (defaults to 30 days up to to)
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day as YYYY-MM-DD (defaults to today in tz); at most 366 days after from
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// GroupBy Break the time down by list, tag or day (defaults to list)
+	GroupBy *TimesheetGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// Format Response format (defaults to json)
+	Format *TimesheetFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Tz IANA timezone of the days (defaults to UTC)
+	Tz *string `form:"tz,omitempty" json:"tz,omitempty"`
+}
+
+// SyncParams defines parameters for Sync.
+type SyncParams struct {
+	// Cursor Cursor from the previous sync
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of changes to read (default 500)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListViewItemsParams defines parameters for ListViewItems.
+type ListViewItemsParams struct {
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Page token from previous response of the same view
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// IncludeTotalCount Also return the total number of matching items. Counting reads every match, so only ask when needed.
+	IncludeTotalCount *bool `form:"include_total_count,omitempty" json:"include_total_count,omitempty"`
+}
+
+// DiscardDeadLetterJobJSONRequestBody defines body for DiscardDeadLetterJob for application/json ContentType.
+type DiscardDeadLetterJobJSONRequestBody DiscardDeadLetterJobJSONBody
+
+// DiscardDeadLetterReminderJSONRequestBody defines body for DiscardDeadLetterReminder for application/json ContentType.
+type DiscardDeadLetterReminderJSONRequestBody DiscardDeadLetterReminderJSONBody
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = CreateWebhookRequest
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = UpdateWebhookRequest
+
+// RestoreListBackupJSONRequestBody defines body for RestoreListBackup for application/json ContentType.
+type RestoreListBackupJSONRequestBody = ListBackup
+
+// CreateListJSONRequestBody defines body for CreateList for application/json ContentType.
+type CreateListJSONRequestBody = CreateListRequest
+
+// UpdateListJSONRequestBody defines body for UpdateList for application/json ContentType.
+type UpdateListJSONRequestBody = UpdateListRequest
+
+// CreateCalendarFeedJSONRequestBody defines body for CreateCalendarFeed for application/json ContentType.
+type CreateCalendarFeedJSONRequestBody = CreateCalendarFeedRequest
+
+// ImportTaskwarriorJSONRequestBody defines body for ImportTaskwarrior for application/json ContentType.
+type ImportTaskwarriorJSONRequestBody = ImportTaskwarriorJSONBody
+
+// ImportTodoTxtTextRequestBody defines body for ImportTodoTxt for text/plain ContentType.
+type ImportTodoTxtTextRequestBody = ImportTodoTxtTextBody
+
+// CreateItemJSONRequestBody defines body for CreateItem for application/json ContentType.
+type CreateItemJSONRequestBody = CreateItemRequest
+
+// UpdateItemJSONRequestBody defines body for UpdateItem for application/json ContentType.
+type UpdateItemJSONRequestBody = UpdateItemRequest
+
+// CreateItemReminderJSONRequestBody defines body for CreateItemReminder for application/json ContentType.
+type CreateItemReminderJSONRequestBody = CreateReminderRequest
+
+// SnoozeItemJSONRequestBody defines body for SnoozeItem for application/json ContentType.
+type SnoozeItemJSONRequestBody = SnoozeItemRequest
+
+// CreateTimeEntryJSONRequestBody defines body for CreateTimeEntry for application/json ContentType.
+type CreateTimeEntryJSONRequestBody = CreateTimeEntryRequest
+
+// StartTimerJSONRequestBody defines body for StartTimer for application/json ContentType.
+type StartTimerJSONRequestBody = TimerRequest
+
+// StopTimerJSONRequestBody defines body for StopTimer for application/json ContentType.
+type StopTimerJSONRequestBody = TimerRequest
+
+// AddListMemberJSONRequestBody defines body for AddListMember for application/json ContentType.
+type AddListMemberJSONRequestBody = AddListMemberRequest
+
+// UpdateListMemberJSONRequestBody defines body for UpdateListMember for application/json ContentType.
+type UpdateListMemberJSONRequestBody = UpdateListMemberRequest
+
+// CreateRecurringTemplateJSONRequestBody defines body for CreateRecurringTemplate for application/json ContentType.
+type CreateRecurringTemplateJSONRequestBody = CreateRecurringTemplateRequest
+
+// UpdateRecurringTemplateJSONRequestBody defines body for UpdateRecurringTemplate for application/json ContentType.
+type UpdateRecurringTemplateJSONRequestBody = UpdateRecurringTemplateRequest
+
+// CreateAutomationRuleJSONRequestBody defines body for CreateAutomationRule for application/json ContentType.
+type CreateAutomationRuleJSONRequestBody = CreateAutomationRuleRequest
+
+// UpdateAutomationRuleJSONRequestBody defines body for UpdateAutomationRule for application/json ContentType.
+type UpdateAutomationRuleJSONRequestBody = UpdateAutomationRuleRequest
+
+// CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
+type CreateTagJSONRequestBody = CreateTagRequest
+
+// UpdateTagJSONRequestBody defines body for UpdateTag for application/json ContentType.
+type UpdateTagJSONRequestBody = UpdateTagRequest
+
+// MergeTagsJSONRequestBody defines body for MergeTags for application/json ContentType.
+type MergeTagsJSONRequestBody = MergeTagsRequest
+
+// RenameTagJSONRequestBody defines body for RenameTag for application/json ContentType.
+type RenameTagJSONRequestBody = RenameTagRequest
+
+// CreateViewJSONRequestBody defines body for CreateView for application/json ContentType.
+type CreateViewJSONRequestBody = CreateViewRequest
+
+// UpdateViewJSONRequestBody defines body for UpdateView for application/json ContentType.
+type UpdateViewJSONRequestBody = UpdateViewRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List pending dead letter jobs
+	// (GET /v1/admin/dead-letter-jobs)
+	ListDeadLetterJobs(w http.ResponseWriter, r *http.Request, params ListDeadLetterJobsParams)
+	// Discard a dead letter job
+	// (POST /v1/admin/dead-letter-jobs/{id}/discard)
+	DiscardDeadLetterJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Retry a dead letter job
+	// (POST /v1/admin/dead-letter-jobs/{id}/retry)
+	RetryDeadLetterJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List pending dead letter reminders
+	// (GET /v1/admin/dead-letter-reminders)
+	ListDeadLetterReminders(w http.ResponseWriter, r *http.Request, params ListDeadLetterRemindersParams)
+	// Discard a dead letter reminder
+	// (POST /v1/admin/dead-letter-reminders/{id}/discard)
+	DiscardDeadLetterReminder(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Retry a dead letter reminder
+	// (POST /v1/admin/dead-letter-reminders/{id}/retry)
+	RetryDeadLetterReminder(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Open items of a local day across lists
+	// (GET /v1/agenda)
+	GetAgenda(w http.ResponseWriter, r *http.Request, params GetAgendaParams)
+	// Restore a list from a backup
+	// (POST /v1/backups/restore)
+	RestoreListBackup(w http.ResponseWriter, r *http.Request, params RestoreListBackupParams)
+	// Stream changes to items and recurring templates of every accessible list
+	// (GET /v1/events)
+	StreamEvents(w http.ResponseWriter, r *http.Request, params StreamEventsParams)
+	// Fetch a calendar feed
+	// (GET /v1/feeds/{token})
+	GetCalendarFeed(w http.ResponseWriter, r *http.Request, token string)
+	// Search items across lists with filtering and sorting
+	// (GET /v1/items)
+	SearchItems(w http.ResponseWriter, r *http.Request, params SearchItemsParams)
+	// List all todo lists with pagination
 	// (GET /v1/lists)
 	ListLists(w http.ResponseWriter, r *http.Request, params ListListsParams)
 	// Create a new todo list
@@ -508,6 +2205,39 @@ type ServerInterface interface {
 	// Get a todo list by ID
 	// (GET /v1/lists/{id})
 	GetList(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update a todo list
+	// (PATCH /v1/lists/{id})
+	UpdateList(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Export a backup of a list
+	// (GET /v1/lists/{list_id}/backup)
+	ExportListBackup(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID)
+	// Stream changes to items and recurring templates of a list
+	// (GET /v1/lists/{list_id}/events)
+	StreamListEvents(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params StreamListEventsParams)
+	// Export the items of a list for Taskwarrior
+	// (GET /v1/lists/{list_id}/export/taskwarrior)
+	ExportTaskwarrior(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID)
+	// Export the items of a list as todo.txt
+	// (GET /v1/lists/{list_id}/export/todotxt)
+	ExportTodoTxt(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID)
+	// List the calendar feeds of a list
+	// (GET /v1/lists/{list_id}/feeds)
+	ListCalendarFeeds(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID)
+	// Create an iCalendar subscription feed for a list
+	// (POST /v1/lists/{list_id}/feeds)
+	CreateCalendarFeed(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID)
+	// Delete a calendar feed
+	// (DELETE /v1/lists/{list_id}/feeds/{feed_id})
+	DeleteCalendarFeed(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, feedId openapi_types.UUID)
+	// Import items from an iCalendar file
+	// (POST /v1/lists/{list_id}/import/ical)
+	ImportCalendar(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params ImportCalendarParams)
+	// Import items from a Taskwarrior export
+	// (POST /v1/lists/{list_id}/import/taskwarrior)
+	ImportTaskwarrior(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params ImportTaskwarriorParams)
+	// Import items from a todo.txt file
+	// (POST /v1/lists/{list_id}/import/todotxt)
+	ImportTodoTxt(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params ImportTodoTxtParams)
 	// List items in a list with filtering and sorting
 	// (GET /v1/lists/{list_id}/items)
 	ListItems(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params ListItemsParams)
@@ -520,6 +2250,48 @@ type ServerInterface interface {
 	// Update an existing item
 	// (PATCH /v1/lists/{list_id}/items/{item_id})
 	UpdateItem(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID)
+	// List the reminders of an item
+	// (GET /v1/lists/{list_id}/items/{item_id}/reminders)
+	ListItemReminders(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID)
+	// Add a reminder to an item
+	// (POST /v1/lists/{list_id}/items/{item_id}/reminders)
+	CreateItemReminder(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID)
+	// Remove a reminder from an item
+	// (DELETE /v1/lists/{list_id}/items/{item_id}/reminders/{reminder_id})
+	DeleteItemReminder(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID, reminderId openapi_types.UUID)
+	// Wake a snoozed item
+	// (DELETE /v1/lists/{list_id}/items/{item_id}/snooze)
+	WakeItem(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID)
+	// Snooze an item
+	// (POST /v1/lists/{list_id}/items/{item_id}/snooze)
+	SnoozeItem(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID)
+	// List the time entries of an item
+	// (GET /v1/lists/{list_id}/items/{item_id}/time-entries)
+	ListTimeEntries(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID)
+	// Add a manual time entry to an item
+	// (POST /v1/lists/{list_id}/items/{item_id}/time-entries)
+	CreateTimeEntry(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID)
+	// Remove a time entry from an item
+	// (DELETE /v1/lists/{list_id}/items/{item_id}/time-entries/{entry_id})
+	DeleteTimeEntry(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID, entryId openapi_types.UUID)
+	// Start a timer on an item
+	// (POST /v1/lists/{list_id}/items/{item_id}/timer/start)
+	StartTimer(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID)
+	// Stop the running timer on an item
+	// (POST /v1/lists/{list_id}/items/{item_id}/timer/stop)
+	StopTimer(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID)
+	// List the principals a list is shared with
+	// (GET /v1/lists/{list_id}/members)
+	ListListMembers(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID)
+	// Share a list with another principal
+	// (POST /v1/lists/{list_id}/members)
+	AddListMember(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID)
+	// Revoke the access of a list member
+	// (DELETE /v1/lists/{list_id}/members/{principal_id})
+	RemoveListMember(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, principalId openapi_types.UUID)
+	// Change the role of a list member
+	// (PATCH /v1/lists/{list_id}/members/{principal_id})
+	UpdateListMember(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, principalId openapi_types.UUID)
 	// List recurring templates for a list
 	// (GET /v1/lists/{list_id}/recurring-templates)
 	ListRecurringTemplates(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params ListRecurringTemplatesParams)
@@ -535,6 +2307,96 @@ type ServerInterface interface {
 	// Update a recurring template
 	// (PATCH /v1/lists/{list_id}/recurring-templates/{template_id})
 	UpdateRecurringTemplate(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, templateId openapi_types.UUID)
+	// List the automation rules of a list
+	// (GET /v1/lists/{list_id}/rules)
+	ListAutomationRules(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID)
+	// Create an automation rule
+	// (POST /v1/lists/{list_id}/rules)
+	CreateAutomationRule(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID)
+	// Delete an automation rule and its execution log
+	// (DELETE /v1/lists/{list_id}/rules/{rule_id})
+	DeleteAutomationRule(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, ruleId openapi_types.UUID)
+	// Get an automation rule
+	// (GET /v1/lists/{list_id}/rules/{rule_id})
+	GetAutomationRule(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, ruleId openapi_types.UUID)
+	// Update an automation rule
+	// (PATCH /v1/lists/{list_id}/rules/{rule_id})
+	UpdateAutomationRule(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, ruleId openapi_types.UUID)
+	// List recent runs of an automation rule
+	// (GET /v1/lists/{list_id}/rules/{rule_id}/runs)
+	ListAutomationRuleRuns(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, ruleId openapi_types.UUID, params ListAutomationRuleRunsParams)
+	// Daily item counts of a list
+	// (GET /v1/lists/{list_id}/snapshots)
+	GetListSnapshots(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params GetListSnapshotsParams)
+	// Flow metrics of a list
+	// (GET /v1/lists/{list_id}/stats)
+	GetListStats(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params GetListStatsParams)
+	// List the tags of a list with usage counts
+	// (GET /v1/lists/{list_id}/tags)
+	ListTags(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID)
+	// Register a tag on a list
+	// (POST /v1/lists/{list_id}/tags)
+	CreateTag(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID)
+	// Remove the registration of a tag
+	// (DELETE /v1/lists/{list_id}/tags/{tag_name})
+	DeleteTag(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, tagName string)
+	// Update a registered tag
+	// (PATCH /v1/lists/{list_id}/tags/{tag_name})
+	UpdateTag(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, tagName string)
+	// Merge tags into a tag
+	// (POST /v1/lists/{list_id}/tags/{tag_name}/merge)
+	MergeTags(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, tagName string)
+	// Rename a tag on every item and recurring template of a list
+	// (POST /v1/lists/{list_id}/tags/{tag_name}/rename)
+	RenameTag(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, tagName string)
+	// Time tracked on items per list, tag or day
+	// (GET /v1/reports/timesheet)
+	GetTimesheet(w http.ResponseWriter, r *http.Request, params GetTimesheetParams)
+	// Get lists, items and recurring templates changed since a cursor
+	// (GET /v1/sync)
+	Sync(w http.ResponseWriter, r *http.Request, params SyncParams)
+	// Get the caller's running timer
+	// (GET /v1/timer)
+	GetRunningTimer(w http.ResponseWriter, r *http.Request)
+	// List saved views
+	// (GET /v1/views)
+	ListViews(w http.ResponseWriter, r *http.Request)
+	// Save a named item search
+	// (POST /v1/views)
+	CreateView(w http.ResponseWriter, r *http.Request)
+	// Delete a saved view
+	// (DELETE /v1/views/{id})
+	DeleteView(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a saved view
+	// (GET /v1/views/{id})
+	GetView(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update a saved view
+	// (PATCH /v1/views/{id})
+	UpdateView(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Run a saved view
+	// (GET /v1/views/{id}/items)
+	ListViewItems(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListViewItemsParams)
+	// List webhook subscriptions
+	// (GET /v1/webhooks)
+	ListWebhooks(w http.ResponseWriter, r *http.Request)
+	// Subscribe a URL to events
+	// (POST /v1/webhooks)
+	CreateWebhook(w http.ResponseWriter, r *http.Request)
+	// Delete a webhook subscription and its deliveries
+	// (DELETE /v1/webhooks/{id})
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a webhook subscription
+	// (GET /v1/webhooks/{id})
+	GetWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update a webhook subscription
+	// (PATCH /v1/webhooks/{id})
+	UpdateWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List recent deliveries of a webhook subscription
+	// (GET /v1/webhooks/{id}/deliveries)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListWebhookDeliveriesParams)
+	// Queue a new delivery of the same event
+	// (POST /v1/webhooks/{id}/deliveries/{delivery_id}/redeliver)
+	RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, deliveryId openapi_types.UUID)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -559,6 +2421,54 @@ func (_ Unimplemented) RetryDeadLetterJob(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List pending dead letter reminders
+// (GET /v1/admin/dead-letter-reminders)
+func (_ Unimplemented) ListDeadLetterReminders(w http.ResponseWriter, r *http.Request, params ListDeadLetterRemindersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Discard a dead letter reminder
+// (POST /v1/admin/dead-letter-reminders/{id}/discard)
+func (_ Unimplemented) DiscardDeadLetterReminder(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Retry a dead letter reminder
+// (POST /v1/admin/dead-letter-reminders/{id}/retry)
+func (_ Unimplemented) RetryDeadLetterReminder(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Open items of a local day across lists
+// (GET /v1/agenda)
+func (_ Unimplemented) GetAgenda(w http.ResponseWriter, r *http.Request, params GetAgendaParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore a list from a backup
+// (POST /v1/backups/restore)
+func (_ Unimplemented) RestoreListBackup(w http.ResponseWriter, r *http.Request, params RestoreListBackupParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream changes to items and recurring templates of every accessible list
+// (GET /v1/events)
+func (_ Unimplemented) StreamEvents(w http.ResponseWriter, r *http.Request, params StreamEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Fetch a calendar feed
+// (GET /v1/feeds/{token})
+func (_ Unimplemented) GetCalendarFeed(w http.ResponseWriter, r *http.Request, token string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search items across lists with filtering and sorting
+// (GET /v1/items)
+func (_ Unimplemented) SearchItems(w http.ResponseWriter, r *http.Request, params SearchItemsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List all todo lists with pagination
 // (GET /v1/lists)
 func (_ Unimplemented) ListLists(w http.ResponseWriter, r *http.Request, params ListListsParams) {
@@ -577,6 +2487,72 @@ func (_ Unimplemented) GetList(w http.ResponseWriter, r *http.Request, id openap
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Update a todo list
+// (PATCH /v1/lists/{id})
+func (_ Unimplemented) UpdateList(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export a backup of a list
+// (GET /v1/lists/{list_id}/backup)
+func (_ Unimplemented) ExportListBackup(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream changes to items and recurring templates of a list
+// (GET /v1/lists/{list_id}/events)
+func (_ Unimplemented) StreamListEvents(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params StreamListEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export the items of a list for Taskwarrior
+// (GET /v1/lists/{list_id}/export/taskwarrior)
+func (_ Unimplemented) ExportTaskwarrior(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export the items of a list as todo.txt
+// (GET /v1/lists/{list_id}/export/todotxt)
+func (_ Unimplemented) ExportTodoTxt(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the calendar feeds of a list
+// (GET /v1/lists/{list_id}/feeds)
+func (_ Unimplemented) ListCalendarFeeds(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an iCalendar subscription feed for a list
+// (POST /v1/lists/{list_id}/feeds)
+func (_ Unimplemented) CreateCalendarFeed(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a calendar feed
+// (DELETE /v1/lists/{list_id}/feeds/{feed_id})
+func (_ Unimplemented) DeleteCalendarFeed(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, feedId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import items from an iCalendar file
+// (POST /v1/lists/{list_id}/import/ical)
+func (_ Unimplemented) ImportCalendar(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params ImportCalendarParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import items from a Taskwarrior export
+// (POST /v1/lists/{list_id}/import/taskwarrior)
+func (_ Unimplemented) ImportTaskwarrior(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params ImportTaskwarriorParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import items from a todo.txt file
+// (POST /v1/lists/{list_id}/import/todotxt)
+func (_ Unimplemented) ImportTodoTxt(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params ImportTodoTxtParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List items in a list with filtering and sorting
 // (GET /v1/lists/{list_id}/items)
 func (_ Unimplemented) ListItems(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params ListItemsParams) {
@@ -601,6 +2577,90 @@ func (_ Unimplemented) UpdateItem(w http.ResponseWriter, r *http.Request, listId
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List the reminders of an item
+// (GET /v1/lists/{list_id}/items/{item_id}/reminders)
+func (_ Unimplemented) ListItemReminders(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a reminder to an item
+// (POST /v1/lists/{list_id}/items/{item_id}/reminders)
+func (_ Unimplemented) CreateItemReminder(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a reminder from an item
+// (DELETE /v1/lists/{list_id}/items/{item_id}/reminders/{reminder_id})
+func (_ Unimplemented) DeleteItemReminder(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID, reminderId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Wake a snoozed item
+// (DELETE /v1/lists/{list_id}/items/{item_id}/snooze)
+func (_ Unimplemented) WakeItem(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Snooze an item
+// (POST /v1/lists/{list_id}/items/{item_id}/snooze)
+func (_ Unimplemented) SnoozeItem(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the time entries of an item
+// (GET /v1/lists/{list_id}/items/{item_id}/time-entries)
+func (_ Unimplemented) ListTimeEntries(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a manual time entry to an item
+// (POST /v1/lists/{list_id}/items/{item_id}/time-entries)
+func (_ Unimplemented) CreateTimeEntry(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a time entry from an item
+// (DELETE /v1/lists/{list_id}/items/{item_id}/time-entries/{entry_id})
+func (_ Unimplemented) DeleteTimeEntry(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID, entryId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a timer on an item
+// (POST /v1/lists/{list_id}/items/{item_id}/timer/start)
+func (_ Unimplemented) StartTimer(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stop the running timer on an item
+// (POST /v1/lists/{list_id}/items/{item_id}/timer/stop)
+func (_ Unimplemented) StopTimer(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, itemId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the principals a list is shared with
+// (GET /v1/lists/{list_id}/members)
+func (_ Unimplemented) ListListMembers(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Share a list with another principal
+// (POST /v1/lists/{list_id}/members)
+func (_ Unimplemented) AddListMember(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke the access of a list member
+// (DELETE /v1/lists/{list_id}/members/{principal_id})
+func (_ Unimplemented) RemoveListMember(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, principalId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Change the role of a list member
+// (PATCH /v1/lists/{list_id}/members/{principal_id})
+func (_ Unimplemented) UpdateListMember(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, principalId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List recurring templates for a list
 // (GET /v1/lists/{list_id}/recurring-templates)
 func (_ Unimplemented) ListRecurringTemplates(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params ListRecurringTemplatesParams) {
@@ -631,39 +2691,2738 @@ func (_ Unimplemented) UpdateRecurringTemplate(w http.ResponseWriter, r *http.Re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// List the automation rules of a list
+// (GET /v1/lists/{list_id}/rules)
+func (_ Unimplemented) ListAutomationRules(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an automation rule
+// (POST /v1/lists/{list_id}/rules)
+func (_ Unimplemented) CreateAutomationRule(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an automation rule and its execution log
+// (DELETE /v1/lists/{list_id}/rules/{rule_id})
+func (_ Unimplemented) DeleteAutomationRule(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, ruleId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get an automation rule
+// (GET /v1/lists/{list_id}/rules/{rule_id})
+func (_ Unimplemented) GetAutomationRule(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, ruleId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update an automation rule
+// (PATCH /v1/lists/{list_id}/rules/{rule_id})
+func (_ Unimplemented) UpdateAutomationRule(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, ruleId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List recent runs of an automation rule
+// (GET /v1/lists/{list_id}/rules/{rule_id}/runs)
+func (_ Unimplemented) ListAutomationRuleRuns(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, ruleId openapi_types.UUID, params ListAutomationRuleRunsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Daily item counts of a list
+// (GET /v1/lists/{list_id}/snapshots)
+func (_ Unimplemented) GetListSnapshots(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params GetListSnapshotsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Flow metrics of a list
+// (GET /v1/lists/{list_id}/stats)
+func (_ Unimplemented) GetListStats(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, params GetListStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the tags of a list with usage counts
+// (GET /v1/lists/{list_id}/tags)
+func (_ Unimplemented) ListTags(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register a tag on a list
+// (POST /v1/lists/{list_id}/tags)
+func (_ Unimplemented) CreateTag(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove the registration of a tag
+// (DELETE /v1/lists/{list_id}/tags/{tag_name})
+func (_ Unimplemented) DeleteTag(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, tagName string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a registered tag
+// (PATCH /v1/lists/{list_id}/tags/{tag_name})
+func (_ Unimplemented) UpdateTag(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, tagName string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Merge tags into a tag
+// (POST /v1/lists/{list_id}/tags/{tag_name}/merge)
+func (_ Unimplemented) MergeTags(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, tagName string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rename a tag on every item and recurring template of a list
+// (POST /v1/lists/{list_id}/tags/{tag_name}/rename)
+func (_ Unimplemented) RenameTag(w http.ResponseWriter, r *http.Request, listId openapi_types.UUID, tagName string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Time tracked on items per list, tag or day
+// (GET /v1/reports/timesheet)
+func (_ Unimplemented) GetTimesheet(w http.ResponseWriter, r *http.Request, params GetTimesheetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get lists, items and recurring templates changed since a cursor
+// (GET /v1/sync)
+func (_ Unimplemented) Sync(w http.ResponseWriter, r *http.Request, params SyncParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the caller's running timer
+// (GET /v1/timer)
+func (_ Unimplemented) GetRunningTimer(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List saved views
+// (GET /v1/views)
+func (_ Unimplemented) ListViews(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Save a named item search
+// (POST /v1/views)
+func (_ Unimplemented) CreateView(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a saved view
+// (DELETE /v1/views/{id})
+func (_ Unimplemented) DeleteView(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a saved view
+// (GET /v1/views/{id})
+func (_ Unimplemented) GetView(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a saved view
+// (PATCH /v1/views/{id})
+func (_ Unimplemented) UpdateView(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Run a saved view
+// (GET /v1/views/{id}/items)
+func (_ Unimplemented) ListViewItems(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListViewItemsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List webhook subscriptions
+// (GET /v1/webhooks)
+func (_ Unimplemented) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Subscribe a URL to events
+// (POST /v1/webhooks)
+func (_ Unimplemented) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a webhook subscription and its deliveries
+// (DELETE /v1/webhooks/{id})
+func (_ Unimplemented) DeleteWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a webhook subscription
+// (GET /v1/webhooks/{id})
+func (_ Unimplemented) GetWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a webhook subscription
+// (PATCH /v1/webhooks/{id})
+func (_ Unimplemented) UpdateWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List recent deliveries of a webhook subscription
+// (GET /v1/webhooks/{id}/deliveries)
+func (_ Unimplemented) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Queue a new delivery of the same event
+// (POST /v1/webhooks/{id}/deliveries/{delivery_id}/redeliver)
+func (_ Unimplemented) RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, deliveryId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListDeadLetterJobs operation middleware
+func (siw *ServerInterfaceWrapper) ListDeadLetterJobs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDeadLetterJobsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDeadLetterJobs(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DiscardDeadLetterJob operation middleware
+func (siw *ServerInterfaceWrapper) DiscardDeadLetterJob(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DiscardDeadLetterJob(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RetryDeadLetterJob operation middleware
+func (siw *ServerInterfaceWrapper) RetryDeadLetterJob(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryDeadLetterJob(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDeadLetterReminders operation middleware
+func (siw *ServerInterfaceWrapper) ListDeadLetterReminders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDeadLetterRemindersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDeadLetterReminders(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DiscardDeadLetterReminder operation middleware
+func (siw *ServerInterfaceWrapper) DiscardDeadLetterReminder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DiscardDeadLetterReminder(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RetryDeadLetterReminder operation middleware
+func (siw *ServerInterfaceWrapper) RetryDeadLetterReminder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryDeadLetterReminder(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAgenda operation middleware
+func (siw *ServerInterfaceWrapper) GetAgenda(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAgendaParams
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tz" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tz", r.URL.Query(), &params.Tz)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tz", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAgenda(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreListBackup operation middleware
+func (siw *ServerInterfaceWrapper) RestoreListBackup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write", "items:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestoreListBackupParams
+
+	// ------------- Optional query parameter "id_mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id_mode", r.URL.Query(), &params.IdMode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id_mode", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreListBackup(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamEventsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalendarFeed(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchItems operation middleware
+func (siw *ServerInterfaceWrapper) SearchItems(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchItemsParams
+
+	// ------------- Optional query parameter "list_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "list_id", r.URL.Query(), &params.ListId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", r.URL.Query(), &params.Priority)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "priority", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "custom_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "custom_field", r.URL.Query(), &params.CustomField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "custom_field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "due_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_before", r.URL.Query(), &params.DueBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "due_before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "due_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_after", r.URL.Query(), &params.DueAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "due_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "updated_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updated_after", r.URL.Query(), &params.UpdatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "updated_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_snoozed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_snoozed", r.URL.Query(), &params.IncludeSnoozed)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_snoozed", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_by", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_dir" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_dir", r.URL.Query(), &params.SortDir)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_dir", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_total_count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total_count", r.URL.Query(), &params.IncludeTotalCount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_total_count", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchItems(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListLists operation middleware
+func (siw *ServerInterfaceWrapper) ListLists(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListListsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_total_count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total_count", r.URL.Query(), &params.IncludeTotalCount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_total_count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "title_contains" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "title_contains", r.URL.Query(), &params.TitleContains)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "title_contains", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_before", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_by", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_dir" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_dir", r.URL.Query(), &params.SortDir)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_dir", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLists(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateList operation middleware
+func (siw *ServerInterfaceWrapper) CreateList(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetList operation middleware
+func (siw *ServerInterfaceWrapper) GetList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetList(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateList operation middleware
+func (siw *ServerInterfaceWrapper) UpdateList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateList(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportListBackup operation middleware
+func (siw *ServerInterfaceWrapper) ExportListBackup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:read", "items:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportListBackup(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamListEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamListEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamListEventsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamListEvents(w, r, listId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportTaskwarrior operation middleware
+func (siw *ServerInterfaceWrapper) ExportTaskwarrior(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportTaskwarrior(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportTodoTxt operation middleware
+func (siw *ServerInterfaceWrapper) ExportTodoTxt(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportTodoTxt(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCalendarFeeds operation middleware
+func (siw *ServerInterfaceWrapper) ListCalendarFeeds(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCalendarFeeds(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) CreateCalendarFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write", "items:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCalendarFeed(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCalendarFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "feed_id" -------------
+	var feedId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "feed_id", chi.URLParam(r, "feed_id"), &feedId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "feed_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCalendarFeed(w, r, listId, feedId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportCalendar operation middleware
+func (siw *ServerInterfaceWrapper) ImportCalendar(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportCalendarParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportCalendar(w, r, listId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportTaskwarrior operation middleware
+func (siw *ServerInterfaceWrapper) ImportTaskwarrior(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTaskwarriorParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTaskwarrior(w, r, listId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportTodoTxt operation middleware
+func (siw *ServerInterfaceWrapper) ImportTodoTxt(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTodoTxtParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTodoTxt(w, r, listId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListItems operation middleware
+func (siw *ServerInterfaceWrapper) ListItems(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListItemsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", r.URL.Query(), &params.Priority)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "priority", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "custom_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "custom_field", r.URL.Query(), &params.CustomField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "custom_field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "due_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_before", r.URL.Query(), &params.DueBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "due_before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "due_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_after", r.URL.Query(), &params.DueAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "due_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "updated_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updated_after", r.URL.Query(), &params.UpdatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "updated_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_snoozed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_snoozed", r.URL.Query(), &params.IncludeSnoozed)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_snoozed", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_by", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_dir" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_dir", r.URL.Query(), &params.SortDir)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_dir", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_total_count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total_count", r.URL.Query(), &params.IncludeTotalCount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_total_count", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListItems(w, r, listId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateItem operation middleware
+func (siw *ServerInterfaceWrapper) CreateItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateItem(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteItem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteItem(w, r, listId, itemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateItem operation middleware
+func (siw *ServerInterfaceWrapper) UpdateItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateItem(w, r, listId, itemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListItemReminders operation middleware
+func (siw *ServerInterfaceWrapper) ListItemReminders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListItemReminders(w, r, listId, itemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateItemReminder operation middleware
+func (siw *ServerInterfaceWrapper) CreateItemReminder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateItemReminder(w, r, listId, itemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteItemReminder operation middleware
+func (siw *ServerInterfaceWrapper) DeleteItemReminder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "reminder_id" -------------
+	var reminderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reminder_id", chi.URLParam(r, "reminder_id"), &reminderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reminder_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteItemReminder(w, r, listId, itemId, reminderId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WakeItem operation middleware
+func (siw *ServerInterfaceWrapper) WakeItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WakeItem(w, r, listId, itemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SnoozeItem operation middleware
+func (siw *ServerInterfaceWrapper) SnoozeItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SnoozeItem(w, r, listId, itemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTimeEntries operation middleware
+func (siw *ServerInterfaceWrapper) ListTimeEntries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTimeEntries(w, r, listId, itemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) CreateTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTimeEntry(w, r, listId, itemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entry_id" -------------
+	var entryId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entry_id", chi.URLParam(r, "entry_id"), &entryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entry_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTimeEntry(w, r, listId, itemId, entryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartTimer operation middleware
+func (siw *ServerInterfaceWrapper) StartTimer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartTimer(w, r, listId, itemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StopTimer operation middleware
+func (siw *ServerInterfaceWrapper) StopTimer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StopTimer(w, r, listId, itemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListListMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListListMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListListMembers(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddListMember operation middleware
+func (siw *ServerInterfaceWrapper) AddListMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddListMember(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveListMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveListMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "principal_id" -------------
+	var principalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "principal_id", chi.URLParam(r, "principal_id"), &principalId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "principal_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveListMember(w, r, listId, principalId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateListMember operation middleware
+func (siw *ServerInterfaceWrapper) UpdateListMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "principal_id" -------------
+	var principalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "principal_id", chi.URLParam(r, "principal_id"), &principalId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "principal_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateListMember(w, r, listId, principalId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRecurringTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListRecurringTemplates(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRecurringTemplatesParams
+
+	// ------------- Optional query parameter "active_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active_only", r.URL.Query(), &params.ActiveOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active_only", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRecurringTemplates(w, r, listId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRecurringTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateRecurringTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRecurringTemplate(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRecurringTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRecurringTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", chi.URLParam(r, "template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRecurringTemplate(w, r, listId, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRecurringTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetRecurringTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", chi.URLParam(r, "template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRecurringTemplate(w, r, listId, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRecurringTemplate operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRecurringTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", chi.URLParam(r, "template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRecurringTemplate(w, r, listId, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAutomationRules operation middleware
+func (siw *ServerInterfaceWrapper) ListAutomationRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAutomationRules(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAutomationRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateAutomationRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAutomationRule(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAutomationRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAutomationRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "rule_id" -------------
+	var ruleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rule_id", chi.URLParam(r, "rule_id"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rule_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAutomationRule(w, r, listId, ruleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAutomationRule operation middleware
+func (siw *ServerInterfaceWrapper) GetAutomationRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "rule_id" -------------
+	var ruleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rule_id", chi.URLParam(r, "rule_id"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rule_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAutomationRule(w, r, listId, ruleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateAutomationRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAutomationRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "rule_id" -------------
+	var ruleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rule_id", chi.URLParam(r, "rule_id"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rule_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateAutomationRule(w, r, listId, ruleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAutomationRuleRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListAutomationRuleRuns(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "rule_id" -------------
+	var ruleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rule_id", chi.URLParam(r, "rule_id"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rule_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAutomationRuleRunsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAutomationRuleRuns(w, r, listId, ruleId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetListSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) GetListSnapshots(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetListSnapshotsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetListSnapshots(w, r, listId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetListStats operation middleware
+func (siw *ServerInterfaceWrapper) GetListStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetListStatsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tz" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tz", r.URL.Query(), &params.Tz)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tz", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetListStats(w, r, listId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTags operation middleware
+func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTags(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTag operation middleware
+func (siw *ServerInterfaceWrapper) CreateTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTag(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tag_name" -------------
+	var tagName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tag_name", chi.URLParam(r, "tag_name"), &tagName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag_name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTag(w, r, listId, tagName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
-
-// ListDeadLetterJobs operation middleware
-func (siw *ServerInterfaceWrapper) ListDeadLetterJobs(w http.ResponseWriter, r *http.Request) {
+// UpdateTag operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTag(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListDeadLetterJobsParams
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "tag_name" -------------
+	var tagName string
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "tag_name", chi.URLParam(r, "tag_name"), &tagName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag_name", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write"})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListDeadLetterJobs(w, r, params)
+		siw.Handler.UpdateTag(w, r, listId, tagName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -673,28 +5432,37 @@ func (siw *ServerInterfaceWrapper) ListDeadLetterJobs(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// DiscardDeadLetterJob operation middleware
-func (siw *ServerInterfaceWrapper) DiscardDeadLetterJob(w http.ResponseWriter, r *http.Request) {
+// MergeTags operation middleware
+func (siw *ServerInterfaceWrapper) MergeTags(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tag_name" -------------
+	var tagName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tag_name", chi.URLParam(r, "tag_name"), &tagName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag_name", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write", "items:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DiscardDeadLetterJob(w, r, id)
+		siw.Handler.MergeTags(w, r, listId, tagName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -704,28 +5472,37 @@ func (siw *ServerInterfaceWrapper) DiscardDeadLetterJob(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// RetryDeadLetterJob operation middleware
-func (siw *ServerInterfaceWrapper) RetryDeadLetterJob(w http.ResponseWriter, r *http.Request) {
+// RenameTag operation middleware
+func (siw *ServerInterfaceWrapper) RenameTag(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// ------------- Path parameter "list_id" -------------
+	var listId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tag_name" -------------
+	var tagName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tag_name", chi.URLParam(r, "tag_name"), &tagName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag_name", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:write", "items:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RetryDeadLetterJob(w, r, id)
+		siw.Handler.RenameTag(w, r, listId, tagName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -735,78 +5512,103 @@ func (siw *ServerInterfaceWrapper) RetryDeadLetterJob(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// ListLists operation middleware
-func (siw *ServerInterfaceWrapper) ListLists(w http.ResponseWriter, r *http.Request) {
+// GetTimesheet operation middleware
+func (siw *ServerInterfaceWrapper) GetTimesheet(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
 
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListListsParams
+	var params GetTimesheetParams
 
-	// ------------- Optional query parameter "page_size" -------------
+	// ------------- Optional query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page_token" -------------
+	// ------------- Optional query parameter "to" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "title_contains" -------------
+	// ------------- Optional query parameter "group_by" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "title_contains", r.URL.Query(), &params.TitleContains)
+	err = runtime.BindQueryParameter("form", true, false, "group_by", r.URL.Query(), &params.GroupBy)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "title_contains", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "created_after" -------------
+	// ------------- Optional query parameter "format" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "created_after", r.URL.Query(), &params.CreatedAfter)
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_after", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "created_before" -------------
+	// ------------- Optional query parameter "tz" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "created_before", r.URL.Query(), &params.CreatedBefore)
+	err = runtime.BindQueryParameter("form", true, false, "tz", r.URL.Query(), &params.Tz)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_before", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tz", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_by" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTimesheet(w, r, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_by", r.URL.Query(), &params.SortBy)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Sync operation middleware
+func (siw *ServerInterfaceWrapper) Sync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"lists:read", "items:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SyncParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_by", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_dir" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_dir", r.URL.Query(), &params.SortDir)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_dir", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListLists(w, r, params)
+		siw.Handler.Sync(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -816,17 +5618,17 @@ func (siw *ServerInterfaceWrapper) ListLists(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// CreateList operation middleware
-func (siw *ServerInterfaceWrapper) CreateList(w http.ResponseWriter, r *http.Request) {
+// GetRunningTimer operation middleware
+func (siw *ServerInterfaceWrapper) GetRunningTimer(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateList(w, r)
+		siw.Handler.GetRunningTimer(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -836,28 +5638,37 @@ func (siw *ServerInterfaceWrapper) CreateList(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetList operation middleware
-func (siw *ServerInterfaceWrapper) GetList(w http.ResponseWriter, r *http.Request) {
+// ListViews operation middleware
+func (siw *ServerInterfaceWrapper) ListViews(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListViews(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// CreateView operation middleware
+func (siw *ServerInterfaceWrapper) CreateView(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetList(w, r, id)
+		siw.Handler.CreateView(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -867,87 +5678,59 @@ func (siw *ServerInterfaceWrapper) GetList(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// ListItems operation middleware
-func (siw *ServerInterfaceWrapper) ListItems(w http.ResponseWriter, r *http.Request) {
+// DeleteView operation middleware
+func (siw *ServerInterfaceWrapper) DeleteView(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "list_id" -------------
-	var listId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListItemsParams
-
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "priority" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteView(w, r, id)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "priority", r.URL.Query(), &params.Priority)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "priority", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "tags" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
-		return
-	}
+	handler.ServeHTTP(w, r)
+}
 
-	// ------------- Optional query parameter "sort_by" -------------
+// GetView operation middleware
+func (siw *ServerInterfaceWrapper) GetView(w http.ResponseWriter, r *http.Request) {
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_by", r.URL.Query(), &params.SortBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_by", Err: err})
-		return
-	}
+	var err error
 
-	// ------------- Optional query parameter "sort_dir" -------------
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_dir", r.URL.Query(), &params.SortDir)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_dir", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page_size" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
-		return
-	}
+	ctx := r.Context()
 
-	// ------------- Optional query parameter "page_token" -------------
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
 
-	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListItems(w, r, listId, params)
+		siw.Handler.GetView(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -957,28 +5740,28 @@ func (siw *ServerInterfaceWrapper) ListItems(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// CreateItem operation middleware
-func (siw *ServerInterfaceWrapper) CreateItem(w http.ResponseWriter, r *http.Request) {
+// UpdateView operation middleware
+func (siw *ServerInterfaceWrapper) UpdateView(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "list_id" -------------
-	var listId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateItem(w, r, listId)
+		siw.Handler.UpdateView(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -988,37 +5771,75 @@ func (siw *ServerInterfaceWrapper) CreateItem(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteItem operation middleware
-func (siw *ServerInterfaceWrapper) DeleteItem(w http.ResponseWriter, r *http.Request) {
+// ListViewItems operation middleware
+func (siw *ServerInterfaceWrapper) ListViewItems(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "list_id" -------------
-	var listId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "item_id" -------------
-	var itemId openapi_types.UUID
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"items:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListViewItemsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_total_count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total_count", r.URL.Query(), &params.IncludeTotalCount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_total_count", Err: err})
 		return
 	}
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListViewItems(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"webhooks:read"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteItem(w, r, listId, itemId)
+		siw.Handler.ListWebhooks(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1028,37 +5849,17 @@ func (siw *ServerInterfaceWrapper) DeleteItem(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateItem operation middleware
-func (siw *ServerInterfaceWrapper) UpdateItem(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "list_id" -------------
-	var listId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "item_id" -------------
-	var itemId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
-		return
-	}
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"webhooks:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateItem(w, r, listId, itemId)
+		siw.Handler.CreateWebhook(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1068,39 +5869,28 @@ func (siw *ServerInterfaceWrapper) UpdateItem(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ListRecurringTemplates operation middleware
-func (siw *ServerInterfaceWrapper) ListRecurringTemplates(w http.ResponseWriter, r *http.Request) {
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "list_id" -------------
-	var listId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"webhooks:write"})
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListRecurringTemplatesParams
-
-	// ------------- Optional query parameter "active_only" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "active_only", r.URL.Query(), &params.ActiveOnly)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active_only", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListRecurringTemplates(w, r, listId, params)
+		siw.Handler.DeleteWebhook(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1110,28 +5900,28 @@ func (siw *ServerInterfaceWrapper) ListRecurringTemplates(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// CreateRecurringTemplate operation middleware
-func (siw *ServerInterfaceWrapper) CreateRecurringTemplate(w http.ResponseWriter, r *http.Request) {
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "list_id" -------------
-	var listId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"webhooks:read"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateRecurringTemplate(w, r, listId)
+		siw.Handler.GetWebhook(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1141,37 +5931,28 @@ func (siw *ServerInterfaceWrapper) CreateRecurringTemplate(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteRecurringTemplate operation middleware
-func (siw *ServerInterfaceWrapper) DeleteRecurringTemplate(w http.ResponseWriter, r *http.Request) {
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "list_id" -------------
-	var listId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "template_id" -------------
-	var templateId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "template_id", chi.URLParam(r, "template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"webhooks:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteRecurringTemplate(w, r, listId, templateId)
+		siw.Handler.UpdateWebhook(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1181,37 +5962,47 @@ func (siw *ServerInterfaceWrapper) DeleteRecurringTemplate(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetRecurringTemplate operation middleware
-func (siw *ServerInterfaceWrapper) GetRecurringTemplate(w http.ResponseWriter, r *http.Request) {
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "list_id" -------------
-	var listId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "template_id" -------------
-	var templateId openapi_types.UUID
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "template_id", chi.URLParam(r, "template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"webhooks:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
 		return
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
 
-	r = r.WithContext(ctx)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRecurringTemplate(w, r, listId, templateId)
+		siw.Handler.ListWebhookDeliveries(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1221,37 +6012,37 @@ func (siw *ServerInterfaceWrapper) GetRecurringTemplate(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateRecurringTemplate operation middleware
-func (siw *ServerInterfaceWrapper) UpdateRecurringTemplate(w http.ResponseWriter, r *http.Request) {
+// RedeliverWebhookDelivery operation middleware
+func (siw *ServerInterfaceWrapper) RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "list_id" -------------
-	var listId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "list_id", chi.URLParam(r, "list_id"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "list_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "template_id" -------------
-	var templateId openapi_types.UUID
+	// ------------- Path parameter "delivery_id" -------------
+	var deliveryId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "template_id", chi.URLParam(r, "template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "delivery_id", chi.URLParam(r, "delivery_id"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "delivery_id", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"webhooks:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateRecurringTemplate(w, r, listId, templateId)
+		siw.Handler.RedeliverWebhookDelivery(w, r, id, deliveryId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1383,6 +6174,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/admin/dead-letter-jobs/{id}/retry", wrapper.RetryDeadLetterJob)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/admin/dead-letter-reminders", wrapper.ListDeadLetterReminders)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/admin/dead-letter-reminders/{id}/discard", wrapper.DiscardDeadLetterReminder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/admin/dead-letter-reminders/{id}/retry", wrapper.RetryDeadLetterReminder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/agenda", wrapper.GetAgenda)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/backups/restore", wrapper.RestoreListBackup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/events", wrapper.StreamEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/feeds/{token}", wrapper.GetCalendarFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/items", wrapper.SearchItems)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/lists", wrapper.ListLists)
 	})
@@ -1392,6 +6207,39 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/lists/{id}", wrapper.GetList)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/lists/{id}", wrapper.UpdateList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/lists/{list_id}/backup", wrapper.ExportListBackup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/lists/{list_id}/events", wrapper.StreamListEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/lists/{list_id}/export/taskwarrior", wrapper.ExportTaskwarrior)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/lists/{list_id}/export/todotxt", wrapper.ExportTodoTxt)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/lists/{list_id}/feeds", wrapper.ListCalendarFeeds)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/lists/{list_id}/feeds", wrapper.CreateCalendarFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/lists/{list_id}/feeds/{feed_id}", wrapper.DeleteCalendarFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/lists/{list_id}/import/ical", wrapper.ImportCalendar)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/lists/{list_id}/import/taskwarrior", wrapper.ImportTaskwarrior)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/lists/{list_id}/import/todotxt", wrapper.ImportTodoTxt)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/lists/{list_id}/items", wrapper.ListItems)
 	})
@@ -1404,6 +6252,48 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/v1/lists/{list_id}/items/{item_id}", wrapper.UpdateItem)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/lists/{list_id}/items/{item_id}/reminders", wrapper.ListItemReminders)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/lists/{list_id}/items/{item_id}/reminders", wrapper.CreateItemReminder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/lists/{list_id}/items/{item_id}/reminders/{reminder_id}", wrapper.DeleteItemReminder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/lists/{list_id}/items/{item_id}/snooze", wrapper.WakeItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/lists/{list_id}/items/{item_id}/snooze", wrapper.SnoozeItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/lists/{list_id}/items/{item_id}/time-entries", wrapper.ListTimeEntries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/lists/{list_id}/items/{item_id}/time-entries", wrapper.CreateTimeEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/lists/{list_id}/items/{item_id}/time-entries/{entry_id}", wrapper.DeleteTimeEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/lists/{list_id}/items/{item_id}/timer/start", wrapper.StartTimer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/lists/{list_id}/items/{item_id}/timer/stop", wrapper.StopTimer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/lists/{list_id}/members", wrapper.ListListMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/lists/{list_id}/members", wrapper.AddListMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/lists/{list_id}/members/{principal_id}", wrapper.RemoveListMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/lists/{list_id}/members/{principal_id}", wrapper.UpdateListMember)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/lists/{list_id}/recurring-templates", wrapper.ListRecurringTemplates)
 	})
@@ -1419,69 +6309,441 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/v1/lists/{list_id}/recurring-templates/{template_id}", wrapper.UpdateRecurringTemplate)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/lists/{list_id}/rules", wrapper.ListAutomationRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/lists/{list_id}/rules", wrapper.CreateAutomationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/lists/{list_id}/rules/{rule_id}", wrapper.DeleteAutomationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/lists/{list_id}/rules/{rule_id}", wrapper.GetAutomationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/lists/{list_id}/rules/{rule_id}", wrapper.UpdateAutomationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/lists/{list_id}/rules/{rule_id}/runs", wrapper.ListAutomationRuleRuns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/lists/{list_id}/snapshots", wrapper.GetListSnapshots)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/lists/{list_id}/stats", wrapper.GetListStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/lists/{list_id}/tags", wrapper.ListTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/lists/{list_id}/tags", wrapper.CreateTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/lists/{list_id}/tags/{tag_name}", wrapper.DeleteTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/lists/{list_id}/tags/{tag_name}", wrapper.UpdateTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/lists/{list_id}/tags/{tag_name}/merge", wrapper.MergeTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/lists/{list_id}/tags/{tag_name}/rename", wrapper.RenameTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/reports/timesheet", wrapper.GetTimesheet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/sync", wrapper.Sync)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/timer", wrapper.GetRunningTimer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/views", wrapper.ListViews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/views", wrapper.CreateView)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/views/{id}", wrapper.DeleteView)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/views/{id}", wrapper.GetView)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/views/{id}", wrapper.UpdateView)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/views/{id}/items", wrapper.ListViewItems)
+	})
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/webhooks", wrapper.ListWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/webhooks", wrapper.CreateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/webhooks/{id}", wrapper.DeleteWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/webhooks/{id}", wrapper.GetWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/webhooks/{id}", wrapper.UpdateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/webhooks/{id}/deliveries", wrapper.ListWebhookDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/webhooks/{id}/deliveries/{delivery_id}/redeliver", wrapper.RedeliverWebhookDelivery)
+	})
 	return r
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/+xce2/bOBL/KgTvgE1xih9p090LsH9kt4/LYrfbS1ocFk1g0NLYZiORKkkl0Rb+7och",
-	"JVmyKNt5OI/t/lHUtkjOcPibJ0f5SkOZpFKAMJoefKUKdCqFBvvlJxYdw5cMtMFvoRQGhP3I0jTmITNc",
-	"iv5nLQX+psMZJAw//VPBhB7Qf/QXS/fdU91/rZRUxwUROp/PAxqBDhVPcTF6QI/EBYt5RFRBeB7QI2FA",
-	"CRbbuffJiSNLNKgLUAQs+XlA30nzRmYiuj9WjkHLTIVAhDRkYmnPA/pRsMzMpOJ/wj3yUqdKdgkvzksq",
-	"knCtuZiSw/dH5BxyinOLZZHqzwqYgSMDSQ1UqZIpKMMd4KIMRsz+PpEqwU80YgZ2DU+ABtTkKdADqo3i",
-	"YooSwPFyMtFg5zT5fJUpu38yUTIh2jBl9IgZYiRxZMjO0cnv5IeXgyGJirHPeqfiaEI0GHLJzWwxKyjn",
-	"/Fhb6V9kQb93KmhA4YolaYw8vv+w9x8fx6ANT5iBaFTSbHPeYqu18vPBb77FudCGiRBGKLTNpZgqLhU3",
-	"+Tpo4NG9L8fOA6ogzBQuMjKQpDEzMOJRg2yW8chHsZJhe/P/m4EgZgbEMH1OxhDKBDRhoeEX0L/gmo9j",
-	"6J2KN1KRij7hBhJ9YM/NnjbOlyE+BRECSZlBVS6mRVxBaMo5cIWawk2c2+lGEtxulMVAJpnJlGNEu/Nt",
-	"yLNjYybTmwjyxI2cB9SwqZ1hGcIPrVWLH5hSzAoeD/JPKcADncN3h6R8THagN+0F5LvXGWpZ/8TI8Hwm",
-	"4+S7Zw1EHSageMj67+By9IdU576NGW5iSy9hV7+CmJoZPdjb3w9owkX5fdiaZ0HyJeMKDdSnYpGzapgc",
-	"f4bQmvi6bShMT8s4oHzWSfaDjCSuYkl3UPmVa9Npgap9LsRzMpNpiijDiTTYqgQcb10SiLljeZ0ELKMr",
-	"JHBcKs6HQm9XGuQuA9syU8SNdCpY2iLSpSo3tYSthaYgwD0dWbckxShiuWMfJiyLDT14/nJ/2Y99kIbF",
-	"ZDGZFJPJzuHJH+9+JjHLQT1z582TLKEH3z8f2NN23/BLwQsXBqagbm9L0VqNQikmfNoWxi8nv78j7iGZ",
-	"SFVatV2dQsgnPET7ZbiYap+QausX89ZxeFzNeF9MQPuWi7BbysMXy0J+xXKNNrUQMxCeJBBxZiDOyU6H",
-	"nP9dF/PQJ+WbmMw7s19eYZ5dR9m69Lt0o5sdDRdTRFG5rF/hXwGLfgXk8Rc5blO0Me0oAa3ZFLyCdCPc",
-	"z57HE8ZjiK4VtJVYGKGduMG0TBgebz5vw5AkZtqMLqU6B1VEMa0hUvEpFywefZbjTSMdBUblo1BmLi73",
-	"QPlaoZPviJsRu/+I2z+HMvKfaASG8bipXs2pEw6xX0Jc68y3qo/tZQ3tBmF7tm+9t2C27T3fgnlYbW54",
-	"DTxbgSbyE43lJRpQiHiW0IDO+HRGA5qpKaaDZx5Y1iLQ2jJGRpJiGjFKlZwq0OhKxrEMzwGxGGHIGVCm",
-	"whm/sL+E6OHjGCIvERRkw/7obmF9luMm5FZJrGnUWljySQ55wU3r1fHl5jwsIs02lAVcmVHKpjAy8hzE",
-	"hgqBLOI/vRrC12PRgfkuWWxpgF6vApvz3KEMmxxxO2CpQTtiPM5pQC8Bzu2HMa8+JlKYmf2UA1P2w5eM",
-	"KQOqmmJDHR/G/fy2ra0NBaI7K25sFBg/2gibC4Lzbxdpb+iAuR654kEN4mMpY2CicvtlaBFdN7ZAfdw0",
-	"ErifzGDFKT7GFEAK4lSjn6Uo6YfJCVojHTPXUVe/QTIqb/iqbksp4HLzsNJHrPJHraVZaDIW39YG3NSC",
-	"/aXKuYtt3IfZBcM8yv5aGG5yYpirAsjU8IRrw0O0AIW+5vjZKBmTneM3P5Pv957vPeuR/2bSQEQcAeL2",
-	"QmJ+DuSUDk9pQE7pHv4HJuzdIpP6uwq95Sr0ApZ7g72Xu4Ph7nD/yRWnVxaZt2SQq4D4TuKzDVHWvSmD",
-	"8dGokqwveLIPMV4yM65J7ArQbd+XCczNupayeY+9OszBkKgh/GoRn7w+WqmvvLG7XlG+PMhRwvR5m9E3",
-	"HOLIBgtuWI98FOdCXgoycU+YAqIA2YPImfQXg0GvrhKLIl2B9pphOQsWMK5S3s7RlfuqG4qGjyq0w2vx",
-	"g5bbrWmGL5FIuDhyzA09yU69ClkXoduRt/pYP7ztXak4KptfKNyyKLI1AHUCozjiGiy8p+0Jrn2h+xJ+",
-	"FsmJL67uTrzuEkDViZxd53jvreiFfgvHcpOf4NyiMwWYAnWYmVkbBEX7AUmZ1hARpokbTWylA43pYdHA",
-	"UGSlwCJQtOhVsPmhHb8wkjNjUtcKwcVEtikevz75MMli2/iAMZmRkbTGmjARWRtOEibYFBIQRSi6iBoW",
-	"NZLKV9DfpJC4Gg3oBSjtqAx7g97A1qFTECzl9IA+7w16zxGdzMysXPoXwz6LEi76EbBoN7aJx25ZXJu6",
-	"wBrPy279KKIHniqdXVCxBAwoTQ8+LW/3N5eUEZElY1BETggSQK1TYDKLfI7jvmSgUGEEs1KNecJNKWbW",
-	"yBr3B7VUbzjw5Pvzs6DZm7Q3GNxZz8uKQqWnAQZH46ZRwsRJ2AoAj+bFYNhFrOK+3+jbmQd0321l9aRm",
-	"E5RViyxJmMpLjlIQEeKpxVYZxH2ih4gMeoaTu4HS/8qjeT/iOmTKRjip1B7YvHIDmqXYNcDBwcSNJr/I",
-	"MTl6VUIFAbxAig2kFhbLqAzqsFmXHZ+5yaDNTzLKr4WSpaRcGk8YewxMYx5qg3grBCS7QQA6d4a4AeIX",
-	"nmqOHJcLY7aWhSFoPcniOL8xwl44OqsnVW1tdwHJAh6ELePxZnC012jdYGxXWh4TFLdktVaUlzxWCzdZ",
-	"5pSRBa+T6dOBlN3vdQBV3ZZ0+j173bIOKu8qN2cXJCkokrIpdLg5e6mi+Z/gd3V7+8uublVZcx4sc/Oe",
-	"TaGIZGyRIVVwwWWmSSnEVWy5u546Xy2j1Q6pYxT0OCc2OCE6GxcFpJ2QadjlQoPQHIPYZx2k7USMgA3j",
-	"Qt+IvJN8kaUTNsHfbEpcJOc+slVOj6OpV2tX1g82YmUME6lgY17c8DthBuLI1omkMmScd9DFpyP71APF",
-	"etUjqBKfxo/L/WrdDJ0gH66o5TKiTnYirjr4wRVrnDD7zf54dr+mtX0V2xEHamtFOVx4PfUGBq/WYv+w",
-	"4SOL40Xeol2SkrIpF1X1ojCyzmaezYMOX7xoZKQ3j8NWHU67i3PezHDRSbcjreFWGFiTJpRm4sliw+2V",
-	"MCLgcoEPDxzqPtfGbDXHuxw/Y5LoqpkkAcMiZliPfNRA3r7+QGqrFNer874rgxpJJmDCWVEVtRCdWKPM",
-	"xbRHgyUgFg1B69y7PacnGP4t9zt1QbB6XeSJhHlvwRBWK6GMc3c6qwHXhMpa7FV1dWaJ9MhPOSn8UEDK",
-	"BidbvalanIo5TAGBqzDOIojamKvajG6FurKt4DbQWxHI2YKUK3hjECeI6yDOSZLFhqcxFPemQpriEYfo",
-	"GmJBGoUwe6cC9doR+7Fawcj6jRcXdt6CgF2yvOtKY9up6PbvDSrK0v1COO1q7t12tiXsqqi1vmxfgGmT",
-	"2yIenhBdew5lYdl/EjZR+/2YxHLKw2ebyaNWql4hkWu3DC72/OIWe0YtJjsOM0mmDZmxC7DBR4U0O2bD",
-	"vRbVec8+V/C/fwP+txVtV3dMtVNrDKzdfD7KMDz4ej9Z8C1y2m1nCs2+Ut+bpBbuD58p3L8zt25uydku",
-	"BW7WnSAiiypq4eadrq5LM+zF5EO72rNt5jn12/cHyXMaN8gd4H7wPOf+kd1IjKwnrwDuQfGqYLX/Ff8b",
-	"FSlTBDH4bh28rUZO7BojL7ADrTIVYYyLcsmOlhND3LLPiuYjIcVua7EUVMJQBHFeDHcruEBs6fbHPn8U",
-	"2hf42l26Ezon6jvO6l74m24KMT7dSxzLfpmNcXfaHgPNTDhrW+hFA8q3ipG79wntjqyNfMJgKwys8QlF",
-	"5PoN+QQnHMIEgSuuTWlbN/cHlU3ebbw5s7KU4ekkqRU2TkW9siFFnBddrLXRrikKV4OI7HDhHVAm9+hC",
-	"TsAUq4xwxR8nLNaA6VGZybfX8DkR/9tEj7Zygql4SzS4f2+1pJC5rVoiB2THL/xS8KVcjSQbi9OXE9XO",
-	"xZcUVa++bD0rWvGemMdifKgLpDNNejo5j08rLYCWI0SPAqxLelpT/toZUGdz6YOkQ929kCtQ/S3nR808",
-	"o1KHdSqwuYfsf629+LGUQ/mylsenPi2PU+Gmi2r9VZetpzIVN3+VdKZtnNcbZG8Pke8PAvyNpm1dd97M",
-	"9D7N+882RFsXoV2Rw6ps/G+03lOKfrugZbB9bjbQnG83gb+Bh6i/qGL1qP6KyqczRJv7O6JeLZMhi0kE",
-	"FxDLNMFDD2im4uLVk4N+P8YBM6nNwQ+DH4Z9lnI6P5v/PwAA//9P5XwsvVUAAA==",
+	"H4sIAAAAAAAC/+y9e3fbNvYo+lVwdeauOmdo2UmaztRes9Z146ST38mjJ3Gmp79RjgcWIQljClAB0I6a",
+	"yXe/a+8NkKAISpRjO0nrP9rYJglsAPuF/fwwGOv5QiuhnB0cfBgsuOFz4YTB356pcVHm4o3S+jeRw19y",
+	"YcdGLpzUanAwOCqsZka40igmnZhb5mbcMW4Es/QNW3DrmNKXw0E2kPDNr6Uwy0E2UHwuBgcDSVOc+vcH",
+	"2cCOZ2LOabIJLws3OJjwwops4JYL+ORM60JwNfj4MQsQnmjHi8e6VG49kG4mmIN3mSrnZ8IwPWFz7sYz",
+	"qaa0giHDYeB3I3humbgQZkkvZcxqplWxZNyes8uZUEwJkYt80+JwytMxwrftAp2YPzaCO5EfTZww7fW9",
+	"AoBo98f0IuOOacM4vM/cTFrm5Fx0wOi/OcW3G9BNtJlzNzgY5NyJXT+EB9E6I9W0hrC0Ts+fSlHkT2WR",
+	"BJP+zs6WbIwvswm8zS54UQrGLQNwDui3nTFXzC7EWE6WbF4WTi4Kkfk1zkvr6DgYL4p7w5E6mQk/jLQM",
+	"0JkbkTOn6bTFe8dgJXDU8AfrNDzGD7KREsPpkFnHp+LgrJRFnuELy9OFlsrZg4cZO5NFwc8KceBMKTJm",
+	"xIUUl6daHTzYf/Dd7v793fuPhiM1yAbi/aLQuRjQi+nNxqWf4tIbe41rIwJ0Thj49P/+k+/+9g7+t7/7",
+	"/em7D/vZdw8+Hgz/55/ap5AN5vz9MxriUfWUG8OX8NC6ZQF/gG0Y+BM7LsVmfMpLsRUu5aX4NDw6LsUP",
+	"YqKN6AnWGb7cCy569aqAEfY+eb8wwloEqMVmnv20e/+7fYabzSaE7aL6IAPMPJNK5OxSuhmionYzYfyr",
+	"lpUWmM7Ry+PhSD3V8C2fLwpxwBZGaiPdko3K/f2H4m9sJqczeJHtOD49uNTmnL16zeBnrcZAFPgQD8PR",
+	"R2NgwX9+kOODl69OAONdaQ/OCj0+F/lIjRQSrz3wT7Jq1gwGtmxHG/jhXsacdECNNHzGKv7hMlYu8urn",
+	"GNXtkKCA48CfxHCkXi2E4U4be8D+xv6fvwVA6R//q6jWzFXODtjOjFvGARAPBxtr5bhUlsmp0nBkbMyt",
+	"yGhvL6UVTPxa8sICo0BYDv4ncQ9hPTbhcXDPQSbarKw8rBSpoMG7gPUIM7fhZGmko5fHGXv1OsNt3sGP",
+	"CsFzgGz3Hi4D+JNyM2GFPYSDO5MqB/SdzojGuPJYcCLnwqI0ff30MXv48OH3TCr2a6mdsBn75Zdfftl9",
+	"8WL3+DiD080AQDjll2wP/t19SfCUSjo2z9gsYzm8cjkcqSN/yp5bSqsVM2JR8LGwiJleMDHxflyUgL7A",
+	"PbkZz+QFiBeVszFXY1EUIvdic6Q6aI/Qu0F3c/7+uVBTNxsc3N9/8G0Xzf3Ep+JEn4sEscEj5uAZmxg9",
+	"Zwvgyrq0zAi70MqKITvyz1FeA5ZIVfrVIYSoqowUKQa4jAM2nnE1hZOCt6w2eOiBPs+EuxRCsQWfAu7A",
+	"XP8WYyfy7rXDq6cIRmP9Hcv1aLdZgMKO11whKS0RkV+9ZoWeyvG9fsIpjJgWTH8yYjI4GPyPvVph3KPX",
+	"7F4MflMafdtPGr3Rxv2wTK0ZdASn6TDOlgcV26mJtIMBjZQ2G5hQNPAKaXtusFNzCF26ikngNwW37l73",
+	"0cM7p2fLtLpXK11ukMUif4eW95+wuv/UL/6nXtt/GssajYYpTeHenzqFGez2sUygGDxguTRijH9Ys7Jc",
+	"mo6lwYiDbCBUOR8c/HPA8Tf847tOeJAZ9cR7z7mSWA/n8WzClHb+kRR5tpZvIXdFLpeLHObwCxmO1Fsr",
+	"/GR/q0ZwGkR6IcfSgTZC6n09QcQKe9AbDX41aqMta9Lad/1o7YRPe+w1Cf1I5Z7xCwEad72z+E5P3gKv",
+	"plf66arsWyKMzeoskGxgEVvptRXpXVW3BXn1Rv6Gam2noLDwQpKoHjzCbZFzoKn7+/vZYC6V/62aTion",
+	"psIMPsKEQQ7iFv/A89fi11JYvB2DLBR0UeYLwGUOO7X3b0tabT39Ohx8Yow2r/0kNGVz25+pC17InBk/",
+	"8cds8FirSSHHtwhEmJHtokQ3wurSjAGNjeD5kon30jqLWhG3bK5zwuuxVuPSGKFcgUj3VJszmedC3R7k",
+	"1ZRslx399IydiyXLtbDI25AUYUF2rBcCt1gaYl/wV42KNYyDBhInjOIFznibx0/TMivMhTBM4PQfs8FL",
+	"7Z7qUuW3B8rrcOqwdROc+2M2eKt46WbayN/ELcISz8p2mfREog2bS0v3Pzps5Bp+WJj1KM+fS+teCLBa",
+	"RcS8MHDaThKhL4xUY7ngxanMG9ypLGWeMhsYXYhNi4J5X8N7xFcI10C0N2bzY9UyXp+BdgyTHE2FyvkT",
+	"5cyyDTIvitOcJ1S/E1MKsrFxB9o2B2EM6gl3ItLInJwLuJzAGG0DWjbgCYvgMdhx9JhofCxg+2lsHAxN",
+	"mHgXypGcpBPzDC5e8AvYfoT5hqTFb1qJQdZLBmQo8DZt9YnONUi01lbjx7iYrNqx7q2uMLC12wBhS2yl",
+	"oNUXwuSl6K2TxGf8sS22q91KCXynPQJcy0ymVGMQ1uswak5GI67Yo/39YFdEowApiVbPBWqGniqTmFUu",
+	"xnoOD68F8pUDD+dSY1k4kbBfEQDxqpNYUTo9R372uiwSWMFR3be9FwKjHI2DhFk9gbFWuXTeRrZpmMfV",
+	"y/BlfSvqqVplA6HANptHiBUdUU8uWEjr+nJMUtg+DLx1bnAweGLHvACmdFZObeoTZ+R0Kkyf7TjxryJ6",
+	"5VtuxirPyAf10jzg9X7VYMUnllW4sBmPurmMKTdLleZYCPym+Uq1FnWbxP4zcHHBwUuAr7Bc5sjFtclx",
+	"0dUB/nNghavNKU6z0kyFcrAF3beUFtZfAXdzsXCzNugvK9cUbKQNomc84/gTd2yC6h7eWkypDtk+mnvQ",
+	"eiWAk+XA3Ywup2RnBp1Cm6Aegr1YmOGgfXHIBiJoiW3x1Y88YMN6Kx9lIfq+6+/K7Qsrl3CXN6WyDPU2",
+	"kTOllfDeHmn88dtDZs/lYhHevRQGXUH4l7MlK7ResIXRjkwfuF1izEtLijaA+o1l+lIJwwptHePjsbB2",
+	"GFk6bDkeozcQVAIEa5AN/KQJ28dV+MLJckGk0qLzsJf1CcQEToiW1RaHQDYNvE2R/A98fF4u4ptbk/56",
+	"Ht+5VAmB/L+kyoNbLueOs5ku8mB+fXZ8yErFL7hE7xti+LNjyxwHq+7Zkr5Y6hJMOkqHE4kOBHgfbI0A",
+	"RU+q6akT80VBgtUrVNEEafNUvNG4CKSE7p165rW8lV3aSvcL53Q6k+iLTNjpvPWeCD5jusiFRbaAK+4l",
+	"xGmMxzjERnXEb9cKXN3b8Drs+UnY8taeiPdjsdhS71gd9UkYI6kJRlP3GhWNY+Gj1R2IcCeCPLUDj3kB",
+	"ep55KkTeXvZV5MQNqjGtBwueEkk/cTcLlDoRIs+YEQV38kIEPzvd84cMzW2VU0UrMs9LrYaxzB3s8YXc",
+	"u7i/B4PZPTm2p7/eX/z3cDjsZv7CrjXukZSsbLbCDtmT+cItmZ3pS/L7hCeMU7gKgx0DfkOvRNbfvkTU",
+	"NMW2MJBP18NMrsei8FtrBfN20v56h0s7yN6IsREuuMDqg9twQFfRKKvTyYKVd4NMiQmkW4mcePJZt/3x",
+	"SGkVkvjbkwtv2mndhtGkw3NSf3nxU/ScjNkrV8iZYEI50BOD5Vh4Nsx2fDCCdBkKq1wUwgk0kLfAyuVk",
+	"0j3z+kWjT6zm2ysWT/x7Tl4sW4UaSAMyAu+2SlySG8uyHbpjWPSRJuGktfZlKv5t+vuHSg574VFx0Hef",
+	"yLu85WY7HlobRSPIPKIOqtsW6kpwbGmNzYpfE7xRW+m8izxCB6ni3wo9jS1FUrnvvk1q4BfChPiS1Ycr",
+	"1AjANPc8Pq+YUOu11+M3tzEjWkhSK+7R6tWvwwD5aZaE2vNzn1wc4bfrNDJEBoPKv0Kk3rYfdErJq1zp",
+	"V47Pc89aSV9386ZDaDLOjiMIMEdBFg8ePdogVa9R2PUVXR87lwnzdC6v4freKB/qsMR/IMtDhoku9i1u",
+	"6aU41ZOJFUlLMhEWRaGgEdlCrJDTIWpo59mbV+yv3+3fZ7l/1zuprXDEnquvqkijv0Uj/ZnV8wfPclCi",
+	"fjp58PcUxMI6OUcJHOZsQ94CqzXyw/0XqcGlsg586KdJW3LnLlbBJVuGkrRvb1uYDGgPU8Yh4WOBIZD3",
+	"TIw1BlqNQaHdu5BWnhXCR+FV85PKdoDnhqcN30dOBB/F4T+jIIrwTRQzYFErY7DcvIRrbelKQ4D4yIGN",
+	"tvnaFrIFtW5JnU2T/QrqHL08qvwfbAciaDP2zZMS6HTvjdPj85ku5t/ca2DU0VwYOeZ7L8Xl6S/anKcW",
+	"hhF9Kd41l6oKGNukpNIg7zZwly6tc2svTccs6DTrzcNWlMzlApzQUTiSj6v3ESsqj7AyUIW/AEmLlxqw",
+	"ibAxNxhj0Iu5R6zyWEykkm2h/Gg/hSX+yOqTfjPTiwWA9pzsLzd5mLTNXYcJO9HnMBHQNYfZsjvcpHQi",
+	"neTUiLlUuTAJ/Hgdrt7VO4znuY9OwmyFqVDCYJSL1717ocAxTf3aj9rWyFYPf51kbMkXRm8S7wxChHXx",
+	"uKuKsNZAfiukVqfod9cKnKe2of49/O5R66KHSSL1x8x/zHaO3vzy8jEr+FKYe4MoLOcvD+OwnIf7KeX+",
+	"04QgiJnTsVYTOW1vxn+9efWS0UMKYiZxtOujtcbMCge2jqSHKhrff9fPaAZf/OQ/AMG0VOPuXb7/7eom",
+	"H/OlBaQN6MrkfC5yyZ0olmynY5+/3xD9dDVZd22CJ7mZ77ZhLV3c7NPtmZ0wEMFHXG3VmOSYkJisQCwH",
+	"lVVUjogTnTrNnJ4KfAWVWrKFgEmpuYizjuyOTero/b+n8baaf/Ou0CKP1HhGkUjVUrbwr3bs4AmfdosE",
+	"XehEWOL/MGY6PTtjM/Ge0Svxgv/HffH9/mSSdhpGwzQw9tH+/hpLb/Tid99uwmX8qBtrITEB4xg6Vy1U",
+	"vqWBRmkn7Aqg9/f397s0+09xjUcDZDWo3ev9hxSXnUutk542yddS/BDCz6KMpC2+mlRhu+u+AGh9gC/e",
+	"15oJlom0w6sldeL9iQKmKC6KkTt6mIyWuQbnxHY4+rM4m2l93o2hFyHntZdy5IdDSzK5YTdYqCxa4BPM",
+	"VE4V+hzw+ZD9WOlqGJqk59I5yiiNhc93iW0qTZFIQzuzuiidYDPnFsCj4V/L3r5+TsdoxFjIC2HBPC0v",
+	"hJHgKHmu9eKMj88zVkh1vlvoMS8oVcnICxDMPM+NsNZnI1WJLxsJDUDMwlb3OKsu2dd3M19qV/s2IKtD",
+	"LX2WXWUDTXqYLgmAnkjQIQqSN6hO+1zN7OOE02Y6SCqv409p63ZHGMxRUejLkO5q2Q5YvsOdMlj9o0iY",
+	"XFg5VYNsgLmwqMwAZW8XChPs/z3vPsmghmAdhWfv1m/2yYq7wQOWDSjFm2zbIsQFZBVTIgJKmfnbF7Ot",
+	"nESPW4lEFkJ6CSPpr7C8IXtK54DpFWeC5WJc8CiOG1jmcKQIrozh0QFRlqYIwyJTxuc2Cynt0SP6i81G",
+	"CrYgflInD9bfw9h+c+JX/Z/sivXxwwCzpgcHFa408PjgYYpGjgXPnwvnhPkvfZbgycZoczoX1uLICVyj",
+	"NwKGtR5T3M1Weke4fJzCxfQKn5XKyeL6ffnculOK1PJSs03zRk6l4sXpv/VZ75Ar4czSFyJIeJhqJ12/",
+	"AdcfcWVI+ORzDqS9EGbOgZEgIs54abs8dVdAhBuIcOtxitvoRcHec02nnYwji6aIY8lqZ2J0Ms0Z4k1/",
+	"l0SMpnmphRVfzN1wZWPikbIAZXKFQVVPxHwIJijYjWP5gkupcn05ZNHVGpxFnE3ke5GjXn0PtDcymqEx",
+	"ZaR24J/a+ZBSvjOmxBShRSsQPF3Uiaorztpt3Oc1HA3l5S+RZebhd49i28wu/Z7AusTG0cGCvyKhxLwU",
+	"3Ajrdg1X52whzFgAzwXxZFkLOWwWdOgqS8QisLa1AWv44OLRfmOdg58eHJ98m0S0xV8fpSOpvn+0+RJD",
+	"IKSQqZlLlGahKWtDnmaouXBcFs37TvNT1EyS30pry44LWQvsVWWwm8m3v06N98Qbgo/A08bHiWDI8EbO",
+	"+JRLhbG5ruRFbHmmUAwNZ+mq1F+8D2Eu3ZnG8NjVWAaY0aUYEn5+OdNWtOaCRELAO6nYg0f/b5g6mLOT",
+	"IR80BBUJuqKVuzaXf8ooqyp+BCPaZdUpftse/QU+DYtdCIM7HPZGYwJgdUo4hm04OnV5VkR74xV3IJX0",
+	"fGSdj4fHZUeThGUeMn6mLwS7z+aCQ9w3FEuBMyqB4Vfv94EmERILq1jd+pXzzGo8SlF5HMzVviqKS/yn",
+	"pAJEdNsAZlzkib+vwKdRJ1fiMj1voS8rbrvKQjyZdCF+REfkRTcIfgq1Q4BV10j0uMc4y3EhTlE4bTSX",
+	"xaIkIo2NFp4Wp4G4dZHKTITqM1W+CCQPC3EOufKWvdAq50uQS/UF616gi6nR5WLInsU1JUYKE+2jqjN4",
+	"58JXRU5Yih8LjGU9F8tD/2MQ74hm/nLW1i4Fz6+2bfDRqVSn/YINwCD8TIVwg9W4ebGsg0LhpwrBYgAb",
+	"h9yaPz7HFD7/KNxN+4J/FO7zemuezRfauNcC/t8ZWJ5m37lZnppSpbPkfLJK8kMjbFk4m9Zo6SHyewHe",
+	"AMyvmshCVElW/eLK/LpgsJQKEXJoNl9hwjJjbAtfR0k5YVHv1mwyAtMR39jeDZruIDA0dI5d6rLIwa4j",
+	"FSj9ZonG8ZECgA6YxHmQ9/kIeozb8H6zkQJgD0Jqy5mo3s+YFbDz3GpFRN8MZvUr9stN3o3Fe8rN99fI",
+	"FcZ8XOkscKSBNfsUejjcjGHNPK6YDAGJ7O2z4+4rddcEFfd3Yg4bFkjmsIKAm0IKw7Tyebv+LDNWqkJY",
+	"y6RDWR5CdrP+iUgrscmJHKF3yTslt6nj/3m2jLYMQPKQwrIqtOuOd1p/R4jPKwtZSMHb7DEyicpxFEG0",
+	"5kJfDkijQ3soVJNDY2hIemzBGYWSRcM4netBNpDqdGH01FDulS8oN8gGOaUth9o4QJOh9E5yEmC8rYRP",
+	"uy7JdIs449bA/eJR2zDZ9VmvV4WoPziUX5Uw9BP/QOc7BgE5qmmqmHRD9g+K+mb3maRUGKyK5mPBDxkg",
+	"vBkp/zsoJ0VRxbE7GOQSLA+6yIXxmT428CYjrCOW1bpBiffEs7Yzw4Xdazky6NqW+bJLsLqOyk5aXTUp",
+	"LkriSwii7XSIFFfpjx9deXQJsKKUgcpecT/bJCvDV1njmLLurMk6L+ldB27G8el2fWZP/41o5vj0JZOG",
+	"o2ENLP/WZ/1BaQx6FViCoXEdF4nj/raEKo7cW5vOWU/SdZR4SVkfJtsfwji1dRV5lXjvTqM6iekCIXX5",
+	"5A5bQFc5Z8bHRluLSXYwiz0k1lc5iVGvSNRpBkZphRv0M2DCltX1edZs3Jxe6L119aD98Q3+s+tvQtud",
+	"XeBnt3d2COMtnp3f4mvJ1l0twrRyb8ISArUS7ITiytUXAAB9hl5gEOV9VNrtajhdQ2GRvnWf4oJV6+lh",
+	"GyroOsKWqLSbL+dXSD1vXtP7kuSNMP617D4NhkeVlXhyXQjyTCEG0pEMGeS6UsELOGF2JgqtpjbkeyNl",
+	"oIOqxl2vhfkb69Rw5YOEwpVB5NJhpCOV0eq8B7xRfGFnOnEBhyiliSyK9fWdlGbWD4G3MSPG2mCEPJj8",
+	"0UKncloG8AtfDgTeUtaZcuxETm42X2w0FDxIBbb1L6m1SCVqP2tG2MGlCs0o4U6FlUiqS1XbQBMV6lph",
+	"NAuhonrpPjk57ATpet0R6sRIN6JguF4+prdJ560m6M67ircdC3oc+vtzVA9kyXa4Y3NN/SPuocGzPn6G",
+	"rsi+xdd84Yp+i/KVMfyS0oW5mgO2980fd1y2q7n19Uo6eWeggpSvLhWrklx4PEbbghceow0v58ur3Zoa",
+	"JJusUdAL1i1Lt0FwcCjeNuOY/XImYgzBeKYmwmBBNyzvNkh224hPGvcYgY+3cVOtNdyLtGNlywAjcAFA",
+	"Beke2Gp/hJd/WFbfJY4bX7FkmaUYs8iZEDzl1aQ9T752I/U79s7VVm7L/rNdHVu2LPW3Bi+Cj89veh/k",
+	"OOHTW08SSAcxdSjlJJFiuV41rHB8mpQa3XnyIZysY67XiTzGLWZOB41Gi2tBsOZU1umLqxlFm7jhCafl",
+	"99LKQjaFXKuygj9M0Fv9L29+5GV/YCB5YA0YoLj1n/8NvxA5jNh/fh9ifVxFp3fDUkewbxvF70dfbg3W",
+	"Gmh8CPnWsPSD4YUwU0FI2pHPQB4am3RX29Ddo4otdnzK5jAmOaCaUeCXmJ79M2Rprw38rrNDH6yv17FC",
+	"pwHUFDGuaJTr1NqFqL3mLdszejWSLk1wf3TGuJTz9DOVzISPgAkSNAKoPYr3sWx2ZPoXK+dM5awh3w1C",
+	"k9q9dlpm5LDJuSwALghXwB/OZPXjXCs3w5+Wghv84deSGydM9Qkq3KkbW/pufC22lN9bPvUnZVB/VXnS",
+	"UpHC/Un50n1Dsu0p1e5IxzdgFHZ1hNsG7G8ToH07+d1rTvFLTOQOJebEHlkeP09md+vNK5lBOxhusiRl",
+	"24WpGkXnweAWtFMyAEWxDBkzItSIwSgCkUsKcbi1cpJRokO7CF4SusAd+1Vws1sBvZoKUsd8RKAMsoHf",
+	"p43FXGsQ1mSZXUOuQm14q1Pld0xb4rSS8ToTG65y5BNpRNIi91pYXVxgwUBDGQVDdnRmhXLsciYLUXVA",
+	"YDNuwa4arwNf798AoW/SynXl9HckxlYrZTs8/K19Dv3WBBu11UH0C6cMC+sIqJR5Xct5Hdr6fYmphmqf",
+	"xcWx0hpdqAixyVvR30fxcQ2g7eCihVA5WWNMqRT9ZEklrgP6csHzDvgVn6+tyhAsFquJJpeYmlk559Dq",
+	"cD2VE15TnExn+KYvsW1TEXOVUeQMo0IidwHZzr1b1EcUni1DtKEvACrz07nOxd8WRmDwznbRMFXx75T+",
+	"ui6utFnhOaXdIVjxsRsx5wsUch7Sd9ulKUTKWlfUoY9XypkPdOnBkJJRPO3Jg0+AqnJvFa4atiJOtEtH",
+	"4ESbmlU5CCszZxE2pVHRmWUjmKWb0JW47J9g+nHzZP1ZS/Icw+fMiF1u5iC4qNQu2XOyHhCmAmFOO4q6",
+	"R/VJW6BY4ehiyqxw1id276y2z2Ubu1DeA80Z860PGWeQ1DFS+CsbF4IbiteLh4PfG31o4bp6ChYdnucW",
+	"mNbQa9yngCAjRb/UZa/JqkptfDH9wJd6oBmkYh4J2U7U+4DssTOhRsrn1907ZB8+4DAfP4Y4YfyVWcdV",
+	"busUBfyjpz+EIIxLnSxGaqLNkM0hUQf+Z+sXATLpqN0S8+ZP2C8P4eFqfcaR8g5oGGfIoBvyqbfMQdtX",
+	"zGnHmYe+8KrIK+yBcf27pzJPBTRWKXK1lta299zu1dGRO6E13idclerFvcbqDwf1Ma/TzPvV+Q21UxDD",
+	"0xIYHwG64HZnzGE9RE5YQRjfUJQrY1krUao+zX7MK2YN3ZeC5loi6VVxhEE28CRZpSKc+rsR4CX8MULM",
+	"tArTqFucdNJUnYkrL4kF3WUuONZE8RtWhGg+3/Z4wQ2fC/jOt85HOiQNolREtFF+ZNVp39PvPEUXLTNd",
+	"V13z9Y6qZuUMVMQCa8Q+/ocs6kdaATZMFi5f06n9abs3e2uvaGvrzRqyykrosCu3oYsTuRkb7Mw2sbO7",
+	"e3uqQfsg29gdu8krbqI/c1DGr7sb7WaetKb368cOUjypS3E3kdJHXngXehMFAIKhfwFxf9hOJAdxqYOB",
+	"vyrSgqWvQCLBlzl3YrhaODmdJe75dAIMx6enZG8mQJ5K40vyB+SSKNSxCSK8d4hljeAvqxWj2rPq0ytV",
+	"C+7J0te1JVrLP+MPV7JxhnXqFv7a0G2rv1abNsgah5nkpbUb8lqcEl96wbee5r1UXbiVYC2sS1FVmOB1",
+	"YTjbrzKcdH1rwjWnfi79KDivFZDnAXXKKssUUmX1wHtpqAGUhGZRWDqpz+WuXY3ruBREcuDzGmTXZDhu",
+	"mW98rII/4PZxpCjnDT5bWx6/cm50BN6R/s/PYTdfeJbmVXeqRN5kZw/2H3y3u/9w98HDk/2/HuzvH+zv",
+	"//cgu8qiCbDkqhw3GAOx5k7oO7WdVlEQy+1CH67y2So/q8foWEUdhhVnyaEOGN0SEK2SXCpuyNVmVPj3",
+	"La2+Rs+vxP+rWpzXI1BWd1LXWd3Rsrp2tQrF7IhUWgjDqvGam1YlHybtNSGsNvmwTlZMPs6bzWyjJ3E+",
+	"ZPIFzJvcbBy6lvTKaCeXatxNX+ALSQWgvVrwX8EQgY+xbwGG6BLDgPQPBq7CpJAUHTUknignYdrg6QKL",
+	"htIMgsuFiXm4leAmc7Mwfe/Gdks1PtHzM+tgixIX3Rm3p/Ok9+aFNqJqowli7ZJLJ9X0EJdJ9WXq2xZ0",
+	"UqpAa0u368uRur6MnZtMfIiRlyCuDZSxBbP23FWbV51IF+bW55moNJxsOuWtu316T117e72VzWi2aGqU",
+	"U0ut91YCRK+k924IKu2MAv1kjanTqYIunjVRmxtphXdYrXHgSyPXlfhA5D71i+vg9alb34mPvyNvBKwN",
+	"maAPx0ODLPSeTR9CRUnrJl4VJaiDNMFNjZTeYXt+yY2R2hyBYZWnq9puwo1K+erhMYimhB9Tue7Yt4db",
+	"9i/8gRKY/8XwvCwmvB+TwdsI9vbkMWi41fX+jFs5RovznDtfywLU3P2H9++fYJT4/n8P2Stfks8ZeVaG",
+	"oeRUaSOSlmFe7c0WjDq5tSkv24bNzUvRsen5VoeRDeY6lxMp0p8tuGlGL6YtUs2z+nvGXgB2P08bvTUe",
+	"empINOw3r2ZVtGK6InxymK7G0d7BnAURn9VlrbJYNan8Cx1GnS2N7NX9rM0ky44KoQBgX8qpbi/XZOjo",
+	"HVKYMXBLa7BiogWZM++3R0OAaQW2dDQXi9sGtMI3mkEpOC7DKiDZZ6zwuk1Z+85b1fqU4Z/CUyg5yJzh",
+	"cAWo9qCXC5vOIu2m376bwnXZQFLlZVeSixudGqL41ToqhHb13Tpq6JFVsaVhIDlXVQCtLfHlXDC7AASW",
+	"igk+npFl2aeX+nqRVQm8LF3YtN1MpXWBjc17qWtofJGnW2hElg+Pk/0Et7mXdh2D6Q7E6dv2o2vX7UwI",
+	"9wkZkn3z7Kq5euTaof2SHkI0Dqh46GlHdzZkttbPKAkP7ZfwQE9qNKDQhurtvvffJqCfko/Zs5ToYd2B",
+	"28JKeEFKLNVi0GosVtl/Z3PJLZP6HmFS30IYqXOKkQrMEQhuyzS/bOB+29TssE5OthsJJc4TjPIq3W/b",
+	"5w1WR/rUn1l9z/23RX44thfJu+0KMiSa13xS1kBHsaYX2jo6g63yiCtguwwhyXKcSGzPjonAdurimM28",
+	"HMDIe8wT1E5UnXOdVyAxEcWywPhFTeQZYsW58M1GLqXdLAGpKqZ3A0R73F1iqcWB2uYOuunlfLkeGZ75",
+	"Lpefggu99LmbUqUog6w3Pr2h1/uX3EtpJvRpNXd0aGsP600FasslY2pNFtMBvKuZutEC+zhoxT5l9V+k",
+	"GqkggrHgJFe+JHKVC4TdZs+WbMZV3iwW6Yer44izwZyrkqcrRlbGyVRBzHjCHqhDhUVsOY8Dwr6BiAsl",
+	"7Szwbg/fYYjqqsg4esgmEJYCIbGhKIlr6Flhczpq436mfLTfW/fpehm3kaYmkhY19ClQSARwZr1wci6t",
+	"k2PImPIZN0v42RldsJ3XTx+zvzx4+ODekP3vUjuR+x44jNbCCnku2GhwfzTI2GjwAP4Rbjz8lCvlV9M0",
+	"m9zPpx1+5DcivoIjt5I2OP+HjBzUob4+N4IVYuIYEK6eoMCUampHCkf3gS1AsqGca9MHjkNDV6/HhaCK",
+	"WAkvdrtldthNI3j+ShXLlfb+X3+D8FX3/P3d+4++ur7h29TDva5kvcozdjO5yZ/WZ3t1H79STtd9hlSg",
+	"r+PGQHnE1T2yamSeTqNXuVaiayiKClDasaVwLG/g2tpCgG8RyVZKBXfZLaju8hZ+1DqTYGNf7XEc97tp",
+	"0DpIGO2nEP+cpw193VVa6hDKnhF/NU2ezpMuGt/azmlGrw3ZW3Wu9KUKzQfjPpKkiXy7vz9s1qEIq4mL",
+	"UAQF1l+cwiv1IuLty6pjSmm1/QtWxEt914k5awOzpFef+8YA3PzuVveZOnbGaxCp/e5+O0tlFTYUVy9G",
+	"kmpg1rpCRCJklcle3ynSCjce5jrXb//T7GY2cYnMDsTZrrznypI7a3PW03dOvG2165vH2BYydGLpzaEN",
+	"7sqmHb25JiQ0S6IPScchfmIbkhs71c6T85wi4i5JppEodJEqo7HChupCIakaF91FUFYRKlVW5jqRLBGu",
+	"1AMFbrEVDcFwTW3vP7nF/ZY4+oLbc6x4QA9CCHvoGsiNz7wU+Sr/QYBTfCcsJZ76NlSO31lj+i1SBF7N",
+	"pbdg+Vfg2hBtGgWrQnjsNWQHXBPGYR1e2LKtsC46kTWKcG2nrlIK6vNvHOu6XIObw9RbaMe/eo3aUBaq",
+	"7im/sdH+jWs2NaipI6Yu+h7eqp1+1vxqmxOk1ucbvSCbTjai5/UJquSBNS67cqrql5dy2tkI/eMXkY4a",
+	"9QT+2vNRtXE+PqOqaTb4vzsE7X/C3P+pTXj/qa2F/2mgyWg0/Cff/e0d/G9/9/vTdx/2s+8efLz3p7SL",
+	"0bjTXDbK53A79kI+SXFfUeYsKQ7rqrxuUds1NYFn0Ndjar0J4XAtpQW3t0tXrLemyJlzC3uwt+f/Mhzr",
+	"+R7Ab/fmWul+oXQkItZKhtbZVFVw28ZN58R84ToSmK6WO4BzXeXc+7qu6OU+qcsp/Nimo3/Ve7v1GDvi",
+	"+N3rdixx5qOP69Ij0lI6lf92pSPxuj0yIgxyqie91mA83Z92xUb//eTkpxCbGKpxc+tY+LB2bxkxFjB3",
+	"0kzfz9+0gozrSp5V6NA47MgmWeFtD6zfWFd6uXUx6Y+bZ+1X6ayilnVFzlpY3DrHdJ0b6bMZsBxOVRC6",
+	"USmHrOYUkzJSvPKIYJGLLERb2PKsmo1Jh9qTPWz8ueoSaEVB7s7hSGG+/qU+F4D1i/KsoLiPSnWha0kc",
+	"ESNUbjPfXsbAAiDyRVxIXdrThse6GeSC97y6nAD+Wue/pIoNrDxN1x6oE9iCZSUapvpTO9Em+rDaAn/A",
+	"p4VwoNbV4zTPbh0CbCxJ3rsQebIjvwWrkHTLN/A2jfuD4EaYo9LNEnH6Pz3DQLgFt5aq9tDbDFuEwSUd",
+	"vgPjmq8XLHguzHCkniAfBPDpAbUAQ3wY6wWm9TDPESzbwacHRvA8ozcPMPknI+wKT+hnehJKO4Vn1a/+",
+	"Mc/nUt0bsv8lllG8UR30OWdT4di3+w/ZU23OZJ4L338XtxH1AlxozQpBrg8+fkRTxyTRxf71kzcnk7Jg",
+	"sGVYKkvnum6khIg/54pPxRwzH+DmZNr9EqrINAi7VBpGG0Q9IQf3h/vD/dB/iC/k4GDwcLg/fDjIBgvu",
+	"Znigexf393D9e4CMu4SMu6E34pRuydXRPMt9MGSzySIOGC5sg4N/toJCqc5v1O4NJgBmQjUl0DAxOBjg",
+	"3S+ERh4MCjmXLmwzbxQifrQfVQ++v58oIf3xXS3ucC0P9vd9tUXnc5n4AkIvcGF7//bdfeu5NnldOvpM",
+	"4qknokf1hMEOM9ph3AA4mm/373dNVkG/91ZxTznUCPPb/YebP6pwFb54tL+/+YtniroNP0ElJ+YAeKYx",
+	"7f9zgEgzeAfbbMv5nJtlWGmt4awsN1xm/jk48h9/zNYg4N4HmX/cy6Udc4Mq2kLbBDoe0wuN49iEkPAy",
+	"o7fZf+kz9uw4oCAQRo2BvhpjUEYomqhGkU3JwO/oY2HdDzpfboV97VSJBBfBntSUK0CbkExWS/D2jx9b",
+	"xPFtovC4PgsDi5zZEs2ok7IolreIud/uf7v5i5faPQ0G6VtCdY92jK/i+dXQ3IiQu5dE8nbdzi8JxW+I",
+	"y64pVprgsrDIujL5BOPznLlDVY88n4aojR4aU+G6y7NaSFW0or7fUhN8thBmzmFtBVYyFe9nvLRUOd7i",
+	"SUlhM0zOrgygtrqeCAO3Ce/IId1rnU5SwbK9YlIt9Hq1k3XNDW5RU2k3I+2prtTn/8fRWUyERdsTSqf2",
+	"kuqoQN8w6/jSIgzDFoa31JxwltsIgvDNncKTVHiq7bnTerbSekyNilcllJb+s3oyUPPbesujPyXAg6rd",
+	"TC1w8LrM2cQIOyMdgJ2V+VS4NlF1VCj/4kjqdhSsVoH2hHColttUtfKord6dttXStnoQyFSonK/Rrqj2",
+	"JiaW1K3n9MSHuBShbGZLW8Lj0YraKlOpgZGiJtzf2ConI6N0Vt8TkIX6uDtR/2if/XwPjMCYSZuXYHSj",
+	"NBeQndpQ+hj+rKr30aRVLsZ6Dg+wrvxfGDYIwwgVJh0kpj0tNHchlcZ7til8gudM1o7tFaitZqPB93w+",
+	"GjBp2fccfRNGHHpjYMUL5HuRV5/hwGOtLoSB6rmsbkUakrVo7qpv4ki5mZAmSukZMvLON4qfQgWUOPMp",
+	"paj+KNwRHfUGHvNcj3mBh8Ytq5OW2Y7XMqkduw6n+tu9DjU1dK2uGEK7xOf9/ZQM7ZUOT0fSBOrtyeMu",
+	"YNxvHaA8KUH8771xenw+08X8lhkhHck6vkdvRFUAiAX14Cg/8DyEPn3NunNtXF9leK8a/IizosbcsdGW",
+	"snliJfoZ5bkH1kedbeyeb8/SrQQ8Ds0ssP4isjx0R/LQHGfOcwFerR+fnDAYGSfe++AD5D76mTKy8fuL",
+	"Z8uqnrG60Yr3I1AUE3lFZxKgXGaYhA2U4AxXltxmlOCMgJ0JKGtpg8OM2PJwpEbq57gvD3a9IaboSege",
+	"cXQ3A6Cmwllc7LNji8yuWuoY3HNnUU8bKAxeX2ORgQ7Zz6keQNX9OqsWPVKV36HdcghY2rlYhIppTlO7",
+	"Du6FjmZcYe2DkQrs85DJCQIU/CdYxvw8lMsnkNEwYENY3PdD9rqkCpsj5bvj/M2Z0teR8KFZMqeOeTVs",
+	"Kvdg4PDPji3u8Un9hrQUeFn7O/0NhZ3pHATnXLqwYNwek2LbvoUT3BWpNdIm9v0jBGhFJ+kPERrAzfkC",
+	"y1GcC7HAadtbvhPOqouR1l2DEuaHqpfSFr2V2vz+H2G7YY+pw1RVUgOdlbAsrvz6ggSAzHzbCXbd9qgG",
+	"u9Vz/RMukZssIf7sPjZjDHzLkBtUs+P+Xwnhckw9uw6Z0rSb0A4yOIQ/ZoMH+/dvDxi0hwS28mVLuW/3",
+	"v9/8RdzE7PrFYuSBDrkt/rfWtYC4Hk8Irkg2egythGMd/5a8FzyHLF7sEiXMLg5snRF8nqHan74b6Etl",
+	"gf9IkKRz4UVGiuu9wbGehNCytQzvMRWKjoOGEPYQKJSTcCTwAL/KuaivANlIWc2U9iWYAba5pDAC0iGM",
+	"GGulxNh5qQZcSNKyaMiRohREEiihkjNqBDSJ/16qKS0V+RPFINQM6jm3bhcXvIv3+cg+VQeg/nN/9/t3",
+	"f/7TlXRUiCymQ90luJuUuzpgizrfoIjaxYqItL80zJA94ZTwoBw27KyrZ8MFQjrLZJ6N1L8oxjcqTIx/",
+	"EL43WXX+9Nd/sR2U+XGEzL0wHnyMwoGPFNWux40Lj3Pu+JAdQU0bjGKQliHUVLNLAjJi361KDIYD0tR8",
+	"BU7pTsf23IMosUJrpyPFNKHD1oaBldyXiNN4sq44zUSI3O59wGCdj70MEfAFnvZjXsDtyOCZU+mBR4++",
+	"fXTvIIItulpb9o8n/3jy8gT8Pag5ssZlHWwOWBsEXjx5dfzKDlnKPDDjFwJYRriT9rj0A2d2FIv09uRx",
+	"BnFruG8zUb8nFYa+/Z/dF69evto9+e9nx1Hw/UjBWQH1YMm2oFLCTnxjGeVpVPFO1gme+2A2HxzVYRII",
+	"G/hUUKPndYz2TTwJeajiEDo8lFh/SBol8eu1dskrsraxX8mWbK2JQp/JvNeguKcCMz5YWBBubEQ+FcBw",
+	"ZhEZVWHqa8mnt/kuY5FQRZ/oVF4IBYjnr7XQyw3q4dS5NVXuja3zSIbsh2W4YmYs9I7wgZW+e0SouBXu",
+	"gh3mLfEec8jypMaAeXfPfO3/tXgMBXrqfXAzYcMKd2D5diHGcrJk87JwcuEL7716zQo9lWNql70osIcs",
+	"YW7aRRsy42pUrM5nY7B0lFexn+qVtkTLFQwz+JilEa/egCjlo8qD7PVNSJbZ7qsTPt1ynqg6zXYf0ttP",
+	"6jSnnt9BEimlJfb/4GiyBWCUfZhv9Q2ZmLb4hvIpffWr3nigjfthuc3bx7IXOD/xqXgjf+u9pfD+CYqC",
+	"/mvFej3Yl2dw45ELSH/rzLL4gg8fuUi6a++0x8CVg8oYGWVJVSJ5QeYcytb0bvW0vbbqSdMZHfzcG3zX",
+	"8v+XlbGSQFlgluNUdJiO4NGpBdxO2rwePNoq5KZl7QJK8DoVXs1DkkGV+QJXGXqO/RkBxaUqvdhFWDGz",
+	"e6QqlUy6A1LYg4oJOwviPMjnM+EuhVC4aosZmz5XOLqgpnYhKG/d+lVreUd1Az8EBStyRfZizG8FOPEs",
+	"hgzpG34HdApd9vAlNEPjFkCqPaqdSoicctdTIIeUc5zyFCtDp48QLYft4sztxfi0WdC9qed0eUYrB83B",
+	"il2prFBWQhJcpyMKPoRSIY5LZbfbSz89Ya3Xsr2Noapr2DFtlT/nc/MTYQBrC9v1AqXy1/aDpaoN8OnA",
+	"iCLH4guA52fLjnlDUm8SB+IMw9p+3fgjHl0vCzYITl9t0RdY7gInl6YDHhhxkPVLA75xcQj/bQzduxOH",
+	"sWU2JQ7Rwg0FhKu0Gy8IF3wqVVWKzEs/EmbvPmYdodmkMj4n48pNOC7qCapj6eO/uH8jAGwIGw086A7x",
+	"kk4A2knvwK6wL4FssaqFAXIbLQrwKpsLx8nu+taKTje4b+Cr2QRtHJHRrNIEhykrlcfx9WErVJL/qwuF",
+	"88vbiOCTYG7640S5dTPSH4VjvMZj0MieHSewGXDGjRMpo3RF98jri+ZQV7xmSafhSL0WEI4FSl6jsEdt",
+	"Esd0UThMtBT52slIhfaQyrpYxgtYxnKkyLvJdAhcmWC6CMQ15HHxHgh7YKFkdV31GvP1CbykFawuCPjZ",
+	"yeX6xVG7fuQtu9MT5Ra7qNWf0Vckjr4Yek/KL9r6mOY3yq6V4KtejiX4hq2L0UJDBcVkxsFaXOUjBYod",
+	"fBaJNXpzNYCLg//bZ0uLnP3Xm1cvWa7H5VwoCN0Eb3X4FZ2X6IIT+UFU4z74kzje4RlHdijnFJhacDMV",
+	"I+UvZ1xBw3XqV0lBpxQPEGrZ/fTqDUnrlXC44UhRmVib1RkpdQ67t+BHPgqCTGkHbitHIXk0ZopVPUGA",
+	"+sc1rWVYtb39yxTyqyFA7VQcj6R3LKK3SpCtsTsSctVBixQe2uQaqwEvq2xjQwAMOcaBkm0rMqIOgaFr",
+	"SeblAdIMVeSISo2MlDYJXhOCzylgUiqMZ5COaZNTMTYftkBU3IgfGSmnfaRLI/hFqNxihCQF6DtdTUBG",
+	"wAt93uFdw+8Bi/vF5Nw4sWZ3UUB3UUB/7CigL4AbX2vYEN8UJ9Ri0Mjk91zd0rqXjhd7/n3pG0utbnxr",
+	"bzmn1t6wLptVqUtzvlgA0JW+tAqPnK/CQ0MM2RHWxRup1Mop1yj8nfpdWwxFCpZ9IY1naF6HG6k6HwgV",
+	"vqird+Qfwp6vlopvXVad32gQFAWHI1X/BS6l1ahVGpJUtDXdGlw091evwm3bQh1+bBd6TCt4uI13HOXK",
+	"HMWrdA0KDiHN2rAmGlYOZCTJzSxE59q9dxvZB+l0MP0q/6CL6dC9d6yQSgSuwTzTGKmNXINACBzjGJMM",
+	"42ipkVoJl/LJhL6BPE5rhwx7QAJ0OV9mdR/nzBuvRioYmToY8aY0Qk/0Otcn791XQPCo8CAj21LTOQmI",
+	"lmES6wK9nUrcUfBNUDC3Ff1sQb1odOgl8ym6ETp+wicx8Q4ZhiER4mN0QRVGoZVPN9JqmKz7EgfP2t+9",
+	"/aKx2nUG0McNo1DGdJEL63xb5jsHRu0J9pgZW9BSenAr4DfbmKYKU+4iNsvGacSY/41tR7/rONV8pMii",
+	"qBdU476ArvLG6Evhgy3mwps2g12UTyGCJ0zIF4uoFitIsLOoLCellVaB49KSU1DkFAbF2dvXz9lMF1gd",
+	"hzci3EdqJ3gYG7kD9+iOj7/AgE1yDi3+wlkejpQ3xVRAOO2NIFA0lp2EP9uZvgQVmrdCpa0QKSFJB7FN",
+	"bP3tcIebChGIV/q5QgUaIPRkTnGq451Q3zLZMMXWQpiBivhOo2Qz7jro65u53Dqhv/cB/jn14QlExikN",
+	"AGjZxokyyBuqetFnonZhWGadXpChNhmHcIyzfFFE3baCNrC7a2q/d9esbSTKWjXBCaWo73yavqQVSZ/t",
+	"M306rpBg0+yuYUHKNBEDJrehyKZ8OFav0/slhnJsg6kIC/NU+vJIweXP+gbVXoVAc5Vjx2+f4G1pV08m",
+	"ZAu20QshU2xJ1XqGI/W4npaUEMVev377/EkYPFkkgwxiT/7P8dHJExuQKjaJ2UOsGe8bXgmVs53Hr96+",
+	"PMnY25cnz55jCYSQwleFH9d9/EbK29q9ac4bx7j1BSWH7KS6LVfG40tw/o4LPT73KhY4FEozrtP7sDxE",
+	"tGAYXOZCOTmRVT6fNOzts+MDb4IkzWciC8H4FExx9lwuLI0enRi9XMXfZrjz4OahAb2SJ4wIm8WshEpC",
+	"IxUKX7B03QsrBGk9l7osYPggL1M6D+FXQNkvjzV+mVUltktdvL1QFzrN7loNPyNa1MmeGeLmwif2EaFk",
+	"NVsB9K5+udO2eplQkjKDziXEjuFFKda1gFdsYUBpeyz6yQ9dukWJER7kMSFB8a8VScF+8rVNASUuuXRo",
+	"CCVPi2fwGM1Di9mRii2Mnhph0UeNMkLk97LIxknf5lqJkHFdO9TD0xUr6SHJJg8Bs8KRHPKfGzFS4QWS",
+	"SnjtWxgNCTEeTFo09L8fDfyTA/JXAh/An8RoMGRHSmlH9taRqqL4Ijf+N5aNBko7YUcDb5D1XeDkBNzJ",
+	"uRgX3AiLFkewKo8UkT+91bREokB53fIcdYvOukgeXWSlwb0BpngYCs3VyfJS1Xm9XhGAJfl2tXUGehie",
+	"bNQpcfmThyyIeAprzDEuAm8CCKt3zAaePFI8JZyZl81Zl3DGIkyICJ0CtpeEJWwaqRXhOmTXLzO/JA/a",
+	"76IY0/W58b42gYvcGDDdr+hOzF6bmG042YkhXkHS1o7GzVIWPXpkEa6ci8iqGmKWhAJAGkuGx02/INt5",
+	"fy9Ihjy8b4fM5/hLYdlRxn5AXHrsXxyp0kyFchl164RHc5HLcp6xY/wNKg1eskJfDtmfOwTmSK2TmBn7",
+	"/5B23rtIwKYEa16KA2aF35cgtLKRcgehKmYdJk9xWFlUOvGgNlNXGwkxSnj/M+fCYPX/Q0YlYc7F8oCa",
+	"sS64RLNQVb+W0j5RxjzHbe2SMZ4lrBczI1Uq3+YreG9vQLh8IZ7ar+k+1s9d/LXJBkAxqt5y58W+btnQ",
+	"4M/biIUtavZI5Y3m/SvqVE3d7EhtXVGnKoPxJfCOuxo3dzVu7mrc3NW4+T3EQSG/XOHp29XDWV8RAN76",
+	"fccbwAo/a0kCAmA95XyFJQm+bMWrUcOAMqJVO44gVTNqVePa+wD/bAofeNpIyyLA/KHaOvuTUlBICSNV",
+	"je1YPXHeLI0tRrRhSqvd1mBxazp6nUZIqWPkLf4iaLvdoYPq96dn9Ft989EGCEXl4vzDts9aQ0FVxEHl",
+	"d0kLl1AzIVVc4I+MgTdV02BrebZ/IwBskGd3NQ2ulxpDTQPFxHtpqyLTV5Zle6leqek7fe8+pX8IWXK9",
+	"16perU6rl0JENqwsY4KbQv5Bg+U3XJjiBow2yp6PyKXG6u4Y+aOoi6M0wjIh0erPHQzIz6wuSufLwO/Q",
+	"q6fc3SNHdUin8s5gasMUHaDvEIfNdgoOOZ+nTrM/+9fvQdI+/TlayUQX4EbBoC4YI4Qn2BCQj/4MAsin",
+	"0w7ZkWNzbR27vx+NtBCmU3uMry39Wk3eyfDtroR1D8vPci/dqoXm3c30eiX5UZ5jGIvfXuxMto45bSvP",
+	"9z6EH9t31q5b4h+YzrPO9spds0bbe/P31Aqau7vq2rZVvtlfLa9DuOMnExb5wtbZfp6o3Ff2w1djIR/K",
+	"HIOIBqnczhT5mZ/fGWo+61X1ElMA7+gqQVeAnIxXDVa6jUBJ9fnvMhd1GRUiyRCaUdAl1kZ1K6llDKWR",
+	"VsEdvjI9qLu26uY9ZOjm88meEXDMYP1NPysSHPs59ByiFyFjw1qfG0IfXWJjGjTNEsMYAkr4+kTSskV5",
+	"Vkg766h2haPeGbquU0mu9/TLN3QF9LtTj6+H5dDZJ0T3lvYtIP5doZyRYr2JC4qgPPHv3cng6zZwRbu7",
+	"jpTgNeZPq8PKRaYNtHVljNp1YLh/qRT8C+dt7J0VbMUK5lY2tk1XsPUnho/PASG6hTmUkDQ5abHhhHxx",
+	"AxdCCpV2zMFQIq9K2tLBUKYMwLEcqXlpKdUyVFRkvhwiiGAYwwfQUnVs+tRbzPjYlbw4Dba1kYpjiG2J",
+	"pZZAU5hIhRK7sf5uW1fA0uWdDL9eQ1e1sZ/J0hXN34P5LO+MXTdi7JpzVfKipsVl2uq1womuIOj3PuDw",
+	"m4I1nuTSaWN9LX0fhq8vVWAUh3W5Nn2pRCi6DwxqSQvoqvjwR2YkrRkjuuqaNxzXzRvSImDuTGl9TGkR",
+	"tXYY065Or2YPJX53ahMWCF4YqcZywQtMwNFKNJU97HUHP1R/xgCrZWiAgf0zMLOBcmIod8QXeOJUwmUh",
+	"8lMY45SoOlnNmhvUYu+cYNcqlWPf101qAPX5bVIBTEgj/z1L/2/3v9/8wWOtJoUcu9u//OMdj3uyRnK+",
+	"XqajF90856UONU35YiFUTjXesISBEqGjddXB3MUYM0ywDb244xq3zDX2b//eYIIYuWMan49paKqr1NAO",
+	"PoV7zKmVTWeuHRgk6GIgrTdY+NQ4dqSWkd5CVSzaDTR8OUg0VfjJ0lVj4T/fWOd3XzM2Wus6qvOvxNVJ",
+	"7wrFrlj9Kgy0IWVIWmZn3HjDXEQRAbu6LX8/Go41yBSlvNfYveMqOnh27M9DGnb00zPIi7f3EBSBd22m",
+	"DbuQ4lIYZjRmxb+Ceqsr12wgC57nFUkk1PGjPK/x5PeauNRY5GeynMUAbOhf5xHrTgDepABcUxvyzQyV",
+	"1ig3sEWrSYJfL/32PlRfb7KnnbTJ2JvW4DpO4x36f2382M3E3Irigrq+FILT3yhzvUX7ZJr4gsi/3Z+/",
+	"5o24FfdqxuiXnwYm3umbN4UdkULiW3j9gc1ga2iKygHjwXn9rW5AUJ1kUoame8h2iTvfQwyEYkIFrJuI",
+	"3mH85+ofewUpvH/LUvi1LsRdxtV1d0EnysSLJexvL/rvEKlVMu9uVWBxYyGXVFHGuqzLSMV1XbB5AcfG",
+	"Ya1uYd7gvSNV8oVQ1QVyj98I50c5hRH/hvWTsB8b3W9Ze4yuYjBVrcmq/vGXx7uoXgpVmF/dGlj/cKSe",
+	"TfB+bxdijFW7srDndJ83pWA76c0PGx/21WnWeztT5aqic+lRsupGU9ZWT3atjSzekM7SIHcBO4j9KZJP",
+	"9j9IUNemQh+tT37fVT9ay/2sJUAS0GwmmbtglJuqCdKsrVER2yYC6y/c9z6EH/vlYH15xNkO5ghY2TVr",
+	"tOJbiOYI0NzFcvQp4dEWLJuFSTJg+kfh7nD1dlwiqa3uJTYmtdXyTq/6Ubgk/kNx02fHm6lgbXmbO1K4",
+	"JTvMp2lz+zcPTQ+yvLPP3ExFnCsIty5Friw25AodlU7P8U+v8d3fu0t+Zb1rbZDwQuyUv+vlutZFz6ut",
+	"9Q3IUt1c6/1f45+vElydkdNpVasGDQeq3YAcs3WwW4pPmxlrlUsYK6MW/2Bp0soyUyowOGqTC5M1Wrw4",
+	"w5Wl1xj3gwRrKccDhyZrFJJTYBsUWCD8menQTSdcbrnKK9boZkaXU0obOvrp2aGviG8z6BVjnTZitRe5",
+	"7zADgOQaTXU4C/gVh5TKqy+EyUvhNxl7+CB8oSXNpYai/hnT2DBG1G1uomwpaseGK7RsznPUHky9qElp",
+	"0N2Kf8pYuWBOs0csF2JBrQU4PoIdtYy7kcJ6PNWMlB2Ndh7axkN6M0SWYSfaS76kfut6OvXxy1S3fThS",
+	"R/7EcPcdN1PhKhcwnrnChrV1NAYZtGFxWWgXXxSnl+JspvV5hQDVV/6BZ8TMjvWCGhuc+CMmeLHW0YwX",
+	"k4BvTiiuPMaF89aGFdy66sylIzMrwU4fKM0KrQCVZ9ym4K4daSb+1TYRmHYi89vesXcdGWFNzvf7tpU1",
+	"1/qZDGWrQKyXNHe2sev2dlXNeFcEU5c0WqdD7X2Af/qZvr4wQss+pLCta0a/zFsoAwRQ3Nm6ejTIbSGw",
+	"72sBVZjFuMQ/F3rarWR12b3uEPUGLxtbcv9cOC4Le3epiIxcvTn3BqPWHZ7fpCXrE3Stz0Ztd5ar6xVV",
+	"dS3na9W19uCes4X56jW8/scj79aML/h7OS/nTJUQxQV3V7wwOu1jeDoCcgo5l64RiuNDgwYHj/azwZxG",
+	"HRw82N+vgJDKiakwt265g6NeT+bKZtAqY8Ved0ffVzXxGTEWynm7yOTTad0qvrAz7TbHDXIW3m0Uohzr",
+	"UjmChUwnYHASF8Is2duTx9gVE9P8/wX//9dIBVvcv5z+V9OUm+GnZ6VROdTM2NGL0CL7HvWVK+elr1w9",
+	"KfTlSO3gQzR1UW/Ne2DnMg5qU3v7GzOhvNBMRKASfAiugIoAM10aaj/dWCRH8Ge68AOggcmPQgOjIYs7",
+	"eI8sck7nfPmNxTIB9ImDBVqI0jNDdsyXtipCyOtBwqwZCMMZWJJyeDOU+A6PLbsURlSfhQbUY62sM+UY",
+	"hCluNi4Et4TNpHXaLOMzg8bgKqe2o2i1OuPj84mEln3YynTJ5tLa6lpYd/ErrWDUBNuxRdW1lUYL5s1k",
+	"5OaPArPw3lTI9gXGbBqLxwj78csvv/yy++LF7vFx1fMT+fbDfToWMsQ63dUCFM6gwcHFez5fFPDowf6D",
+	"73b3H+7u3+8D1XO+GSjEuAyI7d4h474m+8PvviNQqRSWBygFq9MbIH24/xkcRDWmJKTKMZfFktU00crY",
+	"vBMvV4hooF1N8vRIpsDhrHF1AtPZLErgtLwcWJQugwSt3BdoHS/HhfA/w//BP+Q5GXAZYZ2ccydGio/H",
+	"peHjZSR5wCXD/gm4ngFxkm9JO17gpxqh4EWxZFOjy4V31fBpNlKemS2ZNuxSiPMhex5AIlGLXJU6Avtu",
+	"WzWgNctFMQblYbhxEBh/ujB6aoSFlFXNxtQOW2pFks77q6om2d4TZshT411E4KECQUgejrq1alXiDurj",
+	"YTftXAtKGsfTYzwaeshOaCtHyu+lrCvZejjsAqvSKhKIKHv9uyCuYtiG7En7FGAujq0s/LO8KqYXstUb",
+	"FfYC0VYwUuMJ72uaQcLemXazIaP+jyDn4lOjBtdeFAFu4pmEYzwEkeRr8MLcFuQ9L+hFj9zVSifk1Vsj",
+	"tRz/EiUW1TTRk/pg0sLKVwrfQlpVoIA83wUc6QPPE5U3oMkoBcTKixXAlL68Dkl1NSh/MIKfV/oRkM6l",
+	"CnyArbIBtoOkDA7hF1rlfNm1hYiap2fNDIoN1YOc/RG++mGZAvPZ0UsqQvUbkDbAYn2hUqmauwlyv2vD",
+	"fusQ7U9Koxdi743T4/OZLuafQ8A7nhbu+OBOpF+LSH8KTX3mwhk5vpI0p9f6CHNgrEZMpXXCgKhSUbjO",
+	"2ZIpHoqvsxmABOnbvqJqdCFJpanAlQP49JCdIJc3IG6F2AXqP2AcZsZ81zMBr+YjFS5XZwIGqmHKfIQH",
+	"/S4xRp/xPIeL7VgXGNSSs2iJBBNuCbw6E0Z0JaUBaL/7mC1Y5NqARD5t0G118Hf2m9XayXwakSORRWn5",
+	"NFgo4rpC8O+64smEztZTAg7Vgc9U7xjeqjRFVLOcBuKRCuhn2FXHmE9/36EqJ3z6uSoJw8xrqSpmrHe1",
+	"UD5TLZRAaZ7QdKLps6fVNbJ074Pj01OgibVlT55VBreURDwXYhHYSGe54C+AXtspA3zqFYG3r5/vCjXW",
+	"ucjT84dtWgvA1RK8Knry91CqGHMX/LKuaC+1vYw2DYWX49OkpFoXBPDHRcybcvxvK7n2b1Ny3Xn4b8bD",
+	"H6sESTLsJ4T25sJMRXfl2tdx1yurSzP2yqtvwiFtEIaVa6lLbI1U42ogFdbdjgL+g6XP2//yymjnv8N5",
+	"QfaNVDTx2LfxiLlTdQshiEO9EmTzh6HQLo0wUr7QZnxPlI457NyF9uQU76tsvdF3I0XTQX9dODsnVLQj",
+	"zdooVeMvsNJ6GjkkA+gQDofn4BNsZXJEmQipm+gLOMwv4ir6e+Gw1Y5+Tg6LjGDjxRsp+Y7RXpnRZutS",
+	"AhEPiANJ5TTjn8R0jSBU7+a6CERlWevFX5uWF3gJ+J2l/oYx/0rz3tcAEw7oV0d8WFrGCyN4vkTnERjj",
+	"JBnM6Bp2SHhXM2iprBM878EERyrJBdknMkFcyJ2aeY1MsNrRL50JMiKsOyPJ7RlJsvUdX+A4aoMJBV3B",
+	"Bx1MLOmiaPJWIzBxE/su2JkQrp97Qs5F1UCuwU2bsWBR7zksGY1olI1U5eCvotnijFL4IxZfY+63oMWS",
+	"ghz3yEOWR+DHPuyqMxRlVRJU9NSDDfFYwTlfBRSg512b3LNsGqsZ8QU141tedyscjD6jAyDtngLVosF9",
+	"+IVdAOevteD4jYUwUufoRV8YDYtC0YI5nxIEjl8nd3XgHLF48vtUmj7Gsyk4Az8Wjg+pvVhzf6So/6JP",
+	"toHPrJNFEb/sAx4oKEvpy3AG9Ofazz9S7bCBIfsZTop4+d/G9sLr+wA+iDoQlEZfwnop/qACpCNg4KRC",
+	"zA3y5ysPOCN0v6GIs/vbefKRToIjnxQgZDkGV9KAHZ5emxO/Ous1jvwgszyKNaEBQdh5pPj+9rA8pe82",
+	"xhR4fRGP7GsPKKiJDuSEE+/d3theNL9faSEueB5IOqODR9XMt6nJvAKYOekKkdHNPgtcNINwYXs4UuEP",
+	"wCmevXnF/vrd/n1kEPgCy8VYzkO3DnepGfFb4hyr+9PWaDxm+4V9yWrMbcY0nKzI8joGfJXyG9VaUG2o",
+	"9Qi7VONO1eFYFI4zeAXj9PRkUkgldsd8wc8gRbyQsLQhCypGrUFkCfVmpCr9Jqlf+DIGWKAhxPXhi6Wx",
+	"mgpkwB3OSy2K4BMZWxQlkOv8zDpsIwWQhoht/AMAgeArYeOZC+DWYWp9EOLumhc08ongZZDafGBvGaXD",
+	"l3TFLDA44nKmrcBmDbhaxHYfmOitbhCPj8NU8DJeWO0f2+ri+o2tb4ugLLVuuUP2xmkTHBK+AjFtFAJk",
+	"BUrnkfJKnhLvHR4k2P1kIdiM29M5jCAtVtnN6Jj5lEvFjJzOHOOXHCr0/lwF6YeDwGN2MwBI1nV4k739",
+	"ALs2yP/HBHWlWi6MuJC6tAhQB/8lSAbrLoE9spDGvuAIJiLxvOL+7NH+/r3eSUlVHtL9fUhEmkvlf73l",
+	"pCTY63UXw1BexUo1FhFh/dE4ahSXkm2oa4evZhvsTYFh0b4GKol4LhJBxXBRje9MIoSahKTwh952n7kD",
+	"22rXr0NWd/NEE9iZFcqRM0Hpul2o/Z1KXUCLWoZ8Y5u7s7ERGrRoWp9C+g9844ZD2HCSdef+hoPYQ2g/",
+	"W5mxW68ObutFRwdJB9IdenYUfcd8DS0qOIXV70FDYFYb5+tUQXrYpYTMvowsClqRzB+pHa9s32NV5iAp",
+	"GRK0LXg4ZMcl5AOUKvfNBSRaSybyvW/Fb5k2I4jQBm3NCrR5W10AgFVnTYRUIuoeADynPjIeFRb67BSv",
+	"Q38ZqbngyrLRgMCu8h9Qn/gLXppGg+4iT7B3g5sMV4MJPlO8Gk3dh4RCLaU7P9Qn9KDk2DOb7NpIXlZw",
+	"M54lCDVmtXsfetVIqvB0U9RUdKg0Yn53Qqu1sGt2mOSiXXpP+gz2PwO93mVNxKWdNxzn2rtdRC6d/ZA/",
+	"ORh6bZzdDUqgeoLP5BDcQgJ5r/KdBLqGcshrCaIlfKhTei/vHL5JlWPxRuFVtYwt+FQqOD9WyHPSBNGz",
+	"Mxyp18JXgsibimGl9XnHE7oGQlYinax3D0FRBa1/Ezlb8FC9Ft+GYQoxcQysP6UqyEQX1EfqsGRDzuhP",
+	"kLXh9LnwZVWx7ASsoqV1elTsSiGCjUS4Bp+dt2QfkiagBZ+KUyt/E+naNA8eZQ2b0AaTULvhX7WTZBOr",
+	"7GEBpcMxYqVgj4adcOJA2xnKjsgiCXhJqIOZ47XZrEJQxNche6xLhamhIEOCERhfypjVvnWaPfc2AiFy",
+	"auefAtlj1SlOeYp+y/QmY6ev7PZ7cyFmruO5z9I0fMd4r6yFvC5VT6Ybqiivta/8HF66YUwJ82xs7qwn",
+	"ofwzs+VZ9fjrNqCFo+g2tqTXXJ9tdU7dlhcwTsafszMBVa2tDwSpbXS+6jUWvwBuZMRYSPC3iAtYJIZk",
+	"kwsnKqqtL5Vl2lCcH3WkZHoyHKljUcgLEWJZmJVT5bu+s7+/OHq8++bvRw8efReqWLzQSu++kVPFXWkE",
+	"m6G/Fdwq3mJqxdgIDLFYGH0hc/Jawe++9DrEAsJA9Yt+Dd7rgzEy8NeA6t3mGL+nN2qR8XN81m5wFQzd",
+	"pPdzAv2+DjvNDRJr2vJCO3QGqu/b18+BuIhs0sS6wot7GmBizNxkg0ke3R/SGrP+4CqDTIrVVkWb84qb",
+	"dTHfLoNN55ldnyC9IiH/QbGg24aTwoBOWbvu0pWkvc9k2rlZadaY4zMZeK4qx+6sPdfBPyuDT3/qSQm/",
+	"vYjD9riYHMf8+IuhxZaR4FWtg0YihJReaX0Edsctv3q4FRX4jVm+oa97RfhEkH3d1YZbyLGOJ9Rv3ZUc",
+	"vuZbq686HOEVpmpcG4fY++B/XvrOx/7XOEluNc/Lv7JCJl809whAElWGNSanjvbjmqtC3b9uOR1WtbYQ",
+	"eFjtkv1aivLuxhKT2P+GHQEvP7nXaZtiazdePDsIa+3ENJEwFx05kHrMC5aLC1HoxZzmKE0xOBjMnFsc",
+	"7O0V8MJMW3fw1/2/3t/jCzn4+O7j/z8AAzlqCjwBAgA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
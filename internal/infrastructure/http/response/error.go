@@ -94,6 +94,11 @@ func Unauthorized(w http.ResponseWriter, message string) {
 	Error(w, "UNAUTHORIZED", message, http.StatusUnauthorized)
 }
 
+// Forbidden sends a 403 Forbidden error.
+func Forbidden(w http.ResponseWriter, message string) {
+	Error(w, "FORBIDDEN", message, http.StatusForbidden)
+}
+
 // Conflict sends a 409 Conflict error.
 func Conflict(w http.ResponseWriter, message string) {
 	Error(w, "CONFLICT", message, http.StatusConflict)
@@ -168,6 +173,66 @@ func FromDomainError(w http.ResponseWriter, r *http.Request, err error) {
 		ValidationError(w, "timezone", "invalid timezone (expected IANA timezone like 'America/New_York')")
 	case errors.Is(err, domain.ErrInvalidPageToken):
 		ValidationError(w, "page_token", "invalid page token format")
+	case errors.Is(err, domain.ErrInvalidCustomFieldSchema):
+		ValidationError(w, "custom_fields", err.Error())
+	case errors.Is(err, domain.ErrUnknownCustomField), errors.Is(err, domain.ErrInvalidCustomFieldValue):
+		ValidationError(w, "custom_fields", err.Error())
+	case errors.Is(err, domain.ErrInvalidCustomFieldFilter):
+		ValidationError(w, "custom_field", err.Error())
+	case errors.Is(err, domain.ErrInvalidFilterExpression):
+		ValidationError(w, "filter", err.Error())
+	case errors.Is(err, domain.ErrTooManyCustomFieldFilters):
+		ValidationError(w, "custom_field", "at most 5 custom field filters allowed")
+	case errors.Is(err, domain.ErrInvalidAgendaDate):
+		ValidationError(w, "date", "must be a date like 2026-03-10")
+	case errors.Is(err, domain.ErrInvalidAgendaTimezone):
+		ValidationError(w, "tz", "invalid timezone (expected IANA timezone like 'America/New_York')")
+	case errors.Is(err, domain.ErrInvalidStatsRange):
+		ValidationError(w, "from", err.Error())
+	case errors.Is(err, domain.ErrInvalidStatsGroupBy):
+		ValidationError(w, "group_by", "must be tag, priority or week")
+	case errors.Is(err, domain.ErrInvalidStatsTimezone):
+		ValidationError(w, "tz", "invalid timezone (expected IANA timezone like 'America/New_York')")
+	case errors.Is(err, domain.ErrInvalidSnapshotRange):
+		ValidationError(w, "from", err.Error())
+	case errors.Is(err, domain.ErrInvalidTimesheetRange):
+		ValidationError(w, "from", err.Error())
+	case errors.Is(err, domain.ErrInvalidTimesheetGroupBy):
+		ValidationError(w, "group_by", "must be list, tag or day")
+	case errors.Is(err, domain.ErrInvalidTimesheetTimezone):
+		ValidationError(w, "tz", "invalid timezone (expected IANA timezone like 'America/New_York')")
+	case errors.Is(err, domain.ErrTooManyListIDs):
+		ValidationError(w, "list_id", "at most 50 lists allowed")
+	case errors.Is(err, domain.ErrInvalidListRole):
+		ValidationError(w, "role", "must be editor or viewer")
+	case errors.Is(err, domain.ErrInvalidReminder):
+		ValidationError(w, "reminder", err.Error())
+	case errors.Is(err, domain.ErrTooManyReminders):
+		ValidationError(w, "reminders", err.Error())
+	case errors.Is(err, domain.ErrInvalidTag):
+		ValidationError(w, "tag", err.Error())
+	case errors.Is(err, domain.ErrInvalidSnooze):
+		ValidationError(w, "until", err.Error())
+	case errors.Is(err, domain.ErrInvalidTimeEntry):
+		ValidationError(w, "time_entry", err.Error())
+	case errors.Is(err, domain.ErrInvalidWebhook):
+		ValidationError(w, "webhook", err.Error())
+	case errors.Is(err, domain.ErrInvalidSavedView):
+		ValidationError(w, "view", err.Error())
+	case errors.Is(err, domain.ErrInvalidCalendarFeed):
+		ValidationError(w, "feed", err.Error())
+	case errors.Is(err, domain.ErrInvalidRule):
+		ValidationError(w, "rule", err.Error())
+	case errors.Is(err, domain.ErrTooManyRules):
+		ValidationError(w, "rules", err.Error())
+	case errors.Is(err, domain.ErrInvalidImport):
+		ValidationError(w, "file", err.Error())
+	case errors.Is(err, domain.ErrInvalidBackup):
+		ValidationError(w, "backup", err.Error())
+	case errors.Is(err, domain.ErrInvalidSyncCursor):
+		ValidationError(w, "cursor", "invalid sync cursor")
+	case errors.Is(err, domain.ErrInvalidLastEventID):
+		ValidationError(w, "Last-Event-ID", "must be a cursor from an earlier event")
 
 	// Not found errors (404)
 	case errors.Is(err, domain.ErrListNotFound):
@@ -178,6 +243,26 @@ func FromDomainError(w http.ResponseWriter, r *http.Request, err error) {
 		NotFound(w, "recurring template")
 	case errors.Is(err, domain.ErrDeadLetterNotFound):
 		NotFound(w, "dead letter job")
+	case errors.Is(err, domain.ErrMemberNotFound):
+		NotFound(w, "list member")
+	case errors.Is(err, domain.ErrReminderNotFound):
+		NotFound(w, "reminder")
+	case errors.Is(err, domain.ErrTimeEntryNotFound):
+		NotFound(w, "time entry")
+	case errors.Is(err, domain.ErrWebhookNotFound):
+		NotFound(w, "webhook")
+	case errors.Is(err, domain.ErrWebhookDeliveryNotFound):
+		NotFound(w, "webhook delivery")
+	case errors.Is(err, domain.ErrSavedViewNotFound):
+		NotFound(w, "saved view")
+	case errors.Is(err, domain.ErrCalendarFeedNotFound):
+		NotFound(w, "calendar feed")
+	case errors.Is(err, domain.ErrRuleNotFound):
+		NotFound(w, "automation rule")
+	case errors.Is(err, domain.ErrTagNotFound):
+		NotFound(w, "tag")
+	case errors.Is(err, domain.ErrDeadLetterReminderNotFound):
+		NotFound(w, "dead letter reminder")
 	case errors.Is(err, domain.ErrNotFound):
 		NotFound(w, "resource")
 
@@ -185,9 +270,27 @@ func FromDomainError(w http.ResponseWriter, r *http.Request, err error) {
 	case errors.Is(err, domain.ErrUnauthorized):
 		Unauthorized(w, "invalid or missing API key")
 
+	// Authorization errors (403)
+	case errors.Is(err, domain.ErrForbidden):
+		Forbidden(w, "API key does not have the required scope")
+	case errors.Is(err, domain.ErrListAccessDenied):
+		Forbidden(w, err.Error())
+
 	// Concurrency errors (409)
 	case errors.Is(err, domain.ErrVersionConflict):
 		Conflict(w, err.Error())
+	case errors.Is(err, domain.ErrMemberAlreadyExists):
+		Conflict(w, err.Error())
+	case errors.Is(err, domain.ErrAlreadyImported):
+		Conflict(w, err.Error())
+	case errors.Is(err, domain.ErrBackupConflict):
+		Conflict(w, err.Error())
+	case errors.Is(err, domain.ErrNoRunningTimer):
+		Conflict(w, err.Error())
+	case errors.Is(err, domain.ErrTimerAlreadyRunning):
+		Conflict(w, err.Error())
+	case errors.Is(err, domain.ErrTagAlreadyExists):
+		Conflict(w, err.Error())
 
 	// Unknown errors (500) - Log server-side, return generic message to client
 	default:
@@ -130,9 +130,11 @@ func setupRouter(apiHandler http.Handler, authenticator *auth.Authenticator, cfg
 		}
 	})
 
-	// API routes with authentication
+	// API routes with authentication. Calendar feeds are fetched by calendar
+	// apps that cannot send an Authorization header; the token in the URL is
+	// their credential.
 	r.Route("/api", func(r chi.Router) {
-		authMiddleware := mw.NewAuth(authenticator)
+		authMiddleware := mw.NewAuth(authenticator, "/api/v1/feeds/")
 		r.Use(authMiddleware.Validate)
 
 		// Mount the provided API handler
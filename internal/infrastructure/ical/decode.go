@@ -0,0 +1,670 @@
+package ical
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+const dateLayout = "20060102"
+
+// Decode reads the VTODO and VEVENT components of a calendar as import
+// entries, in file order, keyed by their UID.
+//
+// VTODOs become items due at DUE; one-off VEVENTs become items due when they
+// start, with the event length as estimated duration. Components with an RRULE
+// become recurring templates instead, starting at DTSTART (or DUE), with their
+// EXDATEs as deleted occurrences. Templates have no timezone, so their times
+// are kept as the wall clock of the source timezone.
+//
+// A component that cannot be mapped, like one with an RRULE that has no
+// matching recurrence pattern, is returned with the reason as Problem.
+// Returns domain.ErrInvalidImport if data is not a calendar.
+func Decode(data []byte) ([]domain.ImportEntry, error) {
+	components, err := parseComponents(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidImport, err)
+	}
+	if len(components) > domain.MaxImportEntries {
+		return nil, fmt.Errorf("%w: more than %d components", domain.ErrInvalidImport, domain.MaxImportEntries)
+	}
+
+	entries := make([]domain.ImportEntry, len(components))
+	for i, c := range components {
+		entries[i] = decodeComponent(c)
+	}
+	return entries, nil
+}
+
+// property is a content line: NAME;PARAM=value:value.
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// component is a VTODO or VEVENT with its own properties; properties of
+// nested components like VALARM are left out.
+type component struct {
+	name  string
+	props []property
+}
+
+// first returns the first property with the given name.
+func (c *component) first(name string) (property, bool) {
+	for _, p := range c.props {
+		if p.name == name {
+			return p, true
+		}
+	}
+	return property{}, false
+}
+
+// all returns every property with the given name.
+func (c *component) all(name string) []property {
+	var props []property
+	for _, p := range c.props {
+		if p.name == name {
+			props = append(props, p)
+		}
+	}
+	return props
+}
+
+// text returns the unescaped value of a TEXT property, or "" if it is missing.
+func (c *component) text(name string) string {
+	p, ok := c.first(name)
+	if !ok {
+		return ""
+	}
+	return unescapeText(p.value)
+}
+
+// parseComponents returns the VTODO and VEVENT components of every VCALENDAR in data.
+func parseComponents(data []byte) ([]*component, error) {
+	lines := unfold(strings.TrimPrefix(string(data), "\uFEFF"))
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, fmt.Errorf("not an iCalendar file: must start with BEGIN:VCALENDAR")
+	}
+
+	var (
+		components []*component
+		current    *component
+		stack      []string
+	)
+	for n, line := range lines {
+		p, err := parseLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+1, err)
+		}
+
+		switch p.name {
+		case "BEGIN":
+			name := strings.ToUpper(p.value)
+			stack = append(stack, name)
+			if len(stack) == 2 && (name == "VTODO" || name == "VEVENT") {
+				current = &component{name: name}
+				components = append(components, current)
+			}
+		case "END":
+			name := strings.ToUpper(p.value)
+			if len(stack) == 0 || stack[len(stack)-1] != name {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", n+1, p.value)
+			}
+			stack = stack[:len(stack)-1]
+			if len(stack) == 1 {
+				current = nil
+			}
+		default:
+			if current != nil && len(stack) == 2 {
+				current.props = append(current.props, p)
+			}
+		}
+	}
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("missing END:%s", stack[len(stack)-1])
+	}
+	return components, nil
+}
+
+// unfold splits data into content lines, joining folded continuation lines.
+// Accepts LF line endings as well as CRLF.
+func unfold(data string) []string {
+	var lines []string
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// parseLine splits a content line into its name, parameters and value.
+// Parameter values may be quoted; only the first of a list of values is kept.
+func parseLine(line string) (property, error) {
+	colon, inQuote := -1, false
+	for i := 0; i < len(line) && colon < 0; i++ {
+		switch {
+		case line[i] == '"':
+			inQuote = !inQuote
+		case line[i] == ':' && !inQuote:
+			colon = i
+		}
+	}
+	if colon <= 0 {
+		return property{}, fmt.Errorf("malformed content line")
+	}
+
+	parts := splitUnquoted(line[:colon], ';')
+	p := property{name: strings.ToUpper(parts[0]), value: line[colon+1:]}
+	for _, param := range parts[1:] {
+		key, value, ok := strings.Cut(param, "=")
+		if !ok {
+			return property{}, fmt.Errorf("malformed parameter %q of %s", param, p.name)
+		}
+		if p.params == nil {
+			p.params = make(map[string]string)
+		}
+		value = splitUnquoted(value, ',')[0]
+		p.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+	return p, nil
+}
+
+// splitUnquoted splits s at every sep outside double quotes.
+func splitUnquoted(s string, sep byte) []string {
+	var parts []string
+	start, inQuote := 0, false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '"':
+			inQuote = !inQuote
+		case s[i] == sep && !inQuote:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// decodeComponent maps a VTODO or VEVENT to an item, or to a recurring template if it has an RRULE.
+func decodeComponent(c *component) domain.ImportEntry {
+	entry := domain.ImportEntry{ExternalID: c.text("UID")}
+	item := &domain.TodoItem{
+		Title:    c.text("SUMMARY"),
+		Status:   decodeStatus(c),
+		Priority: decodePriority(c),
+		Tags:     decodeCategories(c),
+	}
+	entry.Item = item
+
+	// Changed occurrences share the UID of their recurring component
+	if rid, ok := c.first("RECURRENCE-ID"); ok {
+		entry.ExternalID += "/" + rid.value
+		entry.Problem = "changed occurrences of recurring components are not supported"
+		return entry
+	}
+
+	times, err := decodeTimes(c)
+	if err != nil {
+		entry.Problem = err.Error()
+		return entry
+	}
+
+	rrule, ok := c.first("RRULE")
+	if !ok {
+		times.applyTo(item)
+		return entry
+	}
+
+	template := &domain.RecurringTemplate{
+		Title:    item.Title,
+		Tags:     item.Tags,
+		Priority: item.Priority,
+	}
+	entry.Item, entry.Template = nil, template
+	if err := decodeRecurrence(c, rrule.value, times, &entry); err != nil {
+		entry.Problem = err.Error()
+	}
+	return entry
+}
+
+// dateTime is a DATE or DATE-TIME value.
+type dateTime struct {
+	t    time.Time      // Absolute for fixed times, the wall clock in UTC for floating ones
+	loc  *time.Location // Timezone of fixed times, nil for floating ones
+	date bool           // VALUE=DATE: a day without time
+}
+
+// parseDateTime parses a value of a DTSTART, DUE, DTEND or EXDATE property:
+// a floating or UTC time, a time in the timezone of its TZID, or a date.
+// UTC times written by Encode are fixed in the timezone of their timezoneParam.
+func parseDateTime(p property, value string) (dateTime, error) {
+	if len(value) == len(dateLayout) || p.params["VALUE"] == "DATE" {
+		t, err := time.Parse(dateLayout, value)
+		if err != nil {
+			return dateTime{}, fmt.Errorf("invalid %s date %q", p.name, value)
+		}
+		return dateTime{t: t, date: true}, nil
+	}
+
+	if utc, ok := strings.CutSuffix(value, "Z"); ok {
+		t, err := time.Parse(dateTimeLayout, utc)
+		if err != nil {
+			return dateTime{}, fmt.Errorf("invalid %s time %q", p.name, value)
+		}
+		loc := time.UTC
+		if tzid := p.params[timezoneParam]; tzid != "" {
+			if named, err := time.LoadLocation(tzid); err == nil {
+				loc = named
+			}
+		}
+		return dateTime{t: t, loc: loc}, nil
+	}
+
+	t, err := time.Parse(dateTimeLayout, value)
+	if err != nil {
+		return dateTime{}, fmt.Errorf("invalid %s time %q", p.name, value)
+	}
+	tzid := p.params["TZID"]
+	if tzid == "" {
+		return dateTime{t: t}, nil
+	}
+	loc, err := time.LoadLocation(strings.TrimPrefix(tzid, "/"))
+	if err != nil {
+		return dateTime{}, fmt.Errorf("unknown timezone %q (expected an IANA timezone)", tzid)
+	}
+	return dateTime{t: wallClockIn(t, loc), loc: loc}, nil
+}
+
+// in returns the time in the timezone mode of an item: floating when loc is
+// nil, fixed in loc otherwise. Floating times are read as the wall clock of
+// loc; fixed times of another timezone keep their absolute time.
+func (d dateTime) in(loc *time.Location) time.Time {
+	switch {
+	case loc == nil && d.loc != nil:
+		return wallClockIn(d.t.In(d.loc), time.UTC)
+	case loc != nil && d.loc == nil:
+		return wallClockIn(d.t, loc)
+	default:
+		return d.t
+	}
+}
+
+// wallClockIn returns the time with the wall clock of t in loc, as UTC.
+func wallClockIn(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc).UTC()
+}
+
+// componentTimes are the times of a component in the timezone mode of its main time.
+type componentTimes struct {
+	loc   *time.Location // nil for floating times
+	start *time.Time     // DTSTART
+	due   *time.Time     // DUE, DTEND, or DTSTART + DURATION
+	event bool           // VEVENT: due is the end of the event
+}
+
+// decodeTimes reads DTSTART, DUE, DTEND and DURATION. The main time, DTSTART
+// of an event and DUE of a todo, decides whether the times are floating or fixed.
+func decodeTimes(c *component) (componentTimes, error) {
+	times := componentTimes{event: c.name == "VEVENT"}
+
+	values := make(map[string]dateTime)
+	for _, name := range []string{"DTSTART", "DUE", "DTEND"} {
+		if p, ok := c.first(name); ok {
+			d, err := parseDateTime(p, p.value)
+			if err != nil {
+				return times, err
+			}
+			values[name] = d
+		}
+	}
+
+	main, ok := values["DUE"]
+	if times.event || !ok {
+		main, ok = values["DTSTART"]
+	}
+	if ok {
+		times.loc = main.loc
+	}
+	if d, ok := values["DTSTART"]; ok {
+		t := d.in(times.loc)
+		times.start = &t
+	}
+	for _, name := range []string{"DUE", "DTEND"} {
+		if d, ok := values[name]; ok {
+			t := d.in(times.loc)
+			times.due = &t
+		}
+	}
+	if p, ok := c.first("DURATION"); ok && times.due == nil && times.start != nil {
+		d, err := parseDuration(p.value)
+		if err != nil {
+			return times, err
+		}
+		t := times.start.Add(d)
+		times.due = &t
+	}
+	if times.start != nil && times.due != nil && times.due.Before(*times.start) {
+		return times, fmt.Errorf("ends before it starts")
+	}
+	return times, nil
+}
+
+// timezone returns the IANA name of fixed times, nil for floating ones.
+func (ct componentTimes) timezone() *string {
+	if ct.loc == nil {
+		return nil
+	}
+	name := ct.loc.String()
+	return &name
+}
+
+// applyTo sets the times of a one-off item: a todo is due at its due time, an
+// event when it starts. Items become visible on the day they start.
+func (ct componentTimes) applyTo(item *domain.TodoItem) {
+	item.Timezone = ct.timezone()
+	if ct.start != nil {
+		startsAt := startOfDay(*ct.start, ct.loc)
+		item.StartsAt = &startsAt
+	}
+	if !ct.event {
+		item.DueAt = ct.due
+		return
+	}
+	item.DueAt = ct.start
+	if ct.start != nil && ct.due != nil && ct.due.After(*ct.start) {
+		length := ct.due.Sub(*ct.start)
+		item.EstimatedDuration = &length
+	}
+}
+
+// startOfDay returns the local date of t as midnight UTC, the form of DATE values.
+func startOfDay(t time.Time, loc *time.Location) time.Time {
+	if loc != nil {
+		t = t.In(loc)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// decodeRecurrence maps an RRULE to the recurrence pattern of a template and
+// reads its EXDATEs. Only rules that repeat a single time of day forever map to
+// a pattern; rules with COUNT, UNTIL, or several days are reported as problems.
+func decodeRecurrence(c *component, rule string, times componentTimes, entry *domain.ImportEntry) error {
+	// Templates are floating: use the wall clock of fixed times
+	floating := componentTimes{event: times.event}
+	if times.start != nil {
+		t := wallClock(*times.start, times.loc)
+		floating.start = &t
+	}
+	if times.due != nil {
+		t := wallClock(*times.due, times.loc)
+		floating.due = &t
+	}
+
+	anchor := floating.start
+	if anchor == nil {
+		anchor = floating.due
+	}
+	if anchor == nil {
+		return fmt.Errorf("recurring component without DTSTART or DUE")
+	}
+	if times.event && floating.due == nil {
+		floating.due = anchor
+	}
+
+	pattern, config, err := decodeRule(rule, *anchor)
+	if err != nil {
+		return err
+	}
+
+	template := entry.Template
+	template.RecurrencePattern = pattern
+	template.RecurrenceConfig = config
+	if floating.due != nil {
+		dueOffset := floating.due.Sub(startOfDay(*anchor, nil))
+		template.DueOffset = &dueOffset
+	}
+	if times.event && floating.start != nil && floating.due.After(*floating.start) {
+		length := floating.due.Sub(*floating.start)
+		template.EstimatedDuration = &length
+	}
+	entry.TemplateStart = *anchor
+
+	for _, p := range c.all("EXDATE") {
+		for value := range strings.SplitSeq(p.value, ",") {
+			d, err := parseDateTime(p, value)
+			if err != nil {
+				return err
+			}
+			exdate := wallClock(d.in(times.loc), times.loc)
+			if d.date {
+				// A date excludes the occurrence of that day
+				exdate = startOfDay(exdate, nil).Add(anchor.Sub(startOfDay(*anchor, nil)))
+			}
+			entry.Exceptions = append(entry.Exceptions, exdate)
+		}
+	}
+	return nil
+}
+
+// wallClock returns the wall clock of t in loc as a floating time.
+func wallClock(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		return t
+	}
+	return wallClockIn(t.In(loc), time.UTC)
+}
+
+// weekdays is the BYDAY of a rule repeating on working days.
+var weekdays = []string{"MO", "TU", "WE", "TH", "FR"}
+
+// byDay maps days of the week to BYDAY values.
+var byDay = map[time.Weekday]string{
+	time.Monday: "MO", time.Tuesday: "TU", time.Wednesday: "WE", time.Thursday: "TH",
+	time.Friday: "FR", time.Saturday: "SA", time.Sunday: "SU",
+}
+
+// decodeRule maps an RRULE value to a recurrence pattern and its config.
+// BYDAY, BYMONTHDAY and BYMONTH are accepted when they repeat what DTSTART
+// already says, and BYDAY=MO,TU,WE,TH,FR as the weekdays pattern.
+func decodeRule(rule string, start time.Time) (domain.RecurrencePattern, map[string]any, error) {
+	parts := make(map[string]string)
+	for part := range strings.SplitSeq(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return "", nil, fmt.Errorf("malformed RRULE %q", rule)
+		}
+		parts[strings.ToUpper(key)] = strings.ToUpper(value)
+	}
+
+	interval := 1
+	if v, ok := parts["INTERVAL"]; ok {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return "", nil, fmt.Errorf("invalid RRULE interval %q", v)
+		}
+		interval = n
+	}
+
+	for key := range parts {
+		switch key {
+		case "FREQ", "INTERVAL", "WKST", "BYDAY", "BYMONTHDAY", "BYMONTH":
+		case "COUNT", "UNTIL":
+			return "", nil, fmt.Errorf("recurrence rules that end (%s) are not supported", key)
+		default:
+			return "", nil, fmt.Errorf("RRULE part %s is not supported", key)
+		}
+	}
+
+	var days []string
+	if v, ok := parts["BYDAY"]; ok {
+		days = strings.Split(v, ",")
+	}
+	sameDay := days == nil || slices.Equal(days, []string{byDay[start.Weekday()]})
+	isWeekdays := slices.Equal(days, weekdays) && interval == 1
+	sameMonthDay := parts["BYMONTHDAY"] == "" || parts["BYMONTHDAY"] == strconv.Itoa(start.Day())
+	sameMonth := parts["BYMONTH"] == "" || parts["BYMONTH"] == strconv.Itoa(int(start.Month()))
+
+	var config map[string]any
+	if interval > 1 {
+		config = map[string]any{"interval": float64(interval)} // As read back from JSON
+	}
+
+	switch freq := parts["FREQ"]; {
+	case (freq == "DAILY" || freq == "WEEKLY") && isWeekdays && sameMonthDay && sameMonth:
+		return domain.RecurrenceWeekdays, nil, nil
+	case freq == "DAILY" && days == nil && sameMonthDay && sameMonth:
+		return domain.RecurrenceDaily, config, nil
+	case freq == "WEEKLY" && sameDay && sameMonthDay && sameMonth:
+		if interval == 2 {
+			return domain.RecurrenceBiweekly, nil, nil
+		}
+		return domain.RecurrenceWeekly, config, nil
+	case freq == "MONTHLY" && days == nil && sameMonthDay && sameMonth:
+		if interval == 3 {
+			return domain.RecurrenceQuarterly, nil, nil
+		}
+		return domain.RecurrenceMonthly, config, nil
+	case freq == "YEARLY" && interval == 1 && days == nil && sameMonthDay && sameMonth:
+		return domain.RecurrenceYearly, nil, nil
+	case freq == "":
+		return "", nil, fmt.Errorf("RRULE without FREQ")
+	default:
+		return "", nil, fmt.Errorf("RRULE %q has no matching recurrence pattern", rule)
+	}
+}
+
+// decodeStatus maps STATUS (and COMPLETED) to an item status.
+func decodeStatus(c *component) domain.TaskStatus {
+	switch strings.ToUpper(c.text("STATUS")) {
+	case "IN-PROCESS":
+		return domain.TaskStatusInProgress
+	case "COMPLETED":
+		return domain.TaskStatusDone
+	case "CANCELLED":
+		return domain.TaskStatusCancelled
+	case "NEEDS-ACTION":
+		return domain.TaskStatusTodo
+	}
+	if _, ok := c.first("COMPLETED"); ok && c.name == "VTODO" {
+		return domain.TaskStatusDone
+	}
+	return domain.TaskStatusTodo
+}
+
+// decodePriority maps the 1 (highest) to 9 (lowest) PRIORITY scale to an item
+// priority; 0 and missing values are undefined.
+func decodePriority(c *component) *domain.TaskPriority {
+	value, err := strconv.Atoi(c.text("PRIORITY"))
+	if err != nil || value < 1 || value > 9 {
+		return nil
+	}
+	priority := domain.TaskPriorityLow
+	switch {
+	case value == 1:
+		priority = domain.TaskPriorityUrgent
+	case value <= 4:
+		priority = domain.TaskPriorityHigh
+	case value == 5:
+		priority = domain.TaskPriorityMedium
+	}
+	return &priority
+}
+
+// decodeCategories returns the CATEGORIES of every property as tags, without duplicates.
+func decodeCategories(c *component) []string {
+	var tags []string
+	for _, p := range c.all("CATEGORIES") {
+		for _, value := range splitText(p.value) {
+			tag := strings.TrimSpace(unescapeText(value))
+			if tag != "" && !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return tags
+}
+
+// parseDuration parses a DURATION value: weeks, or days and time, e.g. P1W, P1DT2H, PT15M.
+func parseDuration(value string) (time.Duration, error) {
+	s, negative := strings.CutPrefix(strings.TrimPrefix(value, "+"), "-")
+	s, ok := strings.CutPrefix(s, "P")
+	if !ok || s == "" {
+		return 0, fmt.Errorf("invalid DURATION %q", value)
+	}
+
+	var d time.Duration
+	inTime, parsed := false, false
+	for s != "" {
+		if s[0] == 'T' {
+			inTime, s = true, s[1:]
+			continue
+		}
+		i := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+		if i <= 0 {
+			return 0, fmt.Errorf("invalid DURATION %q", value)
+		}
+		n, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return 0, fmt.Errorf("invalid DURATION %q", value)
+		}
+		var unit time.Duration
+		switch {
+		case !inTime && s[i] == 'W':
+			unit = 7 * 24 * time.Hour
+		case !inTime && s[i] == 'D':
+			unit = 24 * time.Hour
+		case inTime && s[i] == 'H':
+			unit = time.Hour
+		case inTime && s[i] == 'M':
+			unit = time.Minute
+		case inTime && s[i] == 'S':
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("invalid DURATION %q", value)
+		}
+		d += time.Duration(n) * unit
+		s = s[i+1:]
+		parsed = true
+	}
+	if !parsed {
+		return 0, fmt.Errorf("invalid DURATION %q", value)
+	}
+	if negative {
+		d = -d
+	}
+	return d, nil
+}
+
+// splitText splits a multi-valued TEXT property at unescaped commas.
+func splitText(value string) []string {
+	var values []string
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++ // Skip the escaped character
+		case ',':
+			values = append(values, value[start:i])
+			start = i + 1
+		}
+	}
+	return append(values, value[start:])
+}
+
+// textUnescaper reverses textEscaper.
+var textUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, "\n", `\N`, "\n")
+
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
@@ -0,0 +1,300 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// calendar wraps components in a VCALENDAR with CRLF line endings.
+func calendar(lines ...string) []byte {
+	all := append([]string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:-//test//EN"}, lines...)
+	all = append(all, "END:VCALENDAR", "")
+	return []byte(strings.Join(all, "\r\n"))
+}
+
+func TestDecode_Todo(t *testing.T) {
+	entries, err := Decode(calendar(
+		"BEGIN:VTODO",
+		"UID:todo-1@example.com",
+		`SUMMARY:Call Anna\; then file\, quickly`,
+		"STATUS:IN-PROCESS",
+		"PRIORITY:2",
+		`CATEGORIES:calls,q1\,q2`,
+		"CATEGORIES:calls,home",
+		"DTSTART;TZID=Europe/Stockholm:20260310T080000",
+		"DUE;TZID=Europe/Stockholm:20260311T100000",
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"SUMMARY:Not the title",
+		"END:VALARM",
+		"END:VTODO",
+	))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	entry := entries[0]
+	require.NoError(t, entry.Validate())
+	assert.Equal(t, "todo-1@example.com", entry.ExternalID)
+	assert.Equal(t, domain.ImportKindItem, entry.Kind())
+	item := entry.Item
+	assert.Equal(t, "Call Anna; then file, quickly", item.Title)
+	assert.Equal(t, domain.TaskStatusInProgress, item.Status)
+	require.NotNil(t, item.Priority)
+	assert.Equal(t, domain.TaskPriorityHigh, *item.Priority)
+	assert.Equal(t, []string{"calls", "q1,q2", "home"}, item.Tags)
+	require.NotNil(t, item.Timezone)
+	assert.Equal(t, "Europe/Stockholm", *item.Timezone)
+	require.NotNil(t, item.DueAt)
+	assert.Equal(t, time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC), *item.DueAt) // 10am CET
+	require.NotNil(t, item.StartsAt)
+	assert.Equal(t, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), *item.StartsAt)
+}
+
+func TestDecode_EventTimes(t *testing.T) {
+	entries, err := Decode(calendar(
+		"BEGIN:VEVENT",
+		"UID:event-1",
+		"SUMMARY:Dentist",
+		"DTSTART:20260312T140000",
+		"DURATION:PT45M",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:event-2",
+		"SUMMARY:Flight",
+		"DTSTART:20260312T140000Z",
+		"DTEND:20260312T170000Z",
+		"STATUS:CANCELLED",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:event-3",
+		"SUMMARY:Holiday",
+		"DTSTART;VALUE=DATE:20260401",
+		"END:VEVENT",
+	))
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	// Floating: the wall clock without a timezone
+	dentist := entries[0].Item
+	assert.Nil(t, dentist.Timezone)
+	assert.Equal(t, time.Date(2026, 3, 12, 14, 0, 0, 0, time.UTC), *dentist.DueAt)
+	require.NotNil(t, dentist.EstimatedDuration)
+	assert.Equal(t, 45*time.Minute, *dentist.EstimatedDuration)
+
+	flight := entries[1].Item
+	require.NotNil(t, flight.Timezone)
+	assert.Equal(t, "UTC", *flight.Timezone)
+	assert.Equal(t, domain.TaskStatusCancelled, flight.Status)
+	assert.Equal(t, 3*time.Hour, *flight.EstimatedDuration)
+
+	holiday := entries[2].Item
+	assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), *holiday.DueAt)
+	assert.Nil(t, holiday.EstimatedDuration)
+}
+
+func TestDecode_RecurringComponents(t *testing.T) {
+	entries, err := Decode(calendar(
+		"BEGIN:VEVENT",
+		"UID:standup",
+		"SUMMARY:Standup",
+		"DTSTART;TZID=Europe/Stockholm:20260302T093000",
+		"DTEND;TZID=Europe/Stockholm:20260302T094500",
+		"RRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+		"EXDATE;TZID=Europe/Stockholm:20260305T093000,20260306T093000",
+		"EXDATE;VALUE=DATE:20260310",
+		"END:VEVENT",
+		"BEGIN:VTODO",
+		"UID:rent",
+		"SUMMARY:Pay rent",
+		"DUE;VALUE=DATE:20260301",
+		"RRULE:FREQ=MONTHLY;INTERVAL=3",
+		"END:VTODO",
+		"BEGIN:VTODO",
+		"UID:plants",
+		"SUMMARY:Water plants",
+		"DTSTART:20260301T080000",
+		"RRULE:FREQ=DAILY;INTERVAL=2",
+		"END:VTODO",
+	))
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	standup := entries[0]
+	require.NoError(t, standup.Validate())
+	assert.Equal(t, domain.ImportKindRecurringTemplate, standup.Kind())
+	assert.Equal(t, domain.RecurrenceWeekdays, standup.Template.RecurrencePattern)
+	// Templates are floating: the Stockholm wall clock is kept
+	assert.Equal(t, time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC), standup.TemplateStart)
+	require.NotNil(t, standup.Template.DueOffset)
+	assert.Equal(t, 9*time.Hour+45*time.Minute, *standup.Template.DueOffset)
+	require.NotNil(t, standup.Template.EstimatedDuration)
+	assert.Equal(t, 15*time.Minute, *standup.Template.EstimatedDuration)
+	assert.Equal(t, []time.Time{
+		time.Date(2026, 3, 5, 9, 30, 0, 0, time.UTC),
+		time.Date(2026, 3, 6, 9, 30, 0, 0, time.UTC),
+		// A date excludes the occurrence of that day
+		time.Date(2026, 3, 10, 9, 30, 0, 0, time.UTC),
+	}, standup.Exceptions)
+
+	rent := entries[1]
+	require.NoError(t, rent.Validate())
+	assert.Equal(t, domain.RecurrenceQuarterly, rent.Template.RecurrencePattern)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), rent.TemplateStart)
+	assert.Equal(t, time.Duration(0), *rent.Template.DueOffset)
+
+	plants := entries[2]
+	require.NoError(t, plants.Validate())
+	assert.Equal(t, domain.RecurrenceDaily, plants.Template.RecurrencePattern)
+	assert.Equal(t, map[string]any{"interval": float64(2)}, plants.Template.RecurrenceConfig)
+	assert.Nil(t, plants.Template.DueOffset)
+}
+
+func TestDecodeRule(t *testing.T) {
+	monday := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		rule     string
+		pattern  domain.RecurrencePattern
+		interval float64
+		problem  string
+	}{
+		{rule: "FREQ=DAILY", pattern: domain.RecurrenceDaily},
+		{rule: "FREQ=WEEKLY;BYDAY=MO", pattern: domain.RecurrenceWeekly},
+		{rule: "FREQ=WEEKLY;INTERVAL=2;WKST=MO", pattern: domain.RecurrenceBiweekly},
+		{rule: "FREQ=WEEKLY;INTERVAL=3", pattern: domain.RecurrenceWeekly, interval: 3},
+		{rule: "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR", pattern: domain.RecurrenceWeekdays},
+		{rule: "FREQ=MONTHLY;BYMONTHDAY=2", pattern: domain.RecurrenceMonthly},
+		{rule: "FREQ=YEARLY;BYMONTH=3", pattern: domain.RecurrenceYearly},
+		{rule: "FREQ=WEEKLY;BYDAY=TU", problem: "has no matching recurrence pattern"},
+		{rule: "FREQ=WEEKLY;BYDAY=MO,WE", problem: "has no matching recurrence pattern"},
+		{rule: "FREQ=MONTHLY;BYDAY=1MO", problem: "has no matching recurrence pattern"},
+		{rule: "FREQ=YEARLY;INTERVAL=2", problem: "has no matching recurrence pattern"},
+		{rule: "FREQ=DAILY;COUNT=5", problem: "rules that end (COUNT)"},
+		{rule: "FREQ=DAILY;UNTIL=20260401T000000Z", problem: "rules that end (UNTIL)"},
+		{rule: "FREQ=HOURLY", problem: "has no matching recurrence pattern"},
+		{rule: "FREQ=DAILY;BYHOUR=9", problem: "BYHOUR is not supported"},
+		{rule: "INTERVAL=2", problem: "without FREQ"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			pattern, config, err := decodeRule(tt.rule, monday)
+			if tt.problem != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.problem)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.pattern, pattern)
+			if tt.interval > 0 {
+				assert.Equal(t, tt.interval, config["interval"])
+			} else {
+				assert.Nil(t, config)
+			}
+		})
+	}
+}
+
+func TestDecode_Problems(t *testing.T) {
+	entries, err := Decode(calendar(
+		"BEGIN:VTODO",
+		"UID:a",
+		"SUMMARY:Unknown zone",
+		"DUE;TZID=Mars/Olympus:20260311T090000",
+		"END:VTODO",
+		"BEGIN:VEVENT",
+		"UID:b",
+		"SUMMARY:Limited",
+		"DTSTART:20260311T090000",
+		"RRULE:FREQ=DAILY;COUNT=3",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:b",
+		"RECURRENCE-ID:20260312T090000",
+		"SUMMARY:Moved",
+		"DTSTART:20260312T100000",
+		"END:VEVENT",
+		"BEGIN:VTODO",
+		"SUMMARY:No UID",
+		"END:VTODO",
+	))
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+
+	assert.Contains(t, entries[0].Problem, `unknown timezone "Mars/Olympus"`)
+	assert.Equal(t, domain.ImportKindRecurringTemplate, entries[1].Kind())
+	assert.Contains(t, entries[1].Problem, "COUNT")
+	assert.Equal(t, "b/20260312T090000", entries[2].ExternalID)
+	assert.NotEmpty(t, entries[2].Problem)
+	assert.Empty(t, entries[3].Problem)
+	assert.ErrorIs(t, entries[3].Validate(), domain.ErrInvalidImport)
+}
+
+func TestDecode_InvalidCalendar(t *testing.T) {
+	for name, data := range map[string]string{
+		"not a calendar": "hello",
+		"unterminated":   "BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nUID:a\r\n",
+		"mismatched end": "BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+		"malformed line": "BEGIN:VCALENDAR\r\nno colon here\r\nEND:VCALENDAR\r\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Decode([]byte(data))
+			assert.ErrorIs(t, err, domain.ErrInvalidImport)
+		})
+	}
+}
+
+func TestDecode_UnfoldsLinesAndAcceptsLF(t *testing.T) {
+	data := "\uFEFFBEGIN:VCALENDAR\nBEGIN:VTODO\nUID:a\nSUMMARY:A very\n  long title\nDUE:20260311T090000\nEND:VTODO\nEND:VCALENDAR\n"
+
+	entries, err := Decode([]byte(data))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "A very long title", entries[0].Item.Title)
+}
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	due := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	stockholm := "Europe/Stockholm"
+	urgent := domain.TaskPriorityUrgent
+	items := []domain.TodoItem{
+		{ID: "1", Title: "Call Anna, then file", Status: domain.TaskStatusDone, Priority: &urgent, Tags: []string{"a;b", "c"}, DueAt: &due},
+		{ID: "2", Title: "Stockholm sync", Status: domain.TaskStatusTodo, DueAt: &due, Timezone: &stockholm},
+	}
+
+	entries, err := Decode(Encode("Work", items))
+	require.NoError(t, err)
+	require.Len(t, entries, len(items))
+	for i, entry := range entries {
+		want := items[i]
+		assert.Equal(t, want.ID, entry.ExternalID)
+		assert.Equal(t, want.Title, entry.Item.Title)
+		assert.Equal(t, want.Status, entry.Item.Status)
+		assert.Equal(t, want.Priority, entry.Item.Priority)
+		assert.Equal(t, want.Tags, entry.Item.Tags)
+		assert.Equal(t, want.Timezone, entry.Item.Timezone)
+		assert.Equal(t, *want.DueAt, *entry.Item.DueAt)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	for value, want := range map[string]time.Duration{
+		"PT15M":      15 * time.Minute,
+		"P1DT2H":     26 * time.Hour,
+		"P2W":        14 * 24 * time.Hour,
+		"PT1H30M10S": time.Hour + 30*time.Minute + 10*time.Second,
+		"-PT5M":      -5 * time.Minute,
+	} {
+		d, err := parseDuration(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, want, d, value)
+	}
+	for _, value := range []string{"", "P", "15M", "PT", "P1H", "PTM"} {
+		_, err := parseDuration(value)
+		assert.Error(t, err, value)
+	}
+}
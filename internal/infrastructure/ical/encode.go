@@ -0,0 +1,183 @@
+// Package ical reads and writes todo items as iCalendar data (RFC 5545).
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// ContentType is the media type of iCalendar data.
+const ContentType = "text/calendar; charset=utf-8"
+
+// productID identifies the producer of a calendar (PRODID).
+const productID = "-//rezkam//mono//EN"
+
+// maxLineOctets is the longest content line before it is folded.
+const maxLineOctets = 75
+
+const (
+	dateTimeLayout    = "20060102T150405"
+	utcDateTimeLayout = "20060102T150405Z"
+)
+
+// timezoneParam names the IANA timezone of a fixed time written in UTC.
+// Other calendar clients ignore it; Decode restores the item timezone from it.
+const timezoneParam = "X-MONO-TZID"
+
+// Encode returns a calendar named name holding the items that have a due or
+// occurrence time: recurring instances (OccursAt) as VEVENTs from their
+// occurrence to their due time, other items as VTODOs due at DueAt.
+//
+// Item times keep their timezone mode: floating times are written without a
+// timezone, so calendars show them at the same wall clock everywhere; fixed
+// times are written in UTC, so they need no VTIMEZONE definition and every
+// calendar shows the same instant. The item's IANA timezone is kept in the
+// X-MONO-TZID parameter.
+func Encode(name string, items []domain.TodoItem) []byte {
+	var w writer
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + productID)
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	if name != "" {
+		w.line("X-WR-CALNAME:" + escapeText(name))
+	}
+
+	for _, item := range items {
+		switch {
+		case item.OccursAt != nil:
+			w.event(item)
+		case item.DueAt != nil:
+			w.todo(item)
+		}
+	}
+
+	w.line("END:VCALENDAR")
+	return w.buf.Bytes()
+}
+
+// writer accumulates folded, CRLF-terminated content lines.
+type writer struct {
+	buf bytes.Buffer
+}
+
+// event writes a recurring instance as a VEVENT.
+func (w *writer) event(item domain.TodoItem) {
+	w.line("BEGIN:VEVENT")
+	w.common(item)
+	w.line(timeProperty("DTSTART", *item.OccursAt, item.Timezone))
+	switch {
+	case item.DueAt != nil && item.DueAt.After(*item.OccursAt):
+		w.line(timeProperty("DTEND", *item.DueAt, item.Timezone))
+	case item.EstimatedDuration != nil && *item.EstimatedDuration > 0:
+		w.line("DURATION:" + domain.FormatDurationISO8601(*item.EstimatedDuration))
+	}
+	if item.Status == domain.TaskStatusCancelled {
+		w.line("STATUS:CANCELLED")
+	} else {
+		w.line("STATUS:CONFIRMED")
+	}
+	w.line("END:VEVENT")
+}
+
+// todo writes an item with a due time as a VTODO.
+func (w *writer) todo(item domain.TodoItem) {
+	w.line("BEGIN:VTODO")
+	w.common(item)
+	w.line(timeProperty("DUE", *item.DueAt, item.Timezone))
+	w.line("STATUS:" + todoStatus(item.Status))
+	if item.Status == domain.TaskStatusDone {
+		w.line("PERCENT-COMPLETE:100")
+	}
+	w.line("END:VTODO")
+}
+
+// common writes the properties shared by VEVENT and VTODO. The item ID is a
+// UUID and so already a globally unique UID; DTSTAMP and LAST-MODIFIED follow
+// UpdatedAt so clients see the component change with the item.
+func (w *writer) common(item domain.TodoItem) {
+	w.line("UID:" + item.ID)
+	w.line("DTSTAMP:" + item.UpdatedAt.UTC().Format(utcDateTimeLayout))
+	w.line("CREATED:" + item.CreatedAt.UTC().Format(utcDateTimeLayout))
+	w.line("LAST-MODIFIED:" + item.UpdatedAt.UTC().Format(utcDateTimeLayout))
+	w.line("SUMMARY:" + escapeText(item.Title))
+	if item.Priority != nil {
+		w.line(fmt.Sprintf("PRIORITY:%d", priorityValue(*item.Priority)))
+	}
+	if len(item.Tags) > 0 {
+		categories := make([]string, len(item.Tags))
+		for i, tag := range item.Tags {
+			categories[i] = escapeText(tag)
+		}
+		w.line("CATEGORIES:" + strings.Join(categories, ","))
+	}
+}
+
+// line writes a content line, folded after maxLineOctets octets without
+// splitting a UTF-8 sequence. Continuation lines start with a space.
+func (w *writer) line(s string) {
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		w.buf.WriteString(s[:cut])
+		w.buf.WriteString("\r\n ")
+		s = s[cut:]
+		limit = maxLineOctets - 1 // The leading space counts
+	}
+	w.buf.WriteString(s)
+	w.buf.WriteString("\r\n")
+}
+
+// timeProperty formats a DATE-TIME property of an item time: floating times
+// as local time, fixed times in UTC with their timezone in timezoneParam.
+// Floating times are stored with their wall clock in UTC.
+func timeProperty(name string, t time.Time, timezone *string) string {
+	if timezone == nil || *timezone == "" {
+		return name + ":" + t.UTC().Format(dateTimeLayout)
+	}
+	return name + ";" + timezoneParam + "=" + *timezone + ":" + t.UTC().Format(utcDateTimeLayout)
+}
+
+// todoStatus maps an item status to a VTODO STATUS.
+func todoStatus(status domain.TaskStatus) string {
+	switch status {
+	case domain.TaskStatusInProgress:
+		return "IN-PROCESS"
+	case domain.TaskStatusDone:
+		return "COMPLETED"
+	case domain.TaskStatusCancelled, domain.TaskStatusArchived:
+		return "CANCELLED"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
+
+// priorityValue maps an item priority to the 1 (highest) to 9 (lowest) PRIORITY scale.
+func priorityValue(priority domain.TaskPriority) int {
+	switch priority {
+	case domain.TaskPriorityUrgent:
+		return 1
+	case domain.TaskPriorityHigh:
+		return 3
+	case domain.TaskPriorityMedium:
+		return 5
+	default:
+		return 9
+	}
+}
+
+// textEscaper escapes a TEXT value: backslash, semicolon, comma and newlines.
+var textEscaper = strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
@@ -0,0 +1,83 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncode(t *testing.T) {
+	updated := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+	due := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	occurs := time.Date(2026, 3, 12, 7, 0, 0, 0, time.UTC)
+	stockholm := "Europe/Stockholm"
+	high := domain.TaskPriorityHigh
+	templateID := "template-1"
+
+	data := string(Encode("Work, home", []domain.TodoItem{
+		{
+			ID: "0190d3c8-6b4a-7000-8000-000000000001", Title: "Call Anna; then file", Status: domain.TaskStatusTodo,
+			Priority: &high, Tags: []string{"calls", "q1"}, DueAt: &due, CreatedAt: updated, UpdatedAt: updated,
+		},
+		{
+			ID: "0190d3c8-6b4a-7000-8000-000000000002", Title: "Standup", Status: domain.TaskStatusTodo,
+			RecurringTemplateID: &templateID, OccursAt: &occurs, EstimatedDuration: ptrDuration(15 * time.Minute),
+			Timezone: &stockholm, CreatedAt: updated, UpdatedAt: updated,
+		},
+		{ID: "0190d3c8-6b4a-7000-8000-000000000003", Title: "Someday", Status: domain.TaskStatusTodo},
+	}))
+
+	assert.Equal(t, strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//rezkam//mono//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		`X-WR-CALNAME:Work\, home`,
+		"BEGIN:VTODO",
+		"UID:0190d3c8-6b4a-7000-8000-000000000001",
+		"DTSTAMP:20260301T123000Z",
+		"CREATED:20260301T123000Z",
+		"LAST-MODIFIED:20260301T123000Z",
+		`SUMMARY:Call Anna\; then file`,
+		"PRIORITY:3",
+		"CATEGORIES:calls,q1",
+		// Floating: the stored wall clock, without a timezone
+		"DUE:20260311T090000",
+		"STATUS:NEEDS-ACTION",
+		"END:VTODO",
+		"BEGIN:VEVENT",
+		"UID:0190d3c8-6b4a-7000-8000-000000000002",
+		"DTSTAMP:20260301T123000Z",
+		"CREATED:20260301T123000Z",
+		"LAST-MODIFIED:20260301T123000Z",
+		"SUMMARY:Standup",
+		// Fixed: the absolute time in UTC, naming the item's timezone
+		"DTSTART;X-MONO-TZID=Europe/Stockholm:20260312T070000Z",
+		"DURATION:PT15M",
+		"STATUS:CONFIRMED",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n"), data)
+}
+
+func TestEncode_FoldsLongLines(t *testing.T) {
+	due := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	title := strings.Repeat("ö", 60) // 120 octets
+
+	data := string(Encode("", []domain.TodoItem{{ID: "1", Title: title, Status: domain.TaskStatusTodo, DueAt: &due}}))
+
+	for _, line := range strings.Split(data, "\r\n") {
+		assert.LessOrEqual(t, len(line), maxLineOctets, line)
+	}
+	unfolded := strings.ReplaceAll(data, "\r\n ", "")
+	assert.Contains(t, unfolded, "\r\nSUMMARY:"+title+"\r\n")
+}
+
+func ptrDuration(d time.Duration) *time.Duration {
+	return &d
+}
@@ -0,0 +1,123 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/rezkam/mono/internal/application/worker"
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// Headers sent with every webhook delivery.
+const (
+	SignatureHeader  = "Mono-Signature"
+	EventTypeHeader  = "Mono-Event-Type"
+	DeliveryIDHeader = "Mono-Delivery-Id"
+)
+
+// SignedWebhookSender posts event deliveries to subscription URLs.
+//
+// Each request carries a Mono-Signature header of the form
+// "t=<unix seconds>,v1=<hex HMAC-SHA256>" computed over "<t>.<body>" with the
+// subscription secret. Receivers should recompute the signature and reject
+// stale timestamps to prevent replays. The Idempotency-Key header carries the
+// event ID, which stays the same across retries and redeliveries.
+//
+// Subscription URLs are set by tenants, so the sender only connects to
+// public addresses (see domain.IsPublicAddr). The check runs on the address
+// being dialled, after name resolution, so host names that resolve or are
+// rebound to internal addresses are refused too. Redirects are not followed.
+type SignedWebhookSender struct {
+	client  *http.Client
+	now     func() time.Time
+	allowed func(netip.Addr) bool // Addresses the sender may connect to
+}
+
+// errAddressNotAllowed is returned when a delivery would connect to an
+// address that is not public.
+var errAddressNotAllowed = errors.New("webhook address not allowed")
+
+// NewSignedWebhookSender creates a sender with the given request timeout.
+func NewSignedWebhookSender(timeout time.Duration) *SignedWebhookSender {
+	s := &SignedWebhookSender{
+		now:     time.Now,
+		allowed: domain.IsPublicAddr,
+	}
+
+	dialer := &net.Dialer{Timeout: timeout, Control: s.checkAddress}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil // The dialled address must be the receiver's
+	transport.DialContext = dialer.DialContext
+
+	s.client = &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return s
+}
+
+// checkAddress refuses connections to addresses the sender may not connect to.
+func (s *SignedWebhookSender) checkAddress(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errAddressNotAllowed, address)
+	}
+	if !s.allowed(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s is not a public address", errAddressNotAllowed, addrPort.Addr())
+	}
+	return nil
+}
+
+// Sign computes the Mono-Signature header value for body signed at timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send implements worker.WebhookSender.
+func (s *SignedWebhookSender) Send(ctx context.Context, sub *domain.WebhookSubscription, delivery *domain.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", delivery.EventID)
+	req.Header.Set(EventTypeHeader, string(delivery.EventType))
+	req.Header.Set(DeliveryIDHeader, delivery.ID)
+	req.Header.Set(SignatureHeader, Sign(sub.Secret, s.now(), delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if errors.Is(err, errAddressNotAllowed) {
+		return 0, fmt.Errorf("webhook request refused: %w", err)
+	}
+	if err != nil {
+		return 0, worker.Transient(fmt.Errorf("webhook request failed: %w", err))
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	return resp.StatusCode, classifyResponse(resp.StatusCode, "event")
+}
+
+var _ worker.WebhookSender = (*SignedWebhookSender)(nil)
@@ -0,0 +1,174 @@
+package notify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/application/worker"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "whsec_0123456789abcdef"
+
+func testDelivery() *domain.WebhookDelivery {
+	return &domain.WebhookDelivery{
+		ID:        "delivery-1",
+		EventID:   "event-1",
+		EventType: domain.EventItemCreated,
+		Payload:   []byte(`{"id":"event-1","type":"item.created","data":{}}`),
+	}
+}
+
+// verifySignature checks a Mono-Signature header the way a receiver would.
+func verifySignature(t *testing.T, header, secret string, body []byte) {
+	t.Helper()
+
+	parts := strings.Split(header, ",")
+	require.Len(t, parts, 2)
+	ts, ok := strings.CutPrefix(parts[0], "t=")
+	require.True(t, ok, "missing timestamp in %q", header)
+	sig, ok := strings.CutPrefix(parts[1], "v1=")
+	require.True(t, ok, "missing v1 signature in %q", header)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts + "." + string(body)))
+	assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), sig)
+}
+
+func TestSignedWebhookSender_SignsPayload(t *testing.T) {
+	var (
+		gotHeaders http.Header
+		gotBody    []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header.Clone()
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		gotBody = body
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sender := newTestSender()
+	sub := &domain.WebhookSubscription{ID: "sub-1", URL: server.URL, Secret: testSecret}
+	delivery := testDelivery()
+
+	status, err := sender.Send(context.Background(), sub, delivery)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, status)
+
+	assert.Equal(t, delivery.Payload, gotBody)
+	assert.Equal(t, "application/json", gotHeaders.Get("Content-Type"))
+	assert.Equal(t, "event-1", gotHeaders.Get("Idempotency-Key"))
+	assert.Equal(t, "item.created", gotHeaders.Get(EventTypeHeader))
+	assert.Equal(t, "delivery-1", gotHeaders.Get(DeliveryIDHeader))
+	verifySignature(t, gotHeaders.Get(SignatureHeader), testSecret, gotBody)
+}
+
+func TestSign_IsDeterministic(t *testing.T) {
+	at := time.Unix(1700000000, 0)
+	body := []byte(`{"a":1}`)
+
+	sig := Sign(testSecret, at, body)
+	assert.Equal(t, sig, Sign(testSecret, at, body))
+	assert.True(t, strings.HasPrefix(sig, "t=1700000000,v1="))
+	assert.NotEqual(t, sig, Sign("another-secret-value", at, body))
+	verifySignature(t, sig, testSecret, body)
+}
+
+func TestSignedWebhookSender_ClassifiesFailures(t *testing.T) {
+	tests := []struct {
+		status        int
+		wantRetryable bool
+	}{
+		{http.StatusInternalServerError, true},
+		{http.StatusTooManyRequests, true},
+		{http.StatusBadRequest, false},
+		{http.StatusGone, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			sender := newTestSender()
+			sub := &domain.WebhookSubscription{URL: server.URL, Secret: testSecret}
+
+			status, err := sender.Send(context.Background(), sub, testDelivery())
+			require.Error(t, err)
+			assert.Equal(t, tt.status, status)
+			assert.Equal(t, tt.wantRetryable, worker.IsRetryable(err))
+		})
+	}
+}
+
+func TestSignedWebhookSender_UnreachableIsTransient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	sender := newTestSender()
+	status, err := sender.Send(context.Background(), &domain.WebhookSubscription{URL: url, Secret: testSecret}, testDelivery())
+	require.Error(t, err)
+	assert.Zero(t, status)
+	assert.True(t, worker.IsRetryable(err))
+}
+
+// newTestSender returns a sender that may connect to the loopback test servers.
+func newTestSender() *SignedWebhookSender {
+	sender := NewSignedWebhookSender(time.Second)
+	sender.allowed = func(netip.Addr) bool { return true }
+	return sender
+}
+
+func TestSignedWebhookSender_RefusesInternalAddresses(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	// localhost resolves at delivery time, past the URL check of the API
+	url := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+	for _, target := range []string{server.URL, url} {
+		sender := NewSignedWebhookSender(time.Second)
+		status, err := sender.Send(context.Background(), &domain.WebhookSubscription{URL: target, Secret: testSecret}, testDelivery())
+		require.ErrorIs(t, err, errAddressNotAllowed, target)
+		assert.Zero(t, status)
+		assert.False(t, worker.IsRetryable(err))
+	}
+	assert.False(t, called)
+}
+
+func TestSignedWebhookSender_DoesNotFollowRedirects(t *testing.T) {
+	redirected := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/internal" {
+			redirected = true
+			return
+		}
+		http.Redirect(w, r, "/internal", http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	sender := newTestSender()
+	status, err := sender.Send(context.Background(), &domain.WebhookSubscription{URL: server.URL + "/hooks", Secret: testSecret}, testDelivery())
+	require.Error(t, err)
+	assert.Equal(t, http.StatusTemporaryRedirect, status)
+	assert.False(t, worker.IsRetryable(err))
+	assert.False(t, redirected)
+}
@@ -0,0 +1,106 @@
+// Package notify implements reminder notifiers and webhook senders.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rezkam/mono/internal/application/worker"
+)
+
+// WebhookNotifier delivers reminders by POSTing JSON to a fixed URL.
+//
+// The Idempotency-Key header carries the reminder ID so receivers can drop
+// duplicates caused by at-least-once delivery.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a notifier posting to url with the given request timeout.
+func NewWebhookNotifier(url string, timeout time.Duration) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// webhookPayload is the JSON body sent for each reminder.
+type webhookPayload struct {
+	ReminderID string     `json:"reminder_id"`
+	FireAt     *time.Time `json:"fire_at,omitempty"`
+	ListID     string     `json:"list_id"`
+	ListTitle  string     `json:"list_title"`
+	ItemID     string     `json:"item_id"`
+	ItemTitle  string     `json:"item_title"`
+	ItemStatus string     `json:"item_status"`
+	DueAt      *time.Time `json:"due_at,omitempty"`
+	StartsAt   *time.Time `json:"starts_at,omitempty"`
+}
+
+// Notify implements worker.Notifier.
+// Network errors, 408, 429 and 5xx responses are transient; other non-2xx
+// responses are permanent.
+func (n *WebhookNotifier) Notify(ctx context.Context, notification worker.ReminderNotification) error {
+	body, err := json.Marshal(newWebhookPayload(notification))
+	if err != nil {
+		return fmt.Errorf("failed to encode reminder payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", notification.Reminder.ID)
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return worker.Transient(fmt.Errorf("webhook request failed: %w", err))
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	return classifyResponse(resp.StatusCode, "reminder")
+}
+
+// classifyResponse maps a receiver's HTTP status to a delivery outcome.
+// Network errors are handled by callers; 408, 429 and 5xx are transient and
+// any other non-2xx status is permanent.
+func classifyResponse(statusCode int, what string) error {
+	switch {
+	case statusCode >= 200 && statusCode < 300:
+		return nil
+	case statusCode == http.StatusRequestTimeout,
+		statusCode == http.StatusTooManyRequests,
+		statusCode >= 500:
+		return worker.Transient(fmt.Errorf("webhook returned %d", statusCode))
+	default:
+		return fmt.Errorf("webhook rejected %s with %d", what, statusCode)
+	}
+}
+
+func newWebhookPayload(notification worker.ReminderNotification) webhookPayload {
+	item := notification.Item
+	payload := webhookPayload{
+		ReminderID: notification.Reminder.ID,
+		FireAt:     notification.Reminder.FireAt,
+		ListID:     item.ListID,
+		ItemID:     item.ID,
+		ItemTitle:  item.Title,
+		ItemStatus: string(item.Status),
+		DueAt:      item.DueAt,
+		StartsAt:   item.StartsAt,
+	}
+	if notification.List != nil {
+		payload.ListTitle = notification.List.Title
+	}
+	return payload
+}
+
+var _ worker.Notifier = (*WebhookNotifier)(nil)
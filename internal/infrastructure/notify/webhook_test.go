@@ -0,0 +1,84 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/application/worker"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testNotification() worker.ReminderNotification {
+	return worker.ReminderNotification{
+		Reminder: &domain.Reminder{ID: "reminder-1", ItemID: "item-1"},
+		Item:     &domain.TodoItem{ID: "item-1", ListID: "list-1", Title: "Pay rent", Status: domain.TaskStatusTodo},
+		List:     &domain.TodoList{ID: "list-1", Title: "Home"},
+	}
+}
+
+func TestWebhookNotifier_PostsPayload(t *testing.T) {
+	var (
+		gotKey     string
+		gotPayload webhookPayload
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKey = r.Header.Get("Idempotency-Key")
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotPayload))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(server.URL, time.Second)
+	require.NoError(t, notifier.Notify(context.Background(), testNotification()))
+
+	assert.Equal(t, "reminder-1", gotKey)
+	assert.Equal(t, "reminder-1", gotPayload.ReminderID)
+	assert.Equal(t, "Pay rent", gotPayload.ItemTitle)
+	assert.Equal(t, "Home", gotPayload.ListTitle)
+}
+
+func TestWebhookNotifier_ClassifiesFailures(t *testing.T) {
+	tests := []struct {
+		status        int
+		wantRetryable bool
+	}{
+		{http.StatusInternalServerError, true},
+		{http.StatusServiceUnavailable, true},
+		{http.StatusTooManyRequests, true},
+		{http.StatusRequestTimeout, true},
+		{http.StatusBadRequest, false},
+		{http.StatusGone, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			err := NewWebhookNotifier(server.URL, time.Second).Notify(context.Background(), testNotification())
+
+			require.Error(t, err)
+			assert.Equal(t, tt.wantRetryable, worker.IsRetryable(err))
+		})
+	}
+}
+
+func TestWebhookNotifier_NetworkErrorIsTransient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	err := NewWebhookNotifier(url, time.Second).Notify(context.Background(), testNotification())
+
+	require.Error(t, err)
+	assert.True(t, worker.IsRetryable(err))
+}
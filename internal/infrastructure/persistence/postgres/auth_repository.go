@@ -70,6 +70,9 @@ func (s *Store) Create(ctx context.Context, key *domain.APIKey) error {
 	if _, err := uuid.Parse(key.ID); err != nil {
 		return fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
 	}
+	if _, err := uuid.Parse(key.OwnerID); err != nil {
+		return fmt.Errorf("%w: owner %w", domain.ErrInvalidID, err)
+	}
 
 	params := sqlcgen.CreateAPIKeyParams{
 		ID:             key.ID,
@@ -81,6 +84,8 @@ func (s *Store) Create(ctx context.Context, key *domain.APIKey) error {
 		Name:           key.Name,
 		IsActive:       key.IsActive,
 		CreatedAt:      key.CreatedAt,
+		OwnerID:        key.OwnerID,
+		Scopes:         scopesToStrings(key.Scopes),
 		ExpiresAt:      ptrToNullTime(key.ExpiresAt), // Domain *time.Time → DB sql.Null[time.Time]
 	}
 
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rezkam/mono/internal/application/todo"
+)
+
+const (
+	// changeListenerBuffer is the notification buffer per subscriber.
+	// Notifications to a full subscriber are dropped; streams poll to recover.
+	changeListenerBuffer = 64
+
+	// changeListenerRetryDelay is the wait before reconnecting a lost LISTEN connection.
+	changeListenerRetryDelay = time.Second
+)
+
+// ChangeListener delivers change log notifications to event streams.
+// All subscribers share one LISTEN connection, held only while someone is subscribed,
+// so open streams do not each take a connection from the pool.
+type ChangeListener struct {
+	pool *pgxpool.Pool
+
+	mu          sync.Mutex
+	subscribers map[chan int64]struct{}
+	stop        context.CancelFunc // Stops the LISTEN loop; nil when not running
+}
+
+// NewChangeListener creates a change listener using connections from pool.
+func NewChangeListener(pool *pgxpool.Pool) *ChangeListener {
+	return &ChangeListener{
+		pool:        pool,
+		subscribers: make(map[chan int64]struct{}),
+	}
+}
+
+var _ todo.ChangeNotifier = (*ChangeListener)(nil)
+
+// SubscribeToChanges returns a channel receiving the seq of each new change log row.
+// The channel is closed when ctx is cancelled.
+func (l *ChangeListener) SubscribeToChanges(ctx context.Context) (<-chan int64, error) {
+	ch := make(chan int64, changeListenerBuffer)
+
+	l.mu.Lock()
+	l.subscribers[ch] = struct{}{}
+	if l.stop == nil {
+		listenCtx, cancel := context.WithCancel(context.Background())
+		l.stop = cancel
+		go l.listen(listenCtx)
+	}
+	l.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.subscribers, ch)
+		close(ch)
+		if len(l.subscribers) == 0 && l.stop != nil {
+			l.stop()
+			l.stop = nil
+		}
+	}()
+
+	return ch, nil
+}
+
+// listen holds the LISTEN connection until ctx is cancelled, reconnecting on failure.
+func (l *ChangeListener) listen(ctx context.Context) {
+	for {
+		err := l.listenOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		slog.WarnContext(ctx, "change listener disconnected, reconnecting", "error", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(changeListenerRetryDelay):
+		}
+	}
+}
+
+// listenOnce listens on one connection and fans notifications out to subscribers.
+func (l *ChangeListener) listenOnce(ctx context.Context) error {
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN change_log"); err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = conn.Exec(context.Background(), "UNLISTEN change_log")
+	}()
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		seq, err := strconv.ParseInt(notification.Payload, 10, 64)
+		if err != nil {
+			continue
+		}
+		l.broadcast(seq)
+	}
+}
+
+// broadcast sends seq to every subscriber without blocking on slow ones.
+func (l *ChangeListener) broadcast(seq int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for ch := range l.subscribers {
+		select {
+		case ch <- seq:
+		default:
+		}
+	}
+}
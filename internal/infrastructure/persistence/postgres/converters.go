@@ -122,6 +122,8 @@ func dbAPIKeyToDomain(dbKey sqlcgen.ApiKey) *domain.APIKey {
 		ShortToken:     dbKey.ShortToken,
 		LongSecretHash: dbKey.LongSecretHash,
 		Name:           dbKey.Name,
+		OwnerID:        dbKey.OwnerID,
+		Scopes:         stringsToScopes(dbKey.Scopes),
 		IsActive:       dbKey.IsActive,
 		CreatedAt:      dbKey.CreatedAt.UTC(),
 		LastUsedAt:     nullTimeToPtr(dbKey.LastUsedAt), // DB sql.Null[time.Time] → Domain *time.Time
@@ -129,6 +131,22 @@ func dbAPIKeyToDomain(dbKey sqlcgen.ApiKey) *domain.APIKey {
 	}
 }
 
+func scopesToStrings(scopes []domain.Scope) []string {
+	result := make([]string, len(scopes))
+	for i, scope := range scopes {
+		result[i] = string(scope)
+	}
+	return result
+}
+
+func stringsToScopes(values []string) []domain.Scope {
+	result := make([]domain.Scope, len(values))
+	for i, v := range values {
+		result[i] = domain.Scope(v)
+	}
+	return result
+}
+
 // === Todo List Conversions ===
 
 func domainTodoListToDB(list *domain.TodoList) (string, string, time.Time, error) {
@@ -139,6 +157,132 @@ func domainTodoListToDB(list *domain.TodoList) (string, string, time.Time, error
 	return list.ID, list.Title, list.CreatedAt, nil
 }
 
+// customFieldDefinitionRecord is the JSONB representation of a custom field definition
+// stored in todo_lists.custom_field_schema.
+type customFieldDefinitionRecord struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Options []string `json:"options,omitempty"`
+}
+
+// customFieldSchemaToJSON converts a domain schema to JSONB.
+// A nil schema is stored as an empty array because the column is NOT NULL.
+func customFieldSchemaToJSON(schema domain.CustomFieldSchema) ([]byte, error) {
+	records := make([]customFieldDefinitionRecord, 0, len(schema))
+	for _, def := range schema {
+		records = append(records, customFieldDefinitionRecord{
+			Name:    def.Name,
+			Type:    string(def.Type),
+			Options: def.Options,
+		})
+	}
+	data, err := json.Marshal(records)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal custom field schema: %w", err)
+	}
+	return data, nil
+}
+
+// customFieldSchemaFromJSON converts JSONB to a domain schema.
+// Returns nil for an empty array.
+func customFieldSchemaFromJSON(data []byte) (domain.CustomFieldSchema, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var records []customFieldDefinitionRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal custom field schema: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	schema := make(domain.CustomFieldSchema, 0, len(records))
+	for _, r := range records {
+		schema = append(schema, domain.CustomFieldDefinition{
+			Name:    r.Name,
+			Type:    domain.CustomFieldType(r.Type),
+			Options: r.Options,
+		})
+	}
+	return schema, nil
+}
+
+// customFieldsToJSON converts custom field values to JSONB.
+// Nil values are stored as an empty object because the column is NOT NULL.
+func customFieldsToJSON(values map[string]any) ([]byte, error) {
+	if values == nil {
+		values = map[string]any{}
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal custom fields: %w", err)
+	}
+	return data, nil
+}
+
+// customFieldsFromJSON converts JSONB to custom field values.
+// Returns nil for an empty object.
+func customFieldsFromJSON(data []byte) (map[string]any, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var values map[string]any
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal custom fields: %w", err)
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return values, nil
+}
+
+// defaultReminderRecord is the JSONB representation of a template default reminder.
+// The create_default_item_reminders trigger reads the same keys.
+type defaultReminderRecord struct {
+	Anchor              string  `json:"anchor"`
+	OffsetBeforeSeconds float64 `json:"offset_before_seconds"`
+}
+
+// defaultRemindersToJSON converts template default reminders to JSONB.
+// Nil rules are stored as an empty array because the column is NOT NULL.
+func defaultRemindersToJSON(rules []domain.ReminderRule) ([]byte, error) {
+	records := make([]defaultReminderRecord, 0, len(rules))
+	for _, rule := range rules {
+		records = append(records, defaultReminderRecord{
+			Anchor:              string(rule.Anchor),
+			OffsetBeforeSeconds: rule.Before.Seconds(),
+		})
+	}
+	data, err := json.Marshal(records)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal default reminders: %w", err)
+	}
+	return data, nil
+}
+
+// defaultRemindersFromJSON converts JSONB to template default reminders.
+// Returns nil for an empty array.
+func defaultRemindersFromJSON(data []byte) ([]domain.ReminderRule, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var records []defaultReminderRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal default reminders: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	rules := make([]domain.ReminderRule, 0, len(records))
+	for _, r := range records {
+		rules = append(rules, domain.ReminderRule{
+			Anchor: domain.ReminderAnchor(r.Anchor),
+			Before: time.Duration(r.OffsetBeforeSeconds * float64(time.Second)),
+		})
+	}
+	return rules, nil
+}
+
 // taskStatusesToStrings converts domain TaskStatus slice to string slice for SQL queries.
 func taskStatusesToStrings(statuses []domain.TaskStatus) []string {
 	result := make([]string, len(statuses))
@@ -159,7 +303,7 @@ func taskPrioritiesToStrings(priorities []domain.TaskPriority) []string {
 
 // === Todo Item Conversions ===
 
-// todoItemFields holds the common fields present in both sqlcgen.TodoItem and sqlcgen.ListTasksWithFiltersRow
+// todoItemFields holds the fields of a todo item row
 type todoItemFields struct {
 	ID                  string
 	ListID              string
@@ -178,6 +322,8 @@ type todoItemFields struct {
 	OccursAt            pgtype.Timestamptz
 	DueOffset           pgtype.Interval
 	Version             int32
+	CustomFields        []byte
+	SnoozedUntil        pgtype.Timestamptz
 }
 
 // convertTodoItemFields converts common todo item fields from database to domain model.
@@ -230,6 +376,16 @@ func convertTodoItemFields(fields todoItemFields) (domain.TodoItem, error) {
 	// DueOffset: DB pgtype.Interval → Domain *time.Duration
 	item.DueOffset = pgtypeIntervalToDurationPtr(fields.DueOffset)
 
+	// SnoozedUntil: DB pgtype.Timestamptz → Domain *time.Time
+	item.SnoozedUntil = pgtypeTimestamptzToTimePtr(fields.SnoozedUntil)
+
+	// CustomFields: DB JSONB → Domain map
+	customFields, err := customFieldsFromJSON(fields.CustomFields)
+	if err != nil {
+		return domain.TodoItem{}, err
+	}
+	item.CustomFields = customFields
+
 	return item, nil
 }
 
@@ -252,30 +408,8 @@ func dbTodoItemToDomain(dbItem sqlcgen.TodoItem) (domain.TodoItem, error) {
 		OccursAt:            dbItem.OccursAt,
 		DueOffset:           dbItem.DueOffset,
 		Version:             dbItem.Version,
-	})
-}
-
-// dbListTasksRowToDomain converts a ListTasksWithFiltersRow to a domain TodoItem.
-// This is needed because the query includes COUNT(*) OVER() which creates a different struct.
-func dbListTasksRowToDomain(dbItem sqlcgen.ListTasksWithFiltersRow) (domain.TodoItem, error) {
-	return convertTodoItemFields(todoItemFields{
-		ID:                  dbItem.ID,
-		ListID:              dbItem.ListID,
-		Title:               dbItem.Title,
-		Status:              dbItem.Status,
-		Priority:            dbItem.Priority,
-		CreatedAt:           dbItem.CreatedAt,
-		UpdatedAt:           dbItem.UpdatedAt,
-		DueAt:               dbItem.DueAt,
-		Timezone:            dbItem.Timezone,
-		EstimatedDuration:   dbItem.EstimatedDuration,
-		ActualDuration:      dbItem.ActualDuration,
-		Tags:                dbItem.Tags,
-		RecurringTemplateID: dbItem.RecurringTemplateID,
-		StartsAt:            dbItem.StartsAt,
-		OccursAt:            dbItem.OccursAt,
-		DueOffset:           dbItem.DueOffset,
-		Version:             dbItem.Version,
+		CustomFields:        dbItem.CustomFields,
+		SnoozedUntil:        dbItem.SnoozedUntil,
 	})
 }
 
@@ -340,6 +474,13 @@ func domainTodoItemToDB(item *domain.TodoItem, listID string) (sqlcgen.CreateTod
 	// DueOffset: Domain *time.Duration → DB pgtype.Interval
 	params.DueOffset = durationPtrToPgtypeInterval(item.DueOffset)
 
+	// CustomFields: Domain map → DB JSONB
+	customFields, err := customFieldsToJSON(item.CustomFields)
+	if err != nil {
+		return params, err
+	}
+	params.CustomFields = customFields
+
 	return params, nil
 }
 
@@ -387,6 +528,20 @@ func dbRecurringTemplateToDomain(dbTemplate sqlcgen.RecurringTaskTemplate) (*dom
 		template.RecurrenceConfig = config
 	}
 
+	// Custom Fields
+	customFields, err := customFieldsFromJSON(dbTemplate.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+	template.CustomFields = customFields
+
+	// Default Reminders
+	defaultReminders, err := defaultRemindersFromJSON(dbTemplate.DefaultReminders)
+	if err != nil {
+		return nil, err
+	}
+	template.DefaultReminders = defaultReminders
+
 	// Due Offset
 	if dbTemplate.DueOffset.Valid {
 		duration := intervalToDuration(dbTemplate.DueOffset)
@@ -443,6 +598,20 @@ func domainRecurringTemplateToDB(template *domain.RecurringTemplate) (sqlcgen.Cr
 		params.RecurrenceConfig = configJSON
 	}
 
+	// Custom Fields
+	customFields, err := customFieldsToJSON(template.CustomFields)
+	if err != nil {
+		return params, err
+	}
+	params.CustomFields = customFields
+
+	// Default Reminders
+	defaultReminders, err := defaultRemindersToJSON(template.DefaultReminders)
+	if err != nil {
+		return params, err
+	}
+	params.DefaultReminders = defaultReminders
+
 	// Due Offset
 	if template.DueOffset != nil {
 		params.DueOffset = durationToInterval(*template.DueOffset)
@@ -568,6 +737,12 @@ func domainTodoItemsToBatchParams(items []domain.TodoItem, listID string) ([]sql
 		}
 		p.RecurringTemplateID = recurringTemplateID
 
+		customFields, err := customFieldsToJSON(item.CustomFields)
+		if err != nil {
+			return nil, fmt.Errorf("invalid custom fields for item %s: %w", item.ID, err)
+		}
+		p.CustomFields = customFields
+
 		params = append(params, p)
 	}
 
@@ -660,6 +835,87 @@ func dbExceptionToDomain(dbExc sqlcgen.RecurringTemplateException) (*domain.Recu
 	}, nil
 }
 
+// === List Member Converters ===
+
+// dbListMemberToDomain converts database list member to domain model.
+func dbListMemberToDomain(dbMember sqlcgen.ListMember) *domain.ListMember {
+	return &domain.ListMember{
+		ListID:      dbMember.ListID,
+		PrincipalID: dbMember.PrincipalID,
+		Role:        domain.ListRole(dbMember.Role),
+		CreatedAt:   dbMember.CreatedAt.UTC(),
+		UpdatedAt:   dbMember.UpdatedAt.UTC(),
+	}
+}
+
+// === Tag Converters ===
+
+// dbTagToDomain converts database registered tag to domain model.
+func dbTagToDomain(dbTag sqlcgen.ListTag) *domain.Tag {
+	return &domain.Tag{
+		ListID:      dbTag.ListID,
+		Name:        dbTag.Name,
+		Color:       nullStringToPtr(dbTag.Color),
+		Description: nullStringToPtr(dbTag.Description),
+		CreatedAt:   dbTag.CreatedAt.UTC(),
+		UpdatedAt:   dbTag.UpdatedAt.UTC(),
+	}
+}
+
+// === Reminder Converters ===
+
+// dbReminderToDomain converts database reminder to domain model.
+func dbReminderToDomain(dbReminder sqlcgen.ItemReminder) *domain.Reminder {
+	reminder := &domain.Reminder{
+		ID:          dbReminder.ID,
+		ItemID:      dbReminder.ItemID,
+		FireAt:      utcTimePtr(nullTimeToPtr(dbReminder.FireAt)),
+		Status:      domain.ReminderStatus(dbReminder.Status),
+		RetryCount:  int(dbReminder.RetryCount),
+		LastError:   nullStringToPtr(dbReminder.LastError),
+		SentAt:      utcTimePtr(nullTimeToPtr(dbReminder.SentAt)),
+		ClaimedBy:   nullStringToPtr(dbReminder.ClaimedBy),
+		AvailableAt: utcTimePtr(nullTimeToPtr(dbReminder.AvailableAt)),
+		CreatedAt:   dbReminder.CreatedAt.UTC(),
+		UpdatedAt:   dbReminder.UpdatedAt.UTC(),
+	}
+
+	if dbReminder.RemindAt.Valid {
+		reminder.Rule = domain.NewAbsoluteReminderRule(dbReminder.RemindAt.V)
+	} else {
+		reminder.Rule = domain.ReminderRule{
+			Anchor: domain.ReminderAnchor(dbReminder.Anchor.V),
+			Before: intervalToDuration(dbReminder.OffsetBefore),
+		}
+	}
+
+	return reminder
+}
+
+// dbDeadLetterReminderToDomain converts a database dead letter reminder to domain model.
+func dbDeadLetterReminderToDomain(dbDeadLetter sqlcgen.DeadLetterReminder, listID string) *domain.DeadLetterReminder {
+	return &domain.DeadLetterReminder{
+		ID:           dbDeadLetter.ID,
+		ReminderID:   dbDeadLetter.ReminderID,
+		ItemID:       dbDeadLetter.ItemID,
+		ListID:       listID,
+		ErrorType:    dbDeadLetter.ErrorType,
+		ErrorMessage: dbDeadLetter.ErrorMessage.V,
+		RetryCount:   int(dbDeadLetter.RetryCount),
+		LastWorkerID: dbDeadLetter.LastWorkerID.V,
+		FailedAt:     dbDeadLetter.FailedAt.UTC(),
+	}
+}
+
+// utcTimePtr normalizes an optional timestamp to UTC.
+func utcTimePtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
 // stringPtrToText converts *string to pgtype.Text for nullable UUID fields.
 // uuidToStringPtr converts pgtype.UUID to *string for nullable UUID fields.
 func uuidToStringPtr(u pgtype.UUID) *string {
@@ -669,3 +925,343 @@ func uuidToStringPtr(u pgtype.UUID) *string {
 	s := u.String()
 	return &s
 }
+
+// dbWebhookSubscriptionToDomain converts a sqlcgen.WebhookSubscription to a domain.WebhookSubscription.
+func dbWebhookSubscriptionToDomain(dbSub sqlcgen.WebhookSubscription) *domain.WebhookSubscription {
+	events := make([]domain.EventType, len(dbSub.Events))
+	for i, e := range dbSub.Events {
+		events[i] = domain.EventType(e)
+	}
+
+	return &domain.WebhookSubscription{
+		ID:        dbSub.ID,
+		OwnerID:   ptr.ToString(nullUUIDToStringPtr(dbSub.OwnerID)),
+		URL:       dbSub.Url,
+		Secret:    dbSub.Secret,
+		Events:    events,
+		IsActive:  dbSub.IsActive,
+		CreatedAt: dbSub.CreatedAt.UTC(),
+		UpdatedAt: dbSub.UpdatedAt.UTC(),
+	}
+}
+
+// eventTypesToStrings converts event types for the text[] column.
+func eventTypesToStrings(events []domain.EventType) []string {
+	result := make([]string, len(events))
+	for i, e := range events {
+		result[i] = string(e)
+	}
+	return result
+}
+
+// dbWebhookDeliveryToDomain converts a sqlcgen.WebhookDelivery to a domain.WebhookDelivery.
+func dbWebhookDeliveryToDomain(dbDelivery sqlcgen.WebhookDelivery) *domain.WebhookDelivery {
+	delivery := &domain.WebhookDelivery{
+		ID:             dbDelivery.ID,
+		SubscriptionID: dbDelivery.SubscriptionID,
+		EventID:        dbDelivery.EventID,
+		EventType:      domain.EventType(dbDelivery.EventType),
+		Payload:        dbDelivery.Payload,
+		Status:         domain.WebhookDeliveryStatus(dbDelivery.Status),
+		Attempts:       int(dbDelivery.Attempts),
+		LastError:      nullStringToPtr(dbDelivery.LastError),
+		ClaimedBy:      nullStringToPtr(dbDelivery.ClaimedBy),
+		AvailableAt:    utcTimePtr(nullTimeToPtr(dbDelivery.AvailableAt)),
+		DeliveredAt:    utcTimePtr(nullTimeToPtr(dbDelivery.DeliveredAt)),
+		CreatedAt:      dbDelivery.CreatedAt.UTC(),
+		UpdatedAt:      dbDelivery.UpdatedAt.UTC(),
+	}
+
+	if dbDelivery.ResponseStatus.Valid {
+		status := int(dbDelivery.ResponseStatus.Int32)
+		delivery.ResponseStatus = &status
+	}
+	if dbDelivery.RedeliveryOf.Valid {
+		redeliveryOf := uuid.UUID(dbDelivery.RedeliveryOf.Bytes).String()
+		delivery.RedeliveryOf = &redeliveryOf
+	}
+
+	return delivery
+}
+
+// intPtrToInt4 converts an optional HTTP status to pgtype.Int4.
+func intPtrToInt4(i *int) pgtype.Int4 {
+	if i == nil {
+		return pgtype.Int4{Valid: false}
+	}
+	return pgtype.Int4{Int32: int32(*i), Valid: true}
+}
+
+// dbChangeToDomain converts a sqlcgen.ChangeLog row to a domain.Change.
+// Snapshot and diff are decoded from JSONB; numbers decode as float64.
+func dbChangeToDomain(row sqlcgen.ChangeLog) (*domain.Change, error) {
+	change := &domain.Change{
+		Seq:         row.Seq,
+		TxID:        row.Txid,
+		EntityType:  domain.ChangeEntityType(row.EntityType),
+		EntityID:    row.EntityID,
+		Operation:   domain.ChangeOperation(row.Operation),
+		Version:     int(row.Version),
+		OccurredAt:  row.OccurredAt.UTC(),
+		PublishedAt: utcTimePtr(nullTimeToPtr(row.PublishedAt)),
+	}
+	if row.ListID.Valid {
+		change.ListID = row.ListID.String()
+	}
+
+	if err := json.Unmarshal(row.Snapshot, &change.Snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot of change %d: %w", row.Seq, err)
+	}
+	if len(row.Diff) > 0 {
+		if err := json.Unmarshal(row.Diff, &change.Diff); err != nil {
+			return nil, fmt.Errorf("failed to decode diff of change %d: %w", row.Seq, err)
+		}
+	}
+
+	return change, nil
+}
+
+// === Saved View Conversions ===
+
+// savedViewRecord is the JSONB form of a saved view's definition.
+type savedViewRecord struct {
+	Statuses       []string          `json:"statuses,omitempty"`
+	Priorities     []string          `json:"priorities,omitempty"`
+	Tags           []string          `json:"tags,omitempty"`
+	CustomFields   map[string]string `json:"custom_fields,omitempty"`
+	Expression     *string           `json:"expression,omitempty"`
+	OrderBy        *string           `json:"order_by,omitempty"`
+	OrderDir       *string           `json:"order_dir,omitempty"`
+	DueAfter       *dueBoundRecord   `json:"due_after,omitempty"`
+	DueBefore      *dueBoundRecord   `json:"due_before,omitempty"`
+	IncludeSnoozed bool              `json:"include_snoozed,omitempty"`
+}
+
+// dueBoundRecord stores either a fixed time or a day offset from the run time.
+type dueBoundRecord struct {
+	At         *time.Time `json:"at,omitempty"`
+	OffsetDays *int       `json:"offset_days,omitempty"`
+}
+
+// dueBoundToRecord converts a domain due bound for storage, normalizing fixed times to UTC.
+func dueBoundToRecord(bound *domain.DueBound) *dueBoundRecord {
+	if bound == nil {
+		return nil
+	}
+	return &dueBoundRecord{At: utcTimePtr(bound.At), OffsetDays: bound.OffsetDays}
+}
+
+// dueBoundFromRecord converts a stored due bound to the domain type.
+func dueBoundFromRecord(record *dueBoundRecord) *domain.DueBound {
+	if record == nil {
+		return nil
+	}
+	return &domain.DueBound{At: record.At, OffsetDays: record.OffsetDays}
+}
+
+// savedViewDefinitionToJSON converts the filters and due window of a view to JSONB.
+func savedViewDefinitionToJSON(view *domain.SavedView) ([]byte, error) {
+	data, err := json.Marshal(savedViewRecord{
+		Statuses:       view.Filter.Statuses,
+		Priorities:     view.Filter.Priorities,
+		Tags:           view.Filter.Tags,
+		CustomFields:   view.Filter.CustomFields,
+		Expression:     view.Filter.Expression,
+		OrderBy:        view.Filter.OrderBy,
+		OrderDir:       view.Filter.OrderDir,
+		DueAfter:       dueBoundToRecord(view.DueAfter),
+		DueBefore:      dueBoundToRecord(view.DueBefore),
+		IncludeSnoozed: view.IncludeSnoozed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal saved view definition: %w", err)
+	}
+	return data, nil
+}
+
+// dbSavedViewToDomain converts a sqlcgen.SavedView to a domain.SavedView.
+func dbSavedViewToDomain(dbView sqlcgen.SavedView) (*domain.SavedView, error) {
+	var record savedViewRecord
+	if err := json.Unmarshal(dbView.Definition, &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal saved view definition: %w", err)
+	}
+
+	return &domain.SavedView{
+		ID:      dbView.ID,
+		OwnerID: ptr.ToString(nullUUIDToStringPtr(dbView.OwnerID)),
+		Name:    dbView.Name,
+		ListID:  nullUUIDToStringPtr(dbView.ListID),
+		Filter: domain.ItemsFilterInput{
+			Statuses:     record.Statuses,
+			Priorities:   record.Priorities,
+			Tags:         record.Tags,
+			CustomFields: record.CustomFields,
+			Expression:   record.Expression,
+			OrderBy:      record.OrderBy,
+			OrderDir:     record.OrderDir,
+		},
+		DueAfter:       dueBoundFromRecord(record.DueAfter),
+		DueBefore:      dueBoundFromRecord(record.DueBefore),
+		IncludeSnoozed: record.IncludeSnoozed,
+		CreatedAt:      dbView.CreatedAt.UTC(),
+		UpdatedAt:      dbView.UpdatedAt.UTC(),
+	}, nil
+}
+
+func dbTimeEntryToDomain(dbEntry sqlcgen.TimeEntry) *domain.TimeEntry {
+	return &domain.TimeEntry{
+		ID:          dbEntry.ID,
+		ItemID:      dbEntry.ItemID,
+		ListID:      dbEntry.ListID,
+		PrincipalID: dbEntry.PrincipalID,
+		StartedAt:   dbEntry.StartedAt.UTC(),
+		EndedAt:     nullTimeToPtr(dbEntry.EndedAt),
+		Notes:       dbEntry.Notes,
+		CreatedAt:   dbEntry.CreatedAt.UTC(),
+		UpdatedAt:   dbEntry.UpdatedAt.UTC(),
+	}
+}
+
+// === Automation Rule Conversions ===
+
+// ruleConditionRecord is the JSONB form of the condition of a rule.
+type ruleConditionRecord struct {
+	Statuses     []string          `json:"statuses,omitempty"`
+	Priorities   []string          `json:"priorities,omitempty"`
+	Tags         []string          `json:"tags,omitempty"`
+	CustomFields map[string]string `json:"custom_fields,omitempty"`
+	Expression   *string           `json:"expression,omitempty"`
+}
+
+// ruleActionRecord is the JSONB form of an action of a rule.
+type ruleActionRecord struct {
+	Type      string   `json:"type"`
+	Field     string   `json:"field,omitempty"`
+	Value     any      `json:"value,omitempty"`
+	Tag       string   `json:"tag,omitempty"`
+	ListID    string   `json:"list_id,omitempty"`
+	Title     string   `json:"title,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+	Priority  *string  `json:"priority,omitempty"`
+	WebhookID string   `json:"webhook_id,omitempty"`
+}
+
+// automationRuleToDB converts the condition and actions of a rule to JSONB.
+func automationRuleToDB(rule *domain.AutomationRule) (condition, actions []byte, err error) {
+	condition, err = json.Marshal(ruleConditionRecord{
+		Statuses:     rule.Condition.Statuses,
+		Priorities:   rule.Condition.Priorities,
+		Tags:         rule.Condition.Tags,
+		CustomFields: rule.Condition.CustomFields,
+		Expression:   rule.Condition.Expression,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal rule condition: %w", err)
+	}
+
+	records := make([]ruleActionRecord, 0, len(rule.Actions))
+	for _, action := range rule.Actions {
+		records = append(records, ruleActionRecord{
+			Type:      string(action.Type),
+			Field:     action.Field,
+			Value:     action.Value,
+			Tag:       action.Tag,
+			ListID:    action.ListID,
+			Title:     action.Title,
+			Tags:      action.Tags,
+			Priority:  (*string)(action.Priority),
+			WebhookID: action.WebhookID,
+		})
+	}
+	actions, err = json.Marshal(records)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal rule actions: %w", err)
+	}
+	return condition, actions, nil
+}
+
+// ruleOwnerToDB returns the owner_id column of a rule, NULL for rules set up by internal callers.
+func ruleOwnerToDB(rule *domain.AutomationRule) (uuid.NullUUID, error) {
+	if rule.OwnerID == "" {
+		return uuid.NullUUID{}, nil
+	}
+	ownerID, err := stringPtrToNullUUID(&rule.OwnerID)
+	if err != nil {
+		return uuid.NullUUID{}, fmt.Errorf("failed to convert automation rule owner: %w", err)
+	}
+	return ownerID, nil
+}
+
+// dbAutomationRuleToDomain converts a sqlcgen.AutomationRule to a domain.AutomationRule.
+func dbAutomationRuleToDomain(dbRule sqlcgen.AutomationRule) (*domain.AutomationRule, error) {
+	var condition ruleConditionRecord
+	if err := json.Unmarshal(dbRule.Condition, &condition); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal rule condition: %w", err)
+	}
+	var records []ruleActionRecord
+	if err := json.Unmarshal(dbRule.Actions, &records); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal rule actions: %w", err)
+	}
+
+	actions := make([]domain.RuleAction, 0, len(records))
+	for _, record := range records {
+		actions = append(actions, domain.RuleAction{
+			Type:      domain.RuleActionType(record.Type),
+			Field:     record.Field,
+			Value:     record.Value,
+			Tag:       record.Tag,
+			ListID:    record.ListID,
+			Title:     record.Title,
+			Tags:      record.Tags,
+			Priority:  (*domain.TaskPriority)(record.Priority),
+			WebhookID: record.WebhookID,
+		})
+	}
+
+	return &domain.AutomationRule{
+		ID:      dbRule.ID,
+		ListID:  dbRule.ListID,
+		OwnerID: ptr.ToString(nullUUIDToStringPtr(dbRule.OwnerID)),
+		Name:    dbRule.Name,
+		Enabled: dbRule.Enabled,
+		Trigger: domain.RuleTrigger{
+			Type:      domain.RuleTriggerType(dbRule.TriggerType),
+			ToStatus:  (*domain.TaskStatus)(nullStringToPtr(dbRule.TriggerStatus)),
+			Tag:       nullStringToPtr(dbRule.TriggerTag),
+			OverdueBy: intervalToDuration(dbRule.OverdueBy),
+		},
+		Condition: domain.ItemsFilterInput{
+			Statuses:     condition.Statuses,
+			Priorities:   condition.Priorities,
+			Tags:         condition.Tags,
+			CustomFields: condition.CustomFields,
+			Expression:   condition.Expression,
+		},
+		Actions:   actions,
+		CreatedAt: dbRule.CreatedAt.UTC(),
+		UpdatedAt: dbRule.UpdatedAt.UTC(),
+	}, nil
+}
+
+// ruleOverdueByToDB returns the overdue_by column of a rule, NULL unless it is an overdue rule.
+func ruleOverdueByToDB(trigger domain.RuleTrigger) pgtype.Interval {
+	if trigger.Type != domain.RuleTriggerOverdue {
+		return pgtype.Interval{Valid: false}
+	}
+	return durationToInterval(trigger.OverdueBy)
+}
+
+func dbRuleRunToDomain(dbRun sqlcgen.AutomationRuleRun) *domain.RuleRun {
+	return &domain.RuleRun{
+		ID:        dbRun.ID,
+		RuleID:    dbRun.RuleID,
+		ListID:    dbRun.ListID,
+		ItemID:    dbRun.ItemID,
+		Trigger:   domain.RuleTriggerType(dbRun.TriggerType),
+		Depth:     int(dbRun.Depth),
+		Status:    domain.RuleRunStatus(dbRun.Status),
+		Actions:   dbRun.Actions,
+		Error:     nullStringToPtr(dbRun.Error),
+		CreatedAt: dbRun.CreatedAt.UTC(),
+	}
+}
@@ -585,7 +585,15 @@ func (c *PostgresCoordinator) MoveToDeadLetter(ctx context.Context, job *domain.
 }
 
 func (c *PostgresCoordinator) ListDeadLetterJobs(ctx context.Context, limit int) ([]*domain.DeadLetterJob, error) {
-	rows, err := c.queries.ListPendingDeadLetterJobs(ctx, int32(limit))
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.queries.ListPendingDeadLetterJobs(ctx, sqlcgen.ListPendingDeadLetterJobsParams{
+		OwnerID:   ownerID,
+		PageLimit: int32(limit),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list dead letter jobs: %w", err)
 	}
@@ -626,10 +634,16 @@ func (c *PostgresCoordinator) DiscardDeadLetterJob(ctx context.Context, deadLett
 		return fmt.Errorf("invalid dead letter ID: %w", err)
 	}
 
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return err
+	}
+
 	params := sqlcgen.MarkDeadLetterAsDiscardedParams{
-		ID:           pgtype.UUID{Bytes: dlID, Valid: true},
 		ReviewedBy:   sql.Null[string]{Valid: false},
 		ReviewerNote: sql.Null[string]{V: note, Valid: note != ""},
+		ID:           pgtype.UUID{Bytes: dlID, Valid: true},
+		OwnerID:      ownerID,
 	}
 
 	rows, err := c.queries.MarkDeadLetterAsDiscarded(ctx, params)
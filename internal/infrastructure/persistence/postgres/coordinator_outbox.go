@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rezkam/mono/internal/application/worker"
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// PostgresCoordinator also implements worker.OutboxCoordinator.
+var _ worker.OutboxCoordinator = (*PostgresCoordinator)(nil)
+
+// === Change Log ===
+// Rows are written by the record_change() trigger in the same transaction as
+// every insert, update and delete of lists, items, templates and dead letter jobs.
+
+func (c *PostgresCoordinator) ListUnpublishedChanges(ctx context.Context, limit int) ([]*domain.Change, error) {
+	rows, err := c.queries.ListUnpublishedChanges(ctx, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unpublished changes: %w", err)
+	}
+
+	changes := make([]*domain.Change, 0, len(rows))
+	for _, row := range rows {
+		change, err := dbChangeToDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func (c *PostgresCoordinator) MarkChangesPublished(ctx context.Context, seqs []int64) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	if _, err := c.queries.MarkChangesPublished(ctx, seqs); err != nil {
+		return fmt.Errorf("failed to mark changes published: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rezkam/mono/internal/application/worker"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// PostgresCoordinator also implements worker.ReminderCoordinator.
+var _ worker.ReminderCoordinator = (*PostgresCoordinator)(nil)
+
+// === Reminder Delivery ===
+
+func (c *PostgresCoordinator) ClaimNextReminder(ctx context.Context, workerID string, availabilityTimeout time.Duration) (*domain.Reminder, error) {
+	availableAt := time.Now().UTC().Add(availabilityTimeout)
+	row, err := c.queries.ClaimNextReminder(ctx, sqlcgen.ClaimNextReminderParams{
+		ClaimedBy:   sql.Null[string]{V: workerID, Valid: true},
+		AvailableAt: sql.Null[time.Time]{V: availableAt, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil // No reminders due - not an error
+		}
+		slog.ErrorContext(ctx, "failed to claim next reminder",
+			"worker_id", workerID,
+			"error", err)
+		return nil, fmt.Errorf("failed to claim reminder: %w", err)
+	}
+
+	return dbReminderToDomain(row), nil
+}
+
+func (c *PostgresCoordinator) ExtendReminderAvailability(ctx context.Context, reminderID, workerID string, extension time.Duration) error {
+	rows, err := c.queries.ExtendReminderAvailability(ctx, sqlcgen.ExtendReminderAvailabilityParams{
+		ID:          reminderID,
+		ClaimedBy:   sql.Null[string]{V: workerID, Valid: true},
+		AvailableAt: sql.Null[time.Time]{V: time.Now().UTC().Add(extension), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to extend reminder availability: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrReminderOwnershipLost
+	}
+	return nil
+}
+
+func (c *PostgresCoordinator) CompleteReminder(ctx context.Context, reminderID, workerID string, status domain.ReminderStatus) error {
+	params := sqlcgen.CompleteReminderParams{
+		Status:    string(status),
+		ID:        reminderID,
+		ClaimedBy: sql.Null[string]{V: workerID, Valid: true},
+	}
+	if status == domain.ReminderStatusSent {
+		params.SentAt = sql.Null[time.Time]{V: time.Now().UTC(), Valid: true}
+	}
+
+	rows, err := c.queries.CompleteReminder(ctx, params)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to complete reminder",
+			"reminder_id", reminderID,
+			"worker_id", workerID,
+			"error", err)
+		return fmt.Errorf("failed to complete reminder: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrReminderOwnershipLost
+	}
+	return nil
+}
+
+func (c *PostgresCoordinator) FailReminder(ctx context.Context, reminder *domain.Reminder, workerID, errMsg string, cfg worker.RetryConfig) (willRetry bool, err error) {
+	newRetryCount := reminder.RetryCount + 1
+
+	// Exhausted retries - park the reminder
+	if newRetryCount > cfg.MaxRetries {
+		slog.WarnContext(ctx, "reminder exhausted retries, marking dead",
+			"reminder_id", reminder.ID,
+			"worker_id", workerID,
+			"retry_count", newRetryCount,
+			"max_retries", cfg.MaxRetries,
+			"error", errMsg)
+		return false, c.markReminderDead(ctx, reminder.ID, workerID, newRetryCount, domain.ReminderErrorExhausted, errMsg)
+	}
+
+	retryAt := time.Now().UTC().Add(calculateRetryDelay(newRetryCount, cfg))
+	rows, err := c.queries.ScheduleReminderRetry(ctx, sqlcgen.ScheduleReminderRetryParams{
+		RetryCount:  int32(newRetryCount),
+		LastError:   sql.Null[string]{V: errMsg, Valid: true},
+		AvailableAt: sql.Null[time.Time]{V: retryAt, Valid: true},
+		ID:          reminder.ID,
+		ClaimedBy:   sql.Null[string]{V: workerID, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to schedule reminder retry: %w", err)
+	}
+	if rows == 0 {
+		return false, domain.ErrReminderOwnershipLost
+	}
+	return true, nil
+}
+
+func (c *PostgresCoordinator) MarkReminderDead(ctx context.Context, reminder *domain.Reminder, workerID, errMsg string) error {
+	return c.markReminderDead(ctx, reminder.ID, workerID, reminder.RetryCount, domain.ReminderErrorPermanent, errMsg)
+}
+
+// markReminderDead parks a reminder and records it in the dead letter queue.
+func (c *PostgresCoordinator) markReminderDead(ctx context.Context, reminderID, workerID string, retryCount int, errorType, errMsg string) error {
+	rows, err := c.queries.MarkReminderDead(ctx, sqlcgen.MarkReminderDeadParams{
+		ErrorType:  errorType,
+		RetryCount: int32(retryCount),
+		LastError:  sql.Null[string]{V: errMsg, Valid: true},
+		ID:         reminderID,
+		ClaimedBy:  sql.Null[string]{V: workerID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark reminder dead: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrReminderOwnershipLost
+	}
+	return nil
+}
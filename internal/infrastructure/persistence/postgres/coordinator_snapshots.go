@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rezkam/mono/internal/application/worker"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// PostgresCoordinator also implements worker.SnapshotCoordinator.
+var _ worker.SnapshotCoordinator = (*PostgresCoordinator)(nil)
+
+// === List Snapshots ===
+
+func (c *PostgresCoordinator) RecordListSnapshots(ctx context.Context, day, recordedAt time.Time, afterListID string, limit int) ([]string, error) {
+	var after pgtype.UUID
+	if afterListID != "" {
+		id, err := uuid.Parse(afterListID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+		}
+		after = uuidToQueryParam(id)
+	}
+
+	listIDs, err := c.queries.RecordListSnapshots(ctx, sqlcgen.RecordListSnapshotsParams{
+		Day:         timeToDate(day),
+		RecordedAt:  recordedAt,
+		AfterListID: after,
+		BatchSize:   int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record list snapshots: %w", err)
+	}
+	return listIDs, nil
+}
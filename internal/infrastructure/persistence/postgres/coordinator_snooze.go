@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rezkam/mono/internal/application/worker"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// PostgresCoordinator also implements worker.SnoozeCoordinator.
+var _ worker.SnoozeCoordinator = (*PostgresCoordinator)(nil)
+
+// === Snoozed Items ===
+
+func (c *PostgresCoordinator) WakeSnoozedItems(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	itemIDs, err := c.queries.WakeSnoozedItems(ctx, sqlcgen.WakeSnoozedItemsParams{
+		Now:      timeToTimestamptz(now),
+		RowLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to wake snoozed items: %w", err)
+	}
+	return itemIDs, nil
+}
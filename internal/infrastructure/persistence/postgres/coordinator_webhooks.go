@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rezkam/mono/internal/application/worker"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// PostgresCoordinator also implements worker.WebhookCoordinator.
+var _ worker.WebhookCoordinator = (*PostgresCoordinator)(nil)
+
+// === Webhook Delivery ===
+
+func (c *PostgresCoordinator) ClaimNextWebhookDelivery(ctx context.Context, workerID string, availabilityTimeout time.Duration) (*domain.WebhookDelivery, error) {
+	availableAt := time.Now().UTC().Add(availabilityTimeout)
+	row, err := c.queries.ClaimNextWebhookDelivery(ctx, sqlcgen.ClaimNextWebhookDeliveryParams{
+		ClaimedBy:   sql.Null[string]{V: workerID, Valid: true},
+		AvailableAt: sql.Null[time.Time]{V: availableAt, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil // Nothing to deliver - not an error
+		}
+		slog.ErrorContext(ctx, "failed to claim next webhook delivery",
+			"worker_id", workerID,
+			"error", err)
+		return nil, fmt.Errorf("failed to claim webhook delivery: %w", err)
+	}
+
+	return dbWebhookDeliveryToDomain(row), nil
+}
+
+func (c *PostgresCoordinator) ExtendWebhookDeliveryAvailability(ctx context.Context, deliveryID, workerID string, extension time.Duration) error {
+	rows, err := c.queries.ExtendWebhookDeliveryAvailability(ctx, sqlcgen.ExtendWebhookDeliveryAvailabilityParams{
+		ID:          deliveryID,
+		ClaimedBy:   sql.Null[string]{V: workerID, Valid: true},
+		AvailableAt: sql.Null[time.Time]{V: time.Now().UTC().Add(extension), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to extend webhook delivery availability: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrWebhookDeliveryOwnershipLost
+	}
+	return nil
+}
+
+func (c *PostgresCoordinator) CompleteWebhookDelivery(ctx context.Context, deliveryID, workerID string, responseStatus int) error {
+	rows, err := c.queries.CompleteWebhookDelivery(ctx, sqlcgen.CompleteWebhookDeliveryParams{
+		ResponseStatus: pgtype.Int4{Int32: int32(responseStatus), Valid: true},
+		ID:             deliveryID,
+		ClaimedBy:      sql.Null[string]{V: workerID, Valid: true},
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to complete webhook delivery",
+			"delivery_id", deliveryID,
+			"worker_id", workerID,
+			"error", err)
+		return fmt.Errorf("failed to complete webhook delivery: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrWebhookDeliveryOwnershipLost
+	}
+	return nil
+}
+
+func (c *PostgresCoordinator) FailWebhookDelivery(ctx context.Context, delivery *domain.WebhookDelivery, workerID, errMsg string, responseStatus *int, cfg worker.RetryConfig) (willRetry bool, err error) {
+	attempts := delivery.Attempts + 1
+
+	// Exhausted retries - park the delivery
+	if attempts > cfg.MaxRetries {
+		slog.WarnContext(ctx, "webhook delivery exhausted retries, marking dead",
+			"delivery_id", delivery.ID,
+			"worker_id", workerID,
+			"attempts", attempts,
+			"max_retries", cfg.MaxRetries,
+			"error", errMsg)
+		return false, c.markWebhookDeliveryDead(ctx, delivery.ID, workerID, attempts, errMsg, responseStatus)
+	}
+
+	retryAt := time.Now().UTC().Add(calculateRetryDelay(attempts, cfg))
+	rows, err := c.queries.ScheduleWebhookDeliveryRetry(ctx, sqlcgen.ScheduleWebhookDeliveryRetryParams{
+		Attempts:       int32(attempts),
+		LastError:      sql.Null[string]{V: errMsg, Valid: true},
+		ResponseStatus: intPtrToInt4(responseStatus),
+		AvailableAt:    sql.Null[time.Time]{V: retryAt, Valid: true},
+		ID:             delivery.ID,
+		ClaimedBy:      sql.Null[string]{V: workerID, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to schedule webhook delivery retry: %w", err)
+	}
+	if rows == 0 {
+		return false, domain.ErrWebhookDeliveryOwnershipLost
+	}
+	return true, nil
+}
+
+func (c *PostgresCoordinator) MarkWebhookDeliveryDead(ctx context.Context, delivery *domain.WebhookDelivery, workerID, errMsg string, responseStatus *int) error {
+	return c.markWebhookDeliveryDead(ctx, delivery.ID, workerID, delivery.Attempts+1, errMsg, responseStatus)
+}
+
+func (c *PostgresCoordinator) markWebhookDeliveryDead(ctx context.Context, deliveryID, workerID string, attempts int, errMsg string, responseStatus *int) error {
+	rows, err := c.queries.MarkWebhookDeliveryDead(ctx, sqlcgen.MarkWebhookDeliveryDeadParams{
+		Attempts:       int32(attempts),
+		LastError:      sql.Null[string]{V: errMsg, Valid: true},
+		ResponseStatus: intPtrToInt4(responseStatus),
+		ID:             deliveryID,
+		ClaimedBy:      sql.Null[string]{V: workerID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark webhook delivery dead: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrWebhookDeliveryOwnershipLost
+	}
+	return nil
+}
@@ -23,7 +23,16 @@ func retryDeadLetterJobTx(ctx context.Context, qtx *sqlcgen.Queries, deadLetterI
 		return "", fmt.Errorf("invalid dead letter ID: %w", err)
 	}
 
-	dlJob, err := qtx.GetDeadLetterJob(ctx, pgtype.UUID{Bytes: dlID, Valid: true})
+	// Jobs of other tenants are reported as not found
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	dlJob, err := qtx.GetDeadLetterJob(ctx, sqlcgen.GetDeadLetterJobParams{
+		ID:      pgtype.UUID{Bytes: dlID, Valid: true},
+		OwnerID: ownerID,
+	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return "", domain.ErrDeadLetterNotFound
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rezkam/mono/internal/application/worker"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// Store publishes relayed change log events.
+var _ worker.EventPublisher = (*Store)(nil)
+
+// eventEnvelope is the JSON body delivered to webhook receivers.
+type eventEnvelope struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	OccurredAt time.Time      `json:"occurred_at"`
+	Data       map[string]any `json:"data"`
+}
+
+// PublishEvent implements worker.EventPublisher.
+// Fans the event out to the matching webhook subscriptions of tenants that can
+// access the event's list. Subscriptions that
+// already have the event queued are skipped, so relaying a change twice is harmless.
+func (s *Store) PublishEvent(ctx context.Context, event *domain.Event) error {
+	payload, err := json.Marshal(eventEnvelope{
+		ID:         event.ID,
+		Type:       string(event.Type),
+		OccurredAt: event.OccurredAt.UTC(),
+		Data:       event.Data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	listID, err := eventScopeParam(event.ListID)
+	if err != nil {
+		return fmt.Errorf("failed to convert event list: %w", err)
+	}
+	templateID, err := eventScopeParam(event.TemplateID)
+	if err != nil {
+		return fmt.Errorf("failed to convert event template: %w", err)
+	}
+
+	if _, err := s.queries.EnqueueWebhookDeliveries(ctx, sqlcgen.EnqueueWebhookDeliveriesParams{
+		EventID:    event.ID,
+		EventType:  string(event.Type),
+		Payload:    payload,
+		CreatedAt:  event.OccurredAt.UTC(),
+		ListID:     listID,
+		TemplateID: templateID,
+	}); err != nil {
+		return fmt.Errorf("failed to enqueue webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// eventScopeParam converts the optional list or template of an event to a
+// query parameter; empty IDs become NULL.
+func eventScopeParam(id string) (pgtype.UUID, error) {
+	if id == "" {
+		return pgtype.UUID{}, nil
+	}
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return pgtype.UUID{}, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	return uuidToQueryParam(parsed), nil
+}
@@ -0,0 +1,414 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// === Item Search ===
+//
+// Item search is the one statement built at run time rather than generated by
+// sqlc: a filter expression is a tree of comparisons of any shape, and compiles
+// to a WHERE clause of the same shape.
+//
+// SQL Injection Protection:
+// Only fixed SQL text is ever concatenated. Column expressions and operators are
+// chosen from closed sets keyed by the type-checked domain values (FilterField,
+// FilterOperator, the validated sort field), and every value a caller supplies -
+// including custom field names - is bound as a parameter ($n). PostgreSQL's
+// parameterized query protocol treats parameters as DATA, never CODE.
+// See tests/integration/postgres/sql_injection_resistance_test.go for proof.
+
+// itemSearchFrom selects items that are not hidden by a deleted exception of
+// their recurring template (edited and rescheduled occurrences pass through).
+const itemSearchFrom = `
+FROM todo_items i
+LEFT JOIN recurring_template_exceptions e
+    ON i.recurring_template_id = e.template_id
+    AND i.occurs_at = e.occurs_at
+    AND e.exception_type = 'deleted'`
+
+// itemSearchColumns are the todo_items columns in sqlcgen.TodoItem field order.
+const itemSearchColumns = `i.id, i.list_id, i.title, i.status, i.priority,
+    i.estimated_duration, i.actual_duration, i.created_at, i.updated_at, i.due_at,
+    i.tags, i.recurring_template_id, i.starts_at, i.occurs_at, i.due_offset,
+    i.timezone, i.version, i.custom_fields, i.snoozed_until`
+
+// priorityWeightSQL orders priorities semantically, low=1 < medium=2 < high=3 < urgent=4,
+// instead of lexically.
+const priorityWeightSQL = `CASE i.priority WHEN 'low' THEN 1 WHEN 'medium' THEN 2 WHEN 'high' THEN 3 WHEN 'urgent' THEN 4 END`
+
+// priorityWeights are the weights of priorityWeightSQL.
+var priorityWeights = map[domain.TaskPriority]int{
+	domain.TaskPriorityLow:    1,
+	domain.TaskPriorityMedium: 2,
+	domain.TaskPriorityHigh:   3,
+	domain.TaskPriorityUrgent: 4,
+}
+
+// itemSortKeys are the sort key expressions of the built-in sort fields.
+//
+// sort_key is the active sort field as JSONB: timestamps as epoch seconds (exact to the
+// microsecond), priorities as their weight, custom fields as stored. One comparable
+// column keeps a single keyset predicate for every sort.
+var itemSortKeys = map[string]string{
+	"due_at":     `to_jsonb(extract(epoch FROM i.due_at))`,
+	"priority":   `to_jsonb(` + priorityWeightSQL + `)`,
+	"created_at": `to_jsonb(extract(epoch FROM i.created_at))`,
+	"updated_at": `to_jsonb(extract(epoch FROM i.updated_at))`,
+}
+
+// itemSearch accumulates the WHERE conditions of an item search and the
+// parameters they bind.
+type itemSearch struct {
+	conditions []string
+	args       []any
+}
+
+// bind adds a parameter and returns its placeholder.
+func (q *itemSearch) bind(value any) string {
+	q.args = append(q.args, value)
+	return "$" + strconv.Itoa(len(q.args))
+}
+
+// where adds a condition; all conditions must hold.
+func (q *itemSearch) where(condition string) {
+	q.conditions = append(q.conditions, condition)
+}
+
+// whereClause returns the conditions joined with AND.
+func (q *itemSearch) whereClause() string {
+	return "WHERE " + strings.Join(q.conditions, "\n    AND ")
+}
+
+// newItemSearch builds the conditions shared by the search and the count of items:
+// the fixed filters of params, the filter expression, the excluded statuses
+// and the tenant scope. Filters that are not set add no condition.
+func newItemSearch(params domain.ListTasksParams, excludedStatuses []domain.TaskStatus, ownerID pgtype.UUID, now time.Time) (*itemSearch, error) {
+	q := &itemSearch{}
+	q.where("e.id IS NULL")
+
+	if params.ListID != nil {
+		listUUID, err := uuid.Parse(*params.ListID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+		}
+		q.where("i.list_id = " + q.bind(uuidToQueryParam(listUUID)) + "::uuid")
+	}
+	if len(params.ListIDs) > 0 {
+		listUUIDs := make([]pgtype.UUID, len(params.ListIDs))
+		for i, id := range params.ListIDs {
+			listUUID, err := uuid.Parse(id)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+			}
+			listUUIDs[i] = uuidToQueryParam(listUUID)
+		}
+		q.where("i.list_id = ANY(" + q.bind(listUUIDs) + "::uuid[])")
+	}
+
+	// Statuses and priorities match any listed value; tags must all be present
+	if statuses := params.Filter.Statuses(); len(statuses) > 0 {
+		q.where("i.status = ANY(" + q.bind(taskStatusesToStrings(statuses)) + "::text[])")
+	}
+	if len(excludedStatuses) > 0 {
+		q.where("i.status != ALL(" + q.bind(taskStatusesToStrings(excludedStatuses)) + "::text[])")
+	}
+	if priorities := params.Filter.Priorities(); len(priorities) > 0 {
+		q.where("i.priority = ANY(" + q.bind(taskPrioritiesToStrings(priorities)) + "::text[])")
+	}
+	if tags := params.Filter.Tags(); len(tags) > 0 {
+		q.where("i.tags @> " + q.bind(tags) + "::text[]")
+	}
+	if params.DueBefore != nil {
+		q.where("i.due_at <= " + q.bind(*params.DueBefore) + "::timestamptz")
+	}
+	if params.DueAfter != nil {
+		q.where("i.due_at >= " + q.bind(*params.DueAfter) + "::timestamptz")
+	}
+	if params.UpdatedAfter != nil {
+		q.where("i.updated_at >= " + q.bind(*params.UpdatedAfter) + "::timestamptz")
+	}
+	if params.CreatedAfter != nil {
+		q.where("i.created_at >= " + q.bind(*params.CreatedAfter) + "::timestamptz")
+	}
+	if params.ExcludeSnoozed {
+		q.where("(i.snoozed_until IS NULL OR i.snoozed_until <= " + q.bind(now) + "::timestamptz)")
+	}
+
+	// Custom field filters compare the text form of the stored JSON value (->>);
+	// items must match every name/value pair
+	if customFields := params.Filter.CustomFields(); len(customFields) > 0 {
+		data, err := json.Marshal(customFields)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal custom field filters: %w", err)
+		}
+		q.where(`NOT EXISTS (
+        SELECT 1 FROM jsonb_each_text(` + q.bind(data) + `::jsonb) AS f(name, value)
+        WHERE i.custom_fields ->> f.name IS DISTINCT FROM f.value
+    )`)
+	}
+
+	if expression := params.Filter.Expression(); !expression.IsZero() {
+		condition, err := q.compileFilterNode(expression.Root(), now)
+		if err != nil {
+			return nil, err
+		}
+		q.where(condition)
+	}
+
+	// Tenant scope: lists owned by or shared with the owner (NULL = unscoped internal access).
+	// Applies to cross-list searches as well as single-list searches
+	if ownerID.Valid {
+		q.where("list_visible_to(i.list_id, " + q.bind(ownerID) + "::uuid)")
+	}
+
+	return q, nil
+}
+
+// compileFilterNode compiles a node of a filter expression to a condition.
+// Every comparison is two-valued - false, never NULL, when the item has no value
+// for the field - so NOT of a comparison matches exactly the items it does not.
+func (q *itemSearch) compileFilterNode(node domain.FilterNode, now time.Time) (string, error) {
+	switch n := node.(type) {
+	case domain.FilterAnd:
+		return q.compileFilterOperands(n.Operands, " AND ", now)
+	case domain.FilterOr:
+		return q.compileFilterOperands(n.Operands, " OR ", now)
+	case domain.FilterNot:
+		operand, err := q.compileFilterNode(n.Operand, now)
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + operand, nil
+	case domain.FilterComparison:
+		condition, err := q.compileFilterComparison(n, now)
+		if err != nil {
+			return "", err
+		}
+		return "(" + condition + ")", nil
+	default:
+		return "", fmt.Errorf("%w: unsupported node %T", domain.ErrInvalidFilterExpression, node)
+	}
+}
+
+func (q *itemSearch) compileFilterOperands(operands []domain.FilterNode, operator string, now time.Time) (string, error) {
+	compiled := make([]string, len(operands))
+	for i, operand := range operands {
+		condition, err := q.compileFilterNode(operand, now)
+		if err != nil {
+			return "", err
+		}
+		compiled[i] = condition
+	}
+	return "(" + strings.Join(compiled, operator) + ")", nil
+}
+
+// filterSQLOperators are the SQL operators of the ordered comparisons.
+var filterSQLOperators = map[domain.FilterOperator]string{
+	domain.FilterOpEqual:          "=",
+	domain.FilterOpNotEqual:       "<>",
+	domain.FilterOpLess:           "<",
+	domain.FilterOpLessOrEqual:    "<=",
+	domain.FilterOpGreater:        ">",
+	domain.FilterOpGreaterOrEqual: ">=",
+	domain.FilterOpHas:            "=",
+}
+
+// filterTimeColumns are the timestamp columns a filter expression can compare.
+var filterTimeColumns = map[domain.FilterField]string{
+	domain.FilterFieldDueAt:     "i.due_at",
+	domain.FilterFieldCreatedAt: "i.created_at",
+	domain.FilterFieldUpdatedAt: "i.updated_at",
+}
+
+// compileFilterComparison compiles one type-checked comparison.
+func (q *itemSearch) compileFilterComparison(c domain.FilterComparison, now time.Time) (string, error) {
+	operator, ordered := filterSQLOperators[c.Op]
+
+	switch c.Field {
+	case domain.FilterFieldStatus:
+		status, ok := c.Value.(domain.TaskStatus)
+		if !ok || !ordered {
+			break
+		}
+		return "i.status " + operator + " " + q.bind(string(status)), nil
+
+	case domain.FilterFieldPriority:
+		if c.Op == domain.FilterOpPresent {
+			return "i.priority IS NOT NULL", nil
+		}
+		priority, ok := c.Value.(domain.TaskPriority)
+		if !ok || !ordered {
+			break
+		}
+		return "COALESCE(" + priorityWeightSQL + " " + operator + " " + q.bind(priorityWeights[priority]) + "::int, false)", nil
+
+	case domain.FilterFieldTags:
+		if c.Op == domain.FilterOpPresent {
+			return "COALESCE(cardinality(i.tags) > 0, false)", nil
+		}
+		tag, ok := c.Value.(string)
+		if !ok || c.Op != domain.FilterOpHas {
+			break
+		}
+		return "COALESCE(" + q.bind(tag) + "::text = ANY(i.tags), false)", nil
+
+	case domain.FilterFieldTitle:
+		title, ok := c.Value.(string)
+		if !ok {
+			break
+		}
+		if c.Op == domain.FilterOpHas {
+			return "i.title ILIKE " + q.bind("%"+escapeLikePattern(title)+"%"), nil
+		}
+		if !ordered {
+			break
+		}
+		return "i.title " + operator + " " + q.bind(title), nil
+
+	case domain.FilterFieldDueAt, domain.FilterFieldCreatedAt, domain.FilterFieldUpdatedAt:
+		column := filterTimeColumns[c.Field]
+		if c.Op == domain.FilterOpPresent {
+			return column + " IS NOT NULL", nil
+		}
+		value, ok := c.Value.(domain.FilterTime)
+		if !ok || !ordered {
+			break
+		}
+		return "COALESCE(" + column + " " + operator + " " + q.bind(value.Resolve(now)) + "::timestamptz, false)", nil
+
+	case domain.FilterFieldCustomField:
+		name := q.bind(c.Name) + "::text"
+		if c.Op == domain.FilterOpPresent {
+			return "i.custom_fields ? " + name, nil
+		}
+		value, ok := c.Value.(string)
+		if !ok || !ordered {
+			break
+		}
+		return "COALESCE(i.custom_fields ->> " + name + " " + operator + " " + q.bind(value) + "::text, false)", nil
+	}
+
+	return "", fmt.Errorf("%w: unsupported comparison %s %s", domain.ErrInvalidFilterExpression, c.Field, c.Op)
+}
+
+// escapeLikePattern escapes the LIKE wildcards of s, so it matches literally.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// itemSortKey returns the sort key expression and direction of a filter's sort.
+// Defaults: due_at and priority ascend, everything else descends.
+func (q *itemSearch) itemSortKey(filter domain.ItemsFilter) (string, bool) {
+	orderBy := filter.OrderBy()
+	ascending := orderBy == "due_at" || orderBy == "priority"
+
+	var sortKey string
+	if name, ok := filter.CustomFieldOrderBy(); ok {
+		// Values sort by JSONB ordering (numbers numerically, dates/strings lexically)
+		sortKey = "i.custom_fields -> " + q.bind(name) + "::text"
+		ascending = true
+	} else if key, ok := itemSortKeys[orderBy]; ok {
+		sortKey = key
+	} else {
+		sortKey = itemSortKeys[domain.DefaultOrderBy]
+	}
+
+	if dir := filter.OrderDir(); dir != "" {
+		ascending = dir == "asc"
+	}
+	return sortKey, ascending
+}
+
+// listQuery returns the statement of one page of items, ordered by sort key,
+// then by id in the same direction.
+//
+// Keyset pagination: the order is total, so a page continues strictly after the
+// cursor (afterSortKey, afterID). Unlike OFFSET, rows inserted or deleted before
+// the cursor never shift the next page, and earlier rows are not read again.
+// Items without a sort key come last in either direction.
+func (q *itemSearch) listQuery(filter domain.ItemsFilter, afterSortKey []byte, afterID pgtype.UUID, limit int) string {
+	sortKey, ascending := q.itemSortKey(filter)
+	compare, order := ">", "ASC"
+	if !ascending {
+		compare, order = "<", "DESC"
+	}
+
+	if afterID.Valid {
+		id := q.bind(afterID) + "::uuid"
+		if afterSortKey == nil {
+			q.where("k.sort_key IS NULL AND i.id " + compare + " " + id)
+		} else {
+			key := q.bind(afterSortKey) + "::jsonb"
+			q.where("(k.sort_key IS NULL OR k.sort_key " + compare + " " + key +
+				" OR (k.sort_key = " + key + " AND i.id " + compare + " " + id + "))")
+		}
+	}
+
+	return "SELECT " + itemSearchColumns + ", k.sort_key" + itemSearchFrom + `
+CROSS JOIN LATERAL (SELECT ` + sortKey + ` AS sort_key) k
+` + q.whereClause() + `
+ORDER BY k.sort_key ` + order + ` NULLS LAST, i.id ` + order + `
+LIMIT ` + q.bind(limit)
+}
+
+// agendaQuery returns the statement selecting the candidate items of an agenda,
+// earliest first: open items due before the window ends, or occurring or starting
+// within it. starts_at is a date and is compared with the dates of the window in UTC.
+func (q *itemSearch) agendaQuery(query domain.AgendaQuery) string {
+	q.where("i.status = ANY(" + q.bind(taskStatusesToStrings(domain.UndoneStatuses())) + "::text[])")
+
+	from, before := q.bind(query.ScheduledFrom), q.bind(query.ScheduledBefore)
+	fromDate := q.bind(query.ScheduledFrom.UTC().Format(time.DateOnly))
+	beforeDate := q.bind(query.ScheduledBefore.UTC().Format(time.DateOnly))
+	q.where(`(i.due_at < ` + q.bind(query.DueBefore) + `::timestamptz
+        OR (i.occurs_at >= ` + from + `::timestamptz AND i.occurs_at < ` + before + `::timestamptz)
+        OR (i.starts_at >= ` + fromDate + `::date AND i.starts_at < ` + beforeDate + `::date))`)
+
+	return "SELECT " + itemSearchColumns + itemSearchFrom + "\n" + q.whereClause() + `
+ORDER BY COALESCE(i.due_at, i.occurs_at, i.starts_at::timestamp AT TIME ZONE 'UTC') ASC, i.id ASC
+LIMIT ` + q.bind(query.Limit)
+}
+
+// countQuery returns the statement counting every matching item.
+func (q *itemSearch) countQuery() string {
+	return "SELECT COUNT(*)" + itemSearchFrom + "\n" + q.whereClause()
+}
+
+// scanSearchItem scans a row of itemSearchColumns followed by the extra columns
+// of the statement into dest.
+func scanSearchItem(rows pgx.Rows, dest ...any) (sqlcgen.TodoItem, error) {
+	var i sqlcgen.TodoItem
+	err := rows.Scan(append([]any{
+		&i.ID,
+		&i.ListID,
+		&i.Title,
+		&i.Status,
+		&i.Priority,
+		&i.EstimatedDuration,
+		&i.ActualDuration,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DueAt,
+		&i.Tags,
+		&i.RecurringTemplateID,
+		&i.StartsAt,
+		&i.OccursAt,
+		&i.DueOffset,
+		&i.Timezone,
+		&i.Version,
+		&i.CustomFields,
+		&i.SnoozedUntil,
+	}, dest...)...)
+	return i, err
+}
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestItemSearch(t *testing.T, expression string, now time.Time) *itemSearch {
+	t.Helper()
+	filter, err := domain.NewItemsFilter(domain.ItemsFilterInput{Expression: &expression})
+	require.NoError(t, err)
+	search, err := newItemSearch(domain.ListTasksParams{Filter: filter}, nil, pgtype.UUID{}, now)
+	require.NoError(t, err)
+	return search
+}
+
+func TestItemSearch_CompilesFilterExpression(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	search := newTestItemSearch(t, "priority >= high AND (tag:work OR tag:oncall) AND due_at < now+2d AND NOT status:blocked", now)
+
+	assert.Equal(t, []string{
+		"e.id IS NULL",
+		"((COALESCE(" + priorityWeightSQL + " >= $1::int, false)) AND" +
+			" ((COALESCE($2::text = ANY(i.tags), false)) OR (COALESCE($3::text = ANY(i.tags), false))) AND" +
+			" (COALESCE(i.due_at < $4::timestamptz, false)) AND" +
+			" NOT (i.status = $5))",
+	}, search.conditions)
+	assert.Equal(t, []any{3, "work", "oncall", now.Add(48 * time.Hour), "blocked"}, search.args)
+}
+
+func TestItemSearch_BindsEveryValue(t *testing.T) {
+	// Values and custom field names never become SQL text, whatever they contain
+	attack := `x'; DROP TABLE todo_items; --`
+	search := newTestItemSearch(t, `title:"`+attack+`" OR custom_fields.note = "`+attack+`"`, time.Now().UTC())
+
+	for _, condition := range search.conditions {
+		assert.NotContains(t, condition, "DROP")
+	}
+	assert.Equal(t, []any{`%x'; DROP TABLE todo\_items; --%`, "note", attack}, search.args)
+}
+
+func TestItemSearch_ListQueryContinuesAfterCursor(t *testing.T) {
+	filter, err := domain.NewItemsFilter(domain.ItemsFilterInput{})
+	require.NoError(t, err)
+	search, err := newItemSearch(domain.ListTasksParams{Filter: filter}, nil, pgtype.UUID{}, time.Now().UTC())
+	require.NoError(t, err)
+
+	query := search.listQuery(filter, []byte("1767225600"), pgtype.UUID{Valid: true}, 26)
+
+	// created_at descends by default
+	assert.Contains(t, query, "(k.sort_key IS NULL OR k.sort_key < $2::jsonb OR (k.sort_key = $2::jsonb AND i.id < $1::uuid))")
+	assert.Contains(t, query, "ORDER BY k.sort_key DESC NULLS LAST, i.id DESC")
+	assert.Contains(t, query, "LIMIT $3")
+	assert.Len(t, search.args, 3)
+}
+
+func TestItemSearch_ListIDsAndTimeFilters(t *testing.T) {
+	filter, err := domain.NewItemsFilter(domain.ItemsFilterInput{})
+	require.NoError(t, err)
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	search, err := newItemSearch(domain.ListTasksParams{
+		ListIDs:      []string{"0190d3c8-6b4a-7000-8000-000000000001", "0190d3c8-6b4a-7000-8000-000000000002"},
+		Filter:       filter,
+		UpdatedAfter: &since,
+		CreatedAfter: &since,
+	}, nil, pgtype.UUID{}, time.Now().UTC())
+	require.NoError(t, err)
+
+	assert.Contains(t, search.conditions, "i.list_id = ANY($1::uuid[])")
+	assert.Contains(t, search.conditions, "i.updated_at >= $2::timestamptz")
+	assert.Contains(t, search.conditions, "i.created_at >= $3::timestamptz")
+	assert.Len(t, search.args[0], 2)
+
+	_, err = newItemSearch(domain.ListTasksParams{ListIDs: []string{"not-a-uuid"}, Filter: filter}, nil, pgtype.UUID{}, time.Now().UTC())
+	assert.ErrorIs(t, err, domain.ErrInvalidID)
+}
+
+func TestItemSearch_ExcludeSnoozed(t *testing.T) {
+	filter, err := domain.NewItemsFilter(domain.ItemsFilterInput{})
+	require.NoError(t, err)
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	search, err := newItemSearch(domain.ListTasksParams{Filter: filter, ExcludeSnoozed: true}, nil, pgtype.UUID{}, now)
+	require.NoError(t, err)
+	assert.Contains(t, search.conditions, "(i.snoozed_until IS NULL OR i.snoozed_until <= $1::timestamptz)")
+	assert.Equal(t, []any{now}, search.args)
+
+	// Snoozed items are included unless asked
+	search, err = newItemSearch(domain.ListTasksParams{Filter: filter}, nil, pgtype.UUID{}, now)
+	require.NoError(t, err)
+	assert.Empty(t, search.args)
+}
+
+func TestItemSearch_AgendaQuery(t *testing.T) {
+	search, err := newItemSearch(domain.ListTasksParams{}, nil, pgtype.UUID{}, time.Now().UTC())
+	require.NoError(t, err)
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	query := domain.NewAgendaQuery(day)
+
+	statement := search.agendaQuery(query)
+
+	assert.Contains(t, statement, "i.status = ANY($1::text[])")
+	assert.Contains(t, statement, "i.starts_at >= $4::date AND i.starts_at < $5::date")
+	assert.Contains(t, statement, "LIMIT $7")
+	assert.Equal(t, []any{
+		[]string{"todo", "in_progress", "blocked"},
+		query.ScheduledFrom, query.ScheduledBefore,
+		"2026-03-08", "2026-03-20",
+		query.DueBefore,
+		domain.MaxAgendaItems + 1,
+	}, search.args)
+}
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Typed custom fields per list.
+--
+-- A list declares its custom field schema as a JSON array of definitions:
+--   [{"name": "story_points", "type": "number"},
+--    {"name": "stage", "type": "enum", "options": ["design", "build"]}]
+--
+-- Items and recurring templates store values as a JSON object keyed by field name:
+--   {"story_points": 3, "stage": "build"}
+--
+-- Values are validated against the list schema by the application layer on
+-- create/update. The database only guarantees the JSON shape (array/object).
+ALTER TABLE todo_lists
+    ADD COLUMN custom_field_schema jsonb NOT NULL DEFAULT '[]'::jsonb
+        CHECK (jsonb_typeof(custom_field_schema) = 'array');
+
+ALTER TABLE todo_items
+    ADD COLUMN custom_fields jsonb NOT NULL DEFAULT '{}'::jsonb
+        CHECK (jsonb_typeof(custom_fields) = 'object');
+
+ALTER TABLE recurring_task_templates
+    ADD COLUMN custom_fields jsonb NOT NULL DEFAULT '{}'::jsonb
+        CHECK (jsonb_typeof(custom_fields) = 'object');
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE recurring_task_templates DROP COLUMN IF EXISTS custom_fields;
+ALTER TABLE todo_items DROP COLUMN IF EXISTS custom_fields;
+ALTER TABLE todo_lists DROP COLUMN IF EXISTS custom_field_schema;
+
+-- +goose StatementEnd
@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Multi-tenant ownership.
+--
+-- Every API key belongs to an owner (tenant). Lists are owned by the tenant of
+-- the key that created them, and items, recurring templates and exceptions are
+-- scoped through their list. API keys of one tenant cannot see or modify data
+-- of another tenant; such access is reported as "not found".
+--
+-- Lists without an owner are only reachable by internal callers that run
+-- without an authenticated principal (background workers, maintenance tools).
+ALTER TABLE api_keys ADD COLUMN owner_id uuid;
+ALTER TABLE todo_lists ADD COLUMN owner_id uuid;
+
+-- Existing deployments were single-tenant: every key could access every list.
+-- Preserve that by assigning all existing keys and lists to one shared owner.
+DO $$
+DECLARE
+    legacy_owner uuid := uuidv7();
+BEGIN
+    UPDATE api_keys SET owner_id = legacy_owner;
+    UPDATE todo_lists SET owner_id = legacy_owner;
+END $$;
+
+ALTER TABLE api_keys ALTER COLUMN owner_id SET NOT NULL;
+
+CREATE INDEX idx_api_keys_owner ON api_keys(owner_id);
+CREATE INDEX idx_todo_lists_owner_created ON todo_lists(owner_id, created_at DESC);
+
+-- list_visible_to(list_id, owner) reports whether a list belongs to a tenant.
+-- It is the tenant scope of every query reaching data through a list; queries
+-- pass owner NULL for unscoped internal access and check that themselves, as
+-- the function is false for a NULL owner:
+--
+--   (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(i.list_id, sqlc.narg('owner_id')::uuid))
+CREATE FUNCTION list_visible_to(list_id uuid, owner uuid) RETURNS boolean
+LANGUAGE sql STABLE PARALLEL SAFE
+AS $$
+    SELECT EXISTS (
+        SELECT 1 FROM todo_lists tl
+        WHERE tl.id = $1 AND tl.owner_id = $2
+    );
+$$;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP FUNCTION IF EXISTS list_visible_to(uuid, uuid);
+DROP INDEX IF EXISTS idx_todo_lists_owner_created;
+DROP INDEX IF EXISTS idx_api_keys_owner;
+
+ALTER TABLE todo_lists DROP COLUMN IF EXISTS owner_id;
+ALTER TABLE api_keys DROP COLUMN IF EXISTS owner_id;
+
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Scoped API keys.
+--
+-- Every key carries the list of scopes it was granted, e.g.
+--   {lists:read, items:read}                                  (read-only)
+--   {lists:read, lists:write, items:read, items:write}        (read-write)
+--   {lists:read, lists:write, items:read, items:write, admin} (admin)
+--
+-- Required scopes are declared per operation in the OpenAPI spec and checked
+-- by the request validation middleware.
+ALTER TABLE api_keys ADD COLUMN scopes text[];
+
+-- Existing keys had unrestricted access, including the admin endpoints.
+-- Keep them working by granting every scope.
+UPDATE api_keys
+SET scopes = ARRAY['lists:read', 'lists:write', 'items:read', 'items:write', 'admin'];
+
+ALTER TABLE api_keys ALTER COLUMN scopes SET NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE api_keys DROP COLUMN IF EXISTS scopes;
+
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- List sharing.
+--
+-- The owner of a list is todo_lists.owner_id. Other principals (tenants) get
+-- access through a membership with one of two roles:
+--   viewer - read the list, its items and recurring templates
+--   editor - viewer + modify the list, its items and recurring templates
+-- Only the owner manages memberships. Roles are enforced by the application
+-- layer; queries only use memberships to decide which lists are visible.
+CREATE TABLE list_members (
+    list_id uuid NOT NULL REFERENCES todo_lists(id) ON DELETE CASCADE,
+    principal_id uuid NOT NULL,
+    role text NOT NULL CHECK (role IN ('editor', 'viewer')),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (list_id, principal_id)
+);
+
+CREATE INDEX idx_list_members_principal ON list_members(principal_id);
+
+-- list_visible_to(list_id, owner) reports whether a list is owned by or shared
+-- with a tenant. Members see the list the same way its owner does.
+CREATE OR REPLACE FUNCTION list_visible_to(list_id uuid, owner uuid) RETURNS boolean
+LANGUAGE sql STABLE PARALLEL SAFE
+AS $$
+    SELECT EXISTS (
+        SELECT 1 FROM todo_lists tl
+        WHERE tl.id = $1
+          AND (tl.owner_id = $2 OR EXISTS (SELECT 1 FROM list_members lm WHERE lm.list_id = tl.id AND lm.principal_id = $2))
+    );
+$$;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+CREATE OR REPLACE FUNCTION list_visible_to(list_id uuid, owner uuid) RETURNS boolean
+LANGUAGE sql STABLE PARALLEL SAFE
+AS $$
+    SELECT EXISTS (
+        SELECT 1 FROM todo_lists tl
+        WHERE tl.id = $1 AND tl.owner_id = $2
+    );
+$$;
+
+DROP TABLE IF EXISTS list_members;
+
+-- +goose StatementEnd
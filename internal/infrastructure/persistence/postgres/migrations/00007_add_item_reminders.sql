@@ -0,0 +1,161 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Item reminders.
+--
+-- A reminder is either absolute (remind_at) or relative to one of the item's
+-- times (anchor + offset_before, e.g. due_at - 1 hour). fire_at holds the
+-- resolved fire time; it is NULL for relative reminders whose anchor is not set.
+--
+-- Reminders double as the delivery queue, claimed by workers the same way as
+-- recurring_generation_jobs:
+--   pending -> running (claimed_by, available_at = NOW() + timeout)
+--   running -> sent | skipped          (delivered / item already closed)
+--   running -> pending                 (transient failure, available_at = retry time)
+--   running -> dead                    (permanent failure or retries exhausted)
+-- A running reminder whose available_at passed is reclaimed (crashed worker).
+CREATE TABLE item_reminders (
+    id uuid PRIMARY KEY DEFAULT uuidv7(),
+    item_id uuid NOT NULL REFERENCES todo_items(id) ON DELETE CASCADE,
+    remind_at timestamptz,
+    anchor text CHECK (anchor IN ('due_at', 'starts_at')),
+    offset_before interval,
+    fire_at timestamptz,
+    status text NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'running', 'sent', 'skipped', 'dead')),
+    retry_count integer NOT NULL DEFAULT 0,
+    last_error text,
+    claimed_by text,
+    available_at timestamptz,
+    sent_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT item_reminders_absolute_or_relative CHECK (
+        (remind_at IS NOT NULL AND anchor IS NULL AND offset_before IS NULL)
+        OR (remind_at IS NULL AND anchor IS NOT NULL AND offset_before IS NOT NULL)
+    )
+);
+
+CREATE INDEX idx_item_reminders_item ON item_reminders(item_id);
+
+-- Claim scan: only reminders that can still be delivered
+CREATE INDEX idx_item_reminders_claimable ON item_reminders(fire_at)
+    WHERE status IN ('pending', 'running');
+
+-- Default reminders of recurring templates, copied to every generated item.
+-- JSON array of relative rules: [{"anchor": "due_at", "offset_before_seconds": 3600}]
+ALTER TABLE recurring_task_templates
+    ADD COLUMN default_reminders jsonb NOT NULL DEFAULT '[]'::jsonb
+        CHECK (jsonb_typeof(default_reminders) = 'array');
+
+-- Resolves the fire time of a relative reminder for the given item times.
+-- starts_at is a DATE; reminders anchored to it fire at the start of that day
+-- in the item's timezone. Floating items (timezone NULL) use UTC.
+CREATE OR REPLACE FUNCTION reminder_fire_at(
+    p_anchor text, p_offset_before interval, p_due_at timestamptz, p_starts_at date, p_timezone text
+)
+RETURNS timestamptz AS $$
+    SELECT CASE p_anchor
+        WHEN 'due_at' THEN p_due_at - p_offset_before
+        WHEN 'starts_at' THEN (p_starts_at::timestamp AT TIME ZONE COALESCE(p_timezone, 'UTC')) - p_offset_before
+    END;
+$$ LANGUAGE sql STABLE;
+
+-- Reschedule relative reminders when the item's due or start time, or its
+-- timezone, changes.
+-- Reminders that already fired are re-armed for the new time. Running reminders
+-- are left to the worker delivering them.
+CREATE OR REPLACE FUNCTION reschedule_item_reminders()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE item_reminders r
+    SET fire_at = reminder_fire_at(r.anchor, r.offset_before, NEW.due_at, NEW.starts_at, NEW.timezone),
+        status = 'pending',
+        retry_count = 0,
+        last_error = NULL,
+        available_at = NULL,
+        sent_at = NULL,
+        updated_at = now()
+    WHERE r.item_id = NEW.id
+      AND r.anchor IS NOT NULL
+      AND r.status <> 'running'
+      AND r.fire_at IS DISTINCT FROM reminder_fire_at(r.anchor, r.offset_before, NEW.due_at, NEW.starts_at, NEW.timezone);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER reschedule_item_reminders
+    AFTER UPDATE OF due_at, starts_at, timezone ON todo_items
+    FOR EACH ROW
+    WHEN (OLD.due_at IS DISTINCT FROM NEW.due_at
+       OR OLD.starts_at IS DISTINCT FROM NEW.starts_at
+       OR OLD.timezone IS DISTINCT FROM NEW.timezone)
+    EXECUTE FUNCTION reschedule_item_reminders();
+
+-- Copy the template's default reminders to generated recurring instances.
+-- Covers both the synchronous horizon and the generation worker.
+CREATE OR REPLACE FUNCTION create_default_item_reminders()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO item_reminders (item_id, anchor, offset_before, fire_at)
+    SELECT NEW.id,
+           rule->>'anchor',
+           make_interval(secs => (rule->>'offset_before_seconds')::double precision),
+           reminder_fire_at(
+               rule->>'anchor',
+               make_interval(secs => (rule->>'offset_before_seconds')::double precision),
+               NEW.due_at,
+               NEW.starts_at,
+               NEW.timezone)
+    FROM recurring_task_templates t,
+         jsonb_array_elements(t.default_reminders) AS rule
+    WHERE t.id = NEW.recurring_template_id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER create_default_item_reminders
+    AFTER INSERT ON todo_items
+    FOR EACH ROW
+    WHEN (NEW.recurring_template_id IS NOT NULL)
+    EXECUTE FUNCTION create_default_item_reminders();
+
+-- Dead letter queue for reminders, the counterpart of dead_letter_jobs.
+-- An entry is written in the same statement that parks a reminder as dead;
+-- retrying it re-arms the reminder for immediate delivery.
+CREATE TABLE dead_letter_reminders (
+    id uuid PRIMARY KEY DEFAULT uuidv7(),
+    reminder_id uuid NOT NULL REFERENCES item_reminders(id) ON DELETE CASCADE,
+    item_id uuid NOT NULL REFERENCES todo_items(id) ON DELETE CASCADE,
+
+    -- Failure classification
+    error_type text NOT NULL CHECK (error_type IN ('permanent', 'exhausted')),
+    error_message text,
+    retry_count integer NOT NULL,
+    last_worker_id text,
+    failed_at timestamptz NOT NULL DEFAULT now(),
+
+    -- Resolution
+    reviewed_at timestamptz,
+    resolution text CHECK (resolution IN ('retried', 'discarded')),
+    reviewer_note text
+);
+
+CREATE INDEX idx_dead_letter_reminders_pending ON dead_letter_reminders(failed_at DESC)
+    WHERE resolution IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS dead_letter_reminders;
+DROP TRIGGER IF EXISTS create_default_item_reminders ON todo_items;
+DROP TRIGGER IF EXISTS reschedule_item_reminders ON todo_items;
+DROP FUNCTION IF EXISTS create_default_item_reminders();
+DROP FUNCTION IF EXISTS reschedule_item_reminders();
+DROP FUNCTION IF EXISTS reminder_fire_at(text, interval, timestamptz, date, text);
+ALTER TABLE recurring_task_templates DROP COLUMN IF EXISTS default_reminders;
+DROP TABLE IF EXISTS item_reminders;
+
+-- +goose StatementEnd
@@ -0,0 +1,78 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Outbound webhook subscriptions.
+-- Subscriptions belong to the tenant of the key that created them. They are
+-- only visible to their tenant, and events are only fanned out to
+-- subscriptions whose owner owns or is a member of the event's list.
+-- Subscriptions created by internal callers have no owner and receive no
+-- events.
+-- events holds the subscribed event types (e.g. 'item.created', 'dead_letter.created').
+-- secret signs every payload with HMAC-SHA256; it is stored as-is because the
+-- worker needs it to sign.
+CREATE TABLE webhook_subscriptions (
+    id uuid PRIMARY KEY DEFAULT uuidv7(),
+    url text NOT NULL,
+    secret text NOT NULL,
+    events text[] NOT NULL CHECK (cardinality(events) > 0),
+    is_active boolean NOT NULL DEFAULT true,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    owner_id uuid
+);
+
+CREATE INDEX idx_webhook_subscriptions_owner_created ON webhook_subscriptions(owner_id, created_at);
+
+-- One row per (event, subscription). Doubles as the delivery queue, claimed by
+-- workers the same way as recurring_generation_jobs:
+--   pending -> running (claimed_by, available_at = NOW() + timeout)
+--   running -> delivered               (receiver answered 2xx)
+--   running -> pending                 (transient failure, available_at = retry time)
+--   running -> dead                    (permanent failure or retries exhausted)
+-- Dead deliveries stay for review and can be redelivered as a new row
+-- (redelivery_of), like retried dead_letter_jobs.
+CREATE TABLE webhook_deliveries (
+    id uuid PRIMARY KEY DEFAULT uuidv7(),
+    subscription_id uuid NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id uuid NOT NULL,
+    event_type text NOT NULL,
+    payload jsonb NOT NULL,
+    status text NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'running', 'delivered', 'dead')),
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text,
+    response_status integer,
+    claimed_by text,
+    available_at timestamptz,
+    delivered_at timestamptz,
+    redelivery_of uuid,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- Recent deliveries of a subscription
+CREATE INDEX idx_webhook_deliveries_subscription ON webhook_deliveries(subscription_id, created_at DESC);
+
+-- Claim scan: only deliveries that can still be sent
+CREATE INDEX idx_webhook_deliveries_claimable ON webhook_deliveries(created_at)
+    WHERE status IN ('pending', 'running');
+
+-- Webhooks are managed with their own scopes, webhooks:read and webhooks:write.
+-- Admin keys carry every scope; grant the new ones to existing admin keys.
+UPDATE api_keys
+SET scopes = scopes || ARRAY['webhooks:read', 'webhooks:write']
+WHERE 'admin' = ANY(scopes)
+  AND NOT ('webhooks:write' = ANY(scopes));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+UPDATE api_keys
+SET scopes = array_remove(array_remove(scopes, 'webhooks:read'), 'webhooks:write');
+
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
+
+-- +goose StatementEnd
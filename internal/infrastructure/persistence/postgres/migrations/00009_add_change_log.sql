@@ -0,0 +1,131 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Transactional change log (outbox).
+-- Every insert, update and delete of lists, items, templates and dead letter
+-- jobs appends a row here from a trigger, so the row commits or rolls back
+-- together with the change itself - no matter which repository method,
+-- batch statement or cascade made it.
+--
+-- snapshot: the entity after the change (before it, for deletes)
+-- diff:     for updates, the changed columns as {"column": {"old": ..., "new": ...}}
+-- version:  the entity's own version column, or the number of changes recorded
+--           for entities without one (dead letter jobs)
+--
+-- The worker relay publishes unpublished rows in seq order and stamps
+-- published_at. Changes to the same entity are ordered by seq because the
+-- second writer waits on the first writer's row lock.
+CREATE TABLE change_log (
+    seq bigserial PRIMARY KEY,
+    entity_type text NOT NULL
+        CHECK (entity_type IN ('list', 'item', 'template', 'dead_letter')),
+    entity_id uuid NOT NULL,
+    list_id uuid,
+    operation text NOT NULL
+        CHECK (operation IN ('created', 'updated', 'deleted')),
+    version integer NOT NULL,
+    snapshot jsonb NOT NULL,
+    diff jsonb,
+    occurred_at timestamptz NOT NULL DEFAULT now(),
+    published_at timestamptz
+);
+
+-- Relay scan: only rows still waiting to be published
+CREATE INDEX idx_change_log_unpublished ON change_log(seq)
+    WHERE published_at IS NULL;
+
+-- History of a single entity
+CREATE INDEX idx_change_log_entity ON change_log(entity_type, entity_id, seq);
+
+-- Records one change_log row per modified row.
+-- TG_ARGV[0] is the entity type. Updates that only touch updated_at are not recorded.
+CREATE OR REPLACE FUNCTION record_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    v_old jsonb;
+    v_new jsonb;
+    v_row jsonb;
+    v_diff jsonb;
+    v_version integer;
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        v_old := to_jsonb(OLD);
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        v_new := to_jsonb(NEW);
+    END IF;
+    v_row := COALESCE(v_new, v_old);
+
+    IF TG_OP = 'UPDATE' THEN
+        SELECT jsonb_object_agg(n.key, jsonb_build_object('old', v_old -> n.key, 'new', n.value))
+        INTO v_diff
+        FROM jsonb_each(v_new) n
+        WHERE n.key <> 'updated_at'
+          AND n.value IS DISTINCT FROM v_old -> n.key;
+
+        IF v_diff IS NULL THEN
+            RETURN NULL;
+        END IF;
+    END IF;
+
+    v_version := (v_row ->> 'version')::integer;
+    IF v_version IS NULL THEN
+        SELECT count(*) + 1 INTO v_version
+        FROM change_log
+        WHERE entity_type = TG_ARGV[0] AND entity_id = (v_row ->> 'id')::uuid;
+    END IF;
+
+    INSERT INTO change_log (entity_type, entity_id, list_id, operation, version, snapshot, diff)
+    VALUES (
+        TG_ARGV[0],
+        (v_row ->> 'id')::uuid,
+        CASE WHEN TG_ARGV[0] = 'list' THEN (v_row ->> 'id')::uuid ELSE (v_row ->> 'list_id')::uuid END,
+        CASE TG_OP WHEN 'INSERT' THEN 'created' WHEN 'UPDATE' THEN 'updated' ELSE 'deleted' END,
+        v_version,
+        v_row,
+        v_diff
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER record_todo_list_changes
+    AFTER INSERT OR UPDATE OR DELETE ON todo_lists
+    FOR EACH ROW
+    EXECUTE FUNCTION record_change('list');
+
+CREATE TRIGGER record_todo_item_changes
+    AFTER INSERT OR UPDATE OR DELETE ON todo_items
+    FOR EACH ROW
+    EXECUTE FUNCTION record_change('item');
+
+CREATE TRIGGER record_recurring_template_changes
+    AFTER INSERT OR UPDATE OR DELETE ON recurring_task_templates
+    FOR EACH ROW
+    EXECUTE FUNCTION record_change('template');
+
+CREATE TRIGGER record_dead_letter_changes
+    AFTER INSERT OR UPDATE OR DELETE ON dead_letter_jobs
+    FOR EACH ROW
+    EXECUTE FUNCTION record_change('dead_letter');
+
+-- The relay may publish a change more than once (crash between publishing and
+-- marking it published). Event ids are derived from the change, so fanning the
+-- same event out again must not queue a second delivery.
+CREATE UNIQUE INDEX idx_webhook_deliveries_event_once ON webhook_deliveries(subscription_id, event_id)
+    WHERE redelivery_of IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_webhook_deliveries_event_once;
+DROP TRIGGER IF EXISTS record_dead_letter_changes ON dead_letter_jobs;
+DROP TRIGGER IF EXISTS record_recurring_template_changes ON recurring_task_templates;
+DROP TRIGGER IF EXISTS record_todo_item_changes ON todo_items;
+DROP TRIGGER IF EXISTS record_todo_list_changes ON todo_lists;
+DROP FUNCTION IF EXISTS record_change();
+DROP TABLE IF EXISTS change_log;
+
+-- +goose StatementEnd
\ No newline at end of file
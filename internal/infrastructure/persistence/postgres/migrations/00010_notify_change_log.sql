@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Announce every change log row on the change_log channel so event streams
+-- can push changes instead of polling. The payload is the row's seq;
+-- notifications are delivered when the writing transaction commits.
+CREATE OR REPLACE FUNCTION notify_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('change_log', NEW.seq::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER notify_change_log_insert
+    AFTER INSERT ON change_log
+    FOR EACH ROW
+    EXECUTE FUNCTION notify_change();
+
+-- Event stream catch-up: changes of one list after a seq
+CREATE INDEX idx_change_log_list_seq ON change_log(list_id, seq);
+
+-- Event streams read the change log in (txid, seq) order rather than by seq
+-- alone. seq is assigned when a row is written, not when its transaction
+-- commits, so a stream that remembered the highest seq it sent would skip a
+-- lower seq committing later. Streams only read changes of transactions older
+-- than the oldest one still running (the snapshot xmin): those are all
+-- finished, so nothing can appear behind a position after it was sent.
+ALTER TABLE change_log ADD COLUMN txid xid8 NOT NULL DEFAULT pg_current_xact_id();
+
+CREATE INDEX idx_change_log_txid_seq ON change_log(txid, seq);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_change_log_txid_seq;
+ALTER TABLE change_log DROP COLUMN IF EXISTS txid;
+DROP INDEX IF EXISTS idx_change_log_list_seq;
+DROP TRIGGER IF EXISTS notify_change_log_insert ON change_log;
+DROP FUNCTION IF EXISTS notify_change();
+
+-- +goose StatementEnd
@@ -0,0 +1,65 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Delta sync reads the change log in (txid, seq) order, like event streams.
+-- Rows written before the change log existed have no change to sync from.
+-- Record them as created; they are not published as events.
+INSERT INTO change_log (entity_type, entity_id, list_id, operation, version, snapshot, published_at)
+SELECT 'list', l.id, l.id, 'created', l.version, to_jsonb(l), now()
+FROM todo_lists l
+WHERE NOT EXISTS (SELECT 1 FROM change_log cl WHERE cl.entity_type = 'list' AND cl.entity_id = l.id);
+
+INSERT INTO change_log (entity_type, entity_id, list_id, operation, version, snapshot, published_at)
+SELECT 'item', i.id, i.list_id, 'created', i.version, to_jsonb(i), now()
+FROM todo_items i
+WHERE NOT EXISTS (SELECT 1 FROM change_log cl WHERE cl.entity_type = 'item' AND cl.entity_id = i.id);
+
+INSERT INTO change_log (entity_type, entity_id, list_id, operation, version, snapshot, published_at)
+SELECT 'template', t.id, t.list_id, 'created', t.version, to_jsonb(t), now()
+FROM recurring_task_templates t
+WHERE NOT EXISTS (SELECT 1 FROM change_log cl WHERE cl.entity_type = 'template' AND cl.entity_id = t.id);
+
+-- Delta sync has to tell a member when it loses access to a shared list: the
+-- list, its items and templates still exist, so no deletion is recorded.
+-- Removing a membership records a 'revoked' change of the list. Its snapshot
+-- is the removed membership, so sync shows it to that principal only.
+-- Revocations are not events: they are recorded as already published.
+ALTER TABLE change_log DROP CONSTRAINT change_log_operation_check;
+ALTER TABLE change_log ADD CONSTRAINT change_log_operation_check
+    CHECK (operation IN ('created', 'updated', 'deleted', 'revoked'));
+
+CREATE OR REPLACE FUNCTION record_membership_revoked()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO change_log (entity_type, entity_id, list_id, operation, version, snapshot, published_at)
+    VALUES (
+        'list',
+        OLD.list_id,
+        OLD.list_id,
+        'revoked',
+        COALESCE((SELECT version FROM todo_lists WHERE id = OLD.list_id), 0),
+        to_jsonb(OLD),
+        now()
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER record_list_member_revocations
+    AFTER DELETE ON list_members
+    FOR EACH ROW
+    EXECUTE FUNCTION record_membership_revoked();
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TRIGGER IF EXISTS record_list_member_revocations ON list_members;
+DROP FUNCTION IF EXISTS record_membership_revoked();
+DELETE FROM change_log WHERE operation = 'revoked';
+ALTER TABLE change_log DROP CONSTRAINT change_log_operation_check;
+ALTER TABLE change_log ADD CONSTRAINT change_log_operation_check
+    CHECK (operation IN ('created', 'updated', 'deleted'));
+
+-- +goose StatementEnd
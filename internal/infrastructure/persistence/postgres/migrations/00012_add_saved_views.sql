@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Saved views: named item searches a principal runs again and again.
+-- definition holds the filters, sort and due window as JSON. Relative due
+-- bounds ("due within 7 days") are stored as day offsets and resolved when the
+-- view is run, never when it is saved.
+--
+-- Views belong to the tenant that saved them (owner_id, NULL for internal
+-- callers). A view over one list is removed together with the list;
+-- list_id NULL searches every list the owner can access.
+CREATE TABLE saved_views (
+    id uuid PRIMARY KEY DEFAULT uuidv7(),
+    owner_id uuid,
+    name text NOT NULL,
+    list_id uuid REFERENCES todo_lists(id) ON DELETE CASCADE,
+    definition jsonb NOT NULL DEFAULT '{}'::jsonb,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_saved_views_owner_created ON saved_views(owner_id, created_at);
+CREATE INDEX idx_saved_views_list ON saved_views(list_id) WHERE list_id IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS saved_views;
+
+-- +goose StatementEnd
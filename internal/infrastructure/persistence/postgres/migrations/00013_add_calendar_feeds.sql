@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Calendar feeds: read-only iCalendar subscriptions to the items of a list.
+-- Calendar apps cannot send an Authorization header, so a feed is fetched with
+-- a secret token in its URL. Only the SHA-256 hash of the token is stored.
+--
+-- Feeds belong to the tenant that created them (owner_id, NULL for internal
+-- callers) and read items with that tenant's access. A feed is removed
+-- together with its list.
+CREATE TABLE calendar_feeds (
+    id uuid PRIMARY KEY DEFAULT uuidv7(),
+    owner_id uuid,
+    list_id uuid NOT NULL REFERENCES todo_lists(id) ON DELETE CASCADE,
+    name text NOT NULL DEFAULT '',
+    statuses text[] NOT NULL DEFAULT '{}',
+    tags text[] NOT NULL DEFAULT '{}',
+    token_hash text NOT NULL UNIQUE,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_calendar_feeds_list_created ON calendar_feeds(list_id, created_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS calendar_feeds;
+
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Import records: which external IDs (e.g. iCalendar UIDs) were imported into
+-- a list, so importing the same file again skips them.
+--
+-- A record outlives the item or template it created: deleting an imported item
+-- clears item_id but keeps the record, so a re-import does not bring it back.
+-- Records are removed together with their list.
+CREATE TABLE import_records (
+    list_id uuid NOT NULL REFERENCES todo_lists(id) ON DELETE CASCADE,
+    external_id text NOT NULL,
+    item_id uuid REFERENCES todo_items(id) ON DELETE SET NULL,
+    template_id uuid REFERENCES recurring_task_templates(id) ON DELETE SET NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (list_id, external_id)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS import_records;
+
+-- +goose StatementEnd
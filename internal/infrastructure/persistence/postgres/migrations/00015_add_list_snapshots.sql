@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Daily list snapshots: item counts of a list per UTC day, for burndown and
+-- cumulative flow charts. The snapshot worker records the current counts of
+-- every list on each run, so the row of a day holds the counts of the last run
+-- that day.
+--
+-- status_counts maps each status to its item count; priority_counts maps the
+-- priority of open items to their count, with "" for items without priority.
+-- overdue_count counts open items due before recorded_at.
+CREATE TABLE list_snapshots (
+    list_id uuid NOT NULL REFERENCES todo_lists(id) ON DELETE CASCADE,
+    day date NOT NULL,
+    status_counts jsonb NOT NULL DEFAULT '{}',
+    priority_counts jsonb NOT NULL DEFAULT '{}',
+    overdue_count integer NOT NULL DEFAULT 0,
+    recorded_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (list_id, day)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS list_snapshots;
+
+-- +goose StatementEnd
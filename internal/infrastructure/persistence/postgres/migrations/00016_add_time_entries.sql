@@ -0,0 +1,114 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Time entries: time a principal tracked on an item. A timer is an entry
+-- without ended_at; each principal has at most one running timer.
+CREATE TABLE time_entries (
+    id uuid PRIMARY KEY,
+    item_id uuid NOT NULL REFERENCES todo_items(id) ON DELETE CASCADE,
+    list_id uuid NOT NULL REFERENCES todo_lists(id) ON DELETE CASCADE,
+    principal_id uuid NOT NULL,
+    started_at timestamptz NOT NULL,
+    ended_at timestamptz,
+    notes text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    CHECK (ended_at IS NULL OR ended_at >= started_at)
+);
+
+CREATE INDEX idx_time_entries_item ON time_entries (item_id, started_at);
+CREATE INDEX idx_time_entries_principal ON time_entries (principal_id, started_at);
+CREATE UNIQUE INDEX idx_time_entries_running_timer ON time_entries (principal_id) WHERE ended_at IS NULL;
+
+-- actual_duration is the time tracked in finished entries. Items without
+-- entries keep the time spent in in_progress, derived from status history.
+CREATE OR REPLACE FUNCTION calculate_actual_duration(p_task_id uuid)
+RETURNS INTERVAL AS $$
+DECLARE
+    v_total_duration INTERVAL := INTERVAL '0';
+    v_tracked INTERVAL;
+    rec RECORD;
+BEGIN
+    SELECT SUM(ended_at - started_at) INTO v_tracked
+    FROM time_entries
+    WHERE item_id = p_task_id AND ended_at IS NOT NULL;
+    IF v_tracked IS NOT NULL THEN
+        RETURN v_tracked;
+    END IF;
+
+    FOR rec IN (
+        SELECT
+            changed_at as start_time,
+            LEAD(changed_at) OVER (ORDER BY changed_at) as end_time,
+            to_status,
+            LEAD(to_status) OVER (ORDER BY changed_at) as next_status
+        FROM task_status_history
+        WHERE task_id = p_task_id
+        ORDER BY changed_at
+    ) LOOP
+        IF rec.to_status = 'in_progress' AND rec.next_status IS NOT NULL THEN
+            v_total_duration := v_total_duration + (rec.end_time - rec.start_time);
+        END IF;
+    END LOOP;
+
+    RETURN v_total_duration;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Recompute actual_duration of the item whenever its entries change.
+-- The version is bumped like any other change to the item.
+CREATE OR REPLACE FUNCTION update_actual_duration_on_time_entry_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    v_item_id uuid := COALESCE(NEW.item_id, OLD.item_id);
+    v_duration INTERVAL := calculate_actual_duration(v_item_id);
+BEGIN
+    UPDATE todo_items
+    SET actual_duration = v_duration,
+        version = version + 1
+    WHERE id = v_item_id AND actual_duration IS DISTINCT FROM v_duration;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER track_time_entry_changes
+    AFTER INSERT OR UPDATE OR DELETE ON time_entries
+    FOR EACH ROW
+    EXECUTE FUNCTION update_actual_duration_on_time_entry_change();
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TRIGGER IF EXISTS track_time_entry_changes ON time_entries;
+DROP FUNCTION IF EXISTS update_actual_duration_on_time_entry_change();
+DROP TABLE IF EXISTS time_entries;
+
+CREATE OR REPLACE FUNCTION calculate_actual_duration(p_task_id uuid)
+RETURNS INTERVAL AS $$
+DECLARE
+    v_total_duration INTERVAL := INTERVAL '0';
+    rec RECORD;
+BEGIN
+    FOR rec IN (
+        SELECT
+            changed_at as start_time,
+            LEAD(changed_at) OVER (ORDER BY changed_at) as end_time,
+            to_status,
+            LEAD(to_status) OVER (ORDER BY changed_at) as next_status
+        FROM task_status_history
+        WHERE task_id = p_task_id
+        ORDER BY changed_at
+    ) LOOP
+        IF rec.to_status = 'in_progress' AND rec.next_status IS NOT NULL THEN
+            v_total_duration := v_total_duration + (rec.end_time - rec.start_time);
+        END IF;
+    END LOOP;
+
+    RETURN v_total_duration;
+END;
+$$ LANGUAGE plpgsql;
+
+-- +goose StatementEnd
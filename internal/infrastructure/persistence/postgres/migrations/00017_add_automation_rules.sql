@@ -0,0 +1,72 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Automation rules: per-list "when <trigger>, if <condition>, do <actions>".
+-- The trigger settings are columns so the worker can find overdue items in SQL:
+--   trigger_status  item.status_changed: only changes to this status (NULL = any)
+--   trigger_tag     item.tag_added: only this tag (NULL = any)
+--   overdue_by      item.overdue: how long past due_at the rule fires
+-- condition holds the item filters as JSON, like the definition of saved views;
+-- actions holds the list of actions as JSON.
+-- owner_id is the tenant that last created or updated the rule. Its actions
+-- are checked against that tenant's current access to the lists involved, so
+-- a rule stops acting once its owner loses access. Rules without an owner were
+-- set up by internal callers and run unrestricted.
+CREATE TABLE automation_rules (
+    id uuid PRIMARY KEY,
+    list_id uuid NOT NULL REFERENCES todo_lists(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    enabled boolean NOT NULL DEFAULT true,
+    trigger_type text NOT NULL
+        CHECK (trigger_type IN ('item.created', 'item.status_changed', 'item.tag_added', 'item.overdue')),
+    trigger_status text,
+    trigger_tag text,
+    overdue_by interval,
+    condition jsonb NOT NULL DEFAULT '{}'::jsonb,
+    actions jsonb NOT NULL DEFAULT '[]'::jsonb,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    owner_id uuid,
+    CHECK ((trigger_type = 'item.overdue') = (overdue_by IS NOT NULL))
+);
+
+CREATE INDEX idx_automation_rules_list ON automation_rules (list_id, trigger_type) WHERE enabled;
+
+-- Execution log: one row per rule that fired, whether its actions ran, failed
+-- or were skipped by loop protection. item_id has no foreign key so the log
+-- outlives deleted items.
+CREATE TABLE automation_rule_runs (
+    id uuid PRIMARY KEY,
+    rule_id uuid NOT NULL REFERENCES automation_rules(id) ON DELETE CASCADE,
+    list_id uuid NOT NULL REFERENCES todo_lists(id) ON DELETE CASCADE,
+    item_id uuid NOT NULL,
+    trigger_type text NOT NULL,
+    depth integer NOT NULL,
+    status text NOT NULL CHECK (status IN ('succeeded', 'failed', 'skipped')),
+    actions text[] NOT NULL DEFAULT '{}',
+    error text,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_automation_rule_runs_rule ON automation_rule_runs (rule_id, created_at DESC);
+
+-- Overdue rules fire once per item and due date. Postponing an item gives it
+-- a new due date, so it fires again when that one passes.
+CREATE TABLE automation_overdue_fires (
+    rule_id uuid NOT NULL REFERENCES automation_rules(id) ON DELETE CASCADE,
+    item_id uuid NOT NULL REFERENCES todo_items(id) ON DELETE CASCADE,
+    due_at timestamptz NOT NULL,
+    fired_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (rule_id, item_id, due_at)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS automation_overdue_fires;
+DROP TABLE IF EXISTS automation_rule_runs;
+DROP TABLE IF EXISTS automation_rules;
+
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- snoozed_until hides an item from default item listings until that time,
+-- without touching its schedule. starts_at is a date and cannot express
+-- "hidden until Monday 9:00", so the snooze is its own timestamp.
+-- The worker clears it once it has passed; the change log records that as
+-- the item waking.
+ALTER TABLE todo_items ADD COLUMN snoozed_until timestamptz;
+
+-- Worker scan for items due to wake
+CREATE INDEX idx_todo_items_snoozed_until ON todo_items(snoozed_until)
+    WHERE snoozed_until IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_todo_items_snoozed_until;
+ALTER TABLE todo_items DROP COLUMN IF EXISTS snoozed_until;
+
+-- +goose StatementEnd
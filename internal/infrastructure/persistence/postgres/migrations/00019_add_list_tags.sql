@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Tag registry: how the tags of a list are displayed. Items and templates keep
+-- storing tags as free-form text arrays; registering a tag does not restrict
+-- which tags they use, and a tag can be used without being registered.
+-- color is a #rrggbb hex color.
+CREATE TABLE list_tags (
+    list_id uuid NOT NULL REFERENCES todo_lists(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    color text CHECK (color ~ '^#[0-9a-f]{6}$'),
+    description text,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (list_id, name)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS list_tags;
+
+-- +goose StatementEnd
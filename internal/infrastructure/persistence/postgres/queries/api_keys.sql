@@ -1,6 +1,6 @@
 -- name: CreateAPIKey :exec
-INSERT INTO api_keys (id, key_type, service, version, short_token, long_secret_hash, name, is_active, created_at, expires_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+INSERT INTO api_keys (id, key_type, service, version, short_token, long_secret_hash, name, is_active, created_at, expires_at, owner_id, scopes)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
 
 -- name: GetAPIKeyByShortToken :one
 -- SECURITY: Intentionally does NOT filter by expires_at to prevent timing attacks.
@@ -0,0 +1,99 @@
+-- Automation Rules
+-- ================
+-- Rules belong to a list; access is checked by the service against the list.
+
+-- name: CreateAutomationRule :one
+INSERT INTO automation_rules (
+    id, list_id, name, enabled, trigger_type, trigger_status, trigger_tag, overdue_by,
+    condition, actions, created_at, updated_at, owner_id
+)
+VALUES (
+    sqlc.arg(id), sqlc.arg(list_id), sqlc.arg(name), sqlc.arg(enabled), sqlc.arg(trigger_type),
+    sqlc.narg(trigger_status), sqlc.narg(trigger_tag), sqlc.narg(overdue_by),
+    sqlc.arg(condition), sqlc.arg(actions), sqlc.arg(created_at), sqlc.arg(created_at), sqlc.narg(owner_id)
+)
+RETURNING *;
+
+-- name: GetAutomationRule :one
+SELECT * FROM automation_rules
+WHERE id = $1 AND list_id = $2;
+
+-- name: ListAutomationRules :many
+SELECT * FROM automation_rules
+WHERE list_id = $1
+ORDER BY created_at ASC, id ASC;
+
+-- name: ListEnabledAutomationRules :many
+-- Rules of a list that fire on a trigger, in the order they run.
+SELECT * FROM automation_rules
+WHERE list_id = $1 AND trigger_type = $2 AND enabled
+ORDER BY created_at ASC, id ASC;
+
+-- name: CountAutomationRules :one
+SELECT COUNT(*) FROM automation_rules
+WHERE list_id = $1;
+
+-- name: UpdateAutomationRule :one
+UPDATE automation_rules
+SET name = sqlc.arg(name),
+    enabled = sqlc.arg(enabled),
+    trigger_type = sqlc.arg(trigger_type),
+    trigger_status = sqlc.narg(trigger_status),
+    trigger_tag = sqlc.narg(trigger_tag),
+    overdue_by = sqlc.narg(overdue_by),
+    condition = sqlc.arg(condition),
+    actions = sqlc.arg(actions),
+    updated_at = sqlc.arg(updated_at),
+    owner_id = sqlc.narg(owner_id)
+WHERE id = sqlc.arg(id) AND list_id = sqlc.arg(list_id)
+RETURNING *;
+
+-- name: DeleteAutomationRule :execrows
+-- DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+DELETE FROM automation_rules
+WHERE id = $1 AND list_id = $2;
+
+-- Execution Log
+-- =============
+
+-- name: CreateAutomationRuleRun :exec
+INSERT INTO automation_rule_runs (
+    id, rule_id, list_id, item_id, trigger_type, depth, status, actions, error, created_at
+)
+VALUES (
+    sqlc.arg(id), sqlc.arg(rule_id), sqlc.arg(list_id), sqlc.arg(item_id), sqlc.arg(trigger_type),
+    sqlc.arg(depth), sqlc.arg(status), sqlc.arg(actions), sqlc.narg(error), sqlc.arg(created_at)
+);
+
+-- name: ListAutomationRuleRuns :many
+-- Most recent runs of a rule, newest first.
+SELECT * FROM automation_rule_runs
+WHERE rule_id = sqlc.arg(rule_id)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- Overdue Triggers
+-- ================
+
+-- name: ListOverdueRuleCandidates :many
+-- Open items that have been overdue for at least the overdue_by of an enabled
+-- rule of their list, and that the rule has not fired for at their due date yet.
+SELECT r.id AS rule_id, r.list_id, i.id AS item_id, i.due_at
+FROM automation_rules r
+JOIN todo_items i ON i.list_id = r.list_id
+WHERE r.enabled
+  AND r.trigger_type = 'item.overdue'
+  AND i.status IN ('todo', 'in_progress', 'blocked')
+  AND i.due_at + r.overdue_by <= sqlc.arg(now)::timestamptz
+  AND NOT EXISTS (
+      SELECT 1 FROM automation_overdue_fires f
+      WHERE f.rule_id = r.id AND f.item_id = i.id AND f.due_at = i.due_at
+  )
+ORDER BY i.due_at ASC, r.id ASC, i.id ASC
+LIMIT sqlc.arg(row_limit);
+
+-- name: RecordOverdueRuleFire :execrows
+-- Returns 0 rows when the rule already fired for the item at this due date.
+INSERT INTO automation_overdue_fires (rule_id, item_id, due_at, fired_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,26 @@
+-- Calendar Feeds
+-- ==============
+-- owner_id narg: restricts feeds to one tenant (NULL = unscoped internal access)
+
+-- name: CreateCalendarFeed :one
+INSERT INTO calendar_feeds (id, owner_id, list_id, name, statuses, tags, token_hash, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetCalendarFeedByTokenHash :one
+-- Feed requests carry no principal: the token is the credential
+SELECT * FROM calendar_feeds
+WHERE token_hash = sqlc.arg(token_hash);
+
+-- name: ListCalendarFeeds :many
+SELECT * FROM calendar_feeds
+WHERE list_id = sqlc.arg(list_id)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR owner_id = sqlc.narg('owner_id')::uuid)
+ORDER BY created_at ASC, id ASC;
+
+-- name: DeleteCalendarFeed :execrows
+-- DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+DELETE FROM calendar_feeds
+WHERE id = sqlc.arg(id)
+  AND list_id = sqlc.arg(list_id)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR owner_id = sqlc.narg('owner_id')::uuid);
@@ -0,0 +1,62 @@
+-- Change Log - Transactional Outbox
+-- =================================
+-- Rows are written by the record_change() trigger; the application only reads
+-- and publishes them.
+
+-- name: ListUnpublishedChanges :many
+-- Oldest unpublished changes first, so the relay publishes in commit order per entity.
+SELECT * FROM change_log
+WHERE published_at IS NULL
+ORDER BY seq ASC
+LIMIT $1;
+
+-- name: MarkChangesPublished :execrows
+UPDATE change_log
+SET published_at = NOW()
+WHERE seq = ANY(sqlc.arg(seqs)::bigint[])
+  AND published_at IS NULL;
+
+-- name: ListChangesForFeed :many
+-- Changes visible to an event stream, in (txid, seq) order.
+--   watermark:            only transactions older than this (from GetChangeWatermark)
+--   after_txid/after_seq: only changes after this position
+--   list_id:              one list, or NULL for every list the owner can access
+--   owner_id:             restricts to lists owned by or shared with one tenant (NULL = unscoped internal access)
+--   entity_types:         entity types to include
+SELECT cl.* FROM change_log cl
+WHERE cl.txid < sqlc.arg(watermark)::xid8
+  AND (cl.txid, cl.seq) > (sqlc.arg(after_txid)::xid8, sqlc.arg(after_seq)::bigint)
+  AND cl.entity_type = ANY(sqlc.arg(entity_types)::text[])
+  AND (sqlc.narg(list_id)::uuid IS NULL OR cl.list_id = sqlc.narg(list_id)::uuid)
+  AND (sqlc.narg(owner_id)::uuid IS NULL OR list_visible_to(cl.list_id, sqlc.narg(owner_id)::uuid))
+ORDER BY cl.txid ASC, cl.seq ASC
+LIMIT sqlc.arg(page_limit);
+
+-- name: GetChangeWatermark :one
+-- Oldest transaction still running. Every change of an older transaction has
+-- committed or rolled back, so a reader of only those cannot be overtaken.
+SELECT pg_snapshot_xmin(pg_current_snapshot())::xid8 AS watermark;
+
+-- name: ListChangesForSync :many
+-- Changes to lists, items and templates for delta sync, in (txid, seq) order.
+--   watermark:            only transactions older than this (from GetChangeWatermark)
+--   after_txid/after_seq: only changes after this position
+--   owner_id:             restricts to changes one tenant can see (NULL = unscoped internal access):
+--                         changes in or moving out of lists owned by or shared with it,
+--                         and revocations of its memberships
+-- previous_list_id is the list an item or template was moved out of, if the change moved it.
+SELECT cl.seq, cl.txid, cl.entity_type, cl.entity_id, cl.list_id, cl.operation,
+    (cl.diff -> 'list_id' ->> 'old')::uuid AS previous_list_id
+FROM change_log cl
+WHERE cl.entity_type IN ('list', 'item', 'template')
+  AND cl.txid < sqlc.arg(watermark)::xid8
+  AND (cl.txid, cl.seq) > (sqlc.arg(after_txid)::xid8, sqlc.arg(after_seq)::bigint)
+  AND (sqlc.narg(owner_id)::uuid IS NULL
+    OR (cl.operation = 'revoked' AND (cl.snapshot ->> 'principal_id')::uuid = sqlc.narg(owner_id)::uuid)
+    OR (cl.operation <> 'revoked' AND EXISTS (
+        SELECT 1 FROM todo_lists tl
+        WHERE tl.id IN (cl.list_id, (cl.diff -> 'list_id' ->> 'old')::uuid)
+          AND list_visible_to(tl.id, sqlc.narg(owner_id)::uuid)
+    )))
+ORDER BY cl.txid ASC, cl.seq ASC
+LIMIT sqlc.arg(page_limit);
@@ -13,15 +13,27 @@ INSERT INTO dead_letter_jobs (
 -- name: ListPendingDeadLetterJobs :many
 -- Retrieve unresolved dead letter jobs for admin review.
 -- Ordered by failure time (most recent first).
+-- TENANCY: owner_id restricts to jobs of templates in lists owned by or shared with one tenant (NULL = unscoped internal access)
 SELECT * FROM dead_letter_jobs
 WHERE resolution IS NULL
+  AND (sqlc.narg(owner_id)::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM recurring_task_templates t
+      WHERE t.id = dead_letter_jobs.template_id
+        AND list_visible_to(t.list_id, sqlc.narg(owner_id)::uuid)
+  ))
 ORDER BY failed_at DESC
-LIMIT $1;
+LIMIT sqlc.arg(page_limit);
 
 -- name: GetDeadLetterJob :one
 -- Retrieve a specific dead letter job by ID.
+-- TENANCY: owner_id restricts to jobs of templates in lists owned by or shared with one tenant (NULL = unscoped internal access)
 SELECT * FROM dead_letter_jobs
-WHERE id = $1;
+WHERE id = sqlc.arg(id)
+  AND (sqlc.narg(owner_id)::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM recurring_task_templates t
+      WHERE t.id = dead_letter_jobs.template_id
+        AND list_visible_to(t.list_id, sqlc.narg(owner_id)::uuid)
+  ));
 
 -- name: MarkDeadLetterAsRetried :execrows
 -- Mark a dead letter job as retried by admin.
@@ -33,12 +45,18 @@ WHERE id = $1 AND resolution IS NULL;
 
 -- name: MarkDeadLetterAsDiscarded :execrows
 -- Mark a dead letter job as discarded with admin note.
+-- TENANCY: owner_id restricts to jobs of templates in lists owned by or shared with one tenant (NULL = unscoped internal access)
 UPDATE dead_letter_jobs
 SET resolution = 'discarded',
     reviewed_at = NOW(),
-    reviewed_by = $2,
-    reviewer_note = $3
-WHERE id = $1 AND resolution IS NULL;
+    reviewed_by = sqlc.narg(reviewed_by),
+    reviewer_note = sqlc.narg(reviewer_note)
+WHERE id = sqlc.arg(id) AND resolution IS NULL
+  AND (sqlc.narg(owner_id)::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM recurring_task_templates t
+      WHERE t.id = dead_letter_jobs.template_id
+        AND list_visible_to(t.list_id, sqlc.narg(owner_id)::uuid)
+  ));
 
 -- name: DeleteResolvedDeadLetterJobs :execrows
 -- Cleanup old resolved dead letter jobs (housekeeping).
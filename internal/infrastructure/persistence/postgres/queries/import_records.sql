@@ -0,0 +1,14 @@
+-- Import Records
+-- ==============
+-- Records are keyed by list; the service checks list access before reading or writing them.
+
+-- name: CreateImportRecord :execrows
+-- Idempotent by (list_id, external_id): 0 rows means the ID was already imported
+INSERT INTO import_records (list_id, external_id, item_id, template_id, created_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (list_id, external_id) DO NOTHING;
+
+-- name: FindImportRecords :many
+SELECT * FROM import_records
+WHERE list_id = sqlc.arg(list_id)
+  AND external_id = ANY(sqlc.arg(external_ids)::text[]);
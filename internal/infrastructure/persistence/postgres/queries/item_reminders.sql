@@ -0,0 +1,147 @@
+-- Item Reminders - Delivery Queue
+-- ================================
+-- fire_at: WHEN the reminder is due (resolved from remind_at or the item's anchor time)
+--   - Kept in sync with the item by the reschedule_item_reminders trigger
+--   - NULL for relative reminders whose anchor is not set: never claimed
+--
+-- available_at: WHEN the reminder can be claimed (availability window)
+--   - For pending reminders: NULL (claimable at fire_at) or the retry time after a failure
+--   - For running reminders: NOW() + timeout, extended by heartbeats
+--   - Enables stuck reminder recovery: if a worker crashes, the reminder is reclaimed
+--     once available_at <= NOW(), same as recurring_generation_jobs
+
+-- name: CreateItemReminder :one
+-- Resolves fire_at from the item row in the same statement so a concurrent
+-- due/start time change cannot leave the reminder with a stale fire time.
+-- Returns pgx.ErrNoRows when the item does not exist.
+INSERT INTO item_reminders (
+    id, item_id, remind_at, anchor, offset_before, fire_at, status, created_at, updated_at
+)
+SELECT
+    sqlc.arg(id), i.id, sqlc.narg(remind_at), sqlc.narg(anchor), sqlc.narg(offset_before),
+    COALESCE(
+        sqlc.narg(remind_at)::timestamptz,
+        reminder_fire_at(sqlc.narg(anchor)::text, sqlc.narg(offset_before)::interval, i.due_at, i.starts_at, i.timezone)
+    ),
+    'pending', sqlc.arg(created_at), sqlc.arg(created_at)
+FROM todo_items i
+WHERE i.id = sqlc.arg(item_id)
+RETURNING *;
+
+-- name: ListItemReminders :many
+SELECT * FROM item_reminders
+WHERE item_id = $1
+ORDER BY fire_at ASC NULLS LAST, id ASC;
+
+-- name: CountItemReminders :one
+SELECT COUNT(*) FROM item_reminders
+WHERE item_id = $1;
+
+-- name: DeleteItemReminder :execrows
+-- DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+DELETE FROM item_reminders
+WHERE id = $1 AND item_id = $2;
+
+-- name: ClaimNextReminder :one
+-- Atomically claim the next due reminder using SKIP LOCKED.
+-- Covers two scenarios:
+--   1. Pending reminders that are due (fire_at <= NOW) and not waiting for a retry
+--   2. Running reminders past their availability timeout (stuck workers)
+UPDATE item_reminders
+SET status = 'running',
+    claimed_by = sqlc.arg(claimed_by),
+    available_at = sqlc.arg(available_at),
+    updated_at = NOW()
+WHERE item_reminders.id = (
+    SELECT r.id FROM item_reminders r
+    WHERE (r.status = 'pending' AND r.fire_at <= NOW() AND (r.available_at IS NULL OR r.available_at <= NOW()))
+       OR (r.status = 'running' AND r.available_at <= NOW())
+    ORDER BY r.fire_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: ExtendReminderAvailability :execrows
+-- Heartbeat: only succeeds if the reminder is still owned by the worker.
+UPDATE item_reminders
+SET available_at = $3
+WHERE id = $1 AND claimed_by = $2 AND status = 'running';
+
+-- name: CompleteReminder :execrows
+-- Mark a reminder as sent or skipped, but only if still owned by the worker.
+UPDATE item_reminders
+SET status = sqlc.arg(status),
+    sent_at = sqlc.narg(sent_at),
+    last_error = NULL,
+    claimed_by = NULL,
+    available_at = NULL,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND claimed_by = sqlc.arg(claimed_by) AND status = 'running';
+
+-- name: ScheduleReminderRetry :execrows
+-- Release a reminder for another attempt at available_at.
+UPDATE item_reminders
+SET status = 'pending',
+    retry_count = sqlc.arg(retry_count),
+    last_error = sqlc.arg(last_error),
+    claimed_by = NULL,
+    available_at = sqlc.arg(available_at),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND claimed_by = sqlc.arg(claimed_by) AND status = 'running';
+
+-- name: MarkReminderDead :execrows
+-- Park a reminder that failed permanently or exhausted its retries, and record
+-- it in dead_letter_reminders in the same statement.
+WITH dead AS (
+    UPDATE item_reminders
+    SET status = 'dead',
+        retry_count = sqlc.arg(retry_count),
+        last_error = sqlc.arg(last_error),
+        claimed_by = NULL,
+        available_at = NULL,
+        updated_at = NOW()
+    WHERE item_reminders.id = sqlc.arg(id)
+      AND item_reminders.claimed_by = sqlc.arg(claimed_by)
+      AND item_reminders.status = 'running'
+    RETURNING item_reminders.id, item_reminders.item_id, item_reminders.retry_count, item_reminders.last_error
+)
+INSERT INTO dead_letter_reminders (reminder_id, item_id, error_type, error_message, retry_count, last_worker_id)
+SELECT dead.id, dead.item_id, sqlc.arg(error_type), dead.last_error, dead.retry_count, sqlc.arg(claimed_by)
+FROM dead;
+
+-- name: ListDeadLetterReminders :many
+-- Unresolved dead letter reminders, newest first.
+-- TENANCY: owner_id restricts to items in lists owned by or shared with one tenant (NULL = unscoped internal access)
+SELECT d.*, i.list_id FROM dead_letter_reminders d
+JOIN todo_items i ON i.id = d.item_id
+WHERE d.resolution IS NULL
+  AND (sqlc.narg(owner_id)::uuid IS NULL OR list_visible_to(i.list_id, sqlc.narg(owner_id)::uuid))
+ORDER BY d.failed_at DESC, d.id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: ResolveDeadLetterReminder :one
+-- Mark an unresolved dead letter reminder as retried or discarded.
+-- Returns pgx.ErrNoRows when the entry does not exist, is resolved or belongs to another tenant.
+-- TENANCY: owner_id restricts to items in lists owned by or shared with one tenant (NULL = unscoped internal access)
+UPDATE dead_letter_reminders d
+SET resolution = sqlc.arg(resolution)::text,
+    reviewed_at = NOW(),
+    reviewer_note = sqlc.narg(reviewer_note)
+FROM todo_items i
+WHERE d.id = sqlc.arg(id)
+  AND d.resolution IS NULL
+  AND i.id = d.item_id
+  AND (sqlc.narg(owner_id)::uuid IS NULL OR list_visible_to(i.list_id, sqlc.narg(owner_id)::uuid))
+RETURNING d.*, i.list_id;
+
+-- name: RearmDeadReminder :exec
+-- Release a dead reminder for immediate delivery with a fresh retry budget.
+UPDATE item_reminders
+SET status = 'pending',
+    retry_count = 0,
+    last_error = NULL,
+    claimed_by = NULL,
+    available_at = NULL,
+    updated_at = NOW()
+WHERE id = $1 AND status = 'dead';
@@ -0,0 +1,31 @@
+-- name: GetListRole :one
+-- Returns the role of a principal on a list: 'owner' for the list owner,
+-- the membership role for members. No row when the principal has no access.
+SELECT (CASE WHEN tl.owner_id = sqlc.arg(principal_id)::uuid THEN 'owner' ELSE lm.role END)::text AS role
+FROM todo_lists tl
+LEFT JOIN list_members lm ON lm.list_id = tl.id AND lm.principal_id = sqlc.arg(principal_id)::uuid
+WHERE tl.id = sqlc.arg(list_id)
+  AND (tl.owner_id = sqlc.arg(principal_id)::uuid OR lm.principal_id IS NOT NULL);
+
+-- name: ListListMembers :many
+SELECT * FROM list_members
+WHERE list_id = $1
+ORDER BY created_at ASC, principal_id ASC;
+
+-- name: CreateListMember :one
+-- Unique violation on (list_id, principal_id) means the principal is already a member.
+INSERT INTO list_members (list_id, principal_id, role, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: UpdateListMemberRole :one
+-- No row returned when the principal is not a member of the list.
+UPDATE list_members
+SET role = $3, updated_at = $4
+WHERE list_id = $1 AND principal_id = $2
+RETURNING *;
+
+-- name: DeleteListMember :execrows
+-- DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+DELETE FROM list_members
+WHERE list_id = $1 AND principal_id = $2;
@@ -0,0 +1,45 @@
+-- name: RecordListSnapshots :many
+-- Records the current item counts of a batch of lists as their snapshot of day,
+-- replacing an earlier snapshot of the same day. Lists are taken in ID order
+-- after after_list_id (NULL = from the first list); returns the recorded list IDs.
+-- INTERNAL: unscoped, called by the snapshot worker only.
+INSERT INTO list_snapshots (list_id, day, status_counts, priority_counts, overdue_count, recorded_at)
+SELECT
+    l.id,
+    sqlc.arg(day)::date,
+    COALESCE((
+        SELECT jsonb_object_agg(s.status, s.n)
+        FROM (SELECT i.status, COUNT(*) AS n FROM todo_items i WHERE i.list_id = l.id GROUP BY i.status) s
+    ), '{}'::jsonb),
+    COALESCE((
+        SELECT jsonb_object_agg(p.priority, p.n)
+        FROM (
+            SELECT COALESCE(i.priority, '') AS priority, COUNT(*) AS n FROM todo_items i
+            WHERE i.list_id = l.id AND i.status IN ('todo', 'in_progress', 'blocked')
+            GROUP BY 1
+        ) p
+    ), '{}'::jsonb),
+    (
+        SELECT COUNT(*) FROM todo_items i
+        WHERE i.list_id = l.id AND i.status IN ('todo', 'in_progress', 'blocked') AND i.due_at < sqlc.arg(recorded_at)
+    )::integer,
+    sqlc.arg(recorded_at)
+FROM todo_lists l
+WHERE sqlc.narg('after_list_id')::uuid IS NULL OR l.id > sqlc.narg('after_list_id')::uuid
+ORDER BY l.id
+LIMIT sqlc.arg(batch_size)
+ON CONFLICT (list_id, day) DO UPDATE
+SET status_counts = EXCLUDED.status_counts,
+    priority_counts = EXCLUDED.priority_counts,
+    overdue_count = EXCLUDED.overdue_count,
+    recorded_at = EXCLUDED.recorded_at
+RETURNING list_id;
+
+-- name: FindListSnapshots :many
+-- Snapshots of a list from first_day through last_day, oldest first.
+-- TENANCY: owner_id scopes the list to one tenant's owned or shared lists (NULL = unscoped internal access).
+SELECT s.* FROM list_snapshots s
+WHERE s.list_id = sqlc.arg(list_id)
+  AND s.day BETWEEN sqlc.arg(first_day)::date AND sqlc.arg(last_day)::date
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(s.list_id, sqlc.narg('owner_id')::uuid))
+ORDER BY s.day;
@@ -0,0 +1,101 @@
+-- Tag Registry
+-- ============
+-- Registered tags belong to a list; access is checked by the service against the list.
+
+-- name: ListListTags :many
+SELECT * FROM list_tags
+WHERE list_id = $1
+ORDER BY name ASC;
+
+-- name: GetListTag :one
+SELECT * FROM list_tags
+WHERE list_id = $1 AND name = $2;
+
+-- name: CreateListTag :one
+-- Unique violation on (list_id, name) means the tag is already registered.
+INSERT INTO list_tags (list_id, name, color, description, created_at, updated_at)
+VALUES (
+    sqlc.arg(list_id), sqlc.arg(name), sqlc.narg(color), sqlc.narg(description),
+    sqlc.arg(created_at), sqlc.arg(created_at)
+)
+RETURNING *;
+
+-- name: UpdateListTag :one
+-- No row returned when the tag is not registered.
+UPDATE list_tags
+SET color = sqlc.narg(color),
+    description = sqlc.narg(description),
+    updated_at = sqlc.arg(updated_at)
+WHERE list_id = sqlc.arg(list_id) AND name = sqlc.arg(name)
+RETURNING *;
+
+-- name: RenameListTag :execrows
+-- Moves the registration of a tag to a new name.
+UPDATE list_tags
+SET name = sqlc.arg(new_name),
+    updated_at = sqlc.arg(updated_at)
+WHERE list_id = sqlc.arg(list_id) AND name = sqlc.arg(name);
+
+-- name: DeleteListTags :execrows
+-- DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+DELETE FROM list_tags
+WHERE list_id = sqlc.arg(list_id) AND name = ANY(sqlc.arg(names)::text[]);
+
+-- Tag Usage
+-- =========
+
+-- name: CountListTagUsage :many
+-- Counts the items and recurring templates of a list that use each registered tag.
+-- The item counts are containment tests served by the GIN index on tags.
+SELECT
+    lt.name AS tag,
+    (SELECT COUNT(*) FROM todo_items i WHERE i.list_id = lt.list_id AND i.tags @> ARRAY[lt.name])::bigint AS item_count,
+    (SELECT COUNT(*) FROM recurring_task_templates r WHERE r.list_id = lt.list_id AND r.tags @> ARRAY[lt.name])::bigint AS template_count
+FROM list_tags lt
+WHERE lt.list_id = sqlc.arg(list_id)
+ORDER BY lt.name;
+
+-- name: ListTagInUse :one
+-- Reports whether a tag is registered on a list or used by its items or templates.
+-- The item check is a containment test served by the GIN index on tags.
+SELECT (
+    EXISTS (SELECT 1 FROM list_tags lt WHERE lt.list_id = sqlc.arg(list_id) AND lt.name = sqlc.arg(tag)::text)
+    OR EXISTS (SELECT 1 FROM todo_items i WHERE i.list_id = sqlc.arg(list_id) AND i.tags @> ARRAY[sqlc.arg(tag)::text])
+    OR EXISTS (SELECT 1 FROM recurring_task_templates r WHERE r.list_id = sqlc.arg(list_id) AND r.tags @> ARRAY[sqlc.arg(tag)::text])
+)::boolean AS in_use;
+
+-- name: ReplaceItemTags :execrows
+-- Replaces the source tags of every item of a list with the target tag, keeping
+-- the order of the tags and dropping the duplicates the replacement creates.
+-- Items are found by overlap with the sources, served by the GIN index on tags.
+UPDATE todo_items
+SET tags = ARRAY(
+        SELECT r.tag FROM (
+            SELECT
+                CASE WHEN u.tag = ANY(sqlc.arg(sources)::text[]) THEN sqlc.arg(target)::text ELSE u.tag END AS tag,
+                MIN(u.ord) AS ord
+            FROM unnest(todo_items.tags) WITH ORDINALITY AS u(tag, ord)
+            GROUP BY 1
+        ) r
+        ORDER BY r.ord
+    ),
+    updated_at = NOW(),
+    version = version + 1
+WHERE list_id = sqlc.arg(list_id) AND tags && sqlc.arg(sources)::text[];
+
+-- name: ReplaceTemplateTags :execrows
+-- Same as ReplaceItemTags, for the recurring templates of a list.
+UPDATE recurring_task_templates
+SET tags = ARRAY(
+        SELECT r.tag FROM (
+            SELECT
+                CASE WHEN u.tag = ANY(sqlc.arg(sources)::text[]) THEN sqlc.arg(target)::text ELSE u.tag END AS tag,
+                MIN(u.ord) AS ord
+            FROM unnest(recurring_task_templates.tags) WITH ORDINALITY AS u(tag, ord)
+            GROUP BY 1
+        ) r
+        ORDER BY r.ord
+    ),
+    updated_at = NOW(),
+    version = version + 1
+WHERE list_id = sqlc.arg(list_id) AND tags && sqlc.arg(sources)::text[];
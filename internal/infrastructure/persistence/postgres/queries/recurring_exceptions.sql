@@ -1,4 +1,6 @@
 -- name: CreateException :one
+-- TENANCY: Inserts only when the template's list is owned by or shared with owner_id (NULL = unscoped internal access).
+-- Returns pgx.ErrNoRows when the template is not visible to the tenant.
 INSERT INTO recurring_template_exceptions (
     id,
     template_id,
@@ -6,25 +8,48 @@ INSERT INTO recurring_template_exceptions (
     exception_type,
     item_id,
     created_at
-) VALUES (
-    $1, $2, $3, $4, $5, $6
-) RETURNING *;
+)
+SELECT sqlc.arg(id), sqlc.arg(template_id), sqlc.arg(occurs_at), sqlc.arg(exception_type), sqlc.arg(item_id), sqlc.arg(created_at)
+WHERE sqlc.narg('owner_id')::uuid IS NULL
+   OR EXISTS (
+       SELECT 1 FROM recurring_task_templates t
+       WHERE t.id = sqlc.arg(template_id) AND list_visible_to(t.list_id, sqlc.narg('owner_id')::uuid)
+   )
+RETURNING *;
 
 -- name: FindExceptions :many
-SELECT * FROM recurring_template_exceptions
-WHERE template_id = $1
-  AND occurs_at BETWEEN $2 AND $3
-ORDER BY occurs_at;
+-- Used by the generation worker (unscoped) and by tenant requests.
+-- owner_id restricts exceptions to templates in lists owned by or shared with one tenant (NULL = unscoped internal access).
+SELECT e.* FROM recurring_template_exceptions e
+WHERE e.template_id = sqlc.arg(template_id)
+  AND e.occurs_at BETWEEN sqlc.arg(occurs_from) AND sqlc.arg(occurs_to)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM recurring_task_templates t
+      WHERE t.id = e.template_id AND list_visible_to(t.list_id, sqlc.narg('owner_id')::uuid)
+  ))
+ORDER BY e.occurs_at;
 
 -- name: FindExceptionByOccurrence :one
-SELECT * FROM recurring_template_exceptions
-WHERE template_id = $1 AND occurs_at = $2;
+SELECT e.* FROM recurring_template_exceptions e
+WHERE e.template_id = sqlc.arg(template_id) AND e.occurs_at = sqlc.arg(occurs_at)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM recurring_task_templates t
+      WHERE t.id = e.template_id AND list_visible_to(t.list_id, sqlc.narg('owner_id')::uuid)
+  ));
 
 -- name: DeleteException :exec
-DELETE FROM recurring_template_exceptions
-WHERE template_id = $1 AND occurs_at = $2;
+DELETE FROM recurring_template_exceptions e
+WHERE e.template_id = sqlc.arg(template_id) AND e.occurs_at = sqlc.arg(occurs_at)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM recurring_task_templates t
+      WHERE t.id = e.template_id AND list_visible_to(t.list_id, sqlc.narg('owner_id')::uuid)
+  ));
 
 -- name: ListAllExceptionsByTemplate :many
-SELECT * FROM recurring_template_exceptions
-WHERE template_id = $1
-ORDER BY occurs_at;
+SELECT e.* FROM recurring_template_exceptions e
+WHERE e.template_id = sqlc.arg(template_id)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM recurring_task_templates t
+      WHERE t.id = e.template_id AND list_visible_to(t.list_id, sqlc.narg('owner_id')::uuid)
+  ))
+ORDER BY e.occurs_at;
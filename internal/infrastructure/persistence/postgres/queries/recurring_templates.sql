@@ -1,32 +1,50 @@
 -- name: CreateRecurringTemplate :one
+-- TENANCY: Inserts only when the list is owned by or shared with owner_id (NULL = unscoped internal access).
+-- Returns pgx.ErrNoRows when the list is not visible to the tenant, so it is reported as not found.
 INSERT INTO recurring_task_templates (
     id, list_id, title, tags, priority, estimated_duration,
     recurrence_pattern, recurrence_config, due_offset,
     is_active, created_at, updated_at,
-    generated_through, sync_horizon_days, generation_horizon_days
-) VALUES (
+    generated_through, sync_horizon_days, generation_horizon_days,
+    custom_fields, default_reminders
+)
+SELECT
     sqlc.arg(id), sqlc.arg(list_id), sqlc.arg(title), sqlc.arg(tags), sqlc.arg(priority),
     sqlc.narg('estimated_duration'),
     sqlc.arg(recurrence_pattern), sqlc.arg(recurrence_config),
     sqlc.narg('due_offset'),
     sqlc.arg(is_active), sqlc.arg(created_at), sqlc.arg(updated_at),
-    sqlc.arg(generated_through), sqlc.arg(sync_horizon_days), sqlc.arg(generation_horizon_days)
-)
+    sqlc.arg(generated_through), sqlc.arg(sync_horizon_days), sqlc.arg(generation_horizon_days),
+    sqlc.arg(custom_fields), sqlc.arg(default_reminders)
+WHERE sqlc.narg('owner_id')::uuid IS NULL
+   OR list_visible_to(sqlc.arg(list_id), sqlc.narg('owner_id')::uuid)
 RETURNING *;
 
 -- name: FindRecurringTemplateByID :one
-SELECT * FROM recurring_task_templates
-WHERE id = $1;
+-- TENANCY: owner_id scopes the lookup to templates in lists owned by or shared with one tenant (NULL = unscoped internal access).
+-- Background workers pass NULL to process templates of all tenants.
+SELECT t.* FROM recurring_task_templates t
+WHERE t.id = sqlc.arg(id)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(t.list_id, sqlc.narg('owner_id')::uuid));
+
+-- name: FindRecurringTemplatesByIDs :many
+-- Templates that no longer exist are omitted.
+-- TENANCY: owner_id omits templates of lists not owned by or shared with one tenant (NULL = unscoped internal access).
+SELECT t.* FROM recurring_task_templates t
+WHERE t.id = ANY(@ids::uuid[])
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(t.list_id, sqlc.narg('owner_id')::uuid));
 
 -- name: ListRecurringTemplates :many
-SELECT * FROM recurring_task_templates
-WHERE list_id = $1 AND is_active = true
-ORDER BY created_at DESC;
+SELECT t.* FROM recurring_task_templates t
+WHERE t.list_id = sqlc.arg(list_id) AND t.is_active = true
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(t.list_id, sqlc.narg('owner_id')::uuid))
+ORDER BY t.created_at DESC;
 
 -- name: ListAllRecurringTemplatesByList :many
-SELECT * FROM recurring_task_templates
-WHERE list_id = $1
-ORDER BY created_at DESC;
+SELECT t.* FROM recurring_task_templates t
+WHERE t.list_id = sqlc.arg(list_id)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(t.list_id, sqlc.narg('owner_id')::uuid))
+ORDER BY t.created_at DESC;
 
 -- name: ListAllActiveRecurringTemplates :many
 SELECT * FROM recurring_task_templates
@@ -35,6 +53,7 @@ ORDER BY created_at DESC;
 
 -- name: UpdateRecurringTemplate :one
 -- Field mask pattern with optimistic locking support
+-- TENANCY: owner_id restricts updates to templates in lists owned by or shared with one tenant (NULL = unscoped)
 UPDATE recurring_task_templates
 SET title = CASE WHEN sqlc.arg('set_title')::boolean THEN sqlc.narg('title') ELSE title END,
     tags = CASE WHEN sqlc.arg('set_tags')::boolean THEN sqlc.narg('tags') ELSE tags END,
@@ -46,9 +65,12 @@ SET title = CASE WHEN sqlc.arg('set_title')::boolean THEN sqlc.narg('title') ELS
     is_active = CASE WHEN sqlc.arg('set_is_active')::boolean THEN sqlc.narg('is_active') ELSE is_active END,
     sync_horizon_days = CASE WHEN sqlc.arg('set_sync_horizon_days')::boolean THEN sqlc.narg('sync_horizon_days') ELSE sync_horizon_days END,
     generation_horizon_days = CASE WHEN sqlc.arg('set_generation_horizon_days')::boolean THEN sqlc.narg('generation_horizon_days') ELSE generation_horizon_days END,
+    custom_fields = CASE WHEN sqlc.arg('set_custom_fields')::boolean THEN sqlc.arg('custom_fields')::jsonb ELSE custom_fields END,
+    default_reminders = CASE WHEN sqlc.arg('set_default_reminders')::boolean THEN sqlc.arg('default_reminders')::jsonb ELSE default_reminders END,
     updated_at = NOW(),
     version = version + 1
-WHERE id = sqlc.arg('id')
+WHERE recurring_task_templates.id = sqlc.arg('id')
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(recurring_task_templates.list_id, sqlc.narg('owner_id')::uuid))
   AND (sqlc.narg('expected_version')::integer IS NULL OR version = sqlc.narg('expected_version')::integer)
 RETURNING *;
 
@@ -62,10 +84,12 @@ WHERE id = $3;
 -- DATA ACCESS PATTERN: Single-query existence check via rowsAffected
 -- :execrows returns (int64, error) - Repository checks rowsAffected == 0 → domain.ErrNotFound
 -- Soft delete with existence detection in single operation
+-- TENANCY: owner_id restricts deactivation to templates in lists owned by or shared with one tenant (NULL = unscoped)
 UPDATE recurring_task_templates
 SET is_active = false,
-    updated_at = $1
-WHERE id = $2;
+    updated_at = sqlc.arg(updated_at)
+WHERE recurring_task_templates.id = sqlc.arg(id)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(recurring_task_templates.list_id, sqlc.narg('owner_id')::uuid));
 
 -- name: DeleteFutureRecurringInstances :execrows
 -- Delete generated instances with occurs_at > NOW()
@@ -0,0 +1,34 @@
+-- Saved Views
+-- ===========
+-- owner_id narg: restricts views to one tenant (NULL = unscoped internal access)
+
+-- name: CreateSavedView :one
+INSERT INTO saved_views (id, owner_id, name, list_id, definition, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $6)
+RETURNING *;
+
+-- name: GetSavedView :one
+SELECT * FROM saved_views
+WHERE id = sqlc.arg(id)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR owner_id = sqlc.narg('owner_id')::uuid);
+
+-- name: ListSavedViews :many
+SELECT * FROM saved_views
+WHERE sqlc.narg('owner_id')::uuid IS NULL OR owner_id = sqlc.narg('owner_id')::uuid
+ORDER BY created_at ASC, id ASC;
+
+-- name: UpdateSavedView :one
+UPDATE saved_views
+SET name = sqlc.arg(name),
+    list_id = sqlc.arg(list_id),
+    definition = sqlc.arg(definition),
+    updated_at = sqlc.arg(updated_at)
+WHERE id = sqlc.arg(id)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR owner_id = sqlc.narg('owner_id')::uuid)
+RETURNING *;
+
+-- name: DeleteSavedView :execrows
+-- DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+DELETE FROM saved_views
+WHERE id = sqlc.arg(id)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR owner_id = sqlc.narg('owner_id')::uuid);
@@ -11,3 +11,29 @@ ORDER BY changed_at DESC;
 SELECT * FROM task_status_history
 WHERE task_id = $1 AND changed_at BETWEEN $2 AND $3
 ORDER BY changed_at ASC;
+
+-- name: FindStatusHistoryByTasks :many
+-- Status history of several items, oldest change first per item.
+-- TENANCY: owner_id restricts history to items in lists owned by or shared with one tenant (NULL = unscoped internal access).
+SELECT h.* FROM task_status_history h
+WHERE h.task_id = ANY(sqlc.arg(task_ids)::uuid[])
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM todo_items ti
+      WHERE ti.id = h.task_id AND list_visible_to(ti.list_id, sqlc.narg('owner_id')::uuid)
+  ))
+ORDER BY h.task_id, h.changed_at, h.id;
+
+-- name: DeleteStatusHistoryByTasks :exec
+-- Clears the history the insert trigger recorded for restored items, before the backed up history is written.
+-- TENANCY: owner_id restricts deletion to items in lists owned by or shared with one tenant (NULL = unscoped internal access).
+DELETE FROM task_status_history h
+WHERE h.task_id = ANY(sqlc.arg(task_ids)::uuid[])
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM todo_items ti
+      WHERE ti.id = h.task_id AND list_visible_to(ti.list_id, sqlc.narg('owner_id')::uuid)
+  ));
+
+-- name: BatchCreateStatusHistoryEntries :copyfrom
+-- Bulk insert of restored history using the COPY protocol
+INSERT INTO task_status_history (id, task_id, from_status, to_status, changed_at, notes)
+VALUES ($1, $2, $3, $4, $5, $6);
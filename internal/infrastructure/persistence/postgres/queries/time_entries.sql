@@ -0,0 +1,59 @@
+-- Time Entries
+-- ============
+-- Time a principal tracked on an item. A running timer is an entry without
+-- ended_at; the idx_time_entries_running_timer unique index allows one per
+-- principal. The track_time_entry_changes trigger recomputes the item's
+-- actual_duration whenever entries change.
+
+-- name: CreateTimeEntry :one
+-- Copies list_id from the item row.
+-- Returns pgx.ErrNoRows when the item does not exist.
+INSERT INTO time_entries (
+    id, item_id, list_id, principal_id, started_at, ended_at, notes, created_at, updated_at
+)
+SELECT
+    sqlc.arg(id), i.id, i.list_id, sqlc.arg(principal_id), sqlc.arg(started_at),
+    sqlc.narg(ended_at), sqlc.arg(notes), sqlc.arg(created_at), sqlc.arg(created_at)
+FROM todo_items i
+WHERE i.id = sqlc.arg(item_id)
+RETURNING *;
+
+-- name: ListTimeEntries :many
+SELECT * FROM time_entries
+WHERE item_id = $1
+ORDER BY started_at ASC, id ASC;
+
+-- name: FindRunningTimeEntry :one
+SELECT * FROM time_entries
+WHERE principal_id = $1 AND ended_at IS NULL;
+
+-- name: StopRunningTimeEntry :one
+-- Stops the running timer of a principal, on any item when item_id is NULL.
+-- Notes are appended to the ones given at start.
+-- Returns pgx.ErrNoRows when no timer is running.
+UPDATE time_entries
+SET ended_at = GREATEST(sqlc.arg(ended_at)::timestamptz, started_at),
+    notes = CASE
+        WHEN sqlc.arg(notes)::text = '' THEN notes
+        WHEN notes = '' THEN sqlc.arg(notes)::text
+        ELSE notes || E'\n' || sqlc.arg(notes)::text
+    END,
+    updated_at = sqlc.arg(ended_at)::timestamptz
+WHERE principal_id = sqlc.arg(principal_id)
+  AND ended_at IS NULL
+  AND (sqlc.narg(item_id)::uuid IS NULL OR item_id = sqlc.narg(item_id)::uuid)
+RETURNING *;
+
+-- name: DeleteTimeEntry :execrows
+-- DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+-- Entries of other principals are only deleted when principal_id is NULL.
+DELETE FROM time_entries
+WHERE id = sqlc.arg(id)
+  AND item_id = sqlc.arg(item_id)
+  AND (sqlc.narg(principal_id)::uuid IS NULL OR principal_id = sqlc.narg(principal_id)::uuid);
+
+-- name: MoveTimeEntries :exec
+-- Follows an item to the list it moved to.
+UPDATE time_entries
+SET list_id = sqlc.arg(list_id)
+WHERE item_id = sqlc.arg(item_id);
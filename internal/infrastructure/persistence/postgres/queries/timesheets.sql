@@ -0,0 +1,62 @@
+-- Timesheets
+-- ==========
+-- Periods of tracked time overlapping [period_start, period_end) on the items
+-- of the lists the caller can access, one row per period:
+--   - entries: a time entry; items with entries are only reported by their entries
+--   - status:  an in_progress period from task_status_history (ended_at NULL while
+--              still in progress), with the item's actual_duration and the total of
+--              its finished in_progress periods so a manual override can be detected
+--   - manual:  an actual_duration set on an item that was never in progress,
+--              reported at updated_at
+
+-- name: FindTimesheetPeriods :many
+WITH scoped_items AS (
+    SELECT i.id, i.list_id, i.title, i.tags, i.status, i.actual_duration, i.updated_at,
+           tl.title AS list_title,
+           EXISTS (SELECT 1 FROM time_entries e WHERE e.item_id = i.id) AS has_entries
+    FROM todo_items i
+    JOIN todo_lists tl ON tl.id = i.list_id
+    WHERE (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(i.list_id, sqlc.narg('owner_id')::uuid))
+),
+status_periods AS (
+    SELECT h.task_id, h.to_status, h.changed_at AS started_at,
+           LEAD(h.changed_at) OVER (PARTITION BY h.task_id ORDER BY h.changed_at, h.id) AS ended_at
+    FROM task_status_history h
+    JOIN scoped_items si ON si.id = h.task_id
+    -- Items last changed before the period can only overlap it while still in progress
+    WHERE NOT si.has_entries
+      AND (si.updated_at >= sqlc.arg('period_start')::timestamptz OR si.status = 'in_progress')
+),
+in_progress_periods AS (
+    SELECT sp.task_id, sp.started_at, sp.ended_at,
+           COALESCE(SUM(sp.ended_at - sp.started_at) OVER (PARTITION BY sp.task_id), INTERVAL '0') AS tracked_duration
+    FROM status_periods sp
+    WHERE sp.to_status = 'in_progress'
+)
+SELECT si.id::text AS item_id, si.list_id::text AS list_id, si.list_title, si.title, si.tags,
+       'entries'::text AS source, e.started_at, e.ended_at,
+       si.actual_duration, INTERVAL '0' AS tracked_duration
+FROM time_entries e
+JOIN scoped_items si ON si.id = e.item_id
+WHERE e.started_at < sqlc.arg('period_end')::timestamptz
+  AND (e.ended_at IS NULL OR e.ended_at > sqlc.arg('period_start')::timestamptz)
+UNION ALL
+SELECT si.id::text, si.list_id::text, si.list_title, si.title, si.tags,
+       'status'::text, p.started_at, p.ended_at,
+       si.actual_duration, p.tracked_duration
+FROM in_progress_periods p
+JOIN scoped_items si ON si.id = p.task_id
+WHERE p.started_at < sqlc.arg('period_end')::timestamptz
+  AND (p.ended_at IS NULL OR p.ended_at > sqlc.arg('period_start')::timestamptz)
+UNION ALL
+SELECT si.id::text, si.list_id::text, si.list_title, si.title, si.tags,
+       'manual'::text, si.updated_at, si.updated_at,
+       si.actual_duration, INTERVAL '0'
+FROM scoped_items si
+WHERE NOT si.has_entries
+  AND si.actual_duration > INTERVAL '0'
+  AND si.updated_at >= sqlc.arg('period_start')::timestamptz
+  AND si.updated_at < sqlc.arg('period_end')::timestamptz
+  AND NOT EXISTS (SELECT 1 FROM task_status_history h WHERE h.task_id = si.id AND h.to_status = 'in_progress')
+ORDER BY 1, 7
+LIMIT sqlc.arg('row_limit');
@@ -1,15 +1,21 @@
 -- name: CreateTodoItem :one
+-- TENANCY: Inserts only when the list is owned by or shared with owner_id (NULL = unscoped internal access).
+-- Returns pgx.ErrNoRows when the list is not visible to the tenant, so it is reported as not found.
 INSERT INTO todo_items (
     id, list_id, title, status, priority,
     estimated_duration, actual_duration,
     created_at, updated_at, due_at, tags,
-    recurring_template_id, starts_at, occurs_at, due_offset, timezone
-) VALUES (
+    recurring_template_id, starts_at, occurs_at, due_offset, timezone,
+    custom_fields
+)
+SELECT
     sqlc.arg(id), sqlc.arg(list_id), sqlc.arg(title), sqlc.arg(status), sqlc.arg(priority),
     sqlc.narg('estimated_duration'), sqlc.narg('actual_duration'),
     sqlc.arg(created_at), sqlc.arg(updated_at), sqlc.narg(due_at), sqlc.arg(tags),
-    sqlc.narg(recurring_template_id), sqlc.narg(starts_at), sqlc.narg(occurs_at), sqlc.narg(due_offset), sqlc.narg(timezone)
-)
+    sqlc.narg(recurring_template_id), sqlc.narg(starts_at), sqlc.narg(occurs_at), sqlc.narg(due_offset), sqlc.narg(timezone),
+    sqlc.arg(custom_fields)
+WHERE sqlc.narg('owner_id')::uuid IS NULL
+   OR list_visible_to(sqlc.arg(list_id), sqlc.narg('owner_id')::uuid)
 RETURNING *;
 
 -- name: BatchCreateTodoItems :copyfrom
@@ -19,18 +25,27 @@ INSERT INTO todo_items (
     estimated_duration, actual_duration,
     created_at, updated_at, due_at, tags,
     recurring_template_id, starts_at, occurs_at, due_offset, timezone,
-    version
+    version, custom_fields
 ) VALUES (
     $1, $2, $3, $4, $5,
     $6, $7,
     $8, $9, $10, $11,
     $12, $13, $14, $15, $16,
-    $17
+    $17, $18
 );
 
 -- name: GetTodoItem :one
-SELECT * FROM todo_items
-WHERE id = $1;
+-- TENANCY: owner_id scopes the lookup to items in lists owned by or shared with one tenant (NULL = unscoped internal access).
+SELECT i.* FROM todo_items i
+WHERE i.id = sqlc.arg(id)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(i.list_id, sqlc.narg('owner_id')::uuid));
+
+-- name: GetTodoItemsByIDs :many
+-- Items that no longer exist are omitted.
+-- TENANCY: owner_id omits items of lists not owned by or shared with one tenant (NULL = unscoped internal access).
+SELECT i.* FROM todo_items i
+WHERE i.id = ANY(@ids::uuid[])
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(i.list_id, sqlc.narg('owner_id')::uuid));
 
 -- name: GetTodoItemsByListId :many
 SELECT * FROM todo_items
@@ -48,6 +63,7 @@ ORDER BY list_id, created_at ASC;
 --   - Item doesn't exist
 --   - Item belongs to different list (security: prevents cross-list updates)
 --   - Version mismatch (concurrency: prevents lost updates)
+--   - List is not visible to the tenant (when owner_id provided)
 -- SECURITY: Validates item belongs to the specified list
 -- CONCURRENCY: Optional version check for optimistic locking
 -- TYPE SAFETY: All fields managed by sqlc - schema changes caught at compile time
@@ -62,150 +78,25 @@ SET title = CASE WHEN sqlc.arg('set_title')::boolean THEN sqlc.narg('title') ELS
     due_offset = CASE WHEN sqlc.arg('set_due_offset')::boolean THEN sqlc.narg('due_offset') ELSE due_offset END,
     tags = CASE WHEN sqlc.arg('set_tags')::boolean THEN sqlc.narg('tags') ELSE tags END,
     timezone = CASE WHEN sqlc.arg('set_timezone')::boolean THEN sqlc.narg('timezone') ELSE timezone END,
+    custom_fields = CASE WHEN sqlc.arg('set_custom_fields')::boolean THEN sqlc.arg('custom_fields')::jsonb ELSE custom_fields END,
+    snoozed_until = CASE WHEN sqlc.arg('set_snoozed_until')::boolean THEN sqlc.narg('snoozed_until') ELSE snoozed_until END,
     recurring_template_id = CASE WHEN sqlc.arg('detach_from_template')::boolean THEN NULL ELSE recurring_template_id END,
     updated_at = NOW(),
     version = version + 1
-WHERE id = sqlc.arg('id')
-  AND list_id = sqlc.arg('list_id')
+WHERE todo_items.id = sqlc.arg('id')
+  AND todo_items.list_id = sqlc.arg('list_id')
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(todo_items.list_id, sqlc.narg('owner_id')::uuid))
   AND (sqlc.narg('expected_version')::integer IS NULL OR version = sqlc.narg('expected_version')::integer)
 RETURNING *;
 
--- name: UpdateTodoItemStatus :execrows
--- DATA ACCESS PATTERN: Single-query existence check via rowsAffected
--- :execrows returns (int64, error) - Repository checks rowsAffected == 0 → domain.ErrNotFound
--- Efficient status updates without separate existence check
-UPDATE todo_items
-SET status = $1, updated_at = $2
-WHERE id = $3;
-
 -- name: DeleteTodoItem :execrows
 -- DATA ACCESS PATTERN: Single-query existence check via rowsAffected
 -- :execrows returns (int64, error) - Repository checks rowsAffected == 0 → domain.ErrNotFound
 -- Single-query delete with existence detection built-in
+-- TENANCY: owner_id restricts deletion to items in lists owned by or shared with one tenant (NULL = unscoped internal access)
 DELETE FROM todo_items
-WHERE id = $1;
-
--- name: DeleteTodoItemsByListId :exec
-DELETE FROM todo_items
-WHERE list_id = $1;
-
--- name: CountTasksWithFilters :one
--- Counts total matching items for pagination (used when main query returns empty page).
--- Uses same WHERE clause as ListTasksWithFilters for consistency.
--- Includes exception join to match ListTasksWithFilters behavior.
--- $2: statuses array (empty array skips filter, OR logic within array)
--- $3: priorities array (empty array skips filter, OR logic within array)
--- $4: tags array (empty array skips filter, item must have ALL specified tags)
--- $9: excluded_statuses array (empty array skips filter, excludes matching statuses)
-SELECT COUNT(*) FROM todo_items i
-LEFT JOIN recurring_template_exceptions e
-    ON i.recurring_template_id = e.template_id
-    AND i.occurs_at = e.occurs_at
-    AND e.exception_type = 'deleted'  -- Only match deleted exceptions
-WHERE
-    e.id IS NULL AND  -- Exclude only hard-deleted items (edited/rescheduled pass through)
-    ($1::uuid = '00000000-0000-0000-0000-000000000000' OR i.list_id = $1) AND
-    (array_length($2::text[], 1) IS NULL OR i.status = ANY($2::text[])) AND
-    (array_length($9::text[], 1) IS NULL OR i.status != ALL($9::text[])) AND
-    (array_length($3::text[], 1) IS NULL OR i.priority = ANY($3::text[])) AND
-    (array_length($4::text[], 1) IS NULL OR i.tags @> $4::text[]) AND
-    ($5::timestamptz = '0001-01-01 00:00:00+00' OR i.due_at <= $5) AND
-    ($6::timestamptz = '0001-01-01 00:00:00+00' OR i.due_at >= $6) AND
-    ($7::timestamptz = '0001-01-01 00:00:00+00' OR i.updated_at >= $7) AND
-    ($8::timestamptz = '0001-01-01 00:00:00+00' OR i.created_at >= $8);
-
--- name: ListTasksWithFilters :many
--- Optimized for SEARCH/FILTER access pattern: Database-level filtering, sorting, and pagination.
--- Performance: Pushes all operations to PostgreSQL with proper indexes vs loading all items to memory.
--- Use case: Task search, filtered views, "My Tasks" views, pagination through large result sets.
---
--- Parameters (use empty arrays for NULL to skip filters):
---   $1: list_id           - Filter by specific list (zero UUID to search all lists)
---   $2: statuses          - Array of statuses to include (empty array to skip, OR logic)
---   $3: priorities        - Array of priorities to include (empty array to skip, OR logic)
---   $4: tags              - Array of tags to match (empty array to skip, item must have ALL tags)
---   $5: due_before        - Filter tasks due before timestamp (zero time to skip)
---   $6: due_after         - Filter tasks due after timestamp (zero time to skip)
---   $7: updated_at        - Filter by last update time (zero time to skip)
---   $8: created_at        - Filter by creation time (zero time to skip)
---   $9: order_by          - Combined field+direction: 'due_at_asc', 'due_at_desc', etc.
---                           Supports: due_at, priority, created_at, updated_at with _asc or _desc suffix
---                           For bare field names, defaults are: due_at=asc, priority=asc,
---                           created_at=desc, updated_at=desc
---   $10: limit            - Page size (max items to return)
---   $11: offset           - Pagination offset (skip N items)
---   $12: excluded_statuses - Array of statuses to exclude (empty array to skip filter)
---                           Used to exclude archived/cancelled by default when $2 is empty
---
--- Returns: All todo_items columns plus total_count (total matching rows across all pages)
--- The COUNT(*) OVER() window function computes total matching rows in a single query pass,
--- enabling accurate pagination UI without a separate count query.
---
--- SQL Injection Protection:
--- The ORDER BY clause uses parameterized queries ($9::text) with CASE expressions.
--- PostgreSQL's parameterized query protocol treats $9 as DATA, never CODE, making SQL
--- injection structurally impossible. Even if malicious input like "id; DROP TABLE--"
--- flows through without validation, it's compared as a string literal in CASE expressions,
--- never executed as SQL. This protection is guaranteed by PostgreSQL's wire protocol.
--- See tests/integration/sql_injection_resistance_test.go for proof.
---
--- Input validation at the service layer improves UX (clear error messages) but does NOT
--- provide security - parameterized queries are the security boundary.
---
--- Access pattern examples:
---   - "Show my overdue tasks": filter by due_before=now, order by due_at_asc
---   - "Active work": statuses=[todo, in_progress], default sort
---   - "High priority items": priorities=[high, urgent], order by due_at_asc
---   - "Tasks tagged 'urgent' and 'work'": tags=[urgent, work] (item must have both)
-SELECT i.*, COUNT(*) OVER() AS total_count
-FROM todo_items i
-LEFT JOIN recurring_template_exceptions e
-    ON i.recurring_template_id = e.template_id
-    AND i.occurs_at = e.occurs_at
-    AND e.exception_type = 'deleted'  -- Only match deleted exceptions
-WHERE
-    e.id IS NULL AND  -- Exclude only hard-deleted items (edited/rescheduled pass through)
-    ($1::uuid = '00000000-0000-0000-0000-000000000000' OR i.list_id = $1) AND
-    (array_length($2::text[], 1) IS NULL OR i.status = ANY($2::text[])) AND
-    (array_length($12::text[], 1) IS NULL OR i.status != ALL($12::text[])) AND
-    (array_length($3::text[], 1) IS NULL OR i.priority = ANY($3::text[])) AND
-    (array_length($4::text[], 1) IS NULL OR i.tags @> $4::text[]) AND
-    ($5::timestamptz = '0001-01-01 00:00:00+00' OR i.due_at <= $5) AND
-    ($6::timestamptz = '0001-01-01 00:00:00+00' OR i.due_at >= $6) AND
-    ($7::timestamptz = '0001-01-01 00:00:00+00' OR i.updated_at >= $7) AND
-    ($8::timestamptz = '0001-01-01 00:00:00+00' OR i.created_at >= $8)
-ORDER BY
-    -- due_at: default ASC
-    CASE WHEN $9::text IN ('due_at', 'due_at_asc') THEN i.due_at END ASC NULLS LAST,
-    CASE WHEN $9::text = 'due_at_desc' THEN i.due_at END DESC NULLS LAST,
-    -- priority: default ASC (semantic order: low=1 < medium=2 < high=3 < urgent=4)
-    -- Uses numeric weights instead of lexical ordering to match proto enum semantics
-    CASE WHEN $9::text IN ('priority', 'priority_asc') THEN
-        CASE i.priority
-            WHEN 'low' THEN 1
-            WHEN 'medium' THEN 2
-            WHEN 'high' THEN 3
-            WHEN 'urgent' THEN 4
-        END
-    END ASC NULLS LAST,
-    CASE WHEN $9::text = 'priority_desc' THEN
-        CASE i.priority
-            WHEN 'low' THEN 1
-            WHEN 'medium' THEN 2
-            WHEN 'high' THEN 3
-            WHEN 'urgent' THEN 4
-        END
-    END DESC NULLS LAST,
-    -- created_at: default DESC
-    CASE WHEN $9::text = 'created_at_asc' THEN i.created_at END ASC,
-    CASE WHEN $9::text IN ('created_at', 'created_at_desc') THEN i.created_at END DESC,
-    -- updated_at: default DESC
-    CASE WHEN $9::text = 'updated_at_asc' THEN i.updated_at END ASC,
-    CASE WHEN $9::text IN ('updated_at', 'updated_at_desc') THEN i.updated_at END DESC,
-    -- Fallback: created_at DESC (when no valid order_by specified)
-    i.created_at DESC
-LIMIT $10
-OFFSET $11;
+WHERE todo_items.id = sqlc.arg(id)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(todo_items.list_id, sqlc.narg('owner_id')::uuid));
 
 -- name: InsertItemIgnoreConflict :exec
 -- Idempotent single insert with ON CONFLICT DO NOTHING
@@ -215,13 +106,13 @@ INSERT INTO todo_items (
     estimated_duration, actual_duration,
     created_at, updated_at, due_at, tags,
     recurring_template_id, starts_at, occurs_at, due_offset, timezone,
-    version
+    version, custom_fields
 ) VALUES (
     $1, $2, $3, $4, $5,
     $6, $7,
     $8, $9, $10, $11,
     $12, $13, $14, $15, $16,
-    $17
+    $17, $18
 )
 ON CONFLICT (recurring_template_id, occurs_at) WHERE recurring_template_id IS NOT NULL
 DO NOTHING;
@@ -232,3 +123,42 @@ DELETE FROM todo_items
 WHERE recurring_template_id = $1
   AND occurs_at >= $2
   AND status = 'todo';
+
+-- name: FindStatsItems :many
+-- Items of a list that can count towards stats of [from, to): created before to,
+-- and still open or last updated since from (items closed earlier are settled).
+-- TENANCY: owner_id scopes the list to one tenant's owned or shared lists (NULL = unscoped internal access).
+SELECT i.* FROM todo_items i
+WHERE i.list_id = sqlc.arg(list_id)
+  AND i.created_at < sqlc.arg(created_before)
+  AND (i.status NOT IN ('done', 'archived', 'cancelled') OR i.updated_at >= sqlc.arg(updated_since))
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(i.list_id, sqlc.narg('owner_id')::uuid))
+ORDER BY i.created_at, i.id
+LIMIT sqlc.arg(row_limit);
+
+-- name: MoveTodoItem :one
+-- Moves an item to another list.
+-- Returns pgx.ErrNoRows when the item is not in from_list_id.
+UPDATE todo_items
+SET list_id = sqlc.arg(to_list_id),
+    updated_at = NOW(),
+    version = version + 1
+WHERE id = sqlc.arg(id) AND list_id = sqlc.arg(from_list_id)
+RETURNING *;
+
+-- name: WakeSnoozedItems :many
+-- Clears the snooze of up to row_limit items snoozed until now or earlier.
+-- The change log records each as an update clearing snoozed_until, which is
+-- published as item.woke. SKIP LOCKED leaves items being edited to a later pass.
+UPDATE todo_items
+SET snoozed_until = NULL,
+    updated_at = NOW(),
+    version = version + 1
+WHERE id IN (
+    SELECT s.id FROM todo_items s
+    WHERE s.snoozed_until <= sqlc.arg(now)
+    ORDER BY s.snoozed_until, s.id
+    LIMIT sqlc.arg(row_limit)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id;
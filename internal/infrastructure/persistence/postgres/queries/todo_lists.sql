@@ -1,26 +1,33 @@
 -- name: CreateTodoList :one
-INSERT INTO todo_lists (id, title, created_at)
-VALUES ($1, $2, $3)
+INSERT INTO todo_lists (id, title, created_at, custom_field_schema, owner_id)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: GetTodoList :one
-SELECT * FROM todo_lists
-WHERE id = @id;
+-- TENANCY: owner_id scopes the lookup to lists owned by or shared with one tenant (NULL = unscoped internal access).
+-- Lists of other tenants are reported as not found.
+SELECT tl.* FROM todo_lists tl
+WHERE tl.id = @id
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(tl.id, sqlc.narg('owner_id')::uuid));
 
 -- name: GetTodoListWithCounts :one
 -- Returns a single list by ID with item counts (for detail view).
 -- undone_statuses parameter: domain layer defines which statuses count as "undone".
+-- TENANCY: owner_id scopes the lookup to lists owned by or shared with one tenant (NULL = unscoped internal access).
 SELECT
     tl.id,
     tl.title,
     tl.created_at,
     tl.version,
+    tl.custom_field_schema,
+    tl.owner_id,
     COALESCE(COUNT(ti.id), 0)::int AS total_items,
     COALESCE(COUNT(ti.id) FILTER (WHERE ti.status = ANY(@undone_statuses::text[])), 0)::int AS undone_items
 FROM todo_lists tl
 LEFT JOIN todo_items ti ON tl.id = ti.list_id
 WHERE tl.id = @id
-GROUP BY tl.id, tl.title, tl.created_at, tl.version;
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(tl.id, sqlc.narg('owner_id')::uuid))
+GROUP BY tl.id, tl.title, tl.created_at, tl.version, tl.custom_field_schema, tl.owner_id;
 
 -- name: UpdateTodoList :one
 -- ATOMIC UPDATE WITH COUNTS: Uses CTE to update and return counts in single statement.
@@ -32,11 +39,14 @@ GROUP BY tl.id, tl.title, tl.created_at, tl.version;
 -- Returns no rows if:
 --   - List doesn't exist
 --   - Version mismatch (when expected_version provided)
+--   - List is not visible to the tenant (when owner_id provided)
 WITH updated AS (
     UPDATE todo_lists tl
     SET title = CASE WHEN sqlc.arg('set_title')::boolean THEN sqlc.narg('title') ELSE title END,
+        custom_field_schema = CASE WHEN sqlc.arg('set_custom_field_schema')::boolean THEN sqlc.arg('custom_field_schema')::jsonb ELSE custom_field_schema END,
         version = tl.version + 1
     WHERE tl.id = sqlc.arg('id')
+      AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(tl.id, sqlc.narg('owner_id')::uuid))
       AND (sqlc.narg('expected_version')::integer IS NULL OR tl.version = sqlc.narg('expected_version')::integer)
     RETURNING *
 )
@@ -45,18 +55,13 @@ SELECT
     u.title,
     u.created_at,
     u.version,
+    u.custom_field_schema,
+    u.owner_id,
     COALESCE(COUNT(ti.id), 0)::int AS total_items,
     COALESCE(COUNT(ti.id) FILTER (WHERE ti.status = ANY(@undone_statuses::text[])), 0)::int AS undone_items
 FROM updated u
 LEFT JOIN todo_items ti ON u.id = ti.list_id
-GROUP BY u.id, u.title, u.created_at, u.version;
-
--- name: DeleteTodoList :execrows
--- DATA ACCESS PATTERN: Single-query existence check via rowsAffected
--- :execrows returns (int64, error) - Repository checks rowsAffected == 0 → domain.ErrNotFound
--- Efficient detection of non-existent records without separate SELECT query
-DELETE FROM todo_lists
-WHERE id = $1;
+GROUP BY u.id, u.title, u.created_at, u.version, u.custom_field_schema, u.owner_id;
 
 -- name: ListTodoLists :many
 -- Legacy query: Returns all lists without items (use ListTodoListsWithCounts for list views).
@@ -79,15 +84,35 @@ SELECT
     tl.title,
     tl.created_at,
     tl.version,
+    tl.custom_field_schema,
+    tl.owner_id,
     COALESCE(COUNT(ti.id), 0)::int AS total_items,
     COALESCE(COUNT(ti.id) FILTER (WHERE ti.status = ANY(@undone_statuses::text[])), 0)::int AS undone_items
 FROM todo_lists tl
 LEFT JOIN todo_items ti ON tl.id = ti.list_id
-GROUP BY tl.id, tl.title, tl.created_at, tl.version
+GROUP BY tl.id, tl.title, tl.created_at, tl.version, tl.custom_field_schema, tl.owner_id
 ORDER BY tl.created_at DESC;
 
+-- name: ListTodoListsWithCountsByIDs :many
+-- Returns the given lists with item counts. Lists that no longer exist are omitted.
+-- TENANCY: owner_id omits lists not owned by or shared with one tenant (NULL = unscoped internal access).
+SELECT
+    tl.id,
+    tl.title,
+    tl.created_at,
+    tl.version,
+    tl.custom_field_schema,
+    tl.owner_id,
+    COALESCE(COUNT(ti.id), 0)::int AS total_items,
+    COALESCE(COUNT(ti.id) FILTER (WHERE ti.status = ANY(@undone_statuses::text[])), 0)::int AS undone_items
+FROM todo_lists tl
+LEFT JOIN todo_items ti ON tl.id = ti.list_id
+WHERE tl.id = ANY(@ids::uuid[])
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(tl.id, sqlc.narg('owner_id')::uuid))
+GROUP BY tl.id, tl.title, tl.created_at, tl.version, tl.custom_field_schema, tl.owner_id;
+
 -- name: FindTodoListsWithFilters :many
--- Advanced list query with filtering, sorting, and pagination.
+-- Advanced list query with filtering, sorting, and keyset pagination.
 -- Supports AIP-160-style filtering and AIP-132-style sorting.
 --
 -- Parameters use nullable types for optional filters:
@@ -97,44 +122,75 @@ ORDER BY tl.created_at DESC;
 --   - order_by: Column to sort by ("created_at" or "title")
 --   - order_dir: Sort direction ("asc" or "desc")
 --   - page_limit: Maximum number of results to return
---   - page_offset: Number of results to skip
+--   - after_sort_key: sort_key of the last list of the previous page
+--   - after_id: ID of the last list of the previous page (NULL for the first page)
+--   - owner_id: Restricts results to lists owned by or shared with one tenant (NULL = unscoped internal access)
+--
+-- Lists are ordered by sort_key, then by id in the same direction, and a page
+-- continues strictly after (after_sort_key, after_id). sort_key is the sort column
+-- as JSONB (created_at as epoch seconds), the same encoding the item search uses.
 SELECT
     tl.id,
     tl.title,
     tl.created_at,
     tl.version,
+    tl.custom_field_schema,
+    tl.owner_id,
     COALESCE(COUNT(ti.id), 0)::int AS total_items,
-    COALESCE(COUNT(ti.id) FILTER (WHERE ti.status = ANY(@undone_statuses::text[])), 0)::int AS undone_items
+    COALESCE(COUNT(ti.id) FILTER (WHERE ti.status = ANY(@undone_statuses::text[])), 0)::int AS undone_items,
+    k.sort_key::jsonb AS sort_key
 FROM todo_lists tl
+CROSS JOIN LATERAL (
+    SELECT
+        CASE
+            WHEN @order_by::text = 'title' THEN to_jsonb(tl.title)
+            ELSE to_jsonb(extract(epoch FROM tl.created_at))
+        END AS sort_key,
+        @order_dir::text IS DISTINCT FROM 'desc' AS ascending
+) k
 LEFT JOIN todo_items ti ON tl.id = ti.list_id
 WHERE
-    (@title_contains::text IS NULL OR LOWER(tl.title) LIKE LOWER('%' || @title_contains || '%'))
+    (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(tl.id, sqlc.narg('owner_id')::uuid))
+    AND (@title_contains::text IS NULL OR LOWER(tl.title) LIKE LOWER('%' || @title_contains || '%'))
     AND (@created_at_after::timestamptz IS NULL OR tl.created_at > @created_at_after)
     AND (@created_at_before::timestamptz IS NULL OR tl.created_at < @created_at_before)
-GROUP BY tl.id, tl.title, tl.created_at, tl.version
+    AND (sqlc.narg('after_id')::uuid IS NULL OR CASE
+        WHEN k.ascending THEN k.sort_key > sqlc.narg('after_sort_key')::jsonb
+            OR (k.sort_key = sqlc.narg('after_sort_key')::jsonb AND tl.id > sqlc.narg('after_id')::uuid)
+        ELSE k.sort_key < sqlc.narg('after_sort_key')::jsonb
+            OR (k.sort_key = sqlc.narg('after_sort_key')::jsonb AND tl.id < sqlc.narg('after_id')::uuid)
+    END)
+GROUP BY tl.id, tl.title, tl.created_at, tl.version, tl.custom_field_schema, tl.owner_id, k.sort_key, k.ascending
 ORDER BY
-    CASE
-        WHEN @order_by = 'title' AND @order_dir = 'asc' THEN tl.title
-    END ASC,
-    CASE
-        WHEN @order_by = 'title' AND @order_dir = 'desc' THEN tl.title
-    END DESC,
-    CASE
-        WHEN @order_by = 'created_at' AND @order_dir = 'asc' THEN tl.created_at
-        WHEN (@order_by IS NULL OR @order_by = '') AND (@order_dir IS NULL OR @order_dir = '' OR @order_dir = 'asc') THEN tl.created_at
-    END ASC,
-    CASE
-        WHEN @order_by = 'created_at' AND @order_dir = 'desc' THEN tl.created_at
-        WHEN (@order_by IS NULL OR @order_by = '') AND @order_dir = 'desc' THEN tl.created_at
-    END DESC
-LIMIT @page_limit
-OFFSET @page_offset;
+    CASE WHEN k.ascending THEN k.sort_key END ASC,
+    CASE WHEN NOT k.ascending THEN k.sort_key END DESC,
+    CASE WHEN k.ascending THEN tl.id END ASC,
+    CASE WHEN NOT k.ascending THEN tl.id END DESC
+LIMIT @page_limit;
 
 -- name: CountTodoListsWithFilters :one
 -- Count total matching lists for pagination (same filters as FindTodoListsWithFilters).
+-- Only run when the caller asks for a total, since it reads every matching list.
 SELECT COUNT(DISTINCT tl.id)::int AS total_count
 FROM todo_lists tl
 WHERE
-    (@title_contains::text IS NULL OR LOWER(tl.title) LIKE LOWER('%' || @title_contains || '%'))
+    (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(tl.id, sqlc.narg('owner_id')::uuid))
+    AND (@title_contains::text IS NULL OR LOWER(tl.title) LIKE LOWER('%' || @title_contains || '%'))
     AND (@created_at_after::timestamptz IS NULL OR tl.created_at > @created_at_after)
     AND (@created_at_before::timestamptz IS NULL OR tl.created_at < @created_at_before);
+
+-- name: FindTakenBackupIDs :many
+-- IDs of a backup that already exist, as an ID of any kind. Unscoped: IDs are
+-- unique across tenants, so a restore that keeps them conflicts with the rows of
+-- any tenant. visible is false for rows in lists not owned by or shared with
+-- owner_id (NULL = unscoped internal access), whose kind is not reported.
+WITH taken AS (
+    SELECT 'list'::text AS kind, tl.id, tl.id AS list_id FROM todo_lists tl WHERE tl.id = ANY(sqlc.arg(ids)::uuid[])
+    UNION ALL
+    SELECT 'recurring_template'::text AS kind, t.id, t.list_id FROM recurring_task_templates t WHERE t.id = ANY(sqlc.arg(ids)::uuid[])
+    UNION ALL
+    SELECT 'item'::text AS kind, i.id, i.list_id FROM todo_items i WHERE i.id = ANY(sqlc.arg(ids)::uuid[])
+)
+SELECT kind, id,
+       (sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(list_id, sqlc.narg('owner_id')::uuid))::boolean AS visible
+FROM taken;
@@ -0,0 +1,149 @@
+-- Webhook Subscriptions
+-- =====================
+-- owner_id narg: restricts subscriptions to one tenant (NULL = unscoped internal access)
+
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (id, owner_id, url, secret, events, is_active, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+RETURNING *;
+
+-- name: GetWebhookSubscription :one
+SELECT * FROM webhook_subscriptions
+WHERE id = sqlc.arg(id)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR owner_id = sqlc.narg('owner_id')::uuid);
+
+-- name: ListWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+WHERE sqlc.narg('owner_id')::uuid IS NULL OR owner_id = sqlc.narg('owner_id')::uuid
+ORDER BY created_at ASC, id ASC;
+
+-- name: UpdateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET url = sqlc.arg(url),
+    secret = sqlc.arg(secret),
+    events = sqlc.arg(events),
+    is_active = sqlc.arg(is_active),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR owner_id = sqlc.narg('owner_id')::uuid)
+RETURNING *;
+
+-- name: DeleteWebhookSubscription :execrows
+-- DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+DELETE FROM webhook_subscriptions
+WHERE id = sqlc.arg(id)
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR owner_id = sqlc.narg('owner_id')::uuid);
+
+-- Webhook Deliveries - Delivery Queue
+-- ===================================
+-- available_at: WHEN the delivery can be claimed (availability window)
+--   - For pending deliveries: NULL (claimable now) or the retry time after a failure
+--   - For running deliveries: NOW() + timeout, extended by heartbeats
+
+-- name: EnqueueWebhookDeliveries :execrows
+-- Fan an event out to every active subscription that selected its type and
+-- whose owner owns or is a member of the event's list. Dead letter events
+-- belong to the list of the job's template.
+-- Idempotent per event: subscriptions that already have the event queued are skipped.
+INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, created_at, updated_at)
+SELECT s.id, sqlc.arg(event_id), sqlc.arg(event_type)::text, sqlc.arg(payload), sqlc.arg(created_at), sqlc.arg(created_at)
+FROM webhook_subscriptions s
+JOIN todo_lists tl ON tl.id = COALESCE(
+    sqlc.narg(list_id)::uuid,
+    (SELECT t.list_id FROM recurring_task_templates t WHERE t.id = sqlc.narg(template_id)::uuid)
+)
+WHERE s.is_active AND sqlc.arg(event_type)::text = ANY(s.events)
+  AND list_visible_to(tl.id, s.owner_id)
+ON CONFLICT (subscription_id, event_id) WHERE redelivery_of IS NULL DO NOTHING;
+
+-- name: EnqueueWebhookDelivery :one
+-- Queue an event for one active subscription, e.g. the one an automation rule calls.
+-- Returns pgx.ErrNoRows when the subscription does not exist or is paused.
+INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, created_at, updated_at)
+SELECT s.id, sqlc.arg(event_id), sqlc.arg(event_type)::text, sqlc.arg(payload), sqlc.arg(created_at), sqlc.arg(created_at)
+FROM webhook_subscriptions s
+WHERE s.id = sqlc.arg(subscription_id) AND s.is_active
+RETURNING id;
+
+-- name: ListWebhookDeliveries :many
+-- Recent deliveries of a subscription, newest first, optionally filtered by status.
+SELECT * FROM webhook_deliveries
+WHERE subscription_id = sqlc.arg(subscription_id)
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status)::text)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: RedeliverWebhookDelivery :one
+-- Queue a fresh delivery of the same event payload.
+-- Returns pgx.ErrNoRows when the delivery does not belong to the subscription.
+INSERT INTO webhook_deliveries (id, subscription_id, event_id, event_type, payload, redelivery_of, created_at, updated_at)
+SELECT sqlc.arg(new_id), d.subscription_id, d.event_id, d.event_type, d.payload, d.id, sqlc.arg(created_at), sqlc.arg(created_at)
+FROM webhook_deliveries d
+WHERE d.id = sqlc.arg(id) AND d.subscription_id = sqlc.arg(subscription_id)
+RETURNING *;
+
+-- name: ClaimNextWebhookDelivery :one
+-- Atomically claim the next deliverable event using SKIP LOCKED.
+-- Deliveries of paused subscriptions stay pending until the subscription is resumed.
+-- Covers two scenarios:
+--   1. Pending deliveries that are not waiting for a retry
+--   2. Running deliveries past their availability timeout (stuck workers)
+UPDATE webhook_deliveries
+SET status = 'running',
+    claimed_by = sqlc.arg(claimed_by),
+    available_at = sqlc.arg(available_at),
+    updated_at = NOW()
+WHERE webhook_deliveries.id = (
+    SELECT d.id FROM webhook_deliveries d
+    JOIN webhook_subscriptions s ON s.id = d.subscription_id
+    WHERE s.is_active
+      AND ((d.status = 'pending' AND (d.available_at IS NULL OR d.available_at <= NOW()))
+           OR (d.status = 'running' AND d.available_at <= NOW()))
+    ORDER BY d.created_at, d.id
+    LIMIT 1
+    FOR UPDATE OF d SKIP LOCKED
+)
+RETURNING *;
+
+-- name: ExtendWebhookDeliveryAvailability :execrows
+-- Heartbeat: only succeeds if the delivery is still owned by the worker.
+UPDATE webhook_deliveries
+SET available_at = $3
+WHERE id = $1 AND claimed_by = $2 AND status = 'running';
+
+-- name: CompleteWebhookDelivery :execrows
+-- Mark a delivery as delivered, but only if still owned by the worker.
+UPDATE webhook_deliveries
+SET status = 'delivered',
+    attempts = attempts + 1,
+    response_status = sqlc.arg(response_status),
+    last_error = NULL,
+    claimed_by = NULL,
+    available_at = NULL,
+    delivered_at = NOW(),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND claimed_by = sqlc.arg(claimed_by) AND status = 'running';
+
+-- name: ScheduleWebhookDeliveryRetry :execrows
+-- Release a delivery for another attempt at available_at.
+UPDATE webhook_deliveries
+SET status = 'pending',
+    attempts = sqlc.arg(attempts),
+    last_error = sqlc.arg(last_error),
+    response_status = sqlc.narg(response_status),
+    claimed_by = NULL,
+    available_at = sqlc.arg(available_at),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND claimed_by = sqlc.arg(claimed_by) AND status = 'running';
+
+-- name: MarkWebhookDeliveryDead :execrows
+-- Park a delivery that failed permanently or exhausted its retries.
+UPDATE webhook_deliveries
+SET status = 'dead',
+    attempts = sqlc.arg(attempts),
+    last_error = sqlc.arg(last_error),
+    response_status = sqlc.narg(response_status),
+    claimed_by = NULL,
+    available_at = NULL,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND claimed_by = sqlc.arg(claimed_by) AND status = 'running';
@@ -25,8 +25,8 @@ func (q *Queries) CheckAPIKeyExists(ctx context.Context, id string) (bool, error
 }
 
 const createAPIKey = `-- name: CreateAPIKey :exec
-INSERT INTO api_keys (id, key_type, service, version, short_token, long_secret_hash, name, is_active, created_at, expires_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+INSERT INTO api_keys (id, key_type, service, version, short_token, long_secret_hash, name, is_active, created_at, expires_at, owner_id, scopes)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 `
 
 type CreateAPIKeyParams struct {
@@ -40,6 +40,8 @@ type CreateAPIKeyParams struct {
 	IsActive       bool                `json:"is_active"`
 	CreatedAt      time.Time           `json:"created_at"`
 	ExpiresAt      sql.Null[time.Time] `json:"expires_at"`
+	OwnerID        string              `json:"owner_id"`
+	Scopes         []string            `json:"scopes"`
 }
 
 func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) error {
@@ -54,6 +56,8 @@ func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) erro
 		arg.IsActive,
 		arg.CreatedAt,
 		arg.ExpiresAt,
+		arg.OwnerID,
+		arg.Scopes,
 	)
 	return err
 }
@@ -76,7 +80,7 @@ func (q *Queries) DeactivateAPIKey(ctx context.Context, id string) (int64, error
 }
 
 const getAPIKeyByShortToken = `-- name: GetAPIKeyByShortToken :one
-SELECT id, key_type, service, version, short_token, long_secret_hash, name, is_active, created_at, last_used_at, expires_at FROM api_keys
+SELECT id, key_type, service, version, short_token, long_secret_hash, name, is_active, created_at, last_used_at, expires_at, owner_id, scopes FROM api_keys
 WHERE short_token = $1 AND is_active = true
 `
 
@@ -102,12 +106,14 @@ func (q *Queries) GetAPIKeyByShortToken(ctx context.Context, shortToken string)
 		&i.CreatedAt,
 		&i.LastUsedAt,
 		&i.ExpiresAt,
+		&i.OwnerID,
+		&i.Scopes,
 	)
 	return i, err
 }
 
 const listActiveAPIKeys = `-- name: ListActiveAPIKeys :many
-SELECT id, key_type, service, version, short_token, long_secret_hash, name, is_active, created_at, last_used_at, expires_at FROM api_keys
+SELECT id, key_type, service, version, short_token, long_secret_hash, name, is_active, created_at, last_used_at, expires_at, owner_id, scopes FROM api_keys
 WHERE is_active = true
 ORDER BY created_at DESC
 `
@@ -133,6 +139,8 @@ func (q *Queries) ListActiveAPIKeys(ctx context.Context) ([]ApiKey, error) {
 			&i.CreatedAt,
 			&i.LastUsedAt,
 			&i.ExpiresAt,
+			&i.OwnerID,
+			&i.Scopes,
 		); err != nil {
 			return nil, err
 		}
@@ -9,6 +9,44 @@ import (
 	"context"
 )
 
+// iteratorForBatchCreateStatusHistoryEntries implements pgx.CopyFromSource.
+type iteratorForBatchCreateStatusHistoryEntries struct {
+	rows                 []BatchCreateStatusHistoryEntriesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForBatchCreateStatusHistoryEntries) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForBatchCreateStatusHistoryEntries) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].TaskID,
+		r.rows[0].FromStatus,
+		r.rows[0].ToStatus,
+		r.rows[0].ChangedAt,
+		r.rows[0].Notes,
+	}, nil
+}
+
+func (r iteratorForBatchCreateStatusHistoryEntries) Err() error {
+	return nil
+}
+
+// Bulk insert of restored history using the COPY protocol
+func (q *Queries) BatchCreateStatusHistoryEntries(ctx context.Context, arg []BatchCreateStatusHistoryEntriesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"task_status_history"}, []string{"id", "task_id", "from_status", "to_status", "changed_at", "notes"}, &iteratorForBatchCreateStatusHistoryEntries{rows: arg})
+}
+
 // iteratorForBatchCreateTodoItems implements pgx.CopyFromSource.
 type iteratorForBatchCreateTodoItems struct {
 	rows                 []BatchCreateTodoItemsParams
@@ -46,6 +84,7 @@ func (r iteratorForBatchCreateTodoItems) Values() ([]interface{}, error) {
 		r.rows[0].DueOffset,
 		r.rows[0].Timezone,
 		r.rows[0].Version,
+		r.rows[0].CustomFields,
 	}, nil
 }
 
@@ -55,5 +94,5 @@ func (r iteratorForBatchCreateTodoItems) Err() error {
 
 // Bulk insert using PostgreSQL COPY protocol for high performance
 func (q *Queries) BatchCreateTodoItems(ctx context.Context, arg []BatchCreateTodoItemsParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"todo_items"}, []string{"id", "list_id", "title", "status", "priority", "estimated_duration", "actual_duration", "created_at", "updated_at", "due_at", "tags", "recurring_template_id", "starts_at", "occurs_at", "due_offset", "timezone", "version"}, &iteratorForBatchCreateTodoItems{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"todo_items"}, []string{"id", "list_id", "title", "status", "priority", "estimated_duration", "actual_duration", "created_at", "updated_at", "due_at", "tags", "recurring_template_id", "starts_at", "occurs_at", "due_offset", "timezone", "version", "custom_fields"}, &iteratorForBatchCreateTodoItems{rows: arg})
 }
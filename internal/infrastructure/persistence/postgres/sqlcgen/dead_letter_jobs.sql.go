@@ -31,11 +31,22 @@ func (q *Queries) DeleteResolvedDeadLetterJobs(ctx context.Context, reviewedAt p
 const getDeadLetterJob = `-- name: GetDeadLetterJob :one
 SELECT id, original_job_id, template_id, generate_from, generate_until, error_type, error_message, stack_trace, failed_at, reviewed_at, resolution, reviewed_by, reviewer_note, retry_count, last_worker_id, original_scheduled_for, original_created_at FROM dead_letter_jobs
 WHERE id = $1
+  AND ($2::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM recurring_task_templates t
+      WHERE t.id = dead_letter_jobs.template_id
+        AND list_visible_to(t.list_id, $2::uuid)
+  ))
 `
 
+type GetDeadLetterJobParams struct {
+	ID      pgtype.UUID `json:"id"`
+	OwnerID pgtype.UUID `json:"owner_id"`
+}
+
 // Retrieve a specific dead letter job by ID.
-func (q *Queries) GetDeadLetterJob(ctx context.Context, id pgtype.UUID) (DeadLetterJob, error) {
-	row := q.db.QueryRow(ctx, getDeadLetterJob, id)
+// TENANCY: owner_id restricts to jobs of templates in lists owned by or shared with one tenant (NULL = unscoped internal access)
+func (q *Queries) GetDeadLetterJob(ctx context.Context, arg GetDeadLetterJobParams) (DeadLetterJob, error) {
+	row := q.db.QueryRow(ctx, getDeadLetterJob, arg.ID, arg.OwnerID)
 	var i DeadLetterJob
 	err := row.Scan(
 		&i.ID,
@@ -106,14 +117,25 @@ func (q *Queries) InsertDeadLetterJob(ctx context.Context, arg InsertDeadLetterJ
 const listPendingDeadLetterJobs = `-- name: ListPendingDeadLetterJobs :many
 SELECT id, original_job_id, template_id, generate_from, generate_until, error_type, error_message, stack_trace, failed_at, reviewed_at, resolution, reviewed_by, reviewer_note, retry_count, last_worker_id, original_scheduled_for, original_created_at FROM dead_letter_jobs
 WHERE resolution IS NULL
+  AND ($1::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM recurring_task_templates t
+      WHERE t.id = dead_letter_jobs.template_id
+        AND list_visible_to(t.list_id, $1::uuid)
+  ))
 ORDER BY failed_at DESC
-LIMIT $1
+LIMIT $2
 `
 
+type ListPendingDeadLetterJobsParams struct {
+	OwnerID   pgtype.UUID `json:"owner_id"`
+	PageLimit int32       `json:"page_limit"`
+}
+
 // Retrieve unresolved dead letter jobs for admin review.
 // Ordered by failure time (most recent first).
-func (q *Queries) ListPendingDeadLetterJobs(ctx context.Context, limit int32) ([]DeadLetterJob, error) {
-	rows, err := q.db.Query(ctx, listPendingDeadLetterJobs, limit)
+// TENANCY: owner_id restricts to jobs of templates in lists owned by or shared with one tenant (NULL = unscoped internal access)
+func (q *Queries) ListPendingDeadLetterJobs(ctx context.Context, arg ListPendingDeadLetterJobsParams) ([]DeadLetterJob, error) {
+	rows, err := q.db.Query(ctx, listPendingDeadLetterJobs, arg.OwnerID, arg.PageLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -154,20 +176,32 @@ const markDeadLetterAsDiscarded = `-- name: MarkDeadLetterAsDiscarded :execrows
 UPDATE dead_letter_jobs
 SET resolution = 'discarded',
     reviewed_at = NOW(),
-    reviewed_by = $2,
-    reviewer_note = $3
-WHERE id = $1 AND resolution IS NULL
+    reviewed_by = $1,
+    reviewer_note = $2
+WHERE id = $3 AND resolution IS NULL
+  AND ($4::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM recurring_task_templates t
+      WHERE t.id = dead_letter_jobs.template_id
+        AND list_visible_to(t.list_id, $4::uuid)
+  ))
 `
 
 type MarkDeadLetterAsDiscardedParams struct {
-	ID           pgtype.UUID      `json:"id"`
 	ReviewedBy   sql.Null[string] `json:"reviewed_by"`
 	ReviewerNote sql.Null[string] `json:"reviewer_note"`
+	ID           pgtype.UUID      `json:"id"`
+	OwnerID      pgtype.UUID      `json:"owner_id"`
 }
 
 // Mark a dead letter job as discarded with admin note.
+// TENANCY: owner_id restricts to jobs of templates in lists owned by or shared with one tenant (NULL = unscoped internal access)
 func (q *Queries) MarkDeadLetterAsDiscarded(ctx context.Context, arg MarkDeadLetterAsDiscardedParams) (int64, error) {
-	result, err := q.db.Exec(ctx, markDeadLetterAsDiscarded, arg.ID, arg.ReviewedBy, arg.ReviewerNote)
+	result, err := q.db.Exec(ctx, markDeadLetterAsDiscarded,
+		arg.ReviewedBy,
+		arg.ReviewerNote,
+		arg.ID,
+		arg.OwnerID,
+	)
 	if err != nil {
 		return 0, err
 	}
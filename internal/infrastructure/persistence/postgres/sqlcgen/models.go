@@ -24,6 +24,69 @@ type ApiKey struct {
 	CreatedAt      time.Time           `json:"created_at"`
 	LastUsedAt     sql.Null[time.Time] `json:"last_used_at"`
 	ExpiresAt      sql.Null[time.Time] `json:"expires_at"`
+	OwnerID        string              `json:"owner_id"`
+	Scopes         []string            `json:"scopes"`
+}
+
+type AutomationOverdueFire struct {
+	RuleID  string    `json:"rule_id"`
+	ItemID  string    `json:"item_id"`
+	DueAt   time.Time `json:"due_at"`
+	FiredAt time.Time `json:"fired_at"`
+}
+
+type AutomationRule struct {
+	ID            string           `json:"id"`
+	ListID        string           `json:"list_id"`
+	Name          string           `json:"name"`
+	Enabled       bool             `json:"enabled"`
+	TriggerType   string           `json:"trigger_type"`
+	TriggerStatus sql.Null[string] `json:"trigger_status"`
+	TriggerTag    sql.Null[string] `json:"trigger_tag"`
+	OverdueBy     pgtype.Interval  `json:"overdue_by"`
+	Condition     []byte           `json:"condition"`
+	Actions       []byte           `json:"actions"`
+	CreatedAt     time.Time        `json:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+	OwnerID       uuid.NullUUID    `json:"owner_id"`
+}
+
+type AutomationRuleRun struct {
+	ID          string           `json:"id"`
+	RuleID      string           `json:"rule_id"`
+	ListID      string           `json:"list_id"`
+	ItemID      string           `json:"item_id"`
+	TriggerType string           `json:"trigger_type"`
+	Depth       int32            `json:"depth"`
+	Status      string           `json:"status"`
+	Actions     []string         `json:"actions"`
+	Error       sql.Null[string] `json:"error"`
+	CreatedAt   time.Time        `json:"created_at"`
+}
+
+type CalendarFeed struct {
+	ID        string        `json:"id"`
+	OwnerID   uuid.NullUUID `json:"owner_id"`
+	ListID    string        `json:"list_id"`
+	Name      string        `json:"name"`
+	Statuses  []string      `json:"statuses"`
+	Tags      []string      `json:"tags"`
+	TokenHash string        `json:"token_hash"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type ChangeLog struct {
+	Seq         int64               `json:"seq"`
+	EntityType  string              `json:"entity_type"`
+	EntityID    string              `json:"entity_id"`
+	ListID      pgtype.UUID         `json:"list_id"`
+	Operation   string              `json:"operation"`
+	Version     int32               `json:"version"`
+	Snapshot    []byte              `json:"snapshot"`
+	Diff        []byte              `json:"diff"`
+	OccurredAt  time.Time           `json:"occurred_at"`
+	PublishedAt sql.Null[time.Time] `json:"published_at"`
+	Txid        uint64              `json:"txid"`
 }
 
 type CronJobLease struct {
@@ -55,6 +118,71 @@ type DeadLetterJob struct {
 	OriginalCreatedAt    pgtype.Timestamptz `json:"original_created_at"`
 }
 
+type DeadLetterReminder struct {
+	ID           string             `json:"id"`
+	ReminderID   string             `json:"reminder_id"`
+	ItemID       string             `json:"item_id"`
+	ErrorType    string             `json:"error_type"`
+	ErrorMessage sql.Null[string]   `json:"error_message"`
+	RetryCount   int32              `json:"retry_count"`
+	LastWorkerID sql.Null[string]   `json:"last_worker_id"`
+	FailedAt     time.Time          `json:"failed_at"`
+	ReviewedAt   pgtype.Timestamptz `json:"reviewed_at"`
+	Resolution   sql.Null[string]   `json:"resolution"`
+	ReviewerNote sql.Null[string]   `json:"reviewer_note"`
+}
+
+type ImportRecord struct {
+	ListID     string        `json:"list_id"`
+	ExternalID string        `json:"external_id"`
+	ItemID     uuid.NullUUID `json:"item_id"`
+	TemplateID uuid.NullUUID `json:"template_id"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+type ItemReminder struct {
+	ID           string              `json:"id"`
+	ItemID       string              `json:"item_id"`
+	RemindAt     sql.Null[time.Time] `json:"remind_at"`
+	Anchor       sql.Null[string]    `json:"anchor"`
+	OffsetBefore pgtype.Interval     `json:"offset_before"`
+	FireAt       sql.Null[time.Time] `json:"fire_at"`
+	Status       string              `json:"status"`
+	RetryCount   int32               `json:"retry_count"`
+	LastError    sql.Null[string]    `json:"last_error"`
+	ClaimedBy    sql.Null[string]    `json:"claimed_by"`
+	AvailableAt  sql.Null[time.Time] `json:"available_at"`
+	SentAt       sql.Null[time.Time] `json:"sent_at"`
+	CreatedAt    time.Time           `json:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+}
+
+type ListMember struct {
+	ListID      string    `json:"list_id"`
+	PrincipalID string    `json:"principal_id"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type ListSnapshot struct {
+	ListID         string    `json:"list_id"`
+	Day            time.Time `json:"day"`
+	StatusCounts   []byte    `json:"status_counts"`
+	PriorityCounts []byte    `json:"priority_counts"`
+	OverdueCount   int32     `json:"overdue_count"`
+	RecordedAt     time.Time `json:"recorded_at"`
+}
+
+type ListTag struct {
+	ListID      string           `json:"list_id"`
+	Name        string           `json:"name"`
+	Color       sql.Null[string] `json:"color"`
+	Description sql.Null[string] `json:"description"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
 type RecurringGenerationJob struct {
 	ID            string              `json:"id"`
 	TemplateID    string              `json:"template_id"`
@@ -90,6 +218,8 @@ type RecurringTaskTemplate struct {
 	SyncHorizonDays       int32            `json:"sync_horizon_days"`
 	GenerationHorizonDays int32            `json:"generation_horizon_days"`
 	Version               int32            `json:"version"`
+	CustomFields          []byte           `json:"custom_fields"`
+	DefaultReminders      []byte           `json:"default_reminders"`
 }
 
 type RecurringTemplateException struct {
@@ -101,6 +231,16 @@ type RecurringTemplateException struct {
 	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 }
 
+type SavedView struct {
+	ID         string        `json:"id"`
+	OwnerID    uuid.NullUUID `json:"owner_id"`
+	Name       string        `json:"name"`
+	ListID     uuid.NullUUID `json:"list_id"`
+	Definition []byte        `json:"definition"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+}
+
 type TaskStatusHistory struct {
 	ID         string           `json:"id"`
 	TaskID     string           `json:"task_id"`
@@ -110,6 +250,18 @@ type TaskStatusHistory struct {
 	Notes      sql.Null[string] `json:"notes"`
 }
 
+type TimeEntry struct {
+	ID          string              `json:"id"`
+	ItemID      string              `json:"item_id"`
+	ListID      string              `json:"list_id"`
+	PrincipalID string              `json:"principal_id"`
+	StartedAt   time.Time           `json:"started_at"`
+	EndedAt     sql.Null[time.Time] `json:"ended_at"`
+	Notes       string              `json:"notes"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
 type TodoItem struct {
 	ID                  string             `json:"id"`
 	ListID              string             `json:"list_id"`
@@ -128,11 +280,44 @@ type TodoItem struct {
 	DueOffset           pgtype.Interval    `json:"due_offset"`
 	Timezone            sql.Null[string]   `json:"timezone"`
 	Version             int32              `json:"version"`
+	CustomFields        []byte             `json:"custom_fields"`
+	SnoozedUntil        pgtype.Timestamptz `json:"snoozed_until"`
 }
 
 type TodoList struct {
-	ID        string             `json:"id"`
-	Title     string             `json:"title"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	Version   int32              `json:"version"`
+	ID                string             `json:"id"`
+	Title             string             `json:"title"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	Version           int32              `json:"version"`
+	CustomFieldSchema []byte             `json:"custom_field_schema"`
+	OwnerID           uuid.NullUUID      `json:"owner_id"`
+}
+
+type WebhookDelivery struct {
+	ID             string              `json:"id"`
+	SubscriptionID string              `json:"subscription_id"`
+	EventID        string              `json:"event_id"`
+	EventType      string              `json:"event_type"`
+	Payload        []byte              `json:"payload"`
+	Status         string              `json:"status"`
+	Attempts       int32               `json:"attempts"`
+	LastError      sql.Null[string]    `json:"last_error"`
+	ResponseStatus pgtype.Int4         `json:"response_status"`
+	ClaimedBy      sql.Null[string]    `json:"claimed_by"`
+	AvailableAt    sql.Null[time.Time] `json:"available_at"`
+	DeliveredAt    sql.Null[time.Time] `json:"delivered_at"`
+	RedeliveryOf   pgtype.UUID         `json:"redelivery_of"`
+	CreatedAt      time.Time           `json:"created_at"`
+	UpdatedAt      time.Time           `json:"updated_at"`
+}
+
+type WebhookSubscription struct {
+	ID        string        `json:"id"`
+	Url       string        `json:"url"`
+	Secret    string        `json:"secret"`
+	Events    []string      `json:"events"`
+	IsActive  bool          `json:"is_active"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+	OwnerID   uuid.NullUUID `json:"owner_id"`
 }
@@ -12,6 +12,8 @@ import (
 )
 
 type Querier interface {
+	// Bulk insert of restored history using the COPY protocol
+	BatchCreateStatusHistoryEntries(ctx context.Context, arg []BatchCreateStatusHistoryEntriesParams) (int64, error)
 	// Bulk insert using PostgreSQL COPY protocol for high performance
 	BatchCreateTodoItems(ctx context.Context, arg []BatchCreateTodoItemsParams) (int64, error)
 	// Cancel a pending or scheduled job immediately.
@@ -26,28 +28,101 @@ type Querier interface {
 	//   1. Pending jobs ready to run (scheduled_for <= NOW)
 	//   2. Running jobs past availability timeout (stuck workers)
 	ClaimNextPendingJob(ctx context.Context) (ClaimNextPendingJobRow, error)
+	// Atomically claim the next due reminder using SKIP LOCKED.
+	// Covers two scenarios:
+	//   1. Pending reminders that are due (fire_at <= NOW) and not waiting for a retry
+	//   2. Running reminders past their availability timeout (stuck workers)
+	ClaimNextReminder(ctx context.Context, arg ClaimNextReminderParams) (ItemReminder, error)
+	// Atomically claim the next deliverable event using SKIP LOCKED.
+	// Deliveries of paused subscriptions stay pending until the subscription is resumed.
+	// Covers two scenarios:
+	//   1. Pending deliveries that are not waiting for a retry
+	//   2. Running deliveries past their availability timeout (stuck workers)
+	ClaimNextWebhookDelivery(ctx context.Context, arg ClaimNextWebhookDeliveryParams) (WebhookDelivery, error)
 	// Remove expired leases (housekeeping).
 	CleanupExpiredLeases(ctx context.Context) (int64, error)
 	// Mark job as completed, but only if still owned by the specified worker.
 	// Returns 0 rows if job doesn't exist or ownership was lost.
 	// Note: available_at is set to completed_at since NOT NULL constraint prevents NULL.
 	CompleteJobWithOwnershipCheck(ctx context.Context, arg CompleteJobWithOwnershipCheckParams) (int64, error)
-	// Counts total matching items for pagination (used when main query returns empty page).
-	// Uses same WHERE clause as ListTasksWithFilters for consistency.
-	// Includes exception join to match ListTasksWithFilters behavior.
-	// $2: statuses array (empty array skips filter, OR logic within array)
-	// $3: priorities array (empty array skips filter, OR logic within array)
-	// $4: tags array (empty array skips filter, item must have ALL specified tags)
-	// $9: excluded_statuses array (empty array skips filter, excludes matching statuses)
-	CountTasksWithFilters(ctx context.Context, arg CountTasksWithFiltersParams) (int64, error)
+	// Mark a reminder as sent or skipped, but only if still owned by the worker.
+	CompleteReminder(ctx context.Context, arg CompleteReminderParams) (int64, error)
+	// Mark a delivery as delivered, but only if still owned by the worker.
+	CompleteWebhookDelivery(ctx context.Context, arg CompleteWebhookDeliveryParams) (int64, error)
+	CountAutomationRules(ctx context.Context, listID string) (int64, error)
+	CountItemReminders(ctx context.Context, itemID string) (int64, error)
+	// Tag Usage
+	// =========
+	// Counts the items and recurring templates of a list that use each registered tag.
+	// The item counts are containment tests served by the GIN index on tags.
+	CountListTagUsage(ctx context.Context, listID string) ([]CountListTagUsageRow, error)
 	// Count total matching lists for pagination (same filters as FindTodoListsWithFilters).
+	// Only run when the caller asks for a total, since it reads every matching list.
 	CountTodoListsWithFilters(ctx context.Context, arg CountTodoListsWithFiltersParams) (int32, error)
 	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) error
+	// Automation Rules
+	// ================
+	// Rules belong to a list; access is checked by the service against the list.
+	CreateAutomationRule(ctx context.Context, arg CreateAutomationRuleParams) (AutomationRule, error)
+	// Execution Log
+	// =============
+	CreateAutomationRuleRun(ctx context.Context, arg CreateAutomationRuleRunParams) error
+	// Calendar Feeds
+	// ==============
+	// owner_id narg: restricts feeds to one tenant (NULL = unscoped internal access)
+	CreateCalendarFeed(ctx context.Context, arg CreateCalendarFeedParams) (CalendarFeed, error)
+	// TENANCY: Inserts only when the template's list is owned by or shared with owner_id (NULL = unscoped internal access).
+	// Returns pgx.ErrNoRows when the template is not visible to the tenant.
 	CreateException(ctx context.Context, arg CreateExceptionParams) (RecurringTemplateException, error)
+	// Import Records
+	// ==============
+	// Records are keyed by list; the service checks list access before reading or writing them.
+	// Idempotent by (list_id, external_id): 0 rows means the ID was already imported
+	CreateImportRecord(ctx context.Context, arg CreateImportRecordParams) (int64, error)
+	// Item Reminders - Delivery Queue
+	// ================================
+	// fire_at: WHEN the reminder is due (resolved from remind_at or the item's anchor time)
+	//   - Kept in sync with the item by the reschedule_item_reminders trigger
+	//   - NULL for relative reminders whose anchor is not set: never claimed
+	//
+	// available_at: WHEN the reminder can be claimed (availability window)
+	//   - For pending reminders: NULL (claimable at fire_at) or the retry time after a failure
+	//   - For running reminders: NOW() + timeout, extended by heartbeats
+	//   - Enables stuck reminder recovery: if a worker crashes, the reminder is reclaimed
+	//     once available_at <= NOW(), same as recurring_generation_jobs
+	// Resolves fire_at from the item row in the same statement so a concurrent
+	// due/start time change cannot leave the reminder with a stale fire time.
+	// Returns pgx.ErrNoRows when the item does not exist.
+	CreateItemReminder(ctx context.Context, arg CreateItemReminderParams) (ItemReminder, error)
+	// Unique violation on (list_id, principal_id) means the principal is already a member.
+	CreateListMember(ctx context.Context, arg CreateListMemberParams) (ListMember, error)
+	// Unique violation on (list_id, name) means the tag is already registered.
+	CreateListTag(ctx context.Context, arg CreateListTagParams) (ListTag, error)
+	// TENANCY: Inserts only when the list is owned by or shared with owner_id (NULL = unscoped internal access).
+	// Returns pgx.ErrNoRows when the list is not visible to the tenant, so it is reported as not found.
 	CreateRecurringTemplate(ctx context.Context, arg CreateRecurringTemplateParams) (RecurringTaskTemplate, error)
+	// Saved Views
+	// ===========
+	// owner_id narg: restricts views to one tenant (NULL = unscoped internal access)
+	CreateSavedView(ctx context.Context, arg CreateSavedViewParams) (SavedView, error)
 	CreateStatusHistoryEntry(ctx context.Context, arg CreateStatusHistoryEntryParams) error
+	// Time Entries
+	// ============
+	// Time a principal tracked on an item. A running timer is an entry without
+	// ended_at; the idx_time_entries_running_timer unique index allows one per
+	// principal. The track_time_entry_changes trigger recomputes the item's
+	// actual_duration whenever entries change.
+	// Copies list_id from the item row.
+	// Returns pgx.ErrNoRows when the item does not exist.
+	CreateTimeEntry(ctx context.Context, arg CreateTimeEntryParams) (TimeEntry, error)
+	// TENANCY: Inserts only when the list is owned by or shared with owner_id (NULL = unscoped internal access).
+	// Returns pgx.ErrNoRows when the list is not visible to the tenant, so it is reported as not found.
 	CreateTodoItem(ctx context.Context, arg CreateTodoItemParams) (TodoItem, error)
 	CreateTodoList(ctx context.Context, arg CreateTodoListParams) (TodoList, error)
+	// Webhook Subscriptions
+	// =====================
+	// owner_id narg: restricts subscriptions to one tenant (NULL = unscoped internal access)
+	CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error)
 	// DATA ACCESS PATTERN: Single-query existence check via rowsAffected
 	// :execrows returns (int64, error) - Repository checks rowsAffected == 0 → domain.ErrNotFound
 	// Revokes API key with existence check in single operation
@@ -55,7 +130,12 @@ type Querier interface {
 	// DATA ACCESS PATTERN: Single-query existence check via rowsAffected
 	// :execrows returns (int64, error) - Repository checks rowsAffected == 0 → domain.ErrNotFound
 	// Soft delete with existence detection in single operation
+	// TENANCY: owner_id restricts deactivation to templates in lists owned by or shared with one tenant (NULL = unscoped)
 	DeactivateRecurringTemplate(ctx context.Context, arg DeactivateRecurringTemplateParams) (int64, error)
+	// DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+	DeleteAutomationRule(ctx context.Context, arg DeleteAutomationRuleParams) (int64, error)
+	// DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+	DeleteCalendarFeed(ctx context.Context, arg DeleteCalendarFeedParams) (int64, error)
 	DeleteException(ctx context.Context, arg DeleteExceptionParams) error
 	// Delete future pending items for a template (used before regeneration)
 	DeleteFuturePendingItems(ctx context.Context, arg DeleteFuturePendingItemsParams) (int64, error)
@@ -63,32 +143,73 @@ type Querier interface {
 	// Used during template deletion to clean up future scheduled tasks
 	// Preserves historical instances (occurs_at <= NOW()) for audit trail
 	DeleteFutureRecurringInstances(ctx context.Context, templateID uuid.NullUUID) (int64, error)
+	// DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+	DeleteItemReminder(ctx context.Context, arg DeleteItemReminderParams) (int64, error)
+	// DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+	DeleteListMember(ctx context.Context, arg DeleteListMemberParams) (int64, error)
+	// DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+	DeleteListTags(ctx context.Context, arg DeleteListTagsParams) (int64, error)
 	// Cleanup old resolved dead letter jobs (housekeeping).
 	// Retention period determined by caller (e.g., 30 days).
 	DeleteResolvedDeadLetterJobs(ctx context.Context, reviewedAt pgtype.Timestamptz) (int64, error)
 	// DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+	DeleteSavedView(ctx context.Context, arg DeleteSavedViewParams) (int64, error)
+	// Clears the history the insert trigger recorded for restored items, before the backed up history is written.
+	// TENANCY: owner_id restricts deletion to items in lists owned by or shared with one tenant (NULL = unscoped internal access).
+	DeleteStatusHistoryByTasks(ctx context.Context, arg DeleteStatusHistoryByTasksParams) error
+	// DATA ACCESS PATTERN: Single-query existence check via rowsAffected
+	// Entries of other principals are only deleted when principal_id is NULL.
+	DeleteTimeEntry(ctx context.Context, arg DeleteTimeEntryParams) (int64, error)
+	// DATA ACCESS PATTERN: Single-query existence check via rowsAffected
 	// :execrows returns (int64, error) - Repository checks rowsAffected == 0 → domain.ErrNotFound
 	// Single-query delete with existence detection built-in
-	DeleteTodoItem(ctx context.Context, id string) (int64, error)
-	DeleteTodoItemsByListId(ctx context.Context, listID string) error
+	// TENANCY: owner_id restricts deletion to items in lists owned by or shared with one tenant (NULL = unscoped internal access)
+	DeleteTodoItem(ctx context.Context, arg DeleteTodoItemParams) (int64, error)
 	// DATA ACCESS PATTERN: Single-query existence check via rowsAffected
-	// :execrows returns (int64, error) - Repository checks rowsAffected == 0 → domain.ErrNotFound
-	// Efficient detection of non-existent records without separate SELECT query
-	DeleteTodoList(ctx context.Context, id string) (int64, error)
+	DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) (int64, error)
 	// Move job to discarded state after exhausting retries.
 	DiscardJobAfterMaxRetries(ctx context.Context, arg DiscardJobAfterMaxRetriesParams) (int64, error)
 	// Mark job as discarded with ownership verification.
 	// Used by MoveToDeadLetter for atomic DLQ + discard operation.
 	// Returns 0 rows if job doesn't exist or ownership was lost.
 	DiscardJobWithOwnershipCheck(ctx context.Context, arg DiscardJobWithOwnershipCheckParams) (int64, error)
+	// Webhook Deliveries - Delivery Queue
+	// ===================================
+	// available_at: WHEN the delivery can be claimed (availability window)
+	//   - For pending deliveries: NULL (claimable now) or the retry time after a failure
+	//   - For running deliveries: NOW() + timeout, extended by heartbeats
+	// Fan an event out to every active subscription that selected its type and
+	// whose owner owns or is a member of the event's list. Dead letter events
+	// belong to the list of the job's template.
+	// Idempotent per event: subscriptions that already have the event queued are skipped.
+	EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) (int64, error)
+	// Queue an event for one active subscription, e.g. the one an automation rule calls.
+	// Returns pgx.ErrNoRows when the subscription does not exist or is paused.
+	EnqueueWebhookDelivery(ctx context.Context, arg EnqueueWebhookDeliveryParams) (string, error)
 	// Extend the availability timeout for a running job (heartbeat).
 	// Only succeeds if job is still owned by the specified worker.
 	ExtendJobAvailability(ctx context.Context, arg ExtendJobAvailabilityParams) (int64, error)
+	// Heartbeat: only succeeds if the reminder is still owned by the worker.
+	ExtendReminderAvailability(ctx context.Context, arg ExtendReminderAvailabilityParams) (int64, error)
+	// Heartbeat: only succeeds if the delivery is still owned by the worker.
+	ExtendWebhookDeliveryAvailability(ctx context.Context, arg ExtendWebhookDeliveryAvailabilityParams) (int64, error)
 	FindExceptionByOccurrence(ctx context.Context, arg FindExceptionByOccurrenceParams) (RecurringTemplateException, error)
+	// Used by the generation worker (unscoped) and by tenant requests.
+	// owner_id restricts exceptions to templates in lists owned by or shared with one tenant (NULL = unscoped internal access).
 	FindExceptions(ctx context.Context, arg FindExceptionsParams) ([]RecurringTemplateException, error)
 	// Retrieve a generation job by ID
 	FindGenerationJobByID(ctx context.Context, id string) (RecurringGenerationJob, error)
-	FindRecurringTemplateByID(ctx context.Context, id string) (RecurringTaskTemplate, error)
+	FindImportRecords(ctx context.Context, arg FindImportRecordsParams) ([]ImportRecord, error)
+	// Snapshots of a list from first_day through last_day, oldest first.
+	// TENANCY: owner_id scopes the list to one tenant's owned or shared lists (NULL = unscoped internal access).
+	FindListSnapshots(ctx context.Context, arg FindListSnapshotsParams) ([]ListSnapshot, error)
+	// TENANCY: owner_id scopes the lookup to templates in lists owned by or shared with one tenant (NULL = unscoped internal access).
+	// Background workers pass NULL to process templates of all tenants.
+	FindRecurringTemplateByID(ctx context.Context, arg FindRecurringTemplateByIDParams) (RecurringTaskTemplate, error)
+	// Templates that no longer exist are omitted.
+	// TENANCY: owner_id omits templates of lists not owned by or shared with one tenant (NULL = unscoped internal access).
+	FindRecurringTemplatesByIDs(ctx context.Context, arg FindRecurringTemplatesByIDsParams) ([]RecurringTaskTemplate, error)
+	FindRunningTimeEntry(ctx context.Context, principalID string) (TimeEntry, error)
 	// Find templates needing reconciliation across all lists.
 	// Used by reconciliation worker to ensure all templates are properly generated.
 	// Excludes:
@@ -96,7 +217,30 @@ type Querier interface {
 	//   - Templates with pending/running jobs (if exclude_pending is true)
 	//   - Templates already generated through their target date
 	FindStaleTemplatesForReconciliation(ctx context.Context, arg FindStaleTemplatesForReconciliationParams) ([]RecurringTaskTemplate, error)
-	// Advanced list query with filtering, sorting, and pagination.
+	// Items of a list that can count towards stats of [from, to): created before to,
+	// and still open or last updated since from (items closed earlier are settled).
+	// TENANCY: owner_id scopes the list to one tenant's owned or shared lists (NULL = unscoped internal access).
+	FindStatsItems(ctx context.Context, arg FindStatsItemsParams) ([]TodoItem, error)
+	// Status history of several items, oldest change first per item.
+	// TENANCY: owner_id restricts history to items in lists owned by or shared with one tenant (NULL = unscoped internal access).
+	FindStatusHistoryByTasks(ctx context.Context, arg FindStatusHistoryByTasksParams) ([]TaskStatusHistory, error)
+	// IDs of a backup that already exist, as an ID of any kind. Unscoped: IDs are
+	// unique across tenants, so a restore that keeps them conflicts with the rows of
+	// any tenant. visible is false for rows in lists not owned by or shared with
+	// owner_id (NULL = unscoped internal access), whose kind is not reported.
+	FindTakenBackupIDs(ctx context.Context, arg FindTakenBackupIDsParams) ([]FindTakenBackupIDsRow, error)
+	// Timesheets
+	// ==========
+	// Periods of tracked time overlapping [period_start, period_end) on the items
+	// of the lists the caller can access, one row per period:
+	//   - entries: a time entry; items with entries are only reported by their entries
+	//   - status:  an in_progress period from task_status_history (ended_at NULL while
+	//              still in progress), with the item's actual_duration and the total of
+	//              its finished in_progress periods so a manual override can be detected
+	//   - manual:  an actual_duration set on an item that was never in progress,
+	//              reported at updated_at
+	FindTimesheetPeriods(ctx context.Context, arg FindTimesheetPeriodsParams) ([]FindTimesheetPeriodsRow, error)
+	// Advanced list query with filtering, sorting, and keyset pagination.
 	// Supports AIP-160-style filtering and AIP-132-style sorting.
 	//
 	// Parameters use nullable types for optional filters:
@@ -106,7 +250,13 @@ type Querier interface {
 	//   - order_by: Column to sort by ("created_at" or "title")
 	//   - order_dir: Sort direction ("asc" or "desc")
 	//   - page_limit: Maximum number of results to return
-	//   - page_offset: Number of results to skip
+	//   - after_sort_key: sort_key of the last list of the previous page
+	//   - after_id: ID of the last list of the previous page (NULL for the first page)
+	//   - owner_id: Restricts results to lists owned by or shared with one tenant (NULL = unscoped internal access)
+	//
+	// Lists are ordered by sort_key, then by id in the same direction, and a page
+	// continues strictly after (after_sort_key, after_id). sort_key is the sort column
+	// as JSONB (created_at as epoch seconds), the same encoding the item search uses.
 	FindTodoListsWithFilters(ctx context.Context, arg FindTodoListsWithFiltersParams) ([]FindTodoListsWithFiltersRow, error)
 	// SECURITY: Intentionally does NOT filter by expires_at to prevent timing attacks.
 	// If we filtered expired keys here, attackers could distinguish between:
@@ -116,18 +266,38 @@ type Querier interface {
 	// Expiration is checked in constant-time code (authenticator.go) after fetching.
 	GetAPIKeyByShortToken(ctx context.Context, shortToken string) (ApiKey, error)
 	GetAllTodoItems(ctx context.Context) ([]TodoItem, error)
+	GetAutomationRule(ctx context.Context, arg GetAutomationRuleParams) (AutomationRule, error)
+	// Feed requests carry no principal: the token is the credential
+	GetCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (CalendarFeed, error)
+	// Oldest transaction still running. Every change of an older transaction has
+	// committed or rolled back, so a reader of only those cannot be overtaken.
+	GetChangeWatermark(ctx context.Context) (uint64, error)
 	// Retrieve a specific dead letter job by ID.
-	GetDeadLetterJob(ctx context.Context, id pgtype.UUID) (DeadLetterJob, error)
+	// TENANCY: owner_id restricts to jobs of templates in lists owned by or shared with one tenant (NULL = unscoped internal access)
+	GetDeadLetterJob(ctx context.Context, arg GetDeadLetterJobParams) (DeadLetterJob, error)
 	// Retrieve the current lease holder for a run type.
 	GetLease(ctx context.Context, runType string) (CronJobLease, error)
+	// Returns the role of a principal on a list: 'owner' for the list owner,
+	// the membership role for members. No row when the principal has no access.
+	GetListRole(ctx context.Context, arg GetListRoleParams) (string, error)
+	GetListTag(ctx context.Context, arg GetListTagParams) (ListTag, error)
+	GetSavedView(ctx context.Context, arg GetSavedViewParams) (SavedView, error)
 	GetTaskStatusHistory(ctx context.Context, taskID string) ([]TaskStatusHistory, error)
 	GetTaskStatusHistoryByDateRange(ctx context.Context, arg GetTaskStatusHistoryByDateRangeParams) ([]TaskStatusHistory, error)
-	GetTodoItem(ctx context.Context, id string) (TodoItem, error)
+	// TENANCY: owner_id scopes the lookup to items in lists owned by or shared with one tenant (NULL = unscoped internal access).
+	GetTodoItem(ctx context.Context, arg GetTodoItemParams) (TodoItem, error)
+	// Items that no longer exist are omitted.
+	// TENANCY: owner_id omits items of lists not owned by or shared with one tenant (NULL = unscoped internal access).
+	GetTodoItemsByIDs(ctx context.Context, arg GetTodoItemsByIDsParams) ([]TodoItem, error)
 	GetTodoItemsByListId(ctx context.Context, listID string) ([]TodoItem, error)
-	GetTodoList(ctx context.Context, id string) (TodoList, error)
+	// TENANCY: owner_id scopes the lookup to lists owned by or shared with one tenant (NULL = unscoped internal access).
+	// Lists of other tenants are reported as not found.
+	GetTodoList(ctx context.Context, arg GetTodoListParams) (TodoList, error)
 	// Returns a single list by ID with item counts (for detail view).
 	// undone_statuses parameter: domain layer defines which statuses count as "undone".
+	// TENANCY: owner_id scopes the lookup to lists owned by or shared with one tenant (NULL = unscoped internal access).
 	GetTodoListWithCounts(ctx context.Context, arg GetTodoListWithCountsParams) (GetTodoListWithCountsRow, error)
+	GetWebhookSubscription(ctx context.Context, arg GetWebhookSubscriptionParams) (WebhookSubscription, error)
 	// Check if a template has any pending, running, or scheduled job.
 	// Used to prevent duplicate job creation.
 	HasPendingOrRunningJob(ctx context.Context, templateID string) (bool, error)
@@ -162,55 +332,53 @@ type Querier interface {
 	InsertItemIgnoreConflict(ctx context.Context, arg InsertItemIgnoreConflictParams) error
 	ListActiveAPIKeys(ctx context.Context) ([]ApiKey, error)
 	ListAllActiveRecurringTemplates(ctx context.Context) ([]RecurringTaskTemplate, error)
-	ListAllExceptionsByTemplate(ctx context.Context, templateID pgtype.UUID) ([]RecurringTemplateException, error)
-	ListAllRecurringTemplatesByList(ctx context.Context, listID string) ([]RecurringTaskTemplate, error)
+	ListAllExceptionsByTemplate(ctx context.Context, arg ListAllExceptionsByTemplateParams) ([]RecurringTemplateException, error)
+	ListAllRecurringTemplatesByList(ctx context.Context, arg ListAllRecurringTemplatesByListParams) ([]RecurringTaskTemplate, error)
+	// Most recent runs of a rule, newest first.
+	ListAutomationRuleRuns(ctx context.Context, arg ListAutomationRuleRunsParams) ([]AutomationRuleRun, error)
+	ListAutomationRules(ctx context.Context, listID string) ([]AutomationRule, error)
+	ListCalendarFeeds(ctx context.Context, arg ListCalendarFeedsParams) ([]CalendarFeed, error)
+	// Changes visible to an event stream, in (txid, seq) order.
+	//   watermark:            only transactions older than this (from GetChangeWatermark)
+	//   after_txid/after_seq: only changes after this position
+	//   list_id:              one list, or NULL for every list the owner can access
+	//   owner_id:             restricts to lists owned by or shared with one tenant (NULL = unscoped internal access)
+	//   entity_types:         entity types to include
+	ListChangesForFeed(ctx context.Context, arg ListChangesForFeedParams) ([]ChangeLog, error)
+	// Changes to lists, items and templates for delta sync, in (txid, seq) order.
+	//   watermark:            only transactions older than this (from GetChangeWatermark)
+	//   after_txid/after_seq: only changes after this position
+	//   owner_id:             restricts to changes one tenant can see (NULL = unscoped internal access):
+	//                         changes in or moving out of lists owned by or shared with it,
+	//                         and revocations of its memberships
+	// previous_list_id is the list an item or template was moved out of, if the change moved it.
+	ListChangesForSync(ctx context.Context, arg ListChangesForSyncParams) ([]ListChangesForSyncRow, error)
+	// Unresolved dead letter reminders, newest first.
+	// TENANCY: owner_id restricts to items in lists owned by or shared with one tenant (NULL = unscoped internal access)
+	ListDeadLetterReminders(ctx context.Context, arg ListDeadLetterRemindersParams) ([]ListDeadLetterRemindersRow, error)
+	// Rules of a list that fire on a trigger, in the order they run.
+	ListEnabledAutomationRules(ctx context.Context, arg ListEnabledAutomationRulesParams) ([]AutomationRule, error)
+	ListItemReminders(ctx context.Context, itemID string) ([]ItemReminder, error)
+	ListListMembers(ctx context.Context, listID string) ([]ListMember, error)
+	// Tag Registry
+	// ============
+	// Registered tags belong to a list; access is checked by the service against the list.
+	ListListTags(ctx context.Context, listID string) ([]ListTag, error)
+	// Overdue Triggers
+	// ================
+	// Open items that have been overdue for at least the overdue_by of an enabled
+	// rule of their list, and that the rule has not fired for at their due date yet.
+	ListOverdueRuleCandidates(ctx context.Context, arg ListOverdueRuleCandidatesParams) ([]ListOverdueRuleCandidatesRow, error)
 	// Retrieve unresolved dead letter jobs for admin review.
 	// Ordered by failure time (most recent first).
-	ListPendingDeadLetterJobs(ctx context.Context, limit int32) ([]DeadLetterJob, error)
-	ListRecurringTemplates(ctx context.Context, listID string) ([]RecurringTaskTemplate, error)
-	// Optimized for SEARCH/FILTER access pattern: Database-level filtering, sorting, and pagination.
-	// Performance: Pushes all operations to PostgreSQL with proper indexes vs loading all items to memory.
-	// Use case: Task search, filtered views, "My Tasks" views, pagination through large result sets.
-	//
-	// Parameters (use empty arrays for NULL to skip filters):
-	//   $1: list_id           - Filter by specific list (zero UUID to search all lists)
-	//   $2: statuses          - Array of statuses to include (empty array to skip, OR logic)
-	//   $3: priorities        - Array of priorities to include (empty array to skip, OR logic)
-	//   $4: tags              - Array of tags to match (empty array to skip, item must have ALL tags)
-	//   $5: due_before        - Filter tasks due before timestamp (zero time to skip)
-	//   $6: due_after         - Filter tasks due after timestamp (zero time to skip)
-	//   $7: updated_at        - Filter by last update time (zero time to skip)
-	//   $8: created_at        - Filter by creation time (zero time to skip)
-	//   $9: order_by          - Combined field+direction: 'due_at_asc', 'due_at_desc', etc.
-	//                           Supports: due_at, priority, created_at, updated_at with _asc or _desc suffix
-	//                           For bare field names, defaults are: due_at=asc, priority=asc,
-	//                           created_at=desc, updated_at=desc
-	//   $10: limit            - Page size (max items to return)
-	//   $11: offset           - Pagination offset (skip N items)
-	//   $12: excluded_statuses - Array of statuses to exclude (empty array to skip filter)
-	//                           Used to exclude archived/cancelled by default when $2 is empty
-	//
-	// Returns: All todo_items columns plus total_count (total matching rows across all pages)
-	// The COUNT(*) OVER() window function computes total matching rows in a single query pass,
-	// enabling accurate pagination UI without a separate count query.
-	//
-	// SQL Injection Protection:
-	// The ORDER BY clause uses parameterized queries ($9::text) with CASE expressions.
-	// PostgreSQL's parameterized query protocol treats $9 as DATA, never CODE, making SQL
-	// injection structurally impossible. Even if malicious input like "id; DROP TABLE--"
-	// flows through without validation, it's compared as a string literal in CASE expressions,
-	// never executed as SQL. This protection is guaranteed by PostgreSQL's wire protocol.
-	// See tests/integration/sql_injection_resistance_test.go for proof.
-	//
-	// Input validation at the service layer improves UX (clear error messages) but does NOT
-	// provide security - parameterized queries are the security boundary.
-	//
-	// Access pattern examples:
-	//   - "Show my overdue tasks": filter by due_before=now, order by due_at_asc
-	//   - "Active work": statuses=[todo, in_progress], default sort
-	//   - "High priority items": priorities=[high, urgent], order by due_at_asc
-	//   - "Tasks tagged 'urgent' and 'work'": tags=[urgent, work] (item must have both)
-	ListTasksWithFilters(ctx context.Context, arg ListTasksWithFiltersParams) ([]ListTasksWithFiltersRow, error)
+	// TENANCY: owner_id restricts to jobs of templates in lists owned by or shared with one tenant (NULL = unscoped internal access)
+	ListPendingDeadLetterJobs(ctx context.Context, arg ListPendingDeadLetterJobsParams) ([]DeadLetterJob, error)
+	ListRecurringTemplates(ctx context.Context, arg ListRecurringTemplatesParams) ([]RecurringTaskTemplate, error)
+	ListSavedViews(ctx context.Context, ownerID pgtype.UUID) ([]SavedView, error)
+	// Reports whether a tag is registered on a list or used by its items or templates.
+	// The item check is a containment test served by the GIN index on tags.
+	ListTagInUse(ctx context.Context, arg ListTagInUseParams) (bool, error)
+	ListTimeEntries(ctx context.Context, itemID string) ([]TimeEntry, error)
 	// Legacy query: Returns all lists without items (use ListTodoListsWithCounts for list views).
 	ListTodoLists(ctx context.Context) ([]TodoList, error)
 	// Optimized for LIST VIEW access pattern: Returns list metadata with item counts.
@@ -224,7 +392,21 @@ type Querier interface {
 	// This query uses LEFT JOIN to ensure lists with zero items still appear with count=0.
 	// The FILTER clause efficiently counts only matching items in a single pass.
 	ListTodoListsWithCounts(ctx context.Context, undoneStatuses []string) ([]ListTodoListsWithCountsRow, error)
+	// Returns the given lists with item counts. Lists that no longer exist are omitted.
+	// TENANCY: owner_id omits lists not owned by or shared with one tenant (NULL = unscoped internal access).
+	ListTodoListsWithCountsByIDs(ctx context.Context, arg ListTodoListsWithCountsByIDsParams) ([]ListTodoListsWithCountsByIDsRow, error)
+	// Change Log - Transactional Outbox
+	// =================================
+	// Rows are written by the record_change() trigger; the application only reads
+	// and publishes them.
+	// Oldest unpublished changes first, so the relay publishes in commit order per entity.
+	ListUnpublishedChanges(ctx context.Context, limit int32) ([]ChangeLog, error)
+	// Recent deliveries of a subscription, newest first, optionally filtered by status.
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhookSubscriptions(ctx context.Context, ownerID pgtype.UUID) ([]WebhookSubscription, error)
+	MarkChangesPublished(ctx context.Context, seqs []int64) (int64, error)
 	// Mark a dead letter job as discarded with admin note.
+	// TENANCY: owner_id restricts to jobs of templates in lists owned by or shared with one tenant (NULL = unscoped internal access)
 	MarkDeadLetterAsDiscarded(ctx context.Context, arg MarkDeadLetterAsDiscardedParams) (int64, error)
 	// Mark a dead letter job as retried by admin.
 	MarkDeadLetterAsRetried(ctx context.Context, arg MarkDeadLetterAsRetriedParams) (int64, error)
@@ -234,19 +416,61 @@ type Querier interface {
 	// Mark a claimed job as running with worker ownership and availability timeout.
 	// Returns 0 rows if job doesn't exist or was already claimed by another worker.
 	MarkJobAsRunning(ctx context.Context, arg MarkJobAsRunningParams) (int64, error)
+	// Park a reminder that failed permanently or exhausted its retries, and record
+	// it in dead_letter_reminders in the same statement.
+	MarkReminderDead(ctx context.Context, arg MarkReminderDeadParams) (int64, error)
+	// Park a delivery that failed permanently or exhausted its retries.
+	MarkWebhookDeliveryDead(ctx context.Context, arg MarkWebhookDeliveryDeadParams) (int64, error)
+	// Follows an item to the list it moved to.
+	MoveTimeEntries(ctx context.Context, arg MoveTimeEntriesParams) error
+	// Moves an item to another list.
+	// Returns pgx.ErrNoRows when the item is not in from_list_id.
+	MoveTodoItem(ctx context.Context, arg MoveTodoItemParams) (TodoItem, error)
+	// Release a dead reminder for immediate delivery with a fresh retry budget.
+	RearmDeadReminder(ctx context.Context, id string) error
+	// Records the current item counts of a batch of lists as their snapshot of day,
+	// replacing an earlier snapshot of the same day. Lists are taken in ID order
+	// after after_list_id (NULL = from the first list); returns the recorded list IDs.
+	// INTERNAL: unscoped, called by the snapshot worker only.
+	RecordListSnapshots(ctx context.Context, arg RecordListSnapshotsParams) ([]string, error)
+	// Returns 0 rows when the rule already fired for the item at this due date.
+	RecordOverdueRuleFire(ctx context.Context, arg RecordOverdueRuleFireParams) (int64, error)
+	// Queue a fresh delivery of the same event payload.
+	// Returns pgx.ErrNoRows when the delivery does not belong to the subscription.
+	RedeliverWebhookDelivery(ctx context.Context, arg RedeliverWebhookDeliveryParams) (WebhookDelivery, error)
 	// Release a lease held by the specified holder.
 	// Only succeeds if the lease is currently held by this holder.
 	ReleaseLease(ctx context.Context, arg ReleaseLeaseParams) (int64, error)
+	// Moves the registration of a tag to a new name.
+	RenameListTag(ctx context.Context, arg RenameListTagParams) (int64, error)
 	// Renew an existing lease by extending its expiration.
 	// Only succeeds if the lease is currently held by this holder.
 	RenewLease(ctx context.Context, arg RenewLeaseParams) (int64, error)
+	// Replaces the source tags of every item of a list with the target tag, keeping
+	// the order of the tags and dropping the duplicates the replacement creates.
+	// Items are found by overlap with the sources, served by the GIN index on tags.
+	ReplaceItemTags(ctx context.Context, arg ReplaceItemTagsParams) (int64, error)
+	// Same as ReplaceItemTags, for the recurring templates of a list.
+	ReplaceTemplateTags(ctx context.Context, arg ReplaceTemplateTagsParams) (int64, error)
 	// Request cancellation for a running job (sets cancelling status).
 	// Worker must cooperatively stop processing when it sees this status.
 	RequestCancellationForRunningJob(ctx context.Context, id string) (int64, error)
+	// Mark an unresolved dead letter reminder as retried or discarded.
+	// Returns pgx.ErrNoRows when the entry does not exist, is resolved or belongs to another tenant.
+	// TENANCY: owner_id restricts to items in lists owned by or shared with one tenant (NULL = unscoped internal access)
+	ResolveDeadLetterReminder(ctx context.Context, arg ResolveDeadLetterReminderParams) (ResolveDeadLetterReminderRow, error)
 	// Reschedule job for retry with incremented retry count.
 	// Only succeeds if job is still owned by the specified worker.
 	ScheduleJobRetry(ctx context.Context, arg ScheduleJobRetryParams) (int64, error)
+	// Release a reminder for another attempt at available_at.
+	ScheduleReminderRetry(ctx context.Context, arg ScheduleReminderRetryParams) (int64, error)
+	// Release a delivery for another attempt at available_at.
+	ScheduleWebhookDeliveryRetry(ctx context.Context, arg ScheduleWebhookDeliveryRetryParams) (int64, error)
 	SetGeneratedThrough(ctx context.Context, arg SetGeneratedThroughParams) (int64, error)
+	// Stops the running timer of a principal, on any item when item_id is NULL.
+	// Notes are appended to the ones given at start.
+	// Returns pgx.ErrNoRows when no timer is running.
+	StopRunningTimeEntry(ctx context.Context, arg StopRunningTimeEntryParams) (TimeEntry, error)
 	// Atomically try to acquire or renew a lease for exclusive execution.
 	// Uses INSERT ON CONFLICT to handle both initial acquisition and renewal.
 	// Returns the lease if successfully acquired/renewed, NULL otherwise.
@@ -255,22 +479,26 @@ type Querier interface {
 	// Returns 0 rows affected if: (1) key doesn't exist, OR (2) timestamp not later.
 	// Repository uses CheckAPIKeyExists to distinguish these cases.
 	UpdateAPIKeyLastUsed(ctx context.Context, arg UpdateAPIKeyLastUsedParams) (int64, error)
+	UpdateAutomationRule(ctx context.Context, arg UpdateAutomationRuleParams) (AutomationRule, error)
+	// No row returned when the principal is not a member of the list.
+	UpdateListMemberRole(ctx context.Context, arg UpdateListMemberRoleParams) (ListMember, error)
+	// No row returned when the tag is not registered.
+	UpdateListTag(ctx context.Context, arg UpdateListTagParams) (ListTag, error)
 	// Field mask pattern with optimistic locking support
+	// TENANCY: owner_id restricts updates to templates in lists owned by or shared with one tenant (NULL = unscoped)
 	UpdateRecurringTemplate(ctx context.Context, arg UpdateRecurringTemplateParams) (RecurringTaskTemplate, error)
+	UpdateSavedView(ctx context.Context, arg UpdateSavedViewParams) (SavedView, error)
 	// DATA ACCESS PATTERN: Partial update with explicit flags
 	// Supports field masks by passing boolean flags for fields to update
 	// Returns updated row, or pgx.ErrNoRows if:
 	//   - Item doesn't exist
 	//   - Item belongs to different list (security: prevents cross-list updates)
 	//   - Version mismatch (concurrency: prevents lost updates)
+	//   - List is not visible to the tenant (when owner_id provided)
 	// SECURITY: Validates item belongs to the specified list
 	// CONCURRENCY: Optional version check for optimistic locking
 	// TYPE SAFETY: All fields managed by sqlc - schema changes caught at compile time
 	UpdateTodoItem(ctx context.Context, arg UpdateTodoItemParams) (TodoItem, error)
-	// DATA ACCESS PATTERN: Single-query existence check via rowsAffected
-	// :execrows returns (int64, error) - Repository checks rowsAffected == 0 → domain.ErrNotFound
-	// Efficient status updates without separate existence check
-	UpdateTodoItemStatus(ctx context.Context, arg UpdateTodoItemStatusParams) (int64, error)
 	// ATOMIC UPDATE WITH COUNTS: Uses CTE to update and return counts in single statement.
 	// Prevents race conditions where counts could change between UPDATE and SELECT.
 	//
@@ -280,7 +508,13 @@ type Querier interface {
 	// Returns no rows if:
 	//   - List doesn't exist
 	//   - Version mismatch (when expected_version provided)
+	//   - List is not visible to the tenant (when owner_id provided)
 	UpdateTodoList(ctx context.Context, arg UpdateTodoListParams) (UpdateTodoListRow, error)
+	UpdateWebhookSubscription(ctx context.Context, arg UpdateWebhookSubscriptionParams) (WebhookSubscription, error)
+	// Clears the snooze of up to row_limit items snoozed until now or earlier.
+	// The change log records each as an update clearing snoozed_until, which is
+	// published as item.woke. SKIP LOCKED leaves items being edited to a later pass.
+	WakeSnoozedItems(ctx context.Context, arg WakeSnoozedItemsParams) ([]string, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -19,9 +19,14 @@ INSERT INTO recurring_template_exceptions (
     exception_type,
     item_id,
     created_at
-) VALUES (
-    $1, $2, $3, $4, $5, $6
-) RETURNING id, template_id, occurs_at, exception_type, item_id, created_at
+)
+SELECT $1, $2, $3, $4, $5, $6
+WHERE $7::uuid IS NULL
+   OR EXISTS (
+       SELECT 1 FROM recurring_task_templates t
+       WHERE t.id = $2 AND list_visible_to(t.list_id, $7::uuid)
+   )
+RETURNING id, template_id, occurs_at, exception_type, item_id, created_at
 `
 
 type CreateExceptionParams struct {
@@ -31,8 +36,11 @@ type CreateExceptionParams struct {
 	ExceptionType string             `json:"exception_type"`
 	ItemID        pgtype.UUID        `json:"item_id"`
 	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	OwnerID       pgtype.UUID        `json:"owner_id"`
 }
 
+// TENANCY: Inserts only when the template's list is owned by or shared with owner_id (NULL = unscoped internal access).
+// Returns pgx.ErrNoRows when the template is not visible to the tenant.
 func (q *Queries) CreateException(ctx context.Context, arg CreateExceptionParams) (RecurringTemplateException, error) {
 	row := q.db.QueryRow(ctx, createException,
 		arg.ID,
@@ -41,6 +49,7 @@ func (q *Queries) CreateException(ctx context.Context, arg CreateExceptionParams
 		arg.ExceptionType,
 		arg.ItemID,
 		arg.CreatedAt,
+		arg.OwnerID,
 	)
 	var i RecurringTemplateException
 	err := row.Scan(
@@ -55,32 +64,42 @@ func (q *Queries) CreateException(ctx context.Context, arg CreateExceptionParams
 }
 
 const deleteException = `-- name: DeleteException :exec
-DELETE FROM recurring_template_exceptions
-WHERE template_id = $1 AND occurs_at = $2
+DELETE FROM recurring_template_exceptions e
+WHERE e.template_id = $1 AND e.occurs_at = $2
+  AND ($3::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM recurring_task_templates t
+      WHERE t.id = e.template_id AND list_visible_to(t.list_id, $3::uuid)
+  ))
 `
 
 type DeleteExceptionParams struct {
 	TemplateID pgtype.UUID        `json:"template_id"`
 	OccursAt   pgtype.Timestamptz `json:"occurs_at"`
+	OwnerID    pgtype.UUID        `json:"owner_id"`
 }
 
 func (q *Queries) DeleteException(ctx context.Context, arg DeleteExceptionParams) error {
-	_, err := q.db.Exec(ctx, deleteException, arg.TemplateID, arg.OccursAt)
+	_, err := q.db.Exec(ctx, deleteException, arg.TemplateID, arg.OccursAt, arg.OwnerID)
 	return err
 }
 
 const findExceptionByOccurrence = `-- name: FindExceptionByOccurrence :one
-SELECT id, template_id, occurs_at, exception_type, item_id, created_at FROM recurring_template_exceptions
-WHERE template_id = $1 AND occurs_at = $2
+SELECT e.id, e.template_id, e.occurs_at, e.exception_type, e.item_id, e.created_at FROM recurring_template_exceptions e
+WHERE e.template_id = $1 AND e.occurs_at = $2
+  AND ($3::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM recurring_task_templates t
+      WHERE t.id = e.template_id AND list_visible_to(t.list_id, $3::uuid)
+  ))
 `
 
 type FindExceptionByOccurrenceParams struct {
 	TemplateID pgtype.UUID        `json:"template_id"`
 	OccursAt   pgtype.Timestamptz `json:"occurs_at"`
+	OwnerID    pgtype.UUID        `json:"owner_id"`
 }
 
 func (q *Queries) FindExceptionByOccurrence(ctx context.Context, arg FindExceptionByOccurrenceParams) (RecurringTemplateException, error) {
-	row := q.db.QueryRow(ctx, findExceptionByOccurrence, arg.TemplateID, arg.OccursAt)
+	row := q.db.QueryRow(ctx, findExceptionByOccurrence, arg.TemplateID, arg.OccursAt, arg.OwnerID)
 	var i RecurringTemplateException
 	err := row.Scan(
 		&i.ID,
@@ -94,20 +113,32 @@ func (q *Queries) FindExceptionByOccurrence(ctx context.Context, arg FindExcepti
 }
 
 const findExceptions = `-- name: FindExceptions :many
-SELECT id, template_id, occurs_at, exception_type, item_id, created_at FROM recurring_template_exceptions
-WHERE template_id = $1
-  AND occurs_at BETWEEN $2 AND $3
-ORDER BY occurs_at
+SELECT e.id, e.template_id, e.occurs_at, e.exception_type, e.item_id, e.created_at FROM recurring_template_exceptions e
+WHERE e.template_id = $1
+  AND e.occurs_at BETWEEN $2 AND $3
+  AND ($4::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM recurring_task_templates t
+      WHERE t.id = e.template_id AND list_visible_to(t.list_id, $4::uuid)
+  ))
+ORDER BY e.occurs_at
 `
 
 type FindExceptionsParams struct {
 	TemplateID pgtype.UUID        `json:"template_id"`
-	OccursAt   pgtype.Timestamptz `json:"occurs_at"`
-	OccursAt_2 pgtype.Timestamptz `json:"occurs_at_2"`
+	OccursFrom pgtype.Timestamptz `json:"occurs_from"`
+	OccursTo   pgtype.Timestamptz `json:"occurs_to"`
+	OwnerID    pgtype.UUID        `json:"owner_id"`
 }
 
+// Used by the generation worker (unscoped) and by tenant requests.
+// owner_id restricts exceptions to templates in lists owned by or shared with one tenant (NULL = unscoped internal access).
 func (q *Queries) FindExceptions(ctx context.Context, arg FindExceptionsParams) ([]RecurringTemplateException, error) {
-	rows, err := q.db.Query(ctx, findExceptions, arg.TemplateID, arg.OccursAt, arg.OccursAt_2)
+	rows, err := q.db.Query(ctx, findExceptions,
+		arg.TemplateID,
+		arg.OccursFrom,
+		arg.OccursTo,
+		arg.OwnerID,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -134,13 +165,22 @@ func (q *Queries) FindExceptions(ctx context.Context, arg FindExceptionsParams)
 }
 
 const listAllExceptionsByTemplate = `-- name: ListAllExceptionsByTemplate :many
-SELECT id, template_id, occurs_at, exception_type, item_id, created_at FROM recurring_template_exceptions
-WHERE template_id = $1
-ORDER BY occurs_at
+SELECT e.id, e.template_id, e.occurs_at, e.exception_type, e.item_id, e.created_at FROM recurring_template_exceptions e
+WHERE e.template_id = $1
+  AND ($2::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM recurring_task_templates t
+      WHERE t.id = e.template_id AND list_visible_to(t.list_id, $2::uuid)
+  ))
+ORDER BY e.occurs_at
 `
 
-func (q *Queries) ListAllExceptionsByTemplate(ctx context.Context, templateID pgtype.UUID) ([]RecurringTemplateException, error) {
-	rows, err := q.db.Query(ctx, listAllExceptionsByTemplate, templateID)
+type ListAllExceptionsByTemplateParams struct {
+	TemplateID pgtype.UUID `json:"template_id"`
+	OwnerID    pgtype.UUID `json:"owner_id"`
+}
+
+func (q *Queries) ListAllExceptionsByTemplate(ctx context.Context, arg ListAllExceptionsByTemplateParams) ([]RecurringTemplateException, error) {
+	rows, err := q.db.Query(ctx, listAllExceptionsByTemplate, arg.TemplateID, arg.OwnerID)
 	if err != nil {
 		return nil, err
 	}
@@ -19,16 +19,20 @@ INSERT INTO recurring_task_templates (
     id, list_id, title, tags, priority, estimated_duration,
     recurrence_pattern, recurrence_config, due_offset,
     is_active, created_at, updated_at,
-    generated_through, sync_horizon_days, generation_horizon_days
-) VALUES (
+    generated_through, sync_horizon_days, generation_horizon_days,
+    custom_fields, default_reminders
+)
+SELECT
     $1, $2, $3, $4, $5,
     $6,
     $7, $8,
     $9,
     $10, $11, $12,
-    $13, $14, $15
-)
-RETURNING id, list_id, title, tags, priority, estimated_duration, recurrence_pattern, recurrence_config, due_offset, is_active, created_at, updated_at, generated_through, sync_horizon_days, generation_horizon_days, version
+    $13, $14, $15,
+    $16, $17
+WHERE $18::uuid IS NULL
+   OR list_visible_to($2, $18::uuid)
+RETURNING id, list_id, title, tags, priority, estimated_duration, recurrence_pattern, recurrence_config, due_offset, is_active, created_at, updated_at, generated_through, sync_horizon_days, generation_horizon_days, version, custom_fields, default_reminders
 `
 
 type CreateRecurringTemplateParams struct {
@@ -47,8 +51,13 @@ type CreateRecurringTemplateParams struct {
 	GeneratedThrough      pgtype.Date      `json:"generated_through"`
 	SyncHorizonDays       int32            `json:"sync_horizon_days"`
 	GenerationHorizonDays int32            `json:"generation_horizon_days"`
+	CustomFields          []byte           `json:"custom_fields"`
+	DefaultReminders      []byte           `json:"default_reminders"`
+	OwnerID               pgtype.UUID      `json:"owner_id"`
 }
 
+// TENANCY: Inserts only when the list is owned by or shared with owner_id (NULL = unscoped internal access).
+// Returns pgx.ErrNoRows when the list is not visible to the tenant, so it is reported as not found.
 func (q *Queries) CreateRecurringTemplate(ctx context.Context, arg CreateRecurringTemplateParams) (RecurringTaskTemplate, error) {
 	row := q.db.QueryRow(ctx, createRecurringTemplate,
 		arg.ID,
@@ -66,6 +75,9 @@ func (q *Queries) CreateRecurringTemplate(ctx context.Context, arg CreateRecurri
 		arg.GeneratedThrough,
 		arg.SyncHorizonDays,
 		arg.GenerationHorizonDays,
+		arg.CustomFields,
+		arg.DefaultReminders,
+		arg.OwnerID,
 	)
 	var i RecurringTaskTemplate
 	err := row.Scan(
@@ -85,6 +97,8 @@ func (q *Queries) CreateRecurringTemplate(ctx context.Context, arg CreateRecurri
 		&i.SyncHorizonDays,
 		&i.GenerationHorizonDays,
 		&i.Version,
+		&i.CustomFields,
+		&i.DefaultReminders,
 	)
 	return i, err
 }
@@ -93,19 +107,22 @@ const deactivateRecurringTemplate = `-- name: DeactivateRecurringTemplate :execr
 UPDATE recurring_task_templates
 SET is_active = false,
     updated_at = $1
-WHERE id = $2
+WHERE recurring_task_templates.id = $2
+  AND ($3::uuid IS NULL OR list_visible_to(recurring_task_templates.list_id, $3::uuid))
 `
 
 type DeactivateRecurringTemplateParams struct {
-	UpdatedAt time.Time `json:"updated_at"`
-	ID        string    `json:"id"`
+	UpdatedAt time.Time   `json:"updated_at"`
+	ID        string      `json:"id"`
+	OwnerID   pgtype.UUID `json:"owner_id"`
 }
 
 // DATA ACCESS PATTERN: Single-query existence check via rowsAffected
 // :execrows returns (int64, error) - Repository checks rowsAffected == 0 → domain.ErrNotFound
 // Soft delete with existence detection in single operation
+// TENANCY: owner_id restricts deactivation to templates in lists owned by or shared with one tenant (NULL = unscoped)
 func (q *Queries) DeactivateRecurringTemplate(ctx context.Context, arg DeactivateRecurringTemplateParams) (int64, error) {
-	result, err := q.db.Exec(ctx, deactivateRecurringTemplate, arg.UpdatedAt, arg.ID)
+	result, err := q.db.Exec(ctx, deactivateRecurringTemplate, arg.UpdatedAt, arg.ID, arg.OwnerID)
 	if err != nil {
 		return 0, err
 	}
@@ -130,12 +147,20 @@ func (q *Queries) DeleteFutureRecurringInstances(ctx context.Context, templateID
 }
 
 const findRecurringTemplateByID = `-- name: FindRecurringTemplateByID :one
-SELECT id, list_id, title, tags, priority, estimated_duration, recurrence_pattern, recurrence_config, due_offset, is_active, created_at, updated_at, generated_through, sync_horizon_days, generation_horizon_days, version FROM recurring_task_templates
-WHERE id = $1
+SELECT t.id, t.list_id, t.title, t.tags, t.priority, t.estimated_duration, t.recurrence_pattern, t.recurrence_config, t.due_offset, t.is_active, t.created_at, t.updated_at, t.generated_through, t.sync_horizon_days, t.generation_horizon_days, t.version, t.custom_fields, t.default_reminders FROM recurring_task_templates t
+WHERE t.id = $1
+  AND ($2::uuid IS NULL OR list_visible_to(t.list_id, $2::uuid))
 `
 
-func (q *Queries) FindRecurringTemplateByID(ctx context.Context, id string) (RecurringTaskTemplate, error) {
-	row := q.db.QueryRow(ctx, findRecurringTemplateByID, id)
+type FindRecurringTemplateByIDParams struct {
+	ID      string      `json:"id"`
+	OwnerID pgtype.UUID `json:"owner_id"`
+}
+
+// TENANCY: owner_id scopes the lookup to templates in lists owned by or shared with one tenant (NULL = unscoped internal access).
+// Background workers pass NULL to process templates of all tenants.
+func (q *Queries) FindRecurringTemplateByID(ctx context.Context, arg FindRecurringTemplateByIDParams) (RecurringTaskTemplate, error) {
+	row := q.db.QueryRow(ctx, findRecurringTemplateByID, arg.ID, arg.OwnerID)
 	var i RecurringTaskTemplate
 	err := row.Scan(
 		&i.ID,
@@ -154,12 +179,66 @@ func (q *Queries) FindRecurringTemplateByID(ctx context.Context, id string) (Rec
 		&i.SyncHorizonDays,
 		&i.GenerationHorizonDays,
 		&i.Version,
+		&i.CustomFields,
+		&i.DefaultReminders,
 	)
 	return i, err
 }
 
+const findRecurringTemplatesByIDs = `-- name: FindRecurringTemplatesByIDs :many
+SELECT t.id, t.list_id, t.title, t.tags, t.priority, t.estimated_duration, t.recurrence_pattern, t.recurrence_config, t.due_offset, t.is_active, t.created_at, t.updated_at, t.generated_through, t.sync_horizon_days, t.generation_horizon_days, t.version, t.custom_fields, t.default_reminders FROM recurring_task_templates t
+WHERE t.id = ANY($1::uuid[])
+  AND ($2::uuid IS NULL OR list_visible_to(t.list_id, $2::uuid))
+`
+
+type FindRecurringTemplatesByIDsParams struct {
+	Ids     []pgtype.UUID `json:"ids"`
+	OwnerID pgtype.UUID   `json:"owner_id"`
+}
+
+// Templates that no longer exist are omitted.
+// TENANCY: owner_id omits templates of lists not owned by or shared with one tenant (NULL = unscoped internal access).
+func (q *Queries) FindRecurringTemplatesByIDs(ctx context.Context, arg FindRecurringTemplatesByIDsParams) ([]RecurringTaskTemplate, error) {
+	rows, err := q.db.Query(ctx, findRecurringTemplatesByIDs, arg.Ids, arg.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RecurringTaskTemplate{}
+	for rows.Next() {
+		var i RecurringTaskTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.ListID,
+			&i.Title,
+			&i.Tags,
+			&i.Priority,
+			&i.EstimatedDuration,
+			&i.RecurrencePattern,
+			&i.RecurrenceConfig,
+			&i.DueOffset,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.GeneratedThrough,
+			&i.SyncHorizonDays,
+			&i.GenerationHorizonDays,
+			&i.Version,
+			&i.CustomFields,
+			&i.DefaultReminders,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findStaleTemplatesForReconciliation = `-- name: FindStaleTemplatesForReconciliation :many
-SELECT t.id, t.list_id, t.title, t.tags, t.priority, t.estimated_duration, t.recurrence_pattern, t.recurrence_config, t.due_offset, t.is_active, t.created_at, t.updated_at, t.generated_through, t.sync_horizon_days, t.generation_horizon_days, t.version FROM recurring_task_templates t
+SELECT t.id, t.list_id, t.title, t.tags, t.priority, t.estimated_duration, t.recurrence_pattern, t.recurrence_config, t.due_offset, t.is_active, t.created_at, t.updated_at, t.generated_through, t.sync_horizon_days, t.generation_horizon_days, t.version, t.custom_fields, t.default_reminders FROM recurring_task_templates t
 WHERE t.is_active = true
   AND t.generated_through < $1
   AND t.updated_at <= $2
@@ -219,6 +298,8 @@ func (q *Queries) FindStaleTemplatesForReconciliation(ctx context.Context, arg F
 			&i.SyncHorizonDays,
 			&i.GenerationHorizonDays,
 			&i.Version,
+			&i.CustomFields,
+			&i.DefaultReminders,
 		); err != nil {
 			return nil, err
 		}
@@ -231,7 +312,7 @@ func (q *Queries) FindStaleTemplatesForReconciliation(ctx context.Context, arg F
 }
 
 const listAllActiveRecurringTemplates = `-- name: ListAllActiveRecurringTemplates :many
-SELECT id, list_id, title, tags, priority, estimated_duration, recurrence_pattern, recurrence_config, due_offset, is_active, created_at, updated_at, generated_through, sync_horizon_days, generation_horizon_days, version FROM recurring_task_templates
+SELECT id, list_id, title, tags, priority, estimated_duration, recurrence_pattern, recurrence_config, due_offset, is_active, created_at, updated_at, generated_through, sync_horizon_days, generation_horizon_days, version, custom_fields, default_reminders FROM recurring_task_templates
 WHERE is_active = true
 ORDER BY created_at DESC
 `
@@ -262,6 +343,8 @@ func (q *Queries) ListAllActiveRecurringTemplates(ctx context.Context) ([]Recurr
 			&i.SyncHorizonDays,
 			&i.GenerationHorizonDays,
 			&i.Version,
+			&i.CustomFields,
+			&i.DefaultReminders,
 		); err != nil {
 			return nil, err
 		}
@@ -274,13 +357,19 @@ func (q *Queries) ListAllActiveRecurringTemplates(ctx context.Context) ([]Recurr
 }
 
 const listAllRecurringTemplatesByList = `-- name: ListAllRecurringTemplatesByList :many
-SELECT id, list_id, title, tags, priority, estimated_duration, recurrence_pattern, recurrence_config, due_offset, is_active, created_at, updated_at, generated_through, sync_horizon_days, generation_horizon_days, version FROM recurring_task_templates
-WHERE list_id = $1
-ORDER BY created_at DESC
+SELECT t.id, t.list_id, t.title, t.tags, t.priority, t.estimated_duration, t.recurrence_pattern, t.recurrence_config, t.due_offset, t.is_active, t.created_at, t.updated_at, t.generated_through, t.sync_horizon_days, t.generation_horizon_days, t.version, t.custom_fields, t.default_reminders FROM recurring_task_templates t
+WHERE t.list_id = $1
+  AND ($2::uuid IS NULL OR list_visible_to(t.list_id, $2::uuid))
+ORDER BY t.created_at DESC
 `
 
-func (q *Queries) ListAllRecurringTemplatesByList(ctx context.Context, listID string) ([]RecurringTaskTemplate, error) {
-	rows, err := q.db.Query(ctx, listAllRecurringTemplatesByList, listID)
+type ListAllRecurringTemplatesByListParams struct {
+	ListID  string      `json:"list_id"`
+	OwnerID pgtype.UUID `json:"owner_id"`
+}
+
+func (q *Queries) ListAllRecurringTemplatesByList(ctx context.Context, arg ListAllRecurringTemplatesByListParams) ([]RecurringTaskTemplate, error) {
+	rows, err := q.db.Query(ctx, listAllRecurringTemplatesByList, arg.ListID, arg.OwnerID)
 	if err != nil {
 		return nil, err
 	}
@@ -305,6 +394,8 @@ func (q *Queries) ListAllRecurringTemplatesByList(ctx context.Context, listID st
 			&i.SyncHorizonDays,
 			&i.GenerationHorizonDays,
 			&i.Version,
+			&i.CustomFields,
+			&i.DefaultReminders,
 		); err != nil {
 			return nil, err
 		}
@@ -317,13 +408,19 @@ func (q *Queries) ListAllRecurringTemplatesByList(ctx context.Context, listID st
 }
 
 const listRecurringTemplates = `-- name: ListRecurringTemplates :many
-SELECT id, list_id, title, tags, priority, estimated_duration, recurrence_pattern, recurrence_config, due_offset, is_active, created_at, updated_at, generated_through, sync_horizon_days, generation_horizon_days, version FROM recurring_task_templates
-WHERE list_id = $1 AND is_active = true
-ORDER BY created_at DESC
+SELECT t.id, t.list_id, t.title, t.tags, t.priority, t.estimated_duration, t.recurrence_pattern, t.recurrence_config, t.due_offset, t.is_active, t.created_at, t.updated_at, t.generated_through, t.sync_horizon_days, t.generation_horizon_days, t.version, t.custom_fields, t.default_reminders FROM recurring_task_templates t
+WHERE t.list_id = $1 AND t.is_active = true
+  AND ($2::uuid IS NULL OR list_visible_to(t.list_id, $2::uuid))
+ORDER BY t.created_at DESC
 `
 
-func (q *Queries) ListRecurringTemplates(ctx context.Context, listID string) ([]RecurringTaskTemplate, error) {
-	rows, err := q.db.Query(ctx, listRecurringTemplates, listID)
+type ListRecurringTemplatesParams struct {
+	ListID  string      `json:"list_id"`
+	OwnerID pgtype.UUID `json:"owner_id"`
+}
+
+func (q *Queries) ListRecurringTemplates(ctx context.Context, arg ListRecurringTemplatesParams) ([]RecurringTaskTemplate, error) {
+	rows, err := q.db.Query(ctx, listRecurringTemplates, arg.ListID, arg.OwnerID)
 	if err != nil {
 		return nil, err
 	}
@@ -348,6 +445,8 @@ func (q *Queries) ListRecurringTemplates(ctx context.Context, listID string) ([]
 			&i.SyncHorizonDays,
 			&i.GenerationHorizonDays,
 			&i.Version,
+			&i.CustomFields,
+			&i.DefaultReminders,
 		); err != nil {
 			return nil, err
 		}
@@ -392,11 +491,14 @@ SET title = CASE WHEN $1::boolean THEN $2 ELSE title END,
     is_active = CASE WHEN $15::boolean THEN $16 ELSE is_active END,
     sync_horizon_days = CASE WHEN $17::boolean THEN $18 ELSE sync_horizon_days END,
     generation_horizon_days = CASE WHEN $19::boolean THEN $20 ELSE generation_horizon_days END,
+    custom_fields = CASE WHEN $21::boolean THEN $22::jsonb ELSE custom_fields END,
+    default_reminders = CASE WHEN $23::boolean THEN $24::jsonb ELSE default_reminders END,
     updated_at = NOW(),
     version = version + 1
-WHERE id = $21
-  AND ($22::integer IS NULL OR version = $22::integer)
-RETURNING id, list_id, title, tags, priority, estimated_duration, recurrence_pattern, recurrence_config, due_offset, is_active, created_at, updated_at, generated_through, sync_horizon_days, generation_horizon_days, version
+WHERE recurring_task_templates.id = $25
+  AND ($26::uuid IS NULL OR list_visible_to(recurring_task_templates.list_id, $26::uuid))
+  AND ($27::integer IS NULL OR version = $27::integer)
+RETURNING id, list_id, title, tags, priority, estimated_duration, recurrence_pattern, recurrence_config, due_offset, is_active, created_at, updated_at, generated_through, sync_horizon_days, generation_horizon_days, version, custom_fields, default_reminders
 `
 
 type UpdateRecurringTemplateParams struct {
@@ -420,11 +522,17 @@ type UpdateRecurringTemplateParams struct {
 	SyncHorizonDays          pgtype.Int4      `json:"sync_horizon_days"`
 	SetGenerationHorizonDays bool             `json:"set_generation_horizon_days"`
 	GenerationHorizonDays    pgtype.Int4      `json:"generation_horizon_days"`
+	SetCustomFields          bool             `json:"set_custom_fields"`
+	CustomFields             []byte           `json:"custom_fields"`
+	SetDefaultReminders      bool             `json:"set_default_reminders"`
+	DefaultReminders         []byte           `json:"default_reminders"`
 	ID                       string           `json:"id"`
+	OwnerID                  pgtype.UUID      `json:"owner_id"`
 	ExpectedVersion          pgtype.Int4      `json:"expected_version"`
 }
 
 // Field mask pattern with optimistic locking support
+// TENANCY: owner_id restricts updates to templates in lists owned by or shared with one tenant (NULL = unscoped)
 func (q *Queries) UpdateRecurringTemplate(ctx context.Context, arg UpdateRecurringTemplateParams) (RecurringTaskTemplate, error) {
 	row := q.db.QueryRow(ctx, updateRecurringTemplate,
 		arg.SetTitle,
@@ -447,7 +555,12 @@ func (q *Queries) UpdateRecurringTemplate(ctx context.Context, arg UpdateRecurri
 		arg.SyncHorizonDays,
 		arg.SetGenerationHorizonDays,
 		arg.GenerationHorizonDays,
+		arg.SetCustomFields,
+		arg.CustomFields,
+		arg.SetDefaultReminders,
+		arg.DefaultReminders,
 		arg.ID,
+		arg.OwnerID,
 		arg.ExpectedVersion,
 	)
 	var i RecurringTaskTemplate
@@ -468,6 +581,8 @@ func (q *Queries) UpdateRecurringTemplate(ctx context.Context, arg UpdateRecurri
 		&i.SyncHorizonDays,
 		&i.GenerationHorizonDays,
 		&i.Version,
+		&i.CustomFields,
+		&i.DefaultReminders,
 	)
 	return i, err
 }
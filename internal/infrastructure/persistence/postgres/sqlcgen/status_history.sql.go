@@ -9,8 +9,19 @@ import (
 	"context"
 	"database/sql"
 	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type BatchCreateStatusHistoryEntriesParams struct {
+	ID         string           `json:"id"`
+	TaskID     string           `json:"task_id"`
+	FromStatus sql.Null[string] `json:"from_status"`
+	ToStatus   string           `json:"to_status"`
+	ChangedAt  time.Time        `json:"changed_at"`
+	Notes      sql.Null[string] `json:"notes"`
+}
+
 const createStatusHistoryEntry = `-- name: CreateStatusHistoryEntry :exec
 INSERT INTO task_status_history (id, task_id, from_status, to_status, changed_at, notes)
 VALUES ($1, $2, $3, $4, $5, $6)
@@ -37,6 +48,71 @@ func (q *Queries) CreateStatusHistoryEntry(ctx context.Context, arg CreateStatus
 	return err
 }
 
+const deleteStatusHistoryByTasks = `-- name: DeleteStatusHistoryByTasks :exec
+DELETE FROM task_status_history h
+WHERE h.task_id = ANY($1::uuid[])
+  AND ($2::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM todo_items ti
+      WHERE ti.id = h.task_id AND list_visible_to(ti.list_id, $2::uuid)
+  ))
+`
+
+type DeleteStatusHistoryByTasksParams struct {
+	TaskIds []pgtype.UUID `json:"task_ids"`
+	OwnerID pgtype.UUID   `json:"owner_id"`
+}
+
+// Clears the history the insert trigger recorded for restored items, before the backed up history is written.
+// TENANCY: owner_id restricts deletion to items in lists owned by or shared with one tenant (NULL = unscoped internal access).
+func (q *Queries) DeleteStatusHistoryByTasks(ctx context.Context, arg DeleteStatusHistoryByTasksParams) error {
+	_, err := q.db.Exec(ctx, deleteStatusHistoryByTasks, arg.TaskIds, arg.OwnerID)
+	return err
+}
+
+const findStatusHistoryByTasks = `-- name: FindStatusHistoryByTasks :many
+SELECT h.id, h.task_id, h.from_status, h.to_status, h.changed_at, h.notes FROM task_status_history h
+WHERE h.task_id = ANY($1::uuid[])
+  AND ($2::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM todo_items ti
+      WHERE ti.id = h.task_id AND list_visible_to(ti.list_id, $2::uuid)
+  ))
+ORDER BY h.task_id, h.changed_at, h.id
+`
+
+type FindStatusHistoryByTasksParams struct {
+	TaskIds []pgtype.UUID `json:"task_ids"`
+	OwnerID pgtype.UUID   `json:"owner_id"`
+}
+
+// Status history of several items, oldest change first per item.
+// TENANCY: owner_id restricts history to items in lists owned by or shared with one tenant (NULL = unscoped internal access).
+func (q *Queries) FindStatusHistoryByTasks(ctx context.Context, arg FindStatusHistoryByTasksParams) ([]TaskStatusHistory, error) {
+	rows, err := q.db.Query(ctx, findStatusHistoryByTasks, arg.TaskIds, arg.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TaskStatusHistory{}
+	for rows.Next() {
+		var i TaskStatusHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.ChangedAt,
+			&i.Notes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTaskStatusHistory = `-- name: GetTaskStatusHistory :many
 SELECT id, task_id, from_status, to_status, changed_at, notes FROM task_status_history
 WHERE task_id = $1
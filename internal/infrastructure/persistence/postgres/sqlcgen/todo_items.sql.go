@@ -32,61 +32,7 @@ type BatchCreateTodoItemsParams struct {
 	DueOffset           pgtype.Interval    `json:"due_offset"`
 	Timezone            sql.Null[string]   `json:"timezone"`
 	Version             int32              `json:"version"`
-}
-
-const countTasksWithFilters = `-- name: CountTasksWithFilters :one
-SELECT COUNT(*) FROM todo_items i
-LEFT JOIN recurring_template_exceptions e
-    ON i.recurring_template_id = e.template_id
-    AND i.occurs_at = e.occurs_at
-    AND e.exception_type = 'deleted'  -- Only match deleted exceptions
-WHERE
-    e.id IS NULL AND  -- Exclude only hard-deleted items (edited/rescheduled pass through)
-    ($1::uuid = '00000000-0000-0000-0000-000000000000' OR i.list_id = $1) AND
-    (array_length($2::text[], 1) IS NULL OR i.status = ANY($2::text[])) AND
-    (array_length($9::text[], 1) IS NULL OR i.status != ALL($9::text[])) AND
-    (array_length($3::text[], 1) IS NULL OR i.priority = ANY($3::text[])) AND
-    (array_length($4::text[], 1) IS NULL OR i.tags @> $4::text[]) AND
-    ($5::timestamptz = '0001-01-01 00:00:00+00' OR i.due_at <= $5) AND
-    ($6::timestamptz = '0001-01-01 00:00:00+00' OR i.due_at >= $6) AND
-    ($7::timestamptz = '0001-01-01 00:00:00+00' OR i.updated_at >= $7) AND
-    ($8::timestamptz = '0001-01-01 00:00:00+00' OR i.created_at >= $8)
-`
-
-type CountTasksWithFiltersParams struct {
-	Column1 pgtype.UUID        `json:"column_1"`
-	Column2 []string           `json:"column_2"`
-	Column3 []string           `json:"column_3"`
-	Column4 []string           `json:"column_4"`
-	Column5 pgtype.Timestamptz `json:"column_5"`
-	Column6 pgtype.Timestamptz `json:"column_6"`
-	Column7 pgtype.Timestamptz `json:"column_7"`
-	Column8 pgtype.Timestamptz `json:"column_8"`
-	Column9 []string           `json:"column_9"`
-}
-
-// Counts total matching items for pagination (used when main query returns empty page).
-// Uses same WHERE clause as ListTasksWithFilters for consistency.
-// Includes exception join to match ListTasksWithFilters behavior.
-// $2: statuses array (empty array skips filter, OR logic within array)
-// $3: priorities array (empty array skips filter, OR logic within array)
-// $4: tags array (empty array skips filter, item must have ALL specified tags)
-// $9: excluded_statuses array (empty array skips filter, excludes matching statuses)
-func (q *Queries) CountTasksWithFilters(ctx context.Context, arg CountTasksWithFiltersParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countTasksWithFilters,
-		arg.Column1,
-		arg.Column2,
-		arg.Column3,
-		arg.Column4,
-		arg.Column5,
-		arg.Column6,
-		arg.Column7,
-		arg.Column8,
-		arg.Column9,
-	)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
+	CustomFields        []byte             `json:"custom_fields"`
 }
 
 const createTodoItem = `-- name: CreateTodoItem :one
@@ -94,14 +40,18 @@ INSERT INTO todo_items (
     id, list_id, title, status, priority,
     estimated_duration, actual_duration,
     created_at, updated_at, due_at, tags,
-    recurring_template_id, starts_at, occurs_at, due_offset, timezone
-) VALUES (
+    recurring_template_id, starts_at, occurs_at, due_offset, timezone,
+    custom_fields
+)
+SELECT
     $1, $2, $3, $4, $5,
     $6, $7,
     $8, $9, $10, $11,
-    $12, $13, $14, $15, $16
-)
-RETURNING id, list_id, title, status, priority, estimated_duration, actual_duration, created_at, updated_at, due_at, tags, recurring_template_id, starts_at, occurs_at, due_offset, timezone, version
+    $12, $13, $14, $15, $16,
+    $17
+WHERE $18::uuid IS NULL
+   OR list_visible_to($2, $18::uuid)
+RETURNING id, list_id, title, status, priority, estimated_duration, actual_duration, created_at, updated_at, due_at, tags, recurring_template_id, starts_at, occurs_at, due_offset, timezone, version, custom_fields, snoozed_until
 `
 
 type CreateTodoItemParams struct {
@@ -121,8 +71,12 @@ type CreateTodoItemParams struct {
 	OccursAt            pgtype.Timestamptz `json:"occurs_at"`
 	DueOffset           pgtype.Interval    `json:"due_offset"`
 	Timezone            sql.Null[string]   `json:"timezone"`
+	CustomFields        []byte             `json:"custom_fields"`
+	OwnerID             pgtype.UUID        `json:"owner_id"`
 }
 
+// TENANCY: Inserts only when the list is owned by or shared with owner_id (NULL = unscoped internal access).
+// Returns pgx.ErrNoRows when the list is not visible to the tenant, so it is reported as not found.
 func (q *Queries) CreateTodoItem(ctx context.Context, arg CreateTodoItemParams) (TodoItem, error) {
 	row := q.db.QueryRow(ctx, createTodoItem,
 		arg.ID,
@@ -141,6 +95,8 @@ func (q *Queries) CreateTodoItem(ctx context.Context, arg CreateTodoItemParams)
 		arg.OccursAt,
 		arg.DueOffset,
 		arg.Timezone,
+		arg.CustomFields,
+		arg.OwnerID,
 	)
 	var i TodoItem
 	err := row.Scan(
@@ -161,6 +117,8 @@ func (q *Queries) CreateTodoItem(ctx context.Context, arg CreateTodoItemParams)
 		&i.DueOffset,
 		&i.Timezone,
 		&i.Version,
+		&i.CustomFields,
+		&i.SnoozedUntil,
 	)
 	return i, err
 }
@@ -188,32 +146,96 @@ func (q *Queries) DeleteFuturePendingItems(ctx context.Context, arg DeleteFuture
 
 const deleteTodoItem = `-- name: DeleteTodoItem :execrows
 DELETE FROM todo_items
-WHERE id = $1
+WHERE todo_items.id = $1
+  AND ($2::uuid IS NULL OR list_visible_to(todo_items.list_id, $2::uuid))
 `
 
+type DeleteTodoItemParams struct {
+	ID      string      `json:"id"`
+	OwnerID pgtype.UUID `json:"owner_id"`
+}
+
 // DATA ACCESS PATTERN: Single-query existence check via rowsAffected
 // :execrows returns (int64, error) - Repository checks rowsAffected == 0 → domain.ErrNotFound
 // Single-query delete with existence detection built-in
-func (q *Queries) DeleteTodoItem(ctx context.Context, id string) (int64, error) {
-	result, err := q.db.Exec(ctx, deleteTodoItem, id)
+// TENANCY: owner_id restricts deletion to items in lists owned by or shared with one tenant (NULL = unscoped internal access)
+func (q *Queries) DeleteTodoItem(ctx context.Context, arg DeleteTodoItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTodoItem, arg.ID, arg.OwnerID)
 	if err != nil {
 		return 0, err
 	}
 	return result.RowsAffected(), nil
 }
 
-const deleteTodoItemsByListId = `-- name: DeleteTodoItemsByListId :exec
-DELETE FROM todo_items
-WHERE list_id = $1
+const findStatsItems = `-- name: FindStatsItems :many
+SELECT i.id, i.list_id, i.title, i.status, i.priority, i.estimated_duration, i.actual_duration, i.created_at, i.updated_at, i.due_at, i.tags, i.recurring_template_id, i.starts_at, i.occurs_at, i.due_offset, i.timezone, i.version, i.custom_fields, i.snoozed_until FROM todo_items i
+WHERE i.list_id = $1
+  AND i.created_at < $2
+  AND (i.status NOT IN ('done', 'archived', 'cancelled') OR i.updated_at >= $3)
+  AND ($4::uuid IS NULL OR list_visible_to(i.list_id, $4::uuid))
+ORDER BY i.created_at, i.id
+LIMIT $5
 `
 
-func (q *Queries) DeleteTodoItemsByListId(ctx context.Context, listID string) error {
-	_, err := q.db.Exec(ctx, deleteTodoItemsByListId, listID)
-	return err
+type FindStatsItemsParams struct {
+	ListID        string             `json:"list_id"`
+	CreatedBefore pgtype.Timestamptz `json:"created_before"`
+	UpdatedSince  time.Time          `json:"updated_since"`
+	OwnerID       pgtype.UUID        `json:"owner_id"`
+	RowLimit      int32              `json:"row_limit"`
+}
+
+// Items of a list that can count towards stats of [from, to): created before to,
+// and still open or last updated since from (items closed earlier are settled).
+// TENANCY: owner_id scopes the list to one tenant's owned or shared lists (NULL = unscoped internal access).
+func (q *Queries) FindStatsItems(ctx context.Context, arg FindStatsItemsParams) ([]TodoItem, error) {
+	rows, err := q.db.Query(ctx, findStatsItems,
+		arg.ListID,
+		arg.CreatedBefore,
+		arg.UpdatedSince,
+		arg.OwnerID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TodoItem{}
+	for rows.Next() {
+		var i TodoItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.ListID,
+			&i.Title,
+			&i.Status,
+			&i.Priority,
+			&i.EstimatedDuration,
+			&i.ActualDuration,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DueAt,
+			&i.Tags,
+			&i.RecurringTemplateID,
+			&i.StartsAt,
+			&i.OccursAt,
+			&i.DueOffset,
+			&i.Timezone,
+			&i.Version,
+			&i.CustomFields,
+			&i.SnoozedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const getAllTodoItems = `-- name: GetAllTodoItems :many
-SELECT id, list_id, title, status, priority, estimated_duration, actual_duration, created_at, updated_at, due_at, tags, recurring_template_id, starts_at, occurs_at, due_offset, timezone, version FROM todo_items
+SELECT id, list_id, title, status, priority, estimated_duration, actual_duration, created_at, updated_at, due_at, tags, recurring_template_id, starts_at, occurs_at, due_offset, timezone, version, custom_fields, snoozed_until FROM todo_items
 ORDER BY list_id, created_at ASC
 `
 
@@ -244,6 +266,8 @@ func (q *Queries) GetAllTodoItems(ctx context.Context) ([]TodoItem, error) {
 			&i.DueOffset,
 			&i.Timezone,
 			&i.Version,
+			&i.CustomFields,
+			&i.SnoozedUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -256,12 +280,19 @@ func (q *Queries) GetAllTodoItems(ctx context.Context) ([]TodoItem, error) {
 }
 
 const getTodoItem = `-- name: GetTodoItem :one
-SELECT id, list_id, title, status, priority, estimated_duration, actual_duration, created_at, updated_at, due_at, tags, recurring_template_id, starts_at, occurs_at, due_offset, timezone, version FROM todo_items
-WHERE id = $1
+SELECT i.id, i.list_id, i.title, i.status, i.priority, i.estimated_duration, i.actual_duration, i.created_at, i.updated_at, i.due_at, i.tags, i.recurring_template_id, i.starts_at, i.occurs_at, i.due_offset, i.timezone, i.version, i.custom_fields, i.snoozed_until FROM todo_items i
+WHERE i.id = $1
+  AND ($2::uuid IS NULL OR list_visible_to(i.list_id, $2::uuid))
 `
 
-func (q *Queries) GetTodoItem(ctx context.Context, id string) (TodoItem, error) {
-	row := q.db.QueryRow(ctx, getTodoItem, id)
+type GetTodoItemParams struct {
+	ID      string      `json:"id"`
+	OwnerID pgtype.UUID `json:"owner_id"`
+}
+
+// TENANCY: owner_id scopes the lookup to items in lists owned by or shared with one tenant (NULL = unscoped internal access).
+func (q *Queries) GetTodoItem(ctx context.Context, arg GetTodoItemParams) (TodoItem, error) {
+	row := q.db.QueryRow(ctx, getTodoItem, arg.ID, arg.OwnerID)
 	var i TodoItem
 	err := row.Scan(
 		&i.ID,
@@ -281,12 +312,67 @@ func (q *Queries) GetTodoItem(ctx context.Context, id string) (TodoItem, error)
 		&i.DueOffset,
 		&i.Timezone,
 		&i.Version,
+		&i.CustomFields,
+		&i.SnoozedUntil,
 	)
 	return i, err
 }
 
+const getTodoItemsByIDs = `-- name: GetTodoItemsByIDs :many
+SELECT i.id, i.list_id, i.title, i.status, i.priority, i.estimated_duration, i.actual_duration, i.created_at, i.updated_at, i.due_at, i.tags, i.recurring_template_id, i.starts_at, i.occurs_at, i.due_offset, i.timezone, i.version, i.custom_fields, i.snoozed_until FROM todo_items i
+WHERE i.id = ANY($1::uuid[])
+  AND ($2::uuid IS NULL OR list_visible_to(i.list_id, $2::uuid))
+`
+
+type GetTodoItemsByIDsParams struct {
+	Ids     []pgtype.UUID `json:"ids"`
+	OwnerID pgtype.UUID   `json:"owner_id"`
+}
+
+// Items that no longer exist are omitted.
+// TENANCY: owner_id omits items of lists not owned by or shared with one tenant (NULL = unscoped internal access).
+func (q *Queries) GetTodoItemsByIDs(ctx context.Context, arg GetTodoItemsByIDsParams) ([]TodoItem, error) {
+	rows, err := q.db.Query(ctx, getTodoItemsByIDs, arg.Ids, arg.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TodoItem{}
+	for rows.Next() {
+		var i TodoItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.ListID,
+			&i.Title,
+			&i.Status,
+			&i.Priority,
+			&i.EstimatedDuration,
+			&i.ActualDuration,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DueAt,
+			&i.Tags,
+			&i.RecurringTemplateID,
+			&i.StartsAt,
+			&i.OccursAt,
+			&i.DueOffset,
+			&i.Timezone,
+			&i.Version,
+			&i.CustomFields,
+			&i.SnoozedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTodoItemsByListId = `-- name: GetTodoItemsByListId :many
-SELECT id, list_id, title, status, priority, estimated_duration, actual_duration, created_at, updated_at, due_at, tags, recurring_template_id, starts_at, occurs_at, due_offset, timezone, version FROM todo_items
+SELECT id, list_id, title, status, priority, estimated_duration, actual_duration, created_at, updated_at, due_at, tags, recurring_template_id, starts_at, occurs_at, due_offset, timezone, version, custom_fields, snoozed_until FROM todo_items
 WHERE list_id = $1
 ORDER BY created_at ASC
 `
@@ -318,6 +404,8 @@ func (q *Queries) GetTodoItemsByListId(ctx context.Context, listID string) ([]To
 			&i.DueOffset,
 			&i.Timezone,
 			&i.Version,
+			&i.CustomFields,
+			&i.SnoozedUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -335,13 +423,13 @@ INSERT INTO todo_items (
     estimated_duration, actual_duration,
     created_at, updated_at, due_at, tags,
     recurring_template_id, starts_at, occurs_at, due_offset, timezone,
-    version
+    version, custom_fields
 ) VALUES (
     $1, $2, $3, $4, $5,
     $6, $7,
     $8, $9, $10, $11,
     $12, $13, $14, $15, $16,
-    $17
+    $17, $18
 )
 ON CONFLICT (recurring_template_id, occurs_at) WHERE recurring_template_id IS NOT NULL
 DO NOTHING
@@ -365,6 +453,7 @@ type InsertItemIgnoreConflictParams struct {
 	DueOffset           pgtype.Interval    `json:"due_offset"`
 	Timezone            sql.Null[string]   `json:"timezone"`
 	Version             int32              `json:"version"`
+	CustomFields        []byte             `json:"custom_fields"`
 }
 
 // Idempotent single insert with ON CONFLICT DO NOTHING
@@ -388,191 +477,53 @@ func (q *Queries) InsertItemIgnoreConflict(ctx context.Context, arg InsertItemIg
 		arg.DueOffset,
 		arg.Timezone,
 		arg.Version,
+		arg.CustomFields,
 	)
 	return err
 }
 
-const listTasksWithFilters = `-- name: ListTasksWithFilters :many
-SELECT i.id, i.list_id, i.title, i.status, i.priority, i.estimated_duration, i.actual_duration, i.created_at, i.updated_at, i.due_at, i.tags, i.recurring_template_id, i.starts_at, i.occurs_at, i.due_offset, i.timezone, i.version, COUNT(*) OVER() AS total_count
-FROM todo_items i
-LEFT JOIN recurring_template_exceptions e
-    ON i.recurring_template_id = e.template_id
-    AND i.occurs_at = e.occurs_at
-    AND e.exception_type = 'deleted'  -- Only match deleted exceptions
-WHERE
-    e.id IS NULL AND  -- Exclude only hard-deleted items (edited/rescheduled pass through)
-    ($1::uuid = '00000000-0000-0000-0000-000000000000' OR i.list_id = $1) AND
-    (array_length($2::text[], 1) IS NULL OR i.status = ANY($2::text[])) AND
-    (array_length($12::text[], 1) IS NULL OR i.status != ALL($12::text[])) AND
-    (array_length($3::text[], 1) IS NULL OR i.priority = ANY($3::text[])) AND
-    (array_length($4::text[], 1) IS NULL OR i.tags @> $4::text[]) AND
-    ($5::timestamptz = '0001-01-01 00:00:00+00' OR i.due_at <= $5) AND
-    ($6::timestamptz = '0001-01-01 00:00:00+00' OR i.due_at >= $6) AND
-    ($7::timestamptz = '0001-01-01 00:00:00+00' OR i.updated_at >= $7) AND
-    ($8::timestamptz = '0001-01-01 00:00:00+00' OR i.created_at >= $8)
-ORDER BY
-    -- due_at: default ASC
-    CASE WHEN $9::text IN ('due_at', 'due_at_asc') THEN i.due_at END ASC NULLS LAST,
-    CASE WHEN $9::text = 'due_at_desc' THEN i.due_at END DESC NULLS LAST,
-    -- priority: default ASC (semantic order: low=1 < medium=2 < high=3 < urgent=4)
-    -- Uses numeric weights instead of lexical ordering to match proto enum semantics
-    CASE WHEN $9::text IN ('priority', 'priority_asc') THEN
-        CASE i.priority
-            WHEN 'low' THEN 1
-            WHEN 'medium' THEN 2
-            WHEN 'high' THEN 3
-            WHEN 'urgent' THEN 4
-        END
-    END ASC NULLS LAST,
-    CASE WHEN $9::text = 'priority_desc' THEN
-        CASE i.priority
-            WHEN 'low' THEN 1
-            WHEN 'medium' THEN 2
-            WHEN 'high' THEN 3
-            WHEN 'urgent' THEN 4
-        END
-    END DESC NULLS LAST,
-    -- created_at: default DESC
-    CASE WHEN $9::text = 'created_at_asc' THEN i.created_at END ASC,
-    CASE WHEN $9::text IN ('created_at', 'created_at_desc') THEN i.created_at END DESC,
-    -- updated_at: default DESC
-    CASE WHEN $9::text = 'updated_at_asc' THEN i.updated_at END ASC,
-    CASE WHEN $9::text IN ('updated_at', 'updated_at_desc') THEN i.updated_at END DESC,
-    -- Fallback: created_at DESC (when no valid order_by specified)
-    i.created_at DESC
-LIMIT $10
-OFFSET $11
+const moveTodoItem = `-- name: MoveTodoItem :one
+UPDATE todo_items
+SET list_id = $1,
+    updated_at = NOW(),
+    version = version + 1
+WHERE id = $2 AND list_id = $3
+RETURNING id, list_id, title, status, priority, estimated_duration, actual_duration, created_at, updated_at, due_at, tags, recurring_template_id, starts_at, occurs_at, due_offset, timezone, version, custom_fields, snoozed_until
 `
 
-type ListTasksWithFiltersParams struct {
-	Column1  pgtype.UUID        `json:"column_1"`
-	Column2  []string           `json:"column_2"`
-	Column3  []string           `json:"column_3"`
-	Column4  []string           `json:"column_4"`
-	Column5  pgtype.Timestamptz `json:"column_5"`
-	Column6  pgtype.Timestamptz `json:"column_6"`
-	Column7  pgtype.Timestamptz `json:"column_7"`
-	Column8  pgtype.Timestamptz `json:"column_8"`
-	Column9  string             `json:"column_9"`
-	Limit    int32              `json:"limit"`
-	Offset   int32              `json:"offset"`
-	Column12 []string           `json:"column_12"`
+type MoveTodoItemParams struct {
+	ToListID   string `json:"to_list_id"`
+	ID         string `json:"id"`
+	FromListID string `json:"from_list_id"`
 }
 
-type ListTasksWithFiltersRow struct {
-	ID                  string             `json:"id"`
-	ListID              string             `json:"list_id"`
-	Title               string             `json:"title"`
-	Status              string             `json:"status"`
-	Priority            sql.Null[string]   `json:"priority"`
-	EstimatedDuration   pgtype.Interval    `json:"estimated_duration"`
-	ActualDuration      pgtype.Interval    `json:"actual_duration"`
-	CreatedAt           pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt           time.Time          `json:"updated_at"`
-	DueAt               pgtype.Timestamptz `json:"due_at"`
-	Tags                []string           `json:"tags"`
-	RecurringTemplateID uuid.NullUUID      `json:"recurring_template_id"`
-	StartsAt            pgtype.Date        `json:"starts_at"`
-	OccursAt            pgtype.Timestamptz `json:"occurs_at"`
-	DueOffset           pgtype.Interval    `json:"due_offset"`
-	Timezone            sql.Null[string]   `json:"timezone"`
-	Version             int32              `json:"version"`
-	TotalCount          int64              `json:"total_count"`
-}
-
-// Optimized for SEARCH/FILTER access pattern: Database-level filtering, sorting, and pagination.
-// Performance: Pushes all operations to PostgreSQL with proper indexes vs loading all items to memory.
-// Use case: Task search, filtered views, "My Tasks" views, pagination through large result sets.
-//
-// Parameters (use empty arrays for NULL to skip filters):
-//
-//	$1: list_id           - Filter by specific list (zero UUID to search all lists)
-//	$2: statuses          - Array of statuses to include (empty array to skip, OR logic)
-//	$3: priorities        - Array of priorities to include (empty array to skip, OR logic)
-//	$4: tags              - Array of tags to match (empty array to skip, item must have ALL tags)
-//	$5: due_before        - Filter tasks due before timestamp (zero time to skip)
-//	$6: due_after         - Filter tasks due after timestamp (zero time to skip)
-//	$7: updated_at        - Filter by last update time (zero time to skip)
-//	$8: created_at        - Filter by creation time (zero time to skip)
-//	$9: order_by          - Combined field+direction: 'due_at_asc', 'due_at_desc', etc.
-//	                        Supports: due_at, priority, created_at, updated_at with _asc or _desc suffix
-//	                        For bare field names, defaults are: due_at=asc, priority=asc,
-//	                        created_at=desc, updated_at=desc
-//	$10: limit            - Page size (max items to return)
-//	$11: offset           - Pagination offset (skip N items)
-//	$12: excluded_statuses - Array of statuses to exclude (empty array to skip filter)
-//	                        Used to exclude archived/cancelled by default when $2 is empty
-//
-// Returns: All todo_items columns plus total_count (total matching rows across all pages)
-// The COUNT(*) OVER() window function computes total matching rows in a single query pass,
-// enabling accurate pagination UI without a separate count query.
-//
-// SQL Injection Protection:
-// The ORDER BY clause uses parameterized queries ($9::text) with CASE expressions.
-// PostgreSQL's parameterized query protocol treats $9 as DATA, never CODE, making SQL
-// injection structurally impossible. Even if malicious input like "id; DROP TABLE--"
-// flows through without validation, it's compared as a string literal in CASE expressions,
-// never executed as SQL. This protection is guaranteed by PostgreSQL's wire protocol.
-// See tests/integration/sql_injection_resistance_test.go for proof.
-//
-// Input validation at the service layer improves UX (clear error messages) but does NOT
-// provide security - parameterized queries are the security boundary.
-//
-// Access pattern examples:
-//   - "Show my overdue tasks": filter by due_before=now, order by due_at_asc
-//   - "Active work": statuses=[todo, in_progress], default sort
-//   - "High priority items": priorities=[high, urgent], order by due_at_asc
-//   - "Tasks tagged 'urgent' and 'work'": tags=[urgent, work] (item must have both)
-func (q *Queries) ListTasksWithFilters(ctx context.Context, arg ListTasksWithFiltersParams) ([]ListTasksWithFiltersRow, error) {
-	rows, err := q.db.Query(ctx, listTasksWithFilters,
-		arg.Column1,
-		arg.Column2,
-		arg.Column3,
-		arg.Column4,
-		arg.Column5,
-		arg.Column6,
-		arg.Column7,
-		arg.Column8,
-		arg.Column9,
-		arg.Limit,
-		arg.Offset,
-		arg.Column12,
+// Moves an item to another list.
+// Returns pgx.ErrNoRows when the item is not in from_list_id.
+func (q *Queries) MoveTodoItem(ctx context.Context, arg MoveTodoItemParams) (TodoItem, error) {
+	row := q.db.QueryRow(ctx, moveTodoItem, arg.ToListID, arg.ID, arg.FromListID)
+	var i TodoItem
+	err := row.Scan(
+		&i.ID,
+		&i.ListID,
+		&i.Title,
+		&i.Status,
+		&i.Priority,
+		&i.EstimatedDuration,
+		&i.ActualDuration,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DueAt,
+		&i.Tags,
+		&i.RecurringTemplateID,
+		&i.StartsAt,
+		&i.OccursAt,
+		&i.DueOffset,
+		&i.Timezone,
+		&i.Version,
+		&i.CustomFields,
+		&i.SnoozedUntil,
 	)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []ListTasksWithFiltersRow{}
-	for rows.Next() {
-		var i ListTasksWithFiltersRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.ListID,
-			&i.Title,
-			&i.Status,
-			&i.Priority,
-			&i.EstimatedDuration,
-			&i.ActualDuration,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.DueAt,
-			&i.Tags,
-			&i.RecurringTemplateID,
-			&i.StartsAt,
-			&i.OccursAt,
-			&i.DueOffset,
-			&i.Timezone,
-			&i.Version,
-			&i.TotalCount,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+	return i, err
 }
 
 const updateTodoItem = `-- name: UpdateTodoItem :one
@@ -587,13 +538,16 @@ SET title = CASE WHEN $1::boolean THEN $2 ELSE title END,
     due_offset = CASE WHEN $15::boolean THEN $16 ELSE due_offset END,
     tags = CASE WHEN $17::boolean THEN $18 ELSE tags END,
     timezone = CASE WHEN $19::boolean THEN $20 ELSE timezone END,
-    recurring_template_id = CASE WHEN $21::boolean THEN NULL ELSE recurring_template_id END,
+    custom_fields = CASE WHEN $21::boolean THEN $22::jsonb ELSE custom_fields END,
+    snoozed_until = CASE WHEN $23::boolean THEN $24 ELSE snoozed_until END,
+    recurring_template_id = CASE WHEN $25::boolean THEN NULL ELSE recurring_template_id END,
     updated_at = NOW(),
     version = version + 1
-WHERE id = $22
-  AND list_id = $23
-  AND ($24::integer IS NULL OR version = $24::integer)
-RETURNING id, list_id, title, status, priority, estimated_duration, actual_duration, created_at, updated_at, due_at, tags, recurring_template_id, starts_at, occurs_at, due_offset, timezone, version
+WHERE todo_items.id = $26
+  AND todo_items.list_id = $27
+  AND ($28::uuid IS NULL OR list_visible_to(todo_items.list_id, $28::uuid))
+  AND ($29::integer IS NULL OR version = $29::integer)
+RETURNING id, list_id, title, status, priority, estimated_duration, actual_duration, created_at, updated_at, due_at, tags, recurring_template_id, starts_at, occurs_at, due_offset, timezone, version, custom_fields, snoozed_until
 `
 
 type UpdateTodoItemParams struct {
@@ -617,9 +571,14 @@ type UpdateTodoItemParams struct {
 	Tags                 []string           `json:"tags"`
 	SetTimezone          bool               `json:"set_timezone"`
 	Timezone             sql.Null[string]   `json:"timezone"`
+	SetCustomFields      bool               `json:"set_custom_fields"`
+	CustomFields         []byte             `json:"custom_fields"`
+	SetSnoozedUntil      bool               `json:"set_snoozed_until"`
+	SnoozedUntil         pgtype.Timestamptz `json:"snoozed_until"`
 	DetachFromTemplate   bool               `json:"detach_from_template"`
 	ID                   string             `json:"id"`
 	ListID               string             `json:"list_id"`
+	OwnerID              pgtype.UUID        `json:"owner_id"`
 	ExpectedVersion      pgtype.Int4        `json:"expected_version"`
 }
 
@@ -629,6 +588,7 @@ type UpdateTodoItemParams struct {
 //   - Item doesn't exist
 //   - Item belongs to different list (security: prevents cross-list updates)
 //   - Version mismatch (concurrency: prevents lost updates)
+//   - List is not visible to the tenant (when owner_id provided)
 //
 // SECURITY: Validates item belongs to the specified list
 // CONCURRENCY: Optional version check for optimistic locking
@@ -655,9 +615,14 @@ func (q *Queries) UpdateTodoItem(ctx context.Context, arg UpdateTodoItemParams)
 		arg.Tags,
 		arg.SetTimezone,
 		arg.Timezone,
+		arg.SetCustomFields,
+		arg.CustomFields,
+		arg.SetSnoozedUntil,
+		arg.SnoozedUntil,
 		arg.DetachFromTemplate,
 		arg.ID,
 		arg.ListID,
+		arg.OwnerID,
 		arg.ExpectedVersion,
 	)
 	var i TodoItem
@@ -679,29 +644,51 @@ func (q *Queries) UpdateTodoItem(ctx context.Context, arg UpdateTodoItemParams)
 		&i.DueOffset,
 		&i.Timezone,
 		&i.Version,
+		&i.CustomFields,
+		&i.SnoozedUntil,
 	)
 	return i, err
 }
 
-const updateTodoItemStatus = `-- name: UpdateTodoItemStatus :execrows
+const wakeSnoozedItems = `-- name: WakeSnoozedItems :many
 UPDATE todo_items
-SET status = $1, updated_at = $2
-WHERE id = $3
+SET snoozed_until = NULL,
+    updated_at = NOW(),
+    version = version + 1
+WHERE id IN (
+    SELECT s.id FROM todo_items s
+    WHERE s.snoozed_until <= $1
+    ORDER BY s.snoozed_until, s.id
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id
 `
 
-type UpdateTodoItemStatusParams struct {
-	Status    string    `json:"status"`
-	UpdatedAt time.Time `json:"updated_at"`
-	ID        string    `json:"id"`
+type WakeSnoozedItemsParams struct {
+	Now      pgtype.Timestamptz `json:"now"`
+	RowLimit int32              `json:"row_limit"`
 }
 
-// DATA ACCESS PATTERN: Single-query existence check via rowsAffected
-// :execrows returns (int64, error) - Repository checks rowsAffected == 0 → domain.ErrNotFound
-// Efficient status updates without separate existence check
-func (q *Queries) UpdateTodoItemStatus(ctx context.Context, arg UpdateTodoItemStatusParams) (int64, error) {
-	result, err := q.db.Exec(ctx, updateTodoItemStatus, arg.Status, arg.UpdatedAt, arg.ID)
+// Clears the snooze of up to row_limit items snoozed until now or earlier.
+// The change log records each as an update clearing snoozed_until, which is
+// published as item.woke. SKIP LOCKED leaves items being edited to a later pass.
+func (q *Queries) WakeSnoozedItems(ctx context.Context, arg WakeSnoozedItemsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, wakeSnoozedItems, arg.Now, arg.RowLimit)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return result.RowsAffected(), nil
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
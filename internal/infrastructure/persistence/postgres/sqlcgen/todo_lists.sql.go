@@ -8,6 +8,7 @@ package sqlcgen
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -15,63 +16,113 @@ const countTodoListsWithFilters = `-- name: CountTodoListsWithFilters :one
 SELECT COUNT(DISTINCT tl.id)::int AS total_count
 FROM todo_lists tl
 WHERE
-    ($1::text IS NULL OR LOWER(tl.title) LIKE LOWER('%' || $1 || '%'))
-    AND ($2::timestamptz IS NULL OR tl.created_at > $2)
-    AND ($3::timestamptz IS NULL OR tl.created_at < $3)
+    ($1::uuid IS NULL OR list_visible_to(tl.id, $1::uuid))
+    AND ($2::text IS NULL OR LOWER(tl.title) LIKE LOWER('%' || $2 || '%'))
+    AND ($3::timestamptz IS NULL OR tl.created_at > $3)
+    AND ($4::timestamptz IS NULL OR tl.created_at < $4)
 `
 
 type CountTodoListsWithFiltersParams struct {
+	OwnerID         pgtype.UUID        `json:"owner_id"`
 	TitleContains   string             `json:"title_contains"`
 	CreatedAtAfter  pgtype.Timestamptz `json:"created_at_after"`
 	CreatedAtBefore pgtype.Timestamptz `json:"created_at_before"`
 }
 
 // Count total matching lists for pagination (same filters as FindTodoListsWithFilters).
+// Only run when the caller asks for a total, since it reads every matching list.
 func (q *Queries) CountTodoListsWithFilters(ctx context.Context, arg CountTodoListsWithFiltersParams) (int32, error) {
-	row := q.db.QueryRow(ctx, countTodoListsWithFilters, arg.TitleContains, arg.CreatedAtAfter, arg.CreatedAtBefore)
+	row := q.db.QueryRow(ctx, countTodoListsWithFilters,
+		arg.OwnerID,
+		arg.TitleContains,
+		arg.CreatedAtAfter,
+		arg.CreatedAtBefore,
+	)
 	var total_count int32
 	err := row.Scan(&total_count)
 	return total_count, err
 }
 
 const createTodoList = `-- name: CreateTodoList :one
-INSERT INTO todo_lists (id, title, created_at)
-VALUES ($1, $2, $3)
-RETURNING id, title, created_at, version
+INSERT INTO todo_lists (id, title, created_at, custom_field_schema, owner_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, title, created_at, version, custom_field_schema, owner_id
 `
 
 type CreateTodoListParams struct {
-	ID        string             `json:"id"`
-	Title     string             `json:"title"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	ID                string             `json:"id"`
+	Title             string             `json:"title"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	CustomFieldSchema []byte             `json:"custom_field_schema"`
+	OwnerID           uuid.NullUUID      `json:"owner_id"`
 }
 
 func (q *Queries) CreateTodoList(ctx context.Context, arg CreateTodoListParams) (TodoList, error) {
-	row := q.db.QueryRow(ctx, createTodoList, arg.ID, arg.Title, arg.CreatedAt)
+	row := q.db.QueryRow(ctx, createTodoList,
+		arg.ID,
+		arg.Title,
+		arg.CreatedAt,
+		arg.CustomFieldSchema,
+		arg.OwnerID,
+	)
 	var i TodoList
 	err := row.Scan(
 		&i.ID,
 		&i.Title,
 		&i.CreatedAt,
 		&i.Version,
+		&i.CustomFieldSchema,
+		&i.OwnerID,
 	)
 	return i, err
 }
 
-const deleteTodoList = `-- name: DeleteTodoList :execrows
-DELETE FROM todo_lists
-WHERE id = $1
+const findTakenBackupIDs = `-- name: FindTakenBackupIDs :many
+WITH taken AS (
+    SELECT 'list'::text AS kind, tl.id, tl.id AS list_id FROM todo_lists tl WHERE tl.id = ANY($1::uuid[])
+    UNION ALL
+    SELECT 'recurring_template'::text AS kind, t.id, t.list_id FROM recurring_task_templates t WHERE t.id = ANY($1::uuid[])
+    UNION ALL
+    SELECT 'item'::text AS kind, i.id, i.list_id FROM todo_items i WHERE i.id = ANY($1::uuid[])
+)
+SELECT kind, id,
+       ($2::uuid IS NULL OR list_visible_to(list_id, $2::uuid))::boolean AS visible
+FROM taken
 `
 
-// DATA ACCESS PATTERN: Single-query existence check via rowsAffected
-// :execrows returns (int64, error) - Repository checks rowsAffected == 0 → domain.ErrNotFound
-// Efficient detection of non-existent records without separate SELECT query
-func (q *Queries) DeleteTodoList(ctx context.Context, id string) (int64, error) {
-	result, err := q.db.Exec(ctx, deleteTodoList, id)
+type FindTakenBackupIDsParams struct {
+	Ids     []pgtype.UUID `json:"ids"`
+	OwnerID pgtype.UUID   `json:"owner_id"`
+}
+
+type FindTakenBackupIDsRow struct {
+	Kind    string `json:"kind"`
+	ID      string `json:"id"`
+	Visible bool   `json:"visible"`
+}
+
+// IDs of a backup that already exist, as an ID of any kind. Unscoped: IDs are
+// unique across tenants, so a restore that keeps them conflicts with the rows of
+// any tenant. visible is false for rows in lists not owned by or shared with
+// owner_id (NULL = unscoped internal access), whose kind is not reported.
+func (q *Queries) FindTakenBackupIDs(ctx context.Context, arg FindTakenBackupIDsParams) ([]FindTakenBackupIDsRow, error) {
+	rows, err := q.db.Query(ctx, findTakenBackupIDs, arg.Ids, arg.OwnerID)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return result.RowsAffected(), nil
+	defer rows.Close()
+	items := []FindTakenBackupIDsRow{}
+	for rows.Next() {
+		var i FindTakenBackupIDsRow
+		if err := rows.Scan(&i.Kind, &i.ID, &i.Visible); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const findTodoListsWithFilters = `-- name: FindTodoListsWithFilters :many
@@ -80,55 +131,67 @@ SELECT
     tl.title,
     tl.created_at,
     tl.version,
+    tl.custom_field_schema,
+    tl.owner_id,
     COALESCE(COUNT(ti.id), 0)::int AS total_items,
-    COALESCE(COUNT(ti.id) FILTER (WHERE ti.status = ANY($1::text[])), 0)::int AS undone_items
+    COALESCE(COUNT(ti.id) FILTER (WHERE ti.status = ANY($1::text[])), 0)::int AS undone_items,
+    k.sort_key::jsonb AS sort_key
 FROM todo_lists tl
+CROSS JOIN LATERAL (
+    SELECT
+        CASE
+            WHEN $2::text = 'title' THEN to_jsonb(tl.title)
+            ELSE to_jsonb(extract(epoch FROM tl.created_at))
+        END AS sort_key,
+        $3::text IS DISTINCT FROM 'desc' AS ascending
+) k
 LEFT JOIN todo_items ti ON tl.id = ti.list_id
 WHERE
-    ($2::text IS NULL OR LOWER(tl.title) LIKE LOWER('%' || $2 || '%'))
-    AND ($3::timestamptz IS NULL OR tl.created_at > $3)
-    AND ($4::timestamptz IS NULL OR tl.created_at < $4)
-GROUP BY tl.id, tl.title, tl.created_at, tl.version
+    ($4::uuid IS NULL OR list_visible_to(tl.id, $4::uuid))
+    AND ($5::text IS NULL OR LOWER(tl.title) LIKE LOWER('%' || $5 || '%'))
+    AND ($6::timestamptz IS NULL OR tl.created_at > $6)
+    AND ($7::timestamptz IS NULL OR tl.created_at < $7)
+    AND ($8::uuid IS NULL OR CASE
+        WHEN k.ascending THEN k.sort_key > $9::jsonb
+            OR (k.sort_key = $9::jsonb AND tl.id > $8::uuid)
+        ELSE k.sort_key < $9::jsonb
+            OR (k.sort_key = $9::jsonb AND tl.id < $8::uuid)
+    END)
+GROUP BY tl.id, tl.title, tl.created_at, tl.version, tl.custom_field_schema, tl.owner_id, k.sort_key, k.ascending
 ORDER BY
-    CASE
-        WHEN $5 = 'title' AND $6 = 'asc' THEN tl.title
-    END ASC,
-    CASE
-        WHEN $5 = 'title' AND $6 = 'desc' THEN tl.title
-    END DESC,
-    CASE
-        WHEN $5 = 'created_at' AND $6 = 'asc' THEN tl.created_at
-        WHEN ($5 IS NULL OR $5 = '') AND ($6 IS NULL OR $6 = '' OR $6 = 'asc') THEN tl.created_at
-    END ASC,
-    CASE
-        WHEN $5 = 'created_at' AND $6 = 'desc' THEN tl.created_at
-        WHEN ($5 IS NULL OR $5 = '') AND $6 = 'desc' THEN tl.created_at
-    END DESC
-LIMIT $8
-OFFSET $7
+    CASE WHEN k.ascending THEN k.sort_key END ASC,
+    CASE WHEN NOT k.ascending THEN k.sort_key END DESC,
+    CASE WHEN k.ascending THEN tl.id END ASC,
+    CASE WHEN NOT k.ascending THEN tl.id END DESC
+LIMIT $10
 `
 
 type FindTodoListsWithFiltersParams struct {
 	UndoneStatuses  []string           `json:"undone_statuses"`
+	OrderBy         string             `json:"order_by"`
+	OrderDir        string             `json:"order_dir"`
+	OwnerID         pgtype.UUID        `json:"owner_id"`
 	TitleContains   string             `json:"title_contains"`
 	CreatedAtAfter  pgtype.Timestamptz `json:"created_at_after"`
 	CreatedAtBefore pgtype.Timestamptz `json:"created_at_before"`
-	OrderBy         interface{}        `json:"order_by"`
-	OrderDir        interface{}        `json:"order_dir"`
-	PageOffset      int32              `json:"page_offset"`
+	AfterID         pgtype.UUID        `json:"after_id"`
+	AfterSortKey    []byte             `json:"after_sort_key"`
 	PageLimit       int32              `json:"page_limit"`
 }
 
 type FindTodoListsWithFiltersRow struct {
-	ID          string             `json:"id"`
-	Title       string             `json:"title"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	Version     int32              `json:"version"`
-	TotalItems  int32              `json:"total_items"`
-	UndoneItems int32              `json:"undone_items"`
+	ID                string             `json:"id"`
+	Title             string             `json:"title"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	Version           int32              `json:"version"`
+	CustomFieldSchema []byte             `json:"custom_field_schema"`
+	OwnerID           uuid.NullUUID      `json:"owner_id"`
+	TotalItems        int32              `json:"total_items"`
+	UndoneItems       int32              `json:"undone_items"`
+	SortKey           []byte             `json:"sort_key"`
 }
 
-// Advanced list query with filtering, sorting, and pagination.
+// Advanced list query with filtering, sorting, and keyset pagination.
 // Supports AIP-160-style filtering and AIP-132-style sorting.
 //
 // Parameters use nullable types for optional filters:
@@ -138,16 +201,24 @@ type FindTodoListsWithFiltersRow struct {
 //   - order_by: Column to sort by ("created_at" or "title")
 //   - order_dir: Sort direction ("asc" or "desc")
 //   - page_limit: Maximum number of results to return
-//   - page_offset: Number of results to skip
+//   - after_sort_key: sort_key of the last list of the previous page
+//   - after_id: ID of the last list of the previous page (NULL for the first page)
+//   - owner_id: Restricts results to lists owned by or shared with one tenant (NULL = unscoped internal access)
+//
+// Lists are ordered by sort_key, then by id in the same direction, and a page
+// continues strictly after (after_sort_key, after_id). sort_key is the sort column
+// as JSONB (created_at as epoch seconds), the same encoding the item search uses.
 func (q *Queries) FindTodoListsWithFilters(ctx context.Context, arg FindTodoListsWithFiltersParams) ([]FindTodoListsWithFiltersRow, error) {
 	rows, err := q.db.Query(ctx, findTodoListsWithFilters,
 		arg.UndoneStatuses,
+		arg.OrderBy,
+		arg.OrderDir,
+		arg.OwnerID,
 		arg.TitleContains,
 		arg.CreatedAtAfter,
 		arg.CreatedAtBefore,
-		arg.OrderBy,
-		arg.OrderDir,
-		arg.PageOffset,
+		arg.AfterID,
+		arg.AfterSortKey,
 		arg.PageLimit,
 	)
 	if err != nil {
@@ -162,8 +233,11 @@ func (q *Queries) FindTodoListsWithFilters(ctx context.Context, arg FindTodoList
 			&i.Title,
 			&i.CreatedAt,
 			&i.Version,
+			&i.CustomFieldSchema,
+			&i.OwnerID,
 			&i.TotalItems,
 			&i.UndoneItems,
+			&i.SortKey,
 		); err != nil {
 			return nil, err
 		}
@@ -176,18 +250,28 @@ func (q *Queries) FindTodoListsWithFilters(ctx context.Context, arg FindTodoList
 }
 
 const getTodoList = `-- name: GetTodoList :one
-SELECT id, title, created_at, version FROM todo_lists
-WHERE id = $1
+SELECT tl.id, tl.title, tl.created_at, tl.version, tl.custom_field_schema, tl.owner_id FROM todo_lists tl
+WHERE tl.id = $1
+  AND ($2::uuid IS NULL OR list_visible_to(tl.id, $2::uuid))
 `
 
-func (q *Queries) GetTodoList(ctx context.Context, id string) (TodoList, error) {
-	row := q.db.QueryRow(ctx, getTodoList, id)
+type GetTodoListParams struct {
+	ID      string      `json:"id"`
+	OwnerID pgtype.UUID `json:"owner_id"`
+}
+
+// TENANCY: owner_id scopes the lookup to lists owned by or shared with one tenant (NULL = unscoped internal access).
+// Lists of other tenants are reported as not found.
+func (q *Queries) GetTodoList(ctx context.Context, arg GetTodoListParams) (TodoList, error) {
+	row := q.db.QueryRow(ctx, getTodoList, arg.ID, arg.OwnerID)
 	var i TodoList
 	err := row.Scan(
 		&i.ID,
 		&i.Title,
 		&i.CreatedAt,
 		&i.Version,
+		&i.CustomFieldSchema,
+		&i.OwnerID,
 	)
 	return i, err
 }
@@ -198,38 +282,47 @@ SELECT
     tl.title,
     tl.created_at,
     tl.version,
+    tl.custom_field_schema,
+    tl.owner_id,
     COALESCE(COUNT(ti.id), 0)::int AS total_items,
     COALESCE(COUNT(ti.id) FILTER (WHERE ti.status = ANY($1::text[])), 0)::int AS undone_items
 FROM todo_lists tl
 LEFT JOIN todo_items ti ON tl.id = ti.list_id
 WHERE tl.id = $2
-GROUP BY tl.id, tl.title, tl.created_at, tl.version
+  AND ($3::uuid IS NULL OR list_visible_to(tl.id, $3::uuid))
+GROUP BY tl.id, tl.title, tl.created_at, tl.version, tl.custom_field_schema, tl.owner_id
 `
 
 type GetTodoListWithCountsParams struct {
-	UndoneStatuses []string `json:"undone_statuses"`
-	ID             string   `json:"id"`
+	UndoneStatuses []string    `json:"undone_statuses"`
+	ID             string      `json:"id"`
+	OwnerID        pgtype.UUID `json:"owner_id"`
 }
 
 type GetTodoListWithCountsRow struct {
-	ID          string             `json:"id"`
-	Title       string             `json:"title"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	Version     int32              `json:"version"`
-	TotalItems  int32              `json:"total_items"`
-	UndoneItems int32              `json:"undone_items"`
+	ID                string             `json:"id"`
+	Title             string             `json:"title"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	Version           int32              `json:"version"`
+	CustomFieldSchema []byte             `json:"custom_field_schema"`
+	OwnerID           uuid.NullUUID      `json:"owner_id"`
+	TotalItems        int32              `json:"total_items"`
+	UndoneItems       int32              `json:"undone_items"`
 }
 
 // Returns a single list by ID with item counts (for detail view).
 // undone_statuses parameter: domain layer defines which statuses count as "undone".
+// TENANCY: owner_id scopes the lookup to lists owned by or shared with one tenant (NULL = unscoped internal access).
 func (q *Queries) GetTodoListWithCounts(ctx context.Context, arg GetTodoListWithCountsParams) (GetTodoListWithCountsRow, error) {
-	row := q.db.QueryRow(ctx, getTodoListWithCounts, arg.UndoneStatuses, arg.ID)
+	row := q.db.QueryRow(ctx, getTodoListWithCounts, arg.UndoneStatuses, arg.ID, arg.OwnerID)
 	var i GetTodoListWithCountsRow
 	err := row.Scan(
 		&i.ID,
 		&i.Title,
 		&i.CreatedAt,
 		&i.Version,
+		&i.CustomFieldSchema,
+		&i.OwnerID,
 		&i.TotalItems,
 		&i.UndoneItems,
 	)
@@ -237,7 +330,7 @@ func (q *Queries) GetTodoListWithCounts(ctx context.Context, arg GetTodoListWith
 }
 
 const listTodoLists = `-- name: ListTodoLists :many
-SELECT id, title, created_at, version FROM todo_lists
+SELECT id, title, created_at, version, custom_field_schema, owner_id FROM todo_lists
 ORDER BY created_at DESC
 `
 
@@ -256,6 +349,8 @@ func (q *Queries) ListTodoLists(ctx context.Context) ([]TodoList, error) {
 			&i.Title,
 			&i.CreatedAt,
 			&i.Version,
+			&i.CustomFieldSchema,
+			&i.OwnerID,
 		); err != nil {
 			return nil, err
 		}
@@ -273,21 +368,25 @@ SELECT
     tl.title,
     tl.created_at,
     tl.version,
+    tl.custom_field_schema,
+    tl.owner_id,
     COALESCE(COUNT(ti.id), 0)::int AS total_items,
     COALESCE(COUNT(ti.id) FILTER (WHERE ti.status = ANY($1::text[])), 0)::int AS undone_items
 FROM todo_lists tl
 LEFT JOIN todo_items ti ON tl.id = ti.list_id
-GROUP BY tl.id, tl.title, tl.created_at, tl.version
+GROUP BY tl.id, tl.title, tl.created_at, tl.version, tl.custom_field_schema, tl.owner_id
 ORDER BY tl.created_at DESC
 `
 
 type ListTodoListsWithCountsRow struct {
-	ID          string             `json:"id"`
-	Title       string             `json:"title"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	Version     int32              `json:"version"`
-	TotalItems  int32              `json:"total_items"`
-	UndoneItems int32              `json:"undone_items"`
+	ID                string             `json:"id"`
+	Title             string             `json:"title"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	Version           int32              `json:"version"`
+	CustomFieldSchema []byte             `json:"custom_field_schema"`
+	OwnerID           uuid.NullUUID      `json:"owner_id"`
+	TotalItems        int32              `json:"total_items"`
+	UndoneItems       int32              `json:"undone_items"`
 }
 
 // Optimized for LIST VIEW access pattern: Returns list metadata with item counts.
@@ -314,6 +413,73 @@ func (q *Queries) ListTodoListsWithCounts(ctx context.Context, undoneStatuses []
 			&i.Title,
 			&i.CreatedAt,
 			&i.Version,
+			&i.CustomFieldSchema,
+			&i.OwnerID,
+			&i.TotalItems,
+			&i.UndoneItems,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTodoListsWithCountsByIDs = `-- name: ListTodoListsWithCountsByIDs :many
+SELECT
+    tl.id,
+    tl.title,
+    tl.created_at,
+    tl.version,
+    tl.custom_field_schema,
+    tl.owner_id,
+    COALESCE(COUNT(ti.id), 0)::int AS total_items,
+    COALESCE(COUNT(ti.id) FILTER (WHERE ti.status = ANY($1::text[])), 0)::int AS undone_items
+FROM todo_lists tl
+LEFT JOIN todo_items ti ON tl.id = ti.list_id
+WHERE tl.id = ANY($2::uuid[])
+  AND ($3::uuid IS NULL OR list_visible_to(tl.id, $3::uuid))
+GROUP BY tl.id, tl.title, tl.created_at, tl.version, tl.custom_field_schema, tl.owner_id
+`
+
+type ListTodoListsWithCountsByIDsParams struct {
+	UndoneStatuses []string      `json:"undone_statuses"`
+	Ids            []pgtype.UUID `json:"ids"`
+	OwnerID        pgtype.UUID   `json:"owner_id"`
+}
+
+type ListTodoListsWithCountsByIDsRow struct {
+	ID                string             `json:"id"`
+	Title             string             `json:"title"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	Version           int32              `json:"version"`
+	CustomFieldSchema []byte             `json:"custom_field_schema"`
+	OwnerID           uuid.NullUUID      `json:"owner_id"`
+	TotalItems        int32              `json:"total_items"`
+	UndoneItems       int32              `json:"undone_items"`
+}
+
+// Returns the given lists with item counts. Lists that no longer exist are omitted.
+// TENANCY: owner_id omits lists not owned by or shared with one tenant (NULL = unscoped internal access).
+func (q *Queries) ListTodoListsWithCountsByIDs(ctx context.Context, arg ListTodoListsWithCountsByIDsParams) ([]ListTodoListsWithCountsByIDsRow, error) {
+	rows, err := q.db.Query(ctx, listTodoListsWithCountsByIDs, arg.UndoneStatuses, arg.Ids, arg.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTodoListsWithCountsByIDsRow{}
+	for rows.Next() {
+		var i ListTodoListsWithCountsByIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.CreatedAt,
+			&i.Version,
+			&i.CustomFieldSchema,
+			&i.OwnerID,
 			&i.TotalItems,
 			&i.UndoneItems,
 		); err != nil {
@@ -331,38 +497,47 @@ const updateTodoList = `-- name: UpdateTodoList :one
 WITH updated AS (
     UPDATE todo_lists tl
     SET title = CASE WHEN $2::boolean THEN $3 ELSE title END,
+        custom_field_schema = CASE WHEN $4::boolean THEN $5::jsonb ELSE custom_field_schema END,
         version = tl.version + 1
-    WHERE tl.id = $4
-      AND ($5::integer IS NULL OR tl.version = $5::integer)
-    RETURNING id, title, created_at, version
+    WHERE tl.id = $6
+      AND ($7::uuid IS NULL OR list_visible_to(tl.id, $7::uuid))
+      AND ($8::integer IS NULL OR tl.version = $8::integer)
+    RETURNING id, title, created_at, version, custom_field_schema, owner_id
 )
 SELECT
     u.id,
     u.title,
     u.created_at,
     u.version,
+    u.custom_field_schema,
+    u.owner_id,
     COALESCE(COUNT(ti.id), 0)::int AS total_items,
     COALESCE(COUNT(ti.id) FILTER (WHERE ti.status = ANY($1::text[])), 0)::int AS undone_items
 FROM updated u
 LEFT JOIN todo_items ti ON u.id = ti.list_id
-GROUP BY u.id, u.title, u.created_at, u.version
+GROUP BY u.id, u.title, u.created_at, u.version, u.custom_field_schema, u.owner_id
 `
 
 type UpdateTodoListParams struct {
-	UndoneStatuses  []string    `json:"undone_statuses"`
-	SetTitle        bool        `json:"set_title"`
-	Title           string      `json:"title"`
-	ID              string      `json:"id"`
-	ExpectedVersion pgtype.Int4 `json:"expected_version"`
+	UndoneStatuses       []string    `json:"undone_statuses"`
+	SetTitle             bool        `json:"set_title"`
+	Title                string      `json:"title"`
+	SetCustomFieldSchema bool        `json:"set_custom_field_schema"`
+	CustomFieldSchema    []byte      `json:"custom_field_schema"`
+	ID                   string      `json:"id"`
+	OwnerID              pgtype.UUID `json:"owner_id"`
+	ExpectedVersion      pgtype.Int4 `json:"expected_version"`
 }
 
 type UpdateTodoListRow struct {
-	ID          pgtype.UUID        `json:"id"`
-	Title       string             `json:"title"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	Version     int32              `json:"version"`
-	TotalItems  int32              `json:"total_items"`
-	UndoneItems int32              `json:"undone_items"`
+	ID                pgtype.UUID        `json:"id"`
+	Title             string             `json:"title"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	Version           int32              `json:"version"`
+	CustomFieldSchema []byte             `json:"custom_field_schema"`
+	OwnerID           pgtype.UUID        `json:"owner_id"`
+	TotalItems        int32              `json:"total_items"`
+	UndoneItems       int32              `json:"undone_items"`
 }
 
 // ATOMIC UPDATE WITH COUNTS: Uses CTE to update and return counts in single statement.
@@ -374,12 +549,16 @@ type UpdateTodoListRow struct {
 // Returns no rows if:
 //   - List doesn't exist
 //   - Version mismatch (when expected_version provided)
+//   - List is not visible to the tenant (when owner_id provided)
 func (q *Queries) UpdateTodoList(ctx context.Context, arg UpdateTodoListParams) (UpdateTodoListRow, error) {
 	row := q.db.QueryRow(ctx, updateTodoList,
 		arg.UndoneStatuses,
 		arg.SetTitle,
 		arg.Title,
+		arg.SetCustomFieldSchema,
+		arg.CustomFieldSchema,
 		arg.ID,
+		arg.OwnerID,
 		arg.ExpectedVersion,
 	)
 	var i UpdateTodoListRow
@@ -388,6 +567,8 @@ func (q *Queries) UpdateTodoList(ctx context.Context, arg UpdateTodoListParams)
 		&i.Title,
 		&i.CreatedAt,
 		&i.Version,
+		&i.CustomFieldSchema,
+		&i.OwnerID,
 		&i.TotalItems,
 		&i.UndoneItems,
 	)
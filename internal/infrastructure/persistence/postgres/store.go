@@ -27,6 +27,7 @@ import (
 type Store struct {
 	pool    *pgxpool.Pool
 	queries *sqlcgen.Queries
+	tx      pgx.Tx // The transaction the store runs in, if any
 }
 
 // Compile-time verification that Store implements all repository interfaces.
@@ -86,10 +87,17 @@ func finalizeTx(ctx context.Context, tx pgx.Tx, err *error) {
 }
 
 // executeInTransaction is a helper that executes a callback within a transaction with logging and panic recovery.
+// Called on a store that already runs in a transaction, it uses a savepoint, so that the callback
+// can fail without failing the enclosing transaction.
 func (s *Store) executeInTransaction(ctx context.Context, operationName string, fn func(txStore *Store) error) (err error) {
 	start := time.Now().UTC()
 
-	tx, err := s.pool.Begin(ctx)
+	var tx pgx.Tx
+	if s.tx != nil {
+		tx, err = s.tx.Begin(ctx)
+	} else {
+		tx, err = s.pool.Begin(ctx)
+	}
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to begin transaction",
 			"operation", operationName,
@@ -122,6 +130,7 @@ func (s *Store) executeInTransaction(ctx context.Context, operationName string,
 	txStore := &Store{
 		pool:    s.pool,
 		queries: s.queries.WithTx(tx),
+		tx:      tx,
 	}
 
 	err = fn(txStore)
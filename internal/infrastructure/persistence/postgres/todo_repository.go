@@ -13,6 +13,7 @@ import (
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rezkam/mono/internal/domain"
 	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
 	"github.com/rezkam/mono/internal/ptr"
@@ -44,6 +45,32 @@ func isForeignKeyViolation(err error, column string) bool {
 	return false
 }
 
+// ownerQueryParam returns the tenant scope for repository queries.
+// Requests authenticated with an API key are scoped to lists owned by or shared
+// with the key's owner, so data of other tenants is reported as not found.
+// Internal callers marked with domain.WithInternalAccess (workers, tools) get
+// NULL, which disables the tenant filter in SQL:
+//
+//	(sqlc.narg('owner_id')::uuid IS NULL OR list_visible_to(list_id, sqlc.narg('owner_id')::uuid))
+//
+// Callers with neither are refused with domain.ErrUnauthorized, so a code path
+// that lost its principal fails instead of reading every tenant's data.
+func ownerQueryParam(ctx context.Context) (pgtype.UUID, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		if domain.HasInternalAccess(ctx) {
+			return pgtype.UUID{}, nil
+		}
+		return pgtype.UUID{}, fmt.Errorf("%w: no principal or internal access in context", domain.ErrUnauthorized)
+	}
+
+	ownerUUID, err := uuid.Parse(principal.OwnerID)
+	if err != nil {
+		return pgtype.UUID{}, fmt.Errorf("%w: owner %w", domain.ErrInvalidID, err)
+	}
+	return uuidToQueryParam(ownerUUID), nil
+}
+
 // === List Operations ===
 
 // CreateList creates a new todo list.
@@ -54,10 +81,27 @@ func (s *Store) CreateList(ctx context.Context, list *domain.TodoList) (*domain.
 		return nil, fmt.Errorf("failed to convert list: %w", err)
 	}
 
+	customFieldSchema, err := customFieldSchemaToJSON(list.CustomFieldSchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert list: %w", err)
+	}
+
+	// Lists created by internal callers have no owner (NULL)
+	var owner *string
+	if list.OwnerID != "" {
+		owner = &list.OwnerID
+	}
+	ownerID, err := stringPtrToNullUUID(owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert list owner: %w", err)
+	}
+
 	params := sqlcgen.CreateTodoListParams{
-		ID:        id,
-		Title:     title,
-		CreatedAt: timeToTimestamptz(createTime),
+		ID:                id,
+		Title:             title,
+		CreatedAt:         timeToTimestamptz(createTime),
+		CustomFieldSchema: customFieldSchema,
+		OwnerID:           ownerID,
 	}
 
 	row, err := s.queries.CreateTodoList(ctx, params)
@@ -65,13 +109,20 @@ func (s *Store) CreateList(ctx context.Context, list *domain.TodoList) (*domain.
 		return nil, fmt.Errorf("failed to create list: %w", err)
 	}
 
+	schema, err := customFieldSchemaFromJSON(row.CustomFieldSchema)
+	if err != nil {
+		return nil, err
+	}
+
 	return &domain.TodoList{
-		ID:          row.ID,
-		Title:       row.Title,
-		CreatedAt:   timestamptzToTime(row.CreatedAt),
-		TotalItems:  0, // New list has no items
-		UndoneItems: 0,
-		Version:     int(row.Version),
+		ID:                row.ID,
+		Title:             row.Title,
+		CreatedAt:         timestamptzToTime(row.CreatedAt),
+		OwnerID:           ptr.ToString(nullUUIDToStringPtr(row.OwnerID)),
+		CustomFieldSchema: schema,
+		TotalItems:        0, // New list has no items
+		UndoneItems:       0,
+		Version:           int(row.Version),
 	}, nil
 }
 
@@ -82,9 +133,15 @@ func (s *Store) FindListByID(ctx context.Context, id string) (*domain.TodoList,
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
 	}
 
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	dbList, err := s.queries.GetTodoListWithCounts(ctx, sqlcgen.GetTodoListWithCountsParams{
 		ID:             listUUID.String(),
 		UndoneStatuses: taskStatusesToStrings(domain.UndoneStatuses()),
+		OwnerID:        ownerID,
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -93,23 +150,43 @@ func (s *Store) FindListByID(ctx context.Context, id string) (*domain.TodoList,
 		return nil, fmt.Errorf("failed to get list: %w", err)
 	}
 
+	schema, err := customFieldSchemaFromJSON(dbList.CustomFieldSchema)
+	if err != nil {
+		return nil, err
+	}
+
 	return &domain.TodoList{
-		ID:          dbList.ID,
-		Title:       dbList.Title,
-		CreatedAt:   timestamptzToTime(dbList.CreatedAt),
-		TotalItems:  int(dbList.TotalItems),
-		UndoneItems: int(dbList.UndoneItems),
-		Version:     int(dbList.Version),
+		ID:                dbList.ID,
+		Title:             dbList.Title,
+		CreatedAt:         timestamptzToTime(dbList.CreatedAt),
+		OwnerID:           ptr.ToString(nullUUIDToStringPtr(dbList.OwnerID)),
+		CustomFieldSchema: schema,
+		TotalItems:        int(dbList.TotalItems),
+		UndoneItems:       int(dbList.UndoneItems),
+		Version:           int(dbList.Version),
 	}, nil
 }
 
 // ListLists retrieves todo lists with filtering, sorting, and pagination.
 func (s *Store) FindLists(ctx context.Context, params domain.ListListsParams) (*domain.PagedListResult, error) {
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	afterSortKey, afterID, err := pageCursorToQueryParams(params.After)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build sqlc params from domain params
+	// One row beyond the page tells whether another page follows
 	sqlcParams := sqlcgen.FindTodoListsWithFiltersParams{
 		UndoneStatuses: taskStatusesToStrings(domain.UndoneStatuses()),
-		PageLimit:      int32(params.Limit),
-		PageOffset:     int32(params.Offset),
+		OwnerID:        ownerID,
+		AfterSortKey:   afterSortKey,
+		AfterID:        afterID,
+		PageLimit:      int32(params.Limit + 1),
 	}
 
 	// Apply optional filters
@@ -133,39 +210,80 @@ func (s *Store) FindLists(ctx context.Context, params domain.ListListsParams) (*
 		return nil, fmt.Errorf("failed to find lists: %w", err)
 	}
 
+	result := &domain.PagedListResult{}
+	if len(rows) > params.Limit {
+		result.HasMore = true
+		rows = rows[:params.Limit]
+		last := rows[len(rows)-1]
+		result.NextCursor = newPageCursor(last.SortKey, last.ID)
+	}
+
 	// Convert to domain models
-	lists := make([]*domain.TodoList, 0, len(rows))
+	result.Lists = make([]*domain.TodoList, 0, len(rows))
 	for _, row := range rows {
+		schema, err := customFieldSchemaFromJSON(row.CustomFieldSchema)
+		if err != nil {
+			return nil, err
+		}
 		list := &domain.TodoList{
-			ID:          row.ID,
-			Title:       row.Title,
-			CreatedAt:   timestamptzToTime(row.CreatedAt),
-			TotalItems:  int(row.TotalItems),
-			UndoneItems: int(row.UndoneItems),
-			Version:     int(row.Version),
+			ID:                row.ID,
+			Title:             row.Title,
+			CreatedAt:         timestamptzToTime(row.CreatedAt),
+			OwnerID:           ptr.ToString(nullUUIDToStringPtr(row.OwnerID)),
+			CustomFieldSchema: schema,
+			TotalItems:        int(row.TotalItems),
+			UndoneItems:       int(row.UndoneItems),
+			Version:           int(row.Version),
+		}
+		result.Lists = append(result.Lists, list)
+	}
+
+	// The total reads every matching list, so it is only counted on request
+	if params.IncludeTotalCount {
+		countParams := sqlcgen.CountTodoListsWithFiltersParams{
+			OwnerID:         ownerID,
+			TitleContains:   sqlcParams.TitleContains,
+			CreatedAtAfter:  sqlcParams.CreatedAtAfter,
+			CreatedAtBefore: sqlcParams.CreatedAtBefore,
+		}
+		totalCount, err := s.queries.CountTodoListsWithFilters(ctx, countParams)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count lists: %w", err)
 		}
-		lists = append(lists, list)
+		result.TotalCount = ptr.To(int(totalCount))
 	}
 
-	// Get total count for pagination
-	countParams := sqlcgen.CountTodoListsWithFiltersParams{
-		TitleContains:   sqlcParams.TitleContains,
-		CreatedAtAfter:  sqlcParams.CreatedAtAfter,
-		CreatedAtBefore: sqlcParams.CreatedAtBefore,
+	return result, nil
+}
+
+// pageCursorToQueryParams converts a page cursor to the keyset query parameters:
+// the JSONB sort key (nil when the row had none) and the ID (NULL for the first page).
+// Cursors arrive from clients inside page tokens, so malformed ones are rejected
+// rather than sent to the database.
+func pageCursorToQueryParams(cursor *domain.PageCursor) ([]byte, pgtype.UUID, error) {
+	if cursor == nil {
+		return nil, pgtype.UUID{}, nil
 	}
-	totalCount, err := s.queries.CountTodoListsWithFilters(ctx, countParams)
+	id, err := uuid.Parse(cursor.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count lists: %w", err)
+		return nil, pgtype.UUID{}, domain.ErrInvalidPageToken
 	}
+	if cursor.SortKey == nil {
+		return nil, uuidToQueryParam(id), nil
+	}
+	if !json.Valid([]byte(*cursor.SortKey)) {
+		return nil, pgtype.UUID{}, domain.ErrInvalidPageToken
+	}
+	return []byte(*cursor.SortKey), uuidToQueryParam(id), nil
+}
 
-	// Calculate if there are more results
-	hasMore := (params.Offset + params.Limit) < int(totalCount)
-
-	return &domain.PagedListResult{
-		Lists:      lists,
-		TotalCount: int(totalCount),
-		HasMore:    hasMore,
-	}, nil
+// newPageCursor builds the cursor after a row from its JSONB sort key and ID.
+func newPageCursor(sortKey []byte, id string) *domain.PageCursor {
+	cursor := &domain.PageCursor{ID: id}
+	if sortKey != nil {
+		cursor.SortKey = ptr.To(string(sortKey))
+	}
+	return cursor
 }
 
 // UpdateList updates a list using field mask.
@@ -186,9 +304,15 @@ func (s *Store) UpdateList(ctx context.Context, params domain.UpdateListParams)
 
 	// Build sqlc params with field mask support
 	// Uses CTE to atomically update and return counts in single statement
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	sqlParams := sqlcgen.UpdateTodoListParams{
 		ID:             listUUID.String(),
 		UndoneStatuses: taskStatusesToStrings(domain.UndoneStatuses()),
+		OwnerID:        ownerID,
 	}
 
 	// Map field mask to sqlc params
@@ -196,6 +320,18 @@ func (s *Store) UpdateList(ctx context.Context, params domain.UpdateListParams)
 		sqlParams.SetTitle = true
 		sqlParams.Title = *params.Title
 	}
+	if maskSet["custom_fields"] {
+		var schema domain.CustomFieldSchema
+		if params.CustomFieldSchema != nil {
+			schema = *params.CustomFieldSchema
+		}
+		schemaJSON, err := customFieldSchemaToJSON(schema)
+		if err != nil {
+			return nil, err
+		}
+		sqlParams.SetCustomFieldSchema = true
+		sqlParams.CustomFieldSchema = schemaJSON
+	}
 
 	// Handle optimistic locking with etag
 	if params.Etag != nil {
@@ -211,7 +347,10 @@ func (s *Store) UpdateList(ctx context.Context, params domain.UpdateListParams)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			// Distinguish between not-found and version-conflict
-			existingList, lookupErr := s.queries.GetTodoList(ctx, listUUID.String())
+			existingList, lookupErr := s.queries.GetTodoList(ctx, sqlcgen.GetTodoListParams{
+				ID:      listUUID.String(),
+				OwnerID: ownerID,
+			})
 			if lookupErr != nil {
 				if errors.Is(lookupErr, pgx.ErrNoRows) {
 					return nil, fmt.Errorf("%w: list %s", domain.ErrListNotFound, params.ListID)
@@ -229,14 +368,21 @@ func (s *Store) UpdateList(ctx context.Context, params domain.UpdateListParams)
 		return nil, fmt.Errorf("failed to update list: %w", err)
 	}
 
+	schema, err := customFieldSchemaFromJSON(row.CustomFieldSchema)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert to domain model (all data returned atomically from single query)
 	return &domain.TodoList{
-		ID:          uuid.UUID(row.ID.Bytes).String(),
-		Title:       row.Title,
-		CreatedAt:   row.CreatedAt.Time.UTC(),
-		TotalItems:  int(row.TotalItems),
-		UndoneItems: int(row.UndoneItems),
-		Version:     int(row.Version),
+		ID:                uuid.UUID(row.ID.Bytes).String(),
+		Title:             row.Title,
+		CreatedAt:         row.CreatedAt.Time.UTC(),
+		OwnerID:           ptr.ToString(uuidToStringPtr(row.OwnerID)),
+		CustomFieldSchema: schema,
+		TotalItems:        int(row.TotalItems),
+		UndoneItems:       int(row.UndoneItems),
+		Version:           int(row.Version),
 	}, nil
 }
 
@@ -250,8 +396,17 @@ func (s *Store) CreateItem(ctx context.Context, listID string, item *domain.Todo
 		return nil, fmt.Errorf("failed to convert item: %w", err)
 	}
 
+	params.OwnerID, err = ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	row, err := s.queries.CreateTodoItem(ctx, params)
 	if err != nil {
+		// No row inserted: list belongs to another tenant
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: list %s", domain.ErrListNotFound, listID)
+		}
 		if isForeignKeyViolation(err, "list_id") {
 			return nil, fmt.Errorf("%w: %w", domain.ErrListNotFound, err)
 		}
@@ -278,7 +433,15 @@ func (s *Store) FindItemByID(ctx context.Context, id string) (*domain.TodoItem,
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
 	}
 
-	dbItem, err := s.queries.GetTodoItem(ctx, itemUUID.String())
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbItem, err := s.queries.GetTodoItem(ctx, sqlcgen.GetTodoItemParams{
+		ID:      itemUUID.String(),
+		OwnerID: ownerID,
+	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, fmt.Errorf("%w: item %s", domain.ErrItemNotFound, id)
@@ -314,9 +477,15 @@ func (s *Store) UpdateItem(ctx context.Context, params domain.UpdateItemParams)
 	}
 
 	// Build sqlc params - pass nil for fields not in mask to preserve existing values
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	sqlcParams := sqlcgen.UpdateTodoItemParams{
-		ID:     itemUUID.String(),
-		ListID: listUUID.String(),
+		ID:      itemUUID.String(),
+		ListID:  listUUID.String(),
+		OwnerID: ownerID,
 	}
 
 	// Map field mask to sqlc params (nil = preserve existing value via COALESCE)
@@ -372,6 +541,18 @@ func (s *Store) UpdateItem(ctx context.Context, params domain.UpdateItemParams)
 		sqlcParams.SetDueOffset = true
 		sqlcParams.DueOffset = durationPtrToPgtypeInterval(params.DueOffset)
 	}
+	if maskSet["snoozed_until"] {
+		sqlcParams.SetSnoozedUntil = true
+		sqlcParams.SnoozedUntil = timePtrToTimestamptz(params.SnoozedUntil)
+	}
+	if maskSet["custom_fields"] {
+		customFields, err := customFieldsToJSON(params.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+		sqlcParams.SetCustomFields = true
+		sqlcParams.CustomFields = customFields
+	}
 
 	// Handle detachment from recurring template
 	// Set by service layer when content/schedule fields are modified on recurring items
@@ -391,7 +572,10 @@ func (s *Store) UpdateItem(ctx context.Context, params domain.UpdateItemParams)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			// Distinguish between not-found and version-conflict
-			existingItem, lookupErr := s.queries.GetTodoItem(ctx, itemUUID.String())
+			existingItem, lookupErr := s.queries.GetTodoItem(ctx, sqlcgen.GetTodoItemParams{
+				ID:      itemUUID.String(),
+				OwnerID: ownerID,
+			})
 			if lookupErr != nil {
 				if errors.Is(lookupErr, pgx.ErrNoRows) {
 					return nil, fmt.Errorf("%w: item %s", domain.ErrItemNotFound, params.ItemID)
@@ -426,7 +610,15 @@ func (s *Store) UpdateItem(ctx context.Context, params domain.UpdateItemParams)
 // DeleteItem deletes a todo item by ID.
 // Returns domain.ErrItemNotFound if item doesn't exist.
 func (s *Store) DeleteItem(ctx context.Context, id string) error {
-	rowsAffected, err := s.queries.DeleteTodoItem(ctx, id)
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := s.queries.DeleteTodoItem(ctx, sqlcgen.DeleteTodoItemParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to delete item: %w", err)
 	}
@@ -447,118 +639,122 @@ func parseEtagToVersion(etag string) (int32, error) {
 	return version, err
 }
 
-// FindItems searches for items with filtering, sorting, and pagination.
+// FindItems searches for items with filtering, sorting, and keyset pagination.
 // excludedStatuses is provided by service layer based on business rules.
+// The statement is built by itemSearch, which compiles the filter expression.
 func (s *Store) FindItems(ctx context.Context, params domain.ListTasksParams, excludedStatuses []domain.TaskStatus) (*domain.PagedResult, error) {
-	// Build the query parameters for sqlc - uses empty arrays to skip filters
-	var zeroUUID uuid.UUID
-
-	// Column1: list_id (zero UUID to search all lists)
-	listUUID := zeroUUID
-	if params.ListID != nil {
-		parsed, err := uuid.Parse(*params.ListID)
-		if err != nil {
-			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
-		}
-		listUUID = parsed
+	// Tenant scope (NULL for internal callers)
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Column2: statuses array (empty array to skip filter)
-	statuses := taskStatusesToStrings(params.Filter.Statuses())
+	// Keyset cursor (NULL ID for the first page)
+	afterSortKey, afterID, err := pageCursorToQueryParams(params.After)
+	if err != nil {
+		return nil, err
+	}
 
-	// Column3: priorities array (empty array to skip filter)
-	priorities := taskPrioritiesToStrings(params.Filter.Priorities())
+	// Relative times of the filter expression resolve once, for the page and its count
+	now := time.Now().UTC()
+	search, err := newItemSearch(params, excludedStatuses, ownerID, now)
+	if err != nil {
+		return nil, err
+	}
 
-	// Column4: tags array (empty array to skip filter)
-	tags := params.Filter.Tags()
-	if tags == nil {
-		tags = []string{}
+	// One row beyond the page tells whether another page follows
+	query := search.listQuery(params.Filter, afterSortKey, afterID, params.Limit+1)
+	rows, err := s.pool.Query(ctx, query, search.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items: %w", err)
 	}
+	defer rows.Close()
 
-	// Column5: due_before (zero time to skip filter)
-	dueBefore := timePtrToQueryParam(params.DueBefore)
+	var dbItems []sqlcgen.TodoItem
+	var sortKeys [][]byte
+	for rows.Next() {
+		var sortKey []byte
+		i, err := scanSearchItem(rows, &sortKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan item: %w", err)
+		}
+		dbItems = append(dbItems, i)
+		sortKeys = append(sortKeys, sortKey)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list items: %w", err)
+	}
 
-	// Column6: due_after (zero time to skip filter)
-	dueAfter := timePtrToQueryParam(params.DueAfter)
+	result := &domain.PagedResult{}
+	if len(dbItems) > params.Limit {
+		result.HasMore = true
+		dbItems = dbItems[:params.Limit]
+		last := len(dbItems) - 1
+		result.NextCursor = newPageCursor(sortKeys[last], dbItems[last].ID)
+	}
 
-	// Column7, Column8: updated_at and created_at filters (zero time to skip filter)
-	updatedAt := timePtrToQueryParam(nil)
-	createdAt := timePtrToQueryParam(nil)
+	// Convert to domain items
+	result.Items = make([]domain.TodoItem, len(dbItems))
+	for i, dbItem := range dbItems {
+		item, err := dbTodoItemToDomain(dbItem)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert item: %w", err)
+		}
+		result.Items[i] = item
+	}
 
-	// Column9: order_by combined with direction (e.g., "created_at_desc", "due_time_asc")
-	orderBy := params.Filter.OrderBy()
-	if params.Filter.OrderDir() != "" {
-		orderBy = params.Filter.OrderBy() + "_" + params.Filter.OrderDir()
+	// The total reads every matching item, so it is only counted on request
+	if params.IncludeTotalCount {
+		count, err := newItemSearch(params, excludedStatuses, ownerID, now)
+		if err != nil {
+			return nil, err
+		}
+		var total int64
+		if err := s.pool.QueryRow(ctx, count.countQuery(), count.args...).Scan(&total); err != nil {
+			return nil, fmt.Errorf("failed to count items: %w", err)
+		}
+		result.TotalCount = ptr.To(int(total))
 	}
 
-	// Column12: excluded_statuses (provided by service layer)
-	excludedStatusStrings := taskStatusesToStrings(excludedStatuses)
+	return result, nil
+}
 
-	sqlcParams := sqlcgen.ListTasksWithFiltersParams{
-		Column1:  uuidToQueryParam(listUUID),
-		Column2:  statuses,
-		Column3:  priorities,
-		Column4:  tags,
-		Column5:  dueBefore,
-		Column6:  dueAfter,
-		Column7:  updatedAt,
-		Column8:  createdAt,
-		Column9:  orderBy,
-		Limit:    int32(params.Limit),
-		Offset:   int32(params.Offset),
-		Column12: excludedStatusStrings,
+// FindAgendaItems returns the open items due before query.DueBefore, or occurring
+// or starting in the scheduled window, earliest first. Recurring instances are
+// found by their occurrence like any other item; snoozed items are left out.
+func (s *Store) FindAgendaItems(ctx context.Context, query domain.AgendaQuery) ([]domain.TodoItem, error) {
+	// Tenant scope (NULL for internal callers)
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Execute query - includes COUNT(*) OVER() as total_count in each row
-	dbItems, err := s.queries.ListTasksWithFilters(ctx, sqlcParams)
+	search, err := newItemSearch(domain.ListTasksParams{ExcludeSnoozed: true}, nil, ownerID, time.Now().UTC())
 	if err != nil {
-		return nil, fmt.Errorf("failed to list items: %w", err)
+		return nil, err
+	}
+	rows, err := s.pool.Query(ctx, search.agendaQuery(query), search.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list agenda items: %w", err)
 	}
+	defer rows.Close()
 
-	// Get total count from first row (all rows have same total_count from window function)
-	// If no rows returned, run separate count query to get actual total
-	var totalCount int
-	if len(dbItems) > 0 {
-		totalCount = int(dbItems[0].TotalCount)
-	} else {
-		// Empty page - need separate count query to know actual total
-		// This handles the case where offset >= total items
-		countParams := sqlcgen.CountTasksWithFiltersParams{
-			Column1: uuidToQueryParam(listUUID),
-			Column2: statuses,
-			Column3: priorities,
-			Column4: tags,
-			Column5: dueBefore,
-			Column6: dueAfter,
-			Column7: updatedAt,
-			Column8: createdAt,
-			Column9: excludedStatusStrings,
-		}
-		count, err := s.queries.CountTasksWithFilters(ctx, countParams)
+	var items []domain.TodoItem
+	for rows.Next() {
+		dbItem, err := scanSearchItem(rows)
 		if err != nil {
-			return nil, fmt.Errorf("failed to count items: %w", err)
+			return nil, fmt.Errorf("failed to scan item: %w", err)
 		}
-		totalCount = int(count)
-	}
-
-	// Convert to domain items
-	items := make([]domain.TodoItem, len(dbItems))
-	for i, dbItem := range dbItems {
-		item, err := dbListTasksRowToDomain(dbItem)
+		item, err := dbTodoItemToDomain(dbItem)
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert item: %w", err)
 		}
-		items[i] = item
+		items = append(items, item)
 	}
-
-	// HasMore is true if there are more items beyond what we've returned
-	hasMore := params.Offset+len(items) < totalCount
-
-	return &domain.PagedResult{
-		Items:      items,
-		TotalCount: totalCount,
-		HasMore:    hasMore,
-	}, nil
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list agenda items: %w", err)
+	}
+	return items, nil
 }
 
 // === Recurring Template Operations ===
@@ -571,8 +767,17 @@ func (s *Store) CreateRecurringTemplate(ctx context.Context, template *domain.Re
 		return nil, fmt.Errorf("failed to convert template: %w", err)
 	}
 
+	params.OwnerID, err = ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	row, err := s.queries.CreateRecurringTemplate(ctx, params)
 	if err != nil {
+		// No row inserted: list belongs to another tenant
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: list %s", domain.ErrListNotFound, template.ListID)
+		}
 		if isForeignKeyViolation(err, "list_id") {
 			return nil, fmt.Errorf("%w: %w", domain.ErrListNotFound, err)
 		}
@@ -590,7 +795,15 @@ func (s *Store) FindRecurringTemplateByID(ctx context.Context, id string) (*doma
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
 	}
 
-	dbTemplate, err := s.queries.FindRecurringTemplateByID(ctx, templateUUID.String())
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbTemplate, err := s.queries.FindRecurringTemplateByID(ctx, sqlcgen.FindRecurringTemplateByIDParams{
+		ID:      templateUUID.String(),
+		OwnerID: ownerID,
+	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, fmt.Errorf("%w: template %s", domain.ErrTemplateNotFound, id)
@@ -623,8 +836,14 @@ func (s *Store) UpdateRecurringTemplate(ctx context.Context, params domain.Updat
 	}
 
 	// Build sqlc params with field mask support
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	sqlcParams := sqlcgen.UpdateRecurringTemplateParams{
-		ID: templateUUID.String(),
+		ID:      templateUUID.String(),
+		OwnerID: ownerID,
 	}
 
 	// Map field mask to sqlc params (Set* = true means update this field)
@@ -689,6 +908,22 @@ func (s *Store) UpdateRecurringTemplate(ctx context.Context, params domain.Updat
 			sqlcParams.GenerationHorizonDays = int32PtrToInt4(&days)
 		}
 	}
+	if maskSet["custom_fields"] {
+		customFields, err := customFieldsToJSON(params.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+		sqlcParams.SetCustomFields = true
+		sqlcParams.CustomFields = customFields
+	}
+	if maskSet["default_reminders"] {
+		defaultReminders, err := defaultRemindersToJSON(params.DefaultReminders)
+		if err != nil {
+			return nil, err
+		}
+		sqlcParams.SetDefaultReminders = true
+		sqlcParams.DefaultReminders = defaultReminders
+	}
 
 	// Handle optimistic locking with etag
 	if params.Etag != nil {
@@ -704,7 +939,10 @@ func (s *Store) UpdateRecurringTemplate(ctx context.Context, params domain.Updat
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			// Distinguish between not-found and version-conflict
-			existingTemplate, lookupErr := s.queries.FindRecurringTemplateByID(ctx, templateUUID.String())
+			existingTemplate, lookupErr := s.queries.FindRecurringTemplateByID(ctx, sqlcgen.FindRecurringTemplateByIDParams{
+				ID:      templateUUID.String(),
+				OwnerID: ownerID,
+			})
 			if lookupErr != nil {
 				if errors.Is(lookupErr, pgx.ErrNoRows) {
 					return nil, fmt.Errorf("%w: template %s", domain.ErrTemplateNotFound, params.TemplateID)
@@ -737,11 +975,17 @@ func (s *Store) DeleteRecurringTemplate(ctx context.Context, id string) error {
 		return fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
 	}
 
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return err
+	}
+
 	return s.executeInTransaction(ctx, "delete_recurring_template", func(txStore *Store) error {
-		// 1. Deactivate template (soft delete)
+		// 1. Deactivate template (soft delete, scoped to tenant)
 		rowsAffected, err := txStore.queries.DeactivateRecurringTemplate(ctx, sqlcgen.DeactivateRecurringTemplateParams{
 			UpdatedAt: time.Now().UTC(),
 			ID:        templateUUID.String(),
+			OwnerID:   ownerID,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to deactivate template: %w", err)
@@ -771,16 +1015,27 @@ func (s *Store) FindRecurringTemplates(ctx context.Context, listID string, activ
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
 	}
 
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var dbTemplates []sqlcgen.RecurringTaskTemplate
 	if activeOnly {
 		// Get active templates for this specific list (WHERE list_id = $1 AND is_active = true)
-		dbTemplates, err = s.queries.ListRecurringTemplates(ctx, listUUID.String())
+		dbTemplates, err = s.queries.ListRecurringTemplates(ctx, sqlcgen.ListRecurringTemplatesParams{
+			ListID:  listUUID.String(),
+			OwnerID: ownerID,
+		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to list active templates: %w", err)
 		}
 	} else {
 		// Get all templates (active and inactive) for this list (WHERE list_id = $1)
-		dbTemplates, err = s.queries.ListAllRecurringTemplatesByList(ctx, listUUID.String())
+		dbTemplates, err = s.queries.ListAllRecurringTemplatesByList(ctx, sqlcgen.ListAllRecurringTemplatesByListParams{
+			ListID:  listUUID.String(),
+			OwnerID: ownerID,
+		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to list templates: %w", err)
 		}
@@ -0,0 +1,361 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// === Automation Rule Operations ===
+//
+// Rules are not scoped to a tenant: the service checks access to their list.
+
+// CreateAutomationRule stores a new rule.
+// Returns domain.ErrListNotFound if the rule's list doesn't exist.
+func (s *Store) CreateAutomationRule(ctx context.Context, rule *domain.AutomationRule) (*domain.AutomationRule, error) {
+	if _, err := uuid.Parse(rule.ID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(rule.ListID); err != nil {
+		return nil, fmt.Errorf("%w: list %w", domain.ErrInvalidID, err)
+	}
+	condition, actions, err := automationRuleToDB(rule)
+	if err != nil {
+		return nil, err
+	}
+	ownerID, err := ruleOwnerToDB(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	dbRule, err := s.queries.CreateAutomationRule(ctx, sqlcgen.CreateAutomationRuleParams{
+		ID:            rule.ID,
+		ListID:        rule.ListID,
+		Name:          rule.Name,
+		Enabled:       rule.Enabled,
+		TriggerType:   string(rule.Trigger.Type),
+		TriggerStatus: ptrToNullString((*string)(rule.Trigger.ToStatus)),
+		TriggerTag:    ptrToNullString(rule.Trigger.Tag),
+		OverdueBy:     ruleOverdueByToDB(rule.Trigger),
+		Condition:     condition,
+		Actions:       actions,
+		CreatedAt:     rule.CreatedAt,
+		OwnerID:       ownerID,
+	})
+	if err != nil {
+		if isForeignKeyViolation(err, "list_id") {
+			return nil, fmt.Errorf("%w: %s", domain.ErrListNotFound, rule.ListID)
+		}
+		return nil, fmt.Errorf("failed to create automation rule: %w", err)
+	}
+
+	return dbAutomationRuleToDomain(dbRule)
+}
+
+// FindAutomationRuleByID retrieves a rule of a list.
+// Returns domain.ErrRuleNotFound if it doesn't exist.
+func (s *Store) FindAutomationRuleByID(ctx context.Context, listID, id string) (*domain.AutomationRule, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(listID); err != nil {
+		return nil, fmt.Errorf("%w: list %w", domain.ErrInvalidID, err)
+	}
+
+	dbRule, err := s.queries.GetAutomationRule(ctx, sqlcgen.GetAutomationRuleParams{
+		ID:     id,
+		ListID: listID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrRuleNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get automation rule: %w", err)
+	}
+
+	return dbAutomationRuleToDomain(dbRule)
+}
+
+// FindAutomationRules lists the rules of a list, oldest first.
+func (s *Store) FindAutomationRules(ctx context.Context, listID string) ([]*domain.AutomationRule, error) {
+	if _, err := uuid.Parse(listID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	dbRules, err := s.queries.ListAutomationRules(ctx, listID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list automation rules: %w", err)
+	}
+	return dbAutomationRulesToDomain(dbRules)
+}
+
+// FindEnabledAutomationRules lists the enabled rules of a list with a trigger,
+// in the order they run.
+func (s *Store) FindEnabledAutomationRules(ctx context.Context, listID string, trigger domain.RuleTriggerType) ([]*domain.AutomationRule, error) {
+	if _, err := uuid.Parse(listID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	dbRules, err := s.queries.ListEnabledAutomationRules(ctx, sqlcgen.ListEnabledAutomationRulesParams{
+		ListID:      listID,
+		TriggerType: string(trigger),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enabled automation rules: %w", err)
+	}
+	return dbAutomationRulesToDomain(dbRules)
+}
+
+func dbAutomationRulesToDomain(dbRules []sqlcgen.AutomationRule) ([]*domain.AutomationRule, error) {
+	rules := make([]*domain.AutomationRule, 0, len(dbRules))
+	for _, dbRule := range dbRules {
+		rule, err := dbAutomationRuleToDomain(dbRule)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// CountAutomationRules returns the number of rules of a list.
+func (s *Store) CountAutomationRules(ctx context.Context, listID string) (int, error) {
+	if _, err := uuid.Parse(listID); err != nil {
+		return 0, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	count, err := s.queries.CountAutomationRules(ctx, listID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count automation rules: %w", err)
+	}
+	return int(count), nil
+}
+
+// UpdateAutomationRule replaces the name, enabled flag, trigger, condition, actions and owner of a rule.
+// Returns domain.ErrRuleNotFound if it doesn't exist.
+func (s *Store) UpdateAutomationRule(ctx context.Context, rule *domain.AutomationRule) (*domain.AutomationRule, error) {
+	if _, err := uuid.Parse(rule.ID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(rule.ListID); err != nil {
+		return nil, fmt.Errorf("%w: list %w", domain.ErrInvalidID, err)
+	}
+	condition, actions, err := automationRuleToDB(rule)
+	if err != nil {
+		return nil, err
+	}
+	ownerID, err := ruleOwnerToDB(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	dbRule, err := s.queries.UpdateAutomationRule(ctx, sqlcgen.UpdateAutomationRuleParams{
+		ID:            rule.ID,
+		ListID:        rule.ListID,
+		Name:          rule.Name,
+		Enabled:       rule.Enabled,
+		TriggerType:   string(rule.Trigger.Type),
+		TriggerStatus: ptrToNullString((*string)(rule.Trigger.ToStatus)),
+		TriggerTag:    ptrToNullString(rule.Trigger.Tag),
+		OverdueBy:     ruleOverdueByToDB(rule.Trigger),
+		Condition:     condition,
+		Actions:       actions,
+		UpdatedAt:     rule.UpdatedAt,
+		OwnerID:       ownerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrRuleNotFound, rule.ID)
+		}
+		return nil, fmt.Errorf("failed to update automation rule: %w", err)
+	}
+
+	return dbAutomationRuleToDomain(dbRule)
+}
+
+// DeleteAutomationRule removes a rule of a list together with its execution log.
+// Returns domain.ErrRuleNotFound if it doesn't exist.
+func (s *Store) DeleteAutomationRule(ctx context.Context, listID, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(listID); err != nil {
+		return fmt.Errorf("%w: list %w", domain.ErrInvalidID, err)
+	}
+
+	rows, err := s.queries.DeleteAutomationRule(ctx, sqlcgen.DeleteAutomationRuleParams{
+		ID:     id,
+		ListID: listID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete automation rule: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrRuleNotFound, id)
+	}
+	return nil
+}
+
+// CreateRuleRun appends a run to the execution log of a rule.
+func (s *Store) CreateRuleRun(ctx context.Context, run *domain.RuleRun) error {
+	actions := run.Actions
+	if actions == nil {
+		actions = []string{}
+	}
+
+	if err := s.queries.CreateAutomationRuleRun(ctx, sqlcgen.CreateAutomationRuleRunParams{
+		ID:          run.ID,
+		RuleID:      run.RuleID,
+		ListID:      run.ListID,
+		ItemID:      run.ItemID,
+		TriggerType: string(run.Trigger),
+		Depth:       int32(run.Depth),
+		Status:      string(run.Status),
+		Actions:     actions,
+		Error:       ptrToNullString(run.Error),
+		CreatedAt:   run.CreatedAt,
+	}); err != nil {
+		return fmt.Errorf("failed to record automation rule run: %w", err)
+	}
+	return nil
+}
+
+// FindRuleRuns lists the most recent runs of a rule, newest first.
+func (s *Store) FindRuleRuns(ctx context.Context, ruleID string, limit int) ([]*domain.RuleRun, error) {
+	if _, err := uuid.Parse(ruleID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	dbRuns, err := s.queries.ListAutomationRuleRuns(ctx, sqlcgen.ListAutomationRuleRunsParams{
+		RuleID:    ruleID,
+		PageLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list automation rule runs: %w", err)
+	}
+
+	runs := make([]*domain.RuleRun, 0, len(dbRuns))
+	for _, dbRun := range dbRuns {
+		runs = append(runs, dbRuleRunToDomain(dbRun))
+	}
+	return runs, nil
+}
+
+// FindOverdueRuleCandidates returns up to limit open items that are overdue for
+// an enabled overdue rule at now and that the rule has not fired for at their
+// current due date, earliest due date first.
+func (s *Store) FindOverdueRuleCandidates(ctx context.Context, now time.Time, limit int) ([]domain.OverdueRuleCandidate, error) {
+	rows, err := s.queries.ListOverdueRuleCandidates(ctx, sqlcgen.ListOverdueRuleCandidatesParams{
+		Now:      timeToTimestamptz(now),
+		RowLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list overdue rule candidates: %w", err)
+	}
+
+	candidates := make([]domain.OverdueRuleCandidate, 0, len(rows))
+	for _, row := range rows {
+		candidates = append(candidates, domain.OverdueRuleCandidate{
+			RuleID: row.RuleID,
+			ListID: row.ListID,
+			ItemID: row.ItemID,
+			DueAt:  row.DueAt.Time.UTC(),
+		})
+	}
+	return candidates, nil
+}
+
+// RecordOverdueRuleFire remembers that an overdue rule fired for an item at a
+// due date. Returns false if it had already fired.
+func (s *Store) RecordOverdueRuleFire(ctx context.Context, ruleID, itemID string, dueAt, firedAt time.Time) (bool, error) {
+	rows, err := s.queries.RecordOverdueRuleFire(ctx, sqlcgen.RecordOverdueRuleFireParams{
+		RuleID:  ruleID,
+		ItemID:  itemID,
+		DueAt:   dueAt,
+		FiredAt: firedAt,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to record overdue rule fire: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// MoveItem moves an item, with its time entries, from one list to another.
+// Returns domain.ErrItemNotFound if the item is not in fromListID, and
+// domain.ErrListNotFound if toListID doesn't exist.
+func (s *Store) MoveItem(ctx context.Context, itemID, fromListID, toListID string) (*domain.TodoItem, error) {
+	for _, id := range []string{itemID, fromListID, toListID} {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+		}
+	}
+
+	dbItem, err := s.queries.MoveTodoItem(ctx, sqlcgen.MoveTodoItemParams{
+		ID:         itemID,
+		FromListID: fromListID,
+		ToListID:   toListID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrItemNotFound, itemID)
+		}
+		if isForeignKeyViolation(err, "list_id") {
+			return nil, fmt.Errorf("%w: %s", domain.ErrListNotFound, toListID)
+		}
+		return nil, fmt.Errorf("failed to move item: %w", err)
+	}
+
+	if err := s.queries.MoveTimeEntries(ctx, sqlcgen.MoveTimeEntriesParams{
+		ItemID: itemID,
+		ListID: toListID,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to move time entries: %w", err)
+	}
+
+	item, err := dbTodoItemToDomain(dbItem)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// EnqueueWebhookEvent queues a delivery of an event to a single active
+// subscription, whatever events it subscribes to, and returns its ID.
+// Returns domain.ErrWebhookNotFound if no such active subscription exists.
+func (s *Store) EnqueueWebhookEvent(ctx context.Context, subscriptionID string, event *domain.Event) (string, error) {
+	if _, err := uuid.Parse(subscriptionID); err != nil {
+		return "", fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	payload, err := json.Marshal(eventEnvelope{
+		ID:         event.ID,
+		Type:       string(event.Type),
+		OccurredAt: event.OccurredAt.UTC(),
+		Data:       event.Data,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	id, err := s.queries.EnqueueWebhookDelivery(ctx, sqlcgen.EnqueueWebhookDeliveryParams{
+		SubscriptionID: subscriptionID,
+		EventID:        event.ID,
+		EventType:      string(event.Type),
+		Payload:        payload,
+		CreatedAt:      event.OccurredAt.UTC(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("%w: %s", domain.ErrWebhookNotFound, subscriptionID)
+		}
+		return "", fmt.Errorf("failed to enqueue webhook delivery: %w", err)
+	}
+	return id, nil
+}
@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// === Backup Operations ===
+
+// FindStatusHistory returns the status history of items, oldest change first per item.
+func (s *Store) FindStatusHistory(ctx context.Context, itemIDs []string) ([]*domain.StatusChange, error) {
+	if len(itemIDs) == 0 {
+		return []*domain.StatusChange{}, nil
+	}
+	taskIDs, err := stringsToUUIDParams(itemIDs)
+	if err != nil {
+		return nil, err
+	}
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.FindStatusHistoryByTasks(ctx, sqlcgen.FindStatusHistoryByTasksParams{
+		TaskIds: taskIDs,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find status history: %w", err)
+	}
+
+	changes := make([]*domain.StatusChange, 0, len(rows))
+	for _, row := range rows {
+		change := &domain.StatusChange{
+			ID:        row.ID,
+			ItemID:    row.TaskID,
+			ToStatus:  domain.TaskStatus(row.ToStatus),
+			ChangedAt: row.ChangedAt.UTC(),
+			Notes:     nullStringToPtr(row.Notes),
+		}
+		if row.FromStatus.Valid {
+			from := domain.TaskStatus(row.FromStatus.V)
+			change.FromStatus = &from
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// RestoreStatusHistory replaces the status history of the items the changes
+// belong to with the changes, e.g. the history recorded when they were inserted.
+func (s *Store) RestoreStatusHistory(ctx context.Context, changes []domain.StatusChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	var itemIDs []string
+	params := make([]sqlcgen.BatchCreateStatusHistoryEntriesParams, 0, len(changes))
+	for _, change := range changes {
+		if !seen[change.ItemID] {
+			seen[change.ItemID] = true
+			itemIDs = append(itemIDs, change.ItemID)
+		}
+		var from *string
+		if change.FromStatus != nil {
+			from = (*string)(change.FromStatus)
+		}
+		params = append(params, sqlcgen.BatchCreateStatusHistoryEntriesParams{
+			ID:         change.ID,
+			TaskID:     change.ItemID,
+			FromStatus: ptrToNullString(from),
+			ToStatus:   string(change.ToStatus),
+			ChangedAt:  change.ChangedAt.UTC(),
+			Notes:      ptrToNullString(change.Notes),
+		})
+	}
+
+	taskIDs, err := stringsToUUIDParams(itemIDs)
+	if err != nil {
+		return err
+	}
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.queries.DeleteStatusHistoryByTasks(ctx, sqlcgen.DeleteStatusHistoryByTasksParams{
+		TaskIds: taskIDs,
+		OwnerID: ownerID,
+	}); err != nil {
+		return fmt.Errorf("failed to clear status history: %w", err)
+	}
+	if _, err := s.queries.BatchCreateStatusHistoryEntries(ctx, params); err != nil {
+		return fmt.Errorf("failed to restore status history: %w", err)
+	}
+	return nil
+}
+
+// FindBackupConflicts returns the list, template and item IDs of a backup that
+// are already taken, in any tenant and as an ID of any kind. IDs taken by rows the
+// caller cannot see are reported as unavailable, without their kind.
+func (s *Store) FindBackupConflicts(ctx context.Context, backup *domain.ListBackup) ([]domain.BackupConflict, error) {
+	ids := make([]string, 0, 1+len(backup.Templates)+len(backup.Items))
+	ids = append(ids, backup.List.ID)
+	for _, t := range backup.Templates {
+		ids = append(ids, t.Template.ID)
+	}
+	for _, i := range backup.Items {
+		ids = append(ids, i.Item.ID)
+	}
+	idParams, err := stringsToUUIDParams(ids)
+	if err != nil {
+		return nil, err
+	}
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.FindTakenBackupIDs(ctx, sqlcgen.FindTakenBackupIDsParams{
+		Ids:     idParams,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find backup conflicts: %w", err)
+	}
+
+	conflicts := make([]domain.BackupConflict, 0, len(rows))
+	for _, row := range rows {
+		kind := row.Kind
+		if !row.Visible {
+			kind = domain.BackupConflictUnavailable
+		}
+		conflicts = append(conflicts, domain.BackupConflict{Kind: kind, ID: row.ID})
+	}
+	return conflicts, nil
+}
+
+// stringsToUUIDParams converts IDs to a uuid[] query parameter.
+func stringsToUUIDParams(ids []string) ([]pgtype.UUID, error) {
+	params := make([]pgtype.UUID, 0, len(ids))
+	for _, id := range ids {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+		}
+		params = append(params, uuidToQueryParam(parsed))
+	}
+	return params, nil
+}
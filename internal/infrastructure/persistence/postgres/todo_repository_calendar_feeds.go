@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+	"github.com/rezkam/mono/internal/ptr"
+)
+
+// === Calendar Feed Operations ===
+//
+// Feeds are scoped to the tenant in the context like saved views, except for
+// the lookup by token: feed requests carry no principal, the token is the credential.
+
+// CreateCalendarFeed stores a new calendar feed.
+func (s *Store) CreateCalendarFeed(ctx context.Context, feed *domain.CalendarFeed) (*domain.CalendarFeed, error) {
+	if _, err := uuid.Parse(feed.ID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(feed.ListID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	// Feeds created by internal callers have no owner (NULL)
+	var owner *string
+	if feed.OwnerID != "" {
+		owner = &feed.OwnerID
+	}
+	ownerID, err := stringPtrToNullUUID(owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert calendar feed owner: %w", err)
+	}
+
+	dbFeed, err := s.queries.CreateCalendarFeed(ctx, sqlcgen.CreateCalendarFeedParams{
+		ID:        feed.ID,
+		OwnerID:   ownerID,
+		ListID:    feed.ListID,
+		Name:      feed.Name,
+		Statuses:  append([]string{}, feed.Statuses...), // NOT NULL: an empty filter is {}
+		Tags:      append([]string{}, feed.Tags...),
+		TokenHash: feed.TokenHash,
+		CreatedAt: feed.CreatedAt,
+	})
+	if err != nil {
+		if isForeignKeyViolation(err, "list_id") {
+			return nil, fmt.Errorf("%w: %s", domain.ErrListNotFound, feed.ListID)
+		}
+		return nil, fmt.Errorf("failed to create calendar feed: %w", err)
+	}
+
+	return dbCalendarFeedToDomain(dbFeed), nil
+}
+
+// FindCalendarFeedByTokenHash retrieves the feed a token belongs to.
+// Returns domain.ErrCalendarFeedNotFound if no feed has the token.
+func (s *Store) FindCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*domain.CalendarFeed, error) {
+	dbFeed, err := s.queries.GetCalendarFeedByTokenHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrCalendarFeedNotFound
+		}
+		return nil, fmt.Errorf("failed to get calendar feed: %w", err)
+	}
+	return dbCalendarFeedToDomain(dbFeed), nil
+}
+
+// FindCalendarFeeds lists the feeds of a list, oldest first.
+func (s *Store) FindCalendarFeeds(ctx context.Context, listID string) ([]*domain.CalendarFeed, error) {
+	if _, err := uuid.Parse(listID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbFeeds, err := s.queries.ListCalendarFeeds(ctx, sqlcgen.ListCalendarFeedsParams{
+		ListID:  listID,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list calendar feeds: %w", err)
+	}
+
+	feeds := make([]*domain.CalendarFeed, 0, len(dbFeeds))
+	for _, dbFeed := range dbFeeds {
+		feeds = append(feeds, dbCalendarFeedToDomain(dbFeed))
+	}
+	return feeds, nil
+}
+
+// DeleteCalendarFeed removes a feed of a list; its token stops working.
+// Returns domain.ErrCalendarFeedNotFound if it doesn't exist.
+func (s *Store) DeleteCalendarFeed(ctx context.Context, listID, id string) error {
+	if _, err := uuid.Parse(listID); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return err
+	}
+
+	rows, err := s.queries.DeleteCalendarFeed(ctx, sqlcgen.DeleteCalendarFeedParams{
+		ID:      id,
+		ListID:  listID,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete calendar feed: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrCalendarFeedNotFound, id)
+	}
+	return nil
+}
+
+// dbCalendarFeedToDomain converts a calendar_feeds row. The token itself is never stored.
+func dbCalendarFeedToDomain(dbFeed sqlcgen.CalendarFeed) *domain.CalendarFeed {
+	return &domain.CalendarFeed{
+		ID:        dbFeed.ID,
+		OwnerID:   ptr.ToString(nullUUIDToStringPtr(dbFeed.OwnerID)),
+		ListID:    dbFeed.ListID,
+		Name:      dbFeed.Name,
+		Statuses:  dbFeed.Statuses,
+		Tags:      dbFeed.Tags,
+		TokenHash: dbFeed.TokenHash,
+		CreatedAt: dbFeed.CreatedAt.UTC(),
+	}
+}
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// === Change Feed Operations ===
+
+// FindChanges returns change log rows for an event stream, in (TxID, Seq) order.
+// Restricted to lists owned by or shared with the principal in the context.
+// Like sync, only changes of transactions older than the oldest running one
+// are read, so a change committing late can never land behind a position
+// already streamed.
+func (s *Store) FindChanges(ctx context.Context, query domain.ChangeFeedQuery) ([]*domain.Change, error) {
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read before the changes: every transaction older than the watermark is
+	// finished, so the next statement sees all of their changes
+	watermark, err := s.queries.GetChangeWatermark(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get change watermark: %w", err)
+	}
+
+	params := sqlcgen.ListChangesForFeedParams{
+		Watermark:   watermark,
+		AfterTxid:   query.After.TxID,
+		AfterSeq:    query.After.Seq,
+		EntityTypes: make([]string, len(query.EntityTypes)),
+		OwnerID:     ownerID,
+		PageLimit:   int32(query.Limit),
+	}
+	for i, t := range query.EntityTypes {
+		params.EntityTypes[i] = string(t)
+	}
+	if query.ListID != "" {
+		listUUID, err := uuid.Parse(query.ListID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+		}
+		params.ListID = uuidToQueryParam(listUUID)
+	}
+
+	rows, err := s.queries.ListChangesForFeed(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find changes: %w", err)
+	}
+
+	changes := make([]*domain.Change, 0, len(rows))
+	for _, row := range rows {
+		change, err := dbChangeToDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// FindChangeWatermark returns the ID of the oldest running transaction.
+// Every change of an older transaction has committed or rolled back.
+func (s *Store) FindChangeWatermark(ctx context.Context) (uint64, error) {
+	watermark, err := s.queries.GetChangeWatermark(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get change watermark: %w", err)
+	}
+	return watermark, nil
+}
@@ -42,6 +42,11 @@ func (s *Store) CreateException(ctx context.Context, exception *domain.Recurring
 		itemUUID = pgtype.UUID{Bytes: parsedItemUUID, Valid: true}
 	}
 
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	dbException, err := s.queries.CreateException(ctx, sqlcgen.CreateExceptionParams{
 		ID:            pgtype.UUID{Bytes: idUUID, Valid: true},
 		TemplateID:    pgtype.UUID{Bytes: templateUUID, Valid: true},
@@ -49,6 +54,7 @@ func (s *Store) CreateException(ctx context.Context, exception *domain.Recurring
 		ExceptionType: string(exception.ExceptionType),
 		ItemID:        itemUUID,
 		CreatedAt:     timeToTimestamptz(exception.CreatedAt),
+		OwnerID:       ownerID,
 	})
 
 	if err != nil {
@@ -56,6 +62,10 @@ func (s *Store) CreateException(ctx context.Context, exception *domain.Recurring
 		if isUniqueViolation(err) {
 			return nil, domain.ErrExceptionAlreadyExists
 		}
+		// No row inserted: template belongs to another tenant
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTemplateNotFound
+		}
 		return nil, err
 	}
 
@@ -68,10 +78,16 @@ func (s *Store) FindExceptions(ctx context.Context, templateID string, from, unt
 		return nil, err
 	}
 
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	dbExceptions, err := s.queries.FindExceptions(ctx, sqlcgen.FindExceptionsParams{
 		TemplateID: pgtype.UUID{Bytes: templateUUID, Valid: true},
-		OccursAt:   timeToTimestamptz(from),
-		OccursAt_2: timeToTimestamptz(until),
+		OccursFrom: timeToTimestamptz(from),
+		OccursTo:   timeToTimestamptz(until),
+		OwnerID:    ownerID,
 	})
 
 	if err != nil {
@@ -96,9 +112,15 @@ func (s *Store) FindExceptionByOccurrence(ctx context.Context, templateID string
 		return nil, err
 	}
 
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	dbException, err := s.queries.FindExceptionByOccurrence(ctx, sqlcgen.FindExceptionByOccurrenceParams{
 		TemplateID: pgtype.UUID{Bytes: templateUUID, Valid: true},
 		OccursAt:   timeToTimestamptz(occursAt),
+		OwnerID:    ownerID,
 	})
 
 	if err != nil {
@@ -117,9 +139,15 @@ func (s *Store) DeleteException(ctx context.Context, templateID string, occursAt
 		return err
 	}
 
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return err
+	}
+
 	err = s.queries.DeleteException(ctx, sqlcgen.DeleteExceptionParams{
 		TemplateID: pgtype.UUID{Bytes: templateUUID, Valid: true},
 		OccursAt:   timeToTimestamptz(occursAt),
+		OwnerID:    ownerID,
 	})
 
 	if err != nil {
@@ -135,7 +163,15 @@ func (s *Store) ListAllExceptionsByTemplate(ctx context.Context, templateID stri
 		return nil, err
 	}
 
-	dbExceptions, err := s.queries.ListAllExceptionsByTemplate(ctx, pgtype.UUID{Bytes: templateUUID, Valid: true})
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbExceptions, err := s.queries.ListAllExceptionsByTemplate(ctx, sqlcgen.ListAllExceptionsByTemplateParams{
+		TemplateID: pgtype.UUID{Bytes: templateUUID, Valid: true},
+		OwnerID:    ownerID,
+	})
 
 	if err != nil {
 		return nil, err
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// === Import Record Operations ===
+
+// CreateImportRecord remembers that an external ID was imported into a list.
+// Returns domain.ErrAlreadyImported if the list already has a record for it.
+func (s *Store) CreateImportRecord(ctx context.Context, record *domain.ImportRecord) error {
+	if _, err := uuid.Parse(record.ListID); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	itemID, err := stringPtrToNullUUID(record.ItemID)
+	if err != nil {
+		return err
+	}
+	templateID, err := stringPtrToNullUUID(record.TemplateID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := s.queries.CreateImportRecord(ctx, sqlcgen.CreateImportRecordParams{
+		ListID:     record.ListID,
+		ExternalID: record.ExternalID,
+		ItemID:     itemID,
+		TemplateID: templateID,
+		CreatedAt:  record.CreatedAt,
+	})
+	if err != nil {
+		if isForeignKeyViolation(err, "list_id") {
+			return fmt.Errorf("%w: %s", domain.ErrListNotFound, record.ListID)
+		}
+		return fmt.Errorf("failed to create import record: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrAlreadyImported, record.ExternalID)
+	}
+	return nil
+}
+
+// FindImportRecords returns the records of the external IDs already imported into a list.
+func (s *Store) FindImportRecords(ctx context.Context, listID string, externalIDs []string) ([]*domain.ImportRecord, error) {
+	if _, err := uuid.Parse(listID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	if len(externalIDs) == 0 {
+		return []*domain.ImportRecord{}, nil
+	}
+
+	dbRecords, err := s.queries.FindImportRecords(ctx, sqlcgen.FindImportRecordsParams{
+		ListID:      listID,
+		ExternalIds: externalIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find import records: %w", err)
+	}
+
+	records := make([]*domain.ImportRecord, 0, len(dbRecords))
+	for _, dbRecord := range dbRecords {
+		records = append(records, &domain.ImportRecord{
+			ListID:     dbRecord.ListID,
+			ExternalID: dbRecord.ExternalID,
+			ItemID:     nullUUIDToStringPtr(dbRecord.ItemID),
+			TemplateID: nullUUIDToStringPtr(dbRecord.TemplateID),
+			CreatedAt:  dbRecord.CreatedAt.UTC(),
+		})
+	}
+	return records, nil
+}
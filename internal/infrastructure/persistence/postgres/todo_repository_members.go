@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// FindListRole returns the role of a principal on a list.
+// Returns domain.ErrListNotFound if the list doesn't exist or the principal has no access.
+func (s *Store) FindListRole(ctx context.Context, listID, principalID string) (domain.ListRole, error) {
+	if _, err := uuid.Parse(listID); err != nil {
+		return "", fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	principalUUID, err := uuid.Parse(principalID)
+	if err != nil {
+		return "", fmt.Errorf("%w: principal %w", domain.ErrInvalidID, err)
+	}
+
+	role, err := s.queries.GetListRole(ctx, sqlcgen.GetListRoleParams{
+		ListID:      listID,
+		PrincipalID: uuidToQueryParam(principalUUID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("%w: %s", domain.ErrListNotFound, listID)
+		}
+		return "", fmt.Errorf("failed to get list role: %w", err)
+	}
+
+	return domain.ListRole(role), nil
+}
+
+// FindListMembers lists the principals a list is shared with, oldest first.
+func (s *Store) FindListMembers(ctx context.Context, listID string) ([]*domain.ListMember, error) {
+	if _, err := uuid.Parse(listID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	dbMembers, err := s.queries.ListListMembers(ctx, listID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members: %w", err)
+	}
+
+	members := make([]*domain.ListMember, 0, len(dbMembers))
+	for _, dbMember := range dbMembers {
+		members = append(members, dbListMemberToDomain(dbMember))
+	}
+	return members, nil
+}
+
+// CreateListMember shares a list with a principal.
+func (s *Store) CreateListMember(ctx context.Context, member *domain.ListMember) (*domain.ListMember, error) {
+	if _, err := uuid.Parse(member.ListID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(member.PrincipalID); err != nil {
+		return nil, fmt.Errorf("%w: principal %w", domain.ErrInvalidID, err)
+	}
+
+	dbMember, err := s.queries.CreateListMember(ctx, sqlcgen.CreateListMemberParams{
+		ListID:      member.ListID,
+		PrincipalID: member.PrincipalID,
+		Role:        string(member.Role),
+		CreatedAt:   member.CreatedAt,
+		UpdatedAt:   member.UpdatedAt,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, domain.ErrMemberAlreadyExists
+		}
+		if isForeignKeyViolation(err, "list_id") {
+			return nil, fmt.Errorf("%w: %s", domain.ErrListNotFound, member.ListID)
+		}
+		return nil, fmt.Errorf("failed to create list member: %w", err)
+	}
+
+	return dbListMemberToDomain(dbMember), nil
+}
+
+// UpdateListMember changes the role of a member.
+func (s *Store) UpdateListMember(ctx context.Context, member *domain.ListMember) (*domain.ListMember, error) {
+	if _, err := uuid.Parse(member.ListID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(member.PrincipalID); err != nil {
+		return nil, fmt.Errorf("%w: principal %w", domain.ErrInvalidID, err)
+	}
+
+	dbMember, err := s.queries.UpdateListMemberRole(ctx, sqlcgen.UpdateListMemberRoleParams{
+		ListID:      member.ListID,
+		PrincipalID: member.PrincipalID,
+		Role:        string(member.Role),
+		UpdatedAt:   member.UpdatedAt,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrMemberNotFound, member.PrincipalID)
+		}
+		return nil, fmt.Errorf("failed to update list member: %w", err)
+	}
+
+	return dbListMemberToDomain(dbMember), nil
+}
+
+// DeleteListMember revokes the access of a member.
+func (s *Store) DeleteListMember(ctx context.Context, listID, principalID string) error {
+	if _, err := uuid.Parse(listID); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(principalID); err != nil {
+		return fmt.Errorf("%w: principal %w", domain.ErrInvalidID, err)
+	}
+
+	rowsAffected, err := s.queries.DeleteListMember(ctx, sqlcgen.DeleteListMemberParams{
+		ListID:      listID,
+		PrincipalID: principalID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete list member: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrMemberNotFound, principalID)
+	}
+
+	return nil
+}
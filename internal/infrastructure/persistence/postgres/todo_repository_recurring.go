@@ -107,6 +107,13 @@ func domainTodoItemToInsertParams(item *domain.TodoItem) (sqlcgen.InsertItemIgno
 		params.DueOffset = durationToInterval(*item.DueOffset)
 	}
 
+	// Custom fields (copied from template by the generator)
+	customFields, err := customFieldsToJSON(item.CustomFields)
+	if err != nil {
+		return params, err
+	}
+	params.CustomFields = customFields
+
 	return params, nil
 }
 
@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// FindItemReminders lists the reminders of an item, earliest fire time first.
+func (s *Store) FindItemReminders(ctx context.Context, itemID string) ([]*domain.Reminder, error) {
+	if _, err := uuid.Parse(itemID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	dbReminders, err := s.queries.ListItemReminders(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reminders: %w", err)
+	}
+
+	reminders := make([]*domain.Reminder, 0, len(dbReminders))
+	for _, dbReminder := range dbReminders {
+		reminders = append(reminders, dbReminderToDomain(dbReminder))
+	}
+	return reminders, nil
+}
+
+// CreateItemReminder adds a reminder to an item.
+// The fire time is resolved from the item row by the database.
+func (s *Store) CreateItemReminder(ctx context.Context, reminder *domain.Reminder) (*domain.Reminder, error) {
+	if _, err := uuid.Parse(reminder.ID); err != nil {
+		return nil, fmt.Errorf("%w: reminder %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(reminder.ItemID); err != nil {
+		return nil, fmt.Errorf("%w: item %w", domain.ErrInvalidID, err)
+	}
+
+	params := sqlcgen.CreateItemReminderParams{
+		ID:        reminder.ID,
+		ItemID:    reminder.ItemID,
+		RemindAt:  ptrToNullTime(reminder.Rule.RemindAt),
+		CreatedAt: reminder.CreatedAt,
+	}
+	if reminder.Rule.IsRelative() {
+		params.Anchor = sql.Null[string]{V: string(reminder.Rule.Anchor), Valid: true}
+		params.OffsetBefore = durationToInterval(reminder.Rule.Before)
+	}
+
+	dbReminder, err := s.queries.CreateItemReminder(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrItemNotFound, reminder.ItemID)
+		}
+		return nil, fmt.Errorf("failed to create reminder: %w", err)
+	}
+
+	return dbReminderToDomain(dbReminder), nil
+}
+
+// DeleteItemReminder removes a reminder from an item.
+func (s *Store) DeleteItemReminder(ctx context.Context, itemID, reminderID string) error {
+	if _, err := uuid.Parse(itemID); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(reminderID); err != nil {
+		return fmt.Errorf("%w: reminder %w", domain.ErrInvalidID, err)
+	}
+
+	rows, err := s.queries.DeleteItemReminder(ctx, sqlcgen.DeleteItemReminderParams{
+		ID:     reminderID,
+		ItemID: itemID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrReminderNotFound, reminderID)
+	}
+	return nil
+}
+
+// FindDeadLetterReminders lists unresolved dead letter reminders, newest first.
+func (s *Store) FindDeadLetterReminders(ctx context.Context, limit int) ([]*domain.DeadLetterReminder, error) {
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListDeadLetterReminders(ctx, sqlcgen.ListDeadLetterRemindersParams{
+		OwnerID:   ownerID,
+		PageLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dead letter reminders: %w", err)
+	}
+
+	reminders := make([]*domain.DeadLetterReminder, 0, len(rows))
+	for _, row := range rows {
+		reminders = append(reminders, dbDeadLetterReminderToDomain(sqlcgen.DeadLetterReminder{
+			ID:           row.ID,
+			ReminderID:   row.ReminderID,
+			ItemID:       row.ItemID,
+			ErrorType:    row.ErrorType,
+			ErrorMessage: row.ErrorMessage,
+			RetryCount:   row.RetryCount,
+			LastWorkerID: row.LastWorkerID,
+			FailedAt:     row.FailedAt,
+		}, row.ListID))
+	}
+	return reminders, nil
+}
+
+// ResolveDeadLetterReminder marks an unresolved dead letter reminder as retried or discarded.
+func (s *Store) ResolveDeadLetterReminder(ctx context.Context, id, resolution string, note *string) (*domain.DeadLetterReminder, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := sqlcgen.ResolveDeadLetterReminderParams{
+		Resolution: resolution,
+		ID:         id,
+		OwnerID:    ownerID,
+	}
+	if note != nil {
+		params.ReviewerNote = sql.Null[string]{V: *note, Valid: true}
+	}
+
+	row, err := s.queries.ResolveDeadLetterReminder(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrDeadLetterReminderNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to resolve dead letter reminder: %w", err)
+	}
+
+	return dbDeadLetterReminderToDomain(sqlcgen.DeadLetterReminder{
+		ID:           row.ID,
+		ReminderID:   row.ReminderID,
+		ItemID:       row.ItemID,
+		ErrorType:    row.ErrorType,
+		ErrorMessage: row.ErrorMessage,
+		RetryCount:   row.RetryCount,
+		LastWorkerID: row.LastWorkerID,
+		FailedAt:     row.FailedAt,
+	}, row.ListID), nil
+}
+
+// RearmDeadReminder releases a dead reminder for immediate delivery.
+// A reminder its item already rescheduled is not dead anymore and is left as is.
+func (s *Store) RearmDeadReminder(ctx context.Context, reminderID string) error {
+	if _, err := uuid.Parse(reminderID); err != nil {
+		return fmt.Errorf("%w: reminder %w", domain.ErrInvalidID, err)
+	}
+
+	if err := s.queries.RearmDeadReminder(ctx, reminderID); err != nil {
+		return fmt.Errorf("failed to re-arm reminder: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// === Saved View Operations ===
+//
+// Views are scoped to the tenant in the context like lists: views of other
+// tenants are reported as not found.
+
+// CreateSavedView stores a new saved view.
+func (s *Store) CreateSavedView(ctx context.Context, view *domain.SavedView) (*domain.SavedView, error) {
+	if _, err := uuid.Parse(view.ID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	// Views created by internal callers have no owner (NULL)
+	var owner *string
+	if view.OwnerID != "" {
+		owner = &view.OwnerID
+	}
+	ownerID, err := stringPtrToNullUUID(owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert saved view owner: %w", err)
+	}
+	listID, err := stringPtrToNullUUID(view.ListID)
+	if err != nil {
+		return nil, err
+	}
+	definition, err := savedViewDefinitionToJSON(view)
+	if err != nil {
+		return nil, err
+	}
+
+	dbView, err := s.queries.CreateSavedView(ctx, sqlcgen.CreateSavedViewParams{
+		ID:         view.ID,
+		OwnerID:    ownerID,
+		Name:       view.Name,
+		ListID:     listID,
+		Definition: definition,
+		CreatedAt:  view.CreatedAt,
+	})
+	if err != nil {
+		if isForeignKeyViolation(err, "list_id") {
+			return nil, fmt.Errorf("%w: %s", domain.ErrListNotFound, *view.ListID)
+		}
+		return nil, fmt.Errorf("failed to create saved view: %w", err)
+	}
+
+	return dbSavedViewToDomain(dbView)
+}
+
+// FindSavedViewByID retrieves a saved view.
+// Returns domain.ErrSavedViewNotFound if it doesn't exist.
+func (s *Store) FindSavedViewByID(ctx context.Context, id string) (*domain.SavedView, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbView, err := s.queries.GetSavedView(ctx, sqlcgen.GetSavedViewParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrSavedViewNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get saved view: %w", err)
+	}
+
+	return dbSavedViewToDomain(dbView)
+}
+
+// FindSavedViews lists saved views, oldest first.
+func (s *Store) FindSavedViews(ctx context.Context) ([]*domain.SavedView, error) {
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbViews, err := s.queries.ListSavedViews(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved views: %w", err)
+	}
+
+	views := make([]*domain.SavedView, 0, len(dbViews))
+	for _, dbView := range dbViews {
+		view, err := dbSavedViewToDomain(dbView)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, view)
+	}
+	return views, nil
+}
+
+// UpdateSavedView replaces the name, list and definition of a saved view.
+// Returns domain.ErrSavedViewNotFound if it doesn't exist.
+func (s *Store) UpdateSavedView(ctx context.Context, view *domain.SavedView) (*domain.SavedView, error) {
+	if _, err := uuid.Parse(view.ID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	listID, err := stringPtrToNullUUID(view.ListID)
+	if err != nil {
+		return nil, err
+	}
+	definition, err := savedViewDefinitionToJSON(view)
+	if err != nil {
+		return nil, err
+	}
+
+	dbView, err := s.queries.UpdateSavedView(ctx, sqlcgen.UpdateSavedViewParams{
+		ID:         view.ID,
+		OwnerID:    ownerID,
+		Name:       view.Name,
+		ListID:     listID,
+		Definition: definition,
+		UpdatedAt:  view.UpdatedAt,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrSavedViewNotFound, view.ID)
+		}
+		if isForeignKeyViolation(err, "list_id") {
+			return nil, fmt.Errorf("%w: %s", domain.ErrListNotFound, *view.ListID)
+		}
+		return nil, fmt.Errorf("failed to update saved view: %w", err)
+	}
+
+	return dbSavedViewToDomain(dbView)
+}
+
+// DeleteSavedView removes a saved view.
+// Returns domain.ErrSavedViewNotFound if it doesn't exist.
+func (s *Store) DeleteSavedView(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return err
+	}
+
+	rows, err := s.queries.DeleteSavedView(ctx, sqlcgen.DeleteSavedViewParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete saved view: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrSavedViewNotFound, id)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// === Snapshot Operations ===
+
+// FindListSnapshots returns the recorded snapshots of a list from first through last, oldest first.
+func (s *Store) FindListSnapshots(ctx context.Context, listID string, first, last time.Time) ([]domain.ListSnapshot, error) {
+	listUUID, err := uuid.Parse(listID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.FindListSnapshots(ctx, sqlcgen.FindListSnapshotsParams{
+		ListID:   listUUID.String(),
+		FirstDay: timeToDate(first),
+		LastDay:  timeToDate(last),
+		OwnerID:  ownerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find list snapshots: %w", err)
+	}
+
+	snapshots := make([]domain.ListSnapshot, 0, len(rows))
+	for _, row := range rows {
+		snapshot, err := dbListSnapshotToDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+func dbListSnapshotToDomain(row sqlcgen.ListSnapshot) (domain.ListSnapshot, error) {
+	snapshot := domain.ListSnapshot{
+		ListID:     row.ListID,
+		Day:        domain.SnapshotDay(row.Day),
+		Overdue:    int(row.OverdueCount),
+		RecordedAt: row.RecordedAt.UTC(),
+	}
+	if err := json.Unmarshal(row.StatusCounts, &snapshot.StatusCounts); err != nil {
+		return domain.ListSnapshot{}, fmt.Errorf("failed to decode snapshot status counts: %w", err)
+	}
+	if err := json.Unmarshal(row.PriorityCounts, &snapshot.PriorityCounts); err != nil {
+		return domain.ListSnapshot{}, fmt.Errorf("failed to decode snapshot priority counts: %w", err)
+	}
+	return snapshot, nil
+}
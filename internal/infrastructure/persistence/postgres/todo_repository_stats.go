@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// === Stats Operations ===
+
+// FindStatsItems returns the items of a list that can count towards stats of
+// the query range, oldest first, at most limit.
+func (s *Store) FindStatsItems(ctx context.Context, query domain.StatsQuery, limit int) ([]domain.TodoItem, error) {
+	listUUID, err := uuid.Parse(query.ListID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.FindStatsItems(ctx, sqlcgen.FindStatsItemsParams{
+		ListID:        listUUID.String(),
+		CreatedBefore: timeToTimestamptz(query.To),
+		UpdatedSince:  query.From,
+		OwnerID:       ownerID,
+		RowLimit:      int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find stats items: %w", err)
+	}
+
+	items := make([]domain.TodoItem, 0, len(rows))
+	for _, row := range rows {
+		item, err := dbTodoItemToDomain(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert item: %w", err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
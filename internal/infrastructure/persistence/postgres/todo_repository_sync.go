@@ -0,0 +1,214 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+	"github.com/rezkam/mono/internal/ptr"
+)
+
+// === Sync Operations ===
+
+// syncEntity identifies an entity changed within a sync page.
+type syncEntity struct {
+	entityType domain.ChangeEntityType
+	id         string
+}
+
+// FindSyncPage returns the lists, items and templates changed after the cursor.
+// Only changes of transactions older than the oldest running one are read, so
+// a change committing late can never land behind a cursor already returned.
+// When the page is not full, the cursor moves up to that watermark.
+//
+// Entities the caller can no longer access are reported as deleted: items and
+// templates moved to a list it cannot access, and lists it lost access to.
+func (s *Store) FindSyncPage(ctx context.Context, after domain.SyncCursor, limit int) (*domain.SyncPage, error) {
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read before the changes: every transaction older than the watermark is
+	// finished, so the next statement sees all of their changes
+	watermark, err := s.queries.GetChangeWatermark(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sync watermark: %w", err)
+	}
+
+	rows, err := s.queries.ListChangesForSync(ctx, sqlcgen.ListChangesForSyncParams{
+		Watermark: watermark,
+		AfterTxid: after.TxID,
+		AfterSeq:  after.Seq,
+		OwnerID:   ownerID,
+		PageLimit: int32(limit + 1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find changes for sync: %w", err)
+	}
+
+	page := &domain.SyncPage{Cursor: after}
+	if len(rows) > limit {
+		page.HasMore = true
+		rows = rows[:limit]
+	}
+	if page.HasMore {
+		last := rows[len(rows)-1]
+		page.Cursor = domain.SyncCursor{TxID: last.Txid, Seq: last.Seq}
+	} else if next := (domain.SyncCursor{TxID: watermark}); next.After(after) {
+		page.Cursor = next
+	}
+
+	// Each entity is reported once, in its current state. A tombstone names the
+	// list of the entity's last change, or the list it was last moved out of
+	var entities []syncEntity
+	listIDs := make(map[syncEntity]string)
+	ids := make(map[domain.ChangeEntityType][]pgtype.UUID)
+	for _, row := range rows {
+		entity := syncEntity{
+			entityType: domain.ChangeEntityType(row.EntityType),
+			id:         row.EntityID,
+		}
+		listID := row.ListID
+		if row.PreviousListID.Valid {
+			listID = row.PreviousListID
+		}
+		_, seen := listIDs[entity]
+		listIDs[entity] = ptr.ToString(uuidToStringPtr(listID))
+		if seen {
+			continue
+		}
+		entities = append(entities, entity)
+
+		entityUUID, err := uuid.Parse(row.EntityID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid entity ID in change %d: %w", row.Seq, err)
+		}
+		ids[entity.entityType] = append(ids[entity.entityType], pgtype.UUID{Bytes: entityUUID, Valid: true})
+	}
+
+	lists, err := s.findSyncLists(ctx, ids[domain.ChangeEntityList], ownerID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.findSyncItems(ctx, ids[domain.ChangeEntityItem], ownerID)
+	if err != nil {
+		return nil, err
+	}
+	templates, err := s.findSyncTemplates(ctx, ids[domain.ChangeEntityTemplate], ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entity := range entities {
+		var found bool
+		switch entity.entityType {
+		case domain.ChangeEntityList:
+			var list *domain.TodoList
+			if list, found = lists[entity.id]; found {
+				page.Lists = append(page.Lists, list)
+			}
+		case domain.ChangeEntityItem:
+			var item *domain.TodoItem
+			if item, found = items[entity.id]; found {
+				page.Items = append(page.Items, item)
+			}
+		case domain.ChangeEntityTemplate:
+			var template *domain.RecurringTemplate
+			if template, found = templates[entity.id]; found {
+				page.Templates = append(page.Templates, template)
+			}
+		}
+		if !found {
+			page.Deleted = append(page.Deleted, domain.SyncTombstone{
+				EntityType: entity.entityType,
+				EntityID:   entity.id,
+				ListID:     listIDs[entity],
+			})
+		}
+	}
+
+	return page, nil
+}
+
+// findSyncLists loads the lists that still exist and the owner can access, keyed by ID.
+func (s *Store) findSyncLists(ctx context.Context, ids []pgtype.UUID, ownerID pgtype.UUID) (map[string]*domain.TodoList, error) {
+	lists := make(map[string]*domain.TodoList, len(ids))
+	if len(ids) == 0 {
+		return lists, nil
+	}
+
+	rows, err := s.queries.ListTodoListsWithCountsByIDs(ctx, sqlcgen.ListTodoListsWithCountsByIDsParams{
+		UndoneStatuses: taskStatusesToStrings(domain.UndoneStatuses()),
+		Ids:            ids,
+		OwnerID:        ownerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load lists for sync: %w", err)
+	}
+
+	for _, row := range rows {
+		schema, err := customFieldSchemaFromJSON(row.CustomFieldSchema)
+		if err != nil {
+			return nil, err
+		}
+		lists[row.ID] = &domain.TodoList{
+			ID:                row.ID,
+			Title:             row.Title,
+			CreatedAt:         timestamptzToTime(row.CreatedAt),
+			OwnerID:           ptr.ToString(nullUUIDToStringPtr(row.OwnerID)),
+			CustomFieldSchema: schema,
+			TotalItems:        int(row.TotalItems),
+			UndoneItems:       int(row.UndoneItems),
+			Version:           int(row.Version),
+		}
+	}
+	return lists, nil
+}
+
+// findSyncItems loads the items that still exist and the owner can access, keyed by ID.
+func (s *Store) findSyncItems(ctx context.Context, ids []pgtype.UUID, ownerID pgtype.UUID) (map[string]*domain.TodoItem, error) {
+	items := make(map[string]*domain.TodoItem, len(ids))
+	if len(ids) == 0 {
+		return items, nil
+	}
+
+	rows, err := s.queries.GetTodoItemsByIDs(ctx, sqlcgen.GetTodoItemsByIDsParams{Ids: ids, OwnerID: ownerID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load items for sync: %w", err)
+	}
+
+	for _, row := range rows {
+		item, err := dbTodoItemToDomain(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert item: %w", err)
+		}
+		items[item.ID] = &item
+	}
+	return items, nil
+}
+
+// findSyncTemplates loads the templates that still exist and the owner can access, keyed by ID.
+func (s *Store) findSyncTemplates(ctx context.Context, ids []pgtype.UUID, ownerID pgtype.UUID) (map[string]*domain.RecurringTemplate, error) {
+	templates := make(map[string]*domain.RecurringTemplate, len(ids))
+	if len(ids) == 0 {
+		return templates, nil
+	}
+
+	rows, err := s.queries.FindRecurringTemplatesByIDs(ctx, sqlcgen.FindRecurringTemplatesByIDsParams{Ids: ids, OwnerID: ownerID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load templates for sync: %w", err)
+	}
+
+	for _, row := range rows {
+		template, err := dbRecurringTemplateToDomain(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert template: %w", err)
+		}
+		templates[template.ID] = template
+	}
+	return templates, nil
+}
@@ -0,0 +1,207 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// === Tag Operations ===
+//
+// Registered tags are not scoped to a tenant: the service checks access to their list.
+
+// FindTagUsage lists the tags registered on a list, by name, with how many
+// of its items and recurring templates use each.
+func (s *Store) FindTagUsage(ctx context.Context, listID string) ([]*domain.TagUsage, error) {
+	if _, err := uuid.Parse(listID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	dbTags, err := s.queries.ListListTags(ctx, listID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	counts, err := s.queries.CountListTagUsage(ctx, listID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tag usage: %w", err)
+	}
+
+	// A tag registered between the two queries has no count yet and is listed as unused
+	usage := make(map[string]sqlcgen.CountListTagUsageRow, len(counts))
+	for _, count := range counts {
+		usage[count.Tag] = count
+	}
+
+	tags := make([]*domain.TagUsage, len(dbTags))
+	for i, dbTag := range dbTags {
+		tags[i] = &domain.TagUsage{
+			Tag:           *dbTagToDomain(dbTag),
+			ItemCount:     int(usage[dbTag.Name].ItemCount),
+			TemplateCount: int(usage[dbTag.Name].TemplateCount),
+		}
+	}
+	return tags, nil
+}
+
+// FindTag retrieves a registered tag of a list.
+// Returns domain.ErrTagNotFound if the tag isn't registered.
+func (s *Store) FindTag(ctx context.Context, listID, name string) (*domain.Tag, error) {
+	if _, err := uuid.Parse(listID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	dbTag, err := s.queries.GetListTag(ctx, sqlcgen.GetListTagParams{
+		ListID: listID,
+		Name:   name,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrTagNotFound, name)
+		}
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	return dbTagToDomain(dbTag), nil
+}
+
+// CreateTag registers a tag on a list.
+// Returns domain.ErrTagAlreadyExists if it is registered already,
+// and domain.ErrListNotFound if the list doesn't exist.
+func (s *Store) CreateTag(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
+	if _, err := uuid.Parse(tag.ListID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	dbTag, err := s.queries.CreateListTag(ctx, sqlcgen.CreateListTagParams{
+		ListID:      tag.ListID,
+		Name:        tag.Name,
+		Color:       ptrToNullString(tag.Color),
+		Description: ptrToNullString(tag.Description),
+		CreatedAt:   tag.CreatedAt,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrTagAlreadyExists, tag.Name)
+		}
+		if isForeignKeyViolation(err, "list_id") {
+			return nil, fmt.Errorf("%w: %s", domain.ErrListNotFound, tag.ListID)
+		}
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	return dbTagToDomain(dbTag), nil
+}
+
+// UpdateTag replaces the color and description of a registered tag.
+// Returns domain.ErrTagNotFound if the tag isn't registered.
+func (s *Store) UpdateTag(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
+	if _, err := uuid.Parse(tag.ListID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	dbTag, err := s.queries.UpdateListTag(ctx, sqlcgen.UpdateListTagParams{
+		ListID:      tag.ListID,
+		Name:        tag.Name,
+		Color:       ptrToNullString(tag.Color),
+		Description: ptrToNullString(tag.Description),
+		UpdatedAt:   tag.UpdatedAt,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrTagNotFound, tag.Name)
+		}
+		return nil, fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	return dbTagToDomain(dbTag), nil
+}
+
+// RenameTagRegistration moves the registration of a tag to a new name.
+// Returns false if the tag isn't registered.
+func (s *Store) RenameTagRegistration(ctx context.Context, listID, name, newName string, updatedAt time.Time) (bool, error) {
+	if _, err := uuid.Parse(listID); err != nil {
+		return false, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	rows, err := s.queries.RenameListTag(ctx, sqlcgen.RenameListTagParams{
+		ListID:    listID,
+		Name:      name,
+		NewName:   newName,
+		UpdatedAt: updatedAt,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return false, fmt.Errorf("%w: %s", domain.ErrTagAlreadyExists, newName)
+		}
+		return false, fmt.Errorf("failed to rename tag: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// DeleteTags removes the registrations of tags of a list. Items and templates
+// keep using the tags. Returns the number of registrations removed.
+func (s *Store) DeleteTags(ctx context.Context, listID string, names []string) (int, error) {
+	if _, err := uuid.Parse(listID); err != nil {
+		return 0, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	rows, err := s.queries.DeleteListTags(ctx, sqlcgen.DeleteListTagsParams{
+		ListID: listID,
+		Names:  names,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete tags: %w", err)
+	}
+	return int(rows), nil
+}
+
+// TagInUse reports whether a tag is registered on a list or used by its
+// items or recurring templates.
+func (s *Store) TagInUse(ctx context.Context, listID, name string) (bool, error) {
+	if _, err := uuid.Parse(listID); err != nil {
+		return false, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	inUse, err := s.queries.ListTagInUse(ctx, sqlcgen.ListTagInUseParams{
+		ListID: listID,
+		Tag:    name,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check tag usage: %w", err)
+	}
+	return inUse, nil
+}
+
+// ReplaceTags replaces the source tags of every item and recurring template
+// of a list with the target tag, dropping the duplicates this creates.
+// The change log records every rewritten item and template as updated.
+func (s *Store) ReplaceTags(ctx context.Context, listID string, sources []string, target string) (domain.TagRewrite, error) {
+	if _, err := uuid.Parse(listID); err != nil {
+		return domain.TagRewrite{}, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	items, err := s.queries.ReplaceItemTags(ctx, sqlcgen.ReplaceItemTagsParams{
+		ListID:  listID,
+		Sources: sources,
+		Target:  target,
+	})
+	if err != nil {
+		return domain.TagRewrite{}, fmt.Errorf("failed to replace item tags: %w", err)
+	}
+	templates, err := s.queries.ReplaceTemplateTags(ctx, sqlcgen.ReplaceTemplateTagsParams{
+		ListID:  listID,
+		Sources: sources,
+		Target:  target,
+	})
+	if err != nil {
+		return domain.TagRewrite{}, fmt.Errorf("failed to replace template tags: %w", err)
+	}
+
+	return domain.TagRewrite{Tag: target, Items: int(items), Templates: int(templates)}, nil
+}
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// FindTimeEntries lists the time entries of an item, earliest start first.
+func (s *Store) FindTimeEntries(ctx context.Context, itemID string) ([]*domain.TimeEntry, error) {
+	if _, err := uuid.Parse(itemID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	dbEntries, err := s.queries.ListTimeEntries(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list time entries: %w", err)
+	}
+
+	entries := make([]*domain.TimeEntry, 0, len(dbEntries))
+	for _, dbEntry := range dbEntries {
+		entries = append(entries, dbTimeEntryToDomain(dbEntry))
+	}
+	return entries, nil
+}
+
+// FindRunningTimeEntry returns the running timer of a principal.
+func (s *Store) FindRunningTimeEntry(ctx context.Context, principalID string) (*domain.TimeEntry, error) {
+	if _, err := uuid.Parse(principalID); err != nil {
+		return nil, fmt.Errorf("%w: principal %w", domain.ErrInvalidID, err)
+	}
+
+	dbEntry, err := s.queries.FindRunningTimeEntry(ctx, principalID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNoRunningTimer
+		}
+		return nil, fmt.Errorf("failed to find running timer: %w", err)
+	}
+	return dbTimeEntryToDomain(dbEntry), nil
+}
+
+// CreateTimeEntry adds a time entry to an item.
+// The list is copied from the item row by the database.
+func (s *Store) CreateTimeEntry(ctx context.Context, entry *domain.TimeEntry) (*domain.TimeEntry, error) {
+	if _, err := uuid.Parse(entry.ID); err != nil {
+		return nil, fmt.Errorf("%w: time entry %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(entry.ItemID); err != nil {
+		return nil, fmt.Errorf("%w: item %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(entry.PrincipalID); err != nil {
+		return nil, fmt.Errorf("%w: principal %w", domain.ErrInvalidID, err)
+	}
+
+	dbEntry, err := s.queries.CreateTimeEntry(ctx, sqlcgen.CreateTimeEntryParams{
+		ID:          entry.ID,
+		ItemID:      entry.ItemID,
+		PrincipalID: entry.PrincipalID,
+		StartedAt:   entry.StartedAt,
+		EndedAt:     ptrToNullTime(entry.EndedAt),
+		Notes:       entry.Notes,
+		CreatedAt:   entry.CreatedAt,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrItemNotFound, entry.ItemID)
+		}
+		if isUniqueViolation(err) {
+			return nil, domain.ErrTimerAlreadyRunning
+		}
+		return nil, fmt.Errorf("failed to create time entry: %w", err)
+	}
+
+	return dbTimeEntryToDomain(dbEntry), nil
+}
+
+// StopRunningTimeEntry ends the running timer of a principal.
+// An empty itemID stops the timer on any item.
+func (s *Store) StopRunningTimeEntry(ctx context.Context, principalID, itemID string, endedAt time.Time, notes string) (*domain.TimeEntry, error) {
+	if _, err := uuid.Parse(principalID); err != nil {
+		return nil, fmt.Errorf("%w: principal %w", domain.ErrInvalidID, err)
+	}
+	var itemParam pgtype.UUID
+	if itemID != "" {
+		itemUUID, err := uuid.Parse(itemID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: item %w", domain.ErrInvalidID, err)
+		}
+		itemParam = uuidToQueryParam(itemUUID)
+	}
+
+	dbEntry, err := s.queries.StopRunningTimeEntry(ctx, sqlcgen.StopRunningTimeEntryParams{
+		EndedAt:     timeToTimestamptz(endedAt),
+		Notes:       notes,
+		PrincipalID: principalID,
+		ItemID:      itemParam,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNoRunningTimer
+		}
+		return nil, fmt.Errorf("failed to stop timer: %w", err)
+	}
+	return dbTimeEntryToDomain(dbEntry), nil
+}
+
+// DeleteTimeEntry removes a time entry from an item.
+// A non-empty principalID restricts the deletion to entries of that principal.
+func (s *Store) DeleteTimeEntry(ctx context.Context, itemID, entryID, principalID string) error {
+	if _, err := uuid.Parse(itemID); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(entryID); err != nil {
+		return fmt.Errorf("%w: time entry %w", domain.ErrInvalidID, err)
+	}
+	var principalParam pgtype.UUID
+	if principalID != "" {
+		principalUUID, err := uuid.Parse(principalID)
+		if err != nil {
+			return fmt.Errorf("%w: principal %w", domain.ErrInvalidID, err)
+		}
+		principalParam = uuidToQueryParam(principalUUID)
+	}
+
+	rows, err := s.queries.DeleteTimeEntry(ctx, sqlcgen.DeleteTimeEntryParams{
+		ID:          entryID,
+		ItemID:      itemID,
+		PrincipalID: principalParam,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete time entry: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrTimeEntryNotFound, entryID)
+	}
+	return nil
+}
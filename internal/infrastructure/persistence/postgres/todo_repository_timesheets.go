@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// === Timesheet Operations ===
+
+// FindTimesheetPeriods returns the periods of time tracked on items of the
+// caller's lists that overlap [start, end), by item and start, at most limit.
+func (s *Store) FindTimesheetPeriods(ctx context.Context, start, end time.Time, limit int) ([]domain.TimesheetPeriod, error) {
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.FindTimesheetPeriods(ctx, sqlcgen.FindTimesheetPeriodsParams{
+		OwnerID:     ownerID,
+		PeriodStart: timeToTimestamptz(start),
+		PeriodEnd:   timeToTimestamptz(end),
+		RowLimit:    int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find timesheet periods: %w", err)
+	}
+
+	periods := make([]domain.TimesheetPeriod, 0, len(rows))
+	for _, row := range rows {
+		periods = append(periods, domain.TimesheetPeriod{
+			ItemID:          row.ItemID,
+			ListID:          row.ListID,
+			ListTitle:       row.ListTitle,
+			Title:           row.Title,
+			Tags:            row.Tags,
+			Source:          domain.TimesheetSource(row.Source),
+			Start:           row.StartedAt.UTC(),
+			End:             nullTimeToPtr(row.EndedAt),
+			ActualDuration:  pgtypeIntervalToDurationPtr(row.ActualDuration),
+			TrackedDuration: intervalToDuration(row.TrackedDuration),
+		})
+	}
+	return periods, nil
+}
@@ -0,0 +1,205 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres/sqlcgen"
+)
+
+// === Webhook Subscription Operations ===
+//
+// Subscriptions are scoped to the tenant in the context like saved views:
+// subscriptions of other tenants are reported as not found.
+
+// CreateWebhookSubscription stores a new webhook subscription.
+func (s *Store) CreateWebhookSubscription(ctx context.Context, sub *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	if _, err := uuid.Parse(sub.ID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	// Subscriptions created by internal callers have no owner (NULL)
+	var owner *string
+	if sub.OwnerID != "" {
+		owner = &sub.OwnerID
+	}
+	ownerID, err := stringPtrToNullUUID(owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert webhook subscription owner: %w", err)
+	}
+
+	dbSub, err := s.queries.CreateWebhookSubscription(ctx, sqlcgen.CreateWebhookSubscriptionParams{
+		ID:        sub.ID,
+		OwnerID:   ownerID,
+		Url:       sub.URL,
+		Secret:    sub.Secret,
+		Events:    eventTypesToStrings(sub.Events),
+		IsActive:  sub.IsActive,
+		CreatedAt: sub.CreatedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	return dbWebhookSubscriptionToDomain(dbSub), nil
+}
+
+// FindWebhookSubscriptionByID retrieves a webhook subscription.
+// Returns domain.ErrWebhookNotFound if it doesn't exist.
+func (s *Store) FindWebhookSubscriptionByID(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbSub, err := s.queries.GetWebhookSubscription(ctx, sqlcgen.GetWebhookSubscriptionParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrWebhookNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get webhook subscription: %w", err)
+	}
+
+	return dbWebhookSubscriptionToDomain(dbSub), nil
+}
+
+// FindWebhookSubscriptions lists webhook subscriptions, oldest first.
+func (s *Store) FindWebhookSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbSubs, err := s.queries.ListWebhookSubscriptions(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+
+	subs := make([]*domain.WebhookSubscription, 0, len(dbSubs))
+	for _, dbSub := range dbSubs {
+		subs = append(subs, dbWebhookSubscriptionToDomain(dbSub))
+	}
+	return subs, nil
+}
+
+// UpdateWebhookSubscription replaces the mutable fields of a subscription.
+// Returns domain.ErrWebhookNotFound if it doesn't exist.
+func (s *Store) UpdateWebhookSubscription(ctx context.Context, sub *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	if _, err := uuid.Parse(sub.ID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbSub, err := s.queries.UpdateWebhookSubscription(ctx, sqlcgen.UpdateWebhookSubscriptionParams{
+		Url:      sub.URL,
+		Secret:   sub.Secret,
+		Events:   eventTypesToStrings(sub.Events),
+		IsActive: sub.IsActive,
+		ID:       sub.ID,
+		OwnerID:  ownerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrWebhookNotFound, sub.ID)
+		}
+		return nil, fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+
+	return dbWebhookSubscriptionToDomain(dbSub), nil
+}
+
+// DeleteWebhookSubscription removes a subscription and its deliveries.
+func (s *Store) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	ownerID, err := ownerQueryParam(ctx)
+	if err != nil {
+		return err
+	}
+
+	rows, err := s.queries.DeleteWebhookSubscription(ctx, sqlcgen.DeleteWebhookSubscriptionParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrWebhookNotFound, id)
+	}
+	return nil
+}
+
+// FindWebhookDeliveries lists the most recent deliveries of a subscription.
+func (s *Store) FindWebhookDeliveries(ctx context.Context, subscriptionID string, status *domain.WebhookDeliveryStatus, limit int) ([]*domain.WebhookDelivery, error) {
+	if _, err := uuid.Parse(subscriptionID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+
+	params := sqlcgen.ListWebhookDeliveriesParams{
+		SubscriptionID: subscriptionID,
+		PageLimit:      int32(limit),
+	}
+	if status != nil {
+		params.Status = sql.Null[string]{V: string(*status), Valid: true}
+	}
+
+	dbDeliveries, err := s.queries.ListWebhookDeliveries(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	deliveries := make([]*domain.WebhookDelivery, 0, len(dbDeliveries))
+	for _, dbDelivery := range dbDeliveries {
+		deliveries = append(deliveries, dbWebhookDeliveryToDomain(dbDelivery))
+	}
+	return deliveries, nil
+}
+
+// RedeliverWebhookDelivery queues a new delivery of the same event.
+// Returns domain.ErrWebhookDeliveryNotFound if the delivery is not part of the subscription.
+func (s *Store) RedeliverWebhookDelivery(ctx context.Context, subscriptionID, deliveryID string) (*domain.WebhookDelivery, error) {
+	if _, err := uuid.Parse(subscriptionID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidID, err)
+	}
+	if _, err := uuid.Parse(deliveryID); err != nil {
+		return nil, fmt.Errorf("%w: delivery %w", domain.ErrInvalidID, err)
+	}
+
+	newID, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate delivery id: %w", err)
+	}
+
+	dbDelivery, err := s.queries.RedeliverWebhookDelivery(ctx, sqlcgen.RedeliverWebhookDeliveryParams{
+		NewID:          newID.String(),
+		CreatedAt:      time.Now().UTC(),
+		ID:             deliveryID,
+		SubscriptionID: subscriptionID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrWebhookDeliveryNotFound, deliveryID)
+		}
+		return nil, fmt.Errorf("failed to redeliver webhook delivery: %w", err)
+	}
+
+	return dbWebhookDeliveryToDomain(dbDelivery), nil
+}
@@ -0,0 +1,272 @@
+package taskwarrior
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// projectTagPrefix marks the tag that holds the project of a task.
+const projectTagPrefix = "project:"
+
+// Decode reads the tasks of a Taskwarrior export as import entries, in file
+// order, keyed by their UUID.
+//
+// Pending and waiting tasks become todo items, or in progress ones if they are
+// started; completed tasks become done items and deleted tasks cancelled ones.
+// Tasks are due at due and start on the day of wait; their times are fixed in
+// UTC. The project becomes the tag "project:<name>" next to the other tags,
+// and annotations become the notes of the entry.
+//
+// Recurring parents become recurring templates starting at their due date,
+// with their deleted instances as deleted occurrences. The instances of a
+// parent in the same export are not imported on their own: the template
+// generates them. Parents with an until date, or a recur period without a
+// matching pattern, are returned with the reason as Problem.
+// Returns domain.ErrInvalidImport if data is not a JSON array of tasks.
+func Decode(data []byte) ([]domain.ImportEntry, error) {
+	var tasks []Task
+	if err := json.Unmarshal(data, &tasks); err != nil {
+		return nil, fmt.Errorf("%w: not a Taskwarrior export: %v", domain.ErrInvalidImport, err)
+	}
+	if len(tasks) > domain.MaxImportEntries {
+		return nil, fmt.Errorf("%w: more than %d tasks", domain.ErrInvalidImport, domain.MaxImportEntries)
+	}
+
+	// Deleted instances of recurring parents are exceptions of their template
+	parents := make(map[string]bool)
+	for _, task := range tasks {
+		if task.Status == statusRecurring {
+			parents[task.UUID] = true
+		}
+	}
+	deleted := make(map[string][]time.Time)
+	for _, task := range tasks {
+		if parents[task.Parent] && task.Status == statusDeleted && task.Due != nil {
+			deleted[task.Parent] = append(deleted[task.Parent], task.Due.Time)
+		}
+	}
+
+	entries := make([]domain.ImportEntry, len(tasks))
+	for i, task := range tasks {
+		switch {
+		case parents[task.Parent]:
+			entries[i] = domain.ImportEntry{
+				ExternalID: task.UUID,
+				Item:       &domain.TodoItem{Title: task.Description},
+				Problem:    fmt.Sprintf("instance of recurring task %s, which generates it", task.Parent),
+			}
+		case task.Status == statusRecurring:
+			entries[i] = decodeRecurring(task, deleted[task.UUID])
+		default:
+			entries[i] = decodeTask(task)
+		}
+	}
+	return entries, nil
+}
+
+// decodeTask maps a task to an item.
+func decodeTask(task Task) domain.ImportEntry {
+	item := &domain.TodoItem{
+		Title:    task.Description,
+		Priority: decodePriority(task.Priority),
+		Tags:     decodeTags(task),
+	}
+	entry := domain.ImportEntry{ExternalID: task.UUID, Item: item, Notes: decodeAnnotations(task)}
+
+	switch task.Status {
+	case statusPending, statusWaiting, "":
+		item.Status = domain.TaskStatusTodo
+		if task.Start != nil {
+			item.Status = domain.TaskStatusInProgress
+		}
+	case statusCompleted:
+		item.Status = domain.TaskStatusDone
+	case statusDeleted:
+		item.Status = domain.TaskStatusCancelled
+	default:
+		entry.Problem = fmt.Sprintf("unknown status %q", task.Status)
+		return entry
+	}
+
+	if task.Due != nil || task.Wait != nil {
+		utc := time.UTC.String()
+		item.Timezone = &utc
+	}
+	if task.Due != nil {
+		due := task.Due.Time
+		item.DueAt = &due
+	}
+	if task.Wait != nil {
+		startsAt := startOfDay(task.Wait.Time)
+		item.StartsAt = &startsAt
+	}
+	return entry
+}
+
+// decodeRecurring maps a recurring parent to a recurring template. Templates
+// have no timezone: the UTC times of the parent are kept as their wall clock.
+func decodeRecurring(task Task, deleted []time.Time) domain.ImportEntry {
+	template := &domain.RecurringTemplate{
+		Title:    task.Description,
+		Priority: decodePriority(task.Priority),
+		Tags:     decodeTags(task),
+	}
+	entry := domain.ImportEntry{ExternalID: task.UUID, Template: template, Notes: decodeAnnotations(task)}
+
+	if task.Until != nil {
+		entry.Problem = "recurring tasks that end (until) are not supported"
+		return entry
+	}
+	if task.Due == nil {
+		entry.Problem = "recurring task without a due date"
+		return entry
+	}
+	pattern, config, err := decodeRecur(task.Recur)
+	if err != nil {
+		entry.Problem = err.Error()
+		return entry
+	}
+
+	due := task.Due.Time
+	dueOffset := due.Sub(startOfDay(due))
+	template.RecurrencePattern = pattern
+	template.RecurrenceConfig = config
+	template.DueOffset = &dueOffset
+	entry.TemplateStart = due
+	// Instances are due at the time of their occurrence
+	entry.Exceptions = deleted
+	return entry
+}
+
+// recurPeriods maps the named recur periods to recurrence patterns.
+var recurPeriods = map[string]domain.RecurrencePattern{
+	"daily":     domain.RecurrenceDaily,
+	"day":       domain.RecurrenceDaily,
+	"weekdays":  domain.RecurrenceWeekdays,
+	"weekly":    domain.RecurrenceWeekly,
+	"week":      domain.RecurrenceWeekly,
+	"sennight":  domain.RecurrenceWeekly,
+	"biweekly":  domain.RecurrenceBiweekly,
+	"fortnight": domain.RecurrenceBiweekly,
+	"monthly":   domain.RecurrenceMonthly,
+	"month":     domain.RecurrenceMonthly,
+	"quarterly": domain.RecurrenceQuarterly,
+	"yearly":    domain.RecurrenceYearly,
+	"annual":    domain.RecurrenceYearly,
+	"year":      domain.RecurrenceYearly,
+}
+
+// recurUnits maps the units of numbered recur periods, like 2w or 3mo, to a
+// pattern and the number of its periods one unit is.
+var recurUnits = map[string]struct {
+	pattern domain.RecurrencePattern
+	periods int
+}{
+	"d":        {domain.RecurrenceDaily, 1},
+	"day":      {domain.RecurrenceDaily, 1},
+	"days":     {domain.RecurrenceDaily, 1},
+	"w":        {domain.RecurrenceWeekly, 1},
+	"wk":       {domain.RecurrenceWeekly, 1},
+	"wks":      {domain.RecurrenceWeekly, 1},
+	"week":     {domain.RecurrenceWeekly, 1},
+	"weeks":    {domain.RecurrenceWeekly, 1},
+	"mo":       {domain.RecurrenceMonthly, 1},
+	"mos":      {domain.RecurrenceMonthly, 1},
+	"month":    {domain.RecurrenceMonthly, 1},
+	"months":   {domain.RecurrenceMonthly, 1},
+	"q":        {domain.RecurrenceMonthly, 3},
+	"qtr":      {domain.RecurrenceMonthly, 3},
+	"qtrs":     {domain.RecurrenceMonthly, 3},
+	"quarter":  {domain.RecurrenceMonthly, 3},
+	"quarters": {domain.RecurrenceMonthly, 3},
+	"y":        {domain.RecurrenceYearly, 1},
+	"yr":       {domain.RecurrenceYearly, 1},
+	"yrs":      {domain.RecurrenceYearly, 1},
+	"year":     {domain.RecurrenceYearly, 1},
+	"years":    {domain.RecurrenceYearly, 1},
+}
+
+// decodeRecur maps a recur period, named (weekly) or numbered (2w), to a
+// recurrence pattern and its config.
+func decodeRecur(recur string) (domain.RecurrencePattern, map[string]any, error) {
+	period := strings.ToLower(strings.TrimSpace(recur))
+	if pattern, ok := recurPeriods[period]; ok {
+		return pattern, nil, nil
+	}
+
+	digits := strings.IndexFunc(period, func(r rune) bool { return r < '0' || r > '9' })
+	if digits <= 0 {
+		return "", nil, fmt.Errorf("recur period %q has no matching recurrence pattern", recur)
+	}
+	n, err := strconv.Atoi(period[:digits])
+	unit, ok := recurUnits[strings.TrimSpace(period[digits:])]
+	if err != nil || n < 1 || !ok {
+		return "", nil, fmt.Errorf("recur period %q has no matching recurrence pattern", recur)
+	}
+
+	interval := n * unit.periods
+	switch {
+	case unit.pattern == domain.RecurrenceWeekly && interval == 2:
+		return domain.RecurrenceBiweekly, nil, nil
+	case unit.pattern == domain.RecurrenceMonthly && interval == 3:
+		return domain.RecurrenceQuarterly, nil, nil
+	case unit.pattern == domain.RecurrenceYearly && interval > 1:
+		return "", nil, fmt.Errorf("recur period %q has no matching recurrence pattern", recur)
+	case interval == 1:
+		return unit.pattern, nil, nil
+	default:
+		return unit.pattern, map[string]any{"interval": float64(interval)}, nil // As read back from JSON
+	}
+}
+
+// decodePriority maps the H, M and L priorities.
+func decodePriority(priority string) *domain.TaskPriority {
+	var p domain.TaskPriority
+	switch strings.ToUpper(priority) {
+	case "H":
+		p = domain.TaskPriorityHigh
+	case "M":
+		p = domain.TaskPriorityMedium
+	case "L":
+		p = domain.TaskPriorityLow
+	default:
+		return nil
+	}
+	return &p
+}
+
+// decodeTags returns the tags of a task with its project as first tag.
+func decodeTags(task Task) []string {
+	var tags []string
+	if task.Project != "" {
+		tags = append(tags, projectTagPrefix+task.Project)
+	}
+	for _, tag := range task.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// decodeAnnotations returns the annotations of a task, one per line.
+func decodeAnnotations(task Task) string {
+	lines := make([]string, 0, len(task.Annotations))
+	for _, annotation := range task.Annotations {
+		if description := strings.TrimSpace(annotation.Description); description != "" {
+			lines = append(lines, description)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// startOfDay returns the date of t as midnight UTC.
+func startOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,118 @@
+package taskwarrior
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testExport = `[
+{"id":1,"uuid":"a1","description":"Call Anna","status":"pending","entry":"20260301T090000Z",
+ "due":"20260311T100000Z","wait":"20260309T060000Z","priority":"H","project":"garden","tags":["phone"],
+ "annotations":[{"entry":"20260302T090000Z","description":"ask about seeds"},{"entry":"20260303T090000Z","description":"and the hose"}],
+ "urgency":8.2},
+{"uuid":"a2","description":"Fix fence","status":"pending","start":"20260305T080000Z"},
+{"uuid":"a3","description":"Water plants","status":"completed","end":"20260306T080000Z","priority":"L"},
+{"uuid":"a4","description":"Old idea","status":"deleted"},
+{"uuid":"r1","description":"Standup","status":"recurring","recur":"weekly","due":"20260302T091500Z","mask":"--"},
+{"uuid":"r1-1","description":"Standup","status":"completed","parent":"r1","due":"20260302T091500Z"},
+{"uuid":"r1-2","description":"Standup","status":"deleted","parent":"r1","due":"20260309T091500Z"},
+{"uuid":"r2","description":"Report","status":"recurring","recur":"2mo","due":"20260331T000000Z"},
+{"uuid":"r3","description":"Taxes","status":"recurring","recur":"yearly","due":"20260430T120000Z","until":"20290430T120000Z"},
+{"uuid":"r4","description":"Often","status":"recurring","recur":"PT1H","due":"20260430T120000Z"},
+{"uuid":"o1","description":"Orphan instance","status":"pending","parent":"gone","recur":"daily"}
+]`
+
+func TestDecode(t *testing.T) {
+	entries, err := Decode([]byte(testExport))
+	require.NoError(t, err)
+	require.Len(t, entries, 11)
+
+	due := time.Date(2026, 3, 11, 10, 0, 0, 0, time.UTC)
+	wait := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	high := domain.TaskPriorityHigh
+	utc := "UTC"
+	assert.Equal(t, domain.ImportEntry{
+		ExternalID: "a1",
+		Item: &domain.TodoItem{
+			Title:    "Call Anna",
+			Status:   domain.TaskStatusTodo,
+			Priority: &high,
+			Tags:     []string{"project:garden", "phone"},
+			DueAt:    &due,
+			StartsAt: &wait,
+			Timezone: &utc,
+		},
+		Notes: "ask about seeds\nand the hose",
+	}, entries[0])
+
+	assert.Equal(t, domain.TaskStatusInProgress, entries[1].Item.Status)
+	assert.Equal(t, domain.TaskStatusDone, entries[2].Item.Status)
+	assert.Equal(t, domain.TaskStatusCancelled, entries[3].Item.Status)
+
+	standup := entries[4]
+	require.NotNil(t, standup.Template)
+	assert.Empty(t, standup.Problem)
+	assert.Equal(t, domain.RecurrenceWeekly, standup.Template.RecurrencePattern)
+	assert.Equal(t, time.Date(2026, 3, 2, 9, 15, 0, 0, time.UTC), standup.TemplateStart)
+	require.NotNil(t, standup.Template.DueOffset)
+	assert.Equal(t, 9*time.Hour+15*time.Minute, *standup.Template.DueOffset)
+	// The deleted instance is a deleted occurrence
+	assert.Equal(t, []time.Time{time.Date(2026, 3, 9, 9, 15, 0, 0, time.UTC)}, standup.Exceptions)
+
+	// Instances are generated by their template
+	assert.Equal(t, "instance of recurring task r1, which generates it", entries[5].Problem)
+	assert.NotEmpty(t, entries[6].Problem)
+
+	assert.Equal(t, domain.RecurrenceMonthly, entries[7].Template.RecurrencePattern)
+	assert.Equal(t, map[string]any{"interval": float64(2)}, entries[7].Template.RecurrenceConfig)
+	assert.Equal(t, "recurring tasks that end (until) are not supported", entries[8].Problem)
+	assert.Equal(t, `recur period "PT1H" has no matching recurrence pattern`, entries[9].Problem)
+
+	// Instances of a parent that is not exported are plain items
+	assert.Empty(t, entries[10].Problem)
+	assert.Equal(t, "Orphan instance", entries[10].Item.Title)
+}
+
+func TestDecodeRecur(t *testing.T) {
+	tests := []struct {
+		recur   string
+		pattern domain.RecurrencePattern
+		config  map[string]any
+	}{
+		{"daily", domain.RecurrenceDaily, nil},
+		{"3d", domain.RecurrenceDaily, map[string]any{"interval": float64(3)}},
+		{"weekdays", domain.RecurrenceWeekdays, nil},
+		{"fortnight", domain.RecurrenceBiweekly, nil},
+		{"2w", domain.RecurrenceBiweekly, nil},
+		{"3 weeks", domain.RecurrenceWeekly, map[string]any{"interval": float64(3)}},
+		{"1mo", domain.RecurrenceMonthly, nil},
+		{"quarterly", domain.RecurrenceQuarterly, nil},
+		{"1q", domain.RecurrenceQuarterly, nil},
+		{"2q", domain.RecurrenceMonthly, map[string]any{"interval": float64(6)}},
+		{"annual", domain.RecurrenceYearly, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.recur, func(t *testing.T) {
+			pattern, config, err := decodeRecur(tt.recur)
+			require.NoError(t, err)
+			assert.Equal(t, tt.pattern, pattern)
+			assert.Equal(t, tt.config, config)
+		})
+	}
+
+	for _, recur := range []string{"", "2y", "0d", "5min", "hourly"} {
+		_, _, err := decodeRecur(recur)
+		assert.Error(t, err, recur)
+	}
+}
+
+func TestDecode_RejectsInvalidExport(t *testing.T) {
+	for _, data := range []string{"not json", `{"uuid":"a1"}`, `[{"uuid":"a1","due":"tomorrow"}]`} {
+		_, err := Decode([]byte(data))
+		assert.ErrorIs(t, err, domain.ErrInvalidImport, data)
+	}
+}
@@ -0,0 +1,147 @@
+package taskwarrior
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/recurring"
+)
+
+// EncodeItem returns an item as a task.
+//
+// Todo and blocked items are pending tasks, in progress items pending tasks
+// started at their last update. Done and archived items are completed tasks,
+// cancelled items deleted ones, ended at their last update. Items start
+// waiting on the day they start. Urgent items have high priority. The first
+// "project:<name>" tag becomes the project. Notes kept in the
+// domain.ImportNotesField custom field become annotations, one per line.
+func EncodeItem(item domain.TodoItem) Task {
+	task := Task{
+		UUID:        item.ID,
+		Description: item.Title,
+		Status:      statusPending,
+		Entry:       NewDate(item.CreatedAt),
+		Modified:    NewDate(item.UpdatedAt),
+		Priority:    encodePriority(item.Priority),
+		Annotations: encodeNotes(item.CustomFields, item.CreatedAt),
+	}
+	task.Project, task.Tags = encodeTags(item.Tags)
+
+	switch item.Status {
+	case domain.TaskStatusInProgress:
+		task.Start = NewDate(item.UpdatedAt)
+	case domain.TaskStatusDone, domain.TaskStatusArchived:
+		task.Status = statusCompleted
+		task.End = NewDate(item.UpdatedAt)
+	case domain.TaskStatusCancelled:
+		task.Status = statusDeleted
+		task.End = NewDate(item.UpdatedAt)
+	}
+	if item.DueAt != nil {
+		task.Due = NewDate(*item.DueAt)
+	}
+	if item.StartsAt != nil {
+		task.Wait = NewDate(*item.StartsAt)
+	}
+	return task
+}
+
+// EncodeTemplate returns an active recurring template as a recurring parent
+// task, due at the first occurrence after after. Pass the last occurrence
+// exported as an item, so Taskwarrior continues the series where the export
+// ends instead of generating the exported occurrences again; the exported
+// items are plain tasks, not instances of the parent.
+//
+// Returns false for inactive templates and templates without a next occurrence.
+func EncodeTemplate(template domain.RecurringTemplate, after time.Time) (Task, bool) {
+	calculator := recurring.GetCalculator(template.RecurrencePattern)
+	if !template.IsActive || calculator == nil {
+		return Task{}, false
+	}
+	next := calculator.NextOccurrence(after, template.RecurrenceConfig)
+	if next == nil {
+		return Task{}, false
+	}
+	due := *next
+	if template.DueOffset != nil {
+		due = startOfDay(due).Add(*template.DueOffset)
+	}
+
+	task := Task{
+		UUID:        template.ID,
+		Description: template.Title,
+		Status:      statusRecurring,
+		Entry:       NewDate(template.CreatedAt),
+		Modified:    NewDate(template.UpdatedAt),
+		Due:         NewDate(due),
+		Recur:       encodeRecur(template.RecurrencePattern, template.RecurrenceConfig),
+		Priority:    encodePriority(template.Priority),
+		Annotations: encodeNotes(template.CustomFields, template.CreatedAt),
+	}
+	task.Project, task.Tags = encodeTags(template.Tags)
+	return task, true
+}
+
+// encodeRecur returns the recur period of a recurrence pattern, numbered (2w)
+// if it repeats every few periods.
+func encodeRecur(pattern domain.RecurrencePattern, config map[string]any) string {
+	interval := 1
+	if v, ok := config["interval"].(float64); ok && v > 1 {
+		interval = int(v)
+	}
+	switch {
+	case interval > 1 && pattern == domain.RecurrenceDaily:
+		return fmt.Sprintf("%dd", interval)
+	case interval > 1 && pattern == domain.RecurrenceWeekly:
+		return fmt.Sprintf("%dw", interval)
+	case interval > 1 && pattern == domain.RecurrenceMonthly:
+		return fmt.Sprintf("%dmo", interval)
+	default:
+		return string(pattern)
+	}
+}
+
+// encodePriority maps priorities to H, M and L; urgent is H.
+func encodePriority(priority *domain.TaskPriority) string {
+	if priority == nil {
+		return ""
+	}
+	switch *priority {
+	case domain.TaskPriorityUrgent, domain.TaskPriorityHigh:
+		return "H"
+	case domain.TaskPriorityMedium:
+		return "M"
+	case domain.TaskPriorityLow:
+		return "L"
+	default:
+		return ""
+	}
+}
+
+// encodeTags splits tags into the project and the other tags. Taskwarrior tags
+// are single words: whitespace becomes underscores.
+func encodeTags(tags []string) (project string, taskTags []string) {
+	for _, tag := range tags {
+		if name, ok := strings.CutPrefix(tag, projectTagPrefix); ok && project == "" {
+			project = name
+			continue
+		}
+		taskTags = append(taskTags, strings.Join(strings.Fields(tag), "_"))
+	}
+	return project, taskTags
+}
+
+// encodeNotes returns the lines of the notes custom field as annotations
+// entered at entry.
+func encodeNotes(customFields map[string]any, entry time.Time) []Annotation {
+	notes, _ := customFields[domain.ImportNotesField].(string)
+	var annotations []Annotation
+	for line := range strings.SplitSeq(notes, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			annotations = append(annotations, Annotation{Entry: *NewDate(entry), Description: line})
+		}
+	}
+	return annotations
+}
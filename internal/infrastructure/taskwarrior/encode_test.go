@@ -0,0 +1,123 @@
+package taskwarrior
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeItem(t *testing.T) {
+	created := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	updated := time.Date(2026, 3, 5, 8, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 3, 11, 10, 0, 0, 0, time.UTC)
+	urgent := domain.TaskPriorityUrgent
+
+	data, err := json.Marshal(EncodeItem(domain.TodoItem{
+		ID: "0190d3c8-6b4a-7000-8000-000000000001", Title: "Call Anna", Status: domain.TaskStatusInProgress,
+		Priority: &urgent, Tags: []string{"phone", "project:garden", "project:house", "summer house"},
+		DueAt: &due, CreatedAt: created, UpdatedAt: updated,
+		CustomFields: map[string]any{domain.ImportNotesField: "ask about seeds\n\nand the hose"},
+	}))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"uuid": "0190d3c8-6b4a-7000-8000-000000000001",
+		"description": "Call Anna",
+		"status": "pending",
+		"entry": "20260301T090000Z",
+		"modified": "20260305T080000Z",
+		"start": "20260305T080000Z",
+		"due": "20260311T100000Z",
+		"priority": "H",
+		"project": "garden",
+		"tags": ["phone", "project:house", "summer_house"],
+		"annotations": [
+			{"entry": "20260301T090000Z", "description": "ask about seeds"},
+			{"entry": "20260301T090000Z", "description": "and the hose"}
+		]
+	}`, string(data))
+}
+
+func TestEncodeTemplate(t *testing.T) {
+	created := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	dueOffset := 9*time.Hour + 15*time.Minute
+	template := domain.RecurringTemplate{
+		ID: "0190d3c8-6b4a-7000-8000-000000000002", Title: "Standup",
+		RecurrencePattern: domain.RecurrenceWeekly, RecurrenceConfig: map[string]any{"interval": float64(3)},
+		DueOffset: &dueOffset, IsActive: true, CreatedAt: created, UpdatedAt: created,
+	}
+
+	// Continues after the last exported occurrence
+	task, ok := EncodeTemplate(template, time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, "recurring", task.Status)
+	assert.Equal(t, "3w", task.Recur)
+	assert.Equal(t, time.Date(2026, 3, 23, 9, 15, 0, 0, time.UTC), task.Due.Time)
+
+	template.IsActive = false
+	_, ok = EncodeTemplate(template, created)
+	assert.False(t, ok)
+}
+
+func TestRoundTrip(t *testing.T) {
+	created := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 3, 11, 10, 0, 0, 0, time.UTC)
+	startsAt := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	high := domain.TaskPriorityHigh
+	low := domain.TaskPriorityLow
+	utc := "UTC"
+
+	items := []domain.TodoItem{
+		{
+			ID: "a1", Title: "Call Anna", Status: domain.TaskStatusTodo, Priority: &high,
+			Tags: []string{"project:garden", "phone"}, DueAt: &due, StartsAt: &startsAt, Timezone: &utc,
+		},
+		{ID: "a2", Title: "Fix fence", Status: domain.TaskStatusInProgress, Priority: &low},
+		{ID: "a3", Title: "Water plants", Status: domain.TaskStatusDone},
+		{ID: "a4", Title: "Old idea", Status: domain.TaskStatusCancelled},
+	}
+	tasks := make([]Task, len(items))
+	for i := range items {
+		items[i].CreatedAt, items[i].UpdatedAt = created, created
+		tasks[i] = EncodeItem(items[i])
+	}
+
+	dueOffset := 9*time.Hour + 15*time.Minute
+	template := domain.RecurringTemplate{
+		ID: "r1", Title: "Standup", Tags: []string{"project:work"}, Priority: &high,
+		RecurrencePattern: domain.RecurrenceBiweekly, DueOffset: &dueOffset, IsActive: true,
+		CustomFields: map[string]any{domain.ImportNotesField: "bring coffee"},
+	}
+	parent, ok := EncodeTemplate(template, time.Date(2026, 3, 2, 9, 15, 0, 0, time.UTC))
+	require.True(t, ok)
+	tasks = append(tasks, parent)
+
+	data, err := json.Marshal(tasks)
+	require.NoError(t, err)
+	entries, err := Decode(data)
+	require.NoError(t, err)
+	require.Len(t, entries, len(items)+1)
+
+	for i, entry := range entries[:len(items)] {
+		require.Empty(t, entry.Problem)
+		assert.Equal(t, items[i].ID, entry.ExternalID)
+		want := items[i]
+		want.ID, want.CreatedAt, want.UpdatedAt = "", time.Time{}, time.Time{}
+		assert.Equal(t, want, *entry.Item)
+	}
+
+	templateEntry := entries[len(items)]
+	require.Empty(t, templateEntry.Problem)
+	require.NotNil(t, templateEntry.Template)
+	assert.Equal(t, "r1", templateEntry.ExternalID)
+	assert.Equal(t, "bring coffee", templateEntry.Notes)
+	assert.Equal(t, time.Date(2026, 3, 16, 9, 15, 0, 0, time.UTC), templateEntry.TemplateStart)
+	assert.Equal(t, domain.RecurringTemplate{
+		Title: "Standup", Tags: []string{"project:work"}, Priority: &high,
+		RecurrencePattern: domain.RecurrenceBiweekly, DueOffset: &dueOffset,
+	}, *templateEntry.Template)
+}
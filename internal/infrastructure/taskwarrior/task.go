@@ -0,0 +1,80 @@
+// Package taskwarrior reads and writes todo items as Taskwarrior JSON, the
+// format of `task export` and `task import`
+// (https://taskwarrior.org/docs/design/task/).
+package taskwarrior
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ContentType is the media type of Taskwarrior exports.
+const ContentType = "application/json"
+
+// Task statuses.
+const (
+	statusPending   = "pending"
+	statusWaiting   = "waiting" // Pending until the wait date; written by older versions
+	statusCompleted = "completed"
+	statusDeleted   = "deleted"
+	statusRecurring = "recurring" // The parent of recurring tasks, like a recurring template
+)
+
+// Task is a task in Taskwarrior's JSON format. Other attributes, like user
+// defined attributes and dependencies, are ignored.
+type Task struct {
+	UUID        string       `json:"uuid"`
+	Description string       `json:"description"`
+	Status      string       `json:"status"`
+	Entry       *Date        `json:"entry,omitempty"`
+	Modified    *Date        `json:"modified,omitempty"`
+	Start       *Date        `json:"start,omitempty"` // Set while the task is being worked on
+	End         *Date        `json:"end,omitempty"`
+	Due         *Date        `json:"due,omitempty"`
+	Wait        *Date        `json:"wait,omitempty"`
+	Until       *Date        `json:"until,omitempty"`
+	Recur       string       `json:"recur,omitempty"`
+	Parent      string       `json:"parent,omitempty"` // UUID of the recurring parent of an instance
+	Priority    string       `json:"priority,omitempty"`
+	Project     string       `json:"project,omitempty"`
+	Tags        []string     `json:"tags,omitempty"`
+	Annotations []Annotation `json:"annotations,omitempty"`
+}
+
+// Annotation is a note added to a task.
+type Annotation struct {
+	Entry       Date   `json:"entry"`
+	Description string `json:"description"`
+}
+
+// Date is a Taskwarrior date: UTC in ISO 8601 basic format, e.g. 20260311T100000Z.
+// RFC 3339 times are read too.
+type Date struct {
+	time.Time
+}
+
+const dateLayout = "20060102T150405Z"
+
+// NewDate returns t as a Date.
+func NewDate(t time.Time) *Date {
+	return &Date{Time: t.UTC()}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + d.UTC().Format(dateLayout) + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		if t, err = time.Parse(time.RFC3339, s); err != nil {
+			return fmt.Errorf("invalid date %q (expected e.g. 20260311T100000Z)", s)
+		}
+	}
+	d.Time = t.UTC()
+	return nil
+}
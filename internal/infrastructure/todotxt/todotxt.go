@@ -0,0 +1,264 @@
+// Package todotxt reads and writes todo items in the todo.txt format
+// (https://github.com/todotxt/todo.txt): one item per line, like
+//
+//	x 2026-03-12 2026-03-01 Call Anna +garden @phone due:2026-03-15 pri:A
+//	(B) 2026-03-02 Water plants +garden t:2026-03-10
+//
+// +project becomes the tag "project:<name>" and @context the tag "<name>", so
+// both survive a round trip. Of the key:value extensions, due: (due date),
+// t: (threshold date, when the item starts), pri: (priority of completed items)
+// and status: (statuses todo.txt has no marker for) are read; other pairs are
+// kept in the title.
+package todotxt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rezkam/mono/internal/domain"
+)
+
+// ContentType is the media type of todo.txt data.
+const ContentType = "text/plain; charset=utf-8"
+
+const dateLayout = "2006-01-02"
+
+// Decode reads the lines of a todo.txt file as item import entries, in file
+// order, skipping blank lines.
+//
+// Lines have no IDs: an entry is keyed by a hash of its line, so importing a
+// file again skips unchanged lines. A line that changed since, e.g. was
+// completed, is imported as a new item. Creation and completion dates are not
+// read; imported items are created when they are imported.
+// Returns domain.ErrInvalidImport if data is not UTF-8 text.
+func Decode(data []byte) ([]domain.ImportEntry, error) {
+	if !utf8.Valid(data) {
+		return nil, fmt.Errorf("%w: not a todo.txt file: must be UTF-8 text", domain.ErrInvalidImport)
+	}
+
+	var entries []domain.ImportEntry
+	for line := range strings.SplitSeq(strings.TrimPrefix(string(data), "\uFEFF"), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if len(entries) == domain.MaxImportEntries {
+			return nil, fmt.Errorf("%w: more than %d lines", domain.ErrInvalidImport, domain.MaxImportEntries)
+		}
+		entries = append(entries, decodeLine(line))
+	}
+	return entries, nil
+}
+
+// decodeLine maps a line to an item.
+func decodeLine(line string) domain.ImportEntry {
+	sum := sha256.Sum256([]byte(line))
+	item := &domain.TodoItem{Status: domain.TaskStatusTodo}
+	entry := domain.ImportEntry{ExternalID: hex.EncodeToString(sum[:]), Item: item}
+
+	words := strings.Fields(line)
+	if words[0] == "x" {
+		item.Status = domain.TaskStatusDone
+		words = words[1:]
+		// Completion date, then creation date
+		for range 2 {
+			if len(words) > 0 && isDate(words[0]) {
+				words = words[1:]
+			}
+		}
+	} else {
+		if priority, ok := decodePriorityMarker(words[0]); ok {
+			item.Priority = &priority
+			words = words[1:]
+		}
+		if len(words) > 0 && isDate(words[0]) {
+			words = words[1:]
+		}
+	}
+
+	var title []string
+	for _, word := range words {
+		if name, ok := strings.CutPrefix(word, "+"); ok && name != "" {
+			item.Tags = appendTag(item.Tags, projectTagPrefix+name)
+			continue
+		}
+		if name, ok := strings.CutPrefix(word, "@"); ok && name != "" {
+			item.Tags = appendTag(item.Tags, name)
+			continue
+		}
+
+		key, value, ok := strings.Cut(word, ":")
+		if !ok || value == "" {
+			title = append(title, word)
+			continue
+		}
+		switch key {
+		case "due":
+			t, err := time.Parse(dateLayout, value)
+			if err != nil {
+				entry.Problem = fmt.Sprintf("invalid due date %q (expected YYYY-MM-DD)", value)
+				continue
+			}
+			item.DueAt = &t
+		case "t":
+			t, err := time.Parse(dateLayout, value)
+			if err != nil {
+				entry.Problem = fmt.Sprintf("invalid threshold date %q (expected YYYY-MM-DD)", value)
+				continue
+			}
+			item.StartsAt = &t
+		case "pri":
+			priority, ok := decodePriority(value)
+			if !ok {
+				title = append(title, word)
+				continue
+			}
+			if item.Priority == nil {
+				item.Priority = &priority
+			}
+		case "status":
+			status, err := domain.NewTaskStatus(value)
+			if err != nil {
+				entry.Problem = fmt.Sprintf("unknown status %q", value)
+				continue
+			}
+			item.Status = status
+		default:
+			title = append(title, word)
+		}
+	}
+	item.Title = strings.Join(title, " ")
+	return entry
+}
+
+// projectTagPrefix marks the tags of todo.txt projects.
+const projectTagPrefix = "project:"
+
+// appendTag adds a tag unless the item has it already.
+func appendTag(tags []string, tag string) []string {
+	if slices.Contains(tags, tag) {
+		return tags
+	}
+	return append(tags, tag)
+}
+
+// isDate reports whether s is a YYYY-MM-DD date.
+func isDate(s string) bool {
+	_, err := time.Parse(dateLayout, s)
+	return err == nil
+}
+
+// decodePriorityMarker reads the (A) to (Z) priority that starts an open item.
+func decodePriorityMarker(word string) (domain.TaskPriority, bool) {
+	if len(word) != 3 || word[0] != '(' || word[2] != ')' {
+		return "", false
+	}
+	return decodePriority(word[1:2])
+}
+
+// decodePriority maps the priority letters A to Z: A is urgent, B high,
+// C medium and D and below low.
+func decodePriority(letter string) (domain.TaskPriority, bool) {
+	if len(letter) != 1 || letter[0] < 'A' || letter[0] > 'Z' {
+		return "", false
+	}
+	switch letter[0] {
+	case 'A':
+		return domain.TaskPriorityUrgent, true
+	case 'B':
+		return domain.TaskPriorityHigh, true
+	case 'C':
+		return domain.TaskPriorityMedium, true
+	default:
+		return domain.TaskPriorityLow, true
+	}
+}
+
+// priorityLetters maps priorities back to the letters decodePriority reads.
+var priorityLetters = map[domain.TaskPriority]string{
+	domain.TaskPriorityUrgent: "A",
+	domain.TaskPriorityHigh:   "B",
+	domain.TaskPriorityMedium: "C",
+	domain.TaskPriorityLow:    "D",
+}
+
+// Encode returns items as todo.txt lines, one per item.
+//
+// Done, archived and cancelled items are written as completed, with their last
+// update as completion date and their priority as pri:. Statuses other than
+// todo and done are kept as status:. Dates are written in the item's timezone;
+// times of day, durations and custom fields are left out. Whitespace in tags
+// becomes underscores.
+func Encode(items []domain.TodoItem) []byte {
+	var buf bytes.Buffer
+	for _, item := range items {
+		buf.WriteString(encodeItem(item))
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// encodeItem returns the line of an item, without line ending.
+func encodeItem(item domain.TodoItem) string {
+	var loc *time.Location
+	if item.Timezone != nil {
+		loc, _ = time.LoadLocation(*item.Timezone)
+	}
+	date := func(t time.Time) string {
+		if loc != nil {
+			t = t.In(loc)
+		}
+		return t.Format(dateLayout)
+	}
+
+	var words []string
+	completed := item.Status == domain.TaskStatusDone ||
+		item.Status == domain.TaskStatusArchived ||
+		item.Status == domain.TaskStatusCancelled
+	letter := ""
+	if item.Priority != nil {
+		letter = priorityLetters[*item.Priority]
+	}
+	if completed {
+		words = append(words, "x", date(item.UpdatedAt))
+	} else if letter != "" {
+		words = append(words, "("+letter+")")
+	}
+	if !item.CreatedAt.IsZero() {
+		words = append(words, date(item.CreatedAt))
+	}
+
+	words = append(words, strings.Fields(item.Title)...)
+	for _, tag := range item.Tags {
+		if name, ok := strings.CutPrefix(tag, projectTagPrefix); ok {
+			words = append(words, "+"+tagWord(name))
+		} else {
+			words = append(words, "@"+tagWord(tag))
+		}
+	}
+	if item.DueAt != nil {
+		words = append(words, "due:"+date(*item.DueAt))
+	}
+	if item.StartsAt != nil {
+		// StartsAt is a date, kept as midnight UTC
+		words = append(words, "t:"+item.StartsAt.UTC().Format(dateLayout))
+	}
+	if completed && letter != "" {
+		words = append(words, "pri:"+letter)
+	}
+	if item.Status != domain.TaskStatusTodo && item.Status != domain.TaskStatusDone {
+		words = append(words, "status:"+string(item.Status))
+	}
+	return strings.Join(words, " ")
+}
+
+// tagWord joins the words of a tag with underscores.
+func tagWord(tag string) string {
+	return strings.Join(strings.Fields(tag), "_")
+}
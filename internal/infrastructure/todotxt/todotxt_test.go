@@ -0,0 +1,135 @@
+package todotxt
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func priority(p domain.TaskPriority) *domain.TaskPriority {
+	return &p
+}
+
+func date(year int, month time.Month, day int) *time.Time {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func TestDecode(t *testing.T) {
+	entries, err := Decode([]byte("\uFEFF(A) 2026-03-01 Call Anna +garden @phone due:2026-03-15\r\n" +
+		"\n" +
+		"x 2026-03-12 2026-03-01 Water plants +garden pri:B\n" +
+		"Read https://example.com/post t:2026-03-10 status:blocked\n" +
+		"(Q) Someday\n" +
+		"Broken due:tomorrow\n"))
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+
+	items := make([]domain.TodoItem, len(entries))
+	for i, entry := range entries {
+		require.NotNil(t, entry.Item)
+		assert.Len(t, entry.ExternalID, 64)
+		items[i] = *entry.Item
+	}
+
+	assert.Equal(t, domain.TodoItem{
+		Title:    "Call Anna",
+		Status:   domain.TaskStatusTodo,
+		Priority: priority(domain.TaskPriorityUrgent),
+		Tags:     []string{"project:garden", "phone"},
+		DueAt:    date(2026, 3, 15),
+	}, items[0])
+	assert.Equal(t, domain.TodoItem{
+		Title:    "Water plants",
+		Status:   domain.TaskStatusDone,
+		Priority: priority(domain.TaskPriorityHigh),
+		Tags:     []string{"project:garden"},
+	}, items[1])
+	// Other key:value pairs, like URLs, stay in the title
+	assert.Equal(t, domain.TodoItem{
+		Title:    "Read https://example.com/post",
+		Status:   domain.TaskStatusBlocked,
+		StartsAt: date(2026, 3, 10),
+	}, items[2])
+	assert.Equal(t, priority(domain.TaskPriorityLow), items[3].Priority)
+
+	assert.Empty(t, entries[0].Problem)
+	assert.Equal(t, `invalid due date "tomorrow" (expected YYYY-MM-DD)`, entries[4].Problem)
+}
+
+func TestDecode_SameLineSameExternalID(t *testing.T) {
+	first, err := Decode([]byte("Call Anna +garden\n"))
+	require.NoError(t, err)
+	second, err := Decode([]byte("Water plants\nCall Anna +garden\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, first[0].ExternalID, second[1].ExternalID)
+	assert.NotEqual(t, first[0].ExternalID, second[0].ExternalID)
+}
+
+func TestDecode_RejectsBinary(t *testing.T) {
+	_, err := Decode([]byte{0xff, 0xfe, 0x00})
+	assert.ErrorIs(t, err, domain.ErrInvalidImport)
+}
+
+func TestEncode(t *testing.T) {
+	created := time.Date(2026, 3, 1, 23, 30, 0, 0, time.UTC)
+	updated := time.Date(2026, 3, 12, 8, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 3, 15, 23, 30, 0, 0, time.UTC)
+	stockholm := "Europe/Stockholm"
+
+	data := string(Encode([]domain.TodoItem{
+		{
+			Title: "Call Anna", Status: domain.TaskStatusTodo, Priority: priority(domain.TaskPriorityUrgent),
+			Tags: []string{"project:garden", "phone"}, DueAt: &due, CreatedAt: created, UpdatedAt: updated,
+		},
+		{
+			Title: "Water plants", Status: domain.TaskStatusDone, Priority: priority(domain.TaskPriorityHigh),
+			Tags: []string{"project:garden"}, CreatedAt: created, UpdatedAt: updated,
+		},
+		{
+			Title: "Plan trip", Status: domain.TaskStatusCancelled, Tags: []string{"summer house"},
+			DueAt: &due, Timezone: &stockholm, CreatedAt: created, UpdatedAt: updated,
+		},
+		{Title: "Read\npost", Status: domain.TaskStatusInProgress, StartsAt: date(2026, 3, 10)},
+	}))
+
+	assert.Equal(t, strings.Join([]string{
+		"(A) 2026-03-01 Call Anna +garden @phone due:2026-03-15",
+		"x 2026-03-12 2026-03-01 Water plants +garden pri:B",
+		// Dates in the item's timezone
+		"x 2026-03-12 2026-03-02 Plan trip @summer_house due:2026-03-16 status:cancelled",
+		"Read post t:2026-03-10 status:in_progress",
+	}, "\n")+"\n", data)
+}
+
+func TestRoundTrip(t *testing.T) {
+	created := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	items := []domain.TodoItem{
+		{
+			Title: "Call Anna", Status: domain.TaskStatusTodo, Priority: priority(domain.TaskPriorityUrgent),
+			Tags: []string{"project:garden", "phone"}, DueAt: date(2026, 3, 15), StartsAt: date(2026, 3, 10),
+		},
+		{Title: "Water plants", Status: domain.TaskStatusDone, Priority: priority(domain.TaskPriorityMedium)},
+		{Title: "Fix fence", Status: domain.TaskStatusBlocked, Priority: priority(domain.TaskPriorityLow)},
+		{Title: "Old idea", Status: domain.TaskStatusArchived, Tags: []string{"project:house"}},
+		{Title: "Cancelled trip", Status: domain.TaskStatusCancelled},
+	}
+	for i := range items {
+		items[i].CreatedAt, items[i].UpdatedAt = created, created
+	}
+
+	entries, err := Decode(Encode(items))
+	require.NoError(t, err)
+	require.Len(t, entries, len(items))
+	for i, entry := range entries {
+		require.Empty(t, entry.Problem)
+		want := items[i]
+		want.CreatedAt, want.UpdatedAt = time.Time{}, time.Time{}
+		assert.Equal(t, want, *entry.Item)
+	}
+}
@@ -3,6 +3,7 @@ package recurring
 import (
 	"context"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/google/uuid"
@@ -96,6 +97,7 @@ func (g *DomainGenerator) createTaskInstance(template *domain.RecurringTemplate,
 		UpdatedAt:           time.Now().UTC(),
 		DueAt:               dueAt,
 		Tags:                template.Tags,
+		CustomFields:        maps.Clone(template.CustomFields), // Instances never share the template's map
 		RecurringTemplateID: &templateID,
 		StartsAt:            &startsAt, // Date when task becomes visible
 		OccursAt:            &occursAt, // Exact timestamp for this occurrence
@@ -0,0 +1,109 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Agenda tests.
+//
+// GET /v1/agenda groups the open items of a local day across lists. Floating
+// item times are read in the viewer's timezone; fixed ones are converted.
+
+func getAgenda(t *testing.T, ts *TestServer, apiKey, query string) openapi.AgendaResponse {
+	t.Helper()
+
+	w := doTenantRequest(t, ts, apiKey, http.MethodGet, "/api/v1/agenda?"+query, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var resp openapi.AgendaResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	return resp
+}
+
+func agendaTitles(entries []openapi.AgendaEntry) []string {
+	titles := make([]string, len(entries))
+	for i, entry := range entries {
+		titles[i] = ptr.Deref(entry.Item.Title, "")
+	}
+	return titles
+}
+
+func TestAgenda_FloatingAndFixedTimes(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	work := createTestList(t, ts, "Work")
+	home := createTestList(t, ts, "Home")
+	stockholm := "Europe/Stockholm"
+	for _, item := range []struct {
+		list     *openapi.TodoList
+		title    string
+		due      string
+		timezone *string
+	}{
+		// Floating 2am is 2am on the 11th in New York
+		{home, "Take out bins", "2026-03-11T02:00:00Z", nil},
+		// 4am in Stockholm is 11pm on the 10th in New York
+		{work, "Stockholm standup", "2026-03-11T03:00:00Z", &stockholm},
+		{work, "File report", "2026-03-09T12:00:00Z", nil},
+		{home, "Renew passport", "2026-05-01T09:00:00Z", nil},
+	} {
+		due, err := time.Parse(time.RFC3339, item.due)
+		require.NoError(t, err)
+		w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items", item.list.Id),
+			openapi.CreateItemRequest{Title: item.title, DueAt: &due, Timezone: item.timezone})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	agenda := getAgenda(t, ts, ts.APIKey, "date=2026-03-10&tz=America/New_York")
+	assert.Equal(t, "2026-03-10", agenda.Date.String())
+	assert.Equal(t, "America/New_York", agenda.Timezone)
+	assert.Equal(t, []string{"File report"}, agendaTitles(agenda.Overdue))
+	assert.Equal(t, []string{"Stockholm standup"}, agendaTitles(agenda.Today))
+	assert.Equal(t, []string{"Take out bins"}, agendaTitles(agenda.Upcoming))
+	assert.False(t, agenda.Truncated)
+
+	require.Len(t, agenda.Upcoming, 1)
+	assert.Equal(t, "2026-03-11T02:00:00-04:00", agenda.Upcoming[0].At.Format(time.RFC3339))
+
+	// Another tenant sees none of it
+	fixture := setupTenantFixture(t, ts)
+	other := getAgenda(t, ts, fixture.otherKey, "date=2026-03-10&tz=America/New_York")
+	assert.Empty(t, other.Overdue)
+	assert.Empty(t, other.Today)
+	assert.Empty(t, other.Upcoming)
+}
+
+func TestAgenda_IncludesRecurringInstances(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Routines")
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/recurring-templates", list.Id),
+		openapi.CreateRecurringTemplateRequest{Title: "Water plants", RecurrencePattern: openapi.Daily})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	tomorrow := time.Now().UTC().AddDate(0, 0, 1).Format(time.DateOnly)
+	agenda := getAgenda(t, ts, ts.APIKey, "date="+tomorrow)
+	require.Equal(t, []string{"Water plants"}, agendaTitles(agenda.Today))
+	assert.NotNil(t, agenda.Today[0].Item.RecurringTemplateId)
+	assert.Equal(t, tomorrow, agenda.Today[0].At.Format(time.DateOnly))
+}
+
+func TestAgenda_Validation(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	for _, query := range []string{"tz=Mars/Olympus_Mons", "date=10-03-2026"} {
+		w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, "/api/v1/agenda?"+query, nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
@@ -1,7 +1,6 @@
 package http_test
 
 import (
-	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -39,7 +38,7 @@ func TestAPIResponseValidation_ArraysNeverNull(t *testing.T) {
 		ts := SetupTestServer(t)
 		defer ts.Cleanup()
 
-		ctx := context.Background()
+		ctx := ts.OwnerContext()
 		list, err := ts.TodoService.CreateList(ctx, "Test List")
 		require.NoError(t, err)
 
@@ -61,7 +60,7 @@ func TestAPIResponseValidation_ArraysNeverNull(t *testing.T) {
 		ts := SetupTestServer(t)
 		defer ts.Cleanup()
 
-		ctx := context.Background()
+		ctx := ts.OwnerContext()
 		list, err := ts.TodoService.CreateList(ctx, "Test List")
 		require.NoError(t, err)
 
@@ -91,7 +90,7 @@ func TestAPIResponseValidation_ArraysNeverNull(t *testing.T) {
 		ts := SetupTestServer(t)
 		defer ts.Cleanup()
 
-		ctx := context.Background()
+		ctx := ts.OwnerContext()
 		list, err := ts.TodoService.CreateList(ctx, "Test List")
 		require.NoError(t, err)
 
@@ -113,7 +112,7 @@ func TestAPIResponseValidation_ArraysNeverNull(t *testing.T) {
 		ts := SetupTestServer(t)
 		defer ts.Cleanup()
 
-		ctx := context.Background()
+		ctx := ts.OwnerContext()
 		list, err := ts.TodoService.CreateList(ctx, "Test List")
 		require.NoError(t, err)
 
@@ -1,7 +1,6 @@
 package http_test
 
 import (
-	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -29,7 +28,7 @@ func TestAuth_ExpiredAPIKeyIsRejected(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	expiredAt := time.Now().UTC().UTC().Add(-1 * time.Hour)
 	expiredKey, err := auth.CreateAPIKey(ctx, ts.Store, "sk", "test", "v1", "expired", &expiredAt)
 	require.NoError(t, err)
@@ -49,7 +48,7 @@ func TestAuth_APIKeyExpiryConsistentlyUsesUTC(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a key that expires in 24 hours using current pattern (may not be UTC)
 	futureTime := time.Now().UTC().AddDate(0, 0, 1) // Simulates cmd/apikey/main.go:44 pattern
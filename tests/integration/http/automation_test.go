@@ -0,0 +1,237 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Automation rule tests.
+//
+// A rule runs its actions when its trigger fires for an item of its list that
+// matches its condition, in the transaction of the change, and logs each run.
+
+func createTestRule(t *testing.T, ts *TestServer, listID string, req openapi.CreateAutomationRuleRequest) openapi.AutomationRule {
+	t.Helper()
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/rules", listID), req)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var resp openapi.AutomationRuleResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.NotNil(t, resp.Rule)
+	return *resp.Rule
+}
+
+func listRuleRuns(t *testing.T, ts *TestServer, listID string, ruleID fmt.Stringer) []openapi.AutomationRuleRun {
+	t.Helper()
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/rules/%s/runs", listID, ruleID), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var resp openapi.ListAutomationRuleRunsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.NotNil(t, resp.Runs)
+	return *resp.Runs
+}
+
+func TestAutomationRules_RunOnStatusChange(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Work")
+	archive := createTestList(t, ts, "Archive")
+	listID := list.Id.String()
+
+	done := openapi.ItemStatus("done")
+	rule := createTestRule(t, ts, listID, openapi.CreateAutomationRuleRequest{
+		Name:      "Archive shipped bugs",
+		Trigger:   openapi.RuleTrigger{Type: openapi.RuleTriggerTypeItemStatusChanged, ToStatus: &done},
+		Condition: &openapi.RuleCondition{Tags: &[]string{"bug"}},
+		Actions: []openapi.RuleAction{
+			{Type: openapi.SetField, Field: ptr.To("priority"), Value: "low"},
+			{Type: openapi.AddTag, Tag: ptr.To("shipped")},
+			{Type: openapi.Move, ListId: archive.Id},
+		},
+	})
+	assert.True(t, rule.Enabled)
+
+	// Items that do not match the condition are left alone
+	other := createTimeTrackingItem(t, ts, listID, "Write docs")
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, fmt.Sprintf("/api/v1/lists/%s/items/%s", listID, other), openapi.UpdateItemRequest{
+		Item:       openapi.TodoItem{Status: &done},
+		UpdateMask: []openapi.UpdateItemRequestUpdateMask{"status"},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Empty(t, listRuleRuns(t, ts, listID, rule.Id))
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items", listID), openapi.CreateItemRequest{
+		Title: "Crash on save",
+		Tags:  &[]string{"bug"},
+	})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created openapi.CreateItemResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	itemID := created.Item.Id.String()
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, fmt.Sprintf("/api/v1/lists/%s/items/%s", listID, itemID), openapi.UpdateItemRequest{
+		Item:       openapi.TodoItem{Status: &done},
+		UpdateMask: []openapi.UpdateItemRequestUpdateMask{"status"},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var updated openapi.UpdateItemResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &updated))
+	require.NotNil(t, updated.Item.Priority)
+	assert.Equal(t, openapi.ItemPriority("low"), *updated.Item.Priority)
+	assert.ElementsMatch(t, []string{"bug", "shipped"}, *updated.Item.Tags)
+
+	// The item moved to the archive list
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/items/%s", archive.Id, itemID), nil)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	runs := listRuleRuns(t, ts, listID, rule.Id)
+	require.Len(t, runs, 1)
+	assert.Equal(t, openapi.AutomationRuleRunStatusSucceeded, runs[0].Status)
+	assert.Equal(t, itemID, runs[0].ItemId.String())
+	assert.Len(t, runs[0].Actions, 3)
+}
+
+func TestAutomationRules_ChangesByRulesFireRules(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Ping pong")
+	listID := list.Id.String()
+
+	for _, tags := range [][2]string{{"ping", "pong"}, {"pong", "ping"}} {
+		createTestRule(t, ts, listID, openapi.CreateAutomationRuleRequest{
+			Name:    "On " + tags[0],
+			Trigger: openapi.RuleTrigger{Type: openapi.RuleTriggerTypeItemTagAdded, Tag: ptr.To(tags[0])},
+			Actions: []openapi.RuleAction{{Type: openapi.AddTag, Tag: ptr.To(tags[1])}},
+		})
+	}
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items", listID), openapi.CreateItemRequest{
+		Title: "Ball",
+	})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created openapi.CreateItemResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, fmt.Sprintf("/api/v1/lists/%s/items/%s", listID, created.Item.Id), openapi.UpdateItemRequest{
+		Item:       openapi.TodoItem{Tags: &[]string{"ping"}},
+		UpdateMask: []openapi.UpdateItemRequestUpdateMask{"tags"},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var updated openapi.UpdateItemResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &updated))
+	assert.ElementsMatch(t, []string{"ping", "pong"}, *updated.Item.Tags)
+}
+
+func TestAutomationRules_Lifecycle(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Rules")
+	listID := list.Id.String()
+	rulesPath := fmt.Sprintf("/api/v1/lists/%s/rules", listID)
+
+	// A rule needs an action
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, rulesPath, openapi.CreateAutomationRuleRequest{
+		Name:    "Empty",
+		Trigger: openapi.RuleTrigger{Type: openapi.RuleTriggerTypeItemCreated},
+		Actions: []openapi.RuleAction{},
+	})
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+
+	// overdue_by is an ISO 8601 duration of an item.overdue trigger
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, rulesPath, openapi.CreateAutomationRuleRequest{
+		Name:    "Late",
+		Trigger: openapi.RuleTrigger{Type: openapi.RuleTriggerTypeItemOverdue, OverdueBy: ptr.To("2 days")},
+		Actions: []openapi.RuleAction{{Type: openapi.AddTag, Tag: ptr.To("late")}},
+	})
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+
+	rule := createTestRule(t, ts, listID, openapi.CreateAutomationRuleRequest{
+		Name:    "Late",
+		Trigger: openapi.RuleTrigger{Type: openapi.RuleTriggerTypeItemOverdue, OverdueBy: ptr.To("PT48H")},
+		Actions: []openapi.RuleAction{{Type: openapi.AddTag, Tag: ptr.To("late")}},
+	})
+	assert.Equal(t, "PT48H", *rule.Trigger.OverdueBy)
+	rulePath := fmt.Sprintf("%s/%s", rulesPath, rule.Id)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, rulePath, openapi.UpdateAutomationRuleRequest{
+		Enabled:    ptr.To(false),
+		UpdateMask: []openapi.UpdateAutomationRuleRequestUpdateMask{openapi.UpdateAutomationRuleRequestUpdateMaskEnabled},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var updated openapi.AutomationRuleResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &updated))
+	assert.False(t, updated.Rule.Enabled)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, rulesPath, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var listed openapi.ListAutomationRulesResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	assert.Len(t, *listed.Rules, 1)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodDelete, rulePath, nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, rulePath, nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
+
+func TestAutomationRules_SkippedWhenOwnerLosesAccess(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	memberKey, memberID := createTenantKey(t, ts, "member")
+	list := createTestList(t, ts, "Shared")
+	listID := list.Id.String()
+	memberPath := fmt.Sprintf("/api/v1/lists/%s/members/%s", listID, memberID)
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/members", listID),
+		openapi.AddListMemberRequest{PrincipalId: memberID, Role: openapi.Editor})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	// The member sets up a rule moving finished items into a list of their own
+	w = doTenantRequest(t, ts, memberKey, http.MethodPost, "/api/v1/lists", openapi.CreateListRequest{Title: "Mine"})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var mine openapi.CreateListResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &mine))
+
+	done := openapi.ItemStatus("done")
+	w = doTenantRequest(t, ts, memberKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/rules", listID), openapi.CreateAutomationRuleRequest{
+		Name:    "Take finished items",
+		Trigger: openapi.RuleTrigger{Type: openapi.RuleTriggerTypeItemStatusChanged, ToStatus: &done},
+		Actions: []openapi.RuleAction{{Type: openapi.Move, ListId: mine.List.Id}},
+	})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var rule openapi.AutomationRuleResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &rule))
+
+	// Revoked: the rule no longer runs on the member's behalf
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodDelete, memberPath, nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+	itemID := createTimeTrackingItem(t, ts, listID, "Quarterly report")
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, fmt.Sprintf("/api/v1/lists/%s/items/%s", listID, itemID), openapi.UpdateItemRequest{
+		Item:       openapi.TodoItem{Status: &done},
+		UpdateMask: []openapi.UpdateItemRequestUpdateMask{"status"},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/items/%s", listID, itemID), nil)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	runs := listRuleRuns(t, ts, listID, rule.Rule.Id)
+	require.Len(t, runs, 1)
+	assert.Equal(t, openapi.AutomationRuleRunStatusSkipped, runs[0].Status)
+	require.NotNil(t, runs[0].Error)
+	assert.Contains(t, *runs[0].Error, "rule owner has no access")
+	assert.Empty(t, runs[0].Actions)
+}
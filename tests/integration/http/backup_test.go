@@ -0,0 +1,159 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// List backup tests.
+//
+// A backup is a JSON document with a list, its recurring templates and all its
+// items with their status history. Restoring it creates a new list, with new
+// IDs by default or with the IDs of the backup.
+
+// exportBackup creates a list with a template, an item and a finished item, and exports it.
+func exportBackup(t *testing.T, ts *TestServer) openapi.ListBackup {
+	t.Helper()
+	ctx := ts.OwnerContext()
+
+	list, err := ts.TodoService.CreateList(ctx, "Backed up")
+	require.NoError(t, err)
+	createTestRecurringTemplate(t, ts, list.ID, "Water plants")
+	_, err = ts.TodoService.CreateItem(ctx, list.ID, &domain.TodoItem{Title: "Open item"})
+	require.NoError(t, err)
+	done, err := ts.TodoService.CreateItem(ctx, list.ID, &domain.TodoItem{Title: "Finished item"})
+	require.NoError(t, err)
+
+	status := openapi.ItemStatus("done")
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, fmt.Sprintf("/api/v1/lists/%s/items/%s", list.ID, done.ID), openapi.UpdateItemRequest{
+		Item:       openapi.TodoItem{Status: &status},
+		UpdateMask: []openapi.UpdateItemRequestUpdateMask{"status"},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/backup", list.ID), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "attachment")
+
+	var backup openapi.ListBackup
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &backup))
+	return backup
+}
+
+func restoreBackup(t *testing.T, ts *TestServer, backup openapi.ListBackup, query string) *httptest.ResponseRecorder {
+	t.Helper()
+	return doTenantRequest(t, ts, ts.APIKey, http.MethodPost, "/api/v1/backups/restore"+query, backup)
+}
+
+func TestListBackup_Export(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	backup := exportBackup(t, ts)
+
+	assert.Equal(t, 1, backup.Version)
+	assert.Equal(t, "Backed up", ptr.Deref(backup.List.Title, ""))
+	require.Len(t, backup.RecurringTemplates, 1)
+	assert.Equal(t, "Water plants", ptr.Deref(backup.RecurringTemplates[0].Template.Title, ""))
+
+	// Generated items may come before or after the others
+	history := make(map[string][]openapi.StatusChange)
+	for _, item := range backup.Items {
+		history[ptr.Deref(item.Item.Title, "")] = item.StatusHistory
+	}
+	require.Contains(t, history, "Finished item")
+	finished := history["Finished item"]
+	require.Len(t, finished, 2)
+	assert.Equal(t, openapi.ItemStatus("todo"), finished[0].ToStatus)
+	assert.Equal(t, openapi.ItemStatus("done"), finished[1].ToStatus)
+}
+
+func TestListBackup_RestoreWithNewIDs(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	backup := exportBackup(t, ts)
+
+	w := restoreBackup(t, ts, backup, "")
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var report openapi.RestoreReport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+	assert.False(t, report.DryRun)
+	assert.NotEqual(t, *backup.List.Id, report.ListId)
+	assert.Equal(t, 1, report.RecurringTemplates)
+	assert.Equal(t, len(backup.Items), report.Items)
+
+	// The restored list exports the same content
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/backup", report.ListId), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var restored openapi.ListBackup
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &restored))
+	assert.Equal(t, backup.List.Title, restored.List.Title)
+	require.Len(t, restored.Items, len(backup.Items))
+	for i := range backup.Items {
+		assert.Equal(t, backup.Items[i].Item.Title, restored.Items[i].Item.Title)
+		assert.Equal(t, backup.Items[i].Item.Status, restored.Items[i].Item.Status)
+		assert.Len(t, restored.Items[i].StatusHistory, len(backup.Items[i].StatusHistory))
+	}
+}
+
+func TestListBackup_PreserveIDsConflicts(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	backup := exportBackup(t, ts)
+
+	// A dry run lists the taken IDs
+	w := restoreBackup(t, ts, backup, "?id_mode=preserve&dry_run=true")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var report openapi.RestoreReport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+	assert.True(t, report.DryRun)
+	assert.Len(t, report.Conflicts, 2+len(backup.Items))
+
+	w = restoreBackup(t, ts, backup, "?id_mode=preserve")
+	assert.Equal(t, http.StatusConflict, w.Code, w.Body.String())
+}
+
+func TestListBackup_PreserveIDsHidesOtherTenantsData(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	backup := exportBackup(t, ts)
+	otherKey, _ := createTenantKey(t, ts, "other-tenant")
+
+	// IDs taken by another tenant are unavailable, without their kind
+	w := doTenantRequest(t, ts, otherKey, http.MethodPost, "/api/v1/backups/restore?id_mode=preserve&dry_run=true", backup)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var report openapi.RestoreReport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+	require.Len(t, report.Conflicts, 2+len(backup.Items))
+	for _, conflict := range report.Conflicts {
+		assert.Equal(t, openapi.BackupConflictKindUnavailable, conflict.Kind)
+	}
+
+	w = doTenantRequest(t, ts, otherKey, http.MethodPost, "/api/v1/backups/restore?id_mode=preserve", backup)
+	assert.Equal(t, http.StatusConflict, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), "is unavailable")
+	assert.NotContains(t, w.Body.String(), "already exists")
+}
+
+func TestListBackup_RestoreRejectsInvalidBackup(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	backup := exportBackup(t, ts)
+	backup.Version = 99
+
+	w := restoreBackup(t, ts, backup, "")
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+}
@@ -0,0 +1,120 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Calendar feed tests.
+//
+// A feed is an iCalendar subscription to a list. Calendar apps fetch it
+// without an Authorization header; the secret token in the path is the credential.
+
+func createTestCalendarFeed(t *testing.T, ts *TestServer, listID string, req openapi.CreateCalendarFeedRequest) openapi.CalendarFeed {
+	t.Helper()
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/feeds", listID), req)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var resp openapi.CalendarFeedResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.NotNil(t, resp.Feed)
+	require.NotNil(t, resp.Feed.Path)
+	return *resp.Feed
+}
+
+// fetchCalendarFeed requests a feed the way a calendar app does, unauthenticated.
+func fetchCalendarFeed(ts *TestServer, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ts.Router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+func TestCalendarFeed_ServesItemsWithoutAPIKey(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Work")
+	itemsPath := fmt.Sprintf("/api/v1/lists/%s/items", list.Id)
+	due := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	stockholm := "Europe/Stockholm"
+	for _, req := range []openapi.CreateItemRequest{
+		{Title: "Call Anna", DueAt: &due, Tags: &[]string{"calls"}},
+		{Title: "Stockholm sync", DueAt: &due, Timezone: &stockholm, Tags: &[]string{"calls"}},
+		{Title: "Write report", DueAt: &due},
+		{Title: "Someday", Tags: &[]string{"calls"}},
+	} {
+		w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, itemsPath, req)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	feed := createTestCalendarFeed(t, ts, list.Id.String(), openapi.CreateCalendarFeedRequest{
+		Name: ptr.To("Calls"),
+		Tags: &[]string{"calls"},
+	})
+	require.NotNil(t, feed.Token)
+	assert.Equal(t, "/api/v1/feeds/"+*feed.Token, *feed.Path)
+	assert.Equal(t, []string{"calls"}, feed.Tags)
+
+	w := fetchCalendarFeed(ts, *feed.Path)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "text/calendar; charset=utf-8", w.Header().Get("Content-Type"))
+	body := w.Body.String()
+	assert.Contains(t, body, "BEGIN:VCALENDAR\r\n")
+	assert.Contains(t, body, "X-WR-CALNAME:Calls\r\n")
+	assert.Contains(t, body, "SUMMARY:Call Anna\r\nCATEGORIES:calls\r\nDUE:20260311T090000\r\n")
+	assert.Contains(t, body, "SUMMARY:Stockholm sync\r\nCATEGORIES:calls\r\nDUE;X-MONO-TZID=Europe/Stockholm:20260311T090000Z\r\n")
+	// Other tags are filtered out, items without a due time have nothing to show
+	assert.NotContains(t, body, "Write report")
+	assert.NotContains(t, body, "Someday")
+
+	// The token is only returned on creation
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/feeds", list.Id), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var listed openapi.ListCalendarFeedsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	require.NotNil(t, listed.Feeds)
+	require.Len(t, *listed.Feeds, 1)
+	assert.Equal(t, feed.Id, (*listed.Feeds)[0].Id)
+	assert.Nil(t, (*listed.Feeds)[0].Token)
+	assert.Nil(t, (*listed.Feeds)[0].Path)
+}
+
+func TestCalendarFeed_UnknownAndDeletedTokensReturnNotFound(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Home")
+	feed := createTestCalendarFeed(t, ts, list.Id.String(), openapi.CreateCalendarFeedRequest{})
+
+	w := fetchCalendarFeed(ts, "/api/v1/feeds/ics_unknown")
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+
+	w = fetchCalendarFeed(ts, *feed.Path)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), "X-WR-CALNAME:Home\r\n")
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodDelete, fmt.Sprintf("/api/v1/lists/%s/feeds/%s", list.Id, feed.Id), nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+	w = fetchCalendarFeed(ts, *feed.Path)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
+
+func TestCalendarFeed_OtherRoutesStillRequireAPIKey(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Work")
+
+	w := fetchCalendarFeed(ts, fmt.Sprintf("/api/v1/lists/%s/feeds", list.Id))
+	assert.Equal(t, http.StatusUnauthorized, w.Code, w.Body.String())
+}
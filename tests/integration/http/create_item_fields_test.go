@@ -2,7 +2,6 @@ package http_test
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -179,7 +178,7 @@ func TestCreateItem_AllFieldsMapped(t *testing.T) {
 
 	t.Run("instance_date is mapped", func(t *testing.T) {
 		// Create a recurring template first (required for instance_date validation)
-		ctx := context.Background()
+		ctx := ts.OwnerContext()
 		template, err := ts.TodoService.CreateRecurringTemplate(ctx, &domain.RecurringTemplate{
 			ListID:            listID,
 			Title:             "Test Template",
@@ -288,7 +287,7 @@ func TestCreateItem_AllFieldsMapped(t *testing.T) {
 
 	t.Run("all fields together", func(t *testing.T) {
 		// Create a recurring template first (required for instance_date validation)
-		ctx := context.Background()
+		ctx := ts.OwnerContext()
 		template, err := ts.TodoService.CreateRecurringTemplate(ctx, &domain.RecurringTemplate{
 			ListID:            listID,
 			Title:             "Test Template for All Fields",
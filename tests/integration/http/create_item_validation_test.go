@@ -1,7 +1,6 @@
 package http_test
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -26,7 +25,7 @@ func TestCreateItem_InvalidStatus_Rejected(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "Status Test List")
 	require.NoError(t, err)
 
@@ -64,7 +63,7 @@ func TestCreateItem_InvalidPriority_Rejected(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "Priority Test List")
 	require.NoError(t, err)
 
@@ -101,7 +100,7 @@ func TestCreateItem_EmptyPriority_Rejected(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "Empty Priority Test")
 	require.NoError(t, err)
 
@@ -140,7 +139,7 @@ func TestCreateItem_EmptyTimezone_Normalized(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "Timezone Test")
 	require.NoError(t, err)
 
@@ -178,7 +177,7 @@ func TestCreateItem_InvalidTimezone_Rejected(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "Invalid TZ Test")
 	require.NoError(t, err)
 
@@ -215,7 +214,7 @@ func TestCreateItem_NilItem_FailsGracefully(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "Nil Safety Test")
 	require.NoError(t, err)
 
@@ -234,7 +233,7 @@ func TestCreateItem_Tags_Validation(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "Tags Test")
 	require.NoError(t, err)
 
@@ -366,7 +365,7 @@ func TestCreateItem_EstimatedDuration_Validation(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "Duration Test")
 	require.NoError(t, err)
 
@@ -479,7 +478,7 @@ func TestCreateItem_DueOffset_Validation(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "DueOffset Test")
 	require.NoError(t, err)
 
@@ -547,7 +546,7 @@ func TestCreateItem_RecurringTemplateID_Validation(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "RecurringTemplate Test")
 	require.NoError(t, err)
 
@@ -2,7 +2,6 @@ package http_test
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -20,7 +19,7 @@ func TestCreateRecurringTemplate_Title_Validation(t *testing.T) {
 	defer ts.Cleanup()
 
 	// Create list for template
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "Template Validation Test")
 	require.NoError(t, err)
 
@@ -108,7 +107,7 @@ func TestCreateRecurringTemplate_RecurrencePattern_Validation(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "Pattern Validation Test")
 	require.NoError(t, err)
 
@@ -164,7 +163,7 @@ func TestCreateRecurringTemplate_HorizonDays_Validation(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "Horizon Validation Test")
 	require.NoError(t, err)
 
@@ -282,7 +281,7 @@ func TestCreateRecurringTemplate_Priority_Validation(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "Priority Validation Test")
 	require.NoError(t, err)
 
@@ -313,7 +312,7 @@ func TestCreateRecurringTemplate_RecurrenceConfig_Validation(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "Config Validation Test")
 	require.NoError(t, err)
 
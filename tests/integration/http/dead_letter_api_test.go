@@ -2,7 +2,6 @@ package http_test
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -12,8 +11,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/application/auth"
 	"github.com/rezkam/mono/internal/application/worker"
 	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
 	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,17 +26,16 @@ func TestDeadLetterAPI(t *testing.T) {
 	defer ts.Cleanup()
 
 	// Create a dead letter job directly via coordinator/store for testing
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
-	// Create required template first
-	list, err := ts.TodoService.CreateList(ctx, "DLQ Test List")
-	require.NoError(t, err)
+	// Create required template first, in a list of the test key's tenant
+	list := createTestList(t, ts, "DLQ Test List")
 
 	// Use domain.RecurrencePatternDaily
 	dailyPattern, _ := domain.NewRecurrencePattern("daily")
 
 	template := &domain.RecurringTemplate{
-		ListID:            list.ID,
+		ListID:            list.Id.String(),
 		Title:             "Failing Template",
 		RecurrencePattern: dailyPattern,
 		IsActive:          true,
@@ -151,7 +151,7 @@ func TestDeadLetterAPI(t *testing.T) {
 		// Create a separate template for this subtest to avoid unique constraint conflict
 		// (RetryDeadLetterJob creates a new pending job for createdTemplate.ID)
 		discardTemplate := &domain.RecurringTemplate{
-			ListID:            list.ID,
+			ListID:            list.Id.String(),
 			Title:             "Discard Test Template",
 			RecurrencePattern: dailyPattern,
 			IsActive:          true,
@@ -283,3 +283,68 @@ func readBody(r *http.Response) []byte {
 	r.Body.Close()
 	return body
 }
+
+func TestDeadLetterAPI_ScopedToTenant(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+	ctx := ts.OwnerContext()
+
+	fixture := setupTenantFixture(t, ts)
+
+	// Replace the template's pending job with one that ran out of retries
+	_, err := ts.Store.Pool().Exec(ctx, `
+		UPDATE recurring_generation_jobs
+		SET status = 'completed', completed_at = NOW()
+		WHERE template_id = $1 AND status IN ('pending', 'scheduled', 'running')
+	`, fixture.templateID)
+	require.NoError(t, err)
+
+	workerID := "worker-1"
+	now := time.Now().UTC()
+	job := &domain.GenerationJob{
+		ID:            uuid.New().String(),
+		TemplateID:    fixture.templateID,
+		GenerateFrom:  now,
+		GenerateUntil: now.Add(24 * time.Hour),
+		ScheduledFor:  now,
+		CreatedAt:     now,
+		ClaimedBy:     &workerID,
+	}
+	_, err = ts.Store.Pool().Exec(ctx, `
+		INSERT INTO recurring_generation_jobs (id, template_id, generate_from, generate_until, scheduled_for, status, retry_count, created_at, claimed_by, claimed_at, available_at)
+		VALUES ($1, $2, $3, $4, $5, 'running', 0, $6, $7, NOW(), NOW() + INTERVAL '5 minutes')
+	`, job.ID, job.TemplateID, job.GenerateFrom, job.GenerateUntil, job.ScheduledFor, job.CreatedAt, workerID)
+	require.NoError(t, err)
+	require.NoError(t, ts.Coordinator.MoveToDeadLetter(ctx, job, workerID, "permanent", "Test error message", nil))
+
+	jobs, err := ts.Coordinator.ListDeadLetterJobs(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	jobPath := fmt.Sprintf("/api/v1/admin/dead-letter-jobs/%s", jobs[0].ID)
+
+	// An admin of another tenant sees none of the jobs and can't resolve them
+	ownerID, err := uuid.NewV7()
+	require.NoError(t, err)
+	otherAdmin, err := auth.CreateAPIKeyForOwner(ctx, ts.Store, ownerID.String(), domain.AdminScopes(), "sk", "test", "v1", "tenant-b-admin", nil)
+	require.NoError(t, err)
+
+	w := doTenantRequest(t, ts, otherAdmin, http.MethodGet, "/api/v1/admin/dead-letter-jobs", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var listed openapi.ListDeadLetterJobsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	assert.Empty(t, *listed.Jobs)
+
+	w = doTenantRequest(t, ts, otherAdmin, http.MethodPost, jobPath+"/retry", nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+	w = doTenantRequest(t, ts, otherAdmin, http.MethodPost, jobPath+"/discard", map[string]any{"note": "not mine"})
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+
+	// The owning tenant still sees and resolves its job
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, "/api/v1/admin/dead-letter-jobs", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	assert.Len(t, *listed.Jobs, 1)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, jobPath+"/discard", map[string]any{"note": "handled"})
+	assert.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+}
@@ -1,7 +1,6 @@
 package http_test
 
 import (
-	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -51,7 +50,7 @@ func TestEmptyArraysNotNull(t *testing.T) {
 		defer ts.Cleanup()
 
 		// Create a list with no items
-		ctx := context.Background()
+		ctx := ts.OwnerContext()
 		list, err := ts.TodoService.CreateList(ctx, "Empty List")
 		require.NoError(t, err)
 
@@ -85,7 +84,7 @@ func TestEmptyArraysNotNull(t *testing.T) {
 		defer ts.Cleanup()
 
 		// Create a list and an item with no tags via service (not HTTP)
-		ctx := context.Background()
+		ctx := ts.OwnerContext()
 		list, err := ts.TodoService.CreateList(ctx, "Test List")
 		require.NoError(t, err)
 
@@ -135,7 +134,7 @@ func TestEmptyArraysNotNull(t *testing.T) {
 		defer ts.Cleanup()
 
 		// Create a list with no recurring templates
-		ctx := context.Background()
+		ctx := ts.OwnerContext()
 		list, err := ts.TodoService.CreateList(ctx, "Test List")
 		require.NoError(t, err)
 
@@ -171,7 +170,7 @@ func TestEmptyArraysNotNull(t *testing.T) {
 		defer ts.Cleanup()
 
 		// Create a list and a recurring template with no tags via service
-		ctx := context.Background()
+		ctx := ts.OwnerContext()
 		list, err := ts.TodoService.CreateList(ctx, "Test List")
 		require.NoError(t, err)
 
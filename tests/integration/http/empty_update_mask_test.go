@@ -2,7 +2,6 @@ package http_test
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -21,7 +20,7 @@ func TestUpdateItem_EmptyUpdateMask(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list and item
 	list, err := ts.TodoService.CreateList(ctx, "Empty Mask Test List")
@@ -74,7 +73,7 @@ func TestUpdateRecurringTemplate_EmptyUpdateMask(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list and recurring template
 	list, err := ts.TodoService.CreateList(ctx, "Empty Mask Template Test")
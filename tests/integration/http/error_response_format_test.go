@@ -2,7 +2,6 @@ package http_test
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -201,7 +200,7 @@ func TestErrorResponses_409_Conflict(t *testing.T) {
 	defer ts.Cleanup()
 
 	// Create a list
-	list, err := ts.TodoService.CreateList(context.Background(), "Test List")
+	list, err := ts.TodoService.CreateList(ts.OwnerContext(), "Test List")
 	if err != nil {
 		t.Fatalf("Failed to create list: %v", err)
 	}
@@ -215,7 +214,7 @@ func TestErrorResponses_409_Conflict(t *testing.T) {
 		ID:    itemID.String(),
 		Title: "Test Item",
 	}
-	item, err = ts.TodoService.CreateItem(context.Background(), list.ID, item)
+	item, err = ts.TodoService.CreateItem(ts.OwnerContext(), list.ID, item)
 	if err != nil {
 		t.Fatalf("Failed to create item: %v", err)
 	}
@@ -0,0 +1,139 @@
+package http_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sseEvent is one event read from a server-sent event stream.
+type sseEvent struct {
+	ID    string
+	Event string
+	Data  openapi.ChangeEvent
+}
+
+// openEventStream connects to an event stream and returns a reader of its events.
+func openEventStream(t *testing.T, ctx context.Context, server *httptest.Server, apiKey, path, lastEventID string) (*http.Response, func() sseEvent) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	scanner := bufio.NewScanner(resp.Body)
+	next := func() sseEvent {
+		t.Helper()
+		var event sseEvent
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "":
+				if event.ID != "" {
+					return event
+				}
+			case strings.HasPrefix(line, "id: "):
+				event.ID = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				event.Event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event.Data))
+			}
+		}
+		require.FailNow(t, "event stream ended", "error: %v", scanner.Err())
+		return event
+	}
+	return resp, next
+}
+
+func TestEventStream_DeliversListChanges(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	server := httptest.NewServer(ts.Router)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	list := createTestList(t, ts, "Streamed list")
+	eventsPath := fmt.Sprintf("/api/v1/lists/%s/events", list.Id)
+	itemsPath := fmt.Sprintf("/api/v1/lists/%s/items", list.Id)
+
+	resp, next := openEventStream(t, ctx, server, ts.APIKey, eventsPath, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, itemsPath, openapi.CreateItemRequest{Title: "First"})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var first openapi.CreateItemResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &first))
+
+	created := next()
+	assert.Equal(t, "item.created", created.Event)
+	assert.Equal(t, *first.Item.Id, created.Data.EntityId)
+	assert.Equal(t, *list.Id, created.Data.ListId)
+	assert.Equal(t, "First", created.Data.Data["title"])
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, fmt.Sprintf("%s/%s", itemsPath, first.Item.Id), openapi.UpdateItemRequest{
+		Item:       openapi.TodoItem{Title: ptrString("Renamed")},
+		UpdateMask: []openapi.UpdateItemRequestUpdateMask{openapi.UpdateItemRequestUpdateMaskTitle},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	updated := next()
+	assert.Equal(t, "item.updated", updated.Event)
+	require.NotNil(t, updated.Data.Diff)
+	assert.Equal(t, openapi.FieldChange{Old: "First", New: "Renamed"}, (*updated.Data.Diff)["title"])
+
+	// Resuming from the first event replays what came after it
+	resumed, nextResumed := openEventStream(t, ctx, server, ts.APIKey, eventsPath, created.ID)
+	require.Equal(t, http.StatusOK, resumed.StatusCode)
+	replayed := nextResumed()
+	assert.Equal(t, updated.ID, replayed.ID)
+	assert.Equal(t, "item.updated", replayed.Event)
+}
+
+func TestEventStream_RequiresListAccess(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	ownerKey, _ := createTenantKey(t, ts, "owner")
+	otherKey, _ := createTenantKey(t, ts, "other")
+
+	w := doTenantRequest(t, ts, ownerKey, http.MethodPost, "/api/v1/lists", openapi.CreateListRequest{Title: "Private"})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created openapi.CreateListResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+
+	w = doTenantRequest(t, ts, otherKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/events", created.List.Id), nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
+
+func TestEventStream_RejectsInvalidLastEventID(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	server := httptest.NewServer(ts.Router)
+	defer server.Close()
+
+	resp, _ := openEventStream(t, context.Background(), server, ts.APIKey, "/api/v1/events", "not-a-cursor")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
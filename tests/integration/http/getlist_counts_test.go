@@ -1,7 +1,6 @@
 package http_test
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -20,7 +19,7 @@ func TestGetList_ReturnsTotalAndUndoneItems(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list
 	list, err := ts.TodoService.CreateList(ctx, "Count Test List")
@@ -66,7 +65,7 @@ func TestListItems_ExcludesArchivedAndCancelledByDefault(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list
 	list, err := ts.TodoService.CreateList(ctx, "Status Filter Test List")
@@ -0,0 +1,151 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// iCalendar import tests.
+//
+// An import reads VTODOs and VEVENTs exported by another tool into a list.
+// Entries are keyed by UID, so importing the same file twice only adds what is new.
+
+const importTestCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Example//Tasks//EN\r\n" +
+	"BEGIN:VTODO\r\n" +
+	"UID:call-anna@example.com\r\n" +
+	"SUMMARY:Call Anna\r\n" +
+	"DUE;TZID=Europe/Stockholm:20260311T100000\r\n" +
+	"CATEGORIES:calls\r\n" +
+	"END:VTODO\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:standup@example.com\r\n" +
+	"SUMMARY:Standup\r\n" +
+	"DTSTART:20260302T090000\r\n" +
+	"DTEND:20260302T091500\r\n" +
+	"RRULE:FREQ=WEEKLY;BYDAY=MO\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VTODO\r\n" +
+	"UID:taxes@example.com\r\n" +
+	"SUMMARY:Taxes\r\n" +
+	"DUE:20260430T120000Z\r\n" +
+	"RRULE:FREQ=YEARLY;COUNT=3\r\n" +
+	"END:VTODO\r\n" +
+	"END:VCALENDAR\r\n"
+
+// importCalendar posts an .ics file to a list, the way a client uploads one.
+func importCalendar(t *testing.T, ts *TestServer, listID, calendar string, dryRun bool) openapi.ImportReport {
+	t.Helper()
+
+	path := fmt.Sprintf("/api/v1/lists/%s/import/ical", listID)
+	if dryRun {
+		path += "?dry_run=true"
+	}
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(calendar))
+	req.Header.Set("Content-Type", "text/calendar")
+	req.Header.Set("Authorization", "Bearer "+ts.APIKey)
+	w := httptest.NewRecorder()
+	ts.Router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var report openapi.ImportReport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+	return report
+}
+
+func importActions(report openapi.ImportReport) map[string]openapi.ImportResultAction {
+	actions := make(map[string]openapi.ImportResultAction, len(report.Results))
+	for _, result := range report.Results {
+		actions[result.ExternalId] = result.Action
+	}
+	return actions
+}
+
+func TestImportCalendar_DryRunCreatesNothing(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Imported")
+	report := importCalendar(t, ts, list.Id.String(), importTestCalendar, true)
+
+	assert.True(t, report.DryRun)
+	assert.Equal(t, 2, report.Created)
+	assert.Equal(t, 1, report.Failed)
+	assert.Equal(t, map[string]openapi.ImportResultAction{
+		"call-anna@example.com": openapi.Create,
+		"standup@example.com":   openapi.Create,
+		"taxes@example.com":     openapi.Fail,
+	}, importActions(report))
+	for _, result := range report.Results {
+		assert.Nil(t, result.Id, result.ExternalId)
+	}
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/items", list.Id), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var items openapi.ListItemsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &items))
+	assert.Empty(t, items.Items)
+}
+
+func TestImportCalendar_ImportsOnce(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Imported")
+	report := importCalendar(t, ts, list.Id.String(), importTestCalendar, false)
+
+	assert.False(t, report.DryRun)
+	assert.Equal(t, 2, report.Created)
+	assert.Equal(t, 1, report.Failed)
+	for _, result := range report.Results {
+		if result.Action == openapi.Create {
+			assert.NotNil(t, result.Id, result.ExternalId)
+		}
+	}
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/recurring-templates", list.Id), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var templates openapi.ListRecurringTemplatesResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &templates))
+	require.NotNil(t, templates.Templates)
+	require.Len(t, *templates.Templates, 1)
+	assert.Equal(t, "Standup", ptr.Deref((*templates.Templates)[0].Title, ""))
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/items?tags=calls", list.Id), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var items openapi.ListItemsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &items))
+	require.NotNil(t, items.Items)
+	require.Len(t, *items.Items, 1)
+	assert.Equal(t, "Call Anna", ptr.Deref((*items.Items)[0].Title, ""))
+
+	// Importing the same file again only skips
+	again := importCalendar(t, ts, list.Id.String(), importTestCalendar, false)
+	assert.Equal(t, 0, again.Created)
+	assert.Equal(t, 2, again.Skipped)
+	assert.Equal(t, openapi.Skip, importActions(again)["call-anna@example.com"])
+}
+
+func TestImportCalendar_RejectsInvalidCalendar(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Imported")
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/import/ical", list.Id), strings.NewReader("not a calendar"))
+	req.Header.Set("Content-Type", "text/calendar")
+	req.Header.Set("Authorization", "Bearer "+ts.APIKey)
+	w := httptest.NewRecorder()
+	ts.Router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+}
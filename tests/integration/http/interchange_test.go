@@ -0,0 +1,113 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// todo.txt and Taskwarrior import/export tests.
+//
+// Both formats import through the same pipeline as iCalendar files, and export
+// every item of a list in the mapping the import reads.
+
+// postFile posts a file to an import endpoint of a list.
+func postFile(t *testing.T, ts *TestServer, path, contentType, body string) openapi.ImportReport {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", "Bearer "+ts.APIKey)
+	w := httptest.NewRecorder()
+	ts.Router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var report openapi.ImportReport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+	return report
+}
+
+func TestTodoTxt_ImportAndExport(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Terminal")
+	file := "(A) Call Anna +garden @phone due:2026-03-15\n" +
+		"x 2026-03-12 2026-03-01 Water plants +garden\n"
+
+	report := postFile(t, ts, fmt.Sprintf("/api/v1/lists/%s/import/todotxt", list.Id), "text/plain", file)
+	assert.Equal(t, 2, report.Created)
+
+	// Importing the same file again skips every line
+	again := postFile(t, ts, fmt.Sprintf("/api/v1/lists/%s/import/todotxt", list.Id), "text/plain", file)
+	assert.Equal(t, 2, again.Skipped)
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/export/todotxt", list.Id), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Header().Get("Content-Type"), "text/plain")
+
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], "Call Anna +garden @phone due:2026-03-15")
+	assert.True(t, strings.HasPrefix(lines[0], "(A) "), lines[0])
+	assert.True(t, strings.HasPrefix(lines[1], "x "), lines[1])
+	assert.Contains(t, lines[1], "Water plants +garden")
+}
+
+func TestTaskwarrior_ImportAndExport(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Terminal")
+	export := `[
+		{"uuid":"9f0c5b7e-1111-4c1a-9a57-000000000001","description":"Call Anna","status":"pending",
+		 "due":"20260311T100000Z","priority":"H","project":"garden","tags":["phone"]},
+		{"uuid":"9f0c5b7e-1111-4c1a-9a57-000000000002","description":"Water plants","status":"completed"},
+		{"uuid":"9f0c5b7e-1111-4c1a-9a57-000000000003","description":"Standup","status":"recurring",
+		 "recur":"weekly","due":"20260302T091500Z"}
+	]`
+
+	report := postFile(t, ts, fmt.Sprintf("/api/v1/lists/%s/import/taskwarrior", list.Id), "application/json", export)
+	assert.Equal(t, 3, report.Created, report.Results)
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/export/taskwarrior", list.Id), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var tasks []openapi.TaskwarriorTask
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &tasks))
+	byDescription := make(map[string]openapi.TaskwarriorTask)
+	for _, task := range tasks {
+		byDescription[ptr.Deref(task.Description, "")] = task
+	}
+
+	call := byDescription["Call Anna"]
+	assert.Equal(t, "pending", ptr.Deref(call.Status, ""))
+	assert.Equal(t, "20260311T100000Z", ptr.Deref(call.Due, ""))
+	assert.Equal(t, "H", ptr.Deref(call.Priority, ""))
+	assert.Equal(t, "garden", ptr.Deref(call.Project, ""))
+	assert.Equal(t, "completed", ptr.Deref(byDescription["Water plants"].Status, ""))
+
+	// The template is exported as a recurring parent, after its generated instances
+	last := tasks[len(tasks)-1]
+	assert.Equal(t, "recurring", ptr.Deref(last.Status, ""))
+	assert.Equal(t, "weekly", ptr.Deref(last.Recur, ""))
+}
+
+func TestTaskwarrior_RejectsInvalidExport(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Terminal")
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/import/taskwarrior", list.Id),
+		[]map[string]any{{"uuid": "9f0c5b7e-1111-4c1a-9a57-000000000001", "due": "tomorrow"}})
+
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+}
@@ -0,0 +1,127 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Cross-list item search tests.
+//
+// GET /v1/items searches every list the caller can access, or the lists named
+// by list_id, with the same filters, sort and paging as a single list.
+
+func itemTitles(page openapi.ListItemsResponse) []string {
+	titles := make([]string, len(*page.Items))
+	for i, item := range *page.Items {
+		titles[i] = ptr.Deref(item.Title, "")
+	}
+	return titles
+}
+
+func TestSearchItems_AcrossLists(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	now := time.Now().UTC()
+	work := createTestList(t, ts, "Work")
+	home := createTestList(t, ts, "Home")
+	errands := createTestList(t, ts, "Errands")
+	for _, item := range []struct {
+		list  *openapi.TodoList
+		title string
+		due   time.Time
+	}{
+		{work, "Ship release", now.Add(24 * time.Hour)},
+		{home, "Water plants", now.Add(2 * 24 * time.Hour)},
+		{errands, "Renew passport", now.AddDate(0, 2, 0)},
+	} {
+		w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items", item.list.Id),
+			openapi.CreateItemRequest{Title: item.title, DueAt: &item.due})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	page := getItemsPage(t, ts, "/api/v1/items?sort_by=due_at&sort_dir=asc")
+	assert.Equal(t, []string{"Ship release", "Water plants", "Renew passport"}, itemTitles(page))
+
+	page = getItemsPage(t, ts, fmt.Sprintf("/api/v1/items?list_id=%s&list_id=%s&sort_by=due_at&sort_dir=asc", work.Id, errands.Id))
+	assert.Equal(t, []string{"Ship release", "Renew passport"}, itemTitles(page))
+
+	dueBefore := url.QueryEscape(now.Add(7 * 24 * time.Hour).Format(time.RFC3339))
+	page = getItemsPage(t, ts, "/api/v1/items?sort_by=due_at&sort_dir=asc&page_size=1&due_before="+dueBefore)
+	assert.Equal(t, []string{"Ship release"}, itemTitles(page))
+	require.NotNil(t, page.NextPageToken)
+
+	page = getItemsPage(t, ts, "/api/v1/items?sort_by=due_at&sort_dir=asc&page_size=1&due_before="+dueBefore+"&page_token="+*page.NextPageToken)
+	assert.Equal(t, []string{"Water plants"}, itemTitles(page))
+	assert.Nil(t, page.NextPageToken)
+
+	// A token only continues the search it was issued for
+	first := getItemsPage(t, ts, "/api/v1/items?page_size=1")
+	require.NotNil(t, first.NextPageToken)
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet,
+		fmt.Sprintf("/api/v1/items?page_size=1&list_id=%s&page_token=%s", work.Id, *first.NextPageToken), nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+}
+
+func TestSearchItems_TimeFilters(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Inbox")
+	itemsPath := fmt.Sprintf("/api/v1/lists/%s/items", list.Id)
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, itemsPath, openapi.CreateItemRequest{Title: "Old"})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	time.Sleep(10 * time.Millisecond)
+	since := url.QueryEscape(time.Now().UTC().Format(time.RFC3339Nano))
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, itemsPath, openapi.CreateItemRequest{Title: "New"})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	page := getItemsPage(t, ts, "/api/v1/items?created_after="+since)
+	assert.Equal(t, []string{"New"}, itemTitles(page))
+	page = getItemsPage(t, ts, itemsPath+"?updated_after="+since)
+	assert.Equal(t, []string{"New"}, itemTitles(page))
+}
+
+func TestSearchItems_Validation(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	query := url.Values{}
+	for range 51 {
+		query.Add("list_id", "0190d3c8-6b4a-7000-8000-000000000001")
+	}
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, "/api/v1/items?"+query.Encode(), nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, "/api/v1/items?due_before=tomorrow", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+}
+
+func TestSearchItems_OtherTenantSeesNothing(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	fixture := setupTenantFixture(t, ts)
+
+	page := getItemsPage(t, ts, "/api/v1/items")
+	assert.NotEmpty(t, *page.Items)
+
+	for _, path := range []string{"/api/v1/items", "/api/v1/items?list_id=" + fixture.listID} {
+		w := doTenantRequest(t, ts, fixture.otherKey, http.MethodGet, path, nil)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var resp openapi.ListItemsResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.NotNil(t, resp.Items)
+		assert.Empty(t, *resp.Items, path)
+	}
+}
@@ -2,7 +2,6 @@ package http_test
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -19,7 +18,7 @@ func TestUpdateItem_SetsStatusAndPriority(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "HTTP Update List")
 	require.NoError(t, err)
 
@@ -63,7 +62,7 @@ func TestUpdateItem_ListMismatchReturnsNotFound(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	sourceList, err := ts.TodoService.CreateList(ctx, "HTTP Update Source")
 	require.NoError(t, err)
 	otherList, err := ts.TodoService.CreateList(ctx, "HTTP Update Other")
@@ -105,7 +104,7 @@ func TestDeleteItem_Returns204AndRemovesItem(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "HTTP Delete List")
 	require.NoError(t, err)
 
@@ -130,7 +129,7 @@ func TestDeleteItem_ListMismatchReturnsNotFound(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	sourceList, err := ts.TodoService.CreateList(ctx, "HTTP Delete Source")
 	require.NoError(t, err)
 	otherList, err := ts.TodoService.CreateList(ctx, "HTTP Delete Other")
@@ -0,0 +1,110 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/application/auth"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// List sharing tests.
+//
+// The owner of a list can share it with other tenants as editor or viewer.
+// Viewers can read the list but get 403 on writes; revoked members get 404 again.
+
+func createTenantKey(t *testing.T, ts *TestServer, name string) (key string, ownerID uuid.UUID) {
+	t.Helper()
+
+	ownerID, err := uuid.NewV7()
+	require.NoError(t, err)
+	key, err = auth.CreateAPIKeyForOwner(context.Background(), ts.Store, ownerID.String(), domain.ReadWriteScopes(), "sk", "test", "v1", name, nil)
+	require.NoError(t, err)
+	return key, ownerID
+}
+
+func TestListSharing_RolesControlAccess(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	ownerKey, _ := createTenantKey(t, ts, "owner")
+	memberKey, memberID := createTenantKey(t, ts, "member")
+
+	w := doTenantRequest(t, ts, ownerKey, http.MethodPost, "/api/v1/lists", openapi.CreateListRequest{Title: "Shared list"})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created openapi.CreateListResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	require.NotNil(t, created.List)
+	listID := created.List.Id.String()
+
+	listPath := fmt.Sprintf("/api/v1/lists/%s", listID)
+	itemsPath := listPath + "/items"
+	membersPath := listPath + "/members"
+	memberPath := fmt.Sprintf("%s/%s", membersPath, memberID)
+
+	// Not shared yet: the list does not exist for the member
+	w = doTenantRequest(t, ts, memberKey, http.MethodGet, listPath, nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+
+	// Share as viewer
+	w = doTenantRequest(t, ts, ownerKey, http.MethodPost, membersPath,
+		openapi.AddListMemberRequest{PrincipalId: memberID, Role: openapi.Viewer})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	// Sharing twice conflicts
+	w = doTenantRequest(t, ts, ownerKey, http.MethodPost, membersPath,
+		openapi.AddListMemberRequest{PrincipalId: memberID, Role: openapi.Editor})
+	assert.Equal(t, http.StatusConflict, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, memberKey, http.MethodGet, listPath, nil)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, memberKey, http.MethodGet, "/api/v1/lists", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), listID)
+
+	// Viewers cannot write or manage members
+	w = doTenantRequest(t, ts, memberKey, http.MethodPost, itemsPath, openapi.CreateItemRequest{Title: "not allowed"})
+	assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, memberKey, http.MethodPatch, memberPath, openapi.UpdateListMemberRequest{Role: openapi.Editor})
+	assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+
+	// Promote to editor
+	w = doTenantRequest(t, ts, ownerKey, http.MethodPatch, memberPath, openapi.UpdateListMemberRequest{Role: openapi.Editor})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, memberKey, http.MethodPost, itemsPath, openapi.CreateItemRequest{Title: "shared item"})
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, memberKey, http.MethodGet, membersPath, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), `"role":"editor"`)
+
+	// Revoke
+	w = doTenantRequest(t, ts, ownerKey, http.MethodDelete, memberPath, nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, memberKey, http.MethodGet, listPath, nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
+
+func TestListSharing_InvalidRoleIsRejected(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	_, memberID := createTenantKey(t, ts, "member")
+	list := createTestList(t, ts, "Owned list")
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost,
+		fmt.Sprintf("/api/v1/lists/%s/members", list.Id),
+		map[string]any{"principal_id": memberID.String(), "role": "owner"})
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+}
@@ -2,7 +2,6 @@ package http_test
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -93,7 +92,7 @@ func TestGetList(t *testing.T) {
 	defer ts.Cleanup()
 
 	// Create a list first
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	list, err := ts.TodoService.CreateList(ctx, "Test List")
 	require.NoError(t, err)
 
@@ -143,7 +142,7 @@ func TestListLists(t *testing.T) {
 	defer ts.Cleanup()
 
 	// Create multiple lists
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	_, err := ts.TodoService.CreateList(ctx, "List 1")
 	require.NoError(t, err)
 	_, err = ts.TodoService.CreateList(ctx, "List 2")
@@ -174,7 +173,7 @@ func TestListLists_Pagination(t *testing.T) {
 	defer ts.Cleanup()
 
 	// Create many lists to test pagination
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	for i := range 60 {
 		_, err := ts.TodoService.CreateList(ctx, fmt.Sprintf("List %d", i))
 		require.NoError(t, err)
@@ -203,7 +202,7 @@ func TestListLists_PageTokenPagination(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 	titles := []string{"HTTP Pagination A", "HTTP Pagination B", "HTTP Pagination C"}
 	for _, title := range titles {
 		_, err := ts.TodoService.CreateList(ctx, title)
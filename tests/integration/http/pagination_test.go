@@ -1,11 +1,11 @@
 package http_test
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/rezkam/mono/internal/application/todo"
@@ -24,7 +24,7 @@ func TestListTasks_DefaultPageSizeFromService(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list
 	list, err := ts.TodoService.CreateList(ctx, "Pagination Test List")
@@ -74,7 +74,7 @@ func TestListTasks_PageSizeExceedingMaxRejected(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list
 	list, err := ts.TodoService.CreateList(ctx, "Max Page Size Test List")
@@ -103,7 +103,7 @@ func TestListTasks_ExplicitPageSizeRespected(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list with items
 	list, err := ts.TodoService.CreateList(ctx, "Explicit Page Size Test")
@@ -135,3 +135,119 @@ func TestListTasks_ExplicitPageSizeRespected(t *testing.T) {
 		"expected requested page size (%d), but got %d",
 		requestedSize, len(*resp.Items))
 }
+
+// getItemsPage requests one page of items and decodes it.
+func getItemsPage(t *testing.T, ts *TestServer, path string) openapi.ListItemsResponse {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("Authorization", "Bearer "+ts.APIKey)
+
+	w := httptest.NewRecorder()
+	ts.Router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var resp openapi.ListItemsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.NotNil(t, resp.Items)
+	return resp
+}
+
+// TestListTasks_PageTokenSurvivesInsertsBetweenPages verifies that items created
+// while paging do not shift the next page: no item is skipped or returned twice.
+func TestListTasks_PageTokenSurvivesInsertsBetweenPages(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	ctx := ts.OwnerContext()
+	list, err := ts.TodoService.CreateList(ctx, "Keyset Test List")
+	require.NoError(t, err)
+
+	for i := range 6 {
+		_, err := ts.TodoService.CreateItem(ctx, list.ID, &domain.TodoItem{
+			Title: fmt.Sprintf("Item %02d", i+1),
+		})
+		require.NoError(t, err)
+	}
+
+	// Oldest first, so new items land after the pages being read
+	path := fmt.Sprintf("/api/v1/lists/%s/items?page_size=3&sort_by=created_at&sort_dir=asc", list.ID)
+	page1 := getItemsPage(t, ts, path)
+	require.Len(t, *page1.Items, 3)
+	require.NotNil(t, page1.NextPageToken)
+
+	// With offsets, deleting a first-page item would skip an item on the next
+	// page. The new item sorts last and must appear exactly once.
+	require.NoError(t, ts.TodoService.DeleteItem(ctx, list.ID, (*page1.Items)[0].Id.String()))
+	_, err = ts.TodoService.CreateItem(ctx, list.ID, &domain.TodoItem{Title: "Item 07"})
+	require.NoError(t, err)
+
+	page2 := getItemsPage(t, ts, path+"&page_token="+url.QueryEscape(*page1.NextPageToken))
+	require.NotNil(t, page2.NextPageToken)
+	page3 := getItemsPage(t, ts, path+"&page_token="+url.QueryEscape(*page2.NextPageToken))
+	assert.Nil(t, page3.NextPageToken)
+
+	var titles []string
+	for _, page := range []openapi.ListItemsResponse{page1, page2, page3} {
+		for _, item := range *page.Items {
+			titles = append(titles, *item.Title)
+		}
+	}
+	assert.Equal(t, []string{
+		"Item 01", "Item 02", "Item 03", "Item 04", "Item 05", "Item 06", "Item 07",
+	}, titles)
+}
+
+// TestListTasks_PageTokenRejectedForOtherSort verifies that a page token cannot
+// continue a query with a different sort or filter than the one that issued it.
+func TestListTasks_PageTokenRejectedForOtherSort(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	ctx := ts.OwnerContext()
+	list, err := ts.TodoService.CreateList(ctx, "Token Mismatch Test List")
+	require.NoError(t, err)
+	for i := range 3 {
+		_, err := ts.TodoService.CreateItem(ctx, list.ID, &domain.TodoItem{
+			Title: fmt.Sprintf("Item %02d", i+1),
+		})
+		require.NoError(t, err)
+	}
+
+	page := getItemsPage(t, ts, fmt.Sprintf("/api/v1/lists/%s/items?page_size=1&sort_by=due_at", list.ID))
+	require.NotNil(t, page.NextPageToken)
+	token := url.QueryEscape(*page.NextPageToken)
+
+	for _, query := range []string{"sort_by=priority", "sort_by=due_at&status=done"} {
+		req := httptest.NewRequest(http.MethodGet,
+			fmt.Sprintf("/api/v1/lists/%s/items?page_size=1&%s&page_token=%s", list.ID, query, token), nil)
+		req.Header.Set("Authorization", "Bearer "+ts.APIKey)
+
+		w := httptest.NewRecorder()
+		ts.Router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
+
+// TestListTasks_TotalCountOnlyWhenRequested verifies that total_count is
+// omitted by default and returned when include_total_count is set.
+func TestListTasks_TotalCountOnlyWhenRequested(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	ctx := ts.OwnerContext()
+	list, err := ts.TodoService.CreateList(ctx, "Total Count Test List")
+	require.NoError(t, err)
+	for i := range 5 {
+		_, err := ts.TodoService.CreateItem(ctx, list.ID, &domain.TodoItem{
+			Title: fmt.Sprintf("Item %02d", i+1),
+		})
+		require.NoError(t, err)
+	}
+
+	path := fmt.Sprintf("/api/v1/lists/%s/items?page_size=2", list.ID)
+	assert.Nil(t, getItemsPage(t, ts, path).TotalCount)
+
+	counted := getItemsPage(t, ts, path+"&include_total_count=true")
+	require.NotNil(t, counted.TotalCount)
+	assert.Equal(t, 5, *counted.TotalCount)
+}
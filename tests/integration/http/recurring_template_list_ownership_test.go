@@ -2,7 +2,6 @@ package http_test
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -97,7 +96,7 @@ func TestRecurringTemplate_UpdateViaWrongList_ReturnsNotFound(t *testing.T) {
 	assert.Equal(t, "recurring template not found", *resp.Error.Message)
 
 	// Verify the template was NOT modified
-	originalTemplate, err := ts.TodoService.FindRecurringTemplateByID(context.Background(), listA.Id.String(), template.Id.String())
+	originalTemplate, err := ts.TodoService.FindRecurringTemplateByID(ts.OwnerContext(), listA.Id.String(), template.Id.String())
 	require.NoError(t, err)
 	assert.Equal(t, "Template in List A", originalTemplate.Title,
 		"Template should not have been modified via wrong list")
@@ -133,7 +132,7 @@ func TestRecurringTemplate_DeleteViaWrongList_ReturnsNotFound(t *testing.T) {
 	assert.Equal(t, "recurring template not found", *resp.Error.Message)
 
 	// Verify the template was NOT deleted
-	_, err := ts.TodoService.FindRecurringTemplateByID(context.Background(), listA.Id.String(), template.Id.String())
+	_, err := ts.TodoService.FindRecurringTemplateByID(ts.OwnerContext(), listA.Id.String(), template.Id.String())
 	require.NoError(t, err, "Template should still exist - it should not have been deleted via wrong list")
 }
 
@@ -174,7 +173,7 @@ func createTestRecurringTemplate(t *testing.T, ts *TestServer, listID, title str
 		RecurrenceConfig:  make(map[string]any),
 	}
 
-	created, err := ts.TodoService.CreateRecurringTemplate(context.Background(), template)
+	created, err := ts.TodoService.CreateRecurringTemplate(ts.OwnerContext(), template)
 	require.NoError(t, err, "Failed to create test recurring template")
 
 	// Map to openapi type for return
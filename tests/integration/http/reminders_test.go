@@ -0,0 +1,196 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/rezkam/mono/internal/application/auth"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Reminder tests.
+//
+// Relative reminders follow the item: moving the due time moves the fire time.
+// Reminders whose delivery fails for good land in the dead letter queue.
+
+func TestReminders_RelativeReminderFollowsDueTime(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Reminders")
+	due := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Second)
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items", list.Id),
+		openapi.CreateItemRequest{Title: "Dentist", DueAt: &due})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var createdItem openapi.CreateItemResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &createdItem))
+	itemPath := fmt.Sprintf("/api/v1/lists/%s/items/%s", list.Id, createdItem.Item.Id)
+	remindersPath := itemPath + "/reminders"
+
+	// Neither or both kinds of rule are rejected
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, remindersPath, map[string]any{})
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, remindersPath, map[string]any{
+		"remind_at": due.Format(time.RFC3339), "relative_to": "due_at", "before": "PT1H",
+	})
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+
+	anchor := openapi.ReminderAnchorDueAt
+	before := "PT1H"
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, remindersPath, openapi.CreateReminderRequest{RelativeTo: &anchor, Before: &before})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created openapi.ReminderResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	require.NotNil(t, created.Reminder.FireAt)
+	assert.True(t, due.Add(-time.Hour).Equal(*created.Reminder.FireAt))
+	assert.Equal(t, openapi.ReminderStatusPending, created.Reminder.Status)
+
+	// Move the due time: the reminder is rescheduled
+	newDue := due.Add(24 * time.Hour)
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, itemPath, openapi.UpdateItemRequest{
+		Item:       openapi.TodoItem{DueAt: &newDue},
+		UpdateMask: []openapi.UpdateItemRequestUpdateMask{openapi.UpdateItemRequestUpdateMaskDueAt},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, remindersPath, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var listed openapi.ListRemindersResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	require.Len(t, *listed.Reminders, 1)
+	require.NotNil(t, (*listed.Reminders)[0].FireAt)
+	assert.True(t, newDue.Add(-time.Hour).Equal(*(*listed.Reminders)[0].FireAt))
+
+	// Delete
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodDelete, fmt.Sprintf("%s/%s", remindersPath, created.Reminder.Id), nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodDelete, fmt.Sprintf("%s/%s", remindersPath, created.Reminder.Id), nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
+
+func TestReminders_StartsAtReminderUsesItemTimezone(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Reminders")
+	startsAt := time.Now().UTC().AddDate(0, 0, 7)
+	date := openapi_types.Date{Time: time.Date(startsAt.Year(), startsAt.Month(), startsAt.Day(), 0, 0, 0, 0, time.UTC)}
+
+	createReminder := func(timezone *string) openapi.Reminder {
+		t.Helper()
+
+		w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items", list.Id),
+			openapi.CreateItemRequest{Title: "Trip", StartsAt: &date, Timezone: timezone})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var item openapi.CreateItemResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &item))
+
+		anchor := openapi.ReminderAnchorStartsAt
+		before := "PT1H"
+		w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items/%s/reminders", list.Id, item.Item.Id),
+			openapi.CreateReminderRequest{RelativeTo: &anchor, Before: &before})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var created openapi.ReminderResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+		require.NotNil(t, created.Reminder.FireAt)
+		return *created.Reminder
+	}
+
+	// Fixed timezone: an hour before midnight of the start date in that zone
+	tz := "America/New_York"
+	loc, err := time.LoadLocation(tz)
+	require.NoError(t, err)
+	zoned := createReminder(&tz)
+	wantZoned := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc).Add(-time.Hour)
+	assert.True(t, wantZoned.Equal(*zoned.FireAt), "got %s, want %s", zoned.FireAt, wantZoned)
+
+	// Floating: midnight UTC
+	floating := createReminder(nil)
+	assert.True(t, date.Add(-time.Hour).Equal(*floating.FireAt), "got %s", floating.FireAt)
+}
+
+func TestReminders_DeadLetterRetryAndDiscard(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+	ctx := ts.OwnerContext()
+
+	list := createTestList(t, ts, "Reminders")
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items", list.Id),
+		openapi.CreateItemRequest{Title: "Renew passport"})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var item openapi.CreateItemResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &item))
+	remindersPath := fmt.Sprintf("/api/v1/lists/%s/items/%s/reminders", list.Id, item.Item.Id)
+
+	// Two reminders that are due now; both fail permanently
+	remindAt := time.Now().UTC().Add(-time.Minute)
+	for range 2 {
+		w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, remindersPath, openapi.CreateReminderRequest{RemindAt: &remindAt})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		claimed, err := ts.Coordinator.ClaimNextReminder(ctx, "worker-1", time.Minute)
+		require.NoError(t, err)
+		require.NotNil(t, claimed)
+		require.NoError(t, ts.Coordinator.MarkReminderDead(ctx, claimed, "worker-1", "notifier rejected the reminder"))
+	}
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, "/api/v1/admin/dead-letter-reminders", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var listed openapi.ListDeadLetterRemindersResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	require.Len(t, listed.Reminders, 2)
+	retried, discarded := listed.Reminders[0], listed.Reminders[1]
+	assert.Equal(t, openapi.DeadLetterReminderErrorType("permanent"), retried.ErrorType)
+	assert.Equal(t, *item.Item.Id, retried.ItemId)
+	assert.Equal(t, *list.Id, retried.ListId)
+	require.NotNil(t, retried.ErrorMessage)
+	assert.Equal(t, "notifier rejected the reminder", *retried.ErrorMessage)
+
+	// Another tenant neither sees nor resolves them
+	otherKey, err := auth.CreateAPIKey(ctx, ts.Store, "sk", "test", "v1", "tenant-b", nil)
+	require.NoError(t, err)
+	w = doTenantRequest(t, ts, otherKey, http.MethodGet, "/api/v1/admin/dead-letter-reminders", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var foreign openapi.ListDeadLetterRemindersResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &foreign))
+	assert.Empty(t, foreign.Reminders)
+	w = doTenantRequest(t, ts, otherKey, http.MethodPost, fmt.Sprintf("/api/v1/admin/dead-letter-reminders/%s/retry", retried.Id), nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+
+	// Retry re-arms the reminder
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/admin/dead-letter-reminders/%s/retry", retried.Id), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var retryResp openapi.RetryDeadLetterReminderResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &retryResp))
+	assert.Equal(t, retried.ReminderId, retryResp.ReminderId)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/admin/dead-letter-reminders/%s/retry", retried.Id), nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+
+	note := "notifier endpoint removed"
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/admin/dead-letter-reminders/%s/discard", discarded.Id),
+		openapi.DiscardDeadLetterReminderJSONRequestBody{Note: &note})
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, remindersPath, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var reminders openapi.ListRemindersResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &reminders))
+	statuses := make(map[openapi_types.UUID]openapi.ReminderStatus)
+	for _, reminder := range *reminders.Reminders {
+		statuses[reminder.Id] = reminder.Status
+	}
+	assert.Equal(t, openapi.ReminderStatusPending, statuses[retried.ReminderId])
+	assert.Equal(t, openapi.ReminderStatusDead, statuses[discarded.ReminderId])
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, "/api/v1/admin/dead-letter-reminders", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	assert.Empty(t, listed.Reminders)
+}
@@ -0,0 +1,68 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/application/auth"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// API key scope tests.
+//
+// Every operation declares the scopes it requires in the OpenAPI spec.
+// Keys missing one of them get 403 with a FORBIDDEN error body.
+
+func createScopedKey(t *testing.T, ts *TestServer, scopes []domain.Scope) string {
+	t.Helper()
+
+	ownerID, err := uuid.NewV7()
+	require.NoError(t, err)
+	key, err := auth.CreateAPIKeyForOwner(context.Background(), ts.Store, ownerID.String(), scopes, "sk", "test", "v1", "scoped-key", nil)
+	require.NoError(t, err)
+	return key
+}
+
+func TestScopes_ReadOnlyKeyCannotWrite(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	readOnlyKey := createScopedKey(t, ts, domain.ReadOnlyScopes())
+
+	w := doTenantRequest(t, ts, readOnlyKey, http.MethodGet, "/api/v1/lists", nil)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, readOnlyKey, http.MethodPost, "/api/v1/lists", openapi.CreateListRequest{Title: "not allowed"})
+	require.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+
+	var errResp openapi.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errResp))
+	require.NotNil(t, errResp.Error)
+	require.NotNil(t, errResp.Error.Code)
+	assert.Equal(t, "FORBIDDEN", *errResp.Error.Code)
+}
+
+func TestScopes_AdminEndpointsRequireAdminScope(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	// Default keys can manage data but not reach the admin endpoints
+	readWriteKey, err := auth.CreateAPIKey(context.Background(), ts.Store, "sk", "test", "v1", "read-write", nil)
+	require.NoError(t, err)
+
+	w := doTenantRequest(t, ts, readWriteKey, http.MethodPost, "/api/v1/lists", openapi.CreateListRequest{Title: "allowed"})
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, readWriteKey, http.MethodGet, "/api/v1/admin/dead-letter-jobs", nil)
+	assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+
+	adminKey := createScopedKey(t, ts, domain.AdminScopes())
+	w = doTenantRequest(t, ts, adminKey, http.MethodGet, "/api/v1/admin/dead-letter-jobs", nil)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+}
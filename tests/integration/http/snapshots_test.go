@@ -0,0 +1,90 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/application/worker"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// List snapshot tests.
+//
+// GET /v1/lists/{list_id}/snapshots returns daily item counts of a list. Days
+// the snapshot worker recorded come from list_snapshots; other days are
+// reconstructed from status history.
+
+func getListSnapshots(t *testing.T, ts *TestServer, listID, query string) openapi.ListSnapshots {
+	t.Helper()
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/snapshots?%s", listID, query), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var series openapi.ListSnapshots
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &series))
+	return series
+}
+
+func TestListSnapshots_RecordedAndBackfilled(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Sprint")
+	listID := list.Id.String()
+	priority := openapi.ItemPriority("high")
+	yesterday := time.Now().UTC().AddDate(0, 0, -1)
+	for _, req := range []openapi.CreateItemRequest{
+		{Title: "Ship login", Priority: &priority},
+		{Title: "Fix build", DueAt: &yesterday},
+	} {
+		w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items", listID), req)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	// Before the worker runs, today is reconstructed from history
+	series := getListSnapshots(t, ts, listID, "")
+	require.Len(t, series.Snapshots, 30)
+	today := series.Snapshots[29]
+	assert.Equal(t, time.Now().UTC().Format(time.DateOnly), today.Date.String())
+	assert.True(t, today.Backfilled)
+	assert.Equal(t, 2, today.StatusCounts.Todo)
+	assert.Equal(t, 2, today.Open)
+	assert.Equal(t, 1, today.Overdue)
+	assert.Equal(t, 1, today.PriorityCounts.High)
+	assert.Equal(t, 1, today.PriorityCounts.None)
+	// Before the items existed
+	assert.Zero(t, series.Snapshots[0].Open)
+
+	cfg := worker.DefaultSnapshotConfig("test-worker")
+	recorded, err := worker.NewSnapshotWorker(ts.Coordinator, cfg).RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, recorded, 1)
+
+	series = getListSnapshots(t, ts, listID, "from="+time.Now().UTC().Format(time.DateOnly))
+	require.Len(t, series.Snapshots, 1)
+	today = series.Snapshots[0]
+	assert.False(t, today.Backfilled)
+	assert.Equal(t, 2, today.StatusCounts.Todo)
+	assert.Equal(t, 1, today.Overdue)
+	assert.Equal(t, 1, today.PriorityCounts.High)
+}
+
+func TestListSnapshots_Validation(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Sprint")
+	for _, query := range []string{"from=2026-13-01", "from=2024-01-01&to=2026-01-01", "from=2026-03-10&to=2026-03-01"} {
+		w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/snapshots?%s", list.Id, query), nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+
+	fixture := setupTenantFixture(t, ts)
+	w := doTenantRequest(t, ts, fixture.otherKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/snapshots", fixture.listID), nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
@@ -0,0 +1,197 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/application/worker"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Snooze tests.
+//
+// A snoozed item is left out of default listings until its snooze passes;
+// the worker then wakes it, which publishes item.woke.
+
+func listItemTitles(t *testing.T, ts *TestServer, path string) []string {
+	t.Helper()
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, path, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var resp openapi.ListItemsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	var titles []string
+	for _, item := range *resp.Items {
+		titles = append(titles, *item.Title)
+	}
+	return titles
+}
+
+func TestSnoozeItem_HidesItemUntilWoken(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Home")
+	listID := list.Id.String()
+	itemID := createTimeTrackingItem(t, ts, listID, "Clean gutters")
+	createTimeTrackingItem(t, ts, listID, "Buy milk")
+
+	due := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Second)
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, fmt.Sprintf("/api/v1/lists/%s/items/%s", listID, itemID), openapi.UpdateItemRequest{
+		Item:       openapi.TodoItem{DueAt: &due},
+		UpdateMask: []openapi.UpdateItemRequestUpdateMask{"due_at"},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	until := time.Now().UTC().Add(72 * time.Hour).Truncate(time.Second)
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items/%s/snooze", listID, itemID), openapi.SnoozeItemRequest{Until: until})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var snoozed openapi.UpdateItemResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &snoozed))
+	require.NotNil(t, snoozed.Item.SnoozedUntil)
+	assert.True(t, snoozed.Item.SnoozedUntil.Equal(until))
+	// Snoozing leaves the schedule alone
+	require.NotNil(t, snoozed.Item.DueAt)
+	assert.True(t, snoozed.Item.DueAt.Equal(due))
+
+	assert.Equal(t, []string{"Buy milk"}, listItemTitles(t, ts, fmt.Sprintf("/api/v1/lists/%s/items", listID)))
+	assert.ElementsMatch(t, []string{"Buy milk", "Clean gutters"}, listItemTitles(t, ts, fmt.Sprintf("/api/v1/lists/%s/items?include_snoozed=true", listID)))
+	assert.Equal(t, []string{"Buy milk"}, listItemTitles(t, ts, "/api/v1/items?list_id="+listID))
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodDelete, fmt.Sprintf("/api/v1/lists/%s/items/%s/snooze", listID, itemID), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var woken openapi.UpdateItemResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &woken))
+	assert.Nil(t, woken.Item.SnoozedUntil)
+
+	assert.ElementsMatch(t, []string{"Buy milk", "Clean gutters"}, listItemTitles(t, ts, fmt.Sprintf("/api/v1/lists/%s/items", listID)))
+}
+
+func TestSnoozeItem_WorkerWakesPassedSnoozes(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+	ctx := ts.OwnerContext()
+
+	list := createTestList(t, ts, "Home")
+	listID := list.Id.String()
+	itemID := createTimeTrackingItem(t, ts, listID, "Clean gutters")
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items/%s/snooze", listID, itemID), openapi.SnoozeItemRequest{
+		Until: time.Now().UTC().Add(time.Hour),
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// Nothing to wake until the snooze passes
+	snoozeWorker := worker.NewSnoozeWorker(ts.Coordinator, worker.DefaultSnoozeConfig("test-worker"))
+	woken, err := snoozeWorker.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, woken)
+
+	_, err = ts.Store.Pool().Exec(ctx, "UPDATE todo_items SET snoozed_until = NOW() - INTERVAL '1 minute' WHERE id = $1", itemID)
+	require.NoError(t, err)
+	// A passed snooze no longer hides the item, even before the worker runs
+	assert.Equal(t, []string{"Clean gutters"}, listItemTitles(t, ts, fmt.Sprintf("/api/v1/lists/%s/items", listID)))
+
+	woken, err = snoozeWorker.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, woken)
+
+	changes, err := ts.Coordinator.ListUnpublishedChanges(ctx, 100)
+	require.NoError(t, err)
+	var wokeEvents int
+	for _, change := range changes {
+		if change.EntityID == itemID && slices.Contains(change.EventTypes(), domain.EventItemWoke) {
+			wokeEvents++
+		}
+	}
+	assert.Equal(t, 1, wokeEvents)
+}
+
+func TestSnoozeItem_HiddenFromViewsUnlessIncluded(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Home")
+	listID := list.Id.String()
+	itemID := createTimeTrackingItem(t, ts, listID, "Clean gutters")
+	createTimeTrackingItem(t, ts, listID, "Buy milk")
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items/%s/snooze", listID, itemID), openapi.SnoozeItemRequest{
+		Until: time.Now().UTC().Add(72 * time.Hour),
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	view := createTestView(t, ts, openapi.CreateViewRequest{Name: "Home", ListId: list.Id})
+	assert.False(t, view.IncludeSnoozed)
+	viewPath := fmt.Sprintf("/api/v1/views/%s", view.Id)
+	assert.Equal(t, []string{"Buy milk"}, listItemTitles(t, ts, viewPath+"/items"))
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, viewPath, openapi.UpdateViewRequest{
+		IncludeSnoozed: ptr.To(true),
+		UpdateMask:     []openapi.UpdateViewRequestUpdateMask{openapi.UpdateViewRequestUpdateMaskIncludeSnoozed},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var updated openapi.ViewResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &updated))
+	assert.True(t, updated.View.IncludeSnoozed)
+	assert.ElementsMatch(t, []string{"Buy milk", "Clean gutters"}, listItemTitles(t, ts, viewPath+"/items"))
+
+	// The opt-in needs a value
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, viewPath, openapi.UpdateViewRequest{
+		UpdateMask: []openapi.UpdateViewRequestUpdateMask{openapi.UpdateViewRequestUpdateMaskIncludeSnoozed},
+	})
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+}
+
+func TestSnoozeItem_HiddenFromAgenda(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Home")
+	listID := list.Id.String()
+	now := time.Now().UTC()
+	due := now.AddDate(0, 0, 1)
+	var itemID string
+	for _, title := range []string{"Clean gutters", "Buy milk"} {
+		w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items", listID),
+			openapi.CreateItemRequest{Title: title, DueAt: &due})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var created openapi.CreateItemResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+		if itemID == "" {
+			itemID = created.Item.Id.String()
+		}
+	}
+
+	query := "tz=UTC&date=" + now.Format(time.DateOnly)
+	assert.ElementsMatch(t, []string{"Clean gutters", "Buy milk"}, agendaTitles(getAgenda(t, ts, ts.APIKey, query).Upcoming))
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items/%s/snooze", listID, itemID), openapi.SnoozeItemRequest{
+		Until: now.Add(72 * time.Hour),
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	assert.Equal(t, []string{"Buy milk"}, agendaTitles(getAgenda(t, ts, ts.APIKey, query).Upcoming))
+}
+
+func TestSnoozeItem_RejectsPastTime(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Home")
+	listID := list.Id.String()
+	itemID := createTimeTrackingItem(t, ts, listID, "Clean gutters")
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items/%s/snooze", listID, itemID), openapi.SnoozeItemRequest{
+		Until: time.Now().UTC().Add(-time.Hour),
+	})
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+}
@@ -0,0 +1,107 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// List stats tests.
+//
+// GET /v1/lists/{list_id}/stats computes flow metrics of a list from the status
+// history of its items, optionally grouped by tag, priority or week.
+
+func getListStats(t *testing.T, ts *TestServer, listID, query string) openapi.ListStats {
+	t.Helper()
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/stats?%s", listID, query), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var stats openapi.ListStats
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &stats))
+	return stats
+}
+
+func TestListStats_CountsFlow(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Sprint")
+	listID := list.Id.String()
+	create := func(title string, tags []string) string {
+		w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items", listID),
+			openapi.CreateItemRequest{Title: title, Tags: &tags, EstimatedDuration: ptr.To("PT1H")})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var resp openapi.CreateItemResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp.Item.Id.String()
+	}
+	setStatus := func(itemID string, status domain.TaskStatus) {
+		itemStatus := openapi.ItemStatus(status)
+		w := doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, fmt.Sprintf("/api/v1/lists/%s/items/%s", listID, itemID), openapi.UpdateItemRequest{
+			Item:       openapi.TodoItem{Status: &itemStatus},
+			UpdateMask: []openapi.UpdateItemRequestUpdateMask{"status"},
+		})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	}
+
+	shipped := create("Ship login", []string{"backend"})
+	create("Design page", []string{"frontend"})
+	setStatus(shipped, domain.TaskStatusInProgress)
+	setStatus(shipped, domain.TaskStatusDone)
+
+	stats := getListStats(t, ts, listID, "group_by=tag")
+	require.NotNil(t, stats.GroupBy)
+	assert.Equal(t, openapi.StatsGroupByTag, *stats.GroupBy)
+	assert.Equal(t, 2, stats.Total.Created)
+	assert.Equal(t, 1, stats.Total.Completed)
+	assert.Equal(t, 1, stats.Total.LeadTime.Count)
+	assert.NotNil(t, stats.Total.LeadTime.P50)
+	assert.Equal(t, 1, stats.Total.CycleTime.Count)
+	assert.False(t, stats.Truncated)
+
+	require.Len(t, stats.Groups, 2)
+	assert.Equal(t, "backend", stats.Groups[0].Key)
+	assert.Equal(t, 1, stats.Groups[0].Completed)
+	assert.Equal(t, "frontend", stats.Groups[1].Key)
+	assert.Equal(t, 0, stats.Groups[1].Completed)
+	assert.Zero(t, stats.Groups[1].LeadTime.Count)
+	assert.Nil(t, stats.Groups[1].LeadTime.P50)
+
+	// Without group_by there are only totals
+	stats = getListStats(t, ts, listID, "")
+	assert.Nil(t, stats.GroupBy)
+	assert.Empty(t, stats.Groups)
+	assert.NotNil(t, stats.Groups)
+}
+
+func TestListStats_Validation(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Sprint")
+	for _, query := range []string{
+		"group_by=status",
+		"tz=Mars/Olympus_Mons",
+		"from=2026-03-10T00:00:00Z&to=2026-03-01T00:00:00Z",
+		"from=2024-01-01T00:00:00Z&to=2026-01-01T00:00:00Z",
+	} {
+		w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/stats?%s", list.Id, query), nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
+
+func TestListStats_OtherTenant(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	fixture := setupTenantFixture(t, ts)
+	w := doTenantRequest(t, ts, fixture.otherKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/stats", fixture.listID), nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
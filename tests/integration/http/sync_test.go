@@ -0,0 +1,54 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSync_ReturnsOnlyAccessibleLists(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	ownerKey, _ := createTenantKey(t, ts, "owner")
+	otherKey, _ := createTenantKey(t, ts, "other")
+
+	w := doTenantRequest(t, ts, ownerKey, http.MethodPost, "/api/v1/lists", openapi.CreateListRequest{Title: "Mine"})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created openapi.CreateListResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+
+	w = doTenantRequest(t, ts, ownerKey, http.MethodGet, "/api/v1/sync", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var synced openapi.SyncResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &synced))
+	require.Len(t, synced.Lists, 1)
+	assert.Equal(t, created.List.Id, synced.Lists[0].Id)
+	assert.NotEmpty(t, synced.Cursor)
+
+	w = doTenantRequest(t, ts, otherKey, http.MethodGet, "/api/v1/sync", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var other openapi.SyncResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &other))
+	assert.Empty(t, other.Lists, "lists of other tenants must not be synced")
+
+	// Syncing again from the returned cursor reports nothing new
+	w = doTenantRequest(t, ts, ownerKey, http.MethodGet, "/api/v1/sync?cursor="+url.QueryEscape(synced.Cursor), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var again openapi.SyncResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &again))
+	assert.Empty(t, again.Lists)
+}
+
+func TestSync_RejectsInvalidCursor(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, "/api/v1/sync?cursor=garbage", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+}
@@ -0,0 +1,196 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Tag tests.
+//
+// Registered tags are listed with how many items use them; tags can also be
+// used unregistered. Rename and merge rewrite every item of the list in one
+// transaction.
+
+func createTaggedItem(t *testing.T, ts *TestServer, listID, title string, tags ...string) string {
+	t.Helper()
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items", listID), openapi.CreateItemRequest{Title: title, Tags: &tags})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created openapi.CreateItemResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	return created.Item.Id.String()
+}
+
+func listTags(t *testing.T, ts *TestServer, listID string) map[string]openapi.ListTag {
+	t.Helper()
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/tags", listID), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var resp openapi.ListTagsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	tags := make(map[string]openapi.ListTag)
+	for _, tag := range *resp.Tags {
+		tags[tag.Name] = tag
+	}
+	return tags
+}
+
+func itemTags(t *testing.T, ts *TestServer, listID, itemID string) []string {
+	t.Helper()
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/items?include_snoozed=true", listID), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var listed openapi.ListItemsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	for _, item := range *listed.Items {
+		if item.Id != nil && item.Id.String() == itemID {
+			if item.Tags == nil {
+				return nil
+			}
+			return *item.Tags
+		}
+	}
+	t.Fatalf("item %s not listed", itemID)
+	return nil
+}
+
+func TestTags_RegistryListsUsage(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Work")
+	listID := list.Id.String()
+	createTaggedItem(t, ts, listID, "Draft report", "urgent", "writing")
+	createTaggedItem(t, ts, listID, "Call client", "urgent")
+
+	color := "#FF0000"
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/tags", listID), openapi.CreateTagRequest{Name: "urgent", Color: &color})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created openapi.TagResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	require.NotNil(t, created.Tag.Color)
+	assert.Equal(t, "#ff0000", *created.Tag.Color)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/tags", listID), openapi.CreateTagRequest{Name: "urgent"})
+	assert.Equal(t, http.StatusConflict, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/tags", listID), openapi.CreateTagRequest{Name: "someday"})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	// writing is only in use, so it is not listed
+	tags := listTags(t, ts, listID)
+	require.Len(t, tags, 2)
+	assert.Equal(t, 2, tags["urgent"].ItemCount)
+	require.NotNil(t, tags["urgent"].Color)
+	assert.Equal(t, "#ff0000", *tags["urgent"].Color)
+	assert.Equal(t, 0, tags["someday"].ItemCount)
+
+	description := "Needs attention today"
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, fmt.Sprintf("/api/v1/lists/%s/tags/urgent", listID), openapi.UpdateTagRequest{
+		Description: &description,
+		UpdateMask:  []openapi.UpdateTagRequestUpdateMask{openapi.Description},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var updated openapi.TagResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &updated))
+	require.NotNil(t, updated.Tag.Description)
+	assert.Equal(t, description, *updated.Tag.Description)
+	require.NotNil(t, updated.Tag.Color)
+	assert.Equal(t, "#ff0000", *updated.Tag.Color)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodDelete, fmt.Sprintf("/api/v1/lists/%s/tags/someday", listID), nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodDelete, fmt.Sprintf("/api/v1/lists/%s/tags/writing", listID), nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+
+	assert.NotContains(t, listTags(t, ts, listID), "someday")
+}
+
+func TestTags_RenameRewritesItems(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Work")
+	listID := list.Id.String()
+	first := createTaggedItem(t, ts, listID, "Draft report", "wip", "writing")
+	second := createTaggedItem(t, ts, listID, "Call client", "wip")
+	createTaggedItem(t, ts, listID, "Plan sprint", "planning")
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/tags/wip/rename", listID), openapi.RenameTagRequest{Name: "planning"})
+	assert.Equal(t, http.StatusConflict, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/tags/wip/rename", listID), openapi.RenameTagRequest{Name: "in-progress"})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var rewrite openapi.TagRewriteResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &rewrite))
+	assert.Equal(t, "in-progress", rewrite.Tag)
+	assert.Equal(t, 2, rewrite.ItemsUpdated)
+	assert.Equal(t, 0, rewrite.TemplatesUpdated)
+
+	// Renaming keeps the position of the tag
+	assert.Equal(t, []string{"in-progress", "writing"}, itemTags(t, ts, listID, first))
+	assert.Equal(t, []string{"in-progress"}, itemTags(t, ts, listID, second))
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/tags/wip/rename", listID), openapi.RenameTagRequest{Name: "doing"})
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
+
+func TestTags_MergeDeduplicatesItems(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Home")
+	listID := list.Id.String()
+	both := createTaggedItem(t, ts, listID, "Fix sink", "errand", "chore")
+	single := createTaggedItem(t, ts, listID, "Buy bulbs", "errands")
+
+	color := "#00aa00"
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/tags", listID), openapi.CreateTagRequest{Name: "errand", Color: &color})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/tags/chore/merge", listID), openapi.MergeTagsRequest{Sources: []string{"errand", "errands"}})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var rewrite openapi.TagRewriteResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &rewrite))
+	assert.Equal(t, "chore", rewrite.Tag)
+	assert.Equal(t, 2, rewrite.ItemsUpdated)
+
+	assert.Equal(t, []string{"chore"}, itemTags(t, ts, listID, both))
+	assert.Equal(t, []string{"chore"}, itemTags(t, ts, listID, single))
+
+	// The target takes over the registration of the merged tag
+	tags := listTags(t, ts, listID)
+	require.Len(t, tags, 1)
+	require.NotNil(t, tags["chore"].Color)
+	assert.Equal(t, color, *tags["chore"].Color)
+	assert.Equal(t, 2, tags["chore"].ItemCount)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/tags/chore/merge", listID), openapi.MergeTagsRequest{Sources: []string{"chore"}})
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+}
+
+func TestTags_Validation(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Work")
+	listID := list.Id.String()
+
+	color := "red"
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/tags", listID), openapi.CreateTagRequest{Name: "urgent", Color: &color})
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/tags", listID), openapi.CreateTagRequest{Name: "   "})
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, fmt.Sprintf("/api/v1/lists/%s/tags/missing", listID), openapi.UpdateTagRequest{
+		UpdateMask: []openapi.UpdateTagRequestUpdateMask{openapi.Color},
+	})
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
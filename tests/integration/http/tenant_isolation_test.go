@@ -0,0 +1,177 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/rezkam/mono/internal/application/auth"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Tenant isolation tests.
+//
+// Every API key belongs to an owner (tenant). A key must never be able to read
+// or modify lists, items or recurring templates of another tenant. Cross-tenant
+// access is reported as 404 so that the existence of foreign resources is not leaked.
+
+// tenantFixture holds data owned by tenant A and a key for an unrelated tenant B.
+type tenantFixture struct {
+	listID     string
+	itemID     string
+	templateID string
+	otherKey   string
+}
+
+func setupTenantFixture(t *testing.T, ts *TestServer) tenantFixture {
+	t.Helper()
+
+	list := createTestList(t, ts, "Tenant A list")
+	listID := list.Id.String()
+
+	// Create item as tenant A via HTTP
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost,
+		fmt.Sprintf("/api/v1/lists/%s/items", listID),
+		openapi.CreateItemRequest{Title: "Tenant A item"})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var itemResp openapi.CreateItemResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &itemResp))
+	require.NotNil(t, itemResp.Item)
+
+	template := createTestRecurringTemplate(t, ts, listID, "Tenant A template")
+
+	// CreateAPIKey starts a new tenant for every key
+	otherKey, err := auth.CreateAPIKey(context.Background(), ts.Store, "sk", "test", "v1", "tenant-b", nil)
+	require.NoError(t, err)
+
+	return tenantFixture{
+		listID:     listID,
+		itemID:     itemResp.Item.Id.String(),
+		templateID: template.Id.String(),
+		otherKey:   otherKey,
+	}
+}
+
+func TestTenantIsolation_CrossTenantAccessReturnsNotFound(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	f := setupTenantFixture(t, ts)
+
+	listPath := fmt.Sprintf("/api/v1/lists/%s", f.listID)
+	itemPath := fmt.Sprintf("/api/v1/lists/%s/items/%s", f.listID, f.itemID)
+	templatePath := fmt.Sprintf("/api/v1/lists/%s/recurring-templates/%s", f.listID, f.templateID)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   any
+	}{
+		{"get list", http.MethodGet, listPath, nil},
+		{"update list", http.MethodPatch, listPath, openapi.UpdateListRequest{
+			List:       openapi.TodoList{Title: ptrString("hijacked")},
+			UpdateMask: []openapi.UpdateListRequestUpdateMask{openapi.UpdateListRequestUpdateMaskTitle},
+		}},
+		{"create item", http.MethodPost, listPath + "/items", openapi.CreateItemRequest{Title: "intruder"}},
+		{"update item", http.MethodPatch, itemPath, openapi.UpdateItemRequest{
+			Item:       openapi.TodoItem{Title: ptrString("hijacked")},
+			UpdateMask: []openapi.UpdateItemRequestUpdateMask{openapi.UpdateItemRequestUpdateMaskTitle},
+		}},
+		{"delete item", http.MethodDelete, itemPath, nil},
+		{"create template", http.MethodPost, listPath + "/recurring-templates", openapi.CreateRecurringTemplateRequest{
+			Title:             "intruder",
+			RecurrencePattern: openapi.Daily,
+		}},
+		{"get template", http.MethodGet, templatePath, nil},
+		{"update template", http.MethodPatch, templatePath, openapi.UpdateRecurringTemplateRequest{
+			Template:   openapi.RecurringItemTemplate{Title: ptrString("hijacked")},
+			UpdateMask: []openapi.UpdateRecurringTemplateRequestUpdateMask{openapi.UpdateRecurringTemplateRequestUpdateMaskTitle},
+		}},
+		{"delete template", http.MethodDelete, templatePath, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doTenantRequest(t, ts, f.otherKey, tt.method, tt.path, tt.body)
+			assert.Equal(t, http.StatusNotFound, w.Code,
+				"cross-tenant %s should return 404, got body: %s", tt.name, w.Body.String())
+		})
+	}
+
+	// Tenant A still sees its data unchanged
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, listPath, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var listResp openapi.GetListResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listResp))
+	require.NotNil(t, listResp.List)
+	assert.Equal(t, "Tenant A list", *listResp.List.Title)
+	require.NotNil(t, listResp.List.TotalItems)
+	assert.Equal(t, 1, *listResp.List.TotalItems, "item of tenant A must not be deleted by tenant B")
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, templatePath, nil)
+	assert.Equal(t, http.StatusOK, w.Code, "template of tenant A must not be deleted by tenant B")
+}
+
+func TestTenantIsolation_CollectionsExcludeOtherTenants(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	f := setupTenantFixture(t, ts)
+
+	// Lists of tenant A are not listed for tenant B
+	w := doTenantRequest(t, ts, f.otherKey, http.MethodGet, "/api/v1/lists", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var listsResp openapi.ListListsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listsResp))
+	require.NotNil(t, listsResp.Lists)
+	assert.Empty(t, *listsResp.Lists)
+
+	// Items of tenant A's list are not returned to tenant B
+	w = doTenantRequest(t, ts, f.otherKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/items", f.listID), nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var itemsResp openapi.ListItemsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &itemsResp))
+	require.NotNil(t, itemsResp.Items)
+	assert.Empty(t, *itemsResp.Items)
+
+	// Templates of tenant A's list are not returned to tenant B
+	w = doTenantRequest(t, ts, f.otherKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/recurring-templates", f.listID), nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var templatesResp openapi.ListRecurringTemplatesResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &templatesResp))
+	require.NotNil(t, templatesResp.Templates)
+	assert.Empty(t, *templatesResp.Templates)
+
+	// Tenant A still sees its own list
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, "/api/v1/lists", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listsResp))
+	require.NotNil(t, listsResp.Lists)
+	require.Len(t, *listsResp.Lists, 1)
+	assert.Equal(t, f.listID, (*listsResp.Lists)[0].Id.String())
+}
+
+func TestTenantIsolation_KeysOfSameOwnerShareData(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Shared tenant list")
+
+	// Look up the owner of the test key and issue a second key for it
+	var ownerID string
+	err := ts.Store.Pool().QueryRow(context.Background(),
+		"SELECT owner_id::text FROM todo_lists WHERE id = $1", list.Id.String()).Scan(&ownerID)
+	require.NoError(t, err)
+
+	secondKey, err := auth.CreateAPIKeyForOwner(context.Background(), ts.Store, ownerID, domain.ReadWriteScopes(), "sk", "test", "v1", "second", nil)
+	require.NoError(t, err)
+
+	w := doTenantRequest(t, ts, secondKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s", list.Id.String()), nil)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+}
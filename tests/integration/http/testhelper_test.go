@@ -1,19 +1,24 @@
 package http_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rezkam/mono/internal/application/auth"
 	"github.com/rezkam/mono/internal/application/todo"
 	"github.com/rezkam/mono/internal/config"
+	"github.com/rezkam/mono/internal/domain"
 	httpServer "github.com/rezkam/mono/internal/infrastructure/http"
 	"github.com/rezkam/mono/internal/infrastructure/http/handler"
 	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres"
 	"github.com/rezkam/mono/internal/recurring"
+	"github.com/stretchr/testify/require"
 )
 
 // TestServer holds the test HTTP server and its dependencies.
@@ -24,6 +29,7 @@ type TestServer struct {
 	Coordinator   *postgres.PostgresCoordinator
 	Authenticator *auth.Authenticator
 	APIKey        string
+	OwnerID       string // Tenant of APIKey
 	Cleanup       func()
 }
 
@@ -49,7 +55,9 @@ func SetupTestServer(t *testing.T) *TestServer {
 
 	// Create services
 	generator := recurring.NewDomainGenerator()
-	todoService := todo.NewService(store, generator, todo.Config{})
+	todoService := todo.NewService(store, generator, todo.Config{
+		Changes: postgres.NewChangeListener(store.Pool()),
+	})
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 	authenticator := auth.NewAuthenticator(store, auth.Config{OperationTimeout: 5 * time.Second})
 
@@ -73,8 +81,14 @@ func SetupTestServer(t *testing.T) *TestServer {
 	}
 	router := server.Handler()
 
-	// Generate test API key
-	apiKey, err := auth.CreateAPIKey(ctx, store, "sk", "test", "v1", "test-key", nil)
+	// Generate test API key with every scope so tests can reach all endpoints
+	ownerID, err := uuid.NewV7()
+	if err != nil {
+		cancel()
+		_ = store.Close()
+		t.Fatalf("failed to generate owner ID: %v", err)
+	}
+	apiKey, err := auth.CreateAPIKeyForOwner(ctx, store, ownerID.String(), domain.AdminScopes(), "sk", "test", "v1", "test-key", nil)
 	if err != nil {
 		cancel()
 		_ = store.Close()
@@ -88,7 +102,7 @@ func SetupTestServer(t *testing.T) *TestServer {
 		defer shutdownCancel()
 		authenticator.Shutdown(shutdownCtx)
 		// Truncate tables to ensure test isolation
-		_, _ = store.Pool().Exec(context.Background(), "TRUNCATE TABLE todo_items, todo_lists, task_status_history, recurring_task_templates, recurring_generation_jobs, api_keys CASCADE")
+		_, _ = store.Pool().Exec(context.Background(), "TRUNCATE TABLE todo_items, todo_lists, task_status_history, recurring_task_templates, recurring_generation_jobs, api_keys, webhook_subscriptions, change_log CASCADE")
 		_ = store.Close()
 	}
 
@@ -99,13 +113,47 @@ func SetupTestServer(t *testing.T) *TestServer {
 		Coordinator:   coordinator,
 		Authenticator: authenticator,
 		APIKey:        apiKey,
+		OwnerID:       ownerID.String(),
 		Cleanup:       cleanup,
 	}
 }
 
+// OwnerContext returns a context carrying the principal of the test API key,
+// for setting up data through the service that requests with the key can reach.
+func (ts *TestServer) OwnerContext() context.Context {
+	return domain.WithPrincipal(context.Background(), domain.Principal{
+		OwnerID: ts.OwnerID,
+		Scopes:  domain.AdminScopes(),
+	})
+}
+
 // NewRequest creates an httptest request with authentication header.
 func (ts *TestServer) NewRequest(method, path string, body any) *httptest.ResponseRecorder {
 	// This is a helper for creating authenticated requests
 	// Actual request creation happens in individual tests
 	return httptest.NewRecorder()
 }
+
+// doTenantRequest sends an authenticated JSON request through the router.
+func doTenantRequest(t *testing.T, ts *TestServer, apiKey, method, path string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+
+	w := httptest.NewRecorder()
+	ts.Router.ServeHTTP(w, req)
+	return w
+}
@@ -0,0 +1,167 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Time entry tests.
+//
+// Time entries are tracked per principal with a timer (one running at a time)
+// or added manually. An item's actual_duration is the sum of its finished
+// entries and falls back to the time spent in progress without entries.
+
+func createTimeTrackingItem(t *testing.T, ts *TestServer, listID, title string) string {
+	t.Helper()
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items", listID), openapi.CreateItemRequest{Title: title})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created openapi.CreateItemResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	return created.Item.Id.String()
+}
+
+func getActualDuration(t *testing.T, ts *TestServer, listID, itemID string) *string {
+	t.Helper()
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/items", listID), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var listed openapi.ListItemsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	for _, item := range *listed.Items {
+		if item.Id != nil && item.Id.String() == itemID {
+			return item.ActualDuration
+		}
+	}
+	t.Fatalf("item %s not listed", itemID)
+	return nil
+}
+
+func TestTimeEntries_TimerRunsOnOneItemAtATime(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Timesheet")
+	listID := list.Id.String()
+	first := createTimeTrackingItem(t, ts, listID, "Write spec")
+	second := createTimeTrackingItem(t, ts, listID, "Review spec")
+	firstPath := fmt.Sprintf("/api/v1/lists/%s/items/%s", listID, first)
+	secondPath := fmt.Sprintf("/api/v1/lists/%s/items/%s", listID, second)
+
+	// No timer yet
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, "/api/v1/timer", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var running openapi.TimeEntryResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &running))
+	assert.Nil(t, running.TimeEntry)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, firstPath+"/timer/stop", nil)
+	assert.Equal(t, http.StatusConflict, w.Code, w.Body.String())
+
+	notes := "outline"
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, firstPath+"/timer/start", openapi.TimerRequest{Notes: &notes})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var started openapi.StartTimerResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &started))
+	assert.True(t, started.TimeEntry.Running)
+	assert.Nil(t, started.StoppedTimeEntry)
+	assert.Equal(t, "outline", started.TimeEntry.Notes)
+
+	// Starting a timer on another item stops the first one
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, secondPath+"/timer/start", nil)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var switched openapi.StartTimerResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &switched))
+	require.NotNil(t, switched.StoppedTimeEntry)
+	assert.Equal(t, started.TimeEntry.Id, switched.StoppedTimeEntry.Id)
+	assert.False(t, switched.StoppedTimeEntry.Running)
+	require.NotNil(t, switched.StoppedTimeEntry.EndedAt)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, "/api/v1/timer", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &running))
+	require.NotNil(t, running.TimeEntry)
+	assert.Equal(t, switched.TimeEntry.Id, running.TimeEntry.Id)
+
+	// The first item's timer is no longer running
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, firstPath+"/timer/stop", nil)
+	assert.Equal(t, http.StatusConflict, w.Code, w.Body.String())
+
+	more := "comments sent"
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, secondPath+"/timer/stop", openapi.TimerRequest{Notes: &more})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var stopped openapi.TimeEntryResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &stopped))
+	require.NotNil(t, stopped.TimeEntry)
+	assert.False(t, stopped.TimeEntry.Running)
+	assert.Equal(t, "comments sent", stopped.TimeEntry.Notes)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, firstPath+"/time-entries", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var listed openapi.ListTimeEntriesResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	require.Len(t, *listed.TimeEntries, 1)
+	assert.Equal(t, started.TimeEntry.Id, (*listed.TimeEntries)[0].Id)
+}
+
+func TestTimeEntries_ManualEntriesSetActualDuration(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Timesheet")
+	listID := list.Id.String()
+	itemID := createTimeTrackingItem(t, ts, listID, "Client call")
+	entriesPath := fmt.Sprintf("/api/v1/lists/%s/items/%s/time-entries", listID, itemID)
+
+	end := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+
+	// Invalid periods are rejected
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, entriesPath,
+		openapi.CreateTimeEntryRequest{StartedAt: end, EndedAt: end.Add(-time.Minute)})
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, entriesPath,
+		openapi.CreateTimeEntryRequest{StartedAt: end, EndedAt: end.Add(2 * time.Hour)})
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+
+	notes := "kickoff"
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, entriesPath,
+		openapi.CreateTimeEntryRequest{StartedAt: end.Add(-90 * time.Minute), EndedAt: end, Notes: &notes})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created openapi.TimeEntryResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	require.NotNil(t, created.TimeEntry)
+	assert.Equal(t, "PT1H30M", created.TimeEntry.Duration)
+	assert.Equal(t, "kickoff", created.TimeEntry.Notes)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, entriesPath,
+		openapi.CreateTimeEntryRequest{StartedAt: end.Add(-3 * time.Hour), EndedAt: end.Add(-150 * time.Minute)})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	actual := getActualDuration(t, ts, listID, itemID)
+	require.NotNil(t, actual)
+	assert.Equal(t, "PT2H", *actual)
+
+	// Without entries the status-derived duration is used again
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, entriesPath, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var listed openapi.ListTimeEntriesResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	require.Len(t, *listed.TimeEntries, 2)
+	for _, entry := range *listed.TimeEntries {
+		w = doTenantRequest(t, ts, ts.APIKey, http.MethodDelete, fmt.Sprintf("%s/%s", entriesPath, entry.Id), nil)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	}
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodDelete, fmt.Sprintf("%s/%s", entriesPath, created.TimeEntry.Id), nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+
+	actual = getActualDuration(t, ts, listID, itemID)
+	require.NotNil(t, actual)
+	assert.Equal(t, "PT0S", *actual)
+}
@@ -0,0 +1,140 @@
+package http_test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Timesheet tests.
+//
+// GET /v1/reports/timesheet reports the time tracked on the items of the
+// caller's lists: time entries first, then time in progress, with a hand-set
+// actual_duration replacing the time in progress.
+
+func getTimesheet(t *testing.T, ts *TestServer, apiKey, query string) openapi.Timesheet {
+	t.Helper()
+
+	w := doTenantRequest(t, ts, apiKey, http.MethodGet, "/api/v1/reports/timesheet?"+query, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var sheet openapi.Timesheet
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &sheet))
+	return sheet
+}
+
+func TestTimesheet_EntriesStatusAndOverrides(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Client A")
+	listID := list.Id.String()
+	tracked := createTimeTrackingItem(t, ts, listID, "Design review")
+	overridden := createTimeTrackingItem(t, ts, listID, "Invoice")
+
+	// 2h ending at 01:00 in Tokyo three days ago
+	base := time.Now().UTC().AddDate(0, 0, -3).Truncate(24 * time.Hour)
+	start := base.Add(14 * time.Hour)
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items/%s/time-entries", listID, tracked),
+		openapi.CreateTimeEntryRequest{StartedAt: start, EndedAt: start.Add(2 * time.Hour)})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	// In progress for a moment, then actual_duration is set by hand
+	itemPath := fmt.Sprintf("/api/v1/lists/%s/items/%s", listID, overridden)
+	for _, status := range []openapi.ItemStatus{openapi.InProgress, openapi.Done} {
+		w = doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, itemPath, openapi.UpdateItemRequest{
+			Item:       openapi.TodoItem{Status: &status},
+			UpdateMask: []openapi.UpdateItemRequestUpdateMask{openapi.UpdateItemRequestUpdateMaskStatus},
+		})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	}
+	actual := "PT45M"
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, itemPath, openapi.UpdateItemRequest{
+		Item:       openapi.TodoItem{ActualDuration: &actual},
+		UpdateMask: []openapi.UpdateItemRequestUpdateMask{openapi.UpdateItemRequestUpdateMaskActualDuration},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	sheet := getTimesheet(t, ts, ts.APIKey, "")
+	assert.Equal(t, openapi.TimesheetGroupByList, sheet.GroupBy)
+	assert.Equal(t, "UTC", sheet.Tz)
+	assert.Equal(t, "PT2H45M", sheet.Total)
+	require.Len(t, sheet.Groups, 1)
+	group := sheet.Groups[0]
+	assert.Equal(t, listID, group.Key)
+	assert.Equal(t, "Client A", group.Name)
+	require.Len(t, group.Items, 2)
+	assert.Equal(t, tracked, group.Items[0].ItemId.String())
+	assert.Equal(t, openapi.Entries, group.Items[0].Source)
+	assert.Equal(t, "PT2H", group.Items[0].Duration)
+	assert.Equal(t, overridden, group.Items[1].ItemId.String())
+	assert.Equal(t, openapi.Manual, group.Items[1].Source)
+	assert.Equal(t, "PT45M", group.Items[1].Duration)
+
+	// Days follow the timezone: the entry spans midnight in Tokyo
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	first := start.In(tokyo).Format(time.DateOnly)
+	second := start.Add(2 * time.Hour).In(tokyo).Format(time.DateOnly)
+	sheet = getTimesheet(t, ts, ts.APIKey, fmt.Sprintf("group_by=day&tz=Asia/Tokyo&from=%s&to=%s", first, second))
+	require.Len(t, sheet.Groups, 2)
+	assert.Equal(t, first, sheet.Groups[0].Key)
+	assert.Equal(t, "PT1H", sheet.Groups[0].Duration)
+	assert.Equal(t, second, sheet.Groups[1].Key)
+	assert.Equal(t, "PT1H", sheet.Groups[1].Duration)
+	assert.Equal(t, "PT2H", sheet.Total)
+
+	// Other tenants see none of it
+	otherKey, _ := createTenantKey(t, ts, "other")
+	sheet = getTimesheet(t, ts, otherKey, "")
+	assert.Empty(t, sheet.Groups)
+	assert.Equal(t, "PT0S", sheet.Total)
+}
+
+func TestTimesheet_CSV(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Client B")
+	listID := list.Id.String()
+	itemID := createTimeTrackingItem(t, ts, listID, "Workshop, day 1")
+	end := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items/%s/time-entries", listID, itemID),
+		openapi.CreateTimeEntryRequest{StartedAt: end.Add(-90 * time.Minute), EndedAt: end})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, "/api/v1/reports/timesheet?format=csv&group_by=tag", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Header().Get("Content-Type"), "text/csv")
+
+	records, err := csv.NewReader(strings.NewReader(w.Body.String())).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"group", "group_name", "item_id", "list_id", "title", "source", "duration", "hours"},
+		{"", "", itemID, listID, "Workshop, day 1", "entries", "PT1H30M", "1.50"},
+	}, records)
+}
+
+func TestTimesheet_Validation(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	for _, query := range []string{
+		"group_by=week",
+		"format=xml",
+		"tz=Mars/Olympus",
+		"from=2026-03-10&to=2026-03-01",
+		"from=2025-01-01&to=2026-03-01",
+		"from=03/10/2026",
+	} {
+		w := doTenantRequest(t, ts, ts.APIKey, http.MethodGet, "/api/v1/reports/timesheet?"+query, nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code, "%s: %s", query, w.Body.String())
+	}
+}
@@ -2,7 +2,6 @@ package http_test
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -22,7 +21,7 @@ func TestUpdateItem_InvalidStatus(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list and item
 	list, err := ts.TodoService.CreateList(ctx, "Validation Test List")
@@ -69,7 +68,7 @@ func TestUpdateItem_InvalidPriority(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list and item
 	list, err := ts.TodoService.CreateList(ctx, "Priority Validation List")
@@ -115,7 +114,7 @@ func TestUpdateItem_InvalidStatusWithoutMask(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	list, err := ts.TodoService.CreateList(ctx, "No Mask Validation List")
 	require.NoError(t, err)
@@ -154,7 +153,7 @@ func TestUpdateItem_EmptyTitleRejected(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list and item with valid title
 	list, err := ts.TodoService.CreateList(ctx, "Title Validation List")
@@ -208,7 +207,7 @@ func TestUpdateItem_InvalidTimezone(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list and item
 	list, err := ts.TodoService.CreateList(ctx, "Timezone Validation List")
@@ -262,7 +261,7 @@ func TestUpdateItem_EmptyTimezone_Normalized(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list and item with a timezone
 	list, err := ts.TodoService.CreateList(ctx, "Timezone Update Test")
@@ -313,7 +312,7 @@ func TestUpdateItem_Tags_EmptyArrayClearsTags(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create item with tags
 	list, err := ts.TodoService.CreateList(ctx, "Tags Update Test")
@@ -364,7 +363,7 @@ func TestUpdateItem_Tags_PreservesWhenNotInMask(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create item with tags
 	list, err := ts.TodoService.CreateList(ctx, "Tags Preservation Test")
@@ -413,7 +412,7 @@ func TestUpdateItem_Duration_InvalidFormatRejected(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create item
 	list, err := ts.TodoService.CreateList(ctx, "Duration Update Test")
@@ -459,7 +458,7 @@ func TestUpdateItem_Duration_ClearingWithNull(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create item with estimated_duration
 	list, err := ts.TodoService.CreateList(ctx, "Duration Clearing Test")
@@ -2,11 +2,11 @@ package http_test
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -51,7 +51,7 @@ func TestValidation_EmptyTitle_CreateItem(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list first
 	list, err := ts.TodoService.CreateList(ctx, "Test List")
@@ -140,7 +140,7 @@ func TestValidation_InvalidStatus_UpdateItem(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create list and item
 	list, err := ts.TodoService.CreateList(ctx, "Status Test List")
@@ -184,7 +184,7 @@ func TestValidation_InvalidPriority_UpdateItem(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create list and item
 	list, err := ts.TodoService.CreateList(ctx, "Priority Test List")
@@ -235,7 +235,7 @@ func TestValidation_UpdateMaskStatusNoValue(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create list and item
 	list, err := ts.TodoService.CreateList(ctx, "Update Mask Test List")
@@ -285,7 +285,7 @@ func TestValidation_UpdateMaskPriorityNoValue(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create list and item with a priority
 	list, err := ts.TodoService.CreateList(ctx, "Update Mask Priority Test")
@@ -330,7 +330,7 @@ func TestValidation_UpdateRecurringTemplate_TitleInMaskNoValue(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create list and template
 	list, err := ts.TodoService.CreateList(ctx, "Template Update Test List")
@@ -383,7 +383,7 @@ func TestValidation_UpdateRecurringTemplate_RecurrencePatternInMaskNoValue(t *te
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create list and template
 	list, err := ts.TodoService.CreateList(ctx, "Pattern Update Test List")
@@ -433,7 +433,7 @@ func TestValidation_LargeTitle(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list first
 	list, err := ts.TodoService.CreateList(ctx, "Large Title Test List")
@@ -469,7 +469,7 @@ func TestValidation_TitleTooLong(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list first
 	list, err := ts.TodoService.CreateList(ctx, "Title Too Long Test List")
@@ -505,7 +505,7 @@ func TestValidation_ManyTags(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list first
 	list, err := ts.TodoService.CreateList(ctx, "Many Tags Test List")
@@ -584,7 +584,7 @@ func TestValidation_MalformedJSON_CreateItem(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list first
 	list, err := ts.TodoService.CreateList(ctx, "JSON Test List")
@@ -645,7 +645,7 @@ func TestValidation_MissingUpdateMask_UpdateRecurringTemplate(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list first
 	list, err := ts.TodoService.CreateList(ctx, "Template Validation Test List")
@@ -683,7 +683,7 @@ func TestValidation_MissingUpdateMask_UpdateItem(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create a list and item first
 	list, err := ts.TodoService.CreateList(ctx, "Item Validation Test List")
@@ -723,7 +723,7 @@ func TestValidation_UnknownUpdateMaskField_UpdateItem(t *testing.T) {
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create list and item
 	list, err := ts.TodoService.CreateList(ctx, "Unknown Mask Field Test")
@@ -768,7 +768,7 @@ func TestValidation_UnknownUpdateMaskField_UpdateRecurringTemplate(t *testing.T)
 	ts := SetupTestServer(t)
 	defer ts.Cleanup()
 
-	ctx := context.Background()
+	ctx := ts.OwnerContext()
 
 	// Create list and template
 	list, err := ts.TodoService.CreateList(ctx, "Unknown Mask Template Test")
@@ -808,3 +808,31 @@ func TestValidation_UnknownUpdateMaskField_UpdateRecurringTemplate(t *testing.T)
 	require.NotNil(t, resp.Error.Code)
 	assert.Equal(t, "VALIDATION_ERROR", *resp.Error.Code)
 }
+
+// TestValidation_InvalidFilterExpression_ListItems verifies that a filter
+// expression with an unknown field is rejected with the field and its position.
+func TestValidation_InvalidFilterExpression_ListItems(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list, err := ts.TodoService.CreateList(ts.OwnerContext(), "Test List")
+	require.NoError(t, err)
+
+	query := url.Values{"filter": {"status = done AND colour = red"}}
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/items?%s", list.ID, query.Encode()), nil)
+	req.Header.Set("Authorization", "Bearer "+ts.APIKey)
+
+	w := httptest.NewRecorder()
+	ts.Router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var resp openapi.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.NotNil(t, resp.Error)
+	require.NotNil(t, resp.Error.Details)
+	require.Len(t, *resp.Error.Details, 1)
+	detail := (*resp.Error.Details)[0]
+	assert.Equal(t, "filter", *detail.Field)
+	assert.Contains(t, *detail.Issue, `position 19: unknown field "colour"`)
+}
@@ -0,0 +1,124 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Saved view tests.
+//
+// A saved view stores the filters, sort and due window of an item search under
+// a name. Relative due bounds are resolved when the view is run.
+
+func createTestView(t *testing.T, ts *TestServer, req openapi.CreateViewRequest) openapi.SavedView {
+	t.Helper()
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, "/api/v1/views", req)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var resp openapi.ViewResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.NotNil(t, resp.View)
+	return *resp.View
+}
+
+func TestViews_Lifecycle(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	// A due bound needs exactly one of at and offset_days
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, "/api/v1/views", openapi.CreateViewRequest{
+		Name:      "Broken",
+		DueBefore: &openapi.DueBound{},
+	})
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+
+	view := createTestView(t, ts, openapi.CreateViewRequest{
+		Name:      "  Due this week  ",
+		DueBefore: &openapi.DueBound{OffsetDays: ptr.To(7)},
+	})
+	assert.Equal(t, "Due this week", view.Name)
+	viewPath := fmt.Sprintf("/api/v1/views/%s", view.Id)
+
+	name := "Due soon"
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, viewPath, openapi.UpdateViewRequest{
+		Name:       &name,
+		UpdateMask: []openapi.UpdateViewRequestUpdateMask{openapi.UpdateViewRequestUpdateMaskName},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var updated openapi.ViewResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &updated))
+	assert.Equal(t, "Due soon", updated.View.Name)
+	require.NotNil(t, updated.View.DueBefore)
+	assert.Equal(t, 7, *updated.View.DueBefore.OffsetDays)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, "/api/v1/views", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var listed openapi.ListViewsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	require.NotNil(t, listed.Views)
+	assert.Len(t, *listed.Views, 1)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodDelete, viewPath, nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, viewPath, nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
+
+func TestViews_ItemsResolveRelativeDueWindow(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	list := createTestList(t, ts, "Deadlines")
+	itemsPath := fmt.Sprintf("/api/v1/lists/%s/items", list.Id)
+	now := time.Now().UTC()
+	for title, due := range map[string]time.Time{
+		"Tomorrow":  now.Add(24 * time.Hour),
+		"Next week": now.Add(5 * 24 * time.Hour),
+		"Next year": now.AddDate(1, 0, 0),
+	} {
+		w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, itemsPath, openapi.CreateItemRequest{Title: title, DueAt: &due})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	view := createTestView(t, ts, openapi.CreateViewRequest{
+		Name:      "Due this week",
+		ListId:    list.Id,
+		DueAfter:  &openapi.DueBound{OffsetDays: ptr.To(0)},
+		DueBefore: &openapi.DueBound{OffsetDays: ptr.To(7)},
+		Filter:    &openapi.ViewFilter{SortBy: ptr.To("due_at")},
+	})
+
+	page := getItemsPage(t, ts, fmt.Sprintf("/api/v1/views/%s/items?page_size=1", view.Id))
+	require.Len(t, *page.Items, 1)
+	assert.Equal(t, "Tomorrow", ptr.Deref((*page.Items)[0].Title, ""))
+	require.NotNil(t, page.NextPageToken)
+
+	page = getItemsPage(t, ts, fmt.Sprintf("/api/v1/views/%s/items?page_size=1&page_token=%s", view.Id, *page.NextPageToken))
+	require.Len(t, *page.Items, 1)
+	assert.Equal(t, "Next week", ptr.Deref((*page.Items)[0].Title, ""))
+	assert.Nil(t, page.NextPageToken)
+}
+
+func TestViews_OtherTenantCannotAccess(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+	fixture := setupTenantFixture(t, ts)
+
+	view := createTestView(t, ts, openapi.CreateViewRequest{Name: "Mine"})
+	w := doTenantRequest(t, ts, fixture.otherKey, http.MethodGet, fmt.Sprintf("/api/v1/views/%s/items", view.Id), nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+
+	// A view cannot search a list of another tenant
+	w = doTenantRequest(t, ts, fixture.otherKey, http.MethodPost, "/api/v1/views", map[string]any{
+		"name": "Theirs", "list_id": fixture.listID,
+	})
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
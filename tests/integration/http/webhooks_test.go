@@ -0,0 +1,215 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/application/auth"
+	"github.com/rezkam/mono/internal/application/worker"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/http/openapi"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Webhook tests.
+//
+// Changes made through the API are recorded in the change log; the relay queues
+// them for every active subscription of the event type, the worker delivers
+// them and dead deliveries can be redelivered.
+
+func TestWebhooks_SubscriptionLifecycle(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+
+	// Invalid subscriptions are rejected, as are URLs of internal addresses
+	for _, url := range []string{"not-a-url", "http://localhost:8080/hooks", "http://169.254.169.254/latest"} {
+		w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, "/api/v1/webhooks", map[string]any{
+			"url": url, "events": []string{"item.created"},
+		})
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	}
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, "/api/v1/webhooks", openapi.CreateWebhookRequest{
+		Url:    "https://example.com/hooks",
+		Events: []openapi.WebhookEventType{openapi.WebhookEventTypeListCreated, openapi.WebhookEventTypeItemCreated},
+	})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created openapi.CreateWebhookResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	require.NotNil(t, created.Secret)
+	assert.NotEmpty(t, *created.Secret)
+	assert.True(t, created.Webhook.IsActive)
+	webhookPath := fmt.Sprintf("/api/v1/webhooks/%s", created.Webhook.Id)
+
+	// The secret is never returned again
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, webhookPath, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.NotContains(t, w.Body.String(), *created.Secret)
+
+	// Pause the subscription
+	inactive := false
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPatch, webhookPath, openapi.UpdateWebhookRequest{
+		IsActive:   &inactive,
+		UpdateMask: []openapi.UpdateWebhookRequestUpdateMask{openapi.UpdateWebhookRequestUpdateMaskIsActive},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var updated openapi.WebhookResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &updated))
+	assert.False(t, updated.Webhook.IsActive)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodDelete, webhookPath, nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, webhookPath, nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
+
+func TestWebhooks_ChangesAreQueuedAndRedelivered(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+	ctx := ts.OwnerContext()
+
+	w := doTenantRequest(t, ts, ts.APIKey, http.MethodPost, "/api/v1/webhooks", openapi.CreateWebhookRequest{
+		Url:    "https://example.com/hooks",
+		Events: []openapi.WebhookEventType{openapi.WebhookEventTypeListCreated},
+	})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created openapi.CreateWebhookResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	deliveriesPath := fmt.Sprintf("/api/v1/webhooks/%s/deliveries", created.Webhook.Id)
+
+	// Only subscribed event types are queued
+	list := createTestList(t, ts, "Webhooks")
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("/api/v1/lists/%s/items", list.Id),
+		openapi.CreateItemRequest{Title: "Not subscribed"})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	// Nothing is queued until the relay publishes the change log
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, deliveriesPath, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var listed openapi.ListWebhookDeliveriesResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	assert.Empty(t, *listed.Deliveries)
+
+	coordinator := postgres.NewPostgresCoordinator(ts.Store.Pool())
+	relay := worker.NewOutboxRelay(coordinator, ts.Store, worker.DefaultOutboxRelayConfig("relay-1"))
+	published, err := relay.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, published) // list.created and item.created
+
+	// Relaying again publishes nothing new
+	published, err = relay.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, published)
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, deliveriesPath, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	require.Len(t, *listed.Deliveries, 1)
+	delivery := (*listed.Deliveries)[0]
+	assert.Equal(t, openapi.WebhookEventTypeListCreated, delivery.EventType)
+	assert.Equal(t, openapi.Pending, delivery.Status)
+
+	// The worker claims the delivery and the receiver keeps rejecting it
+	claimed, err := coordinator.ClaimNextWebhookDelivery(ctx, "worker-1", time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, claimed)
+	assert.Equal(t, delivery.Id.String(), claimed.ID)
+	status := http.StatusGone
+	require.NoError(t, coordinator.MarkWebhookDeliveryDead(ctx, claimed, "worker-1", "webhook rejected event with 410", &status))
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, deliveriesPath+"?status=dead", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	require.Len(t, *listed.Deliveries, 1)
+	require.NotNil(t, (*listed.Deliveries)[0].ResponseStatus)
+	assert.Equal(t, http.StatusGone, *(*listed.Deliveries)[0].ResponseStatus)
+
+	// Redelivery queues a new delivery of the same event
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, fmt.Sprintf("%s/%s/redeliver", deliveriesPath, delivery.Id), nil)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var redelivered openapi.WebhookDeliveryResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &redelivered))
+	assert.Equal(t, delivery.EventId, redelivered.Delivery.EventId)
+	assert.NotEqual(t, delivery.Id, redelivered.Delivery.Id)
+	require.NotNil(t, redelivered.Delivery.RedeliveryOf)
+	assert.Equal(t, delivery.Id, *redelivered.Delivery.RedeliveryOf)
+	assert.Equal(t, openapi.Pending, redelivered.Delivery.Status)
+
+	claimed, err = coordinator.ClaimNextWebhookDelivery(ctx, "worker-1", time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, claimed)
+	require.NoError(t, coordinator.CompleteWebhookDelivery(ctx, claimed.ID, "worker-1", http.StatusOK))
+
+	// Ownership is checked on every transition
+	err = coordinator.CompleteWebhookDelivery(ctx, claimed.ID, "worker-1", http.StatusOK)
+	assert.ErrorIs(t, err, domain.ErrWebhookDeliveryOwnershipLost)
+}
+
+func TestWebhooks_ScopedToTenant(t *testing.T) {
+	ts := SetupTestServer(t)
+	defer ts.Cleanup()
+	ctx := ts.OwnerContext()
+
+	// Tenant A has a list with an item; its changes are relayed below
+	setupTenantFixture(t, ts)
+
+	// Another tenant manages its own webhooks without the admin scope
+	ownerID, err := uuid.NewV7()
+	require.NoError(t, err)
+	otherKey, err := auth.CreateAPIKeyForOwner(ctx, ts.Store, ownerID.String(),
+		append(domain.ReadWriteScopes(), domain.ScopeWebhooksRead, domain.ScopeWebhooksWrite), "sk", "test", "v1", "tenant-b-webhooks", nil)
+	require.NoError(t, err)
+	w := doTenantRequest(t, ts, otherKey, http.MethodGet, "/api/v1/admin/dead-letter-jobs", nil)
+	assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodPost, "/api/v1/webhooks", openapi.CreateWebhookRequest{
+		Url:    "https://example.com/hooks",
+		Events: []openapi.WebhookEventType{openapi.WebhookEventTypeItemCreated},
+	})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created openapi.CreateWebhookResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	webhookPath := fmt.Sprintf("/api/v1/webhooks/%s", created.Webhook.Id)
+
+	// Subscriptions of another tenant are not found
+	w = doTenantRequest(t, ts, otherKey, http.MethodGet, webhookPath, nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+	w = doTenantRequest(t, ts, otherKey, http.MethodDelete, webhookPath, nil)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+	w = doTenantRequest(t, ts, otherKey, http.MethodGet, "/api/v1/webhooks", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var others openapi.ListWebhooksResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &others))
+	assert.Empty(t, *others.Webhooks)
+
+	// Events of lists the other tenant can't access are not fanned out to it
+	w = doTenantRequest(t, ts, otherKey, http.MethodPost, "/api/v1/webhooks", openapi.CreateWebhookRequest{
+		Url:    "https://example.com/other",
+		Events: []openapi.WebhookEventType{openapi.WebhookEventTypeItemCreated},
+	})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var other openapi.CreateWebhookResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &other))
+
+	coordinator := postgres.NewPostgresCoordinator(ts.Store.Pool())
+	relay := worker.NewOutboxRelay(coordinator, ts.Store, worker.DefaultOutboxRelayConfig("relay-1"))
+	_, err = relay.RunOnce(ctx)
+	require.NoError(t, err)
+
+	var listed openapi.ListWebhookDeliveriesResponse
+	w = doTenantRequest(t, ts, ts.APIKey, http.MethodGet, webhookPath+"/deliveries", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	assert.Len(t, *listed.Deliveries, 1)
+
+	w = doTenantRequest(t, ts, otherKey, http.MethodGet, fmt.Sprintf("/api/v1/webhooks/%s/deliveries", other.Webhook.Id), nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	assert.Empty(t, *listed.Deliveries)
+}
@@ -21,7 +21,7 @@ func TestAtomic_RollsBackOnPanic(t *testing.T) {
 	store, cleanup := setupAtomicityTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Prepare a list to be created inside the transaction
 	listID := uuid.Must(uuid.NewV7()).String()
@@ -55,7 +55,7 @@ func TestAtomic_RollsBackOnError(t *testing.T) {
 	store, cleanup := setupAtomicityTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	listID := uuid.Must(uuid.NewV7()).String()
 	list := &domain.TodoList{
@@ -0,0 +1,100 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/application/todo"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/infrastructure/persistence/postgres"
+	"github.com/rezkam/mono/internal/recurring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChangeLog_RecordsEveryWrite verifies that creates, updates and deletes
+// append change log rows with version, snapshot and diff.
+func TestChangeLog_RecordsEveryWrite(t *testing.T) {
+	store, ctx := SetupTestStore(t)
+	coordinator := postgres.NewPostgresCoordinator(store.Pool())
+	service := todo.NewService(store, recurring.NewDomainGenerator(), todo.Config{})
+
+	list, err := service.CreateList(ctx, "Change log")
+	require.NoError(t, err)
+	item, err := service.CreateItem(ctx, list.ID, &domain.TodoItem{Title: "Write tests"})
+	require.NoError(t, err)
+
+	done := domain.TaskStatusDone
+	_, err = service.UpdateItem(ctx, domain.UpdateItemParams{
+		ItemID:     item.ID,
+		ListID:     list.ID,
+		UpdateMask: []string{"status"},
+		Status:     &done,
+	})
+	require.NoError(t, err)
+	require.NoError(t, service.DeleteItem(ctx, list.ID, item.ID))
+
+	changes, err := coordinator.ListUnpublishedChanges(ctx, 100)
+	require.NoError(t, err)
+	require.Len(t, changes, 4)
+
+	assert.Equal(t, domain.ChangeEntityList, changes[0].EntityType)
+	assert.Equal(t, domain.ChangeCreated, changes[0].Operation)
+	assert.Equal(t, list.ID, changes[0].ListID)
+
+	created, updated, deleted := changes[1], changes[2], changes[3]
+	for i, change := range changes[1:] {
+		assert.Equal(t, domain.ChangeEntityItem, change.EntityType)
+		assert.Equal(t, item.ID, change.EntityID)
+		assert.Equal(t, list.ID, change.ListID)
+		assert.Greater(t, change.Seq, changes[i].Seq, "changes must be ordered by seq")
+	}
+
+	assert.Equal(t, domain.ChangeCreated, created.Operation)
+	assert.Equal(t, "todo", created.Snapshot["status"])
+	assert.Nil(t, created.Diff)
+
+	assert.Equal(t, domain.ChangeUpdated, updated.Operation)
+	assert.Equal(t, created.Version+1, updated.Version)
+	assert.Equal(t, "done", updated.Snapshot["status"])
+	require.Contains(t, updated.Diff, "status")
+	assert.Equal(t, domain.FieldChange{Old: "todo", New: "done"}, updated.Diff["status"])
+	assert.NotContains(t, updated.Diff, "title")
+	assert.NotContains(t, updated.Diff, "updated_at")
+
+	assert.Equal(t, domain.ChangeDeleted, deleted.Operation)
+	assert.Equal(t, "done", deleted.Snapshot["status"])
+
+	// Published changes are not listed again
+	require.NoError(t, coordinator.MarkChangesPublished(ctx, []int64{changes[0].Seq, created.Seq}))
+	remaining, err := coordinator.ListUnpublishedChanges(ctx, 100)
+	require.NoError(t, err)
+	require.Len(t, remaining, 2)
+	assert.Equal(t, updated.Seq, remaining[0].Seq)
+}
+
+// TestChangeLog_RolledBackWithTransaction verifies that a change log row
+// never outlives the write it describes.
+func TestChangeLog_RolledBackWithTransaction(t *testing.T) {
+	store, ctx := SetupTestStore(t)
+	coordinator := postgres.NewPostgresCoordinator(store.Pool())
+
+	errAbort := errors.New("abort")
+	err := store.Atomic(ctx, func(repo todo.Repository) error {
+		if _, err := repo.CreateList(ctx, &domain.TodoList{
+			ID:        uuid.Must(uuid.NewV7()).String(),
+			Title:     "Rolled back",
+			CreatedAt: time.Now().UTC(),
+		}); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	require.ErrorIs(t, err, errAbort)
+
+	changes, err := coordinator.ListUnpublishedChanges(ctx, 100)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
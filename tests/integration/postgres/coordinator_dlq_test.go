@@ -19,7 +19,7 @@ func TestCoordinator_ExhaustedRetries_AtomicDLQMove(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create template and job at max retries
@@ -53,7 +53,7 @@ func TestCoordinator_RetryBehavior_ExponentialBackoff(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create template and job with retry_count = 0
@@ -111,7 +111,7 @@ func TestCoordinator_OwnershipCheck_PreventsRaceConditions(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create template and job
@@ -171,7 +171,7 @@ func TestCoordinator_MaxRetryDiscard_ChecksOwnership(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create template and job at max retries
@@ -244,7 +244,7 @@ func TestCoordinator_RetryExhaustion_MovesToDLQ(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create template and job
@@ -312,7 +312,7 @@ func TestCoordinator_ConcurrentDLQMoves(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create 5 jobs at max retries
 	// NOTE: Each job needs its own template due to unique constraint
@@ -394,7 +394,7 @@ func TestCoordinator_MoveToDeadLetter_AtomicityGuarantee(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create template and job
@@ -439,7 +439,7 @@ func TestCoordinator_DLQAtomicity_TransactionRollback(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create template and job at max retries
@@ -493,7 +493,7 @@ func TestCoordinator_DLQAtomicity_DuplicateDetection(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create template and job
@@ -545,7 +545,7 @@ func TestCoordinator_DLQAtomicity_ConcurrentPanicHandling(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create multiple jobs
 	// NOTE: Each job needs its own template due to unique constraint
@@ -699,7 +699,7 @@ func TestAtomicity_SuccessfulDLQMove_BothTablesUpdated(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create template and job at max retries
@@ -764,7 +764,7 @@ func TestAtomicity_MoveToDeadLetter_MarksJobDiscarded(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create template and job
@@ -819,7 +819,7 @@ func TestAtomicity_ConcurrentRecovery_OnlyOneWorkerSucceeds(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a job and set it as "stuck" (running but past availability timeout)
 	templateID := createTestTemplate(t, store, ctx)
@@ -886,7 +886,7 @@ func TestAtomicity_ContextCancellation_RollsBackTransaction(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create template and job at max retries
@@ -933,7 +933,7 @@ func TestAtomicity_VerifyBothOperationsInSingleTransaction(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create multiple jobs at max retries
@@ -1011,7 +1011,7 @@ func TestAtomicity_StaleJobRecovery_AfterCrash(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a job
 	templateID := createTestTemplate(t, store, ctx)
@@ -1080,7 +1080,7 @@ func TestAtomicity_PartialFailure_NoOrphanedDLQEntries(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create a set of jobs and fail them
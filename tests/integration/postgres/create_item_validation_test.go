@@ -19,7 +19,7 @@ func TestCreateItem_InvalidListID(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -47,7 +47,7 @@ func TestCreateItem_ValidList(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -0,0 +1,159 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestListTasks_CustomFields verifies filtering and sorting by custom field
+// values stored as JSONB, including fields whose type differs between lists.
+func TestListTasks_CustomFields(t *testing.T) {
+	env := newListTasksTestEnv(t)
+
+	planning, err := env.Service().CreateListWithCustomFields(env.Context(), "Sprint Planning", []domain.CustomFieldDefinition{
+		{Name: "story_points", Type: domain.CustomFieldTypeNumber},
+		{Name: "review_on", Type: domain.CustomFieldTypeDate},
+		{Name: "stage", Type: domain.CustomFieldTypeEnum, Options: []string{"build", "review"}},
+		{Name: "billable", Type: domain.CustomFieldTypeBoolean},
+	})
+	require.NoError(t, err)
+
+	// Same field name, different type: estimates are free text in this list
+	backlog, err := env.Service().CreateListWithCustomFields(env.Context(), "Backlog", []domain.CustomFieldDefinition{
+		{Name: "story_points", Type: domain.CustomFieldTypeString},
+	})
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	items := []struct {
+		listID string
+		title  string
+		fields map[string]any
+	}{
+		{planning.ID, "Small", map[string]any{"story_points": 2, "review_on": "2026-03-01", "stage": "build", "billable": true}},
+		{planning.ID, "Large", map[string]any{"story_points": 10, "review_on": "2026-01-15", "stage": "review", "billable": false}},
+		{planning.ID, "Medium", map[string]any{"story_points": 3.5, "review_on": "2026-02-10", "stage": "build"}},
+		{planning.ID, "Three", map[string]any{"story_points": 3}},
+		{planning.ID, "Unestimated", nil},
+		{backlog.ID, "Text estimate", map[string]any{"story_points": "3"}},
+		{backlog.ID, "Text unestimated", nil},
+	}
+	for _, data := range items {
+		itemUUID, err := uuid.NewV7()
+		require.NoError(t, err)
+		_, err = env.Store().CreateItem(env.Context(), data.listID, &domain.TodoItem{
+			ID:           itemUUID.String(),
+			Title:        data.title,
+			Status:       domain.TaskStatusTodo,
+			CustomFields: data.fields,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		})
+		require.NoError(t, err)
+	}
+
+	// search pages through every match two items at a time, so the keyset
+	// cursor has to continue across JSON types and into items without a value
+	search := func(t *testing.T, listIDs []string, input domain.ItemsFilterInput) []string {
+		t.Helper()
+		filter, err := domain.NewItemsFilter(input)
+		require.NoError(t, err)
+
+		var titles []string
+		var after *domain.PageCursor
+		for {
+			result, err := env.Service().ListItems(env.Context(), domain.ListTasksParams{
+				ListIDs: listIDs,
+				Filter:  filter,
+				Limit:   2,
+				After:   after,
+			})
+			require.NoError(t, err)
+			for _, item := range result.Items {
+				titles = append(titles, item.Title)
+			}
+			if !result.HasMore {
+				return titles
+			}
+			require.NotNil(t, result.NextCursor)
+			after = result.NextCursor
+		}
+	}
+	orderBy := func(name string) *string {
+		s := domain.CustomFieldOrderByPrefix + name
+		return &s
+	}
+	desc := "desc"
+	planningOnly := []string{planning.ID}
+	bothLists := []string{planning.ID, backlog.ID}
+
+	t.Run("number_sorts_numerically", func(t *testing.T) {
+		titles := search(t, planningOnly, domain.ItemsFilterInput{OrderBy: orderBy("story_points")})
+		assert.Equal(t, []string{"Small", "Three", "Medium", "Large", "Unestimated"}, titles)
+	})
+
+	t.Run("missing_values_last_when_descending", func(t *testing.T) {
+		titles := search(t, planningOnly, domain.ItemsFilterInput{OrderBy: orderBy("story_points"), OrderDir: &desc})
+		assert.Equal(t, []string{"Large", "Medium", "Three", "Small", "Unestimated"}, titles)
+	})
+
+	t.Run("date_sorts_chronologically", func(t *testing.T) {
+		titles := search(t, planningOnly, domain.ItemsFilterInput{OrderBy: orderBy("review_on")})
+		require.Len(t, titles, 5)
+		assert.Equal(t, []string{"Large", "Medium", "Small"}, titles[:3])
+		assert.ElementsMatch(t, []string{"Three", "Unestimated"}, titles[3:])
+	})
+
+	t.Run("mixed_types_sort_by_jsonb_order", func(t *testing.T) {
+		// JSONB orders strings before numbers; items without a value still come last
+		titles := search(t, bothLists, domain.ItemsFilterInput{OrderBy: orderBy("story_points")})
+		require.Len(t, titles, 7)
+		assert.Equal(t, []string{"Text estimate", "Small", "Three", "Medium", "Large"}, titles[:5])
+		assert.ElementsMatch(t, []string{"Unestimated", "Text unestimated"}, titles[5:])
+	})
+
+	t.Run("filter_matches_text_form", func(t *testing.T) {
+		cases := []struct {
+			fields map[string]string
+			want   []string
+		}{
+			{map[string]string{"story_points": "10"}, []string{"Large"}},
+			{map[string]string{"story_points": "3.5"}, []string{"Medium"}},
+			{map[string]string{"billable": "true"}, []string{"Small"}},
+			{map[string]string{"review_on": "2026-01-15"}, []string{"Large"}},
+			{map[string]string{"stage": "build", "billable": "true"}, []string{"Small"}},
+		}
+		for _, tc := range cases {
+			titles := search(t, planningOnly, domain.ItemsFilterInput{CustomFields: tc.fields})
+			assert.ElementsMatch(t, tc.want, titles, "filter %v", tc.fields)
+		}
+	})
+
+	t.Run("filter_matches_number_and_string_alike", func(t *testing.T) {
+		titles := search(t, bothLists, domain.ItemsFilterInput{CustomFields: map[string]string{"story_points": "3"}})
+		assert.ElementsMatch(t, []string{"Three", "Text estimate"}, titles)
+	})
+
+	t.Run("expression", func(t *testing.T) {
+		cases := []struct {
+			expression string
+			want       []string
+		}{
+			{"custom_fields.stage = build", []string{"Small", "Medium"}},
+			{"custom_fields.stage != build", []string{"Large"}},
+			{"custom_fields.billable:*", []string{"Small", "Large"}},
+			{"-custom_fields.story_points:*", []string{"Unestimated"}},
+			{"custom_fields.billable = false OR custom_fields.story_points = 3", []string{"Large", "Three"}},
+		}
+		for _, tc := range cases {
+			expression := tc.expression
+			titles := search(t, planningOnly, domain.ItemsFilterInput{Expression: &expression})
+			assert.ElementsMatch(t, tc.want, titles, "expression %q", tc.expression)
+		}
+	})
+}
@@ -21,7 +21,7 @@ import (
 func TestWorker_DuplicateJobPrevention(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -150,7 +150,7 @@ func TestWorker_DuplicateJobPrevention(t *testing.T) {
 func TestWorker_DuplicateJobPrevention_RunningJob(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -234,7 +234,7 @@ func TestWorker_DuplicateJobPrevention_RunningJob(t *testing.T) {
 func TestWorker_MultipleTemplates_IndependentDuplicatePrevention(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -333,7 +333,7 @@ func TestWorker_MultipleTemplates_IndependentDuplicatePrevention(t *testing.T) {
 func TestStore_HasPendingOrRunningJob_ScheduledStatus(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -20,7 +20,7 @@ import (
 func TestEtag_ReturnedInItemResponse(t *testing.T) {
 	dsn := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, dsn)
 	require.NoError(t, err)
 	defer store.Close()
@@ -55,7 +55,7 @@ func TestEtag_ReturnedInItemResponse(t *testing.T) {
 func TestEtag_UpdateWithMatchingEtag(t *testing.T) {
 	dsn := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, dsn)
 	require.NoError(t, err)
 	defer store.Close()
@@ -99,7 +99,7 @@ func TestEtag_UpdateWithMatchingEtag(t *testing.T) {
 func TestEtag_UpdateWithMismatchingEtag(t *testing.T) {
 	dsn := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, dsn)
 	require.NoError(t, err)
 	defer store.Close()
@@ -136,7 +136,7 @@ func TestEtag_UpdateWithMismatchingEtag(t *testing.T) {
 func TestEtag_UpdateWithoutEtag(t *testing.T) {
 	dsn := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, dsn)
 	require.NoError(t, err)
 	defer store.Close()
@@ -174,7 +174,7 @@ func TestEtag_UpdateWithoutEtag(t *testing.T) {
 func TestEtag_ConcurrentUpdates(t *testing.T) {
 	dsn := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, dsn)
 	require.NoError(t, err)
 	defer store.Close()
@@ -239,7 +239,7 @@ func TestEtag_ConcurrentUpdates(t *testing.T) {
 func TestEtag_FieldMaskUpdate(t *testing.T) {
 	dsn := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, dsn)
 	require.NoError(t, err)
 	defer store.Close()
@@ -297,7 +297,7 @@ func TestEtag_FieldMaskUpdate(t *testing.T) {
 func TestEtag_CannotBeSetByClient(t *testing.T) {
 	dsn := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, dsn)
 	require.NoError(t, err)
 	defer store.Close()
@@ -345,7 +345,7 @@ func createTestList(t *testing.T, store *postgres.Store, title string) string {
 		Title:     title,
 		CreatedAt: time.Now().UTC(),
 	}
-	_, err := store.CreateList(context.Background(), list)
+	_, err := store.CreateList(domain.WithInternalAccess(context.Background()), list)
 	require.NoError(t, err)
 	return listID
 }
@@ -359,7 +359,7 @@ func createTestItem(t *testing.T, svc *todo.Service, listID, title string) *doma
 		CreatedAt: time.Now().UTC(),
 		UpdatedAt: time.Now().UTC(),
 	}
-	created, err := svc.CreateItem(context.Background(), listID, item)
+	created, err := svc.CreateItem(domain.WithInternalAccess(context.Background()), listID, item)
 	require.NoError(t, err)
 	return created
 }
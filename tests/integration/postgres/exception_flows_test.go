@@ -356,7 +356,7 @@ func TestExceptionFlow_DoubleEditSameInstance(t *testing.T) {
 }
 
 // TestExceptionFlow_CountMatchesListResults verifies that the total_count from
-// ListTasksWithFilters matches the actual number of items returned, including
+// FindItems matches the actual number of items returned, including
 // when edited items are present.
 func TestExceptionFlow_CountMatchesListResults(t *testing.T) {
 	store, ctx := SetupTestStore(t)
@@ -402,13 +402,15 @@ func TestExceptionFlow_CountMatchesListResults(t *testing.T) {
 
 	// Query with limit 2 - should return 2 items but total_count should be 5 (all items)
 	result, err := store.FindItems(ctx, domain.ListTasksParams{
-		ListID: &listID,
-		Limit:  2,
+		ListID:            &listID,
+		Limit:             2,
+		IncludeTotalCount: true,
 	}, nil)
 
 	require.NoError(t, err)
 	assert.Len(t, result.Items, 2, "Should return 2 items (limit)")
-	assert.Equal(t, 5, result.TotalCount, "TotalCount should be 5 (all items including edited)")
+	require.NotNil(t, result.TotalCount)
+	assert.Equal(t, 5, *result.TotalCount, "TotalCount should be 5 (all items including edited)")
 }
 
 // TestExceptionFlow_DeleteTemplateRemovesFutureEditedItems verifies that when
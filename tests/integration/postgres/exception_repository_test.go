@@ -146,7 +146,7 @@ func createTestRecurringTemplate(t *testing.T, store *postgres.Store, title stri
 		CreatedAt:             time.Now().UTC(),
 		UpdatedAt:             time.Now().UTC(),
 	}
-	created, err := store.CreateRecurringTemplate(context.Background(), template)
+	created, err := store.CreateRecurringTemplate(domain.WithInternalAccess(context.Background()), template)
 	require.NoError(t, err)
 	return created
 }
@@ -19,7 +19,7 @@ import (
 func TestFieldMask_ClearPriority(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -87,7 +87,7 @@ func TestFieldMask_ClearPriority(t *testing.T) {
 func TestFieldMask_ClearDueAt(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -154,7 +154,7 @@ func TestFieldMask_ClearDueAt(t *testing.T) {
 func TestFieldMask_ClearEstimatedDuration(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -222,7 +222,7 @@ func TestFieldMask_ClearEstimatedDuration(t *testing.T) {
 func TestFieldMask_ClearTimezone(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -290,7 +290,7 @@ func TestFieldMask_ClearTimezone(t *testing.T) {
 func TestFieldMask_ClearTags(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -357,7 +357,7 @@ func TestFieldMask_ClearTags(t *testing.T) {
 func TestFieldMask_PartialUpdate_DoesNotClearOtherFields(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -0,0 +1,93 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestListTasks_FilterExpression verifies that filter expressions select the
+// same items the equivalent hand-written predicate would.
+func TestListTasks_FilterExpression(t *testing.T) {
+	env := newListTasksTestEnv(t)
+
+	list, err := env.Service().CreateList(env.Context(), "Filter Expression List")
+	require.NoError(t, err)
+	listID := list.ID
+
+	now := time.Now().UTC()
+	items := []struct {
+		title    string
+		status   domain.TaskStatus
+		priority *domain.TaskPriority
+		tags     []string
+		dueTime  *time.Time
+	}{
+		{"Page the on-call", domain.TaskStatusTodo, ptrTaskPriority(domain.TaskPriorityUrgent), []string{"oncall"}, ptrTime(now.Add(time.Hour))},
+		{"Ship the release", domain.TaskStatusInProgress, ptrTaskPriority(domain.TaskPriorityHigh), []string{"work"}, ptrTime(now.Add(24 * time.Hour))},
+		{"Blocked on review", domain.TaskStatusBlocked, ptrTaskPriority(domain.TaskPriorityHigh), []string{"work"}, ptrTime(now.Add(time.Hour))},
+		{"Plan next quarter", domain.TaskStatusTodo, ptrTaskPriority(domain.TaskPriorityHigh), []string{"work"}, ptrTime(now.Add(10 * 24 * time.Hour))},
+		{"Water the plants", domain.TaskStatusTodo, ptrTaskPriority(domain.TaskPriorityLow), []string{"home"}, ptrTime(now.Add(time.Hour))},
+		{"Someday", domain.TaskStatusTodo, nil, nil, nil},
+		{"Old work", domain.TaskStatusArchived, ptrTaskPriority(domain.TaskPriorityHigh), []string{"work"}, ptrTime(now.Add(time.Hour))},
+	}
+	for _, data := range items {
+		itemUUID, err := uuid.NewV7()
+		require.NoError(t, err)
+		_, err = env.Store().CreateItem(env.Context(), listID, &domain.TodoItem{
+			ID:        itemUUID.String(),
+			Title:     data.title,
+			Status:    data.status,
+			Priority:  data.priority,
+			Tags:      data.tags,
+			DueAt:     data.dueTime,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		require.NoError(t, err)
+	}
+
+	search := func(t *testing.T, expression string) []string {
+		t.Helper()
+		filter, err := domain.NewItemsFilter(domain.ItemsFilterInput{Expression: &expression})
+		require.NoError(t, err)
+		result, err := env.Service().ListItems(env.Context(), domain.ListTasksParams{
+			ListID:            &listID,
+			Filter:            filter,
+			IncludeTotalCount: true,
+		})
+		require.NoError(t, err)
+		titles := make([]string, len(result.Items))
+		for i, item := range result.Items {
+			titles[i] = item.Title
+		}
+		require.NotNil(t, result.TotalCount)
+		assert.Equal(t, len(titles), *result.TotalCount, "count must use the same filter")
+		return titles
+	}
+
+	t.Run("combined_expression", func(t *testing.T) {
+		titles := search(t, "priority >= high AND (tag:work OR tag:oncall) AND due_at < now+2d AND NOT status:blocked")
+		// Archived items match too: a status comparison replaces the default exclusion
+		assert.ElementsMatch(t, []string{"Page the on-call", "Ship the release", "Old work"}, titles)
+	})
+
+	t.Run("default_exclusion_without_status", func(t *testing.T) {
+		titles := search(t, "tag:work")
+		assert.ElementsMatch(t, []string{"Ship the release", "Blocked on review", "Plan next quarter"}, titles)
+	})
+
+	t.Run("not_matches_items_without_value", func(t *testing.T) {
+		titles := search(t, "NOT priority >= medium AND status = todo")
+		assert.ElementsMatch(t, []string{"Water the plants", "Someday"}, titles)
+	})
+
+	t.Run("presence", func(t *testing.T) {
+		titles := search(t, "-due_at:* OR title:PLANTS")
+		assert.ElementsMatch(t, []string{"Water the plants", "Someday"}, titles)
+	})
+}
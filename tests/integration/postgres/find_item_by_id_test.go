@@ -18,7 +18,7 @@ func TestFindItemByID_Success(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -79,7 +79,7 @@ func TestFindItemByID_NotFound(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -101,7 +101,7 @@ func TestFindItemByID_InvalidID(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -122,7 +122,7 @@ func TestFindItemByID_ReturnsAllFields(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -18,7 +18,7 @@ func TestStatusHistoryPreservation(t *testing.T) {
 	db, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create storage and service
 	pgURL := getTestDSN(t)
@@ -111,7 +111,7 @@ func TestStatusHistoryPreservationMultipleUpdates(t *testing.T) {
 	db, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	pgURL := getTestDSN(t)
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -164,7 +164,7 @@ func TestCreateItemDoesNotWipeOtherItemsHistory(t *testing.T) {
 	db, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	pgURL := getTestDSN(t)
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -22,7 +22,7 @@ func TestMarkJobAsCancelled_DoesNotViolateNotNullConstraint(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create test list and template
@@ -94,7 +94,7 @@ func TestMarkJobAsCancelled_OnlyAffectsOwnedJobs(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create test data
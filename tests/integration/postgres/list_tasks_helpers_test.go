@@ -25,7 +25,7 @@ func newListTasksTestEnv(t *testing.T) *listTasksTestEnv {
 
 	_, dbCleanup := SetupTestDB(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
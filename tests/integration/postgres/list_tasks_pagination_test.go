@@ -53,7 +53,6 @@ func TestListTasks_LargePageSize(t *testing.T) {
 			params := domain.ListTasksParams{
 				ListID: &listID,
 				Limit:  int(tc.requestPageSize),
-				Offset: 0,
 			}
 			result, err := env.Service().ListItems(env.Context(), params)
 			require.NoError(t, err)
@@ -69,14 +68,12 @@ func TestListTasks_LargePageSize(t *testing.T) {
 				assert.True(t, hasMore, "Should have more results when page size < total items")
 			}
 
-			offset := len(result.Items)
 			for hasMore {
-				params.Offset = offset
+				params.After = result.NextCursor
 				result, err = env.Service().ListItems(env.Context(), params)
 				require.NoError(t, err)
 
 				totalReturned += len(result.Items)
-				offset += len(result.Items)
 				hasMore = result.HasMore
 			}
 
@@ -24,7 +24,7 @@ func TestUpdateList_ReturnsVersionAndCountsAtomically(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	pgURL := getTestDSN(t)
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -75,7 +75,7 @@ func TestUpdateList_VersionConflictDetection(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	pgURL := getTestDSN(t)
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -121,7 +121,7 @@ func TestUpdateList_VersionIncrementsProperly(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	pgURL := getTestDSN(t)
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
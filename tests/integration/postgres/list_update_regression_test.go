@@ -23,7 +23,7 @@ func TestUpdateList_PreservesCreatedAt(t *testing.T) {
 	db, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	pgURL := getTestDSN(t)
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -76,7 +76,7 @@ func TestUpdateList_PreservesCreatedAt_MultipleUpdates(t *testing.T) {
 	db, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	pgURL := getTestDSN(t)
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -127,7 +127,7 @@ func TestUpdateItem_PreservesCreatedAt(t *testing.T) {
 	db, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	pgURL := getTestDSN(t)
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -182,7 +182,7 @@ func TestListLists_ReturnsCorrectItemCounts(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	pgURL := getTestDSN(t)
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -27,7 +27,7 @@ import (
 func TestOrderByDirection_HonorsAscDescKeywords(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -89,7 +89,6 @@ func TestOrderByDirection_HonorsAscDescKeywords(t *testing.T) {
 			ListID: &listID,
 			Filter: filter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 		require.Len(t, result.Items, 5)
@@ -119,7 +118,6 @@ func TestOrderByDirection_HonorsAscDescKeywords(t *testing.T) {
 			ListID: &listID,
 			Filter: filter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 		require.Len(t, result.Items, 5)
@@ -149,7 +147,6 @@ func TestOrderByDirection_HonorsAscDescKeywords(t *testing.T) {
 			ListID: &listID,
 			Filter: ascFilter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 
@@ -163,7 +160,6 @@ func TestOrderByDirection_HonorsAscDescKeywords(t *testing.T) {
 			ListID: &listID,
 			Filter: descFilter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 
@@ -188,7 +184,7 @@ func TestOrderByDirection_HonorsAscDescKeywords(t *testing.T) {
 func TestOrderByDirection_DueAt(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -248,7 +244,6 @@ func TestOrderByDirection_DueAt(t *testing.T) {
 			ListID: &listID,
 			Filter: filter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 		require.Len(t, result.Items, 3)
@@ -271,7 +266,6 @@ func TestOrderByDirection_DueAt(t *testing.T) {
 			ListID: &listID,
 			Filter: filter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 		require.Len(t, result.Items, 3)
@@ -288,7 +282,7 @@ func TestOrderByDirection_DueAt(t *testing.T) {
 func TestOrderByDirection_Priority(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -348,7 +342,6 @@ func TestOrderByDirection_Priority(t *testing.T) {
 			ListID: &listID,
 			Filter: ascFilter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 
@@ -362,7 +355,6 @@ func TestOrderByDirection_Priority(t *testing.T) {
 			ListID: &listID,
 			Filter: descFilter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 
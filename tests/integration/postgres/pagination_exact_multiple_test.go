@@ -19,7 +19,7 @@ import (
 func TestListTasksPaginationExactMultiple(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -73,20 +73,29 @@ func TestListTasksPaginationExactMultiple(t *testing.T) {
 		ListID: &listID,
 		Filter: filter,
 		Limit:  10,
-		Offset: 0,
 	}, nil)
 	require.NoError(t, err)
 	assert.Len(t, result.Items, 10, "Should return all 10 items")
 	assert.False(t, result.HasMore, "HasMore should be false when we've returned all items (10 total, limit=10)")
+	assert.Nil(t, result.NextCursor, "No cursor should be returned when there are no more pages")
 
-	// Verify second page is empty (if buggy HasMore=true causes client to request it)
-	result2, err := store.FindItems(ctx, domain.ListTasksParams{
+	// Two pages of 5: the second page ends exactly at the last item
+	page1, err := store.FindItems(ctx, domain.ListTasksParams{
 		ListID: &listID,
 		Filter: filter,
-		Limit:  10,
-		Offset: 10,
+		Limit:  5,
+	}, nil)
+	require.NoError(t, err)
+	assert.Len(t, page1.Items, 5)
+	require.True(t, page1.HasMore, "First of two full pages should report more")
+
+	page2, err := store.FindItems(ctx, domain.ListTasksParams{
+		ListID: &listID,
+		Filter: filter,
+		Limit:  5,
+		After:  page1.NextCursor,
 	}, nil)
 	require.NoError(t, err)
-	assert.Len(t, result2.Items, 0, "Second page should be empty")
-	assert.False(t, result2.HasMore, "HasMore should be false on empty page")
+	assert.Len(t, page2.Items, 5, "Second page should hold the remaining items")
+	assert.False(t, page2.HasMore, "HasMore should be false after the last full page")
 }
@@ -16,12 +16,11 @@ import (
 // TestFindItems_TotalCount_ReturnsActualTotal tests that TotalCount returns the actual
 // total number of matching items across all pages.
 //
-// Uses COUNT(*) OVER() window function to get total count with each row,
-// or run a separate count query.
+// The total is only counted when requested, on every page of a keyset walk.
 func TestFindItems_TotalCount_ReturnsActualTotal(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -69,68 +68,38 @@ func TestFindItems_TotalCount_ReturnsActualTotal(t *testing.T) {
 	filter, err := domain.NewItemsFilter(domain.ItemsFilterInput{})
 	require.NoError(t, err)
 
-	t.Run("FirstPage_TotalCountShouldBe50", func(t *testing.T) {
-		// Request first page with limit=10
-		result, err := store.FindItems(ctx, domain.ListTasksParams{
-			ListID: &listID,
-			Filter: filter,
-			Limit:  10,
-			Offset: 0,
-		}, nil)
-		require.NoError(t, err)
-
-		assert.Len(t, result.Items, 10, "Should return 10 items on first page")
-		assert.True(t, result.HasMore, "HasMore should be true (40 more items)")
-		assert.Equal(t, totalItems, result.TotalCount,
-			"TotalCount should be 50 (actual total), not 10 (offset+len)")
-	})
-
-	t.Run("MiddlePage_TotalCountShouldBe50", func(t *testing.T) {
-		// Request third page (offset=20)
-		result, err := store.FindItems(ctx, domain.ListTasksParams{
-			ListID: &listID,
-			Filter: filter,
-			Limit:  10,
-			Offset: 20,
-		}, nil)
-		require.NoError(t, err)
-
-		assert.Len(t, result.Items, 10, "Should return 10 items on middle page")
-		assert.True(t, result.HasMore, "HasMore should be true (20 more items)")
-		assert.Equal(t, totalItems, result.TotalCount,
-			"TotalCount should be 50 (actual total), not 30 (offset+len)")
-	})
-
-	t.Run("LastPage_TotalCountShouldBe50", func(t *testing.T) {
-		// Request last page (offset=40)
-		result, err := store.FindItems(ctx, domain.ListTasksParams{
-			ListID: &listID,
-			Filter: filter,
-			Limit:  10,
-			Offset: 40,
-		}, nil)
-		require.NoError(t, err)
-
-		assert.Len(t, result.Items, 10, "Should return 10 items on last page")
-		assert.False(t, result.HasMore, "HasMore should be false (no more items)")
-		assert.Equal(t, totalItems, result.TotalCount,
-			"TotalCount should be 50 (actual total), not 50 (offset+len)")
+	t.Run("EveryPage_TotalCountShouldBe50", func(t *testing.T) {
+		// Walk all five pages of 10 via the cursor
+		var after *domain.PageCursor
+		for page := range 5 {
+			result, err := store.FindItems(ctx, domain.ListTasksParams{
+				ListID:            &listID,
+				Filter:            filter,
+				Limit:             10,
+				After:             after,
+				IncludeTotalCount: true,
+			}, nil)
+			require.NoError(t, err)
+
+			assert.Len(t, result.Items, 10, "Should return 10 items on page %d", page+1)
+			assert.Equal(t, page < 4, result.HasMore, "Only the last page should have no more items")
+			require.NotNil(t, result.TotalCount)
+			assert.Equal(t, totalItems, *result.TotalCount,
+				"TotalCount should be 50 (actual total) on page %d", page+1)
+			after = result.NextCursor
+		}
 	})
 
-	t.Run("EmptyPage_TotalCountShouldBe50", func(t *testing.T) {
-		// Request beyond last page (offset=50)
+	t.Run("NotRequested_TotalCountIsNil", func(t *testing.T) {
 		result, err := store.FindItems(ctx, domain.ListTasksParams{
 			ListID: &listID,
 			Filter: filter,
 			Limit:  10,
-			Offset: 50,
 		}, nil)
 		require.NoError(t, err)
 
-		assert.Len(t, result.Items, 0, "Should return 0 items beyond last page")
-		assert.False(t, result.HasMore, "HasMore should be false")
-		assert.Equal(t, totalItems, result.TotalCount,
-			"TotalCount should still be 50 even on empty page")
+		assert.Len(t, result.Items, 10)
+		assert.Nil(t, result.TotalCount, "TotalCount should only be counted on request")
 	})
 }
 
@@ -138,7 +107,7 @@ func TestFindItems_TotalCount_ReturnsActualTotal(t *testing.T) {
 func TestFindItems_TotalCount_WithFilters(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -203,16 +172,16 @@ func TestFindItems_TotalCount_WithFilters(t *testing.T) {
 		require.NoError(t, err)
 
 		result, err := store.FindItems(ctx, domain.ListTasksParams{
-			ListID: &listID,
-			Filter: filter,
-			Limit:  10,
-			Offset: 0,
+			ListID:            &listID,
+			Filter:            filter,
+			Limit:             10,
+			IncludeTotalCount: true,
 		}, nil)
 		require.NoError(t, err)
 
 		assert.Len(t, result.Items, 10, "Should return 10 TODO items")
 		assert.True(t, result.HasMore, "HasMore should be true (20 more TODO items)")
-		assert.Equal(t, 30, result.TotalCount,
+		assert.Equal(t, 30, *result.TotalCount,
 			"TotalCount should be 30 (TODO items only), not 10")
 	})
 
@@ -223,16 +192,16 @@ func TestFindItems_TotalCount_WithFilters(t *testing.T) {
 		require.NoError(t, err)
 
 		result, err := store.FindItems(ctx, domain.ListTasksParams{
-			ListID: &listID,
-			Filter: filter,
-			Limit:  10,
-			Offset: 0,
+			ListID:            &listID,
+			Filter:            filter,
+			Limit:             10,
+			IncludeTotalCount: true,
 		}, nil)
 		require.NoError(t, err)
 
 		assert.Len(t, result.Items, 10, "Should return 10 DONE items")
 		assert.True(t, result.HasMore, "HasMore should be true (10 more DONE items)")
-		assert.Equal(t, 20, result.TotalCount,
+		assert.Equal(t, 20, *result.TotalCount,
 			"TotalCount should be 20 (DONE items only), not 10")
 	})
 }
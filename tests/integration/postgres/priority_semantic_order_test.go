@@ -21,7 +21,7 @@ import (
 func TestPrioritySorting_SemanticOrder(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -90,7 +90,6 @@ func TestPrioritySorting_SemanticOrder(t *testing.T) {
 			ListID: &listID,
 			Filter: filter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 		require.Len(t, result.Items, 4)
@@ -123,7 +122,6 @@ func TestPrioritySorting_SemanticOrder(t *testing.T) {
 			ListID: &listID,
 			Filter: filter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 		require.Len(t, result.Items, 4)
@@ -156,7 +154,6 @@ func TestPrioritySorting_SemanticOrder(t *testing.T) {
 			ListID: &listID,
 			Filter: ascFilter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 
@@ -170,7 +167,6 @@ func TestPrioritySorting_SemanticOrder(t *testing.T) {
 			ListID: &listID,
 			Filter: descFilter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 
@@ -190,7 +186,7 @@ func TestPrioritySorting_SemanticOrder(t *testing.T) {
 func TestPrioritySorting_NotAlphabetical(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -255,7 +251,6 @@ func TestPrioritySorting_NotAlphabetical(t *testing.T) {
 			ListID: &listID,
 			Filter: filter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 		require.Len(t, result.Items, 2)
@@ -279,7 +274,6 @@ func TestPrioritySorting_NotAlphabetical(t *testing.T) {
 			ListID: &listID,
 			Filter: filter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 		require.Len(t, result.Items, 2)
@@ -298,7 +292,7 @@ func TestPrioritySorting_NotAlphabetical(t *testing.T) {
 func TestPrioritySorting_WithNulls(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -375,7 +369,6 @@ func TestPrioritySorting_WithNulls(t *testing.T) {
 			ListID: &listID,
 			Filter: filter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 		require.Len(t, result.Items, 3)
@@ -397,7 +390,6 @@ func TestPrioritySorting_WithNulls(t *testing.T) {
 			ListID: &listID,
 			Filter: filter,
 			Limit:  10,
-			Offset: 0,
 		}, nil)
 		require.NoError(t, err)
 		require.Len(t, result.Items, 3)
@@ -24,7 +24,7 @@ func TestUpdateRecurringTemplate_RecurrenceConfigNilInMask(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
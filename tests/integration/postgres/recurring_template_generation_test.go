@@ -20,7 +20,7 @@ func setupRecurringTest(t *testing.T) (*postgres.Store, *todo.Service, func()) {
 
 	_, dbCleanup := SetupTestDB(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := GetTestStorageDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -46,7 +46,7 @@ func TestSyncLayerGeneration(t *testing.T) {
 	store, service, cleanup := setupRecurringTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list
 	listID, err := uuid.NewV7()
@@ -105,7 +105,7 @@ func TestOnDemandLayerGeneration(t *testing.T) {
 	store, service, cleanup := setupRecurringTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list
 	listID, err := uuid.NewV7()
@@ -164,7 +164,7 @@ func TestPatternChange_DeletesOldAndGeneratesNew(t *testing.T) {
 	store, service, cleanup := setupRecurringTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list
 	listID, err := uuid.NewV7()
@@ -234,7 +234,7 @@ func TestEditContent_CreatesExceptionButKeepsTemplateLink(t *testing.T) {
 	store, service, cleanup := setupRecurringTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list
 	listID, err := uuid.NewV7()
@@ -304,7 +304,7 @@ func TestDetachOnEdit_StatusChangeDoesNotDetach(t *testing.T) {
 	store, service, cleanup := setupRecurringTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list
 	listID, err := uuid.NewV7()
@@ -365,7 +365,7 @@ func TestIdempotency_DuplicatePrevention(t *testing.T) {
 	store, service, cleanup := setupRecurringTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list
 	listID, err := uuid.NewV7()
@@ -432,7 +432,7 @@ func TestExceptionHandling_ListItemsExcludesDeletedInstances(t *testing.T) {
 	store, service, cleanup := setupRecurringTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list
 	listID, err := uuid.NewV7()
@@ -516,7 +516,7 @@ func TestExceptionHandling_GenerationSkipsExceptionDates(t *testing.T) {
 	store, service, cleanup := setupRecurringTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list
 	listID, err := uuid.NewV7()
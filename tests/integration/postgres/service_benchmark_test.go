@@ -23,7 +23,7 @@ func getBenchmarkStorage(b *testing.B) *postgres.Store {
 		b.Skipf("Failed to load test config: %v", err)
 	}
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, cfg.Database.DSN)
 	if err != nil {
 		b.Fatalf("failed to create storage: %v", err)
@@ -36,7 +36,7 @@ func getBenchmarkStorage(b *testing.B) *postgres.Store {
 func cleanupBenchmarkData(b *testing.B, storage todo.Repository) {
 	b.Helper()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	result, err := storage.FindLists(ctx, domain.ListListsParams{Limit: 1000})
 	if err != nil {
 		b.Logf("failed to list lists for cleanup: %v", err)
@@ -64,7 +64,7 @@ func cleanupBenchmarkData(b *testing.B, storage todo.Repository) {
 func setupBenchmarkData(b *testing.B, storage todo.Repository, numLists, itemsPerList int) {
 	b.Helper()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	now := time.Now().UTC()
 
 	for i := range numLists {
@@ -150,7 +150,7 @@ func BenchmarkListTasks(b *testing.B) {
 
 			generator := recurring.NewDomainGenerator()
 			todoService := todo.NewService(storage, generator, todo.Config{})
-			ctx := context.Background()
+			ctx := domain.WithInternalAccess(context.Background())
 
 			b.Run("NoFilter", func(b *testing.B) {
 				filter, _ := domain.NewItemsFilter(domain.ItemsFilterInput{})
@@ -221,7 +221,7 @@ func BenchmarkCreateList(b *testing.B) {
 
 	generator := recurring.NewDomainGenerator()
 	todoService := todo.NewService(storage, generator, todo.Config{})
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	for b.Loop() {
 		_, err := todoService.CreateList(ctx, "Benchmark List")
@@ -239,7 +239,7 @@ func BenchmarkCreateItem(b *testing.B) {
 
 	generator := recurring.NewDomainGenerator()
 	todoService := todo.NewService(storage, generator, todo.Config{})
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list to add items to
 	list, err := todoService.CreateList(ctx, "Benchmark List")
@@ -266,7 +266,7 @@ func BenchmarkUpdateItem(b *testing.B) {
 
 	generator := recurring.NewDomainGenerator()
 	todoService := todo.NewService(storage, generator, todo.Config{})
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create list and item
 	list, _ := todoService.CreateList(ctx, "Benchmark List")
@@ -296,7 +296,7 @@ func BenchmarkGetList(b *testing.B) {
 
 	generator := recurring.NewDomainGenerator()
 	todoService := todo.NewService(storage, generator, todo.Config{})
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list with items
 	list, _ := todoService.CreateList(ctx, "Benchmark List")
@@ -336,7 +336,7 @@ func BenchmarkListLists(b *testing.B) {
 
 			generator := recurring.NewDomainGenerator()
 			todoService := todo.NewService(storage, generator, todo.Config{})
-			ctx := context.Background()
+			ctx := domain.WithInternalAccess(context.Background())
 
 			// Create N lists, each with 10 items
 			for i := range count {
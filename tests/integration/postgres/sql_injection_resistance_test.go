@@ -17,7 +17,7 @@ import (
 func setupSQLInjectionTest(t *testing.T) (*postgres.Store, func()) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 
@@ -48,7 +48,7 @@ func TestListTasks_DirectAssignment_SQLInjectionResistance(t *testing.T) {
 	store, cleanup := setupSQLInjectionTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create test data
 	listUUID, err := uuid.NewV7()
@@ -122,6 +122,33 @@ func TestListTasks_DirectAssignment_SQLInjectionResistance(t *testing.T) {
 		})
 	}
 
+	// Filter expressions: unknown fields are rejected by the domain layer, and
+	// values - quoted or not - are bound as parameters, never spliced into SQL
+	t.Run("filter_expression_field_rejected_by_domain", func(t *testing.T) {
+		expression := "id; DROP TABLE todo_items-- = 1"
+		_, err := domain.NewItemsFilter(domain.ItemsFilterInput{Expression: &expression})
+		assert.ErrorIs(t, err, domain.ErrInvalidFilterExpression)
+	})
+
+	t.Run("filter_expression_values_are_data", func(t *testing.T) {
+		for _, expression := range []string{
+			`title = "Test Task' OR '1'='1"`,
+			`title:"'; DROP TABLE todo_items; --"`,
+			`custom_fields.note = "x') OR true; --"`,
+		} {
+			filter, err := domain.NewItemsFilter(domain.ItemsFilterInput{Expression: &expression})
+			require.NoError(t, err)
+
+			result, err := store.FindItems(ctx, domain.ListTasksParams{
+				ListID: &listID,
+				Filter: filter,
+				Limit:  10,
+			}, nil)
+			require.NoError(t, err, expression)
+			assert.Empty(t, result.Items, "Malicious value must only match literally: %s", expression)
+		}
+	})
+
 	// Test that valid queries still work after injection attempts
 	t.Run("valid_queries_still_work", func(t *testing.T) {
 		// Valid orderBy options
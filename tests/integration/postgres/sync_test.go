@@ -0,0 +1,221 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rezkam/mono/internal/application/todo"
+	"github.com/rezkam/mono/internal/domain"
+	"github.com/rezkam/mono/internal/recurring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// syncedItemIDs returns the IDs of the items in a sync page.
+func syncedItemIDs(page *domain.SyncPage) []string {
+	ids := make([]string, len(page.Items))
+	for i, item := range page.Items {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+// TestSync_ReturnsChangesAndTombstones verifies that a sync returns what changed
+// after the cursor in its current state, and deleted entities as tombstones.
+func TestSync_ReturnsChangesAndTombstones(t *testing.T) {
+	store, ctx := SetupTestStore(t)
+	service := todo.NewService(store, recurring.NewDomainGenerator(), todo.Config{})
+
+	list, err := service.CreateList(ctx, "Synced")
+	require.NoError(t, err)
+	kept, err := service.CreateItem(ctx, list.ID, &domain.TodoItem{Title: "Kept"})
+	require.NoError(t, err)
+	removed, err := service.CreateItem(ctx, list.ID, &domain.TodoItem{Title: "Removed"})
+	require.NoError(t, err)
+
+	initial, err := service.Sync(ctx, domain.SyncCursor{}, 0)
+	require.NoError(t, err)
+	require.Len(t, initial.Lists, 1)
+	assert.Equal(t, list.ID, initial.Lists[0].ID)
+	assert.ElementsMatch(t, []string{kept.ID, removed.ID}, syncedItemIDs(initial))
+	assert.Empty(t, initial.Deleted)
+	assert.False(t, initial.HasMore)
+
+	// Nothing changed since the last sync
+	unchanged, err := service.Sync(ctx, initial.Cursor, 0)
+	require.NoError(t, err)
+	assert.Empty(t, unchanged.Lists)
+	assert.Empty(t, unchanged.Items)
+
+	title := "Renamed"
+	_, err = service.UpdateItem(ctx, domain.UpdateItemParams{
+		ItemID:     kept.ID,
+		ListID:     list.ID,
+		UpdateMask: []string{"title"},
+		Title:      &title,
+	})
+	require.NoError(t, err)
+	require.NoError(t, service.DeleteItem(ctx, list.ID, removed.ID))
+
+	delta, err := service.Sync(ctx, initial.Cursor, 0)
+	require.NoError(t, err)
+	assert.Empty(t, delta.Lists)
+	require.Len(t, delta.Items, 1)
+	assert.Equal(t, "Renamed", delta.Items[0].Title)
+	require.Len(t, delta.Deleted, 1)
+	assert.Equal(t, domain.SyncTombstone{EntityType: domain.ChangeEntityItem, EntityID: removed.ID, ListID: list.ID}, delta.Deleted[0])
+}
+
+// TestSync_PagesThroughChanges verifies that a full page reports more changes
+// and its cursor continues right after it.
+func TestSync_PagesThroughChanges(t *testing.T) {
+	store, ctx := SetupTestStore(t)
+	service := todo.NewService(store, recurring.NewDomainGenerator(), todo.Config{})
+
+	list, err := service.CreateList(ctx, "Paged")
+	require.NoError(t, err)
+	for _, title := range []string{"One", "Two", "Three"} {
+		_, err := service.CreateItem(ctx, list.ID, &domain.TodoItem{Title: title})
+		require.NoError(t, err)
+	}
+
+	var cursor domain.SyncCursor
+	var items []string
+	for range 10 {
+		page, err := service.Sync(ctx, cursor, 2)
+		require.NoError(t, err)
+		items = append(items, syncedItemIDs(page)...)
+		cursor = page.Cursor
+		if !page.HasMore {
+			break
+		}
+	}
+	assert.Len(t, items, 3, "every item must be synced exactly once")
+}
+
+// TestSync_WaitsForEarlierTransactions verifies that a change committed while an
+// older transaction is still running is not synced ahead of it: the cursor
+// must not move past changes that have not committed yet.
+func TestSync_WaitsForEarlierTransactions(t *testing.T) {
+	store, ctx := SetupTestStore(t)
+	service := todo.NewService(store, recurring.NewDomainGenerator(), todo.Config{})
+
+	list, err := service.CreateList(ctx, "Concurrent")
+	require.NoError(t, err)
+	before, err := service.Sync(ctx, domain.SyncCursor{}, 0)
+	require.NoError(t, err)
+
+	// A slow transaction writes an item and stays open
+	written := make(chan struct{})
+	release := make(chan struct{})
+	slowItemID := uuid.Must(uuid.NewV7()).String()
+	slowDone := make(chan error, 1)
+	go func() {
+		slowDone <- store.Atomic(ctx, func(repo todo.Repository) error {
+			now := time.Now().UTC()
+			if _, err := repo.CreateItem(ctx, list.ID, &domain.TodoItem{
+				ID:        slowItemID,
+				Title:     "Slow",
+				Status:    domain.TaskStatusTodo,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}); err != nil {
+				close(written)
+				return err
+			}
+			close(written)
+			<-release
+			return nil
+		})
+	}()
+	<-written
+
+	// A later transaction commits first
+	fast, err := service.CreateItem(ctx, list.ID, &domain.TodoItem{Title: "Fast"})
+	require.NoError(t, err)
+
+	pending, err := service.Sync(ctx, before.Cursor, 0)
+	require.NoError(t, err)
+	assert.Empty(t, pending.Items, "changes after a running transaction must wait for it")
+
+	close(release)
+	require.NoError(t, <-slowDone)
+
+	after, err := service.Sync(ctx, pending.Cursor, 0)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{slowItemID, fast.ID}, syncedItemIDs(after))
+}
+
+// TestSync_TombstonesForLostAccess verifies that entities leaving the caller's
+// visible set are reported as deleted: an item moved to a list the caller
+// cannot access, and a list whose sharing with the caller was removed.
+func TestSync_TombstonesForLostAccess(t *testing.T) {
+	store, ctx := SetupTestStore(t)
+	service := todo.NewService(store, recurring.NewDomainGenerator(), todo.Config{})
+
+	principal := func(ownerID string) context.Context {
+		return domain.WithPrincipal(ctx, domain.Principal{OwnerID: ownerID, Scopes: domain.ReadWriteScopes()})
+	}
+	ownerID, memberID, outsiderID := uuid.Must(uuid.NewV7()).String(), uuid.Must(uuid.NewV7()).String(), uuid.Must(uuid.NewV7()).String()
+	ownerCtx, memberCtx, outsiderCtx := principal(ownerID), principal(memberID), principal(outsiderID)
+
+	shared, err := service.CreateList(ownerCtx, "Shared")
+	require.NoError(t, err)
+	private, err := service.CreateList(ownerCtx, "Private")
+	require.NoError(t, err)
+	_, err = service.AddMember(ownerCtx, shared.ID, memberID, "editor")
+	require.NoError(t, err)
+	moved, err := service.CreateItem(ownerCtx, shared.ID, &domain.TodoItem{Title: "Moved"})
+	require.NoError(t, err)
+	stays, err := service.CreateItem(ownerCtx, shared.ID, &domain.TodoItem{Title: "Stays"})
+	require.NoError(t, err)
+
+	ownerInitial, err := service.Sync(ownerCtx, domain.SyncCursor{}, 0)
+	require.NoError(t, err)
+	memberInitial, err := service.Sync(memberCtx, domain.SyncCursor{}, 0)
+	require.NoError(t, err)
+	require.Len(t, memberInitial.Lists, 1)
+	assert.ElementsMatch(t, []string{moved.ID, stays.ID}, syncedItemIDs(memberInitial))
+	outsiderInitial, err := service.Sync(outsiderCtx, domain.SyncCursor{}, 0)
+	require.NoError(t, err)
+
+	// Moving an item out of the shared list removes it for the member only
+	_, err = store.MoveItem(ownerCtx, moved.ID, shared.ID, private.ID)
+	require.NoError(t, err)
+
+	memberMoved, err := service.Sync(memberCtx, memberInitial.Cursor, 0)
+	require.NoError(t, err)
+	assert.Empty(t, memberMoved.Items, "the moved item must not be synced in its new list")
+	assert.Equal(t, []domain.SyncTombstone{{EntityType: domain.ChangeEntityItem, EntityID: moved.ID, ListID: shared.ID}}, memberMoved.Deleted)
+
+	ownerMoved, err := service.Sync(ownerCtx, ownerInitial.Cursor, 0)
+	require.NoError(t, err)
+	require.Len(t, ownerMoved.Items, 1)
+	assert.Equal(t, private.ID, ownerMoved.Items[0].ListID)
+	assert.Empty(t, ownerMoved.Deleted)
+
+	// Removing the membership removes the list for the member
+	require.NoError(t, service.RemoveMember(ownerCtx, shared.ID, memberID))
+
+	memberRevoked, err := service.Sync(memberCtx, memberMoved.Cursor, 0)
+	require.NoError(t, err)
+	assert.Empty(t, memberRevoked.Lists)
+	assert.Equal(t, []domain.SyncTombstone{{EntityType: domain.ChangeEntityList, EntityID: shared.ID, ListID: shared.ID}}, memberRevoked.Deleted)
+
+	// Changes after the revocation are not synced to the former member
+	_, err = service.CreateItem(ownerCtx, shared.ID, &domain.TodoItem{Title: "After revocation"})
+	require.NoError(t, err)
+	memberAfter, err := service.Sync(memberCtx, memberRevoked.Cursor, 0)
+	require.NoError(t, err)
+	assert.Empty(t, memberAfter.Items)
+	assert.Empty(t, memberAfter.Deleted)
+
+	// Other tenants never see any of it
+	outsiderAfter, err := service.Sync(outsiderCtx, outsiderInitial.Cursor, 0)
+	require.NoError(t, err)
+	assert.Empty(t, outsiderAfter.Lists)
+	assert.Empty(t, outsiderAfter.Items)
+	assert.Empty(t, outsiderAfter.Deleted)
+}
@@ -17,7 +17,7 @@ func TestTagFiltering(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
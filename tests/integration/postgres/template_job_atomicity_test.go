@@ -18,7 +18,7 @@ import (
 func setupAtomicityTest(t *testing.T) (*postgres.Store, func()) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 
@@ -43,7 +43,7 @@ func createListForAtomicityTest(t *testing.T, store *postgres.Store, title strin
 		Title:     title,
 		CreatedAt: time.Now().UTC(),
 	}
-	_, err := store.CreateList(context.Background(), list)
+	_, err := store.CreateList(domain.WithInternalAccess(context.Background()), list)
 	require.NoError(t, err)
 	return listID
 }
@@ -55,7 +55,7 @@ func TestTemplateJobAtomicity_CommitsAtomically(t *testing.T) {
 	store, cleanup := setupAtomicityTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list first
 	listID := createListForAtomicityTest(t, store, "Test List")
@@ -108,7 +108,7 @@ func TestTemplateJobAtomicity_RollsBackOnError(t *testing.T) {
 	store, cleanup := setupAtomicityTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list first
 	listID := createListForAtomicityTest(t, store, "Test List")
@@ -161,7 +161,7 @@ func TestTemplateJobAtomicity_RollsBackOnPanic(t *testing.T) {
 	store, cleanup := setupAtomicityTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list first
 	listID := createListForAtomicityTest(t, store, "Test List")
@@ -214,7 +214,7 @@ func TestTemplateJobAtomicity_PartialInsertRollback(t *testing.T) {
 	store, cleanup := setupAtomicityTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list first
 	listID := createListForAtomicityTest(t, store, "Test List")
@@ -266,7 +266,7 @@ func TestTemplateJobAtomicity_NestedOperations(t *testing.T) {
 	store, cleanup := setupAtomicityTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a list first
 	listID := createListForAtomicityTest(t, store, "Test List")
@@ -344,7 +344,7 @@ func TestTemplateJobAtomicity_FirstOperationFails(t *testing.T) {
 	store, cleanup := setupAtomicityTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Use non-existent list ID to cause template creation to fail
 	nonExistentListID := uuid.Must(uuid.NewV7()).String()
@@ -396,7 +396,7 @@ func TestTemplateJobAtomicity_DuplicateTemplateID(t *testing.T) {
 	store, cleanup := setupAtomicityTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	listID := createListForAtomicityTest(t, store, "Test List")
 
 	// Create an existing template outside transaction
@@ -475,7 +475,7 @@ func TestTemplateJobAtomicity_ContextCancellation(t *testing.T) {
 	templateID := uuid.Must(uuid.NewV7()).String()
 
 	// Create cancellable context
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(domain.WithInternalAccess(context.Background()))
 
 	err := store.AtomicRecurring(ctx, func(ops todo.RecurringOperations) error {
 		_, err := ops.CreateRecurringTemplate(ctx, &domain.RecurringTemplate{
@@ -506,7 +506,7 @@ func TestTemplateJobAtomicity_ContextCancellation(t *testing.T) {
 	assert.ErrorIs(t, err, context.Canceled, "Error should be context.Canceled")
 
 	// Verify template was rolled back
-	_, err = store.FindRecurringTemplateByID(context.Background(), templateID)
+	_, err = store.FindRecurringTemplateByID(domain.WithInternalAccess(context.Background()), templateID)
 	assert.ErrorIs(t, err, domain.ErrTemplateNotFound, "Template should be rolled back after context cancellation")
 }
 
@@ -516,7 +516,7 @@ func TestTemplateJobAtomicity_TransactionIsolation(t *testing.T) {
 	store, cleanup := setupAtomicityTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	listID := createListForAtomicityTest(t, store, "Test List")
 	templateID := uuid.Must(uuid.NewV7()).String()
 
@@ -576,7 +576,7 @@ func TestTemplateJobAtomicity_JobReferencesNonExistentTemplate(t *testing.T) {
 	store, cleanup := setupAtomicityTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	listID := createListForAtomicityTest(t, store, "Test List")
 
 	templateID := uuid.Must(uuid.NewV7()).String()
@@ -34,7 +34,7 @@ func SetupTestDB(t *testing.T) (*sql.DB, func()) {
 	require.NoError(t, goose.Up(db, "."))
 
 	cleanup := func() {
-		_, _ = db.Exec("TRUNCATE TABLE todo_items, todo_lists, task_status_history, recurring_task_templates, recurring_generation_jobs, api_keys CASCADE")
+		_, _ = db.Exec("TRUNCATE TABLE todo_items, todo_lists, task_status_history, recurring_task_templates, recurring_generation_jobs, api_keys, change_log CASCADE")
 		_ = db.Close()
 	}
 
@@ -47,7 +47,7 @@ func SetupTestStore(t *testing.T) (*postgres.Store, context.Context) {
 	t.Helper()
 
 	pgURL := GetTestStorageDSN(t)
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 
@@ -55,7 +55,7 @@ func SetupTestStore(t *testing.T) (*postgres.Store, context.Context) {
 	t.Cleanup(func() {
 		db, err := sql.Open("pgx", pgURL)
 		if err == nil {
-			_, _ = db.Exec("TRUNCATE TABLE todo_items, todo_lists, task_status_history, recurring_task_templates, recurring_generation_jobs, api_keys CASCADE")
+			_, _ = db.Exec("TRUNCATE TABLE todo_items, todo_lists, task_status_history, recurring_task_templates, recurring_generation_jobs, api_keys, change_log CASCADE")
 			_ = db.Close()
 		}
 		_ = store.Close()
@@ -31,7 +31,7 @@ import (
 func TestTimezoneConsistency_ThreeLayerArchitecture(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(domain.WithInternalAccess(context.Background()))
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 
@@ -74,9 +74,13 @@ func TestTimezoneConsistency_ThreeLayerArchitecture(t *testing.T) {
 	router := server.Handler()
 
 	// Generate test API key for authenticated requests
-	apiKey, err := auth.CreateAPIKey(ctx, store, "sk", "test", "v1", "test-key", nil)
+	ownerID := uuid.Must(uuid.NewV7()).String()
+	apiKey, err := auth.CreateAPIKeyForOwner(ctx, store, ownerID, domain.ReadWriteScopes(), "sk", "test", "v1", "test-key", nil)
 	require.NoError(t, err)
 
+	// Lists requested through the router belong to the tenant of the API key
+	ownerCtx := domain.WithPrincipal(ctx, domain.Principal{OwnerID: ownerID, Scopes: domain.ReadWriteScopes()})
+
 	t.Run("Layer1_Storage_AlwaysUTC", func(t *testing.T) {
 		// Create a test list
 		listUUID, err := uuid.NewV7()
@@ -154,7 +158,7 @@ func TestTimezoneConsistency_ThreeLayerArchitecture(t *testing.T) {
 
 	t.Run("Layer3_Presentation_ISO8601_WithZ", func(t *testing.T) {
 		// Create a list
-		createdList, err := service.CreateList(ctx, "Presentation Test")
+		createdList, err := service.CreateList(ownerCtx, "Presentation Test")
 		require.NoError(t, err)
 
 		// Make HTTP request through router
@@ -192,7 +196,7 @@ func TestTimezoneConsistency_ThreeLayerArchitecture(t *testing.T) {
 
 	t.Run("Layer3_Presentation_AcceptsAnyTimezone", func(t *testing.T) {
 		// Create a list
-		createdList, err := service.CreateList(ctx, "Timezone Acceptance Test")
+		createdList, err := service.CreateList(ownerCtx, "Timezone Acceptance Test")
 		require.NoError(t, err)
 
 		testCases := []struct {
@@ -21,7 +21,7 @@ func TestTimezone_FloatingTime(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -70,7 +70,7 @@ func TestTimezone_FixedTimezone(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -129,7 +129,7 @@ func TestTimezone_ValidationRejectsInvalidTimezone(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -177,7 +177,7 @@ func TestTimezone_ValidIANATimezones(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -233,7 +233,7 @@ func TestTimezone_UpdateBetweenModes(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -307,7 +307,7 @@ func TestTimezone_RecurringItemsInheritTimezone(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -361,7 +361,6 @@ func TestTimezone_RecurringItemsInheritTimezone(t *testing.T) {
 		ListID: ptr.To(list.ID),
 		Filter: domain.ItemsFilter{},
 		Limit:  10,
-		Offset: 0,
 	}
 	result, err := service.ListItems(ctx, params)
 	require.NoError(t, err)
@@ -380,7 +379,7 @@ func TestTimezone_DoesNotAffectOperationalTimes(t *testing.T) {
 	_, cleanup := SetupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	pgURL := getTestDSN(t)
 
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
@@ -22,7 +22,7 @@ import (
 func TestUpdateItem_ValidatesListOwnership(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -121,7 +121,7 @@ func TestUpdateItem_ValidatesListOwnership(t *testing.T) {
 func TestUpdateItem_RepositoryLayer_ValidatesListOwnership(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
@@ -215,7 +215,7 @@ func TestUpdateItem_RepositoryLayer_ValidatesListOwnership(t *testing.T) {
 func TestUpdateItem_EmptyListId_ReturnsInvalidArgument(t *testing.T) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 	defer store.Close()
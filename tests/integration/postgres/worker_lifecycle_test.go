@@ -22,7 +22,7 @@ func TestWorker_Lifecycle_SkipLockedExclusivity(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create a single job
 	listUUID, _ := uuid.NewV7()
@@ -90,7 +90,7 @@ func TestWorker_Lifecycle_HeartbeatExtension(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Create job
@@ -151,7 +151,7 @@ func TestWorker_Lifecycle_CrashRecovery(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Setup job
@@ -205,7 +205,7 @@ func TestWorker_Lifecycle_ReconciliationExclusivity(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	// Run TryAcquireExclusiveRun concurrently - only one should succeed
@@ -250,7 +250,7 @@ func TestWorker_Lifecycle_LeaseContention_ReturnsNotAcquiredNotError(t *testing.
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	coordinator := postgres.NewPostgresCoordinator(store.Pool())
 
 	runType := "test-reconciliation"
@@ -282,7 +282,7 @@ func TestWorker_Lifecycle_PanicHandling_MovesToDLQ(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Setup template
 	listUUID, _ := uuid.NewV7()
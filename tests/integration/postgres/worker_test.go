@@ -22,7 +22,7 @@ import (
 func setupWorkerTest(t *testing.T) (*postgres.Store, *worker.Worker, func()) {
 	pgURL := GetTestStorageDSN(t)
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 	store, err := postgres.NewPostgresStore(ctx, pgURL)
 	require.NoError(t, err)
 
@@ -54,7 +54,7 @@ func TestWorker_CompleteFlow(t *testing.T) {
 	store, w, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Test cases with different patterns
 	testCases := []struct {
@@ -208,7 +208,7 @@ func TestWorker_MultipleWorkers_JobDistribution(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create test list
 	listUUID, err := uuid.NewV7()
@@ -351,7 +351,7 @@ func TestWorker_MultipleWorkers_HighLoad(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create test list
 	listUUID, err := uuid.NewV7()
@@ -493,7 +493,7 @@ func TestWorker_NoJobsAvailable(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Process with empty queue using GenerationWorker
 	genWorker := setupGenerationWorkerTest(t, store)
@@ -506,7 +506,7 @@ func TestWorker_GenerationWindow(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create test list
 	listUUID, err := uuid.NewV7()
@@ -585,7 +585,7 @@ func TestWorker_PreservesExistingItemsAndHistory(t *testing.T) {
 	store, w, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create test list
 	listUUID, err := uuid.NewV7()
@@ -721,7 +721,7 @@ func TestCoordinator_ExhaustedRetries_MovesToDeadLetter(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create test list
 	listUUID, err := uuid.NewV7()
@@ -813,7 +813,7 @@ func TestWorker_UpdateGenerationJobStatus_ReturnsErrorForNonExistentJob(t *testi
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Generate a valid UUID that doesn't exist in the database
 	nonExistentJobID, err := uuid.NewV7()
@@ -841,7 +841,7 @@ func TestWorker_UpdateGenerationJobStatus_SucceedsForExistingJob(t *testing.T) {
 	store, _, cleanup := setupWorkerTest(t)
 	defer cleanup()
 
-	ctx := context.Background()
+	ctx := domain.WithInternalAccess(context.Background())
 
 	// Create test list
 	listUUID, err := uuid.NewV7()